@@ -27,6 +27,7 @@ import (
 	deployments_handler "github.com/unbindapp/unbind-api/internal/api/handlers/deployments"
 	environments_handler "github.com/unbindapp/unbind-api/internal/api/handlers/environments"
 	github_handler "github.com/unbindapp/unbind-api/internal/api/handlers/github"
+	gitlab_handler "github.com/unbindapp/unbind-api/internal/api/handlers/gitlab"
 	instances_handler "github.com/unbindapp/unbind-api/internal/api/handlers/instances"
 	logs_handler "github.com/unbindapp/unbind-api/internal/api/handlers/logs"
 	metrics_handler "github.com/unbindapp/unbind-api/internal/api/handlers/metrics"
@@ -58,6 +59,7 @@ import (
 	"github.com/unbindapp/unbind-api/internal/infrastructure/registry"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/updater"
 	"github.com/unbindapp/unbind-api/internal/integrations/github"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
 	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
 	deployments_service "github.com/unbindapp/unbind-api/internal/services/deployments"
 	environment_service "github.com/unbindapp/unbind-api/internal/services/environment"
@@ -240,6 +242,9 @@ func startAPI(cfg *config.Config) {
 	// Create github client
 	githubClient := github.NewGithubClient(cfg.GithubURL, cfg)
 
+	// Create gitlab client
+	gitlabClient := gitlab.NewGitlabClient(cfg, repo)
+
 	// Buildkit settings manager
 	buildkitSettings := buildkitd.NewBuildkitSettingsManager(cfg, repo, kubeClient)
 
@@ -276,7 +281,7 @@ func startAPI(cfg *config.Config) {
 	webhooksService := webhooks_service.NewWebhooksService(repo)
 
 	// Create deployment controller
-	deploymentController := deployctl.NewDeploymentController(ctx, cancel, cfg, kubeClient, redisClient, repo, githubClient, webhooksService, variableService)
+	deploymentController := deployctl.NewDeploymentController(ctx, cancel, cfg, kubeClient, redisClient, repo, githubClient, gitlabClient, webhooksService, variableService)
 
 	// Create registry tester
 	registryTester := registry.NewRegistryTester(cfg, repo, kubeClient)
@@ -284,10 +289,10 @@ func startAPI(cfg *config.Config) {
 	// Create services
 	teamService := team_service.NewTeamService(repo, kubeClient)
 	projectService := project_service.NewProjectService(cfg, repo, kubeClient, webhooksService, deploymentController)
-	environmentService := environment_service.NewEnvironmentService(repo, kubeClient, deploymentController)
+	environmentService := environment_service.NewEnvironmentService(cfg, repo, kubeClient, deploymentController, githubClient)
 	logService := logs_service.NewLogsService(repo, kubeClient, lokiQuerier)
 	deploymentService := deployments_service.NewDeploymentService(repo, kubeClient, deploymentController, githubClient, lokiQuerier, registryTester, variableService)
-	serviceService := service_service.NewServiceService(cfg, repo, githubClient, kubeClient, deploymentController, dbProvider, webhooksService, variableService, promClient, deploymentService)
+	serviceService := service_service.NewServiceService(cfg, repo, githubClient, gitlabClient, kubeClient, deploymentController, dbProvider, webhooksService, variableService, promClient, deploymentService)
 	systemService := system_service.NewSystemService(cfg, repo, buildkitSettings, registryTester, kubeClient)
 	metricsService := metric_service.NewMetricService(promClient, repo, kubeClient)
 	instanceService := instance_service.NewInstanceService(cfg, repo, kubeClient)
@@ -310,6 +315,7 @@ func startAPI(cfg *config.Config) {
 		Cfg:                  cfg,
 		Repository:           repo,
 		GithubClient:         githubClient,
+		GitlabClient:         gitlabClient,
 		StringCache:          stringCache,
 		HttpClient:           &http.Client{},
 		DeploymentController: deploymentController,
@@ -442,6 +448,7 @@ func startAPI(cfg *config.Config) {
 		register("/system", "System", true, system_handler.RegisterHandlers)
 		register("/users", "Users", true, user_handler.RegisterHandlers)
 		register("/github", "GitHub", true, github_handler.RegisterHandlers)
+		register("/gitlab", "GitLab", true, gitlab_handler.RegisterHandlers)
 		register("/teams", "Teams", true, teams_handler.RegisterHandlers)
 		register("/projects", "Projects", true, projects_handler.RegisterHandlers)
 		register("/environments", "Environments", true, environments_handler.RegisterHandlers)
@@ -513,6 +520,102 @@ func startAPI(cfg *config.Config) {
 		log.Fatal("Failed to create database sync job", "err", err)
 	}
 
+	// Roll back new deployments that stay unhealthy
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(time.Minute),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentService.AutoRollbackUnhealthyDeployments(ctx); err != nil {
+					log.Error("Failed to check deployments for auto rollback", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create auto rollback job", "err", err)
+	}
+
+	// Queue scheduled deployments that are due
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(time.Minute),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentController.ReleaseScheduledDeployments(ctx); err != nil {
+					log.Error("Failed to release scheduled deployments", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create scheduled deployments job", "err", err)
+	}
+
+	// Queue deployments held by freeze windows that have ended
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(time.Minute),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentController.ReleaseFrozenDeployments(ctx); err != nil {
+					log.Error("Failed to release frozen deployments", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create frozen deployments job", "err", err)
+	}
+
+	// Redeploy services whose image tag was re-pushed
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(cfg.ImageUpdateInterval),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentService.RedeployUpdatedImages(ctx); err != nil {
+					log.Error("Failed to check images for updates", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create image update job", "err", err)
+	}
+
+	// Switch blue-green deployments over once healthy, clean up after promotions
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(30*time.Second),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentService.ReconcileRollouts(ctx); err != nil {
+					log.Error("Failed to reconcile rollouts", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create rollout job", "err", err)
+	}
+
+	// Report deployment statuses to GitHub as check runs
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(15*time.Second),
+		gocron.NewTask(
+			func(ctx context.Context) {
+				if err := deploymentController.SyncGithubCheckRuns(ctx); err != nil {
+					log.Error("Failed to sync github check runs", "err", err)
+				}
+			},
+			ctx,
+		),
+	)
+	if err != nil {
+		log.Fatal("Failed to create github check runs job", "err", err)
+	}
+
 	// Start the scheduler
 	scheduler.Start()
 	defer func() {
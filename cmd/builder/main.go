@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -132,6 +133,32 @@ func markDeploymentFailed(ctx context.Context, cfg *config.Config, webhooksServi
 	return nil
 }
 
+// Keeps each platform's build time and failure on the deployment, only multi-platform builds report them
+func recordPlatformBuilds(ctx context.Context, repo *repositories.Repositories, cfg *config.Config, builder *builders.Builder) {
+	platformBuilds := builder.PlatformBuilds()
+	if len(platformBuilds) == 0 {
+		return
+	}
+	if _, err := repo.Deployment().SetPlatformBuilds(ctx, nil, cfg.ServiceDeploymentID, platformBuilds); err != nil {
+		log.Errorf("Failed to record platform builds: %v", err)
+	}
+}
+
+// Index the packages of the built image, so running services can be searched by package
+func recordSBOMPackages(ctx context.Context, repo *repositories.Repositories, cfg *config.Config, builder *builders.Builder) {
+	packages := builder.SBOMPackages()
+	if len(packages) == 0 {
+		return
+	}
+	if err := repo.WithTx(ctx, func(tx repository.TxInterface) error {
+		return repo.Deployment().SetSBOMPackages(ctx, tx, cfg.ServiceDeploymentID, packages)
+	}); err != nil {
+		log.Errorf("Failed to record SBOM packages: %v", err)
+		return
+	}
+	log.Infof("Recorded %d packages from the image SBOM", len(packages))
+}
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -152,6 +179,9 @@ func main() {
 		log.Infof(" - Using docker image: %s", cfg.ServiceImage)
 	} else {
 		log.Infof(" - Builder Type: %s", cfg.ServiceBuilder)
+		if cfg.SourceArchiveKey != "" {
+			log.Infof(" - Source Archive: %s", cfg.SourceArchiveKey)
+		}
 		if cfg.ServiceBuilder == schema.ServiceBuilderDocker {
 			dockerfileDisplay := "Dockerfile"
 			if cfg.ServiceDockerBuilderDockerfilePath != "" {
@@ -163,6 +193,19 @@ func main() {
 				ctxDisplay = cfg.ServiceDockerBuilderBuildContext
 			}
 			log.Infof(" - Dockerfile Context: %s", ctxDisplay)
+			if cfg.ServiceDockerBuilderTarget != "" {
+				log.Infof(" - Dockerfile Target: %s", cfg.ServiceDockerBuilderTarget)
+			}
+		}
+		if cfg.ServiceBuilder == schema.ServiceBuilderBuildpacks {
+			builderImageDisplay := builders.DefaultBuildpacksBuilderImage
+			if cfg.ServiceBuildpacksBuilderImage != "" {
+				builderImageDisplay = cfg.ServiceBuildpacksBuilderImage
+			}
+			log.Infof(" - Buildpacks Builder Image: %s", builderImageDisplay)
+		}
+		if len(cfg.ServicePlatforms) > 0 {
+			log.Infof(" - Platforms: %s", strings.Join(cfg.ServicePlatforms, ", "))
 		}
 	}
 	fmt.Printf("\n")
@@ -314,6 +357,7 @@ func main() {
 		case schema.ServiceBuilderRailpack:
 			dockerImg, _, err = builder.BuildWithRailpack(ctx, buildSecrets)
 			if err != nil {
+				recordPlatformBuilds(ctx, repo, cfg, builder)
 				if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("failed railpack build %v", err), cfg.ServiceDeploymentID); err != nil {
 					log.Errorf("Failed to mark deployment as failed: %v", err)
 				}
@@ -322,11 +366,21 @@ func main() {
 		case schema.ServiceBuilderDocker:
 			dockerImg, _, err = builder.BuildDockerfile(ctx, buildSecrets)
 			if err != nil {
+				recordPlatformBuilds(ctx, repo, cfg, builder)
 				if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("failed docker build %v", err), cfg.ServiceDeploymentID); err != nil {
 					log.Errorf("Failed to mark deployment as failed: %v", err)
 				}
 				log.Fatalf("Failed to build with docker: %v", err)
 			}
+		case schema.ServiceBuilderBuildpacks:
+			dockerImg, _, err = builder.BuildWithBuildpacks(ctx, buildSecrets)
+			if err != nil {
+				recordPlatformBuilds(ctx, repo, cfg, builder)
+				if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("failed buildpacks build %v", err), cfg.ServiceDeploymentID); err != nil {
+					log.Errorf("Failed to mark deployment as failed: %v", err)
+				}
+				log.Fatalf("Failed to build with buildpacks: %v", err)
+			}
 		default:
 			if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("received request with unknown builder: %s", cfg.ServiceBuilder), cfg.ServiceDeploymentID); err != nil {
 				log.Errorf("Failed to mark deployment as failed: %v", err)
@@ -335,6 +389,9 @@ func main() {
 		}
 	}
 
+	recordPlatformBuilds(ctx, repo, cfg, builder)
+	recordSBOMPackages(ctx, repo, cfg, builder)
+
 	crdName := cfg.ServiceName
 	if crdName == "" {
 		log.Fatal("Service name not provided, cannot deploy")
@@ -349,6 +406,49 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Gate the rollout on the pre-deploy command (e.g. migrations)
+	if cfg.ServicePreDeployCommand != "" && cfg.ServiceType != schema.ServiceTypeDatabase {
+		log.Infof("Running pre-deploy command: %s", cfg.ServicePreDeployCommand)
+		output, err := k8s.RunPreDeployCommand(ctx, dockerImg, additionalEnv, securityContext)
+		if err != nil {
+			reason := fmt.Sprintf("pre-deploy command failed %v", err)
+			if output != "" {
+				reason = fmt.Sprintf("%s\n%s", reason, output)
+			}
+			if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, reason, cfg.ServiceDeploymentID); err != nil {
+				log.Errorf("Failed to mark deployment as failed: %v", err)
+			}
+			log.Fatalf("Pre-deploy command failed: %v", err)
+		}
+	}
+
+	// Blue-green and canary deployments run next to the current one until they're promoted
+	if cfg.ServiceRolloutStrategy != "" && cfg.ServiceRolloutStrategy != schema.RolloutStrategyRolling {
+		serviceSpec, err := k8s.DeployCandidate(ctx, crdName, dockerImg, additionalEnv, securityContext, healthCheck, variableMounts)
+		if err != nil {
+			if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("failed to deploy %s candidate %v", cfg.ServiceRolloutStrategy, err), cfg.ServiceDeploymentID); err != nil {
+				log.Errorf("Failed to mark deployment as failed: %v", err)
+			}
+			log.Fatalf("Failed to deploy candidate: %v", err)
+		}
+
+		if err := repo.WithTx(ctx, func(tx repository.TxInterface) error {
+			if _, err := repo.Deployment().AttachDeploymentMetadata(ctx, tx, cfg.ServiceDeploymentID, dockerImg, serviceSpec); err != nil {
+				return err
+			}
+			_, err := repo.Deployment().MarkStaged(ctx, tx, cfg.ServiceDeploymentID, time.Now())
+			return err
+		}); err != nil {
+			if err := markDeploymentFailed(ctx, cfg, webhooksService, repo, fmt.Sprintf("failed to update deployment metadata %v", err), cfg.ServiceDeploymentID); err != nil {
+				log.Errorf("Failed to mark deployment as failed: %v", err)
+			}
+			log.Fatalf("Failed to update deployment metadata: %v", err)
+		}
+
+		log.Infof("Deployment staged for %s rollout, deployment ID: %s", cfg.ServiceRolloutStrategy, cfg.ServiceDeploymentID.String())
+		return
+	}
+
 	// Deploy to kubernetes with context
 	_, serviceSpec, err := k8s.DeployImage(ctx, crdName, dockerImg, additionalEnv, securityContext, healthCheck, variableMounts)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/unbindapp/unbind-api/internal/common/log"
@@ -37,6 +38,9 @@ type Config struct {
 	// Github Specific
 	GithubURL        string `env:"GITHUB_URL" envDefault:"https://github.com"` // Override for github enterprise
 	GithubWebhookURL string
+	// Gitlab Specific, the instance URL is per connection
+	GitlabWebhookURL       string
+	GitlabOAuthCallbackURL string
 	// By default we will just use the external URL for the bind and unbind suffixes
 	UnbindSuffix string `env:"UNBIND_SUFFIX"`
 	// Postgres
@@ -60,6 +64,10 @@ type Config struct {
 	BootstrapContainerRegistryHost     string `env:"BOOTSTRAP_CONTAINER_REGISTRY_HOST"`
 	BootstrapContainerRegistryUser     string `env:"BOOTSTRAP_CONTAINER_REGISTRY_USER"`
 	BootstrapContainerRegistryPassword string `env:"BOOTSTRAP_CONTAINER_REGISTRY_PASSWORD"`
+	// How often image tags of services with image auto-update are checked for new digests
+	ImageUpdateInterval time.Duration `env:"IMAGE_UPDATE_INTERVAL" envDefault:"5m"`
+	// Registries post to /webhook/registry/{secret}, the registry webhook is disabled without it
+	RegistryWebhookSecret string `env:"REGISTRY_WEBHOOK_SECRET"`
 	// Buildkit
 	BuildkitHost string `env:"BUILDKIT_HOST" envDefault:"tcp://buildkitd.unbind-system:1234"`
 	// Logging
@@ -140,5 +148,12 @@ func NewConfig() *Config {
 	baseURL.Path = path.Join(baseURL.Path, "webhook/github")
 	cfg.GithubWebhookURL = baseURL.String()
 
+	// Parse gitlab webhook and oauth callback URLs
+	gitlabURL, _ := url.Parse(cfg.ExternalAPIURL)
+	gitlabURL.Path = path.Join(gitlabURL.Path, "webhook/gitlab")
+	cfg.GitlabWebhookURL = gitlabURL.String()
+	gitlabURL.Path = path.Join(gitlabURL.Path, "oauth/callback")
+	cfg.GitlabOAuthCallbackURL = gitlabURL.String()
+
 	return &cfg
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/jwtkey"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
@@ -30,6 +31,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/pvcmetadata"
 	"github.com/unbindapp/unbind-api/ent/registry"
 	"github.com/unbindapp/unbind-api/ent/s3"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
 	"github.com/unbindapp/unbind-api/ent/servicegroup"
@@ -58,6 +60,8 @@ type Client struct {
 	GithubApp *GithubAppClient
 	// GithubInstallation is the client for interacting with the GithubInstallation builders.
 	GithubInstallation *GithubInstallationClient
+	// GitlabConnection is the client for interacting with the GitlabConnection builders.
+	GitlabConnection *GitlabConnectionClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// JWTKey is the client for interacting with the JWTKey builders.
@@ -76,6 +80,8 @@ type Client struct {
 	Registry *RegistryClient
 	// S3 is the client for interacting with the S3 builders.
 	S3 *S3Client
+	// SBOMPackage is the client for interacting with the SBOMPackage builders.
+	SBOMPackage *SBOMPackageClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// ServiceConfig is the client for interacting with the ServiceConfig builders.
@@ -110,6 +116,7 @@ func (c *Client) init() {
 	c.Environment = NewEnvironmentClient(c.config)
 	c.GithubApp = NewGithubAppClient(c.config)
 	c.GithubInstallation = NewGithubInstallationClient(c.config)
+	c.GitlabConnection = NewGitlabConnectionClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.JWTKey = NewJWTKeyClient(c.config)
 	c.Oauth2Code = NewOauth2CodeClient(c.config)
@@ -119,6 +126,7 @@ func (c *Client) init() {
 	c.Project = NewProjectClient(c.config)
 	c.Registry = NewRegistryClient(c.config)
 	c.S3 = NewS3Client(c.config)
+	c.SBOMPackage = NewSBOMPackageClient(c.config)
 	c.Service = NewServiceClient(c.config)
 	c.ServiceConfig = NewServiceConfigClient(c.config)
 	c.ServiceGroup = NewServiceGroupClient(c.config)
@@ -225,6 +233,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Environment:        NewEnvironmentClient(cfg),
 		GithubApp:          NewGithubAppClient(cfg),
 		GithubInstallation: NewGithubInstallationClient(cfg),
+		GitlabConnection:   NewGitlabConnectionClient(cfg),
 		Group:              NewGroupClient(cfg),
 		JWTKey:             NewJWTKeyClient(cfg),
 		Oauth2Code:         NewOauth2CodeClient(cfg),
@@ -234,6 +243,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Project:            NewProjectClient(cfg),
 		Registry:           NewRegistryClient(cfg),
 		S3:                 NewS3Client(cfg),
+		SBOMPackage:        NewSBOMPackageClient(cfg),
 		Service:            NewServiceClient(cfg),
 		ServiceConfig:      NewServiceConfigClient(cfg),
 		ServiceGroup:       NewServiceGroupClient(cfg),
@@ -267,6 +277,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Environment:        NewEnvironmentClient(cfg),
 		GithubApp:          NewGithubAppClient(cfg),
 		GithubInstallation: NewGithubInstallationClient(cfg),
+		GitlabConnection:   NewGitlabConnectionClient(cfg),
 		Group:              NewGroupClient(cfg),
 		JWTKey:             NewJWTKeyClient(cfg),
 		Oauth2Code:         NewOauth2CodeClient(cfg),
@@ -276,6 +287,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Project:            NewProjectClient(cfg),
 		Registry:           NewRegistryClient(cfg),
 		S3:                 NewS3Client(cfg),
+		SBOMPackage:        NewSBOMPackageClient(cfg),
 		Service:            NewServiceClient(cfg),
 		ServiceConfig:      NewServiceConfigClient(cfg),
 		ServiceGroup:       NewServiceGroupClient(cfg),
@@ -315,9 +327,10 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Bootstrap, c.Deployment, c.Environment, c.GithubApp, c.GithubInstallation,
-		c.Group, c.JWTKey, c.Oauth2Code, c.Oauth2Token, c.PVCMetadata, c.Permission,
-		c.Project, c.Registry, c.S3, c.Service, c.ServiceConfig, c.ServiceGroup,
-		c.SystemSetting, c.Team, c.Template, c.User, c.VariableReference, c.Webhook,
+		c.GitlabConnection, c.Group, c.JWTKey, c.Oauth2Code, c.Oauth2Token,
+		c.PVCMetadata, c.Permission, c.Project, c.Registry, c.S3, c.SBOMPackage,
+		c.Service, c.ServiceConfig, c.ServiceGroup, c.SystemSetting, c.Team,
+		c.Template, c.User, c.VariableReference, c.Webhook,
 	} {
 		n.Use(hooks...)
 	}
@@ -328,9 +341,10 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Bootstrap, c.Deployment, c.Environment, c.GithubApp, c.GithubInstallation,
-		c.Group, c.JWTKey, c.Oauth2Code, c.Oauth2Token, c.PVCMetadata, c.Permission,
-		c.Project, c.Registry, c.S3, c.Service, c.ServiceConfig, c.ServiceGroup,
-		c.SystemSetting, c.Team, c.Template, c.User, c.VariableReference, c.Webhook,
+		c.GitlabConnection, c.Group, c.JWTKey, c.Oauth2Code, c.Oauth2Token,
+		c.PVCMetadata, c.Permission, c.Project, c.Registry, c.S3, c.SBOMPackage,
+		c.Service, c.ServiceConfig, c.ServiceGroup, c.SystemSetting, c.Team,
+		c.Template, c.User, c.VariableReference, c.Webhook,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -349,6 +363,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.GithubApp.mutate(ctx, m)
 	case *GithubInstallationMutation:
 		return c.GithubInstallation.mutate(ctx, m)
+	case *GitlabConnectionMutation:
+		return c.GitlabConnection.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
 	case *JWTKeyMutation:
@@ -367,6 +383,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Registry.mutate(ctx, m)
 	case *S3Mutation:
 		return c.S3.mutate(ctx, m)
+	case *SBOMPackageMutation:
+		return c.SBOMPackage.mutate(ctx, m)
 	case *ServiceMutation:
 		return c.Service.mutate(ctx, m)
 	case *ServiceConfigMutation:
@@ -647,6 +665,22 @@ func (c *DeploymentClient) QueryService(d *Deployment) *ServiceQuery {
 	return query
 }
 
+// QuerySbomPackages queries the sbom_packages edge of a Deployment.
+func (c *DeploymentClient) QuerySbomPackages(_m *Deployment) *SBOMPackageQuery {
+	query := (&SBOMPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deployment.Table, deployment.FieldID, id),
+			sqlgraph.To(sbompackage.Table, sbompackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deployment.SbomPackagesTable, deployment.SbomPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *DeploymentClient) Hooks() []Hook {
 	return c.hooks.Deployment
@@ -1199,6 +1233,171 @@ func (c *GithubInstallationClient) mutate(ctx context.Context, m *GithubInstalla
 	}
 }
 
+// GitlabConnectionClient is a client for the GitlabConnection schema.
+type GitlabConnectionClient struct {
+	config
+}
+
+// NewGitlabConnectionClient returns a client for the GitlabConnection from the given config.
+func NewGitlabConnectionClient(c config) *GitlabConnectionClient {
+	return &GitlabConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gitlabconnection.Hooks(f(g(h())))`.
+func (c *GitlabConnectionClient) Use(hooks ...Hook) {
+	c.hooks.GitlabConnection = append(c.hooks.GitlabConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gitlabconnection.Intercept(f(g(h())))`.
+func (c *GitlabConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GitlabConnection = append(c.inters.GitlabConnection, interceptors...)
+}
+
+// Create returns a builder for creating a GitlabConnection entity.
+func (c *GitlabConnectionClient) Create() *GitlabConnectionCreate {
+	mutation := newGitlabConnectionMutation(c.config, OpCreate)
+	return &GitlabConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GitlabConnection entities.
+func (c *GitlabConnectionClient) CreateBulk(builders ...*GitlabConnectionCreate) *GitlabConnectionCreateBulk {
+	return &GitlabConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GitlabConnectionClient) MapCreateBulk(slice any, setFunc func(*GitlabConnectionCreate, int)) *GitlabConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GitlabConnectionCreateBulk{err: fmt.Errorf("calling to GitlabConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GitlabConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GitlabConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GitlabConnection.
+func (c *GitlabConnectionClient) Update() *GitlabConnectionUpdate {
+	mutation := newGitlabConnectionMutation(c.config, OpUpdate)
+	return &GitlabConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GitlabConnectionClient) UpdateOne(_m *GitlabConnection) *GitlabConnectionUpdateOne {
+	mutation := newGitlabConnectionMutation(c.config, OpUpdateOne, withGitlabConnection(_m))
+	return &GitlabConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GitlabConnectionClient) UpdateOneID(id uuid.UUID) *GitlabConnectionUpdateOne {
+	mutation := newGitlabConnectionMutation(c.config, OpUpdateOne, withGitlabConnectionID(id))
+	return &GitlabConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GitlabConnection.
+func (c *GitlabConnectionClient) Delete() *GitlabConnectionDelete {
+	mutation := newGitlabConnectionMutation(c.config, OpDelete)
+	return &GitlabConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GitlabConnectionClient) DeleteOne(_m *GitlabConnection) *GitlabConnectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GitlabConnectionClient) DeleteOneID(id uuid.UUID) *GitlabConnectionDeleteOne {
+	builder := c.Delete().Where(gitlabconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GitlabConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for GitlabConnection.
+func (c *GitlabConnectionClient) Query() *GitlabConnectionQuery {
+	return &GitlabConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGitlabConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GitlabConnection entity by its id.
+func (c *GitlabConnectionClient) Get(ctx context.Context, id uuid.UUID) (*GitlabConnection, error) {
+	return c.Query().Where(gitlabconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GitlabConnectionClient) GetX(ctx context.Context, id uuid.UUID) *GitlabConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a GitlabConnection.
+func (c *GitlabConnectionClient) QueryUsers(_m *GitlabConnection) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gitlabconnection.Table, gitlabconnection.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gitlabconnection.UsersTable, gitlabconnection.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryServices queries the services edge of a GitlabConnection.
+func (c *GitlabConnectionClient) QueryServices(_m *GitlabConnection) *ServiceQuery {
+	query := (&ServiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gitlabconnection.Table, gitlabconnection.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, gitlabconnection.ServicesTable, gitlabconnection.ServicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GitlabConnectionClient) Hooks() []Hook {
+	return c.hooks.GitlabConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *GitlabConnectionClient) Interceptors() []Interceptor {
+	return c.inters.GitlabConnection
+}
+
+func (c *GitlabConnectionClient) mutate(ctx context.Context, m *GitlabConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GitlabConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GitlabConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GitlabConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GitlabConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GitlabConnection mutation op: %q", m.Op())
+	}
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -2547,6 +2746,22 @@ func (c *S3Client) QueryServiceBackupSource(s *S3) *ServiceConfigQuery {
 	return query
 }
 
+// QueryServiceUploadSource queries the service_upload_source edge of a S3.
+func (c *S3Client) QueryServiceUploadSource(_m *S3) *ServiceConfigQuery {
+	query := (&ServiceConfigClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(s3.Table, s3.FieldID, id),
+			sqlgraph.To(serviceconfig.Table, serviceconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, s3.ServiceUploadSourceTable, s3.ServiceUploadSourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *S3Client) Hooks() []Hook {
 	return c.hooks.S3
@@ -2572,6 +2787,155 @@ func (c *S3Client) mutate(ctx context.Context, m *S3Mutation) (Value, error) {
 	}
 }
 
+// SBOMPackageClient is a client for the SBOMPackage schema.
+type SBOMPackageClient struct {
+	config
+}
+
+// NewSBOMPackageClient returns a client for the SBOMPackage from the given config.
+func NewSBOMPackageClient(c config) *SBOMPackageClient {
+	return &SBOMPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sbompackage.Hooks(f(g(h())))`.
+func (c *SBOMPackageClient) Use(hooks ...Hook) {
+	c.hooks.SBOMPackage = append(c.hooks.SBOMPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sbompackage.Intercept(f(g(h())))`.
+func (c *SBOMPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SBOMPackage = append(c.inters.SBOMPackage, interceptors...)
+}
+
+// Create returns a builder for creating a SBOMPackage entity.
+func (c *SBOMPackageClient) Create() *SBOMPackageCreate {
+	mutation := newSBOMPackageMutation(c.config, OpCreate)
+	return &SBOMPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SBOMPackage entities.
+func (c *SBOMPackageClient) CreateBulk(builders ...*SBOMPackageCreate) *SBOMPackageCreateBulk {
+	return &SBOMPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SBOMPackageClient) MapCreateBulk(slice any, setFunc func(*SBOMPackageCreate, int)) *SBOMPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SBOMPackageCreateBulk{err: fmt.Errorf("calling to SBOMPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SBOMPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SBOMPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SBOMPackage.
+func (c *SBOMPackageClient) Update() *SBOMPackageUpdate {
+	mutation := newSBOMPackageMutation(c.config, OpUpdate)
+	return &SBOMPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SBOMPackageClient) UpdateOne(_m *SBOMPackage) *SBOMPackageUpdateOne {
+	mutation := newSBOMPackageMutation(c.config, OpUpdateOne, withSBOMPackage(_m))
+	return &SBOMPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SBOMPackageClient) UpdateOneID(id uuid.UUID) *SBOMPackageUpdateOne {
+	mutation := newSBOMPackageMutation(c.config, OpUpdateOne, withSBOMPackageID(id))
+	return &SBOMPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SBOMPackage.
+func (c *SBOMPackageClient) Delete() *SBOMPackageDelete {
+	mutation := newSBOMPackageMutation(c.config, OpDelete)
+	return &SBOMPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SBOMPackageClient) DeleteOne(_m *SBOMPackage) *SBOMPackageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SBOMPackageClient) DeleteOneID(id uuid.UUID) *SBOMPackageDeleteOne {
+	builder := c.Delete().Where(sbompackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SBOMPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for SBOMPackage.
+func (c *SBOMPackageClient) Query() *SBOMPackageQuery {
+	return &SBOMPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSBOMPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SBOMPackage entity by its id.
+func (c *SBOMPackageClient) Get(ctx context.Context, id uuid.UUID) (*SBOMPackage, error) {
+	return c.Query().Where(sbompackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SBOMPackageClient) GetX(ctx context.Context, id uuid.UUID) *SBOMPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeployment queries the deployment edge of a SBOMPackage.
+func (c *SBOMPackageClient) QueryDeployment(_m *SBOMPackage) *DeploymentQuery {
+	query := (&DeploymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sbompackage.Table, sbompackage.FieldID, id),
+			sqlgraph.To(deployment.Table, deployment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sbompackage.DeploymentTable, sbompackage.DeploymentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SBOMPackageClient) Hooks() []Hook {
+	return c.hooks.SBOMPackage
+}
+
+// Interceptors returns the client interceptors.
+func (c *SBOMPackageClient) Interceptors() []Interceptor {
+	return c.inters.SBOMPackage
+}
+
+func (c *SBOMPackageClient) mutate(ctx context.Context, m *SBOMPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SBOMPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SBOMPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SBOMPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SBOMPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SBOMPackage mutation op: %q", m.Op())
+	}
+}
+
 // ServiceClient is a client for the Service schema.
 type ServiceClient struct {
 	config
@@ -2712,6 +3076,38 @@ func (c *ServiceClient) QueryGithubInstallation(s *Service) *GithubInstallationQ
 	return query
 }
 
+// QueryGitlabConnection queries the gitlab_connection edge of a Service.
+func (c *ServiceClient) QueryGitlabConnection(_m *Service) *GitlabConnectionQuery {
+	query := (&GitlabConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(gitlabconnection.Table, gitlabconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, service.GitlabConnectionTable, service.GitlabConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryS3UploadSources queries the s3_upload_sources edge of a ServiceConfig.
+func (c *ServiceConfigClient) QueryS3UploadSources(_m *ServiceConfig) *S3Query {
+	query := (&S3Client{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceconfig.Table, serviceconfig.FieldID, id),
+			sqlgraph.To(s3.Table, s3.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, serviceconfig.S3UploadSourcesTable, serviceconfig.S3UploadSourcesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryServiceConfig queries the service_config edge of a Service.
 func (c *ServiceClient) QueryServiceConfig(s *Service) *ServiceConfigQuery {
 	query := (&ServiceConfigClient{config: c.config}).Query()
@@ -3798,6 +4194,22 @@ func (c *UserClient) QueryCreatedBy(u *User) *GithubAppQuery {
 	return query
 }
 
+// QueryGitlabConnections queries the gitlab_connections edge of a User.
+func (c *UserClient) QueryGitlabConnections(_m *User) *GitlabConnectionQuery {
+	query := (&GitlabConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(gitlabconnection.Table, gitlabconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GitlabConnectionsTable, user.GitlabConnectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 	query := (&GroupClient{config: c.config}).Query()
@@ -4172,16 +4584,18 @@ func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value,
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Bootstrap, Deployment, Environment, GithubApp, GithubInstallation, Group,
-		JWTKey, Oauth2Code, Oauth2Token, PVCMetadata, Permission, Project, Registry,
-		S3, Service, ServiceConfig, ServiceGroup, SystemSetting, Team, Template, User,
-		VariableReference, Webhook []ent.Hook
+		Bootstrap, Deployment, Environment, GithubApp, GithubInstallation,
+		GitlabConnection, Group, JWTKey, Oauth2Code, Oauth2Token, PVCMetadata,
+		Permission, Project, Registry, S3, SBOMPackage, Service, ServiceConfig,
+		ServiceGroup, SystemSetting, Team, Template, User, VariableReference,
+		Webhook []ent.Hook
 	}
 	inters struct {
-		Bootstrap, Deployment, Environment, GithubApp, GithubInstallation, Group,
-		JWTKey, Oauth2Code, Oauth2Token, PVCMetadata, Permission, Project, Registry,
-		S3, Service, ServiceConfig, ServiceGroup, SystemSetting, Team, Template, User,
-		VariableReference, Webhook []ent.Interceptor
+		Bootstrap, Deployment, Environment, GithubApp, GithubInstallation,
+		GitlabConnection, Group, JWTKey, Oauth2Code, Oauth2Token, PVCMetadata,
+		Permission, Project, Registry, S3, SBOMPackage, Service, ServiceConfig,
+		ServiceGroup, SystemSetting, Team, Template, User, VariableReference,
+		Webhook []ent.Interceptor
 	}
 )
 
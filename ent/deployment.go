@@ -43,6 +43,10 @@ type Deployment struct {
 	GitBranch *string `json:"git_branch,omitempty"`
 	// CommitAuthor holds the value of the "commit_author" field.
 	CommitAuthor *schema.GitCommitter `json:"commit_author,omitempty"`
+	// Object key of the uploaded source archive the deployment was built from, if applicable
+	SourceArchive *string `json:"source_archive,omitempty"`
+	// When a scheduled deployment is due to be queued
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
 	// QueuedAt holds the value of the "queued_at" field.
 	QueuedAt *time.Time `json:"queued_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
@@ -59,6 +63,8 @@ type Deployment struct {
 	Image *string `json:"image,omitempty"`
 	// The Kubernetes resource definition for the deployment
 	ResourceDefinition *v1.Service `json:"resource_definition,omitempty"`
+	// Names of the variables the deployment was rolled out with, values are never stored
+	EnvKeys []string `json:"env_keys,omitempty"`
 	// Builder used for this deployment
 	Builder schema.ServiceBuilder `json:"builder,omitempty"`
 	// Custom install command used for this deployment (railpack only)
@@ -71,6 +77,28 @@ type Deployment struct {
 	DockerBuilderDockerfilePath *string `json:"docker_builder_dockerfile_path,omitempty"`
 	// Build context path used for this deployment (docker builder only)
 	DockerBuilderBuildContext *string `json:"docker_builder_build_context,omitempty"`
+	// Dockerfile stage built for this deployment (docker builder only)
+	DockerBuilderTarget *string `json:"docker_builder_target,omitempty"`
+	// Build args used for this deployment (docker builder only)
+	DockerBuilderBuildArgs map[string]string `json:"docker_builder_build_args,omitempty"`
+	// Named additional build contexts used for this deployment (docker builder only)
+	DockerBuilderBuildContexts map[string]string `json:"docker_builder_build_contexts,omitempty"`
+	// CNB builder image used for this deployment (buildpacks builder only)
+	BuildpacksBuilderImage *string `json:"buildpacks_builder_image,omitempty"`
+	// Platforms the image was built for, empty for the builder's own platform
+	Platforms []string `json:"platforms,omitempty"`
+	// Build time and failure of each platform, for multi-platform builds
+	PlatformBuilds []schema.PlatformBuild `json:"platform_builds,omitempty"`
+	// Why this deployment was created as an automatic rollback, if it was
+	RollbackReason *string `json:"rollback_reason,omitempty"`
+	// Why a push didn't trigger a build, e.g. a skip marker in the commit message
+	SkipReason *string `json:"skip_reason,omitempty"`
+	// Check run reporting this deployment on its commit
+	GithubCheckRunID *int64 `json:"github_check_run_id,omitempty"`
+	// Status last reported to the check run
+	GithubCheckStatus *schema.DeploymentStatus `json:"github_check_status,omitempty"`
+	// Whether the check run has its final result
+	GithubCheckConcluded bool `json:"github_check_concluded,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeploymentQuery when eager-loading is set.
 	Edges        DeploymentEdges `json:"edges"`
@@ -81,9 +109,11 @@ type Deployment struct {
 type DeploymentEdges struct {
 	// Service holds the value of the service edge.
 	Service *Service `json:"service,omitempty"`
+	// SbomPackages holds the value of the sbom_packages edge.
+	SbomPackages []*SBOMPackage `json:"sbom_packages,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ServiceOrErr returns the Service value or an error if the edge
@@ -97,18 +127,29 @@ func (e DeploymentEdges) ServiceOrErr() (*Service, error) {
 	return nil, &NotLoadedError{edge: "service"}
 }
 
+// SbomPackagesOrErr returns the SbomPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeploymentEdges) SbomPackagesOrErr() ([]*SBOMPackage, error) {
+	if e.loadedTypes[1] {
+		return e.SbomPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "sbom_packages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Deployment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case deployment.FieldCommitAuthor, deployment.FieldResourceDefinition:
+		case deployment.FieldCommitAuthor, deployment.FieldResourceDefinition, deployment.FieldEnvKeys, deployment.FieldDockerBuilderBuildArgs, deployment.FieldDockerBuilderBuildContexts, deployment.FieldPlatforms, deployment.FieldPlatformBuilds:
 			values[i] = new([]byte)
-		case deployment.FieldAttempts:
+		case deployment.FieldGithubCheckConcluded:
+			values[i] = new(sql.NullBool)
+		case deployment.FieldAttempts, deployment.FieldGithubCheckRunID:
 			values[i] = new(sql.NullInt64)
-		case deployment.FieldStatus, deployment.FieldSource, deployment.FieldError, deployment.FieldCommitSha, deployment.FieldCommitMessage, deployment.FieldGitBranch, deployment.FieldKubernetesJobName, deployment.FieldKubernetesJobStatus, deployment.FieldImage, deployment.FieldBuilder, deployment.FieldRailpackBuilderInstallCommand, deployment.FieldRailpackBuilderBuildCommand, deployment.FieldRunCommand, deployment.FieldDockerBuilderDockerfilePath, deployment.FieldDockerBuilderBuildContext:
+		case deployment.FieldStatus, deployment.FieldSource, deployment.FieldError, deployment.FieldCommitSha, deployment.FieldCommitMessage, deployment.FieldGitBranch, deployment.FieldSourceArchive, deployment.FieldKubernetesJobName, deployment.FieldKubernetesJobStatus, deployment.FieldImage, deployment.FieldBuilder, deployment.FieldRailpackBuilderInstallCommand, deployment.FieldRailpackBuilderBuildCommand, deployment.FieldRunCommand, deployment.FieldDockerBuilderDockerfilePath, deployment.FieldDockerBuilderBuildContext, deployment.FieldDockerBuilderTarget, deployment.FieldBuildpacksBuilderImage, deployment.FieldRollbackReason, deployment.FieldSkipReason, deployment.FieldGithubCheckStatus:
 			values[i] = new(sql.NullString)
-		case deployment.FieldCreatedAt, deployment.FieldUpdatedAt, deployment.FieldQueuedAt, deployment.FieldStartedAt, deployment.FieldCompletedAt:
+		case deployment.FieldCreatedAt, deployment.FieldUpdatedAt, deployment.FieldScheduledAt, deployment.FieldQueuedAt, deployment.FieldStartedAt, deployment.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
 		case deployment.FieldID, deployment.FieldServiceID:
 			values[i] = new(uuid.UUID)
@@ -198,6 +239,20 @@ func (d *Deployment) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field commit_author: %w", err)
 				}
 			}
+		case deployment.FieldSourceArchive:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_archive", values[i])
+			} else if value.Valid {
+				d.SourceArchive = new(string)
+				*d.SourceArchive = value.String
+			}
+		case deployment.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				d.ScheduledAt = new(time.Time)
+				*d.ScheduledAt = value.Time
+			}
 		case deployment.FieldQueuedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field queued_at", values[i])
@@ -252,6 +307,14 @@ func (d *Deployment) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field resource_definition: %w", err)
 				}
 			}
+		case deployment.FieldEnvKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field env_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.EnvKeys); err != nil {
+					return fmt.Errorf("unmarshal field env_keys: %w", err)
+				}
+			}
 		case deployment.FieldBuilder:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field builder", values[i])
@@ -293,6 +356,86 @@ func (d *Deployment) assignValues(columns []string, values []any) error {
 				d.DockerBuilderBuildContext = new(string)
 				*d.DockerBuilderBuildContext = value.String
 			}
+		case deployment.FieldDockerBuilderTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_target", values[i])
+			} else if value.Valid {
+				d.DockerBuilderTarget = new(string)
+				*d.DockerBuilderTarget = value.String
+			}
+		case deployment.FieldDockerBuilderBuildArgs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_build_args", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.DockerBuilderBuildArgs); err != nil {
+					return fmt.Errorf("unmarshal field docker_builder_build_args: %w", err)
+				}
+			}
+		case deployment.FieldDockerBuilderBuildContexts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_build_contexts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.DockerBuilderBuildContexts); err != nil {
+					return fmt.Errorf("unmarshal field docker_builder_build_contexts: %w", err)
+				}
+			}
+		case deployment.FieldBuildpacksBuilderImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buildpacks_builder_image", values[i])
+			} else if value.Valid {
+				d.BuildpacksBuilderImage = new(string)
+				*d.BuildpacksBuilderImage = value.String
+			}
+		case deployment.FieldPlatforms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field platforms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Platforms); err != nil {
+					return fmt.Errorf("unmarshal field platforms: %w", err)
+				}
+			}
+		case deployment.FieldPlatformBuilds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_builds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.PlatformBuilds); err != nil {
+					return fmt.Errorf("unmarshal field platform_builds: %w", err)
+				}
+			}
+		case deployment.FieldRollbackReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rollback_reason", values[i])
+			} else if value.Valid {
+				d.RollbackReason = new(string)
+				*d.RollbackReason = value.String
+			}
+		case deployment.FieldSkipReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_reason", values[i])
+			} else if value.Valid {
+				d.SkipReason = new(string)
+				*d.SkipReason = value.String
+			}
+		case deployment.FieldGithubCheckRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field github_check_run_id", values[i])
+			} else if value.Valid {
+				d.GithubCheckRunID = new(int64)
+				*d.GithubCheckRunID = value.Int64
+			}
+		case deployment.FieldGithubCheckStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field github_check_status", values[i])
+			} else if value.Valid {
+				d.GithubCheckStatus = new(schema.DeploymentStatus)
+				*d.GithubCheckStatus = schema.DeploymentStatus(value.String)
+			}
+		case deployment.FieldGithubCheckConcluded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field github_check_concluded", values[i])
+			} else if value.Valid {
+				d.GithubCheckConcluded = value.Bool
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -311,6 +454,11 @@ func (d *Deployment) QueryService() *ServiceQuery {
 	return NewDeploymentClient(d.config).QueryService(d)
 }
 
+// QuerySbomPackages queries the "sbom_packages" edge of the Deployment entity.
+func (d *Deployment) QuerySbomPackages() *SBOMPackageQuery {
+	return NewDeploymentClient(d.config).QuerySbomPackages(d)
+}
+
 // Update returns a builder for updating this Deployment.
 // Note that you need to call Deployment.Unwrap() before calling this method if this Deployment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -370,6 +518,16 @@ func (d *Deployment) String() string {
 	builder.WriteString("commit_author=")
 	builder.WriteString(fmt.Sprintf("%v", d.CommitAuthor))
 	builder.WriteString(", ")
+	if v := d.SourceArchive; v != nil {
+		builder.WriteString("source_archive=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.ScheduledAt; v != nil {
+		builder.WriteString("scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := d.QueuedAt; v != nil {
 		builder.WriteString("queued_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -402,6 +560,9 @@ func (d *Deployment) String() string {
 	builder.WriteString("resource_definition=")
 	builder.WriteString(fmt.Sprintf("%v", d.ResourceDefinition))
 	builder.WriteString(", ")
+	builder.WriteString("env_keys=")
+	builder.WriteString(fmt.Sprintf("%v", d.EnvKeys))
+	builder.WriteString(", ")
 	builder.WriteString("builder=")
 	builder.WriteString(fmt.Sprintf("%v", d.Builder))
 	builder.WriteString(", ")
@@ -429,6 +590,51 @@ func (d *Deployment) String() string {
 		builder.WriteString("docker_builder_build_context=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := d.DockerBuilderTarget; v != nil {
+		builder.WriteString("docker_builder_target=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("docker_builder_build_args=")
+	builder.WriteString(fmt.Sprintf("%v", d.DockerBuilderBuildArgs))
+	builder.WriteString(", ")
+	builder.WriteString("docker_builder_build_contexts=")
+	builder.WriteString(fmt.Sprintf("%v", d.DockerBuilderBuildContexts))
+	builder.WriteString(", ")
+	if v := d.BuildpacksBuilderImage; v != nil {
+		builder.WriteString("buildpacks_builder_image=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("platforms=")
+	builder.WriteString(fmt.Sprintf("%v", d.Platforms))
+	builder.WriteString(", ")
+	builder.WriteString("platform_builds=")
+	builder.WriteString(fmt.Sprintf("%v", d.PlatformBuilds))
+	builder.WriteString(", ")
+	if v := d.RollbackReason; v != nil {
+		builder.WriteString("rollback_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.SkipReason; v != nil {
+		builder.WriteString("skip_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := d.GithubCheckRunID; v != nil {
+		builder.WriteString("github_check_run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := d.GithubCheckStatus; v != nil {
+		builder.WriteString("github_check_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("github_check_concluded=")
+	builder.WriteString(fmt.Sprintf("%v", d.GithubCheckConcluded))
 	builder.WriteByte(')')
 	return builder.String()
 }
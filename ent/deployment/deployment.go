@@ -37,6 +37,10 @@ const (
 	FieldGitBranch = "git_branch"
 	// FieldCommitAuthor holds the string denoting the commit_author field in the database.
 	FieldCommitAuthor = "commit_author"
+	// FieldSourceArchive holds the string denoting the source_archive field in the database.
+	FieldSourceArchive = "source_archive"
+	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
+	FieldScheduledAt = "scheduled_at"
 	// FieldQueuedAt holds the string denoting the queued_at field in the database.
 	FieldQueuedAt = "queued_at"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
@@ -53,6 +57,8 @@ const (
 	FieldImage = "image"
 	// FieldResourceDefinition holds the string denoting the resource_definition field in the database.
 	FieldResourceDefinition = "resource_definition"
+	// FieldEnvKeys holds the string denoting the env_keys field in the database.
+	FieldEnvKeys = "env_keys"
 	// FieldBuilder holds the string denoting the builder field in the database.
 	FieldBuilder = "builder"
 	// FieldRailpackBuilderInstallCommand holds the string denoting the railpack_builder_install_command field in the database.
@@ -65,8 +71,32 @@ const (
 	FieldDockerBuilderDockerfilePath = "docker_builder_dockerfile_path"
 	// FieldDockerBuilderBuildContext holds the string denoting the docker_builder_build_context field in the database.
 	FieldDockerBuilderBuildContext = "docker_builder_build_context"
+	// FieldDockerBuilderTarget holds the string denoting the docker_builder_target field in the database.
+	FieldDockerBuilderTarget = "docker_builder_target"
+	// FieldDockerBuilderBuildArgs holds the string denoting the docker_builder_build_args field in the database.
+	FieldDockerBuilderBuildArgs = "docker_builder_build_args"
+	// FieldDockerBuilderBuildContexts holds the string denoting the docker_builder_build_contexts field in the database.
+	FieldDockerBuilderBuildContexts = "docker_builder_build_contexts"
+	// FieldBuildpacksBuilderImage holds the string denoting the buildpacks_builder_image field in the database.
+	FieldBuildpacksBuilderImage = "buildpacks_builder_image"
+	// FieldPlatforms holds the string denoting the platforms field in the database.
+	FieldPlatforms = "platforms"
+	// FieldPlatformBuilds holds the string denoting the platform_builds field in the database.
+	FieldPlatformBuilds = "platform_builds"
+	// FieldRollbackReason holds the string denoting the rollback_reason field in the database.
+	FieldRollbackReason = "rollback_reason"
+	// FieldSkipReason holds the string denoting the skip_reason field in the database.
+	FieldSkipReason = "skip_reason"
+	// FieldGithubCheckRunID holds the string denoting the github_check_run_id field in the database.
+	FieldGithubCheckRunID = "github_check_run_id"
+	// FieldGithubCheckStatus holds the string denoting the github_check_status field in the database.
+	FieldGithubCheckStatus = "github_check_status"
+	// FieldGithubCheckConcluded holds the string denoting the github_check_concluded field in the database.
+	FieldGithubCheckConcluded = "github_check_concluded"
 	// EdgeService holds the string denoting the service edge name in mutations.
 	EdgeService = "service"
+	// EdgeSbomPackages holds the string denoting the sbom_packages edge name in mutations.
+	EdgeSbomPackages = "sbom_packages"
 	// Table holds the table name of the deployment in the database.
 	Table = "deployments"
 	// ServiceTable is the table that holds the service relation/edge.
@@ -76,6 +106,13 @@ const (
 	ServiceInverseTable = "services"
 	// ServiceColumn is the table column denoting the service relation/edge.
 	ServiceColumn = "service_id"
+	// SbomPackagesTable is the table that holds the sbom_packages relation/edge.
+	SbomPackagesTable = "sbom_packages"
+	// SbomPackagesInverseTable is the table name for the SBOMPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "sbompackage" package.
+	SbomPackagesInverseTable = "sbom_packages"
+	// SbomPackagesColumn is the table column denoting the sbom_packages relation/edge.
+	SbomPackagesColumn = "deployment_id"
 )
 
 // Columns holds all SQL columns for deployment fields.
@@ -91,6 +128,8 @@ var Columns = []string{
 	FieldCommitMessage,
 	FieldGitBranch,
 	FieldCommitAuthor,
+	FieldSourceArchive,
+	FieldScheduledAt,
 	FieldQueuedAt,
 	FieldStartedAt,
 	FieldCompletedAt,
@@ -99,12 +138,24 @@ var Columns = []string{
 	FieldAttempts,
 	FieldImage,
 	FieldResourceDefinition,
+	FieldEnvKeys,
 	FieldBuilder,
 	FieldRailpackBuilderInstallCommand,
 	FieldRailpackBuilderBuildCommand,
 	FieldRunCommand,
 	FieldDockerBuilderDockerfilePath,
 	FieldDockerBuilderBuildContext,
+	FieldDockerBuilderTarget,
+	FieldDockerBuilderBuildArgs,
+	FieldDockerBuilderBuildContexts,
+	FieldBuildpacksBuilderImage,
+	FieldPlatforms,
+	FieldPlatformBuilds,
+	FieldRollbackReason,
+	FieldSkipReason,
+	FieldGithubCheckRunID,
+	FieldGithubCheckStatus,
+	FieldGithubCheckConcluded,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -126,6 +177,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultAttempts holds the default value on creation for the "attempts" field.
 	DefaultAttempts int
+	// DefaultGithubCheckConcluded holds the default value on creation for the "github_check_concluded" field.
+	DefaultGithubCheckConcluded bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -133,7 +186,7 @@ var (
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s schema.DeploymentStatus) error {
 	switch s {
-	case "build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "active", "launching", "launch-error", "crashing", "removed":
+	case "awaiting-approval", "scheduled", "build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "skipped", "staged", "promoting", "aborted", "active", "launching", "launch-error", "crashing", "removed":
 		return nil
 	default:
 		return fmt.Errorf("deployment: invalid enum value for status field: %q", s)
@@ -145,7 +198,7 @@ const DefaultSource schema.DeploymentSource = "manual"
 // SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
 func SourceValidator(s schema.DeploymentSource) error {
 	switch s {
-	case "manual", "git":
+	case "manual", "git", "deploy-hook", "image-update", "upload":
 		return nil
 	default:
 		return fmt.Errorf("deployment: invalid enum value for source field: %q", s)
@@ -155,13 +208,23 @@ func SourceValidator(s schema.DeploymentSource) error {
 // BuilderValidator is a validator for the "builder" field enum values. It is called by the builders before save.
 func BuilderValidator(b schema.ServiceBuilder) error {
 	switch b {
-	case "railpack", "docker", "database":
+	case "railpack", "docker", "database", "buildpacks":
 		return nil
 	default:
 		return fmt.Errorf("deployment: invalid enum value for builder field: %q", b)
 	}
 }
 
+// GithubCheckStatusValidator is a validator for the "github_check_status" field enum values. It is called by the builders before save.
+func GithubCheckStatusValidator(gcs schema.DeploymentStatus) error {
+	switch gcs {
+	case "awaiting-approval", "scheduled", "build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "skipped", "staged", "promoting", "aborted", "active", "launching", "launch-error", "crashing", "removed":
+		return nil
+	default:
+		return fmt.Errorf("deployment: invalid enum value for github_check_status field: %q", gcs)
+	}
+}
+
 // OrderOption defines the ordering options for the Deployment queries.
 type OrderOption func(*sql.Selector)
 
@@ -215,6 +278,16 @@ func ByGitBranch(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGitBranch, opts...).ToFunc()
 }
 
+// BySourceArchive orders the results by the source_archive field.
+func BySourceArchive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceArchive, opts...).ToFunc()
+}
+
+// ByScheduledAt orders the results by the scheduled_at field.
+func ByScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledAt, opts...).ToFunc()
+}
+
 // ByQueuedAt orders the results by the queued_at field.
 func ByQueuedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQueuedAt, opts...).ToFunc()
@@ -280,12 +353,61 @@ func ByDockerBuilderBuildContext(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDockerBuilderBuildContext, opts...).ToFunc()
 }
 
+// ByDockerBuilderTarget orders the results by the docker_builder_target field.
+func ByDockerBuilderTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDockerBuilderTarget, opts...).ToFunc()
+}
+
+// ByBuildpacksBuilderImage orders the results by the buildpacks_builder_image field.
+func ByBuildpacksBuilderImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildpacksBuilderImage, opts...).ToFunc()
+}
+
+// ByRollbackReason orders the results by the rollback_reason field.
+func ByRollbackReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRollbackReason, opts...).ToFunc()
+}
+
+// BySkipReason orders the results by the skip_reason field.
+func BySkipReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipReason, opts...).ToFunc()
+}
+
+// ByGithubCheckRunID orders the results by the github_check_run_id field.
+func ByGithubCheckRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGithubCheckRunID, opts...).ToFunc()
+}
+
+// ByGithubCheckStatus orders the results by the github_check_status field.
+func ByGithubCheckStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGithubCheckStatus, opts...).ToFunc()
+}
+
+// ByGithubCheckConcluded orders the results by the github_check_concluded field.
+func ByGithubCheckConcluded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGithubCheckConcluded, opts...).ToFunc()
+}
+
 // ByServiceField orders the results by service field.
 func ByServiceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newServiceStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySbomPackagesCount orders the results by sbom_packages count.
+func BySbomPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSbomPackagesStep(), opts...)
+	}
+}
+
+// BySbomPackages orders the results by sbom_packages terms.
+func BySbomPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSbomPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newServiceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -293,3 +415,10 @@ func newServiceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ServiceTable, ServiceColumn),
 	)
 }
+func newSbomPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SbomPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SbomPackagesTable, SbomPackagesColumn),
+	)
+}
@@ -92,6 +92,16 @@ func GitBranch(v string) predicate.Deployment {
 	return predicate.Deployment(sql.FieldEQ(FieldGitBranch, v))
 }
 
+// SourceArchive applies equality check predicate on the "source_archive" field. It's identical to SourceArchiveEQ.
+func SourceArchive(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldSourceArchive, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldScheduledAt, v))
+}
+
 // QueuedAt applies equality check predicate on the "queued_at" field. It's identical to QueuedAtEQ.
 func QueuedAt(v time.Time) predicate.Deployment {
 	return predicate.Deployment(sql.FieldEQ(FieldQueuedAt, v))
@@ -152,6 +162,36 @@ func DockerBuilderBuildContext(v string) predicate.Deployment {
 	return predicate.Deployment(sql.FieldEQ(FieldDockerBuilderBuildContext, v))
 }
 
+// DockerBuilderTarget applies equality check predicate on the "docker_builder_target" field. It's identical to DockerBuilderTargetEQ.
+func DockerBuilderTarget(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldDockerBuilderTarget, v))
+}
+
+// BuildpacksBuilderImage applies equality check predicate on the "buildpacks_builder_image" field. It's identical to BuildpacksBuilderImageEQ.
+func BuildpacksBuilderImage(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldBuildpacksBuilderImage, v))
+}
+
+// RollbackReason applies equality check predicate on the "rollback_reason" field. It's identical to RollbackReasonEQ.
+func RollbackReason(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRollbackReason, v))
+}
+
+// SkipReason applies equality check predicate on the "skip_reason" field. It's identical to SkipReasonEQ.
+func SkipReason(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldSkipReason, v))
+}
+
+// GithubCheckRunID applies equality check predicate on the "github_check_run_id" field. It's identical to GithubCheckRunIDEQ.
+func GithubCheckRunID(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckConcluded applies equality check predicate on the "github_check_concluded" field. It's identical to GithubCheckConcludedEQ.
+func GithubCheckConcluded(v bool) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldGithubCheckConcluded, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Deployment {
 	return predicate.Deployment(sql.FieldEQ(FieldCreatedAt, v))
@@ -622,6 +662,131 @@ func CommitAuthorNotNil() predicate.Deployment {
 	return predicate.Deployment(sql.FieldNotNull(FieldCommitAuthor))
 }
 
+// SourceArchiveEQ applies the EQ predicate on the "source_archive" field.
+func SourceArchiveEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldSourceArchive, v))
+}
+
+// SourceArchiveNEQ applies the NEQ predicate on the "source_archive" field.
+func SourceArchiveNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldSourceArchive, v))
+}
+
+// SourceArchiveIn applies the In predicate on the "source_archive" field.
+func SourceArchiveIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldSourceArchive, vs...))
+}
+
+// SourceArchiveNotIn applies the NotIn predicate on the "source_archive" field.
+func SourceArchiveNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldSourceArchive, vs...))
+}
+
+// SourceArchiveGT applies the GT predicate on the "source_archive" field.
+func SourceArchiveGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldSourceArchive, v))
+}
+
+// SourceArchiveGTE applies the GTE predicate on the "source_archive" field.
+func SourceArchiveGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldSourceArchive, v))
+}
+
+// SourceArchiveLT applies the LT predicate on the "source_archive" field.
+func SourceArchiveLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldSourceArchive, v))
+}
+
+// SourceArchiveLTE applies the LTE predicate on the "source_archive" field.
+func SourceArchiveLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldSourceArchive, v))
+}
+
+// SourceArchiveContains applies the Contains predicate on the "source_archive" field.
+func SourceArchiveContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldSourceArchive, v))
+}
+
+// SourceArchiveHasPrefix applies the HasPrefix predicate on the "source_archive" field.
+func SourceArchiveHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldSourceArchive, v))
+}
+
+// SourceArchiveHasSuffix applies the HasSuffix predicate on the "source_archive" field.
+func SourceArchiveHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldSourceArchive, v))
+}
+
+// SourceArchiveIsNil applies the IsNil predicate on the "source_archive" field.
+func SourceArchiveIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldSourceArchive))
+}
+
+// SourceArchiveNotNil applies the NotNil predicate on the "source_archive" field.
+func SourceArchiveNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldSourceArchive))
+}
+
+// SourceArchiveEqualFold applies the EqualFold predicate on the "source_archive" field.
+func SourceArchiveEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldSourceArchive, v))
+}
+
+// SourceArchiveContainsFold applies the ContainsFold predicate on the "source_archive" field.
+func SourceArchiveContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldSourceArchive, v))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtIsNil applies the IsNil predicate on the "scheduled_at" field.
+func ScheduledAtIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldScheduledAt))
+}
+
+// ScheduledAtNotNil applies the NotNil predicate on the "scheduled_at" field.
+func ScheduledAtNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldScheduledAt))
+}
+
 // QueuedAtEQ applies the EQ predicate on the "queued_at" field.
 func QueuedAtEQ(v time.Time) predicate.Deployment {
 	return predicate.Deployment(sql.FieldEQ(FieldQueuedAt, v))
@@ -1047,6 +1212,16 @@ func ResourceDefinitionNotNil() predicate.Deployment {
 	return predicate.Deployment(sql.FieldNotNull(FieldResourceDefinition))
 }
 
+// EnvKeysIsNil applies the IsNil predicate on the "env_keys" field.
+func EnvKeysIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldEnvKeys))
+}
+
+// EnvKeysNotNil applies the NotNil predicate on the "env_keys" field.
+func EnvKeysNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldEnvKeys))
+}
+
 // BuilderEQ applies the EQ predicate on the "builder" field.
 func BuilderEQ(v schema.ServiceBuilder) predicate.Deployment {
 	vc := v
@@ -1452,6 +1627,446 @@ func DockerBuilderBuildContextContainsFold(v string) predicate.Deployment {
 	return predicate.Deployment(sql.FieldContainsFold(FieldDockerBuilderBuildContext, v))
 }
 
+// DockerBuilderTargetEQ applies the EQ predicate on the "docker_builder_target" field.
+func DockerBuilderTargetEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetNEQ applies the NEQ predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetIn applies the In predicate on the "docker_builder_target" field.
+func DockerBuilderTargetIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldDockerBuilderTarget, vs...))
+}
+
+// DockerBuilderTargetNotIn applies the NotIn predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldDockerBuilderTarget, vs...))
+}
+
+// DockerBuilderTargetGT applies the GT predicate on the "docker_builder_target" field.
+func DockerBuilderTargetGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetGTE applies the GTE predicate on the "docker_builder_target" field.
+func DockerBuilderTargetGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetLT applies the LT predicate on the "docker_builder_target" field.
+func DockerBuilderTargetLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetLTE applies the LTE predicate on the "docker_builder_target" field.
+func DockerBuilderTargetLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetContains applies the Contains predicate on the "docker_builder_target" field.
+func DockerBuilderTargetContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetHasPrefix applies the HasPrefix predicate on the "docker_builder_target" field.
+func DockerBuilderTargetHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetHasSuffix applies the HasSuffix predicate on the "docker_builder_target" field.
+func DockerBuilderTargetHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetIsNil applies the IsNil predicate on the "docker_builder_target" field.
+func DockerBuilderTargetIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldDockerBuilderTarget))
+}
+
+// DockerBuilderTargetNotNil applies the NotNil predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldDockerBuilderTarget))
+}
+
+// DockerBuilderTargetEqualFold applies the EqualFold predicate on the "docker_builder_target" field.
+func DockerBuilderTargetEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetContainsFold applies the ContainsFold predicate on the "docker_builder_target" field.
+func DockerBuilderTargetContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderBuildArgsIsNil applies the IsNil predicate on the "docker_builder_build_args" field.
+func DockerBuilderBuildArgsIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldDockerBuilderBuildArgs))
+}
+
+// DockerBuilderBuildArgsNotNil applies the NotNil predicate on the "docker_builder_build_args" field.
+func DockerBuilderBuildArgsNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldDockerBuilderBuildArgs))
+}
+
+// DockerBuilderBuildContextsIsNil applies the IsNil predicate on the "docker_builder_build_contexts" field.
+func DockerBuilderBuildContextsIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldDockerBuilderBuildContexts))
+}
+
+// DockerBuilderBuildContextsNotNil applies the NotNil predicate on the "docker_builder_build_contexts" field.
+func DockerBuilderBuildContextsNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldDockerBuilderBuildContexts))
+}
+
+// BuildpacksBuilderImageEQ applies the EQ predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageNEQ applies the NEQ predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageIn applies the In predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldBuildpacksBuilderImage, vs...))
+}
+
+// BuildpacksBuilderImageNotIn applies the NotIn predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldBuildpacksBuilderImage, vs...))
+}
+
+// BuildpacksBuilderImageGT applies the GT predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageGTE applies the GTE predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageLT applies the LT predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageLTE applies the LTE predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageContains applies the Contains predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageHasPrefix applies the HasPrefix predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageHasSuffix applies the HasSuffix predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageIsNil applies the IsNil predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldBuildpacksBuilderImage))
+}
+
+// BuildpacksBuilderImageNotNil applies the NotNil predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldBuildpacksBuilderImage))
+}
+
+// BuildpacksBuilderImageEqualFold applies the EqualFold predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageContainsFold applies the ContainsFold predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldBuildpacksBuilderImage, v))
+}
+
+// PlatformsIsNil applies the IsNil predicate on the "platforms" field.
+func PlatformsIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldPlatforms))
+}
+
+// PlatformsNotNil applies the NotNil predicate on the "platforms" field.
+func PlatformsNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldPlatforms))
+}
+
+// PlatformBuildsIsNil applies the IsNil predicate on the "platform_builds" field.
+func PlatformBuildsIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldPlatformBuilds))
+}
+
+// PlatformBuildsNotNil applies the NotNil predicate on the "platform_builds" field.
+func PlatformBuildsNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldPlatformBuilds))
+}
+
+// RollbackReasonEQ applies the EQ predicate on the "rollback_reason" field.
+func RollbackReasonEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldRollbackReason, v))
+}
+
+// RollbackReasonNEQ applies the NEQ predicate on the "rollback_reason" field.
+func RollbackReasonNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldRollbackReason, v))
+}
+
+// RollbackReasonIn applies the In predicate on the "rollback_reason" field.
+func RollbackReasonIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldRollbackReason, vs...))
+}
+
+// RollbackReasonNotIn applies the NotIn predicate on the "rollback_reason" field.
+func RollbackReasonNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldRollbackReason, vs...))
+}
+
+// RollbackReasonGT applies the GT predicate on the "rollback_reason" field.
+func RollbackReasonGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldRollbackReason, v))
+}
+
+// RollbackReasonGTE applies the GTE predicate on the "rollback_reason" field.
+func RollbackReasonGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldRollbackReason, v))
+}
+
+// RollbackReasonLT applies the LT predicate on the "rollback_reason" field.
+func RollbackReasonLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldRollbackReason, v))
+}
+
+// RollbackReasonLTE applies the LTE predicate on the "rollback_reason" field.
+func RollbackReasonLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldRollbackReason, v))
+}
+
+// RollbackReasonContains applies the Contains predicate on the "rollback_reason" field.
+func RollbackReasonContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldRollbackReason, v))
+}
+
+// RollbackReasonHasPrefix applies the HasPrefix predicate on the "rollback_reason" field.
+func RollbackReasonHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldRollbackReason, v))
+}
+
+// RollbackReasonHasSuffix applies the HasSuffix predicate on the "rollback_reason" field.
+func RollbackReasonHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldRollbackReason, v))
+}
+
+// RollbackReasonIsNil applies the IsNil predicate on the "rollback_reason" field.
+func RollbackReasonIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldRollbackReason))
+}
+
+// RollbackReasonNotNil applies the NotNil predicate on the "rollback_reason" field.
+func RollbackReasonNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldRollbackReason))
+}
+
+// RollbackReasonEqualFold applies the EqualFold predicate on the "rollback_reason" field.
+func RollbackReasonEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldRollbackReason, v))
+}
+
+// RollbackReasonContainsFold applies the ContainsFold predicate on the "rollback_reason" field.
+func RollbackReasonContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldRollbackReason, v))
+}
+
+// SkipReasonEQ applies the EQ predicate on the "skip_reason" field.
+func SkipReasonEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldSkipReason, v))
+}
+
+// SkipReasonNEQ applies the NEQ predicate on the "skip_reason" field.
+func SkipReasonNEQ(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldSkipReason, v))
+}
+
+// SkipReasonIn applies the In predicate on the "skip_reason" field.
+func SkipReasonIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldSkipReason, vs...))
+}
+
+// SkipReasonNotIn applies the NotIn predicate on the "skip_reason" field.
+func SkipReasonNotIn(vs ...string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldSkipReason, vs...))
+}
+
+// SkipReasonGT applies the GT predicate on the "skip_reason" field.
+func SkipReasonGT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldSkipReason, v))
+}
+
+// SkipReasonGTE applies the GTE predicate on the "skip_reason" field.
+func SkipReasonGTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldSkipReason, v))
+}
+
+// SkipReasonLT applies the LT predicate on the "skip_reason" field.
+func SkipReasonLT(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldSkipReason, v))
+}
+
+// SkipReasonLTE applies the LTE predicate on the "skip_reason" field.
+func SkipReasonLTE(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldSkipReason, v))
+}
+
+// SkipReasonContains applies the Contains predicate on the "skip_reason" field.
+func SkipReasonContains(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContains(FieldSkipReason, v))
+}
+
+// SkipReasonHasPrefix applies the HasPrefix predicate on the "skip_reason" field.
+func SkipReasonHasPrefix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasPrefix(FieldSkipReason, v))
+}
+
+// SkipReasonHasSuffix applies the HasSuffix predicate on the "skip_reason" field.
+func SkipReasonHasSuffix(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldHasSuffix(FieldSkipReason, v))
+}
+
+// SkipReasonIsNil applies the IsNil predicate on the "skip_reason" field.
+func SkipReasonIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldSkipReason))
+}
+
+// SkipReasonNotNil applies the NotNil predicate on the "skip_reason" field.
+func SkipReasonNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldSkipReason))
+}
+
+// SkipReasonEqualFold applies the EqualFold predicate on the "skip_reason" field.
+func SkipReasonEqualFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEqualFold(FieldSkipReason, v))
+}
+
+// SkipReasonContainsFold applies the ContainsFold predicate on the "skip_reason" field.
+func SkipReasonContainsFold(v string) predicate.Deployment {
+	return predicate.Deployment(sql.FieldContainsFold(FieldSkipReason, v))
+}
+
+// GithubCheckRunIDEQ applies the EQ predicate on the "github_check_run_id" field.
+func GithubCheckRunIDEQ(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDNEQ applies the NEQ predicate on the "github_check_run_id" field.
+func GithubCheckRunIDNEQ(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDIn applies the In predicate on the "github_check_run_id" field.
+func GithubCheckRunIDIn(vs ...int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldIn(FieldGithubCheckRunID, vs...))
+}
+
+// GithubCheckRunIDNotIn applies the NotIn predicate on the "github_check_run_id" field.
+func GithubCheckRunIDNotIn(vs ...int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotIn(FieldGithubCheckRunID, vs...))
+}
+
+// GithubCheckRunIDGT applies the GT predicate on the "github_check_run_id" field.
+func GithubCheckRunIDGT(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGT(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDGTE applies the GTE predicate on the "github_check_run_id" field.
+func GithubCheckRunIDGTE(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldGTE(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDLT applies the LT predicate on the "github_check_run_id" field.
+func GithubCheckRunIDLT(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLT(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDLTE applies the LTE predicate on the "github_check_run_id" field.
+func GithubCheckRunIDLTE(v int64) predicate.Deployment {
+	return predicate.Deployment(sql.FieldLTE(FieldGithubCheckRunID, v))
+}
+
+// GithubCheckRunIDIsNil applies the IsNil predicate on the "github_check_run_id" field.
+func GithubCheckRunIDIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldGithubCheckRunID))
+}
+
+// GithubCheckRunIDNotNil applies the NotNil predicate on the "github_check_run_id" field.
+func GithubCheckRunIDNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldGithubCheckRunID))
+}
+
+// GithubCheckStatusEQ applies the EQ predicate on the "github_check_status" field.
+func GithubCheckStatusEQ(v schema.DeploymentStatus) predicate.Deployment {
+	vc := v
+	return predicate.Deployment(sql.FieldEQ(FieldGithubCheckStatus, vc))
+}
+
+// GithubCheckStatusNEQ applies the NEQ predicate on the "github_check_status" field.
+func GithubCheckStatusNEQ(v schema.DeploymentStatus) predicate.Deployment {
+	vc := v
+	return predicate.Deployment(sql.FieldNEQ(FieldGithubCheckStatus, vc))
+}
+
+// GithubCheckStatusIn applies the In predicate on the "github_check_status" field.
+func GithubCheckStatusIn(vs ...schema.DeploymentStatus) predicate.Deployment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Deployment(sql.FieldIn(FieldGithubCheckStatus, v...))
+}
+
+// GithubCheckStatusNotIn applies the NotIn predicate on the "github_check_status" field.
+func GithubCheckStatusNotIn(vs ...schema.DeploymentStatus) predicate.Deployment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Deployment(sql.FieldNotIn(FieldGithubCheckStatus, v...))
+}
+
+// GithubCheckStatusIsNil applies the IsNil predicate on the "github_check_status" field.
+func GithubCheckStatusIsNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldIsNull(FieldGithubCheckStatus))
+}
+
+// GithubCheckStatusNotNil applies the NotNil predicate on the "github_check_status" field.
+func GithubCheckStatusNotNil() predicate.Deployment {
+	return predicate.Deployment(sql.FieldNotNull(FieldGithubCheckStatus))
+}
+
+// GithubCheckConcludedEQ applies the EQ predicate on the "github_check_concluded" field.
+func GithubCheckConcludedEQ(v bool) predicate.Deployment {
+	return predicate.Deployment(sql.FieldEQ(FieldGithubCheckConcluded, v))
+}
+
+// GithubCheckConcludedNEQ applies the NEQ predicate on the "github_check_concluded" field.
+func GithubCheckConcludedNEQ(v bool) predicate.Deployment {
+	return predicate.Deployment(sql.FieldNEQ(FieldGithubCheckConcluded, v))
+}
+
 // HasService applies the HasEdge predicate on the "service" edge.
 func HasService() predicate.Deployment {
 	return predicate.Deployment(func(s *sql.Selector) {
@@ -1475,6 +2090,29 @@ func HasServiceWith(preds ...predicate.Service) predicate.Deployment {
 	})
 }
 
+// HasSbomPackages applies the HasEdge predicate on the "sbom_packages" edge.
+func HasSbomPackages() predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SbomPackagesTable, SbomPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSbomPackagesWith applies the HasEdge predicate on the "sbom_packages" edge with a given conditions (other predicates).
+func HasSbomPackagesWith(preds ...predicate.SBOMPackage) predicate.Deployment {
+	return predicate.Deployment(func(s *sql.Selector) {
+		step := newSbomPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Deployment) predicate.Deployment {
 	return predicate.Deployment(sql.AndPredicates(predicates...))
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/deployment"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	v1 "github.com/unbindapp/unbind-operator/api/v1"
@@ -143,6 +144,34 @@ func (dc *DeploymentCreate) SetCommitAuthor(sc *schema.GitCommitter) *Deployment
 	return dc
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (dc *DeploymentCreate) SetSourceArchive(v string) *DeploymentCreate {
+	dc.mutation.SetSourceArchive(v)
+	return dc
+}
+
+// SetNillableSourceArchive sets the "source_archive" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableSourceArchive(v *string) *DeploymentCreate {
+	if v != nil {
+		dc.SetSourceArchive(*v)
+	}
+	return dc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (dc *DeploymentCreate) SetScheduledAt(v time.Time) *DeploymentCreate {
+	dc.mutation.SetScheduledAt(v)
+	return dc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableScheduledAt(v *time.Time) *DeploymentCreate {
+	if v != nil {
+		dc.SetScheduledAt(*v)
+	}
+	return dc
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (dc *DeploymentCreate) SetQueuedAt(t time.Time) *DeploymentCreate {
 	dc.mutation.SetQueuedAt(t)
@@ -247,6 +276,12 @@ func (dc *DeploymentCreate) SetResourceDefinition(v *v1.Service) *DeploymentCrea
 	return dc
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (dc *DeploymentCreate) SetEnvKeys(v []string) *DeploymentCreate {
+	dc.mutation.SetEnvKeys(v)
+	return dc
+}
+
 // SetBuilder sets the "builder" field.
 func (dc *DeploymentCreate) SetBuilder(sb schema.ServiceBuilder) *DeploymentCreate {
 	dc.mutation.SetBuilder(sb)
@@ -323,6 +358,128 @@ func (dc *DeploymentCreate) SetNillableDockerBuilderBuildContext(s *string) *Dep
 	return dc
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (dc *DeploymentCreate) SetDockerBuilderTarget(v string) *DeploymentCreate {
+	dc.mutation.SetDockerBuilderTarget(v)
+	return dc
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableDockerBuilderTarget(v *string) *DeploymentCreate {
+	if v != nil {
+		dc.SetDockerBuilderTarget(*v)
+	}
+	return dc
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (dc *DeploymentCreate) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentCreate {
+	dc.mutation.SetDockerBuilderBuildArgs(v)
+	return dc
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (dc *DeploymentCreate) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentCreate {
+	dc.mutation.SetDockerBuilderBuildContexts(v)
+	return dc
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (dc *DeploymentCreate) SetBuildpacksBuilderImage(v string) *DeploymentCreate {
+	dc.mutation.SetBuildpacksBuilderImage(v)
+	return dc
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableBuildpacksBuilderImage(v *string) *DeploymentCreate {
+	if v != nil {
+		dc.SetBuildpacksBuilderImage(*v)
+	}
+	return dc
+}
+
+// SetPlatforms sets the "platforms" field.
+func (dc *DeploymentCreate) SetPlatforms(v []string) *DeploymentCreate {
+	dc.mutation.SetPlatforms(v)
+	return dc
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (dc *DeploymentCreate) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentCreate {
+	dc.mutation.SetPlatformBuilds(v)
+	return dc
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (dc *DeploymentCreate) SetRollbackReason(v string) *DeploymentCreate {
+	dc.mutation.SetRollbackReason(v)
+	return dc
+}
+
+// SetNillableRollbackReason sets the "rollback_reason" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableRollbackReason(v *string) *DeploymentCreate {
+	if v != nil {
+		dc.SetRollbackReason(*v)
+	}
+	return dc
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (dc *DeploymentCreate) SetSkipReason(v string) *DeploymentCreate {
+	dc.mutation.SetSkipReason(v)
+	return dc
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableSkipReason(v *string) *DeploymentCreate {
+	if v != nil {
+		dc.SetSkipReason(*v)
+	}
+	return dc
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (dc *DeploymentCreate) SetGithubCheckRunID(v int64) *DeploymentCreate {
+	dc.mutation.SetGithubCheckRunID(v)
+	return dc
+}
+
+// SetNillableGithubCheckRunID sets the "github_check_run_id" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableGithubCheckRunID(v *int64) *DeploymentCreate {
+	if v != nil {
+		dc.SetGithubCheckRunID(*v)
+	}
+	return dc
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (dc *DeploymentCreate) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentCreate {
+	dc.mutation.SetGithubCheckStatus(v)
+	return dc
+}
+
+// SetNillableGithubCheckStatus sets the "github_check_status" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableGithubCheckStatus(v *schema.DeploymentStatus) *DeploymentCreate {
+	if v != nil {
+		dc.SetGithubCheckStatus(*v)
+	}
+	return dc
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (dc *DeploymentCreate) SetGithubCheckConcluded(v bool) *DeploymentCreate {
+	dc.mutation.SetGithubCheckConcluded(v)
+	return dc
+}
+
+// SetNillableGithubCheckConcluded sets the "github_check_concluded" field if the given value is not nil.
+func (dc *DeploymentCreate) SetNillableGithubCheckConcluded(v *bool) *DeploymentCreate {
+	if v != nil {
+		dc.SetGithubCheckConcluded(*v)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DeploymentCreate) SetID(u uuid.UUID) *DeploymentCreate {
 	dc.mutation.SetID(u)
@@ -342,6 +499,21 @@ func (dc *DeploymentCreate) SetService(s *Service) *DeploymentCreate {
 	return dc.SetServiceID(s.ID)
 }
 
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SBOMPackage entity by IDs.
+func (dc *DeploymentCreate) AddSbomPackageIDs(ids ...uuid.UUID) *DeploymentCreate {
+	dc.mutation.AddSbomPackageIDs(ids...)
+	return dc
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SBOMPackage entity.
+func (dc *DeploymentCreate) AddSbomPackages(v ...*SBOMPackage) *DeploymentCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return dc.AddSbomPackageIDs(ids...)
+}
+
 // Mutation returns the DeploymentMutation object of the builder.
 func (dc *DeploymentCreate) Mutation() *DeploymentMutation {
 	return dc.mutation
@@ -393,6 +565,10 @@ func (dc *DeploymentCreate) defaults() {
 		v := deployment.DefaultAttempts
 		dc.mutation.SetAttempts(v)
 	}
+	if _, ok := dc.mutation.GithubCheckConcluded(); !ok {
+		v := deployment.DefaultGithubCheckConcluded
+		dc.mutation.SetGithubCheckConcluded(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		v := deployment.DefaultID()
 		dc.mutation.SetID(v)
@@ -437,6 +613,14 @@ func (dc *DeploymentCreate) check() error {
 			return &ValidationError{Name: "builder", err: fmt.Errorf(`ent: validator failed for field "Deployment.builder": %w`, err)}
 		}
 	}
+	if v, ok := dc.mutation.GithubCheckStatus(); ok {
+		if err := deployment.GithubCheckStatusValidator(v); err != nil {
+			return &ValidationError{Name: "github_check_status", err: fmt.Errorf(`ent: validator failed for field "Deployment.github_check_status": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.GithubCheckConcluded(); !ok {
+		return &ValidationError{Name: "github_check_concluded", err: errors.New(`ent: missing required field "Deployment.github_check_concluded"`)}
+	}
 	if len(dc.mutation.ServiceIDs()) == 0 {
 		return &ValidationError{Name: "service", err: errors.New(`ent: missing required edge "Deployment.service"`)}
 	}
@@ -512,6 +696,14 @@ func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
 		_spec.SetField(deployment.FieldCommitAuthor, field.TypeJSON, value)
 		_node.CommitAuthor = value
 	}
+	if value, ok := dc.mutation.SourceArchive(); ok {
+		_spec.SetField(deployment.FieldSourceArchive, field.TypeString, value)
+		_node.SourceArchive = &value
+	}
+	if value, ok := dc.mutation.ScheduledAt(); ok {
+		_spec.SetField(deployment.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = &value
+	}
 	if value, ok := dc.mutation.QueuedAt(); ok {
 		_spec.SetField(deployment.FieldQueuedAt, field.TypeTime, value)
 		_node.QueuedAt = &value
@@ -544,6 +736,10 @@ func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
 		_spec.SetField(deployment.FieldResourceDefinition, field.TypeJSON, value)
 		_node.ResourceDefinition = value
 	}
+	if value, ok := dc.mutation.EnvKeys(); ok {
+		_spec.SetField(deployment.FieldEnvKeys, field.TypeJSON, value)
+		_node.EnvKeys = value
+	}
 	if value, ok := dc.mutation.Builder(); ok {
 		_spec.SetField(deployment.FieldBuilder, field.TypeEnum, value)
 		_node.Builder = value
@@ -568,6 +764,50 @@ func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
 		_spec.SetField(deployment.FieldDockerBuilderBuildContext, field.TypeString, value)
 		_node.DockerBuilderBuildContext = &value
 	}
+	if value, ok := dc.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderTarget, field.TypeString, value)
+		_node.DockerBuilderTarget = &value
+	}
+	if value, ok := dc.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+		_node.DockerBuilderBuildArgs = value
+	}
+	if value, ok := dc.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+		_node.DockerBuilderBuildContexts = value
+	}
+	if value, ok := dc.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(deployment.FieldBuildpacksBuilderImage, field.TypeString, value)
+		_node.BuildpacksBuilderImage = &value
+	}
+	if value, ok := dc.mutation.Platforms(); ok {
+		_spec.SetField(deployment.FieldPlatforms, field.TypeJSON, value)
+		_node.Platforms = value
+	}
+	if value, ok := dc.mutation.PlatformBuilds(); ok {
+		_spec.SetField(deployment.FieldPlatformBuilds, field.TypeJSON, value)
+		_node.PlatformBuilds = value
+	}
+	if value, ok := dc.mutation.RollbackReason(); ok {
+		_spec.SetField(deployment.FieldRollbackReason, field.TypeString, value)
+		_node.RollbackReason = &value
+	}
+	if value, ok := dc.mutation.SkipReason(); ok {
+		_spec.SetField(deployment.FieldSkipReason, field.TypeString, value)
+		_node.SkipReason = &value
+	}
+	if value, ok := dc.mutation.GithubCheckRunID(); ok {
+		_spec.SetField(deployment.FieldGithubCheckRunID, field.TypeInt64, value)
+		_node.GithubCheckRunID = &value
+	}
+	if value, ok := dc.mutation.GithubCheckStatus(); ok {
+		_spec.SetField(deployment.FieldGithubCheckStatus, field.TypeEnum, value)
+		_node.GithubCheckStatus = &value
+	}
+	if value, ok := dc.mutation.GithubCheckConcluded(); ok {
+		_spec.SetField(deployment.FieldGithubCheckConcluded, field.TypeBool, value)
+		_node.GithubCheckConcluded = value
+	}
 	if nodes := dc.mutation.ServiceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -585,6 +825,22 @@ func (dc *DeploymentCreate) createSpec() (*Deployment, *sqlgraph.CreateSpec) {
 		_node.ServiceID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -775,6 +1031,42 @@ func (u *DeploymentUpsert) ClearCommitAuthor() *DeploymentUpsert {
 	return u
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (u *DeploymentUpsert) SetSourceArchive(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldSourceArchive, v)
+	return u
+}
+
+// UpdateSourceArchive sets the "source_archive" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateSourceArchive() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldSourceArchive)
+	return u
+}
+
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (u *DeploymentUpsert) ClearSourceArchive() *DeploymentUpsert {
+	u.SetNull(deployment.FieldSourceArchive)
+	return u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *DeploymentUpsert) SetScheduledAt(v time.Time) *DeploymentUpsert {
+	u.Set(deployment.FieldScheduledAt, v)
+	return u
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateScheduledAt() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldScheduledAt)
+	return u
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *DeploymentUpsert) ClearScheduledAt() *DeploymentUpsert {
+	u.SetNull(deployment.FieldScheduledAt)
+	return u
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (u *DeploymentUpsert) SetQueuedAt(v time.Time) *DeploymentUpsert {
 	u.Set(deployment.FieldQueuedAt, v)
@@ -919,6 +1211,24 @@ func (u *DeploymentUpsert) ClearResourceDefinition() *DeploymentUpsert {
 	return u
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (u *DeploymentUpsert) SetEnvKeys(v []string) *DeploymentUpsert {
+	u.Set(deployment.FieldEnvKeys, v)
+	return u
+}
+
+// UpdateEnvKeys sets the "env_keys" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateEnvKeys() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldEnvKeys)
+	return u
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (u *DeploymentUpsert) ClearEnvKeys() *DeploymentUpsert {
+	u.SetNull(deployment.FieldEnvKeys)
+	return u
+}
+
 // SetBuilder sets the "builder" field.
 func (u *DeploymentUpsert) SetBuilder(v schema.ServiceBuilder) *DeploymentUpsert {
 	u.Set(deployment.FieldBuilder, v)
@@ -1021,6 +1331,204 @@ func (u *DeploymentUpsert) ClearDockerBuilderBuildContext() *DeploymentUpsert {
 	return u
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *DeploymentUpsert) SetDockerBuilderTarget(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldDockerBuilderTarget, v)
+	return u
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateDockerBuilderTarget() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldDockerBuilderTarget)
+	return u
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *DeploymentUpsert) ClearDockerBuilderTarget() *DeploymentUpsert {
+	u.SetNull(deployment.FieldDockerBuilderTarget)
+	return u
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *DeploymentUpsert) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentUpsert {
+	u.Set(deployment.FieldDockerBuilderBuildArgs, v)
+	return u
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateDockerBuilderBuildArgs() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldDockerBuilderBuildArgs)
+	return u
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *DeploymentUpsert) ClearDockerBuilderBuildArgs() *DeploymentUpsert {
+	u.SetNull(deployment.FieldDockerBuilderBuildArgs)
+	return u
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsert) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentUpsert {
+	u.Set(deployment.FieldDockerBuilderBuildContexts, v)
+	return u
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateDockerBuilderBuildContexts() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldDockerBuilderBuildContexts)
+	return u
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsert) ClearDockerBuilderBuildContexts() *DeploymentUpsert {
+	u.SetNull(deployment.FieldDockerBuilderBuildContexts)
+	return u
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *DeploymentUpsert) SetBuildpacksBuilderImage(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldBuildpacksBuilderImage, v)
+	return u
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateBuildpacksBuilderImage() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldBuildpacksBuilderImage)
+	return u
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *DeploymentUpsert) ClearBuildpacksBuilderImage() *DeploymentUpsert {
+	u.SetNull(deployment.FieldBuildpacksBuilderImage)
+	return u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *DeploymentUpsert) SetPlatforms(v []string) *DeploymentUpsert {
+	u.Set(deployment.FieldPlatforms, v)
+	return u
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdatePlatforms() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldPlatforms)
+	return u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *DeploymentUpsert) ClearPlatforms() *DeploymentUpsert {
+	u.SetNull(deployment.FieldPlatforms)
+	return u
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (u *DeploymentUpsert) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpsert {
+	u.Set(deployment.FieldPlatformBuilds, v)
+	return u
+}
+
+// UpdatePlatformBuilds sets the "platform_builds" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdatePlatformBuilds() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldPlatformBuilds)
+	return u
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (u *DeploymentUpsert) ClearPlatformBuilds() *DeploymentUpsert {
+	u.SetNull(deployment.FieldPlatformBuilds)
+	return u
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (u *DeploymentUpsert) SetRollbackReason(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldRollbackReason, v)
+	return u
+}
+
+// UpdateRollbackReason sets the "rollback_reason" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateRollbackReason() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldRollbackReason)
+	return u
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (u *DeploymentUpsert) ClearRollbackReason() *DeploymentUpsert {
+	u.SetNull(deployment.FieldRollbackReason)
+	return u
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (u *DeploymentUpsert) SetSkipReason(v string) *DeploymentUpsert {
+	u.Set(deployment.FieldSkipReason, v)
+	return u
+}
+
+// UpdateSkipReason sets the "skip_reason" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateSkipReason() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldSkipReason)
+	return u
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (u *DeploymentUpsert) ClearSkipReason() *DeploymentUpsert {
+	u.SetNull(deployment.FieldSkipReason)
+	return u
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (u *DeploymentUpsert) SetGithubCheckRunID(v int64) *DeploymentUpsert {
+	u.Set(deployment.FieldGithubCheckRunID, v)
+	return u
+}
+
+// UpdateGithubCheckRunID sets the "github_check_run_id" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateGithubCheckRunID() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldGithubCheckRunID)
+	return u
+}
+
+// AddGithubCheckRunID adds v to the "github_check_run_id" field.
+func (u *DeploymentUpsert) AddGithubCheckRunID(v int64) *DeploymentUpsert {
+	u.Add(deployment.FieldGithubCheckRunID, v)
+	return u
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (u *DeploymentUpsert) ClearGithubCheckRunID() *DeploymentUpsert {
+	u.SetNull(deployment.FieldGithubCheckRunID)
+	return u
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (u *DeploymentUpsert) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentUpsert {
+	u.Set(deployment.FieldGithubCheckStatus, v)
+	return u
+}
+
+// UpdateGithubCheckStatus sets the "github_check_status" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateGithubCheckStatus() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldGithubCheckStatus)
+	return u
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (u *DeploymentUpsert) ClearGithubCheckStatus() *DeploymentUpsert {
+	u.SetNull(deployment.FieldGithubCheckStatus)
+	return u
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (u *DeploymentUpsert) SetGithubCheckConcluded(v bool) *DeploymentUpsert {
+	u.Set(deployment.FieldGithubCheckConcluded, v)
+	return u
+}
+
+// UpdateGithubCheckConcluded sets the "github_check_concluded" field to the value that was provided on create.
+func (u *DeploymentUpsert) UpdateGithubCheckConcluded() *DeploymentUpsert {
+	u.SetExcluded(deployment.FieldGithubCheckConcluded)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1233,45 +1741,87 @@ func (u *DeploymentUpsertOne) ClearCommitAuthor() *DeploymentUpsertOne {
 	})
 }
 
-// SetQueuedAt sets the "queued_at" field.
-func (u *DeploymentUpsertOne) SetQueuedAt(v time.Time) *DeploymentUpsertOne {
+// SetSourceArchive sets the "source_archive" field.
+func (u *DeploymentUpsertOne) SetSourceArchive(v string) *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.SetQueuedAt(v)
+		s.SetSourceArchive(v)
 	})
 }
 
-// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
-func (u *DeploymentUpsertOne) UpdateQueuedAt() *DeploymentUpsertOne {
+// UpdateSourceArchive sets the "source_archive" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateSourceArchive() *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.UpdateQueuedAt()
+		s.UpdateSourceArchive()
 	})
 }
 
-// ClearQueuedAt clears the value of the "queued_at" field.
-func (u *DeploymentUpsertOne) ClearQueuedAt() *DeploymentUpsertOne {
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (u *DeploymentUpsertOne) ClearSourceArchive() *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.ClearQueuedAt()
+		s.ClearSourceArchive()
 	})
 }
 
-// SetStartedAt sets the "started_at" field.
-func (u *DeploymentUpsertOne) SetStartedAt(v time.Time) *DeploymentUpsertOne {
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *DeploymentUpsertOne) SetScheduledAt(v time.Time) *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.SetStartedAt(v)
+		s.SetScheduledAt(v)
 	})
 }
 
-// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
-func (u *DeploymentUpsertOne) UpdateStartedAt() *DeploymentUpsertOne {
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateScheduledAt() *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.UpdateStartedAt()
+		s.UpdateScheduledAt()
 	})
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (u *DeploymentUpsertOne) ClearStartedAt() *DeploymentUpsertOne {
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *DeploymentUpsertOne) ClearScheduledAt() *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
-		s.ClearStartedAt()
+		s.ClearScheduledAt()
+	})
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *DeploymentUpsertOne) SetQueuedAt(v time.Time) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateQueuedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *DeploymentUpsertOne) ClearQueuedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearQueuedAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DeploymentUpsertOne) SetStartedAt(v time.Time) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateStartedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DeploymentUpsertOne) ClearStartedAt() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearStartedAt()
 	})
 }
 
@@ -1401,6 +1951,27 @@ func (u *DeploymentUpsertOne) ClearResourceDefinition() *DeploymentUpsertOne {
 	})
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (u *DeploymentUpsertOne) SetEnvKeys(v []string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetEnvKeys(v)
+	})
+}
+
+// UpdateEnvKeys sets the "env_keys" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateEnvKeys() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateEnvKeys()
+	})
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (u *DeploymentUpsertOne) ClearEnvKeys() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearEnvKeys()
+	})
+}
+
 // SetBuilder sets the "builder" field.
 func (u *DeploymentUpsertOne) SetBuilder(v schema.ServiceBuilder) *DeploymentUpsertOne {
 	return u.Update(func(s *DeploymentUpsert) {
@@ -1520,6 +2091,237 @@ func (u *DeploymentUpsertOne) ClearDockerBuilderBuildContext() *DeploymentUpsert
 	})
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *DeploymentUpsertOne) SetDockerBuilderTarget(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderTarget(v)
+	})
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateDockerBuilderTarget() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderTarget()
+	})
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *DeploymentUpsertOne) ClearDockerBuilderTarget() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderTarget()
+	})
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *DeploymentUpsertOne) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderBuildArgs(v)
+	})
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateDockerBuilderBuildArgs() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderBuildArgs()
+	})
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *DeploymentUpsertOne) ClearDockerBuilderBuildArgs() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderBuildArgs()
+	})
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsertOne) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderBuildContexts(v)
+	})
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateDockerBuilderBuildContexts() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderBuildContexts()
+	})
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsertOne) ClearDockerBuilderBuildContexts() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderBuildContexts()
+	})
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *DeploymentUpsertOne) SetBuildpacksBuilderImage(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetBuildpacksBuilderImage(v)
+	})
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateBuildpacksBuilderImage() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateBuildpacksBuilderImage()
+	})
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *DeploymentUpsertOne) ClearBuildpacksBuilderImage() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearBuildpacksBuilderImage()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *DeploymentUpsertOne) SetPlatforms(v []string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdatePlatforms() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *DeploymentUpsertOne) ClearPlatforms() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (u *DeploymentUpsertOne) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetPlatformBuilds(v)
+	})
+}
+
+// UpdatePlatformBuilds sets the "platform_builds" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdatePlatformBuilds() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdatePlatformBuilds()
+	})
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (u *DeploymentUpsertOne) ClearPlatformBuilds() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearPlatformBuilds()
+	})
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (u *DeploymentUpsertOne) SetRollbackReason(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetRollbackReason(v)
+	})
+}
+
+// UpdateRollbackReason sets the "rollback_reason" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateRollbackReason() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateRollbackReason()
+	})
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (u *DeploymentUpsertOne) ClearRollbackReason() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearRollbackReason()
+	})
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (u *DeploymentUpsertOne) SetSkipReason(v string) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetSkipReason(v)
+	})
+}
+
+// UpdateSkipReason sets the "skip_reason" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateSkipReason() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateSkipReason()
+	})
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (u *DeploymentUpsertOne) ClearSkipReason() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearSkipReason()
+	})
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (u *DeploymentUpsertOne) SetGithubCheckRunID(v int64) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckRunID(v)
+	})
+}
+
+// AddGithubCheckRunID adds v to the "github_check_run_id" field.
+func (u *DeploymentUpsertOne) AddGithubCheckRunID(v int64) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.AddGithubCheckRunID(v)
+	})
+}
+
+// UpdateGithubCheckRunID sets the "github_check_run_id" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateGithubCheckRunID() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckRunID()
+	})
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (u *DeploymentUpsertOne) ClearGithubCheckRunID() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearGithubCheckRunID()
+	})
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (u *DeploymentUpsertOne) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckStatus(v)
+	})
+}
+
+// UpdateGithubCheckStatus sets the "github_check_status" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateGithubCheckStatus() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckStatus()
+	})
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (u *DeploymentUpsertOne) ClearGithubCheckStatus() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearGithubCheckStatus()
+	})
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (u *DeploymentUpsertOne) SetGithubCheckConcluded(v bool) *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckConcluded(v)
+	})
+}
+
+// UpdateGithubCheckConcluded sets the "github_check_concluded" field to the value that was provided on create.
+func (u *DeploymentUpsertOne) UpdateGithubCheckConcluded() *DeploymentUpsertOne {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckConcluded()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1899,6 +2701,48 @@ func (u *DeploymentUpsertBulk) ClearCommitAuthor() *DeploymentUpsertBulk {
 	})
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (u *DeploymentUpsertBulk) SetSourceArchive(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetSourceArchive(v)
+	})
+}
+
+// UpdateSourceArchive sets the "source_archive" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateSourceArchive() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateSourceArchive()
+	})
+}
+
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (u *DeploymentUpsertBulk) ClearSourceArchive() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearSourceArchive()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *DeploymentUpsertBulk) SetScheduledAt(v time.Time) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateScheduledAt() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (u *DeploymentUpsertBulk) ClearScheduledAt() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearScheduledAt()
+	})
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (u *DeploymentUpsertBulk) SetQueuedAt(v time.Time) *DeploymentUpsertBulk {
 	return u.Update(func(s *DeploymentUpsert) {
@@ -2067,6 +2911,27 @@ func (u *DeploymentUpsertBulk) ClearResourceDefinition() *DeploymentUpsertBulk {
 	})
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (u *DeploymentUpsertBulk) SetEnvKeys(v []string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetEnvKeys(v)
+	})
+}
+
+// UpdateEnvKeys sets the "env_keys" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateEnvKeys() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateEnvKeys()
+	})
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (u *DeploymentUpsertBulk) ClearEnvKeys() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearEnvKeys()
+	})
+}
+
 // SetBuilder sets the "builder" field.
 func (u *DeploymentUpsertBulk) SetBuilder(v schema.ServiceBuilder) *DeploymentUpsertBulk {
 	return u.Update(func(s *DeploymentUpsert) {
@@ -2186,6 +3051,237 @@ func (u *DeploymentUpsertBulk) ClearDockerBuilderBuildContext() *DeploymentUpser
 	})
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *DeploymentUpsertBulk) SetDockerBuilderTarget(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderTarget(v)
+	})
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateDockerBuilderTarget() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderTarget()
+	})
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *DeploymentUpsertBulk) ClearDockerBuilderTarget() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderTarget()
+	})
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *DeploymentUpsertBulk) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderBuildArgs(v)
+	})
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateDockerBuilderBuildArgs() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderBuildArgs()
+	})
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *DeploymentUpsertBulk) ClearDockerBuilderBuildArgs() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderBuildArgs()
+	})
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsertBulk) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetDockerBuilderBuildContexts(v)
+	})
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateDockerBuilderBuildContexts() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateDockerBuilderBuildContexts()
+	})
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *DeploymentUpsertBulk) ClearDockerBuilderBuildContexts() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearDockerBuilderBuildContexts()
+	})
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *DeploymentUpsertBulk) SetBuildpacksBuilderImage(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetBuildpacksBuilderImage(v)
+	})
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateBuildpacksBuilderImage() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateBuildpacksBuilderImage()
+	})
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *DeploymentUpsertBulk) ClearBuildpacksBuilderImage() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearBuildpacksBuilderImage()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *DeploymentUpsertBulk) SetPlatforms(v []string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdatePlatforms() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *DeploymentUpsertBulk) ClearPlatforms() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (u *DeploymentUpsertBulk) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetPlatformBuilds(v)
+	})
+}
+
+// UpdatePlatformBuilds sets the "platform_builds" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdatePlatformBuilds() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdatePlatformBuilds()
+	})
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (u *DeploymentUpsertBulk) ClearPlatformBuilds() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearPlatformBuilds()
+	})
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (u *DeploymentUpsertBulk) SetRollbackReason(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetRollbackReason(v)
+	})
+}
+
+// UpdateRollbackReason sets the "rollback_reason" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateRollbackReason() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateRollbackReason()
+	})
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (u *DeploymentUpsertBulk) ClearRollbackReason() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearRollbackReason()
+	})
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (u *DeploymentUpsertBulk) SetSkipReason(v string) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetSkipReason(v)
+	})
+}
+
+// UpdateSkipReason sets the "skip_reason" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateSkipReason() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateSkipReason()
+	})
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (u *DeploymentUpsertBulk) ClearSkipReason() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearSkipReason()
+	})
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (u *DeploymentUpsertBulk) SetGithubCheckRunID(v int64) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckRunID(v)
+	})
+}
+
+// AddGithubCheckRunID adds v to the "github_check_run_id" field.
+func (u *DeploymentUpsertBulk) AddGithubCheckRunID(v int64) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.AddGithubCheckRunID(v)
+	})
+}
+
+// UpdateGithubCheckRunID sets the "github_check_run_id" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateGithubCheckRunID() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckRunID()
+	})
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (u *DeploymentUpsertBulk) ClearGithubCheckRunID() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearGithubCheckRunID()
+	})
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (u *DeploymentUpsertBulk) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckStatus(v)
+	})
+}
+
+// UpdateGithubCheckStatus sets the "github_check_status" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateGithubCheckStatus() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckStatus()
+	})
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (u *DeploymentUpsertBulk) ClearGithubCheckStatus() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.ClearGithubCheckStatus()
+	})
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (u *DeploymentUpsertBulk) SetGithubCheckConcluded(v bool) *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.SetGithubCheckConcluded(v)
+	})
+}
+
+// UpdateGithubCheckConcluded sets the "github_check_concluded" field to the value that was provided on create.
+func (u *DeploymentUpsertBulk) UpdateGithubCheckConcluded() *DeploymentUpsertBulk {
+	return u.Update(func(s *DeploymentUpsert) {
+		s.UpdateGithubCheckConcluded()
+	})
+}
+
 // Exec executes the query.
 func (u *DeploymentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
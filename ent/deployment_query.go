@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,18 +15,20 @@ import (
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/predicate"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/service"
 )
 
 // DeploymentQuery is the builder for querying Deployment entities.
 type DeploymentQuery struct {
 	config
-	ctx         *QueryContext
-	order       []deployment.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Deployment
-	withService *ServiceQuery
-	modifiers   []func(*sql.Selector)
+	ctx              *QueryContext
+	order            []deployment.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Deployment
+	withService      *ServiceQuery
+	withSbomPackages *SBOMPackageQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +87,28 @@ func (dq *DeploymentQuery) QueryService() *ServiceQuery {
 	return query
 }
 
+// QuerySbomPackages chains the current query on the "sbom_packages" edge.
+func (dq *DeploymentQuery) QuerySbomPackages() *SBOMPackageQuery {
+	query := (&SBOMPackageClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deployment.Table, deployment.FieldID, selector),
+			sqlgraph.To(sbompackage.Table, sbompackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deployment.SbomPackagesTable, deployment.SbomPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Deployment entity from the query.
 // Returns a *NotFoundError when no Deployment was found.
 func (dq *DeploymentQuery) First(ctx context.Context) (*Deployment, error) {
@@ -271,12 +296,13 @@ func (dq *DeploymentQuery) Clone() *DeploymentQuery {
 		return nil
 	}
 	return &DeploymentQuery{
-		config:      dq.config,
-		ctx:         dq.ctx.Clone(),
-		order:       append([]deployment.OrderOption{}, dq.order...),
-		inters:      append([]Interceptor{}, dq.inters...),
-		predicates:  append([]predicate.Deployment{}, dq.predicates...),
-		withService: dq.withService.Clone(),
+		config:           dq.config,
+		ctx:              dq.ctx.Clone(),
+		order:            append([]deployment.OrderOption{}, dq.order...),
+		inters:           append([]Interceptor{}, dq.inters...),
+		predicates:       append([]predicate.Deployment{}, dq.predicates...),
+		withService:      dq.withService.Clone(),
+		withSbomPackages: dq.withSbomPackages.Clone(),
 		// clone intermediate query.
 		sql:       dq.sql.Clone(),
 		path:      dq.path,
@@ -295,6 +321,17 @@ func (dq *DeploymentQuery) WithService(opts ...func(*ServiceQuery)) *DeploymentQ
 	return dq
 }
 
+// WithSbomPackages tells the query-builder to eager-load the nodes that are connected to
+// the "sbom_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeploymentQuery) WithSbomPackages(opts ...func(*SBOMPackageQuery)) *DeploymentQuery {
+	query := (&SBOMPackageClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSbomPackages = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +410,9 @@ func (dq *DeploymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*D
 	var (
 		nodes       = []*Deployment{}
 		_spec       = dq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			dq.withService != nil,
+			dq.withSbomPackages != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -404,6 +442,13 @@ func (dq *DeploymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*D
 			return nil, err
 		}
 	}
+	if query := dq.withSbomPackages; query != nil {
+		if err := dq.loadSbomPackages(ctx, query, nodes,
+			func(n *Deployment) { n.Edges.SbomPackages = []*SBOMPackage{} },
+			func(n *Deployment, e *SBOMPackage) { n.Edges.SbomPackages = append(n.Edges.SbomPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +481,36 @@ func (dq *DeploymentQuery) loadService(ctx context.Context, query *ServiceQuery,
 	}
 	return nil
 }
+func (dq *DeploymentQuery) loadSbomPackages(ctx context.Context, query *SBOMPackageQuery, nodes []*Deployment, init func(*Deployment), assign func(*Deployment, *SBOMPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Deployment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sbompackage.FieldDeploymentID)
+	}
+	query.Where(predicate.SBOMPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(deployment.SbomPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeploymentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "deployment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (dq *DeploymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
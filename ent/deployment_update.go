@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/predicate"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	v1 "github.com/unbindapp/unbind-operator/api/v1"
@@ -173,6 +175,46 @@ func (du *DeploymentUpdate) ClearCommitAuthor() *DeploymentUpdate {
 	return du
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (du *DeploymentUpdate) SetSourceArchive(v string) *DeploymentUpdate {
+	du.mutation.SetSourceArchive(v)
+	return du
+}
+
+// SetNillableSourceArchive sets the "source_archive" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableSourceArchive(v *string) *DeploymentUpdate {
+	if v != nil {
+		du.SetSourceArchive(*v)
+	}
+	return du
+}
+
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (du *DeploymentUpdate) ClearSourceArchive() *DeploymentUpdate {
+	du.mutation.ClearSourceArchive()
+	return du
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (du *DeploymentUpdate) SetScheduledAt(v time.Time) *DeploymentUpdate {
+	du.mutation.SetScheduledAt(v)
+	return du
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableScheduledAt(v *time.Time) *DeploymentUpdate {
+	if v != nil {
+		du.SetScheduledAt(*v)
+	}
+	return du
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (du *DeploymentUpdate) ClearScheduledAt() *DeploymentUpdate {
+	du.mutation.ClearScheduledAt()
+	return du
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (du *DeploymentUpdate) SetQueuedAt(t time.Time) *DeploymentUpdate {
 	du.mutation.SetQueuedAt(t)
@@ -326,6 +368,24 @@ func (du *DeploymentUpdate) ClearResourceDefinition() *DeploymentUpdate {
 	return du
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (du *DeploymentUpdate) SetEnvKeys(v []string) *DeploymentUpdate {
+	du.mutation.SetEnvKeys(v)
+	return du
+}
+
+// AppendEnvKeys appends value to the "env_keys" field.
+func (du *DeploymentUpdate) AppendEnvKeys(v []string) *DeploymentUpdate {
+	du.mutation.AppendEnvKeys(v)
+	return du
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (du *DeploymentUpdate) ClearEnvKeys() *DeploymentUpdate {
+	du.mutation.ClearEnvKeys()
+	return du
+}
+
 // SetBuilder sets the "builder" field.
 func (du *DeploymentUpdate) SetBuilder(sb schema.ServiceBuilder) *DeploymentUpdate {
 	du.mutation.SetBuilder(sb)
@@ -440,11 +500,227 @@ func (du *DeploymentUpdate) ClearDockerBuilderBuildContext() *DeploymentUpdate {
 	return du
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (du *DeploymentUpdate) SetDockerBuilderTarget(v string) *DeploymentUpdate {
+	du.mutation.SetDockerBuilderTarget(v)
+	return du
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableDockerBuilderTarget(v *string) *DeploymentUpdate {
+	if v != nil {
+		du.SetDockerBuilderTarget(*v)
+	}
+	return du
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (du *DeploymentUpdate) ClearDockerBuilderTarget() *DeploymentUpdate {
+	du.mutation.ClearDockerBuilderTarget()
+	return du
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (du *DeploymentUpdate) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentUpdate {
+	du.mutation.SetDockerBuilderBuildArgs(v)
+	return du
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (du *DeploymentUpdate) ClearDockerBuilderBuildArgs() *DeploymentUpdate {
+	du.mutation.ClearDockerBuilderBuildArgs()
+	return du
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (du *DeploymentUpdate) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentUpdate {
+	du.mutation.SetDockerBuilderBuildContexts(v)
+	return du
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (du *DeploymentUpdate) ClearDockerBuilderBuildContexts() *DeploymentUpdate {
+	du.mutation.ClearDockerBuilderBuildContexts()
+	return du
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (du *DeploymentUpdate) SetBuildpacksBuilderImage(v string) *DeploymentUpdate {
+	du.mutation.SetBuildpacksBuilderImage(v)
+	return du
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableBuildpacksBuilderImage(v *string) *DeploymentUpdate {
+	if v != nil {
+		du.SetBuildpacksBuilderImage(*v)
+	}
+	return du
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (du *DeploymentUpdate) ClearBuildpacksBuilderImage() *DeploymentUpdate {
+	du.mutation.ClearBuildpacksBuilderImage()
+	return du
+}
+
+// SetPlatforms sets the "platforms" field.
+func (du *DeploymentUpdate) SetPlatforms(v []string) *DeploymentUpdate {
+	du.mutation.SetPlatforms(v)
+	return du
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (du *DeploymentUpdate) AppendPlatforms(v []string) *DeploymentUpdate {
+	du.mutation.AppendPlatforms(v)
+	return du
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (du *DeploymentUpdate) ClearPlatforms() *DeploymentUpdate {
+	du.mutation.ClearPlatforms()
+	return du
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (du *DeploymentUpdate) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpdate {
+	du.mutation.SetPlatformBuilds(v)
+	return du
+}
+
+// AppendPlatformBuilds appends value to the "platform_builds" field.
+func (du *DeploymentUpdate) AppendPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpdate {
+	du.mutation.AppendPlatformBuilds(v)
+	return du
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (du *DeploymentUpdate) ClearPlatformBuilds() *DeploymentUpdate {
+	du.mutation.ClearPlatformBuilds()
+	return du
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (du *DeploymentUpdate) SetRollbackReason(v string) *DeploymentUpdate {
+	du.mutation.SetRollbackReason(v)
+	return du
+}
+
+// SetNillableRollbackReason sets the "rollback_reason" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableRollbackReason(v *string) *DeploymentUpdate {
+	if v != nil {
+		du.SetRollbackReason(*v)
+	}
+	return du
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (du *DeploymentUpdate) ClearRollbackReason() *DeploymentUpdate {
+	du.mutation.ClearRollbackReason()
+	return du
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (du *DeploymentUpdate) SetSkipReason(v string) *DeploymentUpdate {
+	du.mutation.SetSkipReason(v)
+	return du
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableSkipReason(v *string) *DeploymentUpdate {
+	if v != nil {
+		du.SetSkipReason(*v)
+	}
+	return du
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (du *DeploymentUpdate) ClearSkipReason() *DeploymentUpdate {
+	du.mutation.ClearSkipReason()
+	return du
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (du *DeploymentUpdate) SetGithubCheckRunID(v int64) *DeploymentUpdate {
+	du.mutation.ResetGithubCheckRunID()
+	du.mutation.SetGithubCheckRunID(v)
+	return du
+}
+
+// SetNillableGithubCheckRunID sets the "github_check_run_id" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableGithubCheckRunID(v *int64) *DeploymentUpdate {
+	if v != nil {
+		du.SetGithubCheckRunID(*v)
+	}
+	return du
+}
+
+// AddGithubCheckRunID adds value to the "github_check_run_id" field.
+func (du *DeploymentUpdate) AddGithubCheckRunID(v int64) *DeploymentUpdate {
+	du.mutation.AddGithubCheckRunID(v)
+	return du
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (du *DeploymentUpdate) ClearGithubCheckRunID() *DeploymentUpdate {
+	du.mutation.ClearGithubCheckRunID()
+	return du
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (du *DeploymentUpdate) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentUpdate {
+	du.mutation.SetGithubCheckStatus(v)
+	return du
+}
+
+// SetNillableGithubCheckStatus sets the "github_check_status" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableGithubCheckStatus(v *schema.DeploymentStatus) *DeploymentUpdate {
+	if v != nil {
+		du.SetGithubCheckStatus(*v)
+	}
+	return du
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (du *DeploymentUpdate) ClearGithubCheckStatus() *DeploymentUpdate {
+	du.mutation.ClearGithubCheckStatus()
+	return du
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (du *DeploymentUpdate) SetGithubCheckConcluded(v bool) *DeploymentUpdate {
+	du.mutation.SetGithubCheckConcluded(v)
+	return du
+}
+
+// SetNillableGithubCheckConcluded sets the "github_check_concluded" field if the given value is not nil.
+func (du *DeploymentUpdate) SetNillableGithubCheckConcluded(v *bool) *DeploymentUpdate {
+	if v != nil {
+		du.SetGithubCheckConcluded(*v)
+	}
+	return du
+}
+
 // SetService sets the "service" edge to the Service entity.
 func (du *DeploymentUpdate) SetService(s *Service) *DeploymentUpdate {
 	return du.SetServiceID(s.ID)
 }
 
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SBOMPackage entity by IDs.
+func (du *DeploymentUpdate) AddSbomPackageIDs(ids ...uuid.UUID) *DeploymentUpdate {
+	du.mutation.AddSbomPackageIDs(ids...)
+	return du
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SBOMPackage entity.
+func (du *DeploymentUpdate) AddSbomPackages(v ...*SBOMPackage) *DeploymentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return du.AddSbomPackageIDs(ids...)
+}
+
 // Mutation returns the DeploymentMutation object of the builder.
 func (du *DeploymentUpdate) Mutation() *DeploymentMutation {
 	return du.mutation
@@ -456,6 +732,27 @@ func (du *DeploymentUpdate) ClearService() *DeploymentUpdate {
 	return du
 }
 
+// ClearSbomPackages clears all "sbom_packages" edges to the SBOMPackage entity.
+func (du *DeploymentUpdate) ClearSbomPackages() *DeploymentUpdate {
+	du.mutation.ClearSbomPackages()
+	return du
+}
+
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to SBOMPackage entities by IDs.
+func (du *DeploymentUpdate) RemoveSbomPackageIDs(ids ...uuid.UUID) *DeploymentUpdate {
+	du.mutation.RemoveSbomPackageIDs(ids...)
+	return du
+}
+
+// RemoveSbomPackages removes "sbom_packages" edges to SBOMPackage entities.
+func (du *DeploymentUpdate) RemoveSbomPackages(v ...*SBOMPackage) *DeploymentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return du.RemoveSbomPackageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DeploymentUpdate) Save(ctx context.Context) (int, error) {
 	du.defaults()
@@ -509,6 +806,11 @@ func (du *DeploymentUpdate) check() error {
 			return &ValidationError{Name: "builder", err: fmt.Errorf(`ent: validator failed for field "Deployment.builder": %w`, err)}
 		}
 	}
+	if v, ok := du.mutation.GithubCheckStatus(); ok {
+		if err := deployment.GithubCheckStatusValidator(v); err != nil {
+			return &ValidationError{Name: "github_check_status", err: fmt.Errorf(`ent: validator failed for field "Deployment.github_check_status": %w`, err)}
+		}
+	}
 	if du.mutation.ServiceCleared() && len(du.mutation.ServiceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Deployment.service"`)
 	}
@@ -572,6 +874,18 @@ func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.CommitAuthorCleared() {
 		_spec.ClearField(deployment.FieldCommitAuthor, field.TypeJSON)
 	}
+	if value, ok := du.mutation.SourceArchive(); ok {
+		_spec.SetField(deployment.FieldSourceArchive, field.TypeString, value)
+	}
+	if du.mutation.SourceArchiveCleared() {
+		_spec.ClearField(deployment.FieldSourceArchive, field.TypeString)
+	}
+	if value, ok := du.mutation.ScheduledAt(); ok {
+		_spec.SetField(deployment.FieldScheduledAt, field.TypeTime, value)
+	}
+	if du.mutation.ScheduledAtCleared() {
+		_spec.ClearField(deployment.FieldScheduledAt, field.TypeTime)
+	}
 	if value, ok := du.mutation.QueuedAt(); ok {
 		_spec.SetField(deployment.FieldQueuedAt, field.TypeTime, value)
 	}
@@ -620,6 +934,17 @@ func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.ResourceDefinitionCleared() {
 		_spec.ClearField(deployment.FieldResourceDefinition, field.TypeJSON)
 	}
+	if value, ok := du.mutation.EnvKeys(); ok {
+		_spec.SetField(deployment.FieldEnvKeys, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedEnvKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldEnvKeys, value)
+		})
+	}
+	if du.mutation.EnvKeysCleared() {
+		_spec.ClearField(deployment.FieldEnvKeys, field.TypeJSON)
+	}
 	if value, ok := du.mutation.Builder(); ok {
 		_spec.SetField(deployment.FieldBuilder, field.TypeEnum, value)
 	}
@@ -653,6 +978,82 @@ func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.DockerBuilderBuildContextCleared() {
 		_spec.ClearField(deployment.FieldDockerBuilderBuildContext, field.TypeString)
 	}
+	if value, ok := du.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderTarget, field.TypeString, value)
+	}
+	if du.mutation.DockerBuilderTargetCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderTarget, field.TypeString)
+	}
+	if value, ok := du.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+	}
+	if du.mutation.DockerBuilderBuildArgsCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderBuildArgs, field.TypeJSON)
+	}
+	if value, ok := du.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+	}
+	if du.mutation.DockerBuilderBuildContextsCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderBuildContexts, field.TypeJSON)
+	}
+	if value, ok := du.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(deployment.FieldBuildpacksBuilderImage, field.TypeString, value)
+	}
+	if du.mutation.BuildpacksBuilderImageCleared() {
+		_spec.ClearField(deployment.FieldBuildpacksBuilderImage, field.TypeString)
+	}
+	if value, ok := du.mutation.Platforms(); ok {
+		_spec.SetField(deployment.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldPlatforms, value)
+		})
+	}
+	if du.mutation.PlatformsCleared() {
+		_spec.ClearField(deployment.FieldPlatforms, field.TypeJSON)
+	}
+	if value, ok := du.mutation.PlatformBuilds(); ok {
+		_spec.SetField(deployment.FieldPlatformBuilds, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedPlatformBuilds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldPlatformBuilds, value)
+		})
+	}
+	if du.mutation.PlatformBuildsCleared() {
+		_spec.ClearField(deployment.FieldPlatformBuilds, field.TypeJSON)
+	}
+	if value, ok := du.mutation.RollbackReason(); ok {
+		_spec.SetField(deployment.FieldRollbackReason, field.TypeString, value)
+	}
+	if du.mutation.RollbackReasonCleared() {
+		_spec.ClearField(deployment.FieldRollbackReason, field.TypeString)
+	}
+	if value, ok := du.mutation.SkipReason(); ok {
+		_spec.SetField(deployment.FieldSkipReason, field.TypeString, value)
+	}
+	if du.mutation.SkipReasonCleared() {
+		_spec.ClearField(deployment.FieldSkipReason, field.TypeString)
+	}
+	if value, ok := du.mutation.GithubCheckRunID(); ok {
+		_spec.SetField(deployment.FieldGithubCheckRunID, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedGithubCheckRunID(); ok {
+		_spec.AddField(deployment.FieldGithubCheckRunID, field.TypeInt64, value)
+	}
+	if du.mutation.GithubCheckRunIDCleared() {
+		_spec.ClearField(deployment.FieldGithubCheckRunID, field.TypeInt64)
+	}
+	if value, ok := du.mutation.GithubCheckStatus(); ok {
+		_spec.SetField(deployment.FieldGithubCheckStatus, field.TypeEnum, value)
+	}
+	if du.mutation.GithubCheckStatusCleared() {
+		_spec.ClearField(deployment.FieldGithubCheckStatus, field.TypeEnum)
+	}
+	if value, ok := du.mutation.GithubCheckConcluded(); ok {
+		_spec.SetField(deployment.FieldGithubCheckConcluded, field.TypeBool, value)
+	}
 	if du.mutation.ServiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -682,6 +1083,51 @@ func (du *DeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if du.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedSbomPackagesIDs(); len(nodes) > 0 && !du.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(du.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -844,6 +1290,46 @@ func (duo *DeploymentUpdateOne) ClearCommitAuthor() *DeploymentUpdateOne {
 	return duo
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (duo *DeploymentUpdateOne) SetSourceArchive(v string) *DeploymentUpdateOne {
+	duo.mutation.SetSourceArchive(v)
+	return duo
+}
+
+// SetNillableSourceArchive sets the "source_archive" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableSourceArchive(v *string) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetSourceArchive(*v)
+	}
+	return duo
+}
+
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (duo *DeploymentUpdateOne) ClearSourceArchive() *DeploymentUpdateOne {
+	duo.mutation.ClearSourceArchive()
+	return duo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (duo *DeploymentUpdateOne) SetScheduledAt(v time.Time) *DeploymentUpdateOne {
+	duo.mutation.SetScheduledAt(v)
+	return duo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableScheduledAt(v *time.Time) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetScheduledAt(*v)
+	}
+	return duo
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (duo *DeploymentUpdateOne) ClearScheduledAt() *DeploymentUpdateOne {
+	duo.mutation.ClearScheduledAt()
+	return duo
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (duo *DeploymentUpdateOne) SetQueuedAt(t time.Time) *DeploymentUpdateOne {
 	duo.mutation.SetQueuedAt(t)
@@ -997,6 +1483,24 @@ func (duo *DeploymentUpdateOne) ClearResourceDefinition() *DeploymentUpdateOne {
 	return duo
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (duo *DeploymentUpdateOne) SetEnvKeys(v []string) *DeploymentUpdateOne {
+	duo.mutation.SetEnvKeys(v)
+	return duo
+}
+
+// AppendEnvKeys appends value to the "env_keys" field.
+func (duo *DeploymentUpdateOne) AppendEnvKeys(v []string) *DeploymentUpdateOne {
+	duo.mutation.AppendEnvKeys(v)
+	return duo
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (duo *DeploymentUpdateOne) ClearEnvKeys() *DeploymentUpdateOne {
+	duo.mutation.ClearEnvKeys()
+	return duo
+}
+
 // SetBuilder sets the "builder" field.
 func (duo *DeploymentUpdateOne) SetBuilder(sb schema.ServiceBuilder) *DeploymentUpdateOne {
 	duo.mutation.SetBuilder(sb)
@@ -1111,11 +1615,227 @@ func (duo *DeploymentUpdateOne) ClearDockerBuilderBuildContext() *DeploymentUpda
 	return duo
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (duo *DeploymentUpdateOne) SetDockerBuilderTarget(v string) *DeploymentUpdateOne {
+	duo.mutation.SetDockerBuilderTarget(v)
+	return duo
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableDockerBuilderTarget(v *string) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetDockerBuilderTarget(*v)
+	}
+	return duo
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (duo *DeploymentUpdateOne) ClearDockerBuilderTarget() *DeploymentUpdateOne {
+	duo.mutation.ClearDockerBuilderTarget()
+	return duo
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (duo *DeploymentUpdateOne) SetDockerBuilderBuildArgs(v map[string]string) *DeploymentUpdateOne {
+	duo.mutation.SetDockerBuilderBuildArgs(v)
+	return duo
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (duo *DeploymentUpdateOne) ClearDockerBuilderBuildArgs() *DeploymentUpdateOne {
+	duo.mutation.ClearDockerBuilderBuildArgs()
+	return duo
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (duo *DeploymentUpdateOne) SetDockerBuilderBuildContexts(v map[string]string) *DeploymentUpdateOne {
+	duo.mutation.SetDockerBuilderBuildContexts(v)
+	return duo
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (duo *DeploymentUpdateOne) ClearDockerBuilderBuildContexts() *DeploymentUpdateOne {
+	duo.mutation.ClearDockerBuilderBuildContexts()
+	return duo
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (duo *DeploymentUpdateOne) SetBuildpacksBuilderImage(v string) *DeploymentUpdateOne {
+	duo.mutation.SetBuildpacksBuilderImage(v)
+	return duo
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableBuildpacksBuilderImage(v *string) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetBuildpacksBuilderImage(*v)
+	}
+	return duo
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (duo *DeploymentUpdateOne) ClearBuildpacksBuilderImage() *DeploymentUpdateOne {
+	duo.mutation.ClearBuildpacksBuilderImage()
+	return duo
+}
+
+// SetPlatforms sets the "platforms" field.
+func (duo *DeploymentUpdateOne) SetPlatforms(v []string) *DeploymentUpdateOne {
+	duo.mutation.SetPlatforms(v)
+	return duo
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (duo *DeploymentUpdateOne) AppendPlatforms(v []string) *DeploymentUpdateOne {
+	duo.mutation.AppendPlatforms(v)
+	return duo
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (duo *DeploymentUpdateOne) ClearPlatforms() *DeploymentUpdateOne {
+	duo.mutation.ClearPlatforms()
+	return duo
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (duo *DeploymentUpdateOne) SetPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpdateOne {
+	duo.mutation.SetPlatformBuilds(v)
+	return duo
+}
+
+// AppendPlatformBuilds appends value to the "platform_builds" field.
+func (duo *DeploymentUpdateOne) AppendPlatformBuilds(v []schema.PlatformBuild) *DeploymentUpdateOne {
+	duo.mutation.AppendPlatformBuilds(v)
+	return duo
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (duo *DeploymentUpdateOne) ClearPlatformBuilds() *DeploymentUpdateOne {
+	duo.mutation.ClearPlatformBuilds()
+	return duo
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (duo *DeploymentUpdateOne) SetRollbackReason(v string) *DeploymentUpdateOne {
+	duo.mutation.SetRollbackReason(v)
+	return duo
+}
+
+// SetNillableRollbackReason sets the "rollback_reason" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableRollbackReason(v *string) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetRollbackReason(*v)
+	}
+	return duo
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (duo *DeploymentUpdateOne) ClearRollbackReason() *DeploymentUpdateOne {
+	duo.mutation.ClearRollbackReason()
+	return duo
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (duo *DeploymentUpdateOne) SetSkipReason(v string) *DeploymentUpdateOne {
+	duo.mutation.SetSkipReason(v)
+	return duo
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableSkipReason(v *string) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetSkipReason(*v)
+	}
+	return duo
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (duo *DeploymentUpdateOne) ClearSkipReason() *DeploymentUpdateOne {
+	duo.mutation.ClearSkipReason()
+	return duo
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (duo *DeploymentUpdateOne) SetGithubCheckRunID(v int64) *DeploymentUpdateOne {
+	duo.mutation.ResetGithubCheckRunID()
+	duo.mutation.SetGithubCheckRunID(v)
+	return duo
+}
+
+// SetNillableGithubCheckRunID sets the "github_check_run_id" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableGithubCheckRunID(v *int64) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetGithubCheckRunID(*v)
+	}
+	return duo
+}
+
+// AddGithubCheckRunID adds value to the "github_check_run_id" field.
+func (duo *DeploymentUpdateOne) AddGithubCheckRunID(v int64) *DeploymentUpdateOne {
+	duo.mutation.AddGithubCheckRunID(v)
+	return duo
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (duo *DeploymentUpdateOne) ClearGithubCheckRunID() *DeploymentUpdateOne {
+	duo.mutation.ClearGithubCheckRunID()
+	return duo
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (duo *DeploymentUpdateOne) SetGithubCheckStatus(v schema.DeploymentStatus) *DeploymentUpdateOne {
+	duo.mutation.SetGithubCheckStatus(v)
+	return duo
+}
+
+// SetNillableGithubCheckStatus sets the "github_check_status" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableGithubCheckStatus(v *schema.DeploymentStatus) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetGithubCheckStatus(*v)
+	}
+	return duo
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (duo *DeploymentUpdateOne) ClearGithubCheckStatus() *DeploymentUpdateOne {
+	duo.mutation.ClearGithubCheckStatus()
+	return duo
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (duo *DeploymentUpdateOne) SetGithubCheckConcluded(v bool) *DeploymentUpdateOne {
+	duo.mutation.SetGithubCheckConcluded(v)
+	return duo
+}
+
+// SetNillableGithubCheckConcluded sets the "github_check_concluded" field if the given value is not nil.
+func (duo *DeploymentUpdateOne) SetNillableGithubCheckConcluded(v *bool) *DeploymentUpdateOne {
+	if v != nil {
+		duo.SetGithubCheckConcluded(*v)
+	}
+	return duo
+}
+
 // SetService sets the "service" edge to the Service entity.
 func (duo *DeploymentUpdateOne) SetService(s *Service) *DeploymentUpdateOne {
 	return duo.SetServiceID(s.ID)
 }
 
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SBOMPackage entity by IDs.
+func (duo *DeploymentUpdateOne) AddSbomPackageIDs(ids ...uuid.UUID) *DeploymentUpdateOne {
+	duo.mutation.AddSbomPackageIDs(ids...)
+	return duo
+}
+
+// AddSbomPackages adds the "sbom_packages" edges to the SBOMPackage entity.
+func (duo *DeploymentUpdateOne) AddSbomPackages(v ...*SBOMPackage) *DeploymentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return duo.AddSbomPackageIDs(ids...)
+}
+
 // Mutation returns the DeploymentMutation object of the builder.
 func (duo *DeploymentUpdateOne) Mutation() *DeploymentMutation {
 	return duo.mutation
@@ -1127,6 +1847,27 @@ func (duo *DeploymentUpdateOne) ClearService() *DeploymentUpdateOne {
 	return duo
 }
 
+// ClearSbomPackages clears all "sbom_packages" edges to the SBOMPackage entity.
+func (duo *DeploymentUpdateOne) ClearSbomPackages() *DeploymentUpdateOne {
+	duo.mutation.ClearSbomPackages()
+	return duo
+}
+
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to SBOMPackage entities by IDs.
+func (duo *DeploymentUpdateOne) RemoveSbomPackageIDs(ids ...uuid.UUID) *DeploymentUpdateOne {
+	duo.mutation.RemoveSbomPackageIDs(ids...)
+	return duo
+}
+
+// RemoveSbomPackages removes "sbom_packages" edges to SBOMPackage entities.
+func (duo *DeploymentUpdateOne) RemoveSbomPackages(v ...*SBOMPackage) *DeploymentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return duo.RemoveSbomPackageIDs(ids...)
+}
+
 // Where appends a list predicates to the DeploymentUpdate builder.
 func (duo *DeploymentUpdateOne) Where(ps ...predicate.Deployment) *DeploymentUpdateOne {
 	duo.mutation.Where(ps...)
@@ -1193,6 +1934,11 @@ func (duo *DeploymentUpdateOne) check() error {
 			return &ValidationError{Name: "builder", err: fmt.Errorf(`ent: validator failed for field "Deployment.builder": %w`, err)}
 		}
 	}
+	if v, ok := duo.mutation.GithubCheckStatus(); ok {
+		if err := deployment.GithubCheckStatusValidator(v); err != nil {
+			return &ValidationError{Name: "github_check_status", err: fmt.Errorf(`ent: validator failed for field "Deployment.github_check_status": %w`, err)}
+		}
+	}
 	if duo.mutation.ServiceCleared() && len(duo.mutation.ServiceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Deployment.service"`)
 	}
@@ -1273,6 +2019,18 @@ func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment,
 	if duo.mutation.CommitAuthorCleared() {
 		_spec.ClearField(deployment.FieldCommitAuthor, field.TypeJSON)
 	}
+	if value, ok := duo.mutation.SourceArchive(); ok {
+		_spec.SetField(deployment.FieldSourceArchive, field.TypeString, value)
+	}
+	if duo.mutation.SourceArchiveCleared() {
+		_spec.ClearField(deployment.FieldSourceArchive, field.TypeString)
+	}
+	if value, ok := duo.mutation.ScheduledAt(); ok {
+		_spec.SetField(deployment.FieldScheduledAt, field.TypeTime, value)
+	}
+	if duo.mutation.ScheduledAtCleared() {
+		_spec.ClearField(deployment.FieldScheduledAt, field.TypeTime)
+	}
 	if value, ok := duo.mutation.QueuedAt(); ok {
 		_spec.SetField(deployment.FieldQueuedAt, field.TypeTime, value)
 	}
@@ -1321,6 +2079,17 @@ func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment,
 	if duo.mutation.ResourceDefinitionCleared() {
 		_spec.ClearField(deployment.FieldResourceDefinition, field.TypeJSON)
 	}
+	if value, ok := duo.mutation.EnvKeys(); ok {
+		_spec.SetField(deployment.FieldEnvKeys, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedEnvKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldEnvKeys, value)
+		})
+	}
+	if duo.mutation.EnvKeysCleared() {
+		_spec.ClearField(deployment.FieldEnvKeys, field.TypeJSON)
+	}
 	if value, ok := duo.mutation.Builder(); ok {
 		_spec.SetField(deployment.FieldBuilder, field.TypeEnum, value)
 	}
@@ -1354,6 +2123,82 @@ func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment,
 	if duo.mutation.DockerBuilderBuildContextCleared() {
 		_spec.ClearField(deployment.FieldDockerBuilderBuildContext, field.TypeString)
 	}
+	if value, ok := duo.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderTarget, field.TypeString, value)
+	}
+	if duo.mutation.DockerBuilderTargetCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderTarget, field.TypeString)
+	}
+	if value, ok := duo.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+	}
+	if duo.mutation.DockerBuilderBuildArgsCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderBuildArgs, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(deployment.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+	}
+	if duo.mutation.DockerBuilderBuildContextsCleared() {
+		_spec.ClearField(deployment.FieldDockerBuilderBuildContexts, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(deployment.FieldBuildpacksBuilderImage, field.TypeString, value)
+	}
+	if duo.mutation.BuildpacksBuilderImageCleared() {
+		_spec.ClearField(deployment.FieldBuildpacksBuilderImage, field.TypeString)
+	}
+	if value, ok := duo.mutation.Platforms(); ok {
+		_spec.SetField(deployment.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldPlatforms, value)
+		})
+	}
+	if duo.mutation.PlatformsCleared() {
+		_spec.ClearField(deployment.FieldPlatforms, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.PlatformBuilds(); ok {
+		_spec.SetField(deployment.FieldPlatformBuilds, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedPlatformBuilds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deployment.FieldPlatformBuilds, value)
+		})
+	}
+	if duo.mutation.PlatformBuildsCleared() {
+		_spec.ClearField(deployment.FieldPlatformBuilds, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.RollbackReason(); ok {
+		_spec.SetField(deployment.FieldRollbackReason, field.TypeString, value)
+	}
+	if duo.mutation.RollbackReasonCleared() {
+		_spec.ClearField(deployment.FieldRollbackReason, field.TypeString)
+	}
+	if value, ok := duo.mutation.SkipReason(); ok {
+		_spec.SetField(deployment.FieldSkipReason, field.TypeString, value)
+	}
+	if duo.mutation.SkipReasonCleared() {
+		_spec.ClearField(deployment.FieldSkipReason, field.TypeString)
+	}
+	if value, ok := duo.mutation.GithubCheckRunID(); ok {
+		_spec.SetField(deployment.FieldGithubCheckRunID, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedGithubCheckRunID(); ok {
+		_spec.AddField(deployment.FieldGithubCheckRunID, field.TypeInt64, value)
+	}
+	if duo.mutation.GithubCheckRunIDCleared() {
+		_spec.ClearField(deployment.FieldGithubCheckRunID, field.TypeInt64)
+	}
+	if value, ok := duo.mutation.GithubCheckStatus(); ok {
+		_spec.SetField(deployment.FieldGithubCheckStatus, field.TypeEnum, value)
+	}
+	if duo.mutation.GithubCheckStatusCleared() {
+		_spec.ClearField(deployment.FieldGithubCheckStatus, field.TypeEnum)
+	}
+	if value, ok := duo.mutation.GithubCheckConcluded(); ok {
+		_spec.SetField(deployment.FieldGithubCheckConcluded, field.TypeBool, value)
+	}
 	if duo.mutation.ServiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1383,6 +2228,51 @@ func (duo *DeploymentUpdateOne) sqlSave(ctx context.Context) (_node *Deployment,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSbomPackagesIDs(); len(nodes) > 0 && !duo.mutation.SbomPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SbomPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deployment.SbomPackagesTable,
+			Columns: []string{deployment.SbomPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sbompackage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(duo.modifiers...)
 	_node = &Deployment{config: duo.config}
 	_spec.Assign = _node.assignValues
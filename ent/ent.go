@@ -17,6 +17,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/jwtkey"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
@@ -26,6 +27,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/pvcmetadata"
 	"github.com/unbindapp/unbind-api/ent/registry"
 	"github.com/unbindapp/unbind-api/ent/s3"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
 	"github.com/unbindapp/unbind-api/ent/servicegroup"
@@ -100,6 +102,7 @@ func checkColumn(table, column string) error {
 			environment.Table:        environment.ValidColumn,
 			githubapp.Table:          githubapp.ValidColumn,
 			githubinstallation.Table: githubinstallation.ValidColumn,
+			gitlabconnection.Table:   gitlabconnection.ValidColumn,
 			group.Table:              group.ValidColumn,
 			jwtkey.Table:             jwtkey.ValidColumn,
 			oauth2code.Table:         oauth2code.ValidColumn,
@@ -109,6 +112,7 @@ func checkColumn(table, column string) error {
 			project.Table:            project.ValidColumn,
 			registry.Table:           registry.ValidColumn,
 			s3.Table:                 s3.ValidColumn,
+			sbompackage.Table:        sbompackage.ValidColumn,
 			service.Table:            service.ValidColumn,
 			serviceconfig.Table:      serviceconfig.ValidColumn,
 			servicegroup.Table:       servicegroup.ValidColumn,
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/project"
+	"github.com/unbindapp/unbind-api/ent/schema"
 )
 
 // Environment is the model entity for the Environment schema.
@@ -36,6 +38,18 @@ type Environment struct {
 	ProjectID uuid.UUID `json:"project_id,omitempty"`
 	// Kubernetes secret for this environment
 	KubernetesSecret string `json:"kubernetes_secret,omitempty"`
+	// Deployments to protected environments require approval from an environment admin
+	Protected bool `json:"protected,omitempty"`
+	// Windows during which deployments are held
+	FreezeWindows []schema.FreezeWindow `json:"freeze_windows,omitempty"`
+	// Environment a pull request preview environment was cloned from
+	PreviewBaseEnvironmentID *uuid.UUID `json:"preview_base_environment_id,omitempty"`
+	// Repository of the pull request a preview environment was created for
+	PreviewRepository *string `json:"preview_repository,omitempty"`
+	// Number of the pull request a preview environment was created for
+	PreviewPullRequest *int `json:"preview_pull_request,omitempty"`
+	// Pull request comment linking to the preview
+	PreviewCommentID *int64 `json:"preview_comment_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvironmentQuery when eager-loading is set.
 	Edges        EnvironmentEdges `json:"edges"`
@@ -100,9 +114,15 @@ func (*Environment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case environment.FieldActive:
+		case environment.FieldPreviewBaseEnvironmentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case environment.FieldFreezeWindows:
+			values[i] = new([]byte)
+		case environment.FieldActive, environment.FieldProtected:
 			values[i] = new(sql.NullBool)
-		case environment.FieldKubernetesName, environment.FieldName, environment.FieldDescription, environment.FieldKubernetesSecret:
+		case environment.FieldPreviewPullRequest, environment.FieldPreviewCommentID:
+			values[i] = new(sql.NullInt64)
+		case environment.FieldKubernetesName, environment.FieldName, environment.FieldDescription, environment.FieldKubernetesSecret, environment.FieldPreviewRepository:
 			values[i] = new(sql.NullString)
 		case environment.FieldCreatedAt, environment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -178,6 +198,48 @@ func (e *Environment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				e.KubernetesSecret = value.String
 			}
+		case environment.FieldProtected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field protected", values[i])
+			} else if value.Valid {
+				e.Protected = value.Bool
+			}
+		case environment.FieldFreezeWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field freeze_windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &e.FreezeWindows); err != nil {
+					return fmt.Errorf("unmarshal field freeze_windows: %w", err)
+				}
+			}
+		case environment.FieldPreviewBaseEnvironmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_base_environment_id", values[i])
+			} else if value.Valid {
+				e.PreviewBaseEnvironmentID = new(uuid.UUID)
+				*e.PreviewBaseEnvironmentID = *value.S.(*uuid.UUID)
+			}
+		case environment.FieldPreviewRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_repository", values[i])
+			} else if value.Valid {
+				e.PreviewRepository = new(string)
+				*e.PreviewRepository = value.String
+			}
+		case environment.FieldPreviewPullRequest:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_pull_request", values[i])
+			} else if value.Valid {
+				e.PreviewPullRequest = new(int)
+				*e.PreviewPullRequest = int(value.Int64)
+			}
+		case environment.FieldPreviewCommentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_comment_id", values[i])
+			} else if value.Valid {
+				e.PreviewCommentID = new(int64)
+				*e.PreviewCommentID = value.Int64
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -259,6 +321,32 @@ func (e *Environment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("kubernetes_secret=")
 	builder.WriteString(e.KubernetesSecret)
+	builder.WriteString(", ")
+	builder.WriteString("protected=")
+	builder.WriteString(fmt.Sprintf("%v", e.Protected))
+	builder.WriteString(", ")
+	builder.WriteString("freeze_windows=")
+	builder.WriteString(fmt.Sprintf("%v", e.FreezeWindows))
+	builder.WriteString(", ")
+	if v := e.PreviewBaseEnvironmentID; v != nil {
+		builder.WriteString("preview_base_environment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := e.PreviewRepository; v != nil {
+		builder.WriteString("preview_repository=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := e.PreviewPullRequest; v != nil {
+		builder.WriteString("preview_pull_request=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := e.PreviewCommentID; v != nil {
+		builder.WriteString("preview_comment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
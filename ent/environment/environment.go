@@ -31,6 +31,18 @@ const (
 	FieldProjectID = "project_id"
 	// FieldKubernetesSecret holds the string denoting the kubernetes_secret field in the database.
 	FieldKubernetesSecret = "kubernetes_secret"
+	// FieldProtected holds the string denoting the protected field in the database.
+	FieldProtected = "protected"
+	// FieldFreezeWindows holds the string denoting the freeze_windows field in the database.
+	FieldFreezeWindows = "freeze_windows"
+	// FieldPreviewBaseEnvironmentID holds the string denoting the preview_base_environment_id field in the database.
+	FieldPreviewBaseEnvironmentID = "preview_base_environment_id"
+	// FieldPreviewRepository holds the string denoting the preview_repository field in the database.
+	FieldPreviewRepository = "preview_repository"
+	// FieldPreviewPullRequest holds the string denoting the preview_pull_request field in the database.
+	FieldPreviewPullRequest = "preview_pull_request"
+	// FieldPreviewCommentID holds the string denoting the preview_comment_id field in the database.
+	FieldPreviewCommentID = "preview_comment_id"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeServices holds the string denoting the services edge name in mutations.
@@ -82,6 +94,12 @@ var Columns = []string{
 	FieldActive,
 	FieldProjectID,
 	FieldKubernetesSecret,
+	FieldProtected,
+	FieldFreezeWindows,
+	FieldPreviewBaseEnvironmentID,
+	FieldPreviewRepository,
+	FieldPreviewPullRequest,
+	FieldPreviewCommentID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -105,6 +123,8 @@ var (
 	KubernetesNameValidator func(string) error
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// DefaultProtected holds the default value on creation for the "protected" field.
+	DefaultProtected bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -157,6 +177,31 @@ func ByKubernetesSecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKubernetesSecret, opts...).ToFunc()
 }
 
+// ByProtected orders the results by the protected field.
+func ByProtected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtected, opts...).ToFunc()
+}
+
+// ByPreviewBaseEnvironmentID orders the results by the preview_base_environment_id field.
+func ByPreviewBaseEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewBaseEnvironmentID, opts...).ToFunc()
+}
+
+// ByPreviewRepository orders the results by the preview_repository field.
+func ByPreviewRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewRepository, opts...).ToFunc()
+}
+
+// ByPreviewPullRequest orders the results by the preview_pull_request field.
+func ByPreviewPullRequest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewPullRequest, opts...).ToFunc()
+}
+
+// ByPreviewCommentID orders the results by the preview_comment_id field.
+func ByPreviewCommentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewCommentID, opts...).ToFunc()
+}
+
 // ByProjectField orders the results by project field.
 func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -96,6 +96,31 @@ func KubernetesSecret(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldKubernetesSecret, v))
 }
 
+// Protected applies equality check predicate on the "protected" field. It's identical to ProtectedEQ.
+func Protected(v bool) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldProtected, v))
+}
+
+// PreviewBaseEnvironmentID applies equality check predicate on the "preview_base_environment_id" field. It's identical to PreviewBaseEnvironmentIDEQ.
+func PreviewBaseEnvironmentID(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewRepository applies equality check predicate on the "preview_repository" field. It's identical to PreviewRepositoryEQ.
+func PreviewRepository(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewRepository, v))
+}
+
+// PreviewPullRequest applies equality check predicate on the "preview_pull_request" field. It's identical to PreviewPullRequestEQ.
+func PreviewPullRequest(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewPullRequest, v))
+}
+
+// PreviewCommentID applies equality check predicate on the "preview_comment_id" field. It's identical to PreviewCommentIDEQ.
+func PreviewCommentID(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewCommentID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldCreatedAt, v))
@@ -476,6 +501,251 @@ func KubernetesSecretContainsFold(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldContainsFold(FieldKubernetesSecret, v))
 }
 
+// ProtectedEQ applies the EQ predicate on the "protected" field.
+func ProtectedEQ(v bool) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldProtected, v))
+}
+
+// ProtectedNEQ applies the NEQ predicate on the "protected" field.
+func ProtectedNEQ(v bool) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldProtected, v))
+}
+
+// FreezeWindowsIsNil applies the IsNil predicate on the "freeze_windows" field.
+func FreezeWindowsIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldFreezeWindows))
+}
+
+// FreezeWindowsNotNil applies the NotNil predicate on the "freeze_windows" field.
+func FreezeWindowsNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldFreezeWindows))
+}
+
+// PreviewBaseEnvironmentIDEQ applies the EQ predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDEQ(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDNEQ applies the NEQ predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDNEQ(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDIn applies the In predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDIn(vs ...uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldPreviewBaseEnvironmentID, vs...))
+}
+
+// PreviewBaseEnvironmentIDNotIn applies the NotIn predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDNotIn(vs ...uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldPreviewBaseEnvironmentID, vs...))
+}
+
+// PreviewBaseEnvironmentIDGT applies the GT predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDGT(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDGTE applies the GTE predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDGTE(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDLT applies the LT predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDLT(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDLTE applies the LTE predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDLTE(v uuid.UUID) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldPreviewBaseEnvironmentID, v))
+}
+
+// PreviewBaseEnvironmentIDIsNil applies the IsNil predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldPreviewBaseEnvironmentID))
+}
+
+// PreviewBaseEnvironmentIDNotNil applies the NotNil predicate on the "preview_base_environment_id" field.
+func PreviewBaseEnvironmentIDNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldPreviewBaseEnvironmentID))
+}
+
+// PreviewRepositoryEQ applies the EQ predicate on the "preview_repository" field.
+func PreviewRepositoryEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryNEQ applies the NEQ predicate on the "preview_repository" field.
+func PreviewRepositoryNEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryIn applies the In predicate on the "preview_repository" field.
+func PreviewRepositoryIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldPreviewRepository, vs...))
+}
+
+// PreviewRepositoryNotIn applies the NotIn predicate on the "preview_repository" field.
+func PreviewRepositoryNotIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldPreviewRepository, vs...))
+}
+
+// PreviewRepositoryGT applies the GT predicate on the "preview_repository" field.
+func PreviewRepositoryGT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryGTE applies the GTE predicate on the "preview_repository" field.
+func PreviewRepositoryGTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryLT applies the LT predicate on the "preview_repository" field.
+func PreviewRepositoryLT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryLTE applies the LTE predicate on the "preview_repository" field.
+func PreviewRepositoryLTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryContains applies the Contains predicate on the "preview_repository" field.
+func PreviewRepositoryContains(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContains(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryHasPrefix applies the HasPrefix predicate on the "preview_repository" field.
+func PreviewRepositoryHasPrefix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasPrefix(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryHasSuffix applies the HasSuffix predicate on the "preview_repository" field.
+func PreviewRepositoryHasSuffix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasSuffix(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryIsNil applies the IsNil predicate on the "preview_repository" field.
+func PreviewRepositoryIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldPreviewRepository))
+}
+
+// PreviewRepositoryNotNil applies the NotNil predicate on the "preview_repository" field.
+func PreviewRepositoryNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldPreviewRepository))
+}
+
+// PreviewRepositoryEqualFold applies the EqualFold predicate on the "preview_repository" field.
+func PreviewRepositoryEqualFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEqualFold(FieldPreviewRepository, v))
+}
+
+// PreviewRepositoryContainsFold applies the ContainsFold predicate on the "preview_repository" field.
+func PreviewRepositoryContainsFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContainsFold(FieldPreviewRepository, v))
+}
+
+// PreviewPullRequestEQ applies the EQ predicate on the "preview_pull_request" field.
+func PreviewPullRequestEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestNEQ applies the NEQ predicate on the "preview_pull_request" field.
+func PreviewPullRequestNEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestIn applies the In predicate on the "preview_pull_request" field.
+func PreviewPullRequestIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldPreviewPullRequest, vs...))
+}
+
+// PreviewPullRequestNotIn applies the NotIn predicate on the "preview_pull_request" field.
+func PreviewPullRequestNotIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldPreviewPullRequest, vs...))
+}
+
+// PreviewPullRequestGT applies the GT predicate on the "preview_pull_request" field.
+func PreviewPullRequestGT(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestGTE applies the GTE predicate on the "preview_pull_request" field.
+func PreviewPullRequestGTE(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestLT applies the LT predicate on the "preview_pull_request" field.
+func PreviewPullRequestLT(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestLTE applies the LTE predicate on the "preview_pull_request" field.
+func PreviewPullRequestLTE(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldPreviewPullRequest, v))
+}
+
+// PreviewPullRequestIsNil applies the IsNil predicate on the "preview_pull_request" field.
+func PreviewPullRequestIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldPreviewPullRequest))
+}
+
+// PreviewPullRequestNotNil applies the NotNil predicate on the "preview_pull_request" field.
+func PreviewPullRequestNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldPreviewPullRequest))
+}
+
+// PreviewCommentIDEQ applies the EQ predicate on the "preview_comment_id" field.
+func PreviewCommentIDEQ(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDNEQ applies the NEQ predicate on the "preview_comment_id" field.
+func PreviewCommentIDNEQ(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDIn applies the In predicate on the "preview_comment_id" field.
+func PreviewCommentIDIn(vs ...int64) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldPreviewCommentID, vs...))
+}
+
+// PreviewCommentIDNotIn applies the NotIn predicate on the "preview_comment_id" field.
+func PreviewCommentIDNotIn(vs ...int64) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldPreviewCommentID, vs...))
+}
+
+// PreviewCommentIDGT applies the GT predicate on the "preview_comment_id" field.
+func PreviewCommentIDGT(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDGTE applies the GTE predicate on the "preview_comment_id" field.
+func PreviewCommentIDGTE(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDLT applies the LT predicate on the "preview_comment_id" field.
+func PreviewCommentIDLT(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDLTE applies the LTE predicate on the "preview_comment_id" field.
+func PreviewCommentIDLTE(v int64) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldPreviewCommentID, v))
+}
+
+// PreviewCommentIDIsNil applies the IsNil predicate on the "preview_comment_id" field.
+func PreviewCommentIDIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldPreviewCommentID))
+}
+
+// PreviewCommentIDNotNil applies the NotNil predicate on the "preview_comment_id" field.
+func PreviewCommentIDNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldPreviewCommentID))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
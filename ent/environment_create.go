@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/project"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/servicegroup"
 )
@@ -107,6 +108,82 @@ func (ec *EnvironmentCreate) SetKubernetesSecret(s string) *EnvironmentCreate {
 	return ec
 }
 
+// SetProtected sets the "protected" field.
+func (ec *EnvironmentCreate) SetProtected(v bool) *EnvironmentCreate {
+	ec.mutation.SetProtected(v)
+	return ec
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillableProtected(v *bool) *EnvironmentCreate {
+	if v != nil {
+		ec.SetProtected(*v)
+	}
+	return ec
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (ec *EnvironmentCreate) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentCreate {
+	ec.mutation.SetFreezeWindows(v)
+	return ec
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (ec *EnvironmentCreate) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentCreate {
+	ec.mutation.SetPreviewBaseEnvironmentID(v)
+	return ec
+}
+
+// SetNillablePreviewBaseEnvironmentID sets the "preview_base_environment_id" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillablePreviewBaseEnvironmentID(v *uuid.UUID) *EnvironmentCreate {
+	if v != nil {
+		ec.SetPreviewBaseEnvironmentID(*v)
+	}
+	return ec
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (ec *EnvironmentCreate) SetPreviewRepository(v string) *EnvironmentCreate {
+	ec.mutation.SetPreviewRepository(v)
+	return ec
+}
+
+// SetNillablePreviewRepository sets the "preview_repository" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillablePreviewRepository(v *string) *EnvironmentCreate {
+	if v != nil {
+		ec.SetPreviewRepository(*v)
+	}
+	return ec
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (ec *EnvironmentCreate) SetPreviewPullRequest(v int) *EnvironmentCreate {
+	ec.mutation.SetPreviewPullRequest(v)
+	return ec
+}
+
+// SetNillablePreviewPullRequest sets the "preview_pull_request" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillablePreviewPullRequest(v *int) *EnvironmentCreate {
+	if v != nil {
+		ec.SetPreviewPullRequest(*v)
+	}
+	return ec
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (ec *EnvironmentCreate) SetPreviewCommentID(v int64) *EnvironmentCreate {
+	ec.mutation.SetPreviewCommentID(v)
+	return ec
+}
+
+// SetNillablePreviewCommentID sets the "preview_comment_id" field if the given value is not nil.
+func (ec *EnvironmentCreate) SetNillablePreviewCommentID(v *int64) *EnvironmentCreate {
+	if v != nil {
+		ec.SetPreviewCommentID(*v)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EnvironmentCreate) SetID(u uuid.UUID) *EnvironmentCreate {
 	ec.mutation.SetID(u)
@@ -218,6 +295,10 @@ func (ec *EnvironmentCreate) defaults() {
 		v := environment.DefaultActive
 		ec.mutation.SetActive(v)
 	}
+	if _, ok := ec.mutation.Protected(); !ok {
+		v := environment.DefaultProtected
+		ec.mutation.SetProtected(v)
+	}
 	if _, ok := ec.mutation.ID(); !ok {
 		v := environment.DefaultID()
 		ec.mutation.SetID(v)
@@ -252,6 +333,9 @@ func (ec *EnvironmentCreate) check() error {
 	if _, ok := ec.mutation.KubernetesSecret(); !ok {
 		return &ValidationError{Name: "kubernetes_secret", err: errors.New(`ent: missing required field "Environment.kubernetes_secret"`)}
 	}
+	if _, ok := ec.mutation.Protected(); !ok {
+		return &ValidationError{Name: "protected", err: errors.New(`ent: missing required field "Environment.protected"`)}
+	}
 	if len(ec.mutation.ProjectIDs()) == 0 {
 		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Environment.project"`)}
 	}
@@ -319,6 +403,30 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		_spec.SetField(environment.FieldKubernetesSecret, field.TypeString, value)
 		_node.KubernetesSecret = value
 	}
+	if value, ok := ec.mutation.Protected(); ok {
+		_spec.SetField(environment.FieldProtected, field.TypeBool, value)
+		_node.Protected = value
+	}
+	if value, ok := ec.mutation.FreezeWindows(); ok {
+		_spec.SetField(environment.FieldFreezeWindows, field.TypeJSON, value)
+		_node.FreezeWindows = value
+	}
+	if value, ok := ec.mutation.PreviewBaseEnvironmentID(); ok {
+		_spec.SetField(environment.FieldPreviewBaseEnvironmentID, field.TypeUUID, value)
+		_node.PreviewBaseEnvironmentID = &value
+	}
+	if value, ok := ec.mutation.PreviewRepository(); ok {
+		_spec.SetField(environment.FieldPreviewRepository, field.TypeString, value)
+		_node.PreviewRepository = &value
+	}
+	if value, ok := ec.mutation.PreviewPullRequest(); ok {
+		_spec.SetField(environment.FieldPreviewPullRequest, field.TypeInt, value)
+		_node.PreviewPullRequest = &value
+	}
+	if value, ok := ec.mutation.PreviewCommentID(); ok {
+		_spec.SetField(environment.FieldPreviewCommentID, field.TypeInt64, value)
+		_node.PreviewCommentID = &value
+	}
 	if nodes := ec.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -526,6 +634,120 @@ func (u *EnvironmentUpsert) UpdateKubernetesSecret() *EnvironmentUpsert {
 	return u
 }
 
+// SetProtected sets the "protected" field.
+func (u *EnvironmentUpsert) SetProtected(v bool) *EnvironmentUpsert {
+	u.Set(environment.FieldProtected, v)
+	return u
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdateProtected() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldProtected)
+	return u
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (u *EnvironmentUpsert) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpsert {
+	u.Set(environment.FieldFreezeWindows, v)
+	return u
+}
+
+// UpdateFreezeWindows sets the "freeze_windows" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdateFreezeWindows() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldFreezeWindows)
+	return u
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (u *EnvironmentUpsert) ClearFreezeWindows() *EnvironmentUpsert {
+	u.SetNull(environment.FieldFreezeWindows)
+	return u
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (u *EnvironmentUpsert) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentUpsert {
+	u.Set(environment.FieldPreviewBaseEnvironmentID, v)
+	return u
+}
+
+// UpdatePreviewBaseEnvironmentID sets the "preview_base_environment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdatePreviewBaseEnvironmentID() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldPreviewBaseEnvironmentID)
+	return u
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (u *EnvironmentUpsert) ClearPreviewBaseEnvironmentID() *EnvironmentUpsert {
+	u.SetNull(environment.FieldPreviewBaseEnvironmentID)
+	return u
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (u *EnvironmentUpsert) SetPreviewRepository(v string) *EnvironmentUpsert {
+	u.Set(environment.FieldPreviewRepository, v)
+	return u
+}
+
+// UpdatePreviewRepository sets the "preview_repository" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdatePreviewRepository() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldPreviewRepository)
+	return u
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (u *EnvironmentUpsert) ClearPreviewRepository() *EnvironmentUpsert {
+	u.SetNull(environment.FieldPreviewRepository)
+	return u
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (u *EnvironmentUpsert) SetPreviewPullRequest(v int) *EnvironmentUpsert {
+	u.Set(environment.FieldPreviewPullRequest, v)
+	return u
+}
+
+// UpdatePreviewPullRequest sets the "preview_pull_request" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdatePreviewPullRequest() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldPreviewPullRequest)
+	return u
+}
+
+// AddPreviewPullRequest adds v to the "preview_pull_request" field.
+func (u *EnvironmentUpsert) AddPreviewPullRequest(v int) *EnvironmentUpsert {
+	u.Add(environment.FieldPreviewPullRequest, v)
+	return u
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (u *EnvironmentUpsert) ClearPreviewPullRequest() *EnvironmentUpsert {
+	u.SetNull(environment.FieldPreviewPullRequest)
+	return u
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (u *EnvironmentUpsert) SetPreviewCommentID(v int64) *EnvironmentUpsert {
+	u.Set(environment.FieldPreviewCommentID, v)
+	return u
+}
+
+// UpdatePreviewCommentID sets the "preview_comment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsert) UpdatePreviewCommentID() *EnvironmentUpsert {
+	u.SetExcluded(environment.FieldPreviewCommentID)
+	return u
+}
+
+// AddPreviewCommentID adds v to the "preview_comment_id" field.
+func (u *EnvironmentUpsert) AddPreviewCommentID(v int64) *EnvironmentUpsert {
+	u.Add(environment.FieldPreviewCommentID, v)
+	return u
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (u *EnvironmentUpsert) ClearPreviewCommentID() *EnvironmentUpsert {
+	u.SetNull(environment.FieldPreviewCommentID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -682,6 +904,139 @@ func (u *EnvironmentUpsertOne) UpdateKubernetesSecret() *EnvironmentUpsertOne {
 	})
 }
 
+// SetProtected sets the "protected" field.
+func (u *EnvironmentUpsertOne) SetProtected(v bool) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdateProtected() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (u *EnvironmentUpsertOne) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetFreezeWindows(v)
+	})
+}
+
+// UpdateFreezeWindows sets the "freeze_windows" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdateFreezeWindows() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateFreezeWindows()
+	})
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (u *EnvironmentUpsertOne) ClearFreezeWindows() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearFreezeWindows()
+	})
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (u *EnvironmentUpsertOne) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewBaseEnvironmentID(v)
+	})
+}
+
+// UpdatePreviewBaseEnvironmentID sets the "preview_base_environment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdatePreviewBaseEnvironmentID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewBaseEnvironmentID()
+	})
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (u *EnvironmentUpsertOne) ClearPreviewBaseEnvironmentID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewBaseEnvironmentID()
+	})
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (u *EnvironmentUpsertOne) SetPreviewRepository(v string) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewRepository(v)
+	})
+}
+
+// UpdatePreviewRepository sets the "preview_repository" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdatePreviewRepository() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewRepository()
+	})
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (u *EnvironmentUpsertOne) ClearPreviewRepository() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewRepository()
+	})
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (u *EnvironmentUpsertOne) SetPreviewPullRequest(v int) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewPullRequest(v)
+	})
+}
+
+// AddPreviewPullRequest adds v to the "preview_pull_request" field.
+func (u *EnvironmentUpsertOne) AddPreviewPullRequest(v int) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.AddPreviewPullRequest(v)
+	})
+}
+
+// UpdatePreviewPullRequest sets the "preview_pull_request" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdatePreviewPullRequest() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewPullRequest()
+	})
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (u *EnvironmentUpsertOne) ClearPreviewPullRequest() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewPullRequest()
+	})
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (u *EnvironmentUpsertOne) SetPreviewCommentID(v int64) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewCommentID(v)
+	})
+}
+
+// AddPreviewCommentID adds v to the "preview_comment_id" field.
+func (u *EnvironmentUpsertOne) AddPreviewCommentID(v int64) *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.AddPreviewCommentID(v)
+	})
+}
+
+// UpdatePreviewCommentID sets the "preview_comment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertOne) UpdatePreviewCommentID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewCommentID()
+	})
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (u *EnvironmentUpsertOne) ClearPreviewCommentID() *EnvironmentUpsertOne {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewCommentID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvironmentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1005,6 +1360,139 @@ func (u *EnvironmentUpsertBulk) UpdateKubernetesSecret() *EnvironmentUpsertBulk
 	})
 }
 
+// SetProtected sets the "protected" field.
+func (u *EnvironmentUpsertBulk) SetProtected(v bool) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetProtected(v)
+	})
+}
+
+// UpdateProtected sets the "protected" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdateProtected() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateProtected()
+	})
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (u *EnvironmentUpsertBulk) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetFreezeWindows(v)
+	})
+}
+
+// UpdateFreezeWindows sets the "freeze_windows" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdateFreezeWindows() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdateFreezeWindows()
+	})
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (u *EnvironmentUpsertBulk) ClearFreezeWindows() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearFreezeWindows()
+	})
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (u *EnvironmentUpsertBulk) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewBaseEnvironmentID(v)
+	})
+}
+
+// UpdatePreviewBaseEnvironmentID sets the "preview_base_environment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdatePreviewBaseEnvironmentID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewBaseEnvironmentID()
+	})
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (u *EnvironmentUpsertBulk) ClearPreviewBaseEnvironmentID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewBaseEnvironmentID()
+	})
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (u *EnvironmentUpsertBulk) SetPreviewRepository(v string) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewRepository(v)
+	})
+}
+
+// UpdatePreviewRepository sets the "preview_repository" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdatePreviewRepository() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewRepository()
+	})
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (u *EnvironmentUpsertBulk) ClearPreviewRepository() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewRepository()
+	})
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (u *EnvironmentUpsertBulk) SetPreviewPullRequest(v int) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewPullRequest(v)
+	})
+}
+
+// AddPreviewPullRequest adds v to the "preview_pull_request" field.
+func (u *EnvironmentUpsertBulk) AddPreviewPullRequest(v int) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.AddPreviewPullRequest(v)
+	})
+}
+
+// UpdatePreviewPullRequest sets the "preview_pull_request" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdatePreviewPullRequest() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewPullRequest()
+	})
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (u *EnvironmentUpsertBulk) ClearPreviewPullRequest() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewPullRequest()
+	})
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (u *EnvironmentUpsertBulk) SetPreviewCommentID(v int64) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.SetPreviewCommentID(v)
+	})
+}
+
+// AddPreviewCommentID adds v to the "preview_comment_id" field.
+func (u *EnvironmentUpsertBulk) AddPreviewCommentID(v int64) *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.AddPreviewCommentID(v)
+	})
+}
+
+// UpdatePreviewCommentID sets the "preview_comment_id" field to the value that was provided on create.
+func (u *EnvironmentUpsertBulk) UpdatePreviewCommentID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.UpdatePreviewCommentID()
+	})
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (u *EnvironmentUpsertBulk) ClearPreviewCommentID() *EnvironmentUpsertBulk {
+	return u.Update(func(s *EnvironmentUpsert) {
+		s.ClearPreviewCommentID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvironmentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/predicate"
 	"github.com/unbindapp/unbind-api/ent/project"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/servicegroup"
 )
@@ -129,6 +131,132 @@ func (eu *EnvironmentUpdate) SetNillableKubernetesSecret(s *string) *Environment
 	return eu
 }
 
+// SetProtected sets the "protected" field.
+func (eu *EnvironmentUpdate) SetProtected(v bool) *EnvironmentUpdate {
+	eu.mutation.SetProtected(v)
+	return eu
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillableProtected(v *bool) *EnvironmentUpdate {
+	if v != nil {
+		eu.SetProtected(*v)
+	}
+	return eu
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (eu *EnvironmentUpdate) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpdate {
+	eu.mutation.SetFreezeWindows(v)
+	return eu
+}
+
+// AppendFreezeWindows appends value to the "freeze_windows" field.
+func (eu *EnvironmentUpdate) AppendFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpdate {
+	eu.mutation.AppendFreezeWindows(v)
+	return eu
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (eu *EnvironmentUpdate) ClearFreezeWindows() *EnvironmentUpdate {
+	eu.mutation.ClearFreezeWindows()
+	return eu
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (eu *EnvironmentUpdate) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentUpdate {
+	eu.mutation.SetPreviewBaseEnvironmentID(v)
+	return eu
+}
+
+// SetNillablePreviewBaseEnvironmentID sets the "preview_base_environment_id" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillablePreviewBaseEnvironmentID(v *uuid.UUID) *EnvironmentUpdate {
+	if v != nil {
+		eu.SetPreviewBaseEnvironmentID(*v)
+	}
+	return eu
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (eu *EnvironmentUpdate) ClearPreviewBaseEnvironmentID() *EnvironmentUpdate {
+	eu.mutation.ClearPreviewBaseEnvironmentID()
+	return eu
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (eu *EnvironmentUpdate) SetPreviewRepository(v string) *EnvironmentUpdate {
+	eu.mutation.SetPreviewRepository(v)
+	return eu
+}
+
+// SetNillablePreviewRepository sets the "preview_repository" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillablePreviewRepository(v *string) *EnvironmentUpdate {
+	if v != nil {
+		eu.SetPreviewRepository(*v)
+	}
+	return eu
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (eu *EnvironmentUpdate) ClearPreviewRepository() *EnvironmentUpdate {
+	eu.mutation.ClearPreviewRepository()
+	return eu
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (eu *EnvironmentUpdate) SetPreviewPullRequest(v int) *EnvironmentUpdate {
+	eu.mutation.ResetPreviewPullRequest()
+	eu.mutation.SetPreviewPullRequest(v)
+	return eu
+}
+
+// SetNillablePreviewPullRequest sets the "preview_pull_request" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillablePreviewPullRequest(v *int) *EnvironmentUpdate {
+	if v != nil {
+		eu.SetPreviewPullRequest(*v)
+	}
+	return eu
+}
+
+// AddPreviewPullRequest adds value to the "preview_pull_request" field.
+func (eu *EnvironmentUpdate) AddPreviewPullRequest(v int) *EnvironmentUpdate {
+	eu.mutation.AddPreviewPullRequest(v)
+	return eu
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (eu *EnvironmentUpdate) ClearPreviewPullRequest() *EnvironmentUpdate {
+	eu.mutation.ClearPreviewPullRequest()
+	return eu
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (eu *EnvironmentUpdate) SetPreviewCommentID(v int64) *EnvironmentUpdate {
+	eu.mutation.ResetPreviewCommentID()
+	eu.mutation.SetPreviewCommentID(v)
+	return eu
+}
+
+// SetNillablePreviewCommentID sets the "preview_comment_id" field if the given value is not nil.
+func (eu *EnvironmentUpdate) SetNillablePreviewCommentID(v *int64) *EnvironmentUpdate {
+	if v != nil {
+		eu.SetPreviewCommentID(*v)
+	}
+	return eu
+}
+
+// AddPreviewCommentID adds value to the "preview_comment_id" field.
+func (eu *EnvironmentUpdate) AddPreviewCommentID(v int64) *EnvironmentUpdate {
+	eu.mutation.AddPreviewCommentID(v)
+	return eu
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (eu *EnvironmentUpdate) ClearPreviewCommentID() *EnvironmentUpdate {
+	eu.mutation.ClearPreviewCommentID()
+	return eu
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (eu *EnvironmentUpdate) SetProject(p *Project) *EnvironmentUpdate {
 	return eu.SetProjectID(p.ID)
@@ -341,6 +469,50 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := eu.mutation.KubernetesSecret(); ok {
 		_spec.SetField(environment.FieldKubernetesSecret, field.TypeString, value)
 	}
+	if value, ok := eu.mutation.Protected(); ok {
+		_spec.SetField(environment.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := eu.mutation.FreezeWindows(); ok {
+		_spec.SetField(environment.FieldFreezeWindows, field.TypeJSON, value)
+	}
+	if value, ok := eu.mutation.AppendedFreezeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, environment.FieldFreezeWindows, value)
+		})
+	}
+	if eu.mutation.FreezeWindowsCleared() {
+		_spec.ClearField(environment.FieldFreezeWindows, field.TypeJSON)
+	}
+	if value, ok := eu.mutation.PreviewBaseEnvironmentID(); ok {
+		_spec.SetField(environment.FieldPreviewBaseEnvironmentID, field.TypeUUID, value)
+	}
+	if eu.mutation.PreviewBaseEnvironmentIDCleared() {
+		_spec.ClearField(environment.FieldPreviewBaseEnvironmentID, field.TypeUUID)
+	}
+	if value, ok := eu.mutation.PreviewRepository(); ok {
+		_spec.SetField(environment.FieldPreviewRepository, field.TypeString, value)
+	}
+	if eu.mutation.PreviewRepositoryCleared() {
+		_spec.ClearField(environment.FieldPreviewRepository, field.TypeString)
+	}
+	if value, ok := eu.mutation.PreviewPullRequest(); ok {
+		_spec.SetField(environment.FieldPreviewPullRequest, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedPreviewPullRequest(); ok {
+		_spec.AddField(environment.FieldPreviewPullRequest, field.TypeInt, value)
+	}
+	if eu.mutation.PreviewPullRequestCleared() {
+		_spec.ClearField(environment.FieldPreviewPullRequest, field.TypeInt)
+	}
+	if value, ok := eu.mutation.PreviewCommentID(); ok {
+		_spec.SetField(environment.FieldPreviewCommentID, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.AddedPreviewCommentID(); ok {
+		_spec.AddField(environment.FieldPreviewCommentID, field.TypeInt64, value)
+	}
+	if eu.mutation.PreviewCommentIDCleared() {
+		_spec.ClearField(environment.FieldPreviewCommentID, field.TypeInt64)
+	}
 	if eu.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -623,6 +795,132 @@ func (euo *EnvironmentUpdateOne) SetNillableKubernetesSecret(s *string) *Environ
 	return euo
 }
 
+// SetProtected sets the "protected" field.
+func (euo *EnvironmentUpdateOne) SetProtected(v bool) *EnvironmentUpdateOne {
+	euo.mutation.SetProtected(v)
+	return euo
+}
+
+// SetNillableProtected sets the "protected" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillableProtected(v *bool) *EnvironmentUpdateOne {
+	if v != nil {
+		euo.SetProtected(*v)
+	}
+	return euo
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (euo *EnvironmentUpdateOne) SetFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpdateOne {
+	euo.mutation.SetFreezeWindows(v)
+	return euo
+}
+
+// AppendFreezeWindows appends value to the "freeze_windows" field.
+func (euo *EnvironmentUpdateOne) AppendFreezeWindows(v []schema.FreezeWindow) *EnvironmentUpdateOne {
+	euo.mutation.AppendFreezeWindows(v)
+	return euo
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (euo *EnvironmentUpdateOne) ClearFreezeWindows() *EnvironmentUpdateOne {
+	euo.mutation.ClearFreezeWindows()
+	return euo
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (euo *EnvironmentUpdateOne) SetPreviewBaseEnvironmentID(v uuid.UUID) *EnvironmentUpdateOne {
+	euo.mutation.SetPreviewBaseEnvironmentID(v)
+	return euo
+}
+
+// SetNillablePreviewBaseEnvironmentID sets the "preview_base_environment_id" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillablePreviewBaseEnvironmentID(v *uuid.UUID) *EnvironmentUpdateOne {
+	if v != nil {
+		euo.SetPreviewBaseEnvironmentID(*v)
+	}
+	return euo
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (euo *EnvironmentUpdateOne) ClearPreviewBaseEnvironmentID() *EnvironmentUpdateOne {
+	euo.mutation.ClearPreviewBaseEnvironmentID()
+	return euo
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (euo *EnvironmentUpdateOne) SetPreviewRepository(v string) *EnvironmentUpdateOne {
+	euo.mutation.SetPreviewRepository(v)
+	return euo
+}
+
+// SetNillablePreviewRepository sets the "preview_repository" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillablePreviewRepository(v *string) *EnvironmentUpdateOne {
+	if v != nil {
+		euo.SetPreviewRepository(*v)
+	}
+	return euo
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (euo *EnvironmentUpdateOne) ClearPreviewRepository() *EnvironmentUpdateOne {
+	euo.mutation.ClearPreviewRepository()
+	return euo
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (euo *EnvironmentUpdateOne) SetPreviewPullRequest(v int) *EnvironmentUpdateOne {
+	euo.mutation.ResetPreviewPullRequest()
+	euo.mutation.SetPreviewPullRequest(v)
+	return euo
+}
+
+// SetNillablePreviewPullRequest sets the "preview_pull_request" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillablePreviewPullRequest(v *int) *EnvironmentUpdateOne {
+	if v != nil {
+		euo.SetPreviewPullRequest(*v)
+	}
+	return euo
+}
+
+// AddPreviewPullRequest adds value to the "preview_pull_request" field.
+func (euo *EnvironmentUpdateOne) AddPreviewPullRequest(v int) *EnvironmentUpdateOne {
+	euo.mutation.AddPreviewPullRequest(v)
+	return euo
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (euo *EnvironmentUpdateOne) ClearPreviewPullRequest() *EnvironmentUpdateOne {
+	euo.mutation.ClearPreviewPullRequest()
+	return euo
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (euo *EnvironmentUpdateOne) SetPreviewCommentID(v int64) *EnvironmentUpdateOne {
+	euo.mutation.ResetPreviewCommentID()
+	euo.mutation.SetPreviewCommentID(v)
+	return euo
+}
+
+// SetNillablePreviewCommentID sets the "preview_comment_id" field if the given value is not nil.
+func (euo *EnvironmentUpdateOne) SetNillablePreviewCommentID(v *int64) *EnvironmentUpdateOne {
+	if v != nil {
+		euo.SetPreviewCommentID(*v)
+	}
+	return euo
+}
+
+// AddPreviewCommentID adds value to the "preview_comment_id" field.
+func (euo *EnvironmentUpdateOne) AddPreviewCommentID(v int64) *EnvironmentUpdateOne {
+	euo.mutation.AddPreviewCommentID(v)
+	return euo
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (euo *EnvironmentUpdateOne) ClearPreviewCommentID() *EnvironmentUpdateOne {
+	euo.mutation.ClearPreviewCommentID()
+	return euo
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (euo *EnvironmentUpdateOne) SetProject(p *Project) *EnvironmentUpdateOne {
 	return euo.SetProjectID(p.ID)
@@ -865,6 +1163,50 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 	if value, ok := euo.mutation.KubernetesSecret(); ok {
 		_spec.SetField(environment.FieldKubernetesSecret, field.TypeString, value)
 	}
+	if value, ok := euo.mutation.Protected(); ok {
+		_spec.SetField(environment.FieldProtected, field.TypeBool, value)
+	}
+	if value, ok := euo.mutation.FreezeWindows(); ok {
+		_spec.SetField(environment.FieldFreezeWindows, field.TypeJSON, value)
+	}
+	if value, ok := euo.mutation.AppendedFreezeWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, environment.FieldFreezeWindows, value)
+		})
+	}
+	if euo.mutation.FreezeWindowsCleared() {
+		_spec.ClearField(environment.FieldFreezeWindows, field.TypeJSON)
+	}
+	if value, ok := euo.mutation.PreviewBaseEnvironmentID(); ok {
+		_spec.SetField(environment.FieldPreviewBaseEnvironmentID, field.TypeUUID, value)
+	}
+	if euo.mutation.PreviewBaseEnvironmentIDCleared() {
+		_spec.ClearField(environment.FieldPreviewBaseEnvironmentID, field.TypeUUID)
+	}
+	if value, ok := euo.mutation.PreviewRepository(); ok {
+		_spec.SetField(environment.FieldPreviewRepository, field.TypeString, value)
+	}
+	if euo.mutation.PreviewRepositoryCleared() {
+		_spec.ClearField(environment.FieldPreviewRepository, field.TypeString)
+	}
+	if value, ok := euo.mutation.PreviewPullRequest(); ok {
+		_spec.SetField(environment.FieldPreviewPullRequest, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedPreviewPullRequest(); ok {
+		_spec.AddField(environment.FieldPreviewPullRequest, field.TypeInt, value)
+	}
+	if euo.mutation.PreviewPullRequestCleared() {
+		_spec.ClearField(environment.FieldPreviewPullRequest, field.TypeInt)
+	}
+	if value, ok := euo.mutation.PreviewCommentID(); ok {
+		_spec.SetField(environment.FieldPreviewCommentID, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.AddedPreviewCommentID(); ok {
+		_spec.AddField(environment.FieldPreviewCommentID, field.TypeInt64, value)
+	}
+	if euo.mutation.PreviewCommentIDCleared() {
+		_spec.ClearField(environment.FieldPreviewCommentID, field.TypeInt64)
+	}
 	if euo.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
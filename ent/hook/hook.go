@@ -69,6 +69,18 @@ func (f GithubInstallationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GithubInstallationMutation", m)
 }
 
+// The GitlabConnectionFunc type is an adapter to allow the use of ordinary
+// function as GitlabConnection mutator.
+type GitlabConnectionFunc func(context.Context, *ent.GitlabConnectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GitlabConnectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GitlabConnectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GitlabConnectionMutation", m)
+}
+
 // The GroupFunc type is an adapter to allow the use of ordinary
 // function as Group mutator.
 type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
@@ -177,6 +189,18 @@ func (f S3Func) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.S3Mutation", m)
 }
 
+// The SBOMPackageFunc type is an adapter to allow the use of ordinary
+// function as SBOMPackage mutator.
+type SBOMPackageFunc func(context.Context, *ent.SBOMPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SBOMPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SBOMPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SBOMPackageMutation", m)
+}
+
 // The ServiceFunc type is an adapter to allow the use of ordinary
 // function as Service mutator.
 type ServiceFunc func(context.Context, *ent.ServiceMutation) (ent.Value, error)
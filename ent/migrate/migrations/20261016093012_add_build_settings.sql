@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "system_settings" table
+ALTER TABLE "system_settings" ADD COLUMN "build_settings" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "system_settings" table
+ALTER TABLE "system_settings" DROP COLUMN "build_settings";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "rollback_reason" character varying NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "auto_rollback" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "auto_rollback";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "rollback_reason";
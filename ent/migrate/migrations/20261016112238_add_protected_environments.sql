@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "environments" table
+ALTER TABLE "environments" ADD COLUMN "protected" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "environments" table
+ALTER TABLE "environments" DROP COLUMN "protected";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "environments" table
+ALTER TABLE "environments" ADD COLUMN "freeze_windows" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "environments" table
+ALTER TABLE "environments" DROP COLUMN "freeze_windows";
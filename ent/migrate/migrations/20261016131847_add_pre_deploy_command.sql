@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "pre_deploy_command" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "pre_deploy_command";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "scheduled_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "scheduled_at";
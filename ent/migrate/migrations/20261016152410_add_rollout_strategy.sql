@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "rollout_strategy" character varying NOT NULL DEFAULT 'rolling', ADD COLUMN "canary_weight" bigint NOT NULL DEFAULT 10;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "canary_weight", DROP COLUMN "rollout_strategy";
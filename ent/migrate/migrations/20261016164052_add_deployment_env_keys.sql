@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "env_keys" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "env_keys";
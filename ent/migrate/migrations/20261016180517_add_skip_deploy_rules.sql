@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "skip_reason" character varying NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "skip_deploy_marker" character varying NULL, ADD COLUMN "ignored_commit_authors" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "ignored_commit_authors", DROP COLUMN "skip_deploy_marker";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "skip_reason";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "github_check_run_id" bigint NULL, ADD COLUMN "github_check_status" character varying NULL, ADD COLUMN "github_check_concluded" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "github_check_concluded", DROP COLUMN "github_check_status", DROP COLUMN "github_check_run_id";
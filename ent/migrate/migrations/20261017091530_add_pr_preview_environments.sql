@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "environments" table
+ALTER TABLE "environments" ADD COLUMN "preview_base_environment_id" uuid NULL, ADD COLUMN "preview_repository" character varying NULL, ADD COLUMN "preview_pull_request" bigint NULL, ADD COLUMN "preview_comment_id" bigint NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "pr_previews" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "pr_previews";
+-- reverse: modify "environments" table
+ALTER TABLE "environments" DROP COLUMN "preview_comment_id", DROP COLUMN "preview_pull_request", DROP COLUMN "preview_repository", DROP COLUMN "preview_base_environment_id";
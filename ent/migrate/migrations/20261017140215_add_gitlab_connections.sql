@@ -0,0 +1,29 @@
+-- +goose Up
+-- create "gitlab_connections" table
+CREATE TABLE "gitlab_connections" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "url" character varying NOT NULL DEFAULT 'https://gitlab.com',
+  "auth_type" character varying NOT NULL,
+  "account_id" bigint NOT NULL,
+  "account_username" character varying NOT NULL,
+  "access_token" character varying NOT NULL,
+  "refresh_token" character varying NULL,
+  "token_expires_at" timestamptz NULL,
+  "client_id" character varying NULL,
+  "client_secret" character varying NULL,
+  "webhook_secret" character varying NOT NULL,
+  "created_by" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "gitlab_connections_users_gitlab_connections" FOREIGN KEY ("created_by") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- modify "services" table
+ALTER TABLE "services" ADD COLUMN "gitlab_connection_id" uuid NULL, ADD CONSTRAINT "services_gitlab_connections_services" FOREIGN KEY ("gitlab_connection_id") REFERENCES "gitlab_connections" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+
+-- +goose Down
+-- reverse: modify "services" table
+ALTER TABLE "services" DROP CONSTRAINT "services_gitlab_connections_services", DROP COLUMN "gitlab_connection_id";
+-- reverse: create "gitlab_connections" table
+DROP TABLE "gitlab_connections";
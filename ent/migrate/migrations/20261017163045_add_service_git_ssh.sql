@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "services" table
+ALTER TABLE "services" ADD COLUMN "git_url" character varying NULL, ADD COLUMN "git_deploy_public_key" character varying NULL, ADD COLUMN "git_ssh_host_key" character varying NULL, ADD COLUMN "git_webhook_secret" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "services" table
+ALTER TABLE "services" DROP COLUMN "git_webhook_secret", DROP COLUMN "git_ssh_host_key", DROP COLUMN "git_deploy_public_key", DROP COLUMN "git_url";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "services" table
+ALTER TABLE "services" ADD COLUMN "deploy_hook_token" character varying NULL;
+-- create index "services_deploy_hook_token_key" to table: "services"
+CREATE UNIQUE INDEX "services_deploy_hook_token_key" ON "services" ("deploy_hook_token");
+
+-- +goose Down
+-- reverse: create index "services_deploy_hook_token_key" to table: "services"
+DROP INDEX "services_deploy_hook_token_key";
+-- reverse: modify "services" table
+ALTER TABLE "services" DROP COLUMN "deploy_hook_token";
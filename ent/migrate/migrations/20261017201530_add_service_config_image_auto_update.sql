@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "image_auto_update" boolean NOT NULL DEFAULT false, ADD COLUMN "image_digest" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "image_digest", DROP COLUMN "image_auto_update";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "source_archive" character varying NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "upload_s3_bucket" character varying NULL, ADD COLUMN "upload_s3_source_id" uuid NULL, ADD CONSTRAINT "service_configs_s3_sources_service_upload_source" FOREIGN KEY ("upload_s3_source_id") REFERENCES "s3_sources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP CONSTRAINT "service_configs_s3_sources_service_upload_source", DROP COLUMN "upload_s3_source_id", DROP COLUMN "upload_s3_bucket";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "source_archive";
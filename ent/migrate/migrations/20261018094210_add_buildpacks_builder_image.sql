@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "buildpacks_builder_image" character varying NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "buildpacks_builder_image" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "buildpacks_builder_image";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "buildpacks_builder_image";
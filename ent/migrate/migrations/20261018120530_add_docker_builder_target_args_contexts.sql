@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "docker_builder_target" character varying NULL, ADD COLUMN "docker_builder_build_args" jsonb NULL, ADD COLUMN "docker_builder_build_contexts" jsonb NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "docker_builder_target" character varying NULL, ADD COLUMN "docker_builder_build_args" jsonb NULL, ADD COLUMN "docker_builder_build_contexts" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "docker_builder_build_contexts", DROP COLUMN "docker_builder_build_args", DROP COLUMN "docker_builder_target";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "docker_builder_build_contexts", DROP COLUMN "docker_builder_build_args", DROP COLUMN "docker_builder_target";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "deployments" table
+ALTER TABLE "deployments" ADD COLUMN "platforms" jsonb NULL, ADD COLUMN "platform_builds" jsonb NULL;
+-- modify "service_configs" table
+ALTER TABLE "service_configs" ADD COLUMN "platforms" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "service_configs" table
+ALTER TABLE "service_configs" DROP COLUMN "platforms";
+-- reverse: modify "deployments" table
+ALTER TABLE "deployments" DROP COLUMN "platform_builds", DROP COLUMN "platforms";
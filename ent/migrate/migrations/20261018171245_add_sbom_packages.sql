@@ -0,0 +1,26 @@
+-- +goose Up
+-- create "sbom_packages" table
+CREATE TABLE "sbom_packages" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "version" character varying NOT NULL DEFAULT '',
+  "type" character varying NOT NULL,
+  "purl" character varying NOT NULL,
+  "deployment_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sbom_packages_deployments_sbom_packages" FOREIGN KEY ("deployment_id") REFERENCES "deployments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "sbompackage_deployment_id_purl" to table: "sbom_packages"
+CREATE UNIQUE INDEX "sbompackage_deployment_id_purl" ON "sbom_packages" ("deployment_id", "purl");
+-- create index "sbompackage_name_version" to table: "sbom_packages"
+CREATE INDEX "sbompackage_name_version" ON "sbom_packages" ("name", "version");
+
+-- +goose Down
+-- reverse: create index "sbompackage_name_version" to table: "sbom_packages"
+DROP INDEX "sbompackage_name_version";
+-- reverse: create index "sbompackage_deployment_id_purl" to table: "sbom_packages"
+DROP INDEX "sbompackage_deployment_id_purl";
+-- reverse: create "sbom_packages" table
+DROP TABLE "sbom_packages";
@@ -0,0 +1,17 @@
+-- +goose Up
+-- modify "services" table
+ALTER TABLE "services"
+RENAME COLUMN "deploy_hook_token" TO "deploy_hook_token_hash";
+-- rename index "services_deploy_hook_token_key" to "services_deploy_hook_token_hash_key"
+ALTER INDEX "services_deploy_hook_token_key" RENAME TO "services_deploy_hook_token_hash_key";
+-- existing deploy hook URLs keep working, only their hash is stored
+UPDATE "services" SET "deploy_hook_token_hash" = encode(sha256(convert_to("deploy_hook_token_hash", 'UTF8')), 'hex') WHERE "deploy_hook_token_hash" IS NOT NULL;
+
+-- +goose Down
+-- hashed tokens can't be restored, revoke the deploy hooks
+UPDATE "services" SET "deploy_hook_token_hash" = NULL;
+-- reverse: rename index "services_deploy_hook_token_key" to "services_deploy_hook_token_hash_key"
+ALTER INDEX "services_deploy_hook_token_hash_key" RENAME TO "services_deploy_hook_token_key";
+-- reverse: modify "services" table
+ALTER TABLE "services"
+RENAME COLUMN "deploy_hook_token_hash" TO "deploy_hook_token";
@@ -25,13 +25,15 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "active", "launching", "launch-error", "crashing", "removed"}},
-		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "git"}, Default: "manual"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"awaiting-approval", "scheduled", "build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "skipped", "staged", "promoting", "aborted", "active", "launching", "launch-error", "crashing", "removed"}},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "git", "deploy-hook", "image-update", "upload"}, Default: "manual"},
 		{Name: "error", Type: field.TypeString, Nullable: true},
 		{Name: "commit_sha", Type: field.TypeString, Nullable: true},
 		{Name: "commit_message", Type: field.TypeString, Nullable: true},
 		{Name: "git_branch", Type: field.TypeString, Nullable: true},
 		{Name: "commit_author", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_archive", Type: field.TypeString, Nullable: true},
+		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "queued_at", Type: field.TypeTime, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
@@ -40,12 +42,24 @@ var (
 		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "image", Type: field.TypeString, Nullable: true},
 		{Name: "resource_definition", Type: field.TypeJSON, Nullable: true},
-		{Name: "builder", Type: field.TypeEnum, Enums: []string{"railpack", "docker", "database"}},
+		{Name: "env_keys", Type: field.TypeJSON, Nullable: true},
+		{Name: "builder", Type: field.TypeEnum, Enums: []string{"railpack", "docker", "database", "buildpacks"}},
 		{Name: "railpack_builder_install_command", Type: field.TypeString, Nullable: true},
 		{Name: "railpack_builder_build_command", Type: field.TypeString, Nullable: true},
 		{Name: "run_command", Type: field.TypeString, Nullable: true},
 		{Name: "docker_builder_dockerfile_path", Type: field.TypeString, Nullable: true},
 		{Name: "docker_builder_build_context", Type: field.TypeString, Nullable: true},
+		{Name: "docker_builder_target", Type: field.TypeString, Nullable: true},
+		{Name: "docker_builder_build_args", Type: field.TypeJSON, Nullable: true},
+		{Name: "docker_builder_build_contexts", Type: field.TypeJSON, Nullable: true},
+		{Name: "buildpacks_builder_image", Type: field.TypeString, Nullable: true},
+		{Name: "platforms", Type: field.TypeJSON, Nullable: true},
+		{Name: "platform_builds", Type: field.TypeJSON, Nullable: true},
+		{Name: "rollback_reason", Type: field.TypeString, Nullable: true},
+		{Name: "skip_reason", Type: field.TypeString, Nullable: true},
+		{Name: "github_check_run_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "github_check_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"awaiting-approval", "scheduled", "build-pending", "build-queued", "build-running", "build-succeeded", "build-cancelled", "build-failed", "skipped", "staged", "promoting", "aborted", "active", "launching", "launch-error", "crashing", "removed"}},
+		{Name: "github_check_concluded", Type: field.TypeBool, Default: false},
 		{Name: "service_id", Type: field.TypeUUID},
 	}
 	// DeploymentsTable holds the schema information for the "deployments" table.
@@ -56,7 +70,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "deployments_services_deployments",
-				Columns:    []*schema.Column{DeploymentsColumns[24]},
+				Columns:    []*schema.Column{DeploymentsColumns[38]},
 				RefColumns: []*schema.Column{ServicesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -65,7 +79,7 @@ var (
 			{
 				Name:    "deployment_service_id",
 				Unique:  false,
-				Columns: []*schema.Column{DeploymentsColumns[24]},
+				Columns: []*schema.Column{DeploymentsColumns[38]},
 			},
 			{
 				Name:    "deployment_created_at",
@@ -75,12 +89,12 @@ var (
 			{
 				Name:    "deployment_service_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{DeploymentsColumns[24], DeploymentsColumns[1]},
+				Columns: []*schema.Column{DeploymentsColumns[38], DeploymentsColumns[1]},
 			},
 			{
 				Name:    "deployment_service_id_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{DeploymentsColumns[24], DeploymentsColumns[3], DeploymentsColumns[1]},
+				Columns: []*schema.Column{DeploymentsColumns[38], DeploymentsColumns[3], DeploymentsColumns[1]},
 			},
 		},
 	}
@@ -94,6 +108,12 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "kubernetes_secret", Type: field.TypeString},
+		{Name: "protected", Type: field.TypeBool, Default: false},
+		{Name: "freeze_windows", Type: field.TypeJSON, Nullable: true},
+		{Name: "preview_base_environment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "preview_repository", Type: field.TypeString, Nullable: true},
+		{Name: "preview_pull_request", Type: field.TypeInt, Nullable: true},
+		{Name: "preview_comment_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "project_id", Type: field.TypeUUID},
 	}
 	// EnvironmentsTable holds the schema information for the "environments" table.
@@ -104,7 +124,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "environments_projects_environments",
-				Columns:    []*schema.Column{EnvironmentsColumns[8]},
+				Columns:    []*schema.Column{EnvironmentsColumns[14]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -167,6 +187,38 @@ var (
 			},
 		},
 	}
+	// GitlabConnectionsColumns holds the columns for the "gitlab_connections" table.
+	GitlabConnectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Default: "https://gitlab.com"},
+		{Name: "auth_type", Type: field.TypeEnum, Enums: []string{"oauth", "token"}},
+		{Name: "account_id", Type: field.TypeInt64},
+		{Name: "account_username", Type: field.TypeString},
+		{Name: "access_token", Type: field.TypeString},
+		{Name: "refresh_token", Type: field.TypeString, Nullable: true},
+		{Name: "token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "webhook_secret", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeUUID},
+	}
+	// GitlabConnectionsTable holds the schema information for the "gitlab_connections" table.
+	GitlabConnectionsTable = &schema.Table{
+		Name:       "gitlab_connections",
+		Columns:    GitlabConnectionsColumns,
+		PrimaryKey: []*schema.Column{GitlabConnectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "gitlab_connections_users_gitlab_connections",
+				Columns:    []*schema.Column{GitlabConnectionsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -353,24 +405,67 @@ var (
 			},
 		},
 	}
+	// SbomPackagesColumns holds the columns for the "sbom_packages" table.
+	SbomPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString, Default: ""},
+		{Name: "type", Type: field.TypeString},
+		{Name: "purl", Type: field.TypeString},
+		{Name: "deployment_id", Type: field.TypeUUID},
+	}
+	// SbomPackagesTable holds the schema information for the "sbom_packages" table.
+	SbomPackagesTable = &schema.Table{
+		Name:       "sbom_packages",
+		Columns:    SbomPackagesColumns,
+		PrimaryKey: []*schema.Column{SbomPackagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sbom_packages_deployments_sbom_packages",
+				Columns:    []*schema.Column{SbomPackagesColumns[7]},
+				RefColumns: []*schema.Column{DeploymentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sbompackage_deployment_id_purl",
+				Unique:  true,
+				Columns: []*schema.Column{SbomPackagesColumns[7], SbomPackagesColumns[6]},
+			},
+			{
+				Name:    "sbompackage_name_version",
+				Unique:  false,
+				Columns: []*schema.Column{SbomPackagesColumns[3], SbomPackagesColumns[4]},
+			},
+		},
+	}
 	// ServicesColumns holds the columns for the "services" table.
 	ServicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"github", "docker-image", "database"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"github", "gitlab", "git", "docker-image", "database", "upload"}},
 		{Name: "kubernetes_name", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "detected_ports", Type: field.TypeJSON, Nullable: true},
 		{Name: "database", Type: field.TypeString, Nullable: true},
 		{Name: "database_version", Type: field.TypeString, Nullable: true},
+		{Name: "git_url", Type: field.TypeString, Nullable: true},
+		{Name: "git_deploy_public_key", Type: field.TypeString, Nullable: true},
+		{Name: "git_ssh_host_key", Type: field.TypeString, Nullable: true},
+		{Name: "git_webhook_secret", Type: field.TypeString, Nullable: true},
 		{Name: "git_repository_owner", Type: field.TypeString, Nullable: true},
 		{Name: "git_repository", Type: field.TypeString, Nullable: true},
 		{Name: "kubernetes_secret", Type: field.TypeString},
 		{Name: "template_instance_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "deploy_hook_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "environment_id", Type: field.TypeUUID},
 		{Name: "github_installation_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "gitlab_connection_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "current_deployment_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "service_group_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "template_id", Type: field.TypeUUID, Nullable: true},
@@ -383,31 +478,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "services_environments_services",
-				Columns:    []*schema.Column{ServicesColumns[14]},
+				Columns:    []*schema.Column{ServicesColumns[19]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "services_github_installations_services",
-				Columns:    []*schema.Column{ServicesColumns[15]},
+				Columns:    []*schema.Column{ServicesColumns[20]},
 				RefColumns: []*schema.Column{GithubInstallationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "services_gitlab_connections_services",
+				Columns:    []*schema.Column{ServicesColumns[21]},
+				RefColumns: []*schema.Column{GitlabConnectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "services_deployments_current_deployment",
-				Columns:    []*schema.Column{ServicesColumns[16]},
+				Columns:    []*schema.Column{ServicesColumns[22]},
 				RefColumns: []*schema.Column{DeploymentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "services_service_groups_services",
-				Columns:    []*schema.Column{ServicesColumns[17]},
+				Columns:    []*schema.Column{ServicesColumns[23]},
 				RefColumns: []*schema.Column{ServiceGroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "services_templates_services",
-				Columns:    []*schema.Column{ServicesColumns[18]},
+				Columns:    []*schema.Column{ServicesColumns[24]},
 				RefColumns: []*schema.Column{TemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -416,12 +517,12 @@ var (
 			{
 				Name:    "service_environment_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ServicesColumns[14], ServicesColumns[1]},
+				Columns: []*schema.Column{ServicesColumns[19], ServicesColumns[1]},
 			},
 			{
 				Name:    "service_service_group_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ServicesColumns[17], ServicesColumns[1]},
+				Columns: []*schema.Column{ServicesColumns[23], ServicesColumns[1]},
 			},
 			{
 				Name:    "service_created_at",
@@ -435,28 +536,45 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "builder", Type: field.TypeEnum, Enums: []string{"railpack", "docker", "database"}},
+		{Name: "builder", Type: field.TypeEnum, Enums: []string{"railpack", "docker", "database", "buildpacks"}},
 		{Name: "icon", Type: field.TypeString},
 		{Name: "docker_builder_dockerfile_path", Type: field.TypeString, Nullable: true},
 		{Name: "docker_builder_build_context", Type: field.TypeString, Nullable: true},
+		{Name: "docker_builder_target", Type: field.TypeString, Nullable: true},
+		{Name: "docker_builder_build_args", Type: field.TypeJSON, Nullable: true},
+		{Name: "docker_builder_build_contexts", Type: field.TypeJSON, Nullable: true},
+		{Name: "buildpacks_builder_image", Type: field.TypeString, Nullable: true},
+		{Name: "platforms", Type: field.TypeJSON, Nullable: true},
 		{Name: "railpack_provider", Type: field.TypeEnum, Nullable: true, Enums: []string{"node", "deno", "bun", "go", "java", "php", "python", "ruby", "rust", "elixir", "staticfile", "dotnet", "cpp", "gleam", "shell", "unknown"}},
 		{Name: "railpack_framework", Type: field.TypeEnum, Nullable: true, Enums: []string{"next", "nuxt", "astro", "vite", "cra", "angular", "remix", "tanstack-start", "react-router", "bun", "static", "sveltekit", "svelte", "solid", "hono", "express", "django", "flask", "fastapi", "fasthtml", "gin", "spring-boot", "laravel", "rails", "rocket", "unknown"}},
 		{Name: "git_branch", Type: field.TypeString, Nullable: true},
 		{Name: "git_tag", Type: field.TypeString, Nullable: true},
+		{Name: "watch_paths", Type: field.TypeJSON, Nullable: true},
+		{Name: "skip_deploy_marker", Type: field.TypeString, Nullable: true},
+		{Name: "ignored_commit_authors", Type: field.TypeJSON, Nullable: true},
+		{Name: "pr_preview_variables", Type: field.TypeJSON, Nullable: true},
 		{Name: "hosts", Type: field.TypeJSON, Nullable: true},
 		{Name: "ports", Type: field.TypeJSON, Nullable: true},
 		{Name: "replicas", Type: field.TypeInt32, Default: 1},
 		{Name: "auto_deploy", Type: field.TypeBool, Default: false},
+		{Name: "auto_rollback", Type: field.TypeBool, Default: false},
+		{Name: "pr_previews", Type: field.TypeBool, Default: false},
 		{Name: "railpack_builder_install_command", Type: field.TypeString, Nullable: true},
 		{Name: "railpack_builder_build_command", Type: field.TypeString, Nullable: true},
 		{Name: "run_command", Type: field.TypeString, Nullable: true},
+		{Name: "pre_deploy_command", Type: field.TypeString, Nullable: true},
+		{Name: "rollout_strategy", Type: field.TypeEnum, Enums: []string{"rolling", "blue-green", "canary"}, Default: "rolling"},
+		{Name: "canary_weight", Type: field.TypeInt, Default: 10},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
 		{Name: "image", Type: field.TypeString, Nullable: true},
+		{Name: "image_auto_update", Type: field.TypeBool, Default: false},
+		{Name: "image_digest", Type: field.TypeString, Nullable: true},
 		{Name: "definition_version", Type: field.TypeString, Nullable: true},
 		{Name: "database_config", Type: field.TypeJSON, Nullable: true},
 		{Name: "s3_backup_bucket", Type: field.TypeString, Nullable: true},
 		{Name: "backup_schedule", Type: field.TypeString, Default: "5 5 * * *"},
 		{Name: "backup_retention_count", Type: field.TypeInt, Default: 3},
+		{Name: "upload_s3_bucket", Type: field.TypeString, Nullable: true},
 		{Name: "volumes", Type: field.TypeJSON, Nullable: true},
 		{Name: "security_context", Type: field.TypeJSON, Nullable: true},
 		{Name: "health_check", Type: field.TypeJSON, Nullable: true},
@@ -465,6 +583,7 @@ var (
 		{Name: "init_containers", Type: field.TypeJSON, Nullable: true},
 		{Name: "resources", Type: field.TypeJSON, Nullable: true},
 		{Name: "s3_backup_source_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "upload_s3_source_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "service_id", Type: field.TypeUUID, Unique: true},
 	}
 	// ServiceConfigsTable holds the schema information for the "service_configs" table.
@@ -475,13 +594,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "service_configs_s3_sources_service_backup_source",
-				Columns:    []*schema.Column{ServiceConfigsColumns[32]},
+				Columns:    []*schema.Column{ServiceConfigsColumns[49]},
+				RefColumns: []*schema.Column{S3SourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "service_configs_s3_sources_service_upload_source",
+				Columns:    []*schema.Column{ServiceConfigsColumns[50]},
 				RefColumns: []*schema.Column{S3SourcesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "service_configs_services_service_config",
-				Columns:    []*schema.Column{ServiceConfigsColumns[33]},
+				Columns:    []*schema.Column{ServiceConfigsColumns[51]},
 				RefColumns: []*schema.Column{ServicesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -518,6 +643,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "wildcard_base_url", Type: field.TypeString, Nullable: true},
 		{Name: "buildkit_settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "build_settings", Type: field.TypeJSON, Nullable: true},
 	}
 	// SystemSettingsTable holds the schema information for the "system_settings" table.
 	SystemSettingsTable = &schema.Table{
@@ -744,6 +870,7 @@ var (
 		EnvironmentsTable,
 		GithubAppsTable,
 		GithubInstallationsTable,
+		GitlabConnectionsTable,
 		GroupsTable,
 		JwtKeysTable,
 		Oauth2CodesTable,
@@ -753,6 +880,7 @@ var (
 		ProjectsTable,
 		RegistriesTable,
 		S3SourcesTable,
+		SbomPackagesTable,
 		ServicesTable,
 		ServiceConfigsTable,
 		ServiceGroupsTable,
@@ -788,6 +916,10 @@ func init() {
 	GithubInstallationsTable.Annotation = &entsql.Annotation{
 		Table: "github_installations",
 	}
+	GitlabConnectionsTable.ForeignKeys[0].RefTable = UsersTable
+	GitlabConnectionsTable.Annotation = &entsql.Annotation{
+		Table: "gitlab_connections",
+	}
 	GroupsTable.Annotation = &entsql.Annotation{
 		Table: "groups",
 	}
@@ -820,16 +952,22 @@ func init() {
 	S3SourcesTable.Annotation = &entsql.Annotation{
 		Table: "s3_sources",
 	}
+	SbomPackagesTable.ForeignKeys[0].RefTable = DeploymentsTable
+	SbomPackagesTable.Annotation = &entsql.Annotation{
+		Table: "sbom_packages",
+	}
 	ServicesTable.ForeignKeys[0].RefTable = EnvironmentsTable
 	ServicesTable.ForeignKeys[1].RefTable = GithubInstallationsTable
-	ServicesTable.ForeignKeys[2].RefTable = DeploymentsTable
-	ServicesTable.ForeignKeys[3].RefTable = ServiceGroupsTable
-	ServicesTable.ForeignKeys[4].RefTable = TemplatesTable
+	ServicesTable.ForeignKeys[2].RefTable = GitlabConnectionsTable
+	ServicesTable.ForeignKeys[3].RefTable = DeploymentsTable
+	ServicesTable.ForeignKeys[4].RefTable = ServiceGroupsTable
+	ServicesTable.ForeignKeys[5].RefTable = TemplatesTable
 	ServicesTable.Annotation = &entsql.Annotation{
 		Table: "services",
 	}
 	ServiceConfigsTable.ForeignKeys[0].RefTable = S3SourcesTable
-	ServiceConfigsTable.ForeignKeys[1].RefTable = ServicesTable
+	ServiceConfigsTable.ForeignKeys[1].RefTable = S3SourcesTable
+	ServiceConfigsTable.ForeignKeys[2].RefTable = ServicesTable
 	ServiceConfigsTable.Annotation = &entsql.Annotation{
 		Table: "service_configs",
 	}
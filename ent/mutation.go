@@ -17,6 +17,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/jwtkey"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
@@ -27,6 +28,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/pvcmetadata"
 	"github.com/unbindapp/unbind-api/ent/registry"
 	"github.com/unbindapp/unbind-api/ent/s3"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -55,6 +57,7 @@ const (
 	TypeEnvironment        = "Environment"
 	TypeGithubApp          = "GithubApp"
 	TypeGithubInstallation = "GithubInstallation"
+	TypeGitlabConnection   = "GitlabConnection"
 	TypeGroup              = "Group"
 	TypeJWTKey             = "JWTKey"
 	TypeOauth2Code         = "Oauth2Code"
@@ -64,6 +67,7 @@ const (
 	TypeProject            = "Project"
 	TypeRegistry           = "Registry"
 	TypeS3                 = "S3"
+	TypeSBOMPackage        = "SBOMPackage"
 	TypeService            = "Service"
 	TypeServiceConfig      = "ServiceConfig"
 	TypeServiceGroup       = "ServiceGroup"
@@ -416,6 +420,8 @@ type DeploymentMutation struct {
 	commit_message                   *string
 	git_branch                       *string
 	commit_author                    **schema.GitCommitter
+	source_archive                   *string
+	scheduled_at                     *time.Time
 	queued_at                        *time.Time
 	started_at                       *time.Time
 	completed_at                     *time.Time
@@ -425,15 +431,34 @@ type DeploymentMutation struct {
 	addattempts                      *int
 	image                            *string
 	resource_definition              **v1.Service
+	env_keys                         *[]string
+	appendenv_keys                   []string
 	builder                          *schema.ServiceBuilder
 	railpack_builder_install_command *string
 	railpack_builder_build_command   *string
 	run_command                      *string
 	docker_builder_dockerfile_path   *string
 	docker_builder_build_context     *string
+	docker_builder_target            *string
+	docker_builder_build_args        *map[string]string
+	docker_builder_build_contexts    *map[string]string
+	buildpacks_builder_image         *string
+	platforms                        *[]string
+	appendplatforms                  []string
+	platform_builds                  *[]schema.PlatformBuild
+	appendplatform_builds            []schema.PlatformBuild
+	rollback_reason                  *string
+	skip_reason                      *string
+	github_check_run_id              *int64
+	addgithub_check_run_id           *int64
+	github_check_status              *schema.DeploymentStatus
+	github_check_concluded           *bool
 	clearedFields                    map[string]struct{}
 	service                          *uuid.UUID
 	clearedservice                   bool
+	sbom_packages                    map[uuid.UUID]struct{}
+	removedsbom_packages             map[uuid.UUID]struct{}
+	clearedsbom_packages             bool
 	done                             bool
 	oldValue                         func(context.Context) (*Deployment, error)
 	predicates                       []predicate.Deployment
@@ -968,6 +993,104 @@ func (m *DeploymentMutation) ResetCommitAuthor() {
 	delete(m.clearedFields, deployment.FieldCommitAuthor)
 }
 
+// SetSourceArchive sets the "source_archive" field.
+func (m *DeploymentMutation) SetSourceArchive(s string) {
+	m.source_archive = &s
+}
+
+// SourceArchive returns the value of the "source_archive" field in the mutation.
+func (m *DeploymentMutation) SourceArchive() (r string, exists bool) {
+	v := m.source_archive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceArchive returns the old "source_archive" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldSourceArchive(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceArchive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceArchive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceArchive: %w", err)
+	}
+	return oldValue.SourceArchive, nil
+}
+
+// ClearSourceArchive clears the value of the "source_archive" field.
+func (m *DeploymentMutation) ClearSourceArchive() {
+	m.source_archive = nil
+	m.clearedFields[deployment.FieldSourceArchive] = struct{}{}
+}
+
+// SourceArchiveCleared returns if the "source_archive" field was cleared in this mutation.
+func (m *DeploymentMutation) SourceArchiveCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldSourceArchive]
+	return ok
+}
+
+// ResetSourceArchive resets all changes to the "source_archive" field.
+func (m *DeploymentMutation) ResetSourceArchive() {
+	m.source_archive = nil
+	delete(m.clearedFields, deployment.FieldSourceArchive)
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *DeploymentMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *DeploymentMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldScheduledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ClearScheduledAt clears the value of the "scheduled_at" field.
+func (m *DeploymentMutation) ClearScheduledAt() {
+	m.scheduled_at = nil
+	m.clearedFields[deployment.FieldScheduledAt] = struct{}{}
+}
+
+// ScheduledAtCleared returns if the "scheduled_at" field was cleared in this mutation.
+func (m *DeploymentMutation) ScheduledAtCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldScheduledAt]
+	return ok
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *DeploymentMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+	delete(m.clearedFields, deployment.FieldScheduledAt)
+}
+
 // SetQueuedAt sets the "queued_at" field.
 func (m *DeploymentMutation) SetQueuedAt(t time.Time) {
 	m.queued_at = &t
@@ -1367,6 +1490,71 @@ func (m *DeploymentMutation) ResetResourceDefinition() {
 	delete(m.clearedFields, deployment.FieldResourceDefinition)
 }
 
+// SetEnvKeys sets the "env_keys" field.
+func (m *DeploymentMutation) SetEnvKeys(s []string) {
+	m.env_keys = &s
+	m.appendenv_keys = nil
+}
+
+// EnvKeys returns the value of the "env_keys" field in the mutation.
+func (m *DeploymentMutation) EnvKeys() (r []string, exists bool) {
+	v := m.env_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvKeys returns the old "env_keys" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldEnvKeys(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvKeys: %w", err)
+	}
+	return oldValue.EnvKeys, nil
+}
+
+// AppendEnvKeys adds s to the "env_keys" field.
+func (m *DeploymentMutation) AppendEnvKeys(s []string) {
+	m.appendenv_keys = append(m.appendenv_keys, s...)
+}
+
+// AppendedEnvKeys returns the list of values that were appended to the "env_keys" field in this mutation.
+func (m *DeploymentMutation) AppendedEnvKeys() ([]string, bool) {
+	if len(m.appendenv_keys) == 0 {
+		return nil, false
+	}
+	return m.appendenv_keys, true
+}
+
+// ClearEnvKeys clears the value of the "env_keys" field.
+func (m *DeploymentMutation) ClearEnvKeys() {
+	m.env_keys = nil
+	m.appendenv_keys = nil
+	m.clearedFields[deployment.FieldEnvKeys] = struct{}{}
+}
+
+// EnvKeysCleared returns if the "env_keys" field was cleared in this mutation.
+func (m *DeploymentMutation) EnvKeysCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldEnvKeys]
+	return ok
+}
+
+// ResetEnvKeys resets all changes to the "env_keys" field.
+func (m *DeploymentMutation) ResetEnvKeys() {
+	m.env_keys = nil
+	m.appendenv_keys = nil
+	delete(m.clearedFields, deployment.FieldEnvKeys)
+}
+
 // SetBuilder sets the "builder" field.
 func (m *DeploymentMutation) SetBuilder(sb schema.ServiceBuilder) {
 	m.builder = &sb
@@ -1648,139 +1836,3890 @@ func (m *DeploymentMutation) ResetDockerBuilderBuildContext() {
 	delete(m.clearedFields, deployment.FieldDockerBuilderBuildContext)
 }
 
-// ClearService clears the "service" edge to the Service entity.
-func (m *DeploymentMutation) ClearService() {
-	m.clearedservice = true
-	m.clearedFields[deployment.FieldServiceID] = struct{}{}
-}
-
-// ServiceCleared reports if the "service" edge to the Service entity was cleared.
-func (m *DeploymentMutation) ServiceCleared() bool {
-	return m.clearedservice
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (m *DeploymentMutation) SetDockerBuilderTarget(s string) {
+	m.docker_builder_target = &s
 }
 
-// ServiceIDs returns the "service" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ServiceID instead. It exists only for internal usage by the builders.
-func (m *DeploymentMutation) ServiceIDs() (ids []uuid.UUID) {
-	if id := m.service; id != nil {
-		ids = append(ids, *id)
+// DockerBuilderTarget returns the value of the "docker_builder_target" field in the mutation.
+func (m *DeploymentMutation) DockerBuilderTarget() (r string, exists bool) {
+	v := m.docker_builder_target
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetService resets all changes to the "service" edge.
-func (m *DeploymentMutation) ResetService() {
-	m.service = nil
-	m.clearedservice = false
+// OldDockerBuilderTarget returns the old "docker_builder_target" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldDockerBuilderTarget(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerBuilderTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerBuilderTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerBuilderTarget: %w", err)
+	}
+	return oldValue.DockerBuilderTarget, nil
 }
 
-// Where appends a list predicates to the DeploymentMutation builder.
-func (m *DeploymentMutation) Where(ps ...predicate.Deployment) {
-	m.predicates = append(m.predicates, ps...)
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (m *DeploymentMutation) ClearDockerBuilderTarget() {
+	m.docker_builder_target = nil
+	m.clearedFields[deployment.FieldDockerBuilderTarget] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the DeploymentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeploymentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Deployment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// DockerBuilderTargetCleared returns if the "docker_builder_target" field was cleared in this mutation.
+func (m *DeploymentMutation) DockerBuilderTargetCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldDockerBuilderTarget]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *DeploymentMutation) Op() Op {
-	return m.op
+// ResetDockerBuilderTarget resets all changes to the "docker_builder_target" field.
+func (m *DeploymentMutation) ResetDockerBuilderTarget() {
+	m.docker_builder_target = nil
+	delete(m.clearedFields, deployment.FieldDockerBuilderTarget)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DeploymentMutation) SetOp(op Op) {
-	m.op = op
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (m *DeploymentMutation) SetDockerBuilderBuildArgs(value map[string]string) {
+	m.docker_builder_build_args = &value
 }
 
-// Type returns the node type of this mutation (Deployment).
-func (m *DeploymentMutation) Type() string {
-	return m.typ
+// DockerBuilderBuildArgs returns the value of the "docker_builder_build_args" field in the mutation.
+func (m *DeploymentMutation) DockerBuilderBuildArgs() (r map[string]string, exists bool) {
+	v := m.docker_builder_build_args
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DeploymentMutation) Fields() []string {
-	fields := make([]string, 0, 24)
-	if m.created_at != nil {
-		fields = append(fields, deployment.FieldCreatedAt)
+// OldDockerBuilderBuildArgs returns the old "docker_builder_build_args" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldDockerBuilderBuildArgs(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerBuilderBuildArgs is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, deployment.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerBuilderBuildArgs requires an ID field in the mutation")
 	}
-	if m.service != nil {
-		fields = append(fields, deployment.FieldServiceID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildArgs: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, deployment.FieldStatus)
+	return oldValue.DockerBuilderBuildArgs, nil
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (m *DeploymentMutation) ClearDockerBuilderBuildArgs() {
+	m.docker_builder_build_args = nil
+	m.clearedFields[deployment.FieldDockerBuilderBuildArgs] = struct{}{}
+}
+
+// DockerBuilderBuildArgsCleared returns if the "docker_builder_build_args" field was cleared in this mutation.
+func (m *DeploymentMutation) DockerBuilderBuildArgsCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldDockerBuilderBuildArgs]
+	return ok
+}
+
+// ResetDockerBuilderBuildArgs resets all changes to the "docker_builder_build_args" field.
+func (m *DeploymentMutation) ResetDockerBuilderBuildArgs() {
+	m.docker_builder_build_args = nil
+	delete(m.clearedFields, deployment.FieldDockerBuilderBuildArgs)
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (m *DeploymentMutation) SetDockerBuilderBuildContexts(value map[string]string) {
+	m.docker_builder_build_contexts = &value
+}
+
+// DockerBuilderBuildContexts returns the value of the "docker_builder_build_contexts" field in the mutation.
+func (m *DeploymentMutation) DockerBuilderBuildContexts() (r map[string]string, exists bool) {
+	v := m.docker_builder_build_contexts
+	if v == nil {
+		return
 	}
-	if m.source != nil {
-		fields = append(fields, deployment.FieldSource)
+	return *v, true
+}
+
+// OldDockerBuilderBuildContexts returns the old "docker_builder_build_contexts" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldDockerBuilderBuildContexts(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerBuilderBuildContexts is only allowed on UpdateOne operations")
 	}
-	if m.error != nil {
-		fields = append(fields, deployment.FieldError)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerBuilderBuildContexts requires an ID field in the mutation")
 	}
-	if m.commit_sha != nil {
-		fields = append(fields, deployment.FieldCommitSha)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildContexts: %w", err)
 	}
-	if m.commit_message != nil {
-		fields = append(fields, deployment.FieldCommitMessage)
+	return oldValue.DockerBuilderBuildContexts, nil
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (m *DeploymentMutation) ClearDockerBuilderBuildContexts() {
+	m.docker_builder_build_contexts = nil
+	m.clearedFields[deployment.FieldDockerBuilderBuildContexts] = struct{}{}
+}
+
+// DockerBuilderBuildContextsCleared returns if the "docker_builder_build_contexts" field was cleared in this mutation.
+func (m *DeploymentMutation) DockerBuilderBuildContextsCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldDockerBuilderBuildContexts]
+	return ok
+}
+
+// ResetDockerBuilderBuildContexts resets all changes to the "docker_builder_build_contexts" field.
+func (m *DeploymentMutation) ResetDockerBuilderBuildContexts() {
+	m.docker_builder_build_contexts = nil
+	delete(m.clearedFields, deployment.FieldDockerBuilderBuildContexts)
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (m *DeploymentMutation) SetBuildpacksBuilderImage(s string) {
+	m.buildpacks_builder_image = &s
+}
+
+// BuildpacksBuilderImage returns the value of the "buildpacks_builder_image" field in the mutation.
+func (m *DeploymentMutation) BuildpacksBuilderImage() (r string, exists bool) {
+	v := m.buildpacks_builder_image
+	if v == nil {
+		return
 	}
-	if m.git_branch != nil {
-		fields = append(fields, deployment.FieldGitBranch)
+	return *v, true
+}
+
+// OldBuildpacksBuilderImage returns the old "buildpacks_builder_image" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldBuildpacksBuilderImage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildpacksBuilderImage is only allowed on UpdateOne operations")
 	}
-	if m.commit_author != nil {
-		fields = append(fields, deployment.FieldCommitAuthor)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildpacksBuilderImage requires an ID field in the mutation")
 	}
-	if m.queued_at != nil {
-		fields = append(fields, deployment.FieldQueuedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildpacksBuilderImage: %w", err)
 	}
-	if m.started_at != nil {
-		fields = append(fields, deployment.FieldStartedAt)
+	return oldValue.BuildpacksBuilderImage, nil
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (m *DeploymentMutation) ClearBuildpacksBuilderImage() {
+	m.buildpacks_builder_image = nil
+	m.clearedFields[deployment.FieldBuildpacksBuilderImage] = struct{}{}
+}
+
+// BuildpacksBuilderImageCleared returns if the "buildpacks_builder_image" field was cleared in this mutation.
+func (m *DeploymentMutation) BuildpacksBuilderImageCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldBuildpacksBuilderImage]
+	return ok
+}
+
+// ResetBuildpacksBuilderImage resets all changes to the "buildpacks_builder_image" field.
+func (m *DeploymentMutation) ResetBuildpacksBuilderImage() {
+	m.buildpacks_builder_image = nil
+	delete(m.clearedFields, deployment.FieldBuildpacksBuilderImage)
+}
+
+// SetPlatforms sets the "platforms" field.
+func (m *DeploymentMutation) SetPlatforms(s []string) {
+	m.platforms = &s
+	m.appendplatforms = nil
+}
+
+// Platforms returns the value of the "platforms" field in the mutation.
+func (m *DeploymentMutation) Platforms() (r []string, exists bool) {
+	v := m.platforms
+	if v == nil {
+		return
 	}
-	if m.completed_at != nil {
-		fields = append(fields, deployment.FieldCompletedAt)
+	return *v, true
+}
+
+// OldPlatforms returns the old "platforms" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldPlatforms(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatforms is only allowed on UpdateOne operations")
 	}
-	if m.kubernetes_job_name != nil {
-		fields = append(fields, deployment.FieldKubernetesJobName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatforms requires an ID field in the mutation")
 	}
-	if m.kubernetes_job_status != nil {
-		fields = append(fields, deployment.FieldKubernetesJobStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatforms: %w", err)
 	}
-	if m.attempts != nil {
-		fields = append(fields, deployment.FieldAttempts)
+	return oldValue.Platforms, nil
+}
+
+// AppendPlatforms adds s to the "platforms" field.
+func (m *DeploymentMutation) AppendPlatforms(s []string) {
+	m.appendplatforms = append(m.appendplatforms, s...)
+}
+
+// AppendedPlatforms returns the list of values that were appended to the "platforms" field in this mutation.
+func (m *DeploymentMutation) AppendedPlatforms() ([]string, bool) {
+	if len(m.appendplatforms) == 0 {
+		return nil, false
 	}
-	if m.image != nil {
+	return m.appendplatforms, true
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (m *DeploymentMutation) ClearPlatforms() {
+	m.platforms = nil
+	m.appendplatforms = nil
+	m.clearedFields[deployment.FieldPlatforms] = struct{}{}
+}
+
+// PlatformsCleared returns if the "platforms" field was cleared in this mutation.
+func (m *DeploymentMutation) PlatformsCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldPlatforms]
+	return ok
+}
+
+// ResetPlatforms resets all changes to the "platforms" field.
+func (m *DeploymentMutation) ResetPlatforms() {
+	m.platforms = nil
+	m.appendplatforms = nil
+	delete(m.clearedFields, deployment.FieldPlatforms)
+}
+
+// SetPlatformBuilds sets the "platform_builds" field.
+func (m *DeploymentMutation) SetPlatformBuilds(sb []schema.PlatformBuild) {
+	m.platform_builds = &sb
+	m.appendplatform_builds = nil
+}
+
+// PlatformBuilds returns the value of the "platform_builds" field in the mutation.
+func (m *DeploymentMutation) PlatformBuilds() (r []schema.PlatformBuild, exists bool) {
+	v := m.platform_builds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatformBuilds returns the old "platform_builds" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldPlatformBuilds(ctx context.Context) (v []schema.PlatformBuild, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatformBuilds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatformBuilds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatformBuilds: %w", err)
+	}
+	return oldValue.PlatformBuilds, nil
+}
+
+// AppendPlatformBuilds adds sb to the "platform_builds" field.
+func (m *DeploymentMutation) AppendPlatformBuilds(sb []schema.PlatformBuild) {
+	m.appendplatform_builds = append(m.appendplatform_builds, sb...)
+}
+
+// AppendedPlatformBuilds returns the list of values that were appended to the "platform_builds" field in this mutation.
+func (m *DeploymentMutation) AppendedPlatformBuilds() ([]schema.PlatformBuild, bool) {
+	if len(m.appendplatform_builds) == 0 {
+		return nil, false
+	}
+	return m.appendplatform_builds, true
+}
+
+// ClearPlatformBuilds clears the value of the "platform_builds" field.
+func (m *DeploymentMutation) ClearPlatformBuilds() {
+	m.platform_builds = nil
+	m.appendplatform_builds = nil
+	m.clearedFields[deployment.FieldPlatformBuilds] = struct{}{}
+}
+
+// PlatformBuildsCleared returns if the "platform_builds" field was cleared in this mutation.
+func (m *DeploymentMutation) PlatformBuildsCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldPlatformBuilds]
+	return ok
+}
+
+// ResetPlatformBuilds resets all changes to the "platform_builds" field.
+func (m *DeploymentMutation) ResetPlatformBuilds() {
+	m.platform_builds = nil
+	m.appendplatform_builds = nil
+	delete(m.clearedFields, deployment.FieldPlatformBuilds)
+}
+
+// SetRollbackReason sets the "rollback_reason" field.
+func (m *DeploymentMutation) SetRollbackReason(s string) {
+	m.rollback_reason = &s
+}
+
+// RollbackReason returns the value of the "rollback_reason" field in the mutation.
+func (m *DeploymentMutation) RollbackReason() (r string, exists bool) {
+	v := m.rollback_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRollbackReason returns the old "rollback_reason" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldRollbackReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRollbackReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRollbackReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollbackReason: %w", err)
+	}
+	return oldValue.RollbackReason, nil
+}
+
+// ClearRollbackReason clears the value of the "rollback_reason" field.
+func (m *DeploymentMutation) ClearRollbackReason() {
+	m.rollback_reason = nil
+	m.clearedFields[deployment.FieldRollbackReason] = struct{}{}
+}
+
+// RollbackReasonCleared returns if the "rollback_reason" field was cleared in this mutation.
+func (m *DeploymentMutation) RollbackReasonCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldRollbackReason]
+	return ok
+}
+
+// ResetRollbackReason resets all changes to the "rollback_reason" field.
+func (m *DeploymentMutation) ResetRollbackReason() {
+	m.rollback_reason = nil
+	delete(m.clearedFields, deployment.FieldRollbackReason)
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (m *DeploymentMutation) SetSkipReason(s string) {
+	m.skip_reason = &s
+}
+
+// SkipReason returns the value of the "skip_reason" field in the mutation.
+func (m *DeploymentMutation) SkipReason() (r string, exists bool) {
+	v := m.skip_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipReason returns the old "skip_reason" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldSkipReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipReason: %w", err)
+	}
+	return oldValue.SkipReason, nil
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (m *DeploymentMutation) ClearSkipReason() {
+	m.skip_reason = nil
+	m.clearedFields[deployment.FieldSkipReason] = struct{}{}
+}
+
+// SkipReasonCleared returns if the "skip_reason" field was cleared in this mutation.
+func (m *DeploymentMutation) SkipReasonCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldSkipReason]
+	return ok
+}
+
+// ResetSkipReason resets all changes to the "skip_reason" field.
+func (m *DeploymentMutation) ResetSkipReason() {
+	m.skip_reason = nil
+	delete(m.clearedFields, deployment.FieldSkipReason)
+}
+
+// SetGithubCheckRunID sets the "github_check_run_id" field.
+func (m *DeploymentMutation) SetGithubCheckRunID(i int64) {
+	m.github_check_run_id = &i
+	m.addgithub_check_run_id = nil
+}
+
+// GithubCheckRunID returns the value of the "github_check_run_id" field in the mutation.
+func (m *DeploymentMutation) GithubCheckRunID() (r int64, exists bool) {
+	v := m.github_check_run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGithubCheckRunID returns the old "github_check_run_id" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldGithubCheckRunID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGithubCheckRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGithubCheckRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGithubCheckRunID: %w", err)
+	}
+	return oldValue.GithubCheckRunID, nil
+}
+
+// AddGithubCheckRunID adds i to the "github_check_run_id" field.
+func (m *DeploymentMutation) AddGithubCheckRunID(i int64) {
+	if m.addgithub_check_run_id != nil {
+		*m.addgithub_check_run_id += i
+	} else {
+		m.addgithub_check_run_id = &i
+	}
+}
+
+// AddedGithubCheckRunID returns the value that was added to the "github_check_run_id" field in this mutation.
+func (m *DeploymentMutation) AddedGithubCheckRunID() (r int64, exists bool) {
+	v := m.addgithub_check_run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGithubCheckRunID clears the value of the "github_check_run_id" field.
+func (m *DeploymentMutation) ClearGithubCheckRunID() {
+	m.github_check_run_id = nil
+	m.addgithub_check_run_id = nil
+	m.clearedFields[deployment.FieldGithubCheckRunID] = struct{}{}
+}
+
+// GithubCheckRunIDCleared returns if the "github_check_run_id" field was cleared in this mutation.
+func (m *DeploymentMutation) GithubCheckRunIDCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldGithubCheckRunID]
+	return ok
+}
+
+// ResetGithubCheckRunID resets all changes to the "github_check_run_id" field.
+func (m *DeploymentMutation) ResetGithubCheckRunID() {
+	m.github_check_run_id = nil
+	m.addgithub_check_run_id = nil
+	delete(m.clearedFields, deployment.FieldGithubCheckRunID)
+}
+
+// SetGithubCheckStatus sets the "github_check_status" field.
+func (m *DeploymentMutation) SetGithubCheckStatus(ss schema.DeploymentStatus) {
+	m.github_check_status = &ss
+}
+
+// GithubCheckStatus returns the value of the "github_check_status" field in the mutation.
+func (m *DeploymentMutation) GithubCheckStatus() (r schema.DeploymentStatus, exists bool) {
+	v := m.github_check_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGithubCheckStatus returns the old "github_check_status" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldGithubCheckStatus(ctx context.Context) (v *schema.DeploymentStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGithubCheckStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGithubCheckStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGithubCheckStatus: %w", err)
+	}
+	return oldValue.GithubCheckStatus, nil
+}
+
+// ClearGithubCheckStatus clears the value of the "github_check_status" field.
+func (m *DeploymentMutation) ClearGithubCheckStatus() {
+	m.github_check_status = nil
+	m.clearedFields[deployment.FieldGithubCheckStatus] = struct{}{}
+}
+
+// GithubCheckStatusCleared returns if the "github_check_status" field was cleared in this mutation.
+func (m *DeploymentMutation) GithubCheckStatusCleared() bool {
+	_, ok := m.clearedFields[deployment.FieldGithubCheckStatus]
+	return ok
+}
+
+// ResetGithubCheckStatus resets all changes to the "github_check_status" field.
+func (m *DeploymentMutation) ResetGithubCheckStatus() {
+	m.github_check_status = nil
+	delete(m.clearedFields, deployment.FieldGithubCheckStatus)
+}
+
+// SetGithubCheckConcluded sets the "github_check_concluded" field.
+func (m *DeploymentMutation) SetGithubCheckConcluded(b bool) {
+	m.github_check_concluded = &b
+}
+
+// GithubCheckConcluded returns the value of the "github_check_concluded" field in the mutation.
+func (m *DeploymentMutation) GithubCheckConcluded() (r bool, exists bool) {
+	v := m.github_check_concluded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGithubCheckConcluded returns the old "github_check_concluded" field's value of the Deployment entity.
+// If the Deployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeploymentMutation) OldGithubCheckConcluded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGithubCheckConcluded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGithubCheckConcluded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGithubCheckConcluded: %w", err)
+	}
+	return oldValue.GithubCheckConcluded, nil
+}
+
+// ResetGithubCheckConcluded resets all changes to the "github_check_concluded" field.
+func (m *DeploymentMutation) ResetGithubCheckConcluded() {
+	m.github_check_concluded = nil
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (m *DeploymentMutation) ClearService() {
+	m.clearedservice = true
+	m.clearedFields[deployment.FieldServiceID] = struct{}{}
+}
+
+// ServiceCleared reports if the "service" edge to the Service entity was cleared.
+func (m *DeploymentMutation) ServiceCleared() bool {
+	return m.clearedservice
+}
+
+// ServiceIDs returns the "service" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceID instead. It exists only for internal usage by the builders.
+func (m *DeploymentMutation) ServiceIDs() (ids []uuid.UUID) {
+	if id := m.service; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetService resets all changes to the "service" edge.
+func (m *DeploymentMutation) ResetService() {
+	m.service = nil
+	m.clearedservice = false
+}
+
+// AddSbomPackageIDs adds the "sbom_packages" edge to the SBOMPackage entity by ids.
+func (m *DeploymentMutation) AddSbomPackageIDs(ids ...uuid.UUID) {
+	if m.sbom_packages == nil {
+		m.sbom_packages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sbom_packages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSbomPackages clears the "sbom_packages" edge to the SBOMPackage entity.
+func (m *DeploymentMutation) ClearSbomPackages() {
+	m.clearedsbom_packages = true
+}
+
+// SbomPackagesCleared reports if the "sbom_packages" edge to the SBOMPackage entity was cleared.
+func (m *DeploymentMutation) SbomPackagesCleared() bool {
+	return m.clearedsbom_packages
+}
+
+// RemoveSbomPackageIDs removes the "sbom_packages" edge to the SBOMPackage entity by IDs.
+func (m *DeploymentMutation) RemoveSbomPackageIDs(ids ...uuid.UUID) {
+	if m.removedsbom_packages == nil {
+		m.removedsbom_packages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sbom_packages, ids[i])
+		m.removedsbom_packages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSbomPackages returns the removed IDs of the "sbom_packages" edge to the SBOMPackage entity.
+func (m *DeploymentMutation) RemovedSbomPackagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsbom_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SbomPackagesIDs returns the "sbom_packages" edge IDs in the mutation.
+func (m *DeploymentMutation) SbomPackagesIDs() (ids []uuid.UUID) {
+	for id := range m.sbom_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSbomPackages resets all changes to the "sbom_packages" edge.
+func (m *DeploymentMutation) ResetSbomPackages() {
+	m.sbom_packages = nil
+	m.clearedsbom_packages = false
+	m.removedsbom_packages = nil
+}
+
+// Where appends a list predicates to the DeploymentMutation builder.
+func (m *DeploymentMutation) Where(ps ...predicate.Deployment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeploymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeploymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Deployment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeploymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeploymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Deployment).
+func (m *DeploymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeploymentMutation) Fields() []string {
+	fields := make([]string, 0, 38)
+	if m.created_at != nil {
+		fields = append(fields, deployment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deployment.FieldUpdatedAt)
+	}
+	if m.service != nil {
+		fields = append(fields, deployment.FieldServiceID)
+	}
+	if m.status != nil {
+		fields = append(fields, deployment.FieldStatus)
+	}
+	if m.source != nil {
+		fields = append(fields, deployment.FieldSource)
+	}
+	if m.error != nil {
+		fields = append(fields, deployment.FieldError)
+	}
+	if m.commit_sha != nil {
+		fields = append(fields, deployment.FieldCommitSha)
+	}
+	if m.commit_message != nil {
+		fields = append(fields, deployment.FieldCommitMessage)
+	}
+	if m.git_branch != nil {
+		fields = append(fields, deployment.FieldGitBranch)
+	}
+	if m.commit_author != nil {
+		fields = append(fields, deployment.FieldCommitAuthor)
+	}
+	if m.source_archive != nil {
+		fields = append(fields, deployment.FieldSourceArchive)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, deployment.FieldScheduledAt)
+	}
+	if m.queued_at != nil {
+		fields = append(fields, deployment.FieldQueuedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, deployment.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, deployment.FieldCompletedAt)
+	}
+	if m.kubernetes_job_name != nil {
+		fields = append(fields, deployment.FieldKubernetesJobName)
+	}
+	if m.kubernetes_job_status != nil {
+		fields = append(fields, deployment.FieldKubernetesJobStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, deployment.FieldAttempts)
+	}
+	if m.image != nil {
+		fields = append(fields, deployment.FieldImage)
+	}
+	if m.resource_definition != nil {
+		fields = append(fields, deployment.FieldResourceDefinition)
+	}
+	if m.env_keys != nil {
+		fields = append(fields, deployment.FieldEnvKeys)
+	}
+	if m.builder != nil {
+		fields = append(fields, deployment.FieldBuilder)
+	}
+	if m.railpack_builder_install_command != nil {
+		fields = append(fields, deployment.FieldRailpackBuilderInstallCommand)
+	}
+	if m.railpack_builder_build_command != nil {
+		fields = append(fields, deployment.FieldRailpackBuilderBuildCommand)
+	}
+	if m.run_command != nil {
+		fields = append(fields, deployment.FieldRunCommand)
+	}
+	if m.docker_builder_dockerfile_path != nil {
+		fields = append(fields, deployment.FieldDockerBuilderDockerfilePath)
+	}
+	if m.docker_builder_build_context != nil {
+		fields = append(fields, deployment.FieldDockerBuilderBuildContext)
+	}
+	if m.docker_builder_target != nil {
+		fields = append(fields, deployment.FieldDockerBuilderTarget)
+	}
+	if m.docker_builder_build_args != nil {
+		fields = append(fields, deployment.FieldDockerBuilderBuildArgs)
+	}
+	if m.docker_builder_build_contexts != nil {
+		fields = append(fields, deployment.FieldDockerBuilderBuildContexts)
+	}
+	if m.buildpacks_builder_image != nil {
+		fields = append(fields, deployment.FieldBuildpacksBuilderImage)
+	}
+	if m.platforms != nil {
+		fields = append(fields, deployment.FieldPlatforms)
+	}
+	if m.platform_builds != nil {
+		fields = append(fields, deployment.FieldPlatformBuilds)
+	}
+	if m.rollback_reason != nil {
+		fields = append(fields, deployment.FieldRollbackReason)
+	}
+	if m.skip_reason != nil {
+		fields = append(fields, deployment.FieldSkipReason)
+	}
+	if m.github_check_run_id != nil {
+		fields = append(fields, deployment.FieldGithubCheckRunID)
+	}
+	if m.github_check_status != nil {
+		fields = append(fields, deployment.FieldGithubCheckStatus)
+	}
+	if m.github_check_concluded != nil {
+		fields = append(fields, deployment.FieldGithubCheckConcluded)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeploymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deployment.FieldCreatedAt:
+		return m.CreatedAt()
+	case deployment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case deployment.FieldServiceID:
+		return m.ServiceID()
+	case deployment.FieldStatus:
+		return m.Status()
+	case deployment.FieldSource:
+		return m.Source()
+	case deployment.FieldError:
+		return m.Error()
+	case deployment.FieldCommitSha:
+		return m.CommitSha()
+	case deployment.FieldCommitMessage:
+		return m.CommitMessage()
+	case deployment.FieldGitBranch:
+		return m.GitBranch()
+	case deployment.FieldCommitAuthor:
+		return m.CommitAuthor()
+	case deployment.FieldSourceArchive:
+		return m.SourceArchive()
+	case deployment.FieldScheduledAt:
+		return m.ScheduledAt()
+	case deployment.FieldQueuedAt:
+		return m.QueuedAt()
+	case deployment.FieldStartedAt:
+		return m.StartedAt()
+	case deployment.FieldCompletedAt:
+		return m.CompletedAt()
+	case deployment.FieldKubernetesJobName:
+		return m.KubernetesJobName()
+	case deployment.FieldKubernetesJobStatus:
+		return m.KubernetesJobStatus()
+	case deployment.FieldAttempts:
+		return m.Attempts()
+	case deployment.FieldImage:
+		return m.Image()
+	case deployment.FieldResourceDefinition:
+		return m.ResourceDefinition()
+	case deployment.FieldEnvKeys:
+		return m.EnvKeys()
+	case deployment.FieldBuilder:
+		return m.Builder()
+	case deployment.FieldRailpackBuilderInstallCommand:
+		return m.RailpackBuilderInstallCommand()
+	case deployment.FieldRailpackBuilderBuildCommand:
+		return m.RailpackBuilderBuildCommand()
+	case deployment.FieldRunCommand:
+		return m.RunCommand()
+	case deployment.FieldDockerBuilderDockerfilePath:
+		return m.DockerBuilderDockerfilePath()
+	case deployment.FieldDockerBuilderBuildContext:
+		return m.DockerBuilderBuildContext()
+	case deployment.FieldDockerBuilderTarget:
+		return m.DockerBuilderTarget()
+	case deployment.FieldDockerBuilderBuildArgs:
+		return m.DockerBuilderBuildArgs()
+	case deployment.FieldDockerBuilderBuildContexts:
+		return m.DockerBuilderBuildContexts()
+	case deployment.FieldBuildpacksBuilderImage:
+		return m.BuildpacksBuilderImage()
+	case deployment.FieldPlatforms:
+		return m.Platforms()
+	case deployment.FieldPlatformBuilds:
+		return m.PlatformBuilds()
+	case deployment.FieldRollbackReason:
+		return m.RollbackReason()
+	case deployment.FieldSkipReason:
+		return m.SkipReason()
+	case deployment.FieldGithubCheckRunID:
+		return m.GithubCheckRunID()
+	case deployment.FieldGithubCheckStatus:
+		return m.GithubCheckStatus()
+	case deployment.FieldGithubCheckConcluded:
+		return m.GithubCheckConcluded()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeploymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deployment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deployment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case deployment.FieldServiceID:
+		return m.OldServiceID(ctx)
+	case deployment.FieldStatus:
+		return m.OldStatus(ctx)
+	case deployment.FieldSource:
+		return m.OldSource(ctx)
+	case deployment.FieldError:
+		return m.OldError(ctx)
+	case deployment.FieldCommitSha:
+		return m.OldCommitSha(ctx)
+	case deployment.FieldCommitMessage:
+		return m.OldCommitMessage(ctx)
+	case deployment.FieldGitBranch:
+		return m.OldGitBranch(ctx)
+	case deployment.FieldCommitAuthor:
+		return m.OldCommitAuthor(ctx)
+	case deployment.FieldSourceArchive:
+		return m.OldSourceArchive(ctx)
+	case deployment.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case deployment.FieldQueuedAt:
+		return m.OldQueuedAt(ctx)
+	case deployment.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case deployment.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case deployment.FieldKubernetesJobName:
+		return m.OldKubernetesJobName(ctx)
+	case deployment.FieldKubernetesJobStatus:
+		return m.OldKubernetesJobStatus(ctx)
+	case deployment.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case deployment.FieldImage:
+		return m.OldImage(ctx)
+	case deployment.FieldResourceDefinition:
+		return m.OldResourceDefinition(ctx)
+	case deployment.FieldEnvKeys:
+		return m.OldEnvKeys(ctx)
+	case deployment.FieldBuilder:
+		return m.OldBuilder(ctx)
+	case deployment.FieldRailpackBuilderInstallCommand:
+		return m.OldRailpackBuilderInstallCommand(ctx)
+	case deployment.FieldRailpackBuilderBuildCommand:
+		return m.OldRailpackBuilderBuildCommand(ctx)
+	case deployment.FieldRunCommand:
+		return m.OldRunCommand(ctx)
+	case deployment.FieldDockerBuilderDockerfilePath:
+		return m.OldDockerBuilderDockerfilePath(ctx)
+	case deployment.FieldDockerBuilderBuildContext:
+		return m.OldDockerBuilderBuildContext(ctx)
+	case deployment.FieldDockerBuilderTarget:
+		return m.OldDockerBuilderTarget(ctx)
+	case deployment.FieldDockerBuilderBuildArgs:
+		return m.OldDockerBuilderBuildArgs(ctx)
+	case deployment.FieldDockerBuilderBuildContexts:
+		return m.OldDockerBuilderBuildContexts(ctx)
+	case deployment.FieldBuildpacksBuilderImage:
+		return m.OldBuildpacksBuilderImage(ctx)
+	case deployment.FieldPlatforms:
+		return m.OldPlatforms(ctx)
+	case deployment.FieldPlatformBuilds:
+		return m.OldPlatformBuilds(ctx)
+	case deployment.FieldRollbackReason:
+		return m.OldRollbackReason(ctx)
+	case deployment.FieldSkipReason:
+		return m.OldSkipReason(ctx)
+	case deployment.FieldGithubCheckRunID:
+		return m.OldGithubCheckRunID(ctx)
+	case deployment.FieldGithubCheckStatus:
+		return m.OldGithubCheckStatus(ctx)
+	case deployment.FieldGithubCheckConcluded:
+		return m.OldGithubCheckConcluded(ctx)
+	}
+	return nil, fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deployment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deployment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case deployment.FieldServiceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceID(v)
+		return nil
+	case deployment.FieldStatus:
+		v, ok := value.(schema.DeploymentStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deployment.FieldSource:
+		v, ok := value.(schema.DeploymentSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case deployment.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case deployment.FieldCommitSha:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitSha(v)
+		return nil
+	case deployment.FieldCommitMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitMessage(v)
+		return nil
+	case deployment.FieldGitBranch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitBranch(v)
+		return nil
+	case deployment.FieldCommitAuthor:
+		v, ok := value.(*schema.GitCommitter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitAuthor(v)
+		return nil
+	case deployment.FieldSourceArchive:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceArchive(v)
+		return nil
+	case deployment.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case deployment.FieldQueuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuedAt(v)
+		return nil
+	case deployment.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case deployment.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case deployment.FieldKubernetesJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesJobName(v)
+		return nil
+	case deployment.FieldKubernetesJobStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesJobStatus(v)
+		return nil
+	case deployment.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case deployment.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case deployment.FieldResourceDefinition:
+		v, ok := value.(*v1.Service)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceDefinition(v)
+		return nil
+	case deployment.FieldEnvKeys:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvKeys(v)
+		return nil
+	case deployment.FieldBuilder:
+		v, ok := value.(schema.ServiceBuilder)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuilder(v)
+		return nil
+	case deployment.FieldRailpackBuilderInstallCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRailpackBuilderInstallCommand(v)
+		return nil
+	case deployment.FieldRailpackBuilderBuildCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRailpackBuilderBuildCommand(v)
+		return nil
+	case deployment.FieldRunCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunCommand(v)
+		return nil
+	case deployment.FieldDockerBuilderDockerfilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderDockerfilePath(v)
+		return nil
+	case deployment.FieldDockerBuilderBuildContext:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderBuildContext(v)
+		return nil
+	case deployment.FieldDockerBuilderTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderTarget(v)
+		return nil
+	case deployment.FieldDockerBuilderBuildArgs:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderBuildArgs(v)
+		return nil
+	case deployment.FieldDockerBuilderBuildContexts:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderBuildContexts(v)
+		return nil
+	case deployment.FieldBuildpacksBuilderImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildpacksBuilderImage(v)
+		return nil
+	case deployment.FieldPlatforms:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatforms(v)
+		return nil
+	case deployment.FieldPlatformBuilds:
+		v, ok := value.([]schema.PlatformBuild)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatformBuilds(v)
+		return nil
+	case deployment.FieldRollbackReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollbackReason(v)
+		return nil
+	case deployment.FieldSkipReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipReason(v)
+		return nil
+	case deployment.FieldGithubCheckRunID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGithubCheckRunID(v)
+		return nil
+	case deployment.FieldGithubCheckStatus:
+		v, ok := value.(schema.DeploymentStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGithubCheckStatus(v)
+		return nil
+	case deployment.FieldGithubCheckConcluded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGithubCheckConcluded(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeploymentMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, deployment.FieldAttempts)
+	}
+	if m.addgithub_check_run_id != nil {
+		fields = append(fields, deployment.FieldGithubCheckRunID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeploymentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deployment.FieldAttempts:
+		return m.AddedAttempts()
+	case deployment.FieldGithubCheckRunID:
+		return m.AddedGithubCheckRunID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeploymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deployment.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case deployment.FieldGithubCheckRunID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGithubCheckRunID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeploymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deployment.FieldError) {
+		fields = append(fields, deployment.FieldError)
+	}
+	if m.FieldCleared(deployment.FieldCommitSha) {
+		fields = append(fields, deployment.FieldCommitSha)
+	}
+	if m.FieldCleared(deployment.FieldCommitMessage) {
+		fields = append(fields, deployment.FieldCommitMessage)
+	}
+	if m.FieldCleared(deployment.FieldGitBranch) {
+		fields = append(fields, deployment.FieldGitBranch)
+	}
+	if m.FieldCleared(deployment.FieldCommitAuthor) {
+		fields = append(fields, deployment.FieldCommitAuthor)
+	}
+	if m.FieldCleared(deployment.FieldSourceArchive) {
+		fields = append(fields, deployment.FieldSourceArchive)
+	}
+	if m.FieldCleared(deployment.FieldScheduledAt) {
+		fields = append(fields, deployment.FieldScheduledAt)
+	}
+	if m.FieldCleared(deployment.FieldQueuedAt) {
+		fields = append(fields, deployment.FieldQueuedAt)
+	}
+	if m.FieldCleared(deployment.FieldStartedAt) {
+		fields = append(fields, deployment.FieldStartedAt)
+	}
+	if m.FieldCleared(deployment.FieldCompletedAt) {
+		fields = append(fields, deployment.FieldCompletedAt)
+	}
+	if m.FieldCleared(deployment.FieldKubernetesJobName) {
+		fields = append(fields, deployment.FieldKubernetesJobName)
+	}
+	if m.FieldCleared(deployment.FieldKubernetesJobStatus) {
+		fields = append(fields, deployment.FieldKubernetesJobStatus)
+	}
+	if m.FieldCleared(deployment.FieldImage) {
 		fields = append(fields, deployment.FieldImage)
 	}
-	if m.resource_definition != nil {
-		fields = append(fields, deployment.FieldResourceDefinition)
+	if m.FieldCleared(deployment.FieldResourceDefinition) {
+		fields = append(fields, deployment.FieldResourceDefinition)
+	}
+	if m.FieldCleared(deployment.FieldEnvKeys) {
+		fields = append(fields, deployment.FieldEnvKeys)
+	}
+	if m.FieldCleared(deployment.FieldRailpackBuilderInstallCommand) {
+		fields = append(fields, deployment.FieldRailpackBuilderInstallCommand)
+	}
+	if m.FieldCleared(deployment.FieldRailpackBuilderBuildCommand) {
+		fields = append(fields, deployment.FieldRailpackBuilderBuildCommand)
+	}
+	if m.FieldCleared(deployment.FieldRunCommand) {
+		fields = append(fields, deployment.FieldRunCommand)
+	}
+	if m.FieldCleared(deployment.FieldDockerBuilderDockerfilePath) {
+		fields = append(fields, deployment.FieldDockerBuilderDockerfilePath)
+	}
+	if m.FieldCleared(deployment.FieldDockerBuilderBuildContext) {
+		fields = append(fields, deployment.FieldDockerBuilderBuildContext)
+	}
+	if m.FieldCleared(deployment.FieldDockerBuilderTarget) {
+		fields = append(fields, deployment.FieldDockerBuilderTarget)
+	}
+	if m.FieldCleared(deployment.FieldDockerBuilderBuildArgs) {
+		fields = append(fields, deployment.FieldDockerBuilderBuildArgs)
+	}
+	if m.FieldCleared(deployment.FieldDockerBuilderBuildContexts) {
+		fields = append(fields, deployment.FieldDockerBuilderBuildContexts)
+	}
+	if m.FieldCleared(deployment.FieldBuildpacksBuilderImage) {
+		fields = append(fields, deployment.FieldBuildpacksBuilderImage)
+	}
+	if m.FieldCleared(deployment.FieldPlatforms) {
+		fields = append(fields, deployment.FieldPlatforms)
+	}
+	if m.FieldCleared(deployment.FieldPlatformBuilds) {
+		fields = append(fields, deployment.FieldPlatformBuilds)
+	}
+	if m.FieldCleared(deployment.FieldRollbackReason) {
+		fields = append(fields, deployment.FieldRollbackReason)
+	}
+	if m.FieldCleared(deployment.FieldSkipReason) {
+		fields = append(fields, deployment.FieldSkipReason)
+	}
+	if m.FieldCleared(deployment.FieldGithubCheckRunID) {
+		fields = append(fields, deployment.FieldGithubCheckRunID)
+	}
+	if m.FieldCleared(deployment.FieldGithubCheckStatus) {
+		fields = append(fields, deployment.FieldGithubCheckStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeploymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeploymentMutation) ClearField(name string) error {
+	switch name {
+	case deployment.FieldError:
+		m.ClearError()
+		return nil
+	case deployment.FieldCommitSha:
+		m.ClearCommitSha()
+		return nil
+	case deployment.FieldCommitMessage:
+		m.ClearCommitMessage()
+		return nil
+	case deployment.FieldGitBranch:
+		m.ClearGitBranch()
+		return nil
+	case deployment.FieldCommitAuthor:
+		m.ClearCommitAuthor()
+		return nil
+	case deployment.FieldSourceArchive:
+		m.ClearSourceArchive()
+		return nil
+	case deployment.FieldScheduledAt:
+		m.ClearScheduledAt()
+		return nil
+	case deployment.FieldQueuedAt:
+		m.ClearQueuedAt()
+		return nil
+	case deployment.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case deployment.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case deployment.FieldKubernetesJobName:
+		m.ClearKubernetesJobName()
+		return nil
+	case deployment.FieldKubernetesJobStatus:
+		m.ClearKubernetesJobStatus()
+		return nil
+	case deployment.FieldImage:
+		m.ClearImage()
+		return nil
+	case deployment.FieldResourceDefinition:
+		m.ClearResourceDefinition()
+		return nil
+	case deployment.FieldEnvKeys:
+		m.ClearEnvKeys()
+		return nil
+	case deployment.FieldRailpackBuilderInstallCommand:
+		m.ClearRailpackBuilderInstallCommand()
+		return nil
+	case deployment.FieldRailpackBuilderBuildCommand:
+		m.ClearRailpackBuilderBuildCommand()
+		return nil
+	case deployment.FieldRunCommand:
+		m.ClearRunCommand()
+		return nil
+	case deployment.FieldDockerBuilderDockerfilePath:
+		m.ClearDockerBuilderDockerfilePath()
+		return nil
+	case deployment.FieldDockerBuilderBuildContext:
+		m.ClearDockerBuilderBuildContext()
+		return nil
+	case deployment.FieldDockerBuilderTarget:
+		m.ClearDockerBuilderTarget()
+		return nil
+	case deployment.FieldDockerBuilderBuildArgs:
+		m.ClearDockerBuilderBuildArgs()
+		return nil
+	case deployment.FieldDockerBuilderBuildContexts:
+		m.ClearDockerBuilderBuildContexts()
+		return nil
+	case deployment.FieldBuildpacksBuilderImage:
+		m.ClearBuildpacksBuilderImage()
+		return nil
+	case deployment.FieldPlatforms:
+		m.ClearPlatforms()
+		return nil
+	case deployment.FieldPlatformBuilds:
+		m.ClearPlatformBuilds()
+		return nil
+	case deployment.FieldRollbackReason:
+		m.ClearRollbackReason()
+		return nil
+	case deployment.FieldSkipReason:
+		m.ClearSkipReason()
+		return nil
+	case deployment.FieldGithubCheckRunID:
+		m.ClearGithubCheckRunID()
+		return nil
+	case deployment.FieldGithubCheckStatus:
+		m.ClearGithubCheckStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeploymentMutation) ResetField(name string) error {
+	switch name {
+	case deployment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deployment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case deployment.FieldServiceID:
+		m.ResetServiceID()
+		return nil
+	case deployment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deployment.FieldSource:
+		m.ResetSource()
+		return nil
+	case deployment.FieldError:
+		m.ResetError()
+		return nil
+	case deployment.FieldCommitSha:
+		m.ResetCommitSha()
+		return nil
+	case deployment.FieldCommitMessage:
+		m.ResetCommitMessage()
+		return nil
+	case deployment.FieldGitBranch:
+		m.ResetGitBranch()
+		return nil
+	case deployment.FieldCommitAuthor:
+		m.ResetCommitAuthor()
+		return nil
+	case deployment.FieldSourceArchive:
+		m.ResetSourceArchive()
+		return nil
+	case deployment.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case deployment.FieldQueuedAt:
+		m.ResetQueuedAt()
+		return nil
+	case deployment.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case deployment.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case deployment.FieldKubernetesJobName:
+		m.ResetKubernetesJobName()
+		return nil
+	case deployment.FieldKubernetesJobStatus:
+		m.ResetKubernetesJobStatus()
+		return nil
+	case deployment.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case deployment.FieldImage:
+		m.ResetImage()
+		return nil
+	case deployment.FieldResourceDefinition:
+		m.ResetResourceDefinition()
+		return nil
+	case deployment.FieldEnvKeys:
+		m.ResetEnvKeys()
+		return nil
+	case deployment.FieldBuilder:
+		m.ResetBuilder()
+		return nil
+	case deployment.FieldRailpackBuilderInstallCommand:
+		m.ResetRailpackBuilderInstallCommand()
+		return nil
+	case deployment.FieldRailpackBuilderBuildCommand:
+		m.ResetRailpackBuilderBuildCommand()
+		return nil
+	case deployment.FieldRunCommand:
+		m.ResetRunCommand()
+		return nil
+	case deployment.FieldDockerBuilderDockerfilePath:
+		m.ResetDockerBuilderDockerfilePath()
+		return nil
+	case deployment.FieldDockerBuilderBuildContext:
+		m.ResetDockerBuilderBuildContext()
+		return nil
+	case deployment.FieldDockerBuilderTarget:
+		m.ResetDockerBuilderTarget()
+		return nil
+	case deployment.FieldDockerBuilderBuildArgs:
+		m.ResetDockerBuilderBuildArgs()
+		return nil
+	case deployment.FieldDockerBuilderBuildContexts:
+		m.ResetDockerBuilderBuildContexts()
+		return nil
+	case deployment.FieldBuildpacksBuilderImage:
+		m.ResetBuildpacksBuilderImage()
+		return nil
+	case deployment.FieldPlatforms:
+		m.ResetPlatforms()
+		return nil
+	case deployment.FieldPlatformBuilds:
+		m.ResetPlatformBuilds()
+		return nil
+	case deployment.FieldRollbackReason:
+		m.ResetRollbackReason()
+		return nil
+	case deployment.FieldSkipReason:
+		m.ResetSkipReason()
+		return nil
+	case deployment.FieldGithubCheckRunID:
+		m.ResetGithubCheckRunID()
+		return nil
+	case deployment.FieldGithubCheckStatus:
+		m.ResetGithubCheckStatus()
+		return nil
+	case deployment.FieldGithubCheckConcluded:
+		m.ResetGithubCheckConcluded()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeploymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.service != nil {
+		edges = append(edges, deployment.EdgeService)
+	}
+	if m.sbom_packages != nil {
+		edges = append(edges, deployment.EdgeSbomPackages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeploymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case deployment.EdgeService:
+		if id := m.service; id != nil {
+			return []ent.Value{*id}
+		}
+	case deployment.EdgeSbomPackages:
+		ids := make([]ent.Value, 0, len(m.sbom_packages))
+		for id := range m.sbom_packages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeploymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsbom_packages != nil {
+		edges = append(edges, deployment.EdgeSbomPackages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeploymentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deployment.EdgeSbomPackages:
+		ids := make([]ent.Value, 0, len(m.removedsbom_packages))
+		for id := range m.removedsbom_packages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeploymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedservice {
+		edges = append(edges, deployment.EdgeService)
+	}
+	if m.clearedsbom_packages {
+		edges = append(edges, deployment.EdgeSbomPackages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeploymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case deployment.EdgeService:
+		return m.clearedservice
+	case deployment.EdgeSbomPackages:
+		return m.clearedsbom_packages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeploymentMutation) ClearEdge(name string) error {
+	switch name {
+	case deployment.EdgeService:
+		m.ClearService()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeploymentMutation) ResetEdge(name string) error {
+	switch name {
+	case deployment.EdgeService:
+		m.ResetService()
+		return nil
+	case deployment.EdgeSbomPackages:
+		m.ResetSbomPackages()
+		return nil
+	}
+	return fmt.Errorf("unknown Deployment edge %s", name)
+}
+
+// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
+type EnvironmentMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	kubernetes_name             *string
+	name                        *string
+	description                 *string
+	active                      *bool
+	kubernetes_secret           *string
+	protected                   *bool
+	freeze_windows              *[]schema.FreezeWindow
+	appendfreeze_windows        []schema.FreezeWindow
+	preview_base_environment_id *uuid.UUID
+	preview_repository          *string
+	preview_pull_request        *int
+	addpreview_pull_request     *int
+	preview_comment_id          *int64
+	addpreview_comment_id       *int64
+	clearedFields               map[string]struct{}
+	project                     *uuid.UUID
+	clearedproject              bool
+	services                    map[uuid.UUID]struct{}
+	removedservices             map[uuid.UUID]struct{}
+	clearedservices             bool
+	project_default             map[uuid.UUID]struct{}
+	removedproject_default      map[uuid.UUID]struct{}
+	clearedproject_default      bool
+	service_groups              map[uuid.UUID]struct{}
+	removedservice_groups       map[uuid.UUID]struct{}
+	clearedservice_groups       bool
+	done                        bool
+	oldValue                    func(context.Context) (*Environment, error)
+	predicates                  []predicate.Environment
+}
+
+var _ ent.Mutation = (*EnvironmentMutation)(nil)
+
+// environmentOption allows management of the mutation configuration using functional options.
+type environmentOption func(*EnvironmentMutation)
+
+// newEnvironmentMutation creates new mutation for the Environment entity.
+func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
+	m := &EnvironmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvironment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvironmentID sets the ID field of the mutation.
+func withEnvironmentID(id uuid.UUID) environmentOption {
+	return func(m *EnvironmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Environment
+		)
+		m.oldValue = func(ctx context.Context) (*Environment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Environment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvironment sets the old Environment of the mutation.
+func withEnvironment(node *Environment) environmentOption {
+	return func(m *EnvironmentMutation) {
+		m.oldValue = func(context.Context) (*Environment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvironmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvironmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Environment entities.
+func (m *EnvironmentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvironmentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvironmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvironmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvironmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvironmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvironmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetKubernetesName sets the "kubernetes_name" field.
+func (m *EnvironmentMutation) SetKubernetesName(s string) {
+	m.kubernetes_name = &s
+}
+
+// KubernetesName returns the value of the "kubernetes_name" field in the mutation.
+func (m *EnvironmentMutation) KubernetesName() (r string, exists bool) {
+	v := m.kubernetes_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKubernetesName returns the old "kubernetes_name" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldKubernetesName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubernetesName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubernetesName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubernetesName: %w", err)
+	}
+	return oldValue.KubernetesName, nil
+}
+
+// ResetKubernetesName resets all changes to the "kubernetes_name" field.
+func (m *EnvironmentMutation) ResetKubernetesName() {
+	m.kubernetes_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *EnvironmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EnvironmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EnvironmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EnvironmentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EnvironmentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EnvironmentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[environment.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EnvironmentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[environment.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EnvironmentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, environment.FieldDescription)
+}
+
+// SetActive sets the "active" field.
+func (m *EnvironmentMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *EnvironmentMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *EnvironmentMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *EnvironmentMutation) SetProjectID(u uuid.UUID) {
+	m.project = &u
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *EnvironmentMutation) ProjectID() (r uuid.UUID, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldProjectID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *EnvironmentMutation) ResetProjectID() {
+	m.project = nil
+}
+
+// SetKubernetesSecret sets the "kubernetes_secret" field.
+func (m *EnvironmentMutation) SetKubernetesSecret(s string) {
+	m.kubernetes_secret = &s
+}
+
+// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
+func (m *EnvironmentMutation) KubernetesSecret() (r string, exists bool) {
+	v := m.kubernetes_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
+	}
+	return oldValue.KubernetesSecret, nil
+}
+
+// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
+func (m *EnvironmentMutation) ResetKubernetesSecret() {
+	m.kubernetes_secret = nil
+}
+
+// SetProtected sets the "protected" field.
+func (m *EnvironmentMutation) SetProtected(b bool) {
+	m.protected = &b
+}
+
+// Protected returns the value of the "protected" field in the mutation.
+func (m *EnvironmentMutation) Protected() (r bool, exists bool) {
+	v := m.protected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtected returns the old "protected" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldProtected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtected: %w", err)
+	}
+	return oldValue.Protected, nil
+}
+
+// ResetProtected resets all changes to the "protected" field.
+func (m *EnvironmentMutation) ResetProtected() {
+	m.protected = nil
+}
+
+// SetFreezeWindows sets the "freeze_windows" field.
+func (m *EnvironmentMutation) SetFreezeWindows(sw []schema.FreezeWindow) {
+	m.freeze_windows = &sw
+	m.appendfreeze_windows = nil
+}
+
+// FreezeWindows returns the value of the "freeze_windows" field in the mutation.
+func (m *EnvironmentMutation) FreezeWindows() (r []schema.FreezeWindow, exists bool) {
+	v := m.freeze_windows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFreezeWindows returns the old "freeze_windows" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldFreezeWindows(ctx context.Context) (v []schema.FreezeWindow, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreezeWindows is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreezeWindows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreezeWindows: %w", err)
+	}
+	return oldValue.FreezeWindows, nil
+}
+
+// AppendFreezeWindows adds sw to the "freeze_windows" field.
+func (m *EnvironmentMutation) AppendFreezeWindows(sw []schema.FreezeWindow) {
+	m.appendfreeze_windows = append(m.appendfreeze_windows, sw...)
+}
+
+// AppendedFreezeWindows returns the list of values that were appended to the "freeze_windows" field in this mutation.
+func (m *EnvironmentMutation) AppendedFreezeWindows() ([]schema.FreezeWindow, bool) {
+	if len(m.appendfreeze_windows) == 0 {
+		return nil, false
+	}
+	return m.appendfreeze_windows, true
+}
+
+// ClearFreezeWindows clears the value of the "freeze_windows" field.
+func (m *EnvironmentMutation) ClearFreezeWindows() {
+	m.freeze_windows = nil
+	m.appendfreeze_windows = nil
+	m.clearedFields[environment.FieldFreezeWindows] = struct{}{}
+}
+
+// FreezeWindowsCleared returns if the "freeze_windows" field was cleared in this mutation.
+func (m *EnvironmentMutation) FreezeWindowsCleared() bool {
+	_, ok := m.clearedFields[environment.FieldFreezeWindows]
+	return ok
+}
+
+// ResetFreezeWindows resets all changes to the "freeze_windows" field.
+func (m *EnvironmentMutation) ResetFreezeWindows() {
+	m.freeze_windows = nil
+	m.appendfreeze_windows = nil
+	delete(m.clearedFields, environment.FieldFreezeWindows)
+}
+
+// SetPreviewBaseEnvironmentID sets the "preview_base_environment_id" field.
+func (m *EnvironmentMutation) SetPreviewBaseEnvironmentID(u uuid.UUID) {
+	m.preview_base_environment_id = &u
+}
+
+// PreviewBaseEnvironmentID returns the value of the "preview_base_environment_id" field in the mutation.
+func (m *EnvironmentMutation) PreviewBaseEnvironmentID() (r uuid.UUID, exists bool) {
+	v := m.preview_base_environment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewBaseEnvironmentID returns the old "preview_base_environment_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldPreviewBaseEnvironmentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewBaseEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewBaseEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewBaseEnvironmentID: %w", err)
+	}
+	return oldValue.PreviewBaseEnvironmentID, nil
+}
+
+// ClearPreviewBaseEnvironmentID clears the value of the "preview_base_environment_id" field.
+func (m *EnvironmentMutation) ClearPreviewBaseEnvironmentID() {
+	m.preview_base_environment_id = nil
+	m.clearedFields[environment.FieldPreviewBaseEnvironmentID] = struct{}{}
+}
+
+// PreviewBaseEnvironmentIDCleared returns if the "preview_base_environment_id" field was cleared in this mutation.
+func (m *EnvironmentMutation) PreviewBaseEnvironmentIDCleared() bool {
+	_, ok := m.clearedFields[environment.FieldPreviewBaseEnvironmentID]
+	return ok
+}
+
+// ResetPreviewBaseEnvironmentID resets all changes to the "preview_base_environment_id" field.
+func (m *EnvironmentMutation) ResetPreviewBaseEnvironmentID() {
+	m.preview_base_environment_id = nil
+	delete(m.clearedFields, environment.FieldPreviewBaseEnvironmentID)
+}
+
+// SetPreviewRepository sets the "preview_repository" field.
+func (m *EnvironmentMutation) SetPreviewRepository(s string) {
+	m.preview_repository = &s
+}
+
+// PreviewRepository returns the value of the "preview_repository" field in the mutation.
+func (m *EnvironmentMutation) PreviewRepository() (r string, exists bool) {
+	v := m.preview_repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewRepository returns the old "preview_repository" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldPreviewRepository(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewRepository: %w", err)
+	}
+	return oldValue.PreviewRepository, nil
+}
+
+// ClearPreviewRepository clears the value of the "preview_repository" field.
+func (m *EnvironmentMutation) ClearPreviewRepository() {
+	m.preview_repository = nil
+	m.clearedFields[environment.FieldPreviewRepository] = struct{}{}
+}
+
+// PreviewRepositoryCleared returns if the "preview_repository" field was cleared in this mutation.
+func (m *EnvironmentMutation) PreviewRepositoryCleared() bool {
+	_, ok := m.clearedFields[environment.FieldPreviewRepository]
+	return ok
+}
+
+// ResetPreviewRepository resets all changes to the "preview_repository" field.
+func (m *EnvironmentMutation) ResetPreviewRepository() {
+	m.preview_repository = nil
+	delete(m.clearedFields, environment.FieldPreviewRepository)
+}
+
+// SetPreviewPullRequest sets the "preview_pull_request" field.
+func (m *EnvironmentMutation) SetPreviewPullRequest(i int) {
+	m.preview_pull_request = &i
+	m.addpreview_pull_request = nil
+}
+
+// PreviewPullRequest returns the value of the "preview_pull_request" field in the mutation.
+func (m *EnvironmentMutation) PreviewPullRequest() (r int, exists bool) {
+	v := m.preview_pull_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewPullRequest returns the old "preview_pull_request" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldPreviewPullRequest(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewPullRequest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewPullRequest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewPullRequest: %w", err)
+	}
+	return oldValue.PreviewPullRequest, nil
+}
+
+// AddPreviewPullRequest adds i to the "preview_pull_request" field.
+func (m *EnvironmentMutation) AddPreviewPullRequest(i int) {
+	if m.addpreview_pull_request != nil {
+		*m.addpreview_pull_request += i
+	} else {
+		m.addpreview_pull_request = &i
+	}
+}
+
+// AddedPreviewPullRequest returns the value that was added to the "preview_pull_request" field in this mutation.
+func (m *EnvironmentMutation) AddedPreviewPullRequest() (r int, exists bool) {
+	v := m.addpreview_pull_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreviewPullRequest clears the value of the "preview_pull_request" field.
+func (m *EnvironmentMutation) ClearPreviewPullRequest() {
+	m.preview_pull_request = nil
+	m.addpreview_pull_request = nil
+	m.clearedFields[environment.FieldPreviewPullRequest] = struct{}{}
+}
+
+// PreviewPullRequestCleared returns if the "preview_pull_request" field was cleared in this mutation.
+func (m *EnvironmentMutation) PreviewPullRequestCleared() bool {
+	_, ok := m.clearedFields[environment.FieldPreviewPullRequest]
+	return ok
+}
+
+// ResetPreviewPullRequest resets all changes to the "preview_pull_request" field.
+func (m *EnvironmentMutation) ResetPreviewPullRequest() {
+	m.preview_pull_request = nil
+	m.addpreview_pull_request = nil
+	delete(m.clearedFields, environment.FieldPreviewPullRequest)
+}
+
+// SetPreviewCommentID sets the "preview_comment_id" field.
+func (m *EnvironmentMutation) SetPreviewCommentID(i int64) {
+	m.preview_comment_id = &i
+	m.addpreview_comment_id = nil
+}
+
+// PreviewCommentID returns the value of the "preview_comment_id" field in the mutation.
+func (m *EnvironmentMutation) PreviewCommentID() (r int64, exists bool) {
+	v := m.preview_comment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewCommentID returns the old "preview_comment_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldPreviewCommentID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewCommentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewCommentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewCommentID: %w", err)
+	}
+	return oldValue.PreviewCommentID, nil
+}
+
+// AddPreviewCommentID adds i to the "preview_comment_id" field.
+func (m *EnvironmentMutation) AddPreviewCommentID(i int64) {
+	if m.addpreview_comment_id != nil {
+		*m.addpreview_comment_id += i
+	} else {
+		m.addpreview_comment_id = &i
+	}
+}
+
+// AddedPreviewCommentID returns the value that was added to the "preview_comment_id" field in this mutation.
+func (m *EnvironmentMutation) AddedPreviewCommentID() (r int64, exists bool) {
+	v := m.addpreview_comment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreviewCommentID clears the value of the "preview_comment_id" field.
+func (m *EnvironmentMutation) ClearPreviewCommentID() {
+	m.preview_comment_id = nil
+	m.addpreview_comment_id = nil
+	m.clearedFields[environment.FieldPreviewCommentID] = struct{}{}
+}
+
+// PreviewCommentIDCleared returns if the "preview_comment_id" field was cleared in this mutation.
+func (m *EnvironmentMutation) PreviewCommentIDCleared() bool {
+	_, ok := m.clearedFields[environment.FieldPreviewCommentID]
+	return ok
+}
+
+// ResetPreviewCommentID resets all changes to the "preview_comment_id" field.
+func (m *EnvironmentMutation) ResetPreviewCommentID() {
+	m.preview_comment_id = nil
+	m.addpreview_comment_id = nil
+	delete(m.clearedFields, environment.FieldPreviewCommentID)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *EnvironmentMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[environment.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *EnvironmentMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *EnvironmentMutation) ProjectIDs() (ids []uuid.UUID) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *EnvironmentMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by ids.
+func (m *EnvironmentMutation) AddServiceIDs(ids ...uuid.UUID) {
+	if m.services == nil {
+		m.services = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.services[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServices clears the "services" edge to the Service entity.
+func (m *EnvironmentMutation) ClearServices() {
+	m.clearedservices = true
+}
+
+// ServicesCleared reports if the "services" edge to the Service entity was cleared.
+func (m *EnvironmentMutation) ServicesCleared() bool {
+	return m.clearedservices
+}
+
+// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
+func (m *EnvironmentMutation) RemoveServiceIDs(ids ...uuid.UUID) {
+	if m.removedservices == nil {
+		m.removedservices = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.services, ids[i])
+		m.removedservices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
+func (m *EnvironmentMutation) RemovedServicesIDs() (ids []uuid.UUID) {
+	for id := range m.removedservices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServicesIDs returns the "services" edge IDs in the mutation.
+func (m *EnvironmentMutation) ServicesIDs() (ids []uuid.UUID) {
+	for id := range m.services {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServices resets all changes to the "services" edge.
+func (m *EnvironmentMutation) ResetServices() {
+	m.services = nil
+	m.clearedservices = false
+	m.removedservices = nil
+}
+
+// AddProjectDefaultIDs adds the "project_default" edge to the Project entity by ids.
+func (m *EnvironmentMutation) AddProjectDefaultIDs(ids ...uuid.UUID) {
+	if m.project_default == nil {
+		m.project_default = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.project_default[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjectDefault clears the "project_default" edge to the Project entity.
+func (m *EnvironmentMutation) ClearProjectDefault() {
+	m.clearedproject_default = true
+}
+
+// ProjectDefaultCleared reports if the "project_default" edge to the Project entity was cleared.
+func (m *EnvironmentMutation) ProjectDefaultCleared() bool {
+	return m.clearedproject_default
+}
+
+// RemoveProjectDefaultIDs removes the "project_default" edge to the Project entity by IDs.
+func (m *EnvironmentMutation) RemoveProjectDefaultIDs(ids ...uuid.UUID) {
+	if m.removedproject_default == nil {
+		m.removedproject_default = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.project_default, ids[i])
+		m.removedproject_default[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjectDefault returns the removed IDs of the "project_default" edge to the Project entity.
+func (m *EnvironmentMutation) RemovedProjectDefaultIDs() (ids []uuid.UUID) {
+	for id := range m.removedproject_default {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectDefaultIDs returns the "project_default" edge IDs in the mutation.
+func (m *EnvironmentMutation) ProjectDefaultIDs() (ids []uuid.UUID) {
+	for id := range m.project_default {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjectDefault resets all changes to the "project_default" edge.
+func (m *EnvironmentMutation) ResetProjectDefault() {
+	m.project_default = nil
+	m.clearedproject_default = false
+	m.removedproject_default = nil
+}
+
+// AddServiceGroupIDs adds the "service_groups" edge to the ServiceGroup entity by ids.
+func (m *EnvironmentMutation) AddServiceGroupIDs(ids ...uuid.UUID) {
+	if m.service_groups == nil {
+		m.service_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.service_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearServiceGroups clears the "service_groups" edge to the ServiceGroup entity.
+func (m *EnvironmentMutation) ClearServiceGroups() {
+	m.clearedservice_groups = true
+}
+
+// ServiceGroupsCleared reports if the "service_groups" edge to the ServiceGroup entity was cleared.
+func (m *EnvironmentMutation) ServiceGroupsCleared() bool {
+	return m.clearedservice_groups
+}
+
+// RemoveServiceGroupIDs removes the "service_groups" edge to the ServiceGroup entity by IDs.
+func (m *EnvironmentMutation) RemoveServiceGroupIDs(ids ...uuid.UUID) {
+	if m.removedservice_groups == nil {
+		m.removedservice_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.service_groups, ids[i])
+		m.removedservice_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedServiceGroups returns the removed IDs of the "service_groups" edge to the ServiceGroup entity.
+func (m *EnvironmentMutation) RemovedServiceGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedservice_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ServiceGroupsIDs returns the "service_groups" edge IDs in the mutation.
+func (m *EnvironmentMutation) ServiceGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.service_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetServiceGroups resets all changes to the "service_groups" edge.
+func (m *EnvironmentMutation) ResetServiceGroups() {
+	m.service_groups = nil
+	m.clearedservice_groups = false
+	m.removedservice_groups = nil
+}
+
+// Where appends a list predicates to the EnvironmentMutation builder.
+func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Environment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvironmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvironmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Environment).
+func (m *EnvironmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvironmentMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, environment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, environment.FieldUpdatedAt)
+	}
+	if m.kubernetes_name != nil {
+		fields = append(fields, environment.FieldKubernetesName)
+	}
+	if m.name != nil {
+		fields = append(fields, environment.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, environment.FieldDescription)
+	}
+	if m.active != nil {
+		fields = append(fields, environment.FieldActive)
+	}
+	if m.project != nil {
+		fields = append(fields, environment.FieldProjectID)
+	}
+	if m.kubernetes_secret != nil {
+		fields = append(fields, environment.FieldKubernetesSecret)
+	}
+	if m.protected != nil {
+		fields = append(fields, environment.FieldProtected)
+	}
+	if m.freeze_windows != nil {
+		fields = append(fields, environment.FieldFreezeWindows)
+	}
+	if m.preview_base_environment_id != nil {
+		fields = append(fields, environment.FieldPreviewBaseEnvironmentID)
+	}
+	if m.preview_repository != nil {
+		fields = append(fields, environment.FieldPreviewRepository)
+	}
+	if m.preview_pull_request != nil {
+		fields = append(fields, environment.FieldPreviewPullRequest)
+	}
+	if m.preview_comment_id != nil {
+		fields = append(fields, environment.FieldPreviewCommentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case environment.FieldCreatedAt:
+		return m.CreatedAt()
+	case environment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case environment.FieldKubernetesName:
+		return m.KubernetesName()
+	case environment.FieldName:
+		return m.Name()
+	case environment.FieldDescription:
+		return m.Description()
+	case environment.FieldActive:
+		return m.Active()
+	case environment.FieldProjectID:
+		return m.ProjectID()
+	case environment.FieldKubernetesSecret:
+		return m.KubernetesSecret()
+	case environment.FieldProtected:
+		return m.Protected()
+	case environment.FieldFreezeWindows:
+		return m.FreezeWindows()
+	case environment.FieldPreviewBaseEnvironmentID:
+		return m.PreviewBaseEnvironmentID()
+	case environment.FieldPreviewRepository:
+		return m.PreviewRepository()
+	case environment.FieldPreviewPullRequest:
+		return m.PreviewPullRequest()
+	case environment.FieldPreviewCommentID:
+		return m.PreviewCommentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case environment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case environment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case environment.FieldKubernetesName:
+		return m.OldKubernetesName(ctx)
+	case environment.FieldName:
+		return m.OldName(ctx)
+	case environment.FieldDescription:
+		return m.OldDescription(ctx)
+	case environment.FieldActive:
+		return m.OldActive(ctx)
+	case environment.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case environment.FieldKubernetesSecret:
+		return m.OldKubernetesSecret(ctx)
+	case environment.FieldProtected:
+		return m.OldProtected(ctx)
+	case environment.FieldFreezeWindows:
+		return m.OldFreezeWindows(ctx)
+	case environment.FieldPreviewBaseEnvironmentID:
+		return m.OldPreviewBaseEnvironmentID(ctx)
+	case environment.FieldPreviewRepository:
+		return m.OldPreviewRepository(ctx)
+	case environment.FieldPreviewPullRequest:
+		return m.OldPreviewPullRequest(ctx)
+	case environment.FieldPreviewCommentID:
+		return m.OldPreviewCommentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Environment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case environment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case environment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case environment.FieldKubernetesName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesName(v)
+		return nil
+	case environment.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case environment.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case environment.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case environment.FieldProjectID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case environment.FieldKubernetesSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesSecret(v)
+		return nil
+	case environment.FieldProtected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtected(v)
+		return nil
+	case environment.FieldFreezeWindows:
+		v, ok := value.([]schema.FreezeWindow)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreezeWindows(v)
+		return nil
+	case environment.FieldPreviewBaseEnvironmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewBaseEnvironmentID(v)
+		return nil
+	case environment.FieldPreviewRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewRepository(v)
+		return nil
+	case environment.FieldPreviewPullRequest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewPullRequest(v)
+		return nil
+	case environment.FieldPreviewCommentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewCommentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Environment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvironmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addpreview_pull_request != nil {
+		fields = append(fields, environment.FieldPreviewPullRequest)
+	}
+	if m.addpreview_comment_id != nil {
+		fields = append(fields, environment.FieldPreviewCommentID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case environment.FieldPreviewPullRequest:
+		return m.AddedPreviewPullRequest()
+	case environment.FieldPreviewCommentID:
+		return m.AddedPreviewCommentID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case environment.FieldPreviewPullRequest:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviewPullRequest(v)
+		return nil
+	case environment.FieldPreviewCommentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviewCommentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Environment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvironmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(environment.FieldDescription) {
+		fields = append(fields, environment.FieldDescription)
+	}
+	if m.FieldCleared(environment.FieldFreezeWindows) {
+		fields = append(fields, environment.FieldFreezeWindows)
+	}
+	if m.FieldCleared(environment.FieldPreviewBaseEnvironmentID) {
+		fields = append(fields, environment.FieldPreviewBaseEnvironmentID)
+	}
+	if m.FieldCleared(environment.FieldPreviewRepository) {
+		fields = append(fields, environment.FieldPreviewRepository)
+	}
+	if m.FieldCleared(environment.FieldPreviewPullRequest) {
+		fields = append(fields, environment.FieldPreviewPullRequest)
+	}
+	if m.FieldCleared(environment.FieldPreviewCommentID) {
+		fields = append(fields, environment.FieldPreviewCommentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvironmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvironmentMutation) ClearField(name string) error {
+	switch name {
+	case environment.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case environment.FieldFreezeWindows:
+		m.ClearFreezeWindows()
+		return nil
+	case environment.FieldPreviewBaseEnvironmentID:
+		m.ClearPreviewBaseEnvironmentID()
+		return nil
+	case environment.FieldPreviewRepository:
+		m.ClearPreviewRepository()
+		return nil
+	case environment.FieldPreviewPullRequest:
+		m.ClearPreviewPullRequest()
+		return nil
+	case environment.FieldPreviewCommentID:
+		m.ClearPreviewCommentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvironmentMutation) ResetField(name string) error {
+	switch name {
+	case environment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case environment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case environment.FieldKubernetesName:
+		m.ResetKubernetesName()
+		return nil
+	case environment.FieldName:
+		m.ResetName()
+		return nil
+	case environment.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case environment.FieldActive:
+		m.ResetActive()
+		return nil
+	case environment.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case environment.FieldKubernetesSecret:
+		m.ResetKubernetesSecret()
+		return nil
+	case environment.FieldProtected:
+		m.ResetProtected()
+		return nil
+	case environment.FieldFreezeWindows:
+		m.ResetFreezeWindows()
+		return nil
+	case environment.FieldPreviewBaseEnvironmentID:
+		m.ResetPreviewBaseEnvironmentID()
+		return nil
+	case environment.FieldPreviewRepository:
+		m.ResetPreviewRepository()
+		return nil
+	case environment.FieldPreviewPullRequest:
+		m.ResetPreviewPullRequest()
+		return nil
+	case environment.FieldPreviewCommentID:
+		m.ResetPreviewCommentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvironmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.project != nil {
+		edges = append(edges, environment.EdgeProject)
+	}
+	if m.services != nil {
+		edges = append(edges, environment.EdgeServices)
+	}
+	if m.project_default != nil {
+		edges = append(edges, environment.EdgeProjectDefault)
+	}
+	if m.service_groups != nil {
+		edges = append(edges, environment.EdgeServiceGroups)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case environment.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case environment.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.services))
+		for id := range m.services {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeProjectDefault:
+		ids := make([]ent.Value, 0, len(m.project_default))
+		for id := range m.project_default {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeServiceGroups:
+		ids := make([]ent.Value, 0, len(m.service_groups))
+		for id := range m.service_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvironmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedservices != nil {
+		edges = append(edges, environment.EdgeServices)
+	}
+	if m.removedproject_default != nil {
+		edges = append(edges, environment.EdgeProjectDefault)
+	}
+	if m.removedservice_groups != nil {
+		edges = append(edges, environment.EdgeServiceGroups)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case environment.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.removedservices))
+		for id := range m.removedservices {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeProjectDefault:
+		ids := make([]ent.Value, 0, len(m.removedproject_default))
+		for id := range m.removedproject_default {
+			ids = append(ids, id)
+		}
+		return ids
+	case environment.EdgeServiceGroups:
+		ids := make([]ent.Value, 0, len(m.removedservice_groups))
+		for id := range m.removedservice_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvironmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedproject {
+		edges = append(edges, environment.EdgeProject)
+	}
+	if m.clearedservices {
+		edges = append(edges, environment.EdgeServices)
+	}
+	if m.clearedproject_default {
+		edges = append(edges, environment.EdgeProjectDefault)
+	}
+	if m.clearedservice_groups {
+		edges = append(edges, environment.EdgeServiceGroups)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvironmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case environment.EdgeProject:
+		return m.clearedproject
+	case environment.EdgeServices:
+		return m.clearedservices
+	case environment.EdgeProjectDefault:
+		return m.clearedproject_default
+	case environment.EdgeServiceGroups:
+		return m.clearedservice_groups
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvironmentMutation) ClearEdge(name string) error {
+	switch name {
+	case environment.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvironmentMutation) ResetEdge(name string) error {
+	switch name {
+	case environment.EdgeProject:
+		m.ResetProject()
+		return nil
+	case environment.EdgeServices:
+		m.ResetServices()
+		return nil
+	case environment.EdgeProjectDefault:
+		m.ResetProjectDefault()
+		return nil
+	case environment.EdgeServiceGroups:
+		m.ResetServiceGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown Environment edge %s", name)
+}
+
+// GithubAppMutation represents an operation that mutates the GithubApp nodes in the graph.
+type GithubAppMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int64
+	created_at           *time.Time
+	updated_at           *time.Time
+	uuid                 *uuid.UUID
+	name                 *string
+	client_id            *string
+	client_secret        *string
+	webhook_secret       *string
+	private_key          *string
+	clearedFields        map[string]struct{}
+	installations        map[int64]struct{}
+	removedinstallations map[int64]struct{}
+	clearedinstallations bool
+	users                *uuid.UUID
+	clearedusers         bool
+	done                 bool
+	oldValue             func(context.Context) (*GithubApp, error)
+	predicates           []predicate.GithubApp
+}
+
+var _ ent.Mutation = (*GithubAppMutation)(nil)
+
+// githubappOption allows management of the mutation configuration using functional options.
+type githubappOption func(*GithubAppMutation)
+
+// newGithubAppMutation creates new mutation for the GithubApp entity.
+func newGithubAppMutation(c config, op Op, opts ...githubappOption) *GithubAppMutation {
+	m := &GithubAppMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGithubApp,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGithubAppID sets the ID field of the mutation.
+func withGithubAppID(id int64) githubappOption {
+	return func(m *GithubAppMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GithubApp
+		)
+		m.oldValue = func(ctx context.Context) (*GithubApp, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GithubApp.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGithubApp sets the old GithubApp of the mutation.
+func withGithubApp(node *GithubApp) githubappOption {
+	return func(m *GithubAppMutation) {
+		m.oldValue = func(context.Context) (*GithubApp, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GithubAppMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GithubAppMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GithubApp entities.
+func (m *GithubAppMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GithubAppMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GithubAppMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GithubApp.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GithubAppMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GithubAppMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GithubAppMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GithubAppMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GithubAppMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GithubAppMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUUID sets the "uuid" field.
+func (m *GithubAppMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *GithubAppMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUID returns the old "uuid" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
+}
+
+// ResetUUID resets all changes to the "uuid" field.
+func (m *GithubAppMutation) ResetUUID() {
+	m.uuid = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *GithubAppMutation) SetCreatedBy(u uuid.UUID) {
+	m.users = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *GithubAppMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *GithubAppMutation) ResetCreatedBy() {
+	m.users = nil
+}
+
+// SetName sets the "name" field.
+func (m *GithubAppMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GithubAppMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GithubAppMutation) ResetName() {
+	m.name = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *GithubAppMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *GithubAppMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *GithubAppMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (m *GithubAppMutation) SetClientSecret(s string) {
+	m.client_secret = &s
+}
+
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *GithubAppMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
-	if m.builder != nil {
-		fields = append(fields, deployment.FieldBuilder)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
 	}
-	if m.railpack_builder_install_command != nil {
-		fields = append(fields, deployment.FieldRailpackBuilderInstallCommand)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
+}
+
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *GithubAppMutation) ResetClientSecret() {
+	m.client_secret = nil
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (m *GithubAppMutation) SetWebhookSecret(s string) {
+	m.webhook_secret = &s
+}
+
+// WebhookSecret returns the value of the "webhook_secret" field in the mutation.
+func (m *GithubAppMutation) WebhookSecret() (r string, exists bool) {
+	v := m.webhook_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookSecret returns the old "webhook_secret" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldWebhookSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookSecret: %w", err)
+	}
+	return oldValue.WebhookSecret, nil
+}
+
+// ResetWebhookSecret resets all changes to the "webhook_secret" field.
+func (m *GithubAppMutation) ResetWebhookSecret() {
+	m.webhook_secret = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *GithubAppMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *GithubAppMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the GithubApp entity.
+// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubAppMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *GithubAppMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// AddInstallationIDs adds the "installations" edge to the GithubInstallation entity by ids.
+func (m *GithubAppMutation) AddInstallationIDs(ids ...int64) {
+	if m.installations == nil {
+		m.installations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.installations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInstallations clears the "installations" edge to the GithubInstallation entity.
+func (m *GithubAppMutation) ClearInstallations() {
+	m.clearedinstallations = true
+}
+
+// InstallationsCleared reports if the "installations" edge to the GithubInstallation entity was cleared.
+func (m *GithubAppMutation) InstallationsCleared() bool {
+	return m.clearedinstallations
+}
+
+// RemoveInstallationIDs removes the "installations" edge to the GithubInstallation entity by IDs.
+func (m *GithubAppMutation) RemoveInstallationIDs(ids ...int64) {
+	if m.removedinstallations == nil {
+		m.removedinstallations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.installations, ids[i])
+		m.removedinstallations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInstallations returns the removed IDs of the "installations" edge to the GithubInstallation entity.
+func (m *GithubAppMutation) RemovedInstallationsIDs() (ids []int64) {
+	for id := range m.removedinstallations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InstallationsIDs returns the "installations" edge IDs in the mutation.
+func (m *GithubAppMutation) InstallationsIDs() (ids []int64) {
+	for id := range m.installations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInstallations resets all changes to the "installations" edge.
+func (m *GithubAppMutation) ResetInstallations() {
+	m.installations = nil
+	m.clearedinstallations = false
+	m.removedinstallations = nil
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *GithubAppMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GithubAppMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[githubapp.FieldCreatedBy] = struct{}{}
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GithubAppMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *GithubAppMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *GithubAppMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *GithubAppMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+}
+
+// Where appends a list predicates to the GithubAppMutation builder.
+func (m *GithubAppMutation) Where(ps ...predicate.GithubApp) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GithubAppMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GithubAppMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GithubApp, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GithubAppMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GithubAppMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GithubApp).
+func (m *GithubAppMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GithubAppMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, githubapp.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, githubapp.FieldUpdatedAt)
+	}
+	if m.uuid != nil {
+		fields = append(fields, githubapp.FieldUUID)
+	}
+	if m.users != nil {
+		fields = append(fields, githubapp.FieldCreatedBy)
+	}
+	if m.name != nil {
+		fields = append(fields, githubapp.FieldName)
 	}
-	if m.railpack_builder_build_command != nil {
-		fields = append(fields, deployment.FieldRailpackBuilderBuildCommand)
+	if m.client_id != nil {
+		fields = append(fields, githubapp.FieldClientID)
 	}
-	if m.run_command != nil {
-		fields = append(fields, deployment.FieldRunCommand)
+	if m.client_secret != nil {
+		fields = append(fields, githubapp.FieldClientSecret)
 	}
-	if m.docker_builder_dockerfile_path != nil {
-		fields = append(fields, deployment.FieldDockerBuilderDockerfilePath)
+	if m.webhook_secret != nil {
+		fields = append(fields, githubapp.FieldWebhookSecret)
 	}
-	if m.docker_builder_build_context != nil {
-		fields = append(fields, deployment.FieldDockerBuilderBuildContext)
+	if m.private_key != nil {
+		fields = append(fields, githubapp.FieldPrivateKey)
 	}
 	return fields
 }
@@ -1788,56 +5727,26 @@ func (m *DeploymentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeploymentMutation) Field(name string) (ent.Value, bool) {
+func (m *GithubAppMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case deployment.FieldCreatedAt:
+	case githubapp.FieldCreatedAt:
 		return m.CreatedAt()
-	case deployment.FieldUpdatedAt:
+	case githubapp.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case deployment.FieldServiceID:
-		return m.ServiceID()
-	case deployment.FieldStatus:
-		return m.Status()
-	case deployment.FieldSource:
-		return m.Source()
-	case deployment.FieldError:
-		return m.Error()
-	case deployment.FieldCommitSha:
-		return m.CommitSha()
-	case deployment.FieldCommitMessage:
-		return m.CommitMessage()
-	case deployment.FieldGitBranch:
-		return m.GitBranch()
-	case deployment.FieldCommitAuthor:
-		return m.CommitAuthor()
-	case deployment.FieldQueuedAt:
-		return m.QueuedAt()
-	case deployment.FieldStartedAt:
-		return m.StartedAt()
-	case deployment.FieldCompletedAt:
-		return m.CompletedAt()
-	case deployment.FieldKubernetesJobName:
-		return m.KubernetesJobName()
-	case deployment.FieldKubernetesJobStatus:
-		return m.KubernetesJobStatus()
-	case deployment.FieldAttempts:
-		return m.Attempts()
-	case deployment.FieldImage:
-		return m.Image()
-	case deployment.FieldResourceDefinition:
-		return m.ResourceDefinition()
-	case deployment.FieldBuilder:
-		return m.Builder()
-	case deployment.FieldRailpackBuilderInstallCommand:
-		return m.RailpackBuilderInstallCommand()
-	case deployment.FieldRailpackBuilderBuildCommand:
-		return m.RailpackBuilderBuildCommand()
-	case deployment.FieldRunCommand:
-		return m.RunCommand()
-	case deployment.FieldDockerBuilderDockerfilePath:
-		return m.DockerBuilderDockerfilePath()
-	case deployment.FieldDockerBuilderBuildContext:
-		return m.DockerBuilderBuildContext()
+	case githubapp.FieldUUID:
+		return m.UUID()
+	case githubapp.FieldCreatedBy:
+		return m.CreatedBy()
+	case githubapp.FieldName:
+		return m.Name()
+	case githubapp.FieldClientID:
+		return m.ClientID()
+	case githubapp.FieldClientSecret:
+		return m.ClientSecret()
+	case githubapp.FieldWebhookSecret:
+		return m.WebhookSecret()
+	case githubapp.FieldPrivateKey:
+		return m.PrivateKey()
 	}
 	return nil, false
 }
@@ -1845,493 +5754,202 @@ func (m *DeploymentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeploymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GithubAppMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case deployment.FieldCreatedAt:
+	case githubapp.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case deployment.FieldUpdatedAt:
+	case githubapp.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case deployment.FieldServiceID:
-		return m.OldServiceID(ctx)
-	case deployment.FieldStatus:
-		return m.OldStatus(ctx)
-	case deployment.FieldSource:
-		return m.OldSource(ctx)
-	case deployment.FieldError:
-		return m.OldError(ctx)
-	case deployment.FieldCommitSha:
-		return m.OldCommitSha(ctx)
-	case deployment.FieldCommitMessage:
-		return m.OldCommitMessage(ctx)
-	case deployment.FieldGitBranch:
-		return m.OldGitBranch(ctx)
-	case deployment.FieldCommitAuthor:
-		return m.OldCommitAuthor(ctx)
-	case deployment.FieldQueuedAt:
-		return m.OldQueuedAt(ctx)
-	case deployment.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case deployment.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case deployment.FieldKubernetesJobName:
-		return m.OldKubernetesJobName(ctx)
-	case deployment.FieldKubernetesJobStatus:
-		return m.OldKubernetesJobStatus(ctx)
-	case deployment.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case deployment.FieldImage:
-		return m.OldImage(ctx)
-	case deployment.FieldResourceDefinition:
-		return m.OldResourceDefinition(ctx)
-	case deployment.FieldBuilder:
-		return m.OldBuilder(ctx)
-	case deployment.FieldRailpackBuilderInstallCommand:
-		return m.OldRailpackBuilderInstallCommand(ctx)
-	case deployment.FieldRailpackBuilderBuildCommand:
-		return m.OldRailpackBuilderBuildCommand(ctx)
-	case deployment.FieldRunCommand:
-		return m.OldRunCommand(ctx)
-	case deployment.FieldDockerBuilderDockerfilePath:
-		return m.OldDockerBuilderDockerfilePath(ctx)
-	case deployment.FieldDockerBuilderBuildContext:
-		return m.OldDockerBuilderBuildContext(ctx)
+	case githubapp.FieldUUID:
+		return m.OldUUID(ctx)
+	case githubapp.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case githubapp.FieldName:
+		return m.OldName(ctx)
+	case githubapp.FieldClientID:
+		return m.OldClientID(ctx)
+	case githubapp.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case githubapp.FieldWebhookSecret:
+		return m.OldWebhookSecret(ctx)
+	case githubapp.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown Deployment field %s", name)
+	return nil, fmt.Errorf("unknown GithubApp field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentMutation) SetField(name string, value ent.Value) error {
+func (m *GithubAppMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case deployment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case deployment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case deployment.FieldServiceID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServiceID(v)
-		return nil
-	case deployment.FieldStatus:
-		v, ok := value.(schema.DeploymentStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case deployment.FieldSource:
-		v, ok := value.(schema.DeploymentSource)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case deployment.FieldError:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetError(v)
-		return nil
-	case deployment.FieldCommitSha:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommitSha(v)
-		return nil
-	case deployment.FieldCommitMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommitMessage(v)
-		return nil
-	case deployment.FieldGitBranch:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGitBranch(v)
-		return nil
-	case deployment.FieldCommitAuthor:
-		v, ok := value.(*schema.GitCommitter)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCommitAuthor(v)
-		return nil
-	case deployment.FieldQueuedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQueuedAt(v)
-		return nil
-	case deployment.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case deployment.FieldCompletedAt:
+	case githubapp.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
-		return nil
-	case deployment.FieldKubernetesJobName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKubernetesJobName(v)
-		return nil
-	case deployment.FieldKubernetesJobStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKubernetesJobStatus(v)
-		return nil
-	case deployment.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttempts(v)
+		m.SetCreatedAt(v)
 		return nil
-	case deployment.FieldImage:
-		v, ok := value.(string)
+	case githubapp.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImage(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case deployment.FieldResourceDefinition:
-		v, ok := value.(*v1.Service)
+	case githubapp.FieldUUID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceDefinition(v)
+		m.SetUUID(v)
 		return nil
-	case deployment.FieldBuilder:
-		v, ok := value.(schema.ServiceBuilder)
+	case githubapp.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuilder(v)
+		m.SetCreatedBy(v)
 		return nil
-	case deployment.FieldRailpackBuilderInstallCommand:
+	case githubapp.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRailpackBuilderInstallCommand(v)
+		m.SetName(v)
 		return nil
-	case deployment.FieldRailpackBuilderBuildCommand:
+	case githubapp.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRailpackBuilderBuildCommand(v)
+		m.SetClientID(v)
 		return nil
-	case deployment.FieldRunCommand:
+	case githubapp.FieldClientSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRunCommand(v)
+		m.SetClientSecret(v)
 		return nil
-	case deployment.FieldDockerBuilderDockerfilePath:
+	case githubapp.FieldWebhookSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDockerBuilderDockerfilePath(v)
+		m.SetWebhookSecret(v)
 		return nil
-	case deployment.FieldDockerBuilderBuildContext:
+	case githubapp.FieldPrivateKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDockerBuilderBuildContext(v)
+		m.SetPrivateKey(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Deployment field %s", name)
+	return fmt.Errorf("unknown GithubApp field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeploymentMutation) AddedFields() []string {
-	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, deployment.FieldAttempts)
-	}
-	return fields
+func (m *GithubAppMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeploymentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case deployment.FieldAttempts:
-		return m.AddedAttempts()
-	}
+func (m *GithubAppMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeploymentMutation) AddField(name string, value ent.Value) error {
+func (m *GithubAppMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case deployment.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttempts(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Deployment numeric field %s", name)
+	return fmt.Errorf("unknown GithubApp numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeploymentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(deployment.FieldError) {
-		fields = append(fields, deployment.FieldError)
-	}
-	if m.FieldCleared(deployment.FieldCommitSha) {
-		fields = append(fields, deployment.FieldCommitSha)
-	}
-	if m.FieldCleared(deployment.FieldCommitMessage) {
-		fields = append(fields, deployment.FieldCommitMessage)
-	}
-	if m.FieldCleared(deployment.FieldGitBranch) {
-		fields = append(fields, deployment.FieldGitBranch)
-	}
-	if m.FieldCleared(deployment.FieldCommitAuthor) {
-		fields = append(fields, deployment.FieldCommitAuthor)
-	}
-	if m.FieldCleared(deployment.FieldQueuedAt) {
-		fields = append(fields, deployment.FieldQueuedAt)
-	}
-	if m.FieldCleared(deployment.FieldStartedAt) {
-		fields = append(fields, deployment.FieldStartedAt)
-	}
-	if m.FieldCleared(deployment.FieldCompletedAt) {
-		fields = append(fields, deployment.FieldCompletedAt)
-	}
-	if m.FieldCleared(deployment.FieldKubernetesJobName) {
-		fields = append(fields, deployment.FieldKubernetesJobName)
-	}
-	if m.FieldCleared(deployment.FieldKubernetesJobStatus) {
-		fields = append(fields, deployment.FieldKubernetesJobStatus)
-	}
-	if m.FieldCleared(deployment.FieldImage) {
-		fields = append(fields, deployment.FieldImage)
-	}
-	if m.FieldCleared(deployment.FieldResourceDefinition) {
-		fields = append(fields, deployment.FieldResourceDefinition)
-	}
-	if m.FieldCleared(deployment.FieldRailpackBuilderInstallCommand) {
-		fields = append(fields, deployment.FieldRailpackBuilderInstallCommand)
-	}
-	if m.FieldCleared(deployment.FieldRailpackBuilderBuildCommand) {
-		fields = append(fields, deployment.FieldRailpackBuilderBuildCommand)
-	}
-	if m.FieldCleared(deployment.FieldRunCommand) {
-		fields = append(fields, deployment.FieldRunCommand)
-	}
-	if m.FieldCleared(deployment.FieldDockerBuilderDockerfilePath) {
-		fields = append(fields, deployment.FieldDockerBuilderDockerfilePath)
-	}
-	if m.FieldCleared(deployment.FieldDockerBuilderBuildContext) {
-		fields = append(fields, deployment.FieldDockerBuilderBuildContext)
-	}
-	return fields
+func (m *GithubAppMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeploymentMutation) FieldCleared(name string) bool {
+func (m *GithubAppMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeploymentMutation) ClearField(name string) error {
-	switch name {
-	case deployment.FieldError:
-		m.ClearError()
-		return nil
-	case deployment.FieldCommitSha:
-		m.ClearCommitSha()
-		return nil
-	case deployment.FieldCommitMessage:
-		m.ClearCommitMessage()
-		return nil
-	case deployment.FieldGitBranch:
-		m.ClearGitBranch()
-		return nil
-	case deployment.FieldCommitAuthor:
-		m.ClearCommitAuthor()
-		return nil
-	case deployment.FieldQueuedAt:
-		m.ClearQueuedAt()
-		return nil
-	case deployment.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case deployment.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case deployment.FieldKubernetesJobName:
-		m.ClearKubernetesJobName()
-		return nil
-	case deployment.FieldKubernetesJobStatus:
-		m.ClearKubernetesJobStatus()
-		return nil
-	case deployment.FieldImage:
-		m.ClearImage()
-		return nil
-	case deployment.FieldResourceDefinition:
-		m.ClearResourceDefinition()
-		return nil
-	case deployment.FieldRailpackBuilderInstallCommand:
-		m.ClearRailpackBuilderInstallCommand()
-		return nil
-	case deployment.FieldRailpackBuilderBuildCommand:
-		m.ClearRailpackBuilderBuildCommand()
-		return nil
-	case deployment.FieldRunCommand:
-		m.ClearRunCommand()
-		return nil
-	case deployment.FieldDockerBuilderDockerfilePath:
-		m.ClearDockerBuilderDockerfilePath()
-		return nil
-	case deployment.FieldDockerBuilderBuildContext:
-		m.ClearDockerBuilderBuildContext()
-		return nil
-	}
-	return fmt.Errorf("unknown Deployment nullable field %s", name)
+func (m *GithubAppMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GithubApp nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeploymentMutation) ResetField(name string) error {
+func (m *GithubAppMutation) ResetField(name string) error {
 	switch name {
-	case deployment.FieldCreatedAt:
+	case githubapp.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case deployment.FieldUpdatedAt:
+	case githubapp.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case deployment.FieldServiceID:
-		m.ResetServiceID()
-		return nil
-	case deployment.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case deployment.FieldSource:
-		m.ResetSource()
-		return nil
-	case deployment.FieldError:
-		m.ResetError()
-		return nil
-	case deployment.FieldCommitSha:
-		m.ResetCommitSha()
-		return nil
-	case deployment.FieldCommitMessage:
-		m.ResetCommitMessage()
-		return nil
-	case deployment.FieldGitBranch:
-		m.ResetGitBranch()
-		return nil
-	case deployment.FieldCommitAuthor:
-		m.ResetCommitAuthor()
-		return nil
-	case deployment.FieldQueuedAt:
-		m.ResetQueuedAt()
-		return nil
-	case deployment.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case deployment.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case deployment.FieldKubernetesJobName:
-		m.ResetKubernetesJobName()
-		return nil
-	case deployment.FieldKubernetesJobStatus:
-		m.ResetKubernetesJobStatus()
-		return nil
-	case deployment.FieldAttempts:
-		m.ResetAttempts()
-		return nil
-	case deployment.FieldImage:
-		m.ResetImage()
-		return nil
-	case deployment.FieldResourceDefinition:
-		m.ResetResourceDefinition()
+	case githubapp.FieldUUID:
+		m.ResetUUID()
 		return nil
-	case deployment.FieldBuilder:
-		m.ResetBuilder()
+	case githubapp.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case deployment.FieldRailpackBuilderInstallCommand:
-		m.ResetRailpackBuilderInstallCommand()
+	case githubapp.FieldName:
+		m.ResetName()
 		return nil
-	case deployment.FieldRailpackBuilderBuildCommand:
-		m.ResetRailpackBuilderBuildCommand()
+	case githubapp.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case deployment.FieldRunCommand:
-		m.ResetRunCommand()
+	case githubapp.FieldClientSecret:
+		m.ResetClientSecret()
 		return nil
-	case deployment.FieldDockerBuilderDockerfilePath:
-		m.ResetDockerBuilderDockerfilePath()
+	case githubapp.FieldWebhookSecret:
+		m.ResetWebhookSecret()
 		return nil
-	case deployment.FieldDockerBuilderBuildContext:
-		m.ResetDockerBuilderBuildContext()
+	case githubapp.FieldPrivateKey:
+		m.ResetPrivateKey()
 		return nil
 	}
-	return fmt.Errorf("unknown Deployment field %s", name)
+	return fmt.Errorf("unknown GithubApp field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeploymentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.service != nil {
-		edges = append(edges, deployment.EdgeService)
+func (m *GithubAppMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.installations != nil {
+		edges = append(edges, githubapp.EdgeInstallations)
+	}
+	if m.users != nil {
+		edges = append(edges, githubapp.EdgeUsers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeploymentMutation) AddedIDs(name string) []ent.Value {
+func (m *GithubAppMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case deployment.EdgeService:
-		if id := m.service; id != nil {
+	case githubapp.EdgeInstallations:
+		ids := make([]ent.Value, 0, len(m.installations))
+		for id := range m.installations {
+			ids = append(ids, id)
+		}
+		return ids
+	case githubapp.EdgeUsers:
+		if id := m.users; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2339,99 +5957,118 @@ func (m *DeploymentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeploymentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *GithubAppMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedinstallations != nil {
+		edges = append(edges, githubapp.EdgeInstallations)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeploymentMutation) RemovedIDs(name string) []ent.Value {
+func (m *GithubAppMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case githubapp.EdgeInstallations:
+		ids := make([]ent.Value, 0, len(m.removedinstallations))
+		for id := range m.removedinstallations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeploymentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedservice {
-		edges = append(edges, deployment.EdgeService)
+func (m *GithubAppMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedinstallations {
+		edges = append(edges, githubapp.EdgeInstallations)
+	}
+	if m.clearedusers {
+		edges = append(edges, githubapp.EdgeUsers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeploymentMutation) EdgeCleared(name string) bool {
+func (m *GithubAppMutation) EdgeCleared(name string) bool {
 	switch name {
-	case deployment.EdgeService:
-		return m.clearedservice
+	case githubapp.EdgeInstallations:
+		return m.clearedinstallations
+	case githubapp.EdgeUsers:
+		return m.clearedusers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeploymentMutation) ClearEdge(name string) error {
+func (m *GithubAppMutation) ClearEdge(name string) error {
 	switch name {
-	case deployment.EdgeService:
-		m.ClearService()
+	case githubapp.EdgeUsers:
+		m.ClearUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown Deployment unique edge %s", name)
+	return fmt.Errorf("unknown GithubApp unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeploymentMutation) ResetEdge(name string) error {
+func (m *GithubAppMutation) ResetEdge(name string) error {
 	switch name {
-	case deployment.EdgeService:
-		m.ResetService()
+	case githubapp.EdgeInstallations:
+		m.ResetInstallations()
+		return nil
+	case githubapp.EdgeUsers:
+		m.ResetUsers()
 		return nil
 	}
-	return fmt.Errorf("unknown Deployment edge %s", name)
+	return fmt.Errorf("unknown GithubApp edge %s", name)
 }
 
-// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
-type EnvironmentMutation struct {
+// GithubInstallationMutation represents an operation that mutates the GithubInstallation nodes in the graph.
+type GithubInstallationMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	created_at             *time.Time
-	updated_at             *time.Time
-	kubernetes_name        *string
-	name                   *string
-	description            *string
-	active                 *bool
-	kubernetes_secret      *string
-	clearedFields          map[string]struct{}
-	project                *uuid.UUID
-	clearedproject         bool
-	services               map[uuid.UUID]struct{}
-	removedservices        map[uuid.UUID]struct{}
-	clearedservices        bool
-	project_default        map[uuid.UUID]struct{}
-	removedproject_default map[uuid.UUID]struct{}
-	clearedproject_default bool
-	service_groups         map[uuid.UUID]struct{}
-	removedservice_groups  map[uuid.UUID]struct{}
-	clearedservice_groups  bool
-	done                   bool
-	oldValue               func(context.Context) (*Environment, error)
-	predicates             []predicate.Environment
+	op                   Op
+	typ                  string
+	id                   *int64
+	created_at           *time.Time
+	updated_at           *time.Time
+	account_id           *int64
+	addaccount_id        *int64
+	account_login        *string
+	account_type         *githubinstallation.AccountType
+	account_url          *string
+	repository_selection *githubinstallation.RepositorySelection
+	suspended            *bool
+	active               *bool
+	permissions          *schema.GithubInstallationPermissions
+	events               *[]string
+	appendevents         []string
+	clearedFields        map[string]struct{}
+	github_app           *int64
+	clearedgithub_app    bool
+	services             map[uuid.UUID]struct{}
+	removedservices      map[uuid.UUID]struct{}
+	clearedservices      bool
+	done                 bool
+	oldValue             func(context.Context) (*GithubInstallation, error)
+	predicates           []predicate.GithubInstallation
 }
 
-var _ ent.Mutation = (*EnvironmentMutation)(nil)
+var _ ent.Mutation = (*GithubInstallationMutation)(nil)
 
-// environmentOption allows management of the mutation configuration using functional options.
-type environmentOption func(*EnvironmentMutation)
+// githubinstallationOption allows management of the mutation configuration using functional options.
+type githubinstallationOption func(*GithubInstallationMutation)
 
-// newEnvironmentMutation creates new mutation for the Environment entity.
-func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
-	m := &EnvironmentMutation{
+// newGithubInstallationMutation creates new mutation for the GithubInstallation entity.
+func newGithubInstallationMutation(c config, op Op, opts ...githubinstallationOption) *GithubInstallationMutation {
+	m := &GithubInstallationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvironment,
+		typ:           TypeGithubInstallation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2440,20 +6077,20 @@ func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *Environ
 	return m
 }
 
-// withEnvironmentID sets the ID field of the mutation.
-func withEnvironmentID(id uuid.UUID) environmentOption {
-	return func(m *EnvironmentMutation) {
+// withGithubInstallationID sets the ID field of the mutation.
+func withGithubInstallationID(id int64) githubinstallationOption {
+	return func(m *GithubInstallationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Environment
+			value *GithubInstallation
 		)
-		m.oldValue = func(ctx context.Context) (*Environment, error) {
+		m.oldValue = func(ctx context.Context) (*GithubInstallation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Environment.Get(ctx, id)
+					value, err = m.Client().GithubInstallation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2462,10 +6099,10 @@ func withEnvironmentID(id uuid.UUID) environmentOption {
 	}
 }
 
-// withEnvironment sets the old Environment of the mutation.
-func withEnvironment(node *Environment) environmentOption {
-	return func(m *EnvironmentMutation) {
-		m.oldValue = func(context.Context) (*Environment, error) {
+// withGithubInstallation sets the old GithubInstallation of the mutation.
+func withGithubInstallation(node *GithubInstallation) githubinstallationOption {
+	return func(m *GithubInstallationMutation) {
+		m.oldValue = func(context.Context) (*GithubInstallation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2474,7 +6111,7 @@ func withEnvironment(node *Environment) environmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvironmentMutation) Client() *Client {
+func (m GithubInstallationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2482,7 +6119,7 @@ func (m EnvironmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvironmentMutation) Tx() (*Tx, error) {
+func (m GithubInstallationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2492,239 +6129,390 @@ func (m EnvironmentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Environment entities.
-func (m *EnvironmentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of GithubInstallation entities.
+func (m *GithubInstallationMutation) SetID(id int64) {
 	m.id = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EnvironmentMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GithubInstallationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GithubInstallationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GithubInstallation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GithubInstallationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GithubInstallationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubInstallationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GithubInstallationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GithubInstallationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GithubInstallationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubInstallationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GithubInstallationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGithubAppID sets the "github_app_id" field.
+func (m *GithubInstallationMutation) SetGithubAppID(i int64) {
+	m.github_app = &i
+}
+
+// GithubAppID returns the value of the "github_app_id" field in the mutation.
+func (m *GithubInstallationMutation) GithubAppID() (r int64, exists bool) {
+	v := m.github_app
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EnvironmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldGithubAppID returns the old "github_app_id" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubInstallationMutation) OldGithubAppID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGithubAppID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGithubAppID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGithubAppID: %w", err)
+	}
+	return oldValue.GithubAppID, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EnvironmentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetGithubAppID resets all changes to the "github_app_id" field.
+func (m *GithubInstallationMutation) ResetGithubAppID() {
+	m.github_app = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetAccountID sets the "account_id" field.
+func (m *GithubInstallationMutation) SetAccountID(i int64) {
+	m.account_id = &i
+	m.addaccount_id = nil
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *GithubInstallationMutation) AccountID() (r int64, exists bool) {
+	v := m.account_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GithubInstallationMutation) OldAccountID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AccountID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvironmentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddAccountID adds i to the "account_id" field.
+func (m *GithubInstallationMutation) AddAccountID(i int64) {
+	if m.addaccount_id != nil {
+		*m.addaccount_id += i
+	} else {
+		m.addaccount_id = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvironmentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedAccountID returns the value that was added to the "account_id" field in this mutation.
+func (m *GithubInstallationMutation) AddedAccountID() (r int64, exists bool) {
+	v := m.addaccount_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *GithubInstallationMutation) ResetAccountID() {
+	m.account_id = nil
+	m.addaccount_id = nil
+}
+
+// SetAccountLogin sets the "account_login" field.
+func (m *GithubInstallationMutation) SetAccountLogin(s string) {
+	m.account_login = &s
+}
+
+// AccountLogin returns the value of the "account_login" field in the mutation.
+func (m *GithubInstallationMutation) AccountLogin() (r string, exists bool) {
+	v := m.account_login
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountLogin returns the old "account_login" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GithubInstallationMutation) OldAccountLogin(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountLogin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAccountLogin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountLogin: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.AccountLogin, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvironmentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetAccountLogin resets all changes to the "account_login" field.
+func (m *GithubInstallationMutation) ResetAccountLogin() {
+	m.account_login = nil
 }
 
-// SetKubernetesName sets the "kubernetes_name" field.
-func (m *EnvironmentMutation) SetKubernetesName(s string) {
-	m.kubernetes_name = &s
+// SetAccountType sets the "account_type" field.
+func (m *GithubInstallationMutation) SetAccountType(gt githubinstallation.AccountType) {
+	m.account_type = &gt
 }
 
-// KubernetesName returns the value of the "kubernetes_name" field in the mutation.
-func (m *EnvironmentMutation) KubernetesName() (r string, exists bool) {
-	v := m.kubernetes_name
+// AccountType returns the value of the "account_type" field in the mutation.
+func (m *GithubInstallationMutation) AccountType() (r githubinstallation.AccountType, exists bool) {
+	v := m.account_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKubernetesName returns the old "kubernetes_name" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountType returns the old "account_type" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldKubernetesName(ctx context.Context) (v string, err error) {
+func (m *GithubInstallationMutation) OldAccountType(ctx context.Context) (v githubinstallation.AccountType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKubernetesName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKubernetesName requires an ID field in the mutation")
+		return v, errors.New("OldAccountType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKubernetesName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountType: %w", err)
 	}
-	return oldValue.KubernetesName, nil
+	return oldValue.AccountType, nil
 }
 
-// ResetKubernetesName resets all changes to the "kubernetes_name" field.
-func (m *EnvironmentMutation) ResetKubernetesName() {
-	m.kubernetes_name = nil
+// ResetAccountType resets all changes to the "account_type" field.
+func (m *GithubInstallationMutation) ResetAccountType() {
+	m.account_type = nil
 }
 
-// SetName sets the "name" field.
-func (m *EnvironmentMutation) SetName(s string) {
-	m.name = &s
+// SetAccountURL sets the "account_url" field.
+func (m *GithubInstallationMutation) SetAccountURL(s string) {
+	m.account_url = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EnvironmentMutation) Name() (r string, exists bool) {
-	v := m.name
+// AccountURL returns the value of the "account_url" field in the mutation.
+func (m *GithubInstallationMutation) AccountURL() (r string, exists bool) {
+	v := m.account_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountURL returns the old "account_url" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GithubInstallationMutation) OldAccountURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldAccountURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountURL: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.AccountURL, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EnvironmentMutation) ResetName() {
-	m.name = nil
+// ResetAccountURL resets all changes to the "account_url" field.
+func (m *GithubInstallationMutation) ResetAccountURL() {
+	m.account_url = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EnvironmentMutation) SetDescription(s string) {
-	m.description = &s
+// SetRepositorySelection sets the "repository_selection" field.
+func (m *GithubInstallationMutation) SetRepositorySelection(gs githubinstallation.RepositorySelection) {
+	m.repository_selection = &gs
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EnvironmentMutation) Description() (r string, exists bool) {
-	v := m.description
+// RepositorySelection returns the value of the "repository_selection" field in the mutation.
+func (m *GithubInstallationMutation) RepositorySelection() (r githubinstallation.RepositorySelection, exists bool) {
+	v := m.repository_selection
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldRepositorySelection returns the old "repository_selection" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *GithubInstallationMutation) OldRepositorySelection(ctx context.Context) (v githubinstallation.RepositorySelection, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldRepositorySelection is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldRepositorySelection requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldRepositorySelection: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.RepositorySelection, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *EnvironmentMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[environment.FieldDescription] = struct{}{}
+// ResetRepositorySelection resets all changes to the "repository_selection" field.
+func (m *GithubInstallationMutation) ResetRepositorySelection() {
+	m.repository_selection = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *EnvironmentMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[environment.FieldDescription]
-	return ok
+// SetSuspended sets the "suspended" field.
+func (m *GithubInstallationMutation) SetSuspended(b bool) {
+	m.suspended = &b
+}
+
+// Suspended returns the value of the "suspended" field in the mutation.
+func (m *GithubInstallationMutation) Suspended() (r bool, exists bool) {
+	v := m.suspended
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspended returns the old "suspended" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GithubInstallationMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspended requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+	}
+	return oldValue.Suspended, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EnvironmentMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, environment.FieldDescription)
+// ResetSuspended resets all changes to the "suspended" field.
+func (m *GithubInstallationMutation) ResetSuspended() {
+	m.suspended = nil
 }
 
 // SetActive sets the "active" field.
-func (m *EnvironmentMutation) SetActive(b bool) {
+func (m *GithubInstallationMutation) SetActive(b bool) {
 	m.active = &b
 }
 
 // Active returns the value of the "active" field in the mutation.
-func (m *EnvironmentMutation) Active() (r bool, exists bool) {
+func (m *GithubInstallationMutation) Active() (r bool, exists bool) {
 	v := m.active
 	if v == nil {
 		return
@@ -2732,10 +6520,10 @@ func (m *EnvironmentMutation) Active() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *GithubInstallationMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
@@ -2750,280 +6538,214 @@ func (m *EnvironmentMutation) OldActive(ctx context.Context) (v bool, err error)
 }
 
 // ResetActive resets all changes to the "active" field.
-func (m *EnvironmentMutation) ResetActive() {
+func (m *GithubInstallationMutation) ResetActive() {
 	m.active = nil
 }
 
-// SetProjectID sets the "project_id" field.
-func (m *EnvironmentMutation) SetProjectID(u uuid.UUID) {
-	m.project = &u
+// SetPermissions sets the "permissions" field.
+func (m *GithubInstallationMutation) SetPermissions(sip schema.GithubInstallationPermissions) {
+	m.permissions = &sip
 }
 
-// ProjectID returns the value of the "project_id" field in the mutation.
-func (m *EnvironmentMutation) ProjectID() (r uuid.UUID, exists bool) {
-	v := m.project
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *GithubInstallationMutation) Permissions() (r schema.GithubInstallationPermissions, exists bool) {
+	v := m.permissions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProjectID returns the old "project_id" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldPermissions returns the old "permissions" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldProjectID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *GithubInstallationMutation) OldPermissions(ctx context.Context) (v schema.GithubInstallationPermissions, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectID requires an ID field in the mutation")
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
 	}
-	return oldValue.ProjectID, nil
+	return oldValue.Permissions, nil
 }
 
-// ResetProjectID resets all changes to the "project_id" field.
-func (m *EnvironmentMutation) ResetProjectID() {
-	m.project = nil
+// ClearPermissions clears the value of the "permissions" field.
+func (m *GithubInstallationMutation) ClearPermissions() {
+	m.permissions = nil
+	m.clearedFields[githubinstallation.FieldPermissions] = struct{}{}
 }
 
-// SetKubernetesSecret sets the "kubernetes_secret" field.
-func (m *EnvironmentMutation) SetKubernetesSecret(s string) {
-	m.kubernetes_secret = &s
+// PermissionsCleared returns if the "permissions" field was cleared in this mutation.
+func (m *GithubInstallationMutation) PermissionsCleared() bool {
+	_, ok := m.clearedFields[githubinstallation.FieldPermissions]
+	return ok
 }
 
-// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
-func (m *EnvironmentMutation) KubernetesSecret() (r string, exists bool) {
-	v := m.kubernetes_secret
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *GithubInstallationMutation) ResetPermissions() {
+	m.permissions = nil
+	delete(m.clearedFields, githubinstallation.FieldPermissions)
+}
+
+// SetEvents sets the "events" field.
+func (m *GithubInstallationMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *GithubInstallationMutation) Events() (r []string, exists bool) {
+	v := m.events
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldEvents returns the old "events" field's value of the GithubInstallation entity.
+// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
+func (m *GithubInstallationMutation) OldEvents(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
+		return v, errors.New("OldEvents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
-	}
-	return oldValue.KubernetesSecret, nil
-}
-
-// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
-func (m *EnvironmentMutation) ResetKubernetesSecret() {
-	m.kubernetes_secret = nil
-}
-
-// ClearProject clears the "project" edge to the Project entity.
-func (m *EnvironmentMutation) ClearProject() {
-	m.clearedproject = true
-	m.clearedFields[environment.FieldProjectID] = struct{}{}
-}
-
-// ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *EnvironmentMutation) ProjectCleared() bool {
-	return m.clearedproject
-}
-
-// ProjectIDs returns the "project" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProjectID instead. It exists only for internal usage by the builders.
-func (m *EnvironmentMutation) ProjectIDs() (ids []uuid.UUID) {
-	if id := m.project; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetProject resets all changes to the "project" edge.
-func (m *EnvironmentMutation) ResetProject() {
-	m.project = nil
-	m.clearedproject = false
-}
-
-// AddServiceIDs adds the "services" edge to the Service entity by ids.
-func (m *EnvironmentMutation) AddServiceIDs(ids ...uuid.UUID) {
-	if m.services == nil {
-		m.services = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.services[ids[i]] = struct{}{}
-	}
-}
-
-// ClearServices clears the "services" edge to the Service entity.
-func (m *EnvironmentMutation) ClearServices() {
-	m.clearedservices = true
-}
-
-// ServicesCleared reports if the "services" edge to the Service entity was cleared.
-func (m *EnvironmentMutation) ServicesCleared() bool {
-	return m.clearedservices
-}
-
-// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
-func (m *EnvironmentMutation) RemoveServiceIDs(ids ...uuid.UUID) {
-	if m.removedservices == nil {
-		m.removedservices = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.services, ids[i])
-		m.removedservices[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
 	}
+	return oldValue.Events, nil
 }
 
-// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
-func (m *EnvironmentMutation) RemovedServicesIDs() (ids []uuid.UUID) {
-	for id := range m.removedservices {
-		ids = append(ids, id)
-	}
-	return
+// AppendEvents adds s to the "events" field.
+func (m *GithubInstallationMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
 }
 
-// ServicesIDs returns the "services" edge IDs in the mutation.
-func (m *EnvironmentMutation) ServicesIDs() (ids []uuid.UUID) {
-	for id := range m.services {
-		ids = append(ids, id)
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *GithubInstallationMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
 	}
-	return
-}
-
-// ResetServices resets all changes to the "services" edge.
-func (m *EnvironmentMutation) ResetServices() {
-	m.services = nil
-	m.clearedservices = false
-	m.removedservices = nil
+	return m.appendevents, true
 }
 
-// AddProjectDefaultIDs adds the "project_default" edge to the Project entity by ids.
-func (m *EnvironmentMutation) AddProjectDefaultIDs(ids ...uuid.UUID) {
-	if m.project_default == nil {
-		m.project_default = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.project_default[ids[i]] = struct{}{}
-	}
+// ClearEvents clears the value of the "events" field.
+func (m *GithubInstallationMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[githubinstallation.FieldEvents] = struct{}{}
 }
 
-// ClearProjectDefault clears the "project_default" edge to the Project entity.
-func (m *EnvironmentMutation) ClearProjectDefault() {
-	m.clearedproject_default = true
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *GithubInstallationMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[githubinstallation.FieldEvents]
+	return ok
 }
 
-// ProjectDefaultCleared reports if the "project_default" edge to the Project entity was cleared.
-func (m *EnvironmentMutation) ProjectDefaultCleared() bool {
-	return m.clearedproject_default
+// ResetEvents resets all changes to the "events" field.
+func (m *GithubInstallationMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, githubinstallation.FieldEvents)
 }
 
-// RemoveProjectDefaultIDs removes the "project_default" edge to the Project entity by IDs.
-func (m *EnvironmentMutation) RemoveProjectDefaultIDs(ids ...uuid.UUID) {
-	if m.removedproject_default == nil {
-		m.removedproject_default = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.project_default, ids[i])
-		m.removedproject_default[ids[i]] = struct{}{}
-	}
+// ClearGithubApp clears the "github_app" edge to the GithubApp entity.
+func (m *GithubInstallationMutation) ClearGithubApp() {
+	m.clearedgithub_app = true
+	m.clearedFields[githubinstallation.FieldGithubAppID] = struct{}{}
 }
 
-// RemovedProjectDefault returns the removed IDs of the "project_default" edge to the Project entity.
-func (m *EnvironmentMutation) RemovedProjectDefaultIDs() (ids []uuid.UUID) {
-	for id := range m.removedproject_default {
-		ids = append(ids, id)
-	}
-	return
+// GithubAppCleared reports if the "github_app" edge to the GithubApp entity was cleared.
+func (m *GithubInstallationMutation) GithubAppCleared() bool {
+	return m.clearedgithub_app
 }
 
-// ProjectDefaultIDs returns the "project_default" edge IDs in the mutation.
-func (m *EnvironmentMutation) ProjectDefaultIDs() (ids []uuid.UUID) {
-	for id := range m.project_default {
-		ids = append(ids, id)
+// GithubAppIDs returns the "github_app" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GithubAppID instead. It exists only for internal usage by the builders.
+func (m *GithubInstallationMutation) GithubAppIDs() (ids []int64) {
+	if id := m.github_app; id != nil {
+		ids = append(ids, *id)
 	}
-	return
-}
-
-// ResetProjectDefault resets all changes to the "project_default" edge.
-func (m *EnvironmentMutation) ResetProjectDefault() {
-	m.project_default = nil
-	m.clearedproject_default = false
-	m.removedproject_default = nil
+	return
 }
 
-// AddServiceGroupIDs adds the "service_groups" edge to the ServiceGroup entity by ids.
-func (m *EnvironmentMutation) AddServiceGroupIDs(ids ...uuid.UUID) {
-	if m.service_groups == nil {
-		m.service_groups = make(map[uuid.UUID]struct{})
+// ResetGithubApp resets all changes to the "github_app" edge.
+func (m *GithubInstallationMutation) ResetGithubApp() {
+	m.github_app = nil
+	m.clearedgithub_app = false
+}
+
+// AddServiceIDs adds the "services" edge to the Service entity by ids.
+func (m *GithubInstallationMutation) AddServiceIDs(ids ...uuid.UUID) {
+	if m.services == nil {
+		m.services = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.service_groups[ids[i]] = struct{}{}
+		m.services[ids[i]] = struct{}{}
 	}
 }
 
-// ClearServiceGroups clears the "service_groups" edge to the ServiceGroup entity.
-func (m *EnvironmentMutation) ClearServiceGroups() {
-	m.clearedservice_groups = true
+// ClearServices clears the "services" edge to the Service entity.
+func (m *GithubInstallationMutation) ClearServices() {
+	m.clearedservices = true
 }
 
-// ServiceGroupsCleared reports if the "service_groups" edge to the ServiceGroup entity was cleared.
-func (m *EnvironmentMutation) ServiceGroupsCleared() bool {
-	return m.clearedservice_groups
+// ServicesCleared reports if the "services" edge to the Service entity was cleared.
+func (m *GithubInstallationMutation) ServicesCleared() bool {
+	return m.clearedservices
 }
 
-// RemoveServiceGroupIDs removes the "service_groups" edge to the ServiceGroup entity by IDs.
-func (m *EnvironmentMutation) RemoveServiceGroupIDs(ids ...uuid.UUID) {
-	if m.removedservice_groups == nil {
-		m.removedservice_groups = make(map[uuid.UUID]struct{})
+// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
+func (m *GithubInstallationMutation) RemoveServiceIDs(ids ...uuid.UUID) {
+	if m.removedservices == nil {
+		m.removedservices = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.service_groups, ids[i])
-		m.removedservice_groups[ids[i]] = struct{}{}
+		delete(m.services, ids[i])
+		m.removedservices[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedServiceGroups returns the removed IDs of the "service_groups" edge to the ServiceGroup entity.
-func (m *EnvironmentMutation) RemovedServiceGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedservice_groups {
+// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
+func (m *GithubInstallationMutation) RemovedServicesIDs() (ids []uuid.UUID) {
+	for id := range m.removedservices {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ServiceGroupsIDs returns the "service_groups" edge IDs in the mutation.
-func (m *EnvironmentMutation) ServiceGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.service_groups {
+// ServicesIDs returns the "services" edge IDs in the mutation.
+func (m *GithubInstallationMutation) ServicesIDs() (ids []uuid.UUID) {
+	for id := range m.services {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetServiceGroups resets all changes to the "service_groups" edge.
-func (m *EnvironmentMutation) ResetServiceGroups() {
-	m.service_groups = nil
-	m.clearedservice_groups = false
-	m.removedservice_groups = nil
+// ResetServices resets all changes to the "services" edge.
+func (m *GithubInstallationMutation) ResetServices() {
+	m.services = nil
+	m.clearedservices = false
+	m.removedservices = nil
 }
 
-// Where appends a list predicates to the EnvironmentMutation builder.
-func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+// Where appends a list predicates to the GithubInstallationMutation builder.
+func (m *GithubInstallationMutation) Where(ps ...predicate.GithubInstallation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GithubInstallationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Environment, len(ps))
+func (m *GithubInstallationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GithubInstallation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3031,48 +6753,60 @@ func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvironmentMutation) Op() Op {
+func (m *GithubInstallationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvironmentMutation) SetOp(op Op) {
+func (m *GithubInstallationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Environment).
-func (m *EnvironmentMutation) Type() string {
+// Type returns the node type of this mutation (GithubInstallation).
+func (m *GithubInstallationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvironmentMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *GithubInstallationMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, environment.FieldCreatedAt)
+		fields = append(fields, githubinstallation.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, environment.FieldUpdatedAt)
+		fields = append(fields, githubinstallation.FieldUpdatedAt)
 	}
-	if m.kubernetes_name != nil {
-		fields = append(fields, environment.FieldKubernetesName)
+	if m.github_app != nil {
+		fields = append(fields, githubinstallation.FieldGithubAppID)
 	}
-	if m.name != nil {
-		fields = append(fields, environment.FieldName)
+	if m.account_id != nil {
+		fields = append(fields, githubinstallation.FieldAccountID)
 	}
-	if m.description != nil {
-		fields = append(fields, environment.FieldDescription)
+	if m.account_login != nil {
+		fields = append(fields, githubinstallation.FieldAccountLogin)
+	}
+	if m.account_type != nil {
+		fields = append(fields, githubinstallation.FieldAccountType)
+	}
+	if m.account_url != nil {
+		fields = append(fields, githubinstallation.FieldAccountURL)
+	}
+	if m.repository_selection != nil {
+		fields = append(fields, githubinstallation.FieldRepositorySelection)
+	}
+	if m.suspended != nil {
+		fields = append(fields, githubinstallation.FieldSuspended)
 	}
 	if m.active != nil {
-		fields = append(fields, environment.FieldActive)
+		fields = append(fields, githubinstallation.FieldActive)
 	}
-	if m.project != nil {
-		fields = append(fields, environment.FieldProjectID)
+	if m.permissions != nil {
+		fields = append(fields, githubinstallation.FieldPermissions)
 	}
-	if m.kubernetes_secret != nil {
-		fields = append(fields, environment.FieldKubernetesSecret)
+	if m.events != nil {
+		fields = append(fields, githubinstallation.FieldEvents)
 	}
 	return fields
 }
@@ -3080,24 +6814,32 @@ func (m *EnvironmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
+func (m *GithubInstallationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case environment.FieldCreatedAt:
+	case githubinstallation.FieldCreatedAt:
 		return m.CreatedAt()
-	case environment.FieldUpdatedAt:
+	case githubinstallation.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case environment.FieldKubernetesName:
-		return m.KubernetesName()
-	case environment.FieldName:
-		return m.Name()
-	case environment.FieldDescription:
-		return m.Description()
-	case environment.FieldActive:
+	case githubinstallation.FieldGithubAppID:
+		return m.GithubAppID()
+	case githubinstallation.FieldAccountID:
+		return m.AccountID()
+	case githubinstallation.FieldAccountLogin:
+		return m.AccountLogin()
+	case githubinstallation.FieldAccountType:
+		return m.AccountType()
+	case githubinstallation.FieldAccountURL:
+		return m.AccountURL()
+	case githubinstallation.FieldRepositorySelection:
+		return m.RepositorySelection()
+	case githubinstallation.FieldSuspended:
+		return m.Suspended()
+	case githubinstallation.FieldActive:
 		return m.Active()
-	case environment.FieldProjectID:
-		return m.ProjectID()
-	case environment.FieldKubernetesSecret:
-		return m.KubernetesSecret()
+	case githubinstallation.FieldPermissions:
+		return m.Permissions()
+	case githubinstallation.FieldEvents:
+		return m.Events()
 	}
 	return nil, false
 }
@@ -3105,365 +6847,388 @@ func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GithubInstallationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case environment.FieldCreatedAt:
+	case githubinstallation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case environment.FieldUpdatedAt:
+	case githubinstallation.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case environment.FieldKubernetesName:
-		return m.OldKubernetesName(ctx)
-	case environment.FieldName:
-		return m.OldName(ctx)
-	case environment.FieldDescription:
-		return m.OldDescription(ctx)
-	case environment.FieldActive:
+	case githubinstallation.FieldGithubAppID:
+		return m.OldGithubAppID(ctx)
+	case githubinstallation.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case githubinstallation.FieldAccountLogin:
+		return m.OldAccountLogin(ctx)
+	case githubinstallation.FieldAccountType:
+		return m.OldAccountType(ctx)
+	case githubinstallation.FieldAccountURL:
+		return m.OldAccountURL(ctx)
+	case githubinstallation.FieldRepositorySelection:
+		return m.OldRepositorySelection(ctx)
+	case githubinstallation.FieldSuspended:
+		return m.OldSuspended(ctx)
+	case githubinstallation.FieldActive:
 		return m.OldActive(ctx)
-	case environment.FieldProjectID:
-		return m.OldProjectID(ctx)
-	case environment.FieldKubernetesSecret:
-		return m.OldKubernetesSecret(ctx)
+	case githubinstallation.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case githubinstallation.FieldEvents:
+		return m.OldEvents(ctx)
 	}
-	return nil, fmt.Errorf("unknown Environment field %s", name)
+	return nil, fmt.Errorf("unknown GithubInstallation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
+func (m *GithubInstallationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case environment.FieldCreatedAt:
+	case githubinstallation.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case environment.FieldUpdatedAt:
+	case githubinstallation.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case environment.FieldKubernetesName:
-		v, ok := value.(string)
+	case githubinstallation.FieldGithubAppID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKubernetesName(v)
+		m.SetGithubAppID(v)
 		return nil
-	case environment.FieldName:
+	case githubinstallation.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case githubinstallation.FieldAccountLogin:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetAccountLogin(v)
 		return nil
-	case environment.FieldDescription:
+	case githubinstallation.FieldAccountType:
+		v, ok := value.(githubinstallation.AccountType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountType(v)
+		return nil
+	case githubinstallation.FieldAccountURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetAccountURL(v)
 		return nil
-	case environment.FieldActive:
+	case githubinstallation.FieldRepositorySelection:
+		v, ok := value.(githubinstallation.RepositorySelection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepositorySelection(v)
+		return nil
+	case githubinstallation.FieldSuspended:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetSuspended(v)
 		return nil
-	case environment.FieldProjectID:
-		v, ok := value.(uuid.UUID)
+	case githubinstallation.FieldActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProjectID(v)
+		m.SetActive(v)
 		return nil
-	case environment.FieldKubernetesSecret:
-		v, ok := value.(string)
+	case githubinstallation.FieldPermissions:
+		v, ok := value.(schema.GithubInstallationPermissions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case githubinstallation.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKubernetesSecret(v)
+		m.SetEvents(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown GithubInstallation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvironmentMutation) AddedFields() []string {
-	return nil
+func (m *GithubInstallationMutation) AddedFields() []string {
+	var fields []string
+	if m.addaccount_id != nil {
+		fields = append(fields, githubinstallation.FieldAccountID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GithubInstallationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case githubinstallation.FieldAccountID:
+		return m.AddedAccountID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
+func (m *GithubInstallationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case githubinstallation.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Environment numeric field %s", name)
+	return fmt.Errorf("unknown GithubInstallation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvironmentMutation) ClearedFields() []string {
+func (m *GithubInstallationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(environment.FieldDescription) {
-		fields = append(fields, environment.FieldDescription)
+	if m.FieldCleared(githubinstallation.FieldPermissions) {
+		fields = append(fields, githubinstallation.FieldPermissions)
+	}
+	if m.FieldCleared(githubinstallation.FieldEvents) {
+		fields = append(fields, githubinstallation.FieldEvents)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvironmentMutation) FieldCleared(name string) bool {
+func (m *GithubInstallationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ClearField(name string) error {
+func (m *GithubInstallationMutation) ClearField(name string) error {
 	switch name {
-	case environment.FieldDescription:
-		m.ClearDescription()
+	case githubinstallation.FieldPermissions:
+		m.ClearPermissions()
+		return nil
+	case githubinstallation.FieldEvents:
+		m.ClearEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment nullable field %s", name)
+	return fmt.Errorf("unknown GithubInstallation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ResetField(name string) error {
+func (m *GithubInstallationMutation) ResetField(name string) error {
 	switch name {
-	case environment.FieldCreatedAt:
+	case githubinstallation.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case environment.FieldUpdatedAt:
+	case githubinstallation.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case environment.FieldKubernetesName:
-		m.ResetKubernetesName()
+	case githubinstallation.FieldGithubAppID:
+		m.ResetGithubAppID()
 		return nil
-	case environment.FieldName:
-		m.ResetName()
+	case githubinstallation.FieldAccountID:
+		m.ResetAccountID()
 		return nil
-	case environment.FieldDescription:
-		m.ResetDescription()
+	case githubinstallation.FieldAccountLogin:
+		m.ResetAccountLogin()
 		return nil
-	case environment.FieldActive:
+	case githubinstallation.FieldAccountType:
+		m.ResetAccountType()
+		return nil
+	case githubinstallation.FieldAccountURL:
+		m.ResetAccountURL()
+		return nil
+	case githubinstallation.FieldRepositorySelection:
+		m.ResetRepositorySelection()
+		return nil
+	case githubinstallation.FieldSuspended:
+		m.ResetSuspended()
+		return nil
+	case githubinstallation.FieldActive:
 		m.ResetActive()
 		return nil
-	case environment.FieldProjectID:
-		m.ResetProjectID()
+	case githubinstallation.FieldPermissions:
+		m.ResetPermissions()
 		return nil
-	case environment.FieldKubernetesSecret:
-		m.ResetKubernetesSecret()
+	case githubinstallation.FieldEvents:
+		m.ResetEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown GithubInstallation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvironmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.project != nil {
-		edges = append(edges, environment.EdgeProject)
+func (m *GithubInstallationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.github_app != nil {
+		edges = append(edges, githubinstallation.EdgeGithubApp)
 	}
 	if m.services != nil {
-		edges = append(edges, environment.EdgeServices)
-	}
-	if m.project_default != nil {
-		edges = append(edges, environment.EdgeProjectDefault)
-	}
-	if m.service_groups != nil {
-		edges = append(edges, environment.EdgeServiceGroups)
+		edges = append(edges, githubinstallation.EdgeServices)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
+func (m *GithubInstallationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case environment.EdgeProject:
-		if id := m.project; id != nil {
+	case githubinstallation.EdgeGithubApp:
+		if id := m.github_app; id != nil {
 			return []ent.Value{*id}
 		}
-	case environment.EdgeServices:
+	case githubinstallation.EdgeServices:
 		ids := make([]ent.Value, 0, len(m.services))
 		for id := range m.services {
 			ids = append(ids, id)
 		}
 		return ids
-	case environment.EdgeProjectDefault:
-		ids := make([]ent.Value, 0, len(m.project_default))
-		for id := range m.project_default {
-			ids = append(ids, id)
-		}
-		return ids
-	case environment.EdgeServiceGroups:
-		ids := make([]ent.Value, 0, len(m.service_groups))
-		for id := range m.service_groups {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvironmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *GithubInstallationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.removedservices != nil {
-		edges = append(edges, environment.EdgeServices)
-	}
-	if m.removedproject_default != nil {
-		edges = append(edges, environment.EdgeProjectDefault)
-	}
-	if m.removedservice_groups != nil {
-		edges = append(edges, environment.EdgeServiceGroups)
+		edges = append(edges, githubinstallation.EdgeServices)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
+func (m *GithubInstallationMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case environment.EdgeServices:
+	case githubinstallation.EdgeServices:
 		ids := make([]ent.Value, 0, len(m.removedservices))
 		for id := range m.removedservices {
 			ids = append(ids, id)
 		}
 		return ids
-	case environment.EdgeProjectDefault:
-		ids := make([]ent.Value, 0, len(m.removedproject_default))
-		for id := range m.removedproject_default {
-			ids = append(ids, id)
-		}
-		return ids
-	case environment.EdgeServiceGroups:
-		ids := make([]ent.Value, 0, len(m.removedservice_groups))
-		for id := range m.removedservice_groups {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvironmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedproject {
-		edges = append(edges, environment.EdgeProject)
+func (m *GithubInstallationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgithub_app {
+		edges = append(edges, githubinstallation.EdgeGithubApp)
 	}
 	if m.clearedservices {
-		edges = append(edges, environment.EdgeServices)
-	}
-	if m.clearedproject_default {
-		edges = append(edges, environment.EdgeProjectDefault)
-	}
-	if m.clearedservice_groups {
-		edges = append(edges, environment.EdgeServiceGroups)
+		edges = append(edges, githubinstallation.EdgeServices)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvironmentMutation) EdgeCleared(name string) bool {
+func (m *GithubInstallationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case environment.EdgeProject:
-		return m.clearedproject
-	case environment.EdgeServices:
+	case githubinstallation.EdgeGithubApp:
+		return m.clearedgithub_app
+	case githubinstallation.EdgeServices:
 		return m.clearedservices
-	case environment.EdgeProjectDefault:
-		return m.clearedproject_default
-	case environment.EdgeServiceGroups:
-		return m.clearedservice_groups
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvironmentMutation) ClearEdge(name string) error {
+func (m *GithubInstallationMutation) ClearEdge(name string) error {
 	switch name {
-	case environment.EdgeProject:
-		m.ClearProject()
+	case githubinstallation.EdgeGithubApp:
+		m.ClearGithubApp()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment unique edge %s", name)
+	return fmt.Errorf("unknown GithubInstallation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvironmentMutation) ResetEdge(name string) error {
+func (m *GithubInstallationMutation) ResetEdge(name string) error {
 	switch name {
-	case environment.EdgeProject:
-		m.ResetProject()
+	case githubinstallation.EdgeGithubApp:
+		m.ResetGithubApp()
 		return nil
-	case environment.EdgeServices:
+	case githubinstallation.EdgeServices:
 		m.ResetServices()
 		return nil
-	case environment.EdgeProjectDefault:
-		m.ResetProjectDefault()
-		return nil
-	case environment.EdgeServiceGroups:
-		m.ResetServiceGroups()
-		return nil
 	}
-	return fmt.Errorf("unknown Environment edge %s", name)
+	return fmt.Errorf("unknown GithubInstallation edge %s", name)
 }
 
-// GithubAppMutation represents an operation that mutates the GithubApp nodes in the graph.
-type GithubAppMutation struct {
+// GitlabConnectionMutation represents an operation that mutates the GitlabConnection nodes in the graph.
+type GitlabConnectionMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int64
-	created_at           *time.Time
-	updated_at           *time.Time
-	uuid                 *uuid.UUID
-	name                 *string
-	client_id            *string
-	client_secret        *string
-	webhook_secret       *string
-	private_key          *string
-	clearedFields        map[string]struct{}
-	installations        map[int64]struct{}
-	removedinstallations map[int64]struct{}
-	clearedinstallations bool
-	users                *uuid.UUID
-	clearedusers         bool
-	done                 bool
-	oldValue             func(context.Context) (*GithubApp, error)
-	predicates           []predicate.GithubApp
-}
-
-var _ ent.Mutation = (*GithubAppMutation)(nil)
-
-// githubappOption allows management of the mutation configuration using functional options.
-type githubappOption func(*GithubAppMutation)
-
-// newGithubAppMutation creates new mutation for the GithubApp entity.
-func newGithubAppMutation(c config, op Op, opts ...githubappOption) *GithubAppMutation {
-	m := &GithubAppMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	name             *string
+	url              *string
+	auth_type        *gitlabconnection.AuthType
+	account_id       *int64
+	addaccount_id    *int64
+	account_username *string
+	access_token     *string
+	refresh_token    *string
+	token_expires_at *time.Time
+	client_id        *string
+	client_secret    *string
+	webhook_secret   *string
+	clearedFields    map[string]struct{}
+	users            *uuid.UUID
+	clearedusers     bool
+	services         map[uuid.UUID]struct{}
+	removedservices  map[uuid.UUID]struct{}
+	clearedservices  bool
+	done             bool
+	oldValue         func(context.Context) (*GitlabConnection, error)
+	predicates       []predicate.GitlabConnection
+}
+
+var _ ent.Mutation = (*GitlabConnectionMutation)(nil)
+
+// gitlabconnectionOption allows management of the mutation configuration using functional options.
+type gitlabconnectionOption func(*GitlabConnectionMutation)
+
+// newGitlabConnectionMutation creates new mutation for the GitlabConnection entity.
+func newGitlabConnectionMutation(c config, op Op, opts ...gitlabconnectionOption) *GitlabConnectionMutation {
+	m := &GitlabConnectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGithubApp,
+		typ:           TypeGitlabConnection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3472,20 +7237,20 @@ func newGithubAppMutation(c config, op Op, opts ...githubappOption) *GithubAppMu
 	return m
 }
 
-// withGithubAppID sets the ID field of the mutation.
-func withGithubAppID(id int64) githubappOption {
-	return func(m *GithubAppMutation) {
+// withGitlabConnectionID sets the ID field of the mutation.
+func withGitlabConnectionID(id uuid.UUID) gitlabconnectionOption {
+	return func(m *GitlabConnectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GithubApp
+			value *GitlabConnection
 		)
-		m.oldValue = func(ctx context.Context) (*GithubApp, error) {
+		m.oldValue = func(ctx context.Context) (*GitlabConnection, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GithubApp.Get(ctx, id)
+					value, err = m.Client().GitlabConnection.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3493,11 +7258,11 @@ func withGithubAppID(id int64) githubappOption {
 		m.id = &id
 	}
 }
-
-// withGithubApp sets the old GithubApp of the mutation.
-func withGithubApp(node *GithubApp) githubappOption {
-	return func(m *GithubAppMutation) {
-		m.oldValue = func(context.Context) (*GithubApp, error) {
+
+// withGitlabConnection sets the old GitlabConnection of the mutation.
+func withGitlabConnection(node *GitlabConnection) gitlabconnectionOption {
+	return func(m *GitlabConnectionMutation) {
+		m.oldValue = func(context.Context) (*GitlabConnection, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3506,7 +7271,7 @@ func withGithubApp(node *GithubApp) githubappOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GithubAppMutation) Client() *Client {
+func (m GitlabConnectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3514,7 +7279,7 @@ func (m GithubAppMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GithubAppMutation) Tx() (*Tx, error) {
+func (m GitlabConnectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3524,14 +7289,14 @@ func (m GithubAppMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GithubApp entities.
-func (m *GithubAppMutation) SetID(id int64) {
+// operation is only accepted on creation of GitlabConnection entities.
+func (m *GitlabConnectionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GithubAppMutation) ID() (id int64, exists bool) {
+func (m *GitlabConnectionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3542,28 +7307,28 @@ func (m *GithubAppMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GithubAppMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *GitlabConnectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GithubApp.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GitlabConnection.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GithubAppMutation) SetCreatedAt(t time.Time) {
+func (m *GitlabConnectionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GithubAppMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GitlabConnectionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3571,10 +7336,10 @@ func (m *GithubAppMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GitlabConnectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3589,17 +7354,17 @@ func (m *GithubAppMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GithubAppMutation) ResetCreatedAt() {
+func (m *GitlabConnectionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GithubAppMutation) SetUpdatedAt(t time.Time) {
+func (m *GitlabConnectionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GithubAppMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *GitlabConnectionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3607,10 +7372,10 @@ func (m *GithubAppMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GitlabConnectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3625,53 +7390,17 @@ func (m *GithubAppMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GithubAppMutation) ResetUpdatedAt() {
+func (m *GitlabConnectionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetUUID sets the "uuid" field.
-func (m *GithubAppMutation) SetUUID(u uuid.UUID) {
-	m.uuid = &u
-}
-
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *GithubAppMutation) UUID() (r uuid.UUID, exists bool) {
-	v := m.uuid
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUUID returns the old "uuid" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
-	}
-	return oldValue.UUID, nil
-}
-
-// ResetUUID resets all changes to the "uuid" field.
-func (m *GithubAppMutation) ResetUUID() {
-	m.uuid = nil
-}
-
 // SetCreatedBy sets the "created_by" field.
-func (m *GithubAppMutation) SetCreatedBy(u uuid.UUID) {
+func (m *GitlabConnectionMutation) SetCreatedBy(u uuid.UUID) {
 	m.users = &u
 }
 
 // CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *GithubAppMutation) CreatedBy() (r uuid.UUID, exists bool) {
+func (m *GitlabConnectionMutation) CreatedBy() (r uuid.UUID, exists bool) {
 	v := m.users
 	if v == nil {
 		return
@@ -3679,10 +7408,10 @@ func (m *GithubAppMutation) CreatedBy() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+func (m *GitlabConnectionMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
@@ -3697,17 +7426,17 @@ func (m *GithubAppMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err
 }
 
 // ResetCreatedBy resets all changes to the "created_by" field.
-func (m *GithubAppMutation) ResetCreatedBy() {
+func (m *GitlabConnectionMutation) ResetCreatedBy() {
 	m.users = nil
 }
 
 // SetName sets the "name" field.
-func (m *GithubAppMutation) SetName(s string) {
+func (m *GitlabConnectionMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *GithubAppMutation) Name() (r string, exists bool) {
+func (m *GitlabConnectionMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3715,10 +7444,10 @@ func (m *GithubAppMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GitlabConnectionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3733,1335 +7462,1482 @@ func (m *GithubAppMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *GithubAppMutation) ResetName() {
+func (m *GitlabConnectionMutation) ResetName() {
 	m.name = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *GithubAppMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetURL sets the "url" field.
+func (m *GitlabConnectionMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *GithubAppMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// URL returns the value of the "url" field in the mutation.
+func (m *GitlabConnectionMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *GitlabConnectionMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.URL, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *GithubAppMutation) ResetClientID() {
-	m.client_id = nil
+// ResetURL resets all changes to the "url" field.
+func (m *GitlabConnectionMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *GithubAppMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// SetAuthType sets the "auth_type" field.
+func (m *GitlabConnectionMutation) SetAuthType(gt gitlabconnection.AuthType) {
+	m.auth_type = &gt
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *GithubAppMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// AuthType returns the value of the "auth_type" field in the mutation.
+func (m *GitlabConnectionMutation) AuthType() (r gitlabconnection.AuthType, exists bool) {
+	v := m.auth_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthType returns the old "auth_type" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *GitlabConnectionMutation) OldAuthType(ctx context.Context) (v gitlabconnection.AuthType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldAuthType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthType: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.AuthType, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *GithubAppMutation) ResetClientSecret() {
-	m.client_secret = nil
+// ResetAuthType resets all changes to the "auth_type" field.
+func (m *GitlabConnectionMutation) ResetAuthType() {
+	m.auth_type = nil
 }
 
-// SetWebhookSecret sets the "webhook_secret" field.
-func (m *GithubAppMutation) SetWebhookSecret(s string) {
-	m.webhook_secret = &s
+// SetAccountID sets the "account_id" field.
+func (m *GitlabConnectionMutation) SetAccountID(i int64) {
+	m.account_id = &i
+	m.addaccount_id = nil
 }
 
-// WebhookSecret returns the value of the "webhook_secret" field in the mutation.
-func (m *GithubAppMutation) WebhookSecret() (r string, exists bool) {
-	v := m.webhook_secret
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *GitlabConnectionMutation) AccountID() (r int64, exists bool) {
+	v := m.account_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebhookSecret returns the old "webhook_secret" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldWebhookSecret(ctx context.Context) (v string, err error) {
+func (m *GitlabConnectionMutation) OldAccountID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebhookSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebhookSecret requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebhookSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.WebhookSecret, nil
+	return oldValue.AccountID, nil
 }
 
-// ResetWebhookSecret resets all changes to the "webhook_secret" field.
-func (m *GithubAppMutation) ResetWebhookSecret() {
-	m.webhook_secret = nil
+// AddAccountID adds i to the "account_id" field.
+func (m *GitlabConnectionMutation) AddAccountID(i int64) {
+	if m.addaccount_id != nil {
+		*m.addaccount_id += i
+	} else {
+		m.addaccount_id = &i
+	}
 }
 
-// SetPrivateKey sets the "private_key" field.
-func (m *GithubAppMutation) SetPrivateKey(s string) {
-	m.private_key = &s
+// AddedAccountID returns the value that was added to the "account_id" field in this mutation.
+func (m *GitlabConnectionMutation) AddedAccountID() (r int64, exists bool) {
+	v := m.addaccount_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PrivateKey returns the value of the "private_key" field in the mutation.
-func (m *GithubAppMutation) PrivateKey() (r string, exists bool) {
-	v := m.private_key
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *GitlabConnectionMutation) ResetAccountID() {
+	m.account_id = nil
+	m.addaccount_id = nil
+}
+
+// SetAccountUsername sets the "account_username" field.
+func (m *GitlabConnectionMutation) SetAccountUsername(s string) {
+	m.account_username = &s
+}
+
+// AccountUsername returns the value of the "account_username" field in the mutation.
+func (m *GitlabConnectionMutation) AccountUsername() (r string, exists bool) {
+	v := m.account_username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrivateKey returns the old "private_key" field's value of the GithubApp entity.
-// If the GithubApp object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountUsername returns the old "account_username" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubAppMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+func (m *GitlabConnectionMutation) OldAccountUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+		return v, errors.New("OldAccountUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountUsername: %w", err)
 	}
-	return oldValue.PrivateKey, nil
-}
-
-// ResetPrivateKey resets all changes to the "private_key" field.
-func (m *GithubAppMutation) ResetPrivateKey() {
-	m.private_key = nil
+	return oldValue.AccountUsername, nil
 }
 
-// AddInstallationIDs adds the "installations" edge to the GithubInstallation entity by ids.
-func (m *GithubAppMutation) AddInstallationIDs(ids ...int64) {
-	if m.installations == nil {
-		m.installations = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.installations[ids[i]] = struct{}{}
-	}
+// ResetAccountUsername resets all changes to the "account_username" field.
+func (m *GitlabConnectionMutation) ResetAccountUsername() {
+	m.account_username = nil
 }
 
-// ClearInstallations clears the "installations" edge to the GithubInstallation entity.
-func (m *GithubAppMutation) ClearInstallations() {
-	m.clearedinstallations = true
+// SetAccessToken sets the "access_token" field.
+func (m *GitlabConnectionMutation) SetAccessToken(s string) {
+	m.access_token = &s
 }
 
-// InstallationsCleared reports if the "installations" edge to the GithubInstallation entity was cleared.
-func (m *GithubAppMutation) InstallationsCleared() bool {
-	return m.clearedinstallations
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *GitlabConnectionMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveInstallationIDs removes the "installations" edge to the GithubInstallation entity by IDs.
-func (m *GithubAppMutation) RemoveInstallationIDs(ids ...int64) {
-	if m.removedinstallations == nil {
-		m.removedinstallations = make(map[int64]struct{})
+// OldAccessToken returns the old "access_token" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.installations, ids[i])
-		m.removedinstallations[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
 }
 
-// RemovedInstallations returns the removed IDs of the "installations" edge to the GithubInstallation entity.
-func (m *GithubAppMutation) RemovedInstallationsIDs() (ids []int64) {
-	for id := range m.removedinstallations {
-		ids = append(ids, id)
-	}
-	return
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *GitlabConnectionMutation) ResetAccessToken() {
+	m.access_token = nil
 }
 
-// InstallationsIDs returns the "installations" edge IDs in the mutation.
-func (m *GithubAppMutation) InstallationsIDs() (ids []int64) {
-	for id := range m.installations {
-		ids = append(ids, id)
-	}
-	return
+// SetRefreshToken sets the "refresh_token" field.
+func (m *GitlabConnectionMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
 }
 
-// ResetInstallations resets all changes to the "installations" edge.
-func (m *GithubAppMutation) ResetInstallations() {
-	m.installations = nil
-	m.clearedinstallations = false
-	m.removedinstallations = nil
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *GitlabConnectionMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *GithubAppMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// OldRefreshToken returns the old "refresh_token" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldRefreshToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	}
+	return oldValue.RefreshToken, nil
+}
+
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (m *GitlabConnectionMutation) ClearRefreshToken() {
+	m.refresh_token = nil
+	m.clearedFields[gitlabconnection.FieldRefreshToken] = struct{}{}
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GithubAppMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[githubapp.FieldCreatedBy] = struct{}{}
+// RefreshTokenCleared returns if the "refresh_token" field was cleared in this mutation.
+func (m *GitlabConnectionMutation) RefreshTokenCleared() bool {
+	_, ok := m.clearedFields[gitlabconnection.FieldRefreshToken]
+	return ok
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GithubAppMutation) UsersCleared() bool {
-	return m.clearedusers
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *GitlabConnectionMutation) ResetRefreshToken() {
+	m.refresh_token = nil
+	delete(m.clearedFields, gitlabconnection.FieldRefreshToken)
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *GithubAppMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
-	}
-	return
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (m *GitlabConnectionMutation) SetTokenExpiresAt(t time.Time) {
+	m.token_expires_at = &t
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *GithubAppMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// TokenExpiresAt returns the value of the "token_expires_at" field in the mutation.
+func (m *GitlabConnectionMutation) TokenExpiresAt() (r time.Time, exists bool) {
+	v := m.token_expires_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GithubAppMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// OldTokenExpiresAt returns the old "token_expires_at" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldTokenExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenExpiresAt: %w", err)
+	}
+	return oldValue.TokenExpiresAt, nil
 }
 
-// Where appends a list predicates to the GithubAppMutation builder.
-func (m *GithubAppMutation) Where(ps ...predicate.GithubApp) {
-	m.predicates = append(m.predicates, ps...)
+// ClearTokenExpiresAt clears the value of the "token_expires_at" field.
+func (m *GitlabConnectionMutation) ClearTokenExpiresAt() {
+	m.token_expires_at = nil
+	m.clearedFields[gitlabconnection.FieldTokenExpiresAt] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the GithubAppMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GithubAppMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GithubApp, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// TokenExpiresAtCleared returns if the "token_expires_at" field was cleared in this mutation.
+func (m *GitlabConnectionMutation) TokenExpiresAtCleared() bool {
+	_, ok := m.clearedFields[gitlabconnection.FieldTokenExpiresAt]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *GithubAppMutation) Op() Op {
-	return m.op
+// ResetTokenExpiresAt resets all changes to the "token_expires_at" field.
+func (m *GitlabConnectionMutation) ResetTokenExpiresAt() {
+	m.token_expires_at = nil
+	delete(m.clearedFields, gitlabconnection.FieldTokenExpiresAt)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GithubAppMutation) SetOp(op Op) {
-	m.op = op
+// SetClientID sets the "client_id" field.
+func (m *GitlabConnectionMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// Type returns the node type of this mutation (GithubApp).
-func (m *GithubAppMutation) Type() string {
-	return m.typ
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *GitlabConnectionMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GithubAppMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.created_at != nil {
-		fields = append(fields, githubapp.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, githubapp.FieldUpdatedAt)
-	}
-	if m.uuid != nil {
-		fields = append(fields, githubapp.FieldUUID)
-	}
-	if m.users != nil {
-		fields = append(fields, githubapp.FieldCreatedBy)
-	}
-	if m.name != nil {
-		fields = append(fields, githubapp.FieldName)
-	}
-	if m.client_id != nil {
-		fields = append(fields, githubapp.FieldClientID)
-	}
-	if m.client_secret != nil {
-		fields = append(fields, githubapp.FieldClientSecret)
-	}
-	if m.webhook_secret != nil {
-		fields = append(fields, githubapp.FieldWebhookSecret)
+// OldClientID returns the old "client_id" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldClientID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
-	if m.private_key != nil {
-		fields = append(fields, githubapp.FieldPrivateKey)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GithubAppMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case githubapp.FieldCreatedAt:
-		return m.CreatedAt()
-	case githubapp.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case githubapp.FieldUUID:
-		return m.UUID()
-	case githubapp.FieldCreatedBy:
-		return m.CreatedBy()
-	case githubapp.FieldName:
-		return m.Name()
-	case githubapp.FieldClientID:
-		return m.ClientID()
-	case githubapp.FieldClientSecret:
-		return m.ClientSecret()
-	case githubapp.FieldWebhookSecret:
-		return m.WebhookSecret()
-	case githubapp.FieldPrivateKey:
-		return m.PrivateKey()
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return nil, false
+	return oldValue.ClientID, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *GithubAppMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case githubapp.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case githubapp.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case githubapp.FieldUUID:
-		return m.OldUUID(ctx)
-	case githubapp.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case githubapp.FieldName:
-		return m.OldName(ctx)
-	case githubapp.FieldClientID:
-		return m.OldClientID(ctx)
-	case githubapp.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case githubapp.FieldWebhookSecret:
-		return m.OldWebhookSecret(ctx)
-	case githubapp.FieldPrivateKey:
-		return m.OldPrivateKey(ctx)
-	}
-	return nil, fmt.Errorf("unknown GithubApp field %s", name)
+// ClearClientID clears the value of the "client_id" field.
+func (m *GitlabConnectionMutation) ClearClientID() {
+	m.client_id = nil
+	m.clearedFields[gitlabconnection.FieldClientID] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GithubAppMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case githubapp.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case githubapp.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case githubapp.FieldUUID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUUID(v)
-		return nil
-	case githubapp.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case githubapp.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case githubapp.FieldClientID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientID(v)
-		return nil
-	case githubapp.FieldClientSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientSecret(v)
-		return nil
-	case githubapp.FieldWebhookSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebhookSecret(v)
-		return nil
-	case githubapp.FieldPrivateKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrivateKey(v)
-		return nil
-	}
-	return fmt.Errorf("unknown GithubApp field %s", name)
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *GitlabConnectionMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[gitlabconnection.FieldClientID]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *GithubAppMutation) AddedFields() []string {
-	return nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *GitlabConnectionMutation) ResetClientID() {
+	m.client_id = nil
+	delete(m.clearedFields, gitlabconnection.FieldClientID)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *GithubAppMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetClientSecret sets the "client_secret" field.
+func (m *GitlabConnectionMutation) SetClientSecret(s string) {
+	m.client_secret = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *GithubAppMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *GitlabConnectionMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientSecret returns the old "client_secret" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldClientSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown GithubApp numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+	}
+	return oldValue.ClientSecret, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GithubAppMutation) ClearedFields() []string {
-	return nil
+// ClearClientSecret clears the value of the "client_secret" field.
+func (m *GitlabConnectionMutation) ClearClientSecret() {
+	m.client_secret = nil
+	m.clearedFields[gitlabconnection.FieldClientSecret] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GithubAppMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClientSecretCleared returns if the "client_secret" field was cleared in this mutation.
+func (m *GitlabConnectionMutation) ClientSecretCleared() bool {
+	_, ok := m.clearedFields[gitlabconnection.FieldClientSecret]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GithubAppMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GithubApp nullable field %s", name)
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *GitlabConnectionMutation) ResetClientSecret() {
+	m.client_secret = nil
+	delete(m.clearedFields, gitlabconnection.FieldClientSecret)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GithubAppMutation) ResetField(name string) error {
-	switch name {
-	case githubapp.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case githubapp.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case githubapp.FieldUUID:
-		m.ResetUUID()
-		return nil
-	case githubapp.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case githubapp.FieldName:
-		m.ResetName()
-		return nil
-	case githubapp.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case githubapp.FieldClientSecret:
-		m.ResetClientSecret()
-		return nil
-	case githubapp.FieldWebhookSecret:
-		m.ResetWebhookSecret()
-		return nil
-	case githubapp.FieldPrivateKey:
-		m.ResetPrivateKey()
-		return nil
-	}
-	return fmt.Errorf("unknown GithubApp field %s", name)
+// SetWebhookSecret sets the "webhook_secret" field.
+func (m *GitlabConnectionMutation) SetWebhookSecret(s string) {
+	m.webhook_secret = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GithubAppMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.installations != nil {
-		edges = append(edges, githubapp.EdgeInstallations)
-	}
-	if m.users != nil {
-		edges = append(edges, githubapp.EdgeUsers)
+// WebhookSecret returns the value of the "webhook_secret" field in the mutation.
+func (m *GitlabConnectionMutation) WebhookSecret() (r string, exists bool) {
+	v := m.webhook_secret
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GithubAppMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case githubapp.EdgeInstallations:
-		ids := make([]ent.Value, 0, len(m.installations))
-		for id := range m.installations {
-			ids = append(ids, id)
-		}
-		return ids
-	case githubapp.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
+// OldWebhookSecret returns the old "webhook_secret" field's value of the GitlabConnection entity.
+// If the GitlabConnection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GitlabConnectionMutation) OldWebhookSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookSecret is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookSecret: %w", err)
+	}
+	return oldValue.WebhookSecret, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GithubAppMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedinstallations != nil {
-		edges = append(edges, githubapp.EdgeInstallations)
-	}
-	return edges
+// ResetWebhookSecret resets all changes to the "webhook_secret" field.
+func (m *GitlabConnectionMutation) ResetWebhookSecret() {
+	m.webhook_secret = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GithubAppMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case githubapp.EdgeInstallations:
-		ids := make([]ent.Value, 0, len(m.removedinstallations))
-		for id := range m.removedinstallations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *GitlabConnectionMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GithubAppMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedinstallations {
-		edges = append(edges, githubapp.EdgeInstallations)
-	}
-	if m.clearedusers {
-		edges = append(edges, githubapp.EdgeUsers)
-	}
-	return edges
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GitlabConnectionMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[gitlabconnection.FieldCreatedBy] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GithubAppMutation) EdgeCleared(name string) bool {
-	switch name {
-	case githubapp.EdgeInstallations:
-		return m.clearedinstallations
-	case githubapp.EdgeUsers:
-		return m.clearedusers
-	}
-	return false
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GitlabConnectionMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GithubAppMutation) ClearEdge(name string) error {
-	switch name {
-	case githubapp.EdgeUsers:
-		m.ClearUsers()
-		return nil
+// UsersID returns the "users" edge ID in the mutation.
+func (m *GitlabConnectionMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
 	}
-	return fmt.Errorf("unknown GithubApp unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GithubAppMutation) ResetEdge(name string) error {
-	switch name {
-	case githubapp.EdgeInstallations:
-		m.ResetInstallations()
-		return nil
-	case githubapp.EdgeUsers:
-		m.ResetUsers()
-		return nil
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *GitlabConnectionMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown GithubApp edge %s", name)
+	return
 }
 
-// GithubInstallationMutation represents an operation that mutates the GithubInstallation nodes in the graph.
-type GithubInstallationMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *int64
-	created_at           *time.Time
-	updated_at           *time.Time
-	account_id           *int64
-	addaccount_id        *int64
-	account_login        *string
-	account_type         *githubinstallation.AccountType
-	account_url          *string
-	repository_selection *githubinstallation.RepositorySelection
-	suspended            *bool
-	active               *bool
-	permissions          *schema.GithubInstallationPermissions
-	events               *[]string
-	appendevents         []string
-	clearedFields        map[string]struct{}
-	github_app           *int64
-	clearedgithub_app    bool
-	services             map[uuid.UUID]struct{}
-	removedservices      map[uuid.UUID]struct{}
-	clearedservices      bool
-	done                 bool
-	oldValue             func(context.Context) (*GithubInstallation, error)
-	predicates           []predicate.GithubInstallation
+// ResetUsers resets all changes to the "users" edge.
+func (m *GitlabConnectionMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-var _ ent.Mutation = (*GithubInstallationMutation)(nil)
-
-// githubinstallationOption allows management of the mutation configuration using functional options.
-type githubinstallationOption func(*GithubInstallationMutation)
-
-// newGithubInstallationMutation creates new mutation for the GithubInstallation entity.
-func newGithubInstallationMutation(c config, op Op, opts ...githubinstallationOption) *GithubInstallationMutation {
-	m := &GithubInstallationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGithubInstallation,
-		clearedFields: make(map[string]struct{}),
+// AddServiceIDs adds the "services" edge to the Service entity by ids.
+func (m *GitlabConnectionMutation) AddServiceIDs(ids ...uuid.UUID) {
+	if m.services == nil {
+		m.services = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.services[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withGithubInstallationID sets the ID field of the mutation.
-func withGithubInstallationID(id int64) githubinstallationOption {
-	return func(m *GithubInstallationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *GithubInstallation
-		)
-		m.oldValue = func(ctx context.Context) (*GithubInstallation, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().GithubInstallation.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearServices clears the "services" edge to the Service entity.
+func (m *GitlabConnectionMutation) ClearServices() {
+	m.clearedservices = true
+}
+
+// ServicesCleared reports if the "services" edge to the Service entity was cleared.
+func (m *GitlabConnectionMutation) ServicesCleared() bool {
+	return m.clearedservices
 }
 
-// withGithubInstallation sets the old GithubInstallation of the mutation.
-func withGithubInstallation(node *GithubInstallation) githubinstallationOption {
-	return func(m *GithubInstallationMutation) {
-		m.oldValue = func(context.Context) (*GithubInstallation, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
+func (m *GitlabConnectionMutation) RemoveServiceIDs(ids ...uuid.UUID) {
+	if m.removedservices == nil {
+		m.removedservices = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.services, ids[i])
+		m.removedservices[ids[i]] = struct{}{}
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GithubInstallationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
+func (m *GitlabConnectionMutation) RemovedServicesIDs() (ids []uuid.UUID) {
+	for id := range m.removedservices {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GithubInstallationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ServicesIDs returns the "services" edge IDs in the mutation.
+func (m *GitlabConnectionMutation) ServicesIDs() (ids []uuid.UUID) {
+	for id := range m.services {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GithubInstallation entities.
-func (m *GithubInstallationMutation) SetID(id int64) {
-	m.id = &id
+// ResetServices resets all changes to the "services" edge.
+func (m *GitlabConnectionMutation) ResetServices() {
+	m.services = nil
+	m.clearedservices = false
+	m.removedservices = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GithubInstallationMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// Where appends a list predicates to the GitlabConnectionMutation builder.
+func (m *GitlabConnectionMutation) Where(ps ...predicate.GitlabConnection) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GithubInstallationMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GithubInstallation.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// WhereP appends storage-level predicates to the GitlabConnectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GitlabConnectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GitlabConnection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GithubInstallationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Op returns the operation name.
+func (m *GitlabConnectionMutation) Op() Op {
+	return m.op
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GithubInstallationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *GitlabConnectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GitlabConnection).
+func (m *GitlabConnectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GitlabConnectionMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, gitlabconnection.FieldCreatedAt)
 	}
-	return *v, true
+	if m.updated_at != nil {
+		fields = append(fields, gitlabconnection.FieldUpdatedAt)
+	}
+	if m.users != nil {
+		fields = append(fields, gitlabconnection.FieldCreatedBy)
+	}
+	if m.name != nil {
+		fields = append(fields, gitlabconnection.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, gitlabconnection.FieldURL)
+	}
+	if m.auth_type != nil {
+		fields = append(fields, gitlabconnection.FieldAuthType)
+	}
+	if m.account_id != nil {
+		fields = append(fields, gitlabconnection.FieldAccountID)
+	}
+	if m.account_username != nil {
+		fields = append(fields, gitlabconnection.FieldAccountUsername)
+	}
+	if m.access_token != nil {
+		fields = append(fields, gitlabconnection.FieldAccessToken)
+	}
+	if m.refresh_token != nil {
+		fields = append(fields, gitlabconnection.FieldRefreshToken)
+	}
+	if m.token_expires_at != nil {
+		fields = append(fields, gitlabconnection.FieldTokenExpiresAt)
+	}
+	if m.client_id != nil {
+		fields = append(fields, gitlabconnection.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, gitlabconnection.FieldClientSecret)
+	}
+	if m.webhook_secret != nil {
+		fields = append(fields, gitlabconnection.FieldWebhookSecret)
+	}
+	return fields
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GitlabConnectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gitlabconnection.FieldCreatedAt:
+		return m.CreatedAt()
+	case gitlabconnection.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case gitlabconnection.FieldCreatedBy:
+		return m.CreatedBy()
+	case gitlabconnection.FieldName:
+		return m.Name()
+	case gitlabconnection.FieldURL:
+		return m.URL()
+	case gitlabconnection.FieldAuthType:
+		return m.AuthType()
+	case gitlabconnection.FieldAccountID:
+		return m.AccountID()
+	case gitlabconnection.FieldAccountUsername:
+		return m.AccountUsername()
+	case gitlabconnection.FieldAccessToken:
+		return m.AccessToken()
+	case gitlabconnection.FieldRefreshToken:
+		return m.RefreshToken()
+	case gitlabconnection.FieldTokenExpiresAt:
+		return m.TokenExpiresAt()
+	case gitlabconnection.FieldClientID:
+		return m.ClientID()
+	case gitlabconnection.FieldClientSecret:
+		return m.ClientSecret()
+	case gitlabconnection.FieldWebhookSecret:
+		return m.WebhookSecret()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GitlabConnectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gitlabconnection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case gitlabconnection.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case gitlabconnection.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case gitlabconnection.FieldName:
+		return m.OldName(ctx)
+	case gitlabconnection.FieldURL:
+		return m.OldURL(ctx)
+	case gitlabconnection.FieldAuthType:
+		return m.OldAuthType(ctx)
+	case gitlabconnection.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case gitlabconnection.FieldAccountUsername:
+		return m.OldAccountUsername(ctx)
+	case gitlabconnection.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case gitlabconnection.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case gitlabconnection.FieldTokenExpiresAt:
+		return m.OldTokenExpiresAt(ctx)
+	case gitlabconnection.FieldClientID:
+		return m.OldClientID(ctx)
+	case gitlabconnection.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case gitlabconnection.FieldWebhookSecret:
+		return m.OldWebhookSecret(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return nil, fmt.Errorf("unknown GitlabConnection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitlabConnectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gitlabconnection.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case gitlabconnection.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case gitlabconnection.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case gitlabconnection.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case gitlabconnection.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case gitlabconnection.FieldAuthType:
+		v, ok := value.(gitlabconnection.AuthType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthType(v)
+		return nil
+	case gitlabconnection.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case gitlabconnection.FieldAccountUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountUsername(v)
+		return nil
+	case gitlabconnection.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case gitlabconnection.FieldRefreshToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	case gitlabconnection.FieldTokenExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenExpiresAt(v)
+		return nil
+	case gitlabconnection.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case gitlabconnection.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case gitlabconnection.FieldWebhookSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookSecret(v)
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown GitlabConnection field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GithubInstallationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GitlabConnectionMutation) AddedFields() []string {
+	var fields []string
+	if m.addaccount_id != nil {
+		fields = append(fields, gitlabconnection.FieldAccountID)
+	}
+	return fields
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GithubInstallationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GitlabConnectionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gitlabconnection.FieldAccountID:
+		return m.AddedAccountID()
+	}
+	return nil, false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GithubInstallationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GitlabConnectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gitlabconnection.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GitlabConnection numeric field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GitlabConnectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gitlabconnection.FieldRefreshToken) {
+		fields = append(fields, gitlabconnection.FieldRefreshToken)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.FieldCleared(gitlabconnection.FieldTokenExpiresAt) {
+		fields = append(fields, gitlabconnection.FieldTokenExpiresAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.FieldCleared(gitlabconnection.FieldClientID) {
+		fields = append(fields, gitlabconnection.FieldClientID)
 	}
-	return oldValue.UpdatedAt, nil
+	if m.FieldCleared(gitlabconnection.FieldClientSecret) {
+		fields = append(fields, gitlabconnection.FieldClientSecret)
+	}
+	return fields
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GithubInstallationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GitlabConnectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetGithubAppID sets the "github_app_id" field.
-func (m *GithubInstallationMutation) SetGithubAppID(i int64) {
-	m.github_app = &i
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GitlabConnectionMutation) ClearField(name string) error {
+	switch name {
+	case gitlabconnection.FieldRefreshToken:
+		m.ClearRefreshToken()
+		return nil
+	case gitlabconnection.FieldTokenExpiresAt:
+		m.ClearTokenExpiresAt()
+		return nil
+	case gitlabconnection.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case gitlabconnection.FieldClientSecret:
+		m.ClearClientSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown GitlabConnection nullable field %s", name)
 }
 
-// GithubAppID returns the value of the "github_app_id" field in the mutation.
-func (m *GithubInstallationMutation) GithubAppID() (r int64, exists bool) {
-	v := m.github_app
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GitlabConnectionMutation) ResetField(name string) error {
+	switch name {
+	case gitlabconnection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case gitlabconnection.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case gitlabconnection.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case gitlabconnection.FieldName:
+		m.ResetName()
+		return nil
+	case gitlabconnection.FieldURL:
+		m.ResetURL()
+		return nil
+	case gitlabconnection.FieldAuthType:
+		m.ResetAuthType()
+		return nil
+	case gitlabconnection.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case gitlabconnection.FieldAccountUsername:
+		m.ResetAccountUsername()
+		return nil
+	case gitlabconnection.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case gitlabconnection.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	case gitlabconnection.FieldTokenExpiresAt:
+		m.ResetTokenExpiresAt()
+		return nil
+	case gitlabconnection.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case gitlabconnection.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case gitlabconnection.FieldWebhookSecret:
+		m.ResetWebhookSecret()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GitlabConnection field %s", name)
 }
 
-// OldGithubAppID returns the old "github_app_id" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldGithubAppID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGithubAppID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGithubAppID requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GitlabConnectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, gitlabconnection.EdgeUsers)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGithubAppID: %w", err)
+	if m.services != nil {
+		edges = append(edges, gitlabconnection.EdgeServices)
 	}
-	return oldValue.GithubAppID, nil
+	return edges
 }
 
-// ResetGithubAppID resets all changes to the "github_app_id" field.
-func (m *GithubInstallationMutation) ResetGithubAppID() {
-	m.github_app = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GitlabConnectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gitlabconnection.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	case gitlabconnection.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.services))
+		for id := range m.services {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *GithubInstallationMutation) SetAccountID(i int64) {
-	m.account_id = &i
-	m.addaccount_id = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GitlabConnectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedservices != nil {
+		edges = append(edges, gitlabconnection.EdgeServices)
+	}
+	return edges
 }
 
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *GithubInstallationMutation) AccountID() (r int64, exists bool) {
-	v := m.account_id
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GitlabConnectionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case gitlabconnection.EdgeServices:
+		ids := make([]ent.Value, 0, len(m.removedservices))
+		for id := range m.removedservices {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldAccountID returns the old "account_id" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldAccountID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GitlabConnectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, gitlabconnection.EdgeUsers)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	if m.clearedservices {
+		edges = append(edges, gitlabconnection.EdgeServices)
 	}
-	return oldValue.AccountID, nil
+	return edges
 }
 
-// AddAccountID adds i to the "account_id" field.
-func (m *GithubInstallationMutation) AddAccountID(i int64) {
-	if m.addaccount_id != nil {
-		*m.addaccount_id += i
-	} else {
-		m.addaccount_id = &i
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GitlabConnectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gitlabconnection.EdgeUsers:
+		return m.clearedusers
+	case gitlabconnection.EdgeServices:
+		return m.clearedservices
 	}
+	return false
 }
 
-// AddedAccountID returns the value that was added to the "account_id" field in this mutation.
-func (m *GithubInstallationMutation) AddedAccountID() (r int64, exists bool) {
-	v := m.addaccount_id
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GitlabConnectionMutation) ClearEdge(name string) error {
+	switch name {
+	case gitlabconnection.EdgeUsers:
+		m.ClearUsers()
+		return nil
 	}
-	return *v, true
-}
-
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *GithubInstallationMutation) ResetAccountID() {
-	m.account_id = nil
-	m.addaccount_id = nil
-}
-
-// SetAccountLogin sets the "account_login" field.
-func (m *GithubInstallationMutation) SetAccountLogin(s string) {
-	m.account_login = &s
+	return fmt.Errorf("unknown GitlabConnection unique edge %s", name)
 }
 
-// AccountLogin returns the value of the "account_login" field in the mutation.
-func (m *GithubInstallationMutation) AccountLogin() (r string, exists bool) {
-	v := m.account_login
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GitlabConnectionMutation) ResetEdge(name string) error {
+	switch name {
+	case gitlabconnection.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case gitlabconnection.EdgeServices:
+		m.ResetServices()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GitlabConnection edge %s", name)
 }
 
-// OldAccountLogin returns the old "account_login" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldAccountLogin(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountLogin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountLogin requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountLogin: %w", err)
-	}
-	return oldValue.AccountLogin, nil
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	name               *string
+	description        *string
+	k8s_role_name      *string
+	clearedFields      map[string]struct{}
+	users              map[uuid.UUID]struct{}
+	removedusers       map[uuid.UUID]struct{}
+	clearedusers       bool
+	permissions        map[uuid.UUID]struct{}
+	removedpermissions map[uuid.UUID]struct{}
+	clearedpermissions bool
+	done               bool
+	oldValue           func(context.Context) (*Group, error)
+	predicates         []predicate.Group
 }
 
-// ResetAccountLogin resets all changes to the "account_login" field.
-func (m *GithubInstallationMutation) ResetAccountLogin() {
-	m.account_login = nil
-}
+var _ ent.Mutation = (*GroupMutation)(nil)
 
-// SetAccountType sets the "account_type" field.
-func (m *GithubInstallationMutation) SetAccountType(gt githubinstallation.AccountType) {
-	m.account_type = &gt
-}
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
 
-// AccountType returns the value of the "account_type" field in the mutation.
-func (m *GithubInstallationMutation) AccountType() (r githubinstallation.AccountType, exists bool) {
-	v := m.account_type
-	if v == nil {
-		return
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldAccountType returns the old "account_type" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldAccountType(ctx context.Context) (v githubinstallation.AccountType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountType: %w", err)
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id uuid.UUID) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.AccountType, nil
 }
 
-// ResetAccountType resets all changes to the "account_type" field.
-func (m *GithubInstallationMutation) ResetAccountType() {
-	m.account_type = nil
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetAccountURL sets the "account_url" field.
-func (m *GithubInstallationMutation) SetAccountURL(s string) {
-	m.account_url = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AccountURL returns the value of the "account_url" field in the mutation.
-func (m *GithubInstallationMutation) AccountURL() (r string, exists bool) {
-	v := m.account_url
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldAccountURL returns the old "account_url" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldAccountURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountURL: %w", err)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Group entities.
+func (m *GroupMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.AccountURL, nil
+	return *m.id, true
 }
 
-// ResetAccountURL resets all changes to the "account_url" field.
-func (m *GithubInstallationMutation) ResetAccountURL() {
-	m.account_url = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetRepositorySelection sets the "repository_selection" field.
-func (m *GithubInstallationMutation) SetRepositorySelection(gs githubinstallation.RepositorySelection) {
-	m.repository_selection = &gs
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RepositorySelection returns the value of the "repository_selection" field in the mutation.
-func (m *GithubInstallationMutation) RepositorySelection() (r githubinstallation.RepositorySelection, exists bool) {
-	v := m.repository_selection
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepositorySelection returns the old "repository_selection" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldRepositorySelection(ctx context.Context) (v githubinstallation.RepositorySelection, err error) {
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepositorySelection is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepositorySelection requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepositorySelection: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.RepositorySelection, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRepositorySelection resets all changes to the "repository_selection" field.
-func (m *GithubInstallationMutation) ResetRepositorySelection() {
-	m.repository_selection = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetSuspended sets the "suspended" field.
-func (m *GithubInstallationMutation) SetSuspended(b bool) {
-	m.suspended = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Suspended returns the value of the "suspended" field in the mutation.
-func (m *GithubInstallationMutation) Suspended() (r bool, exists bool) {
-	v := m.suspended
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuspended returns the old "suspended" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuspended requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Suspended, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSuspended resets all changes to the "suspended" field.
-func (m *GithubInstallationMutation) ResetSuspended() {
-	m.suspended = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetActive sets the "active" field.
-func (m *GithubInstallationMutation) SetActive(b bool) {
-	m.active = &b
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *GithubInstallationMutation) Active() (r bool, exists bool) {
-	v := m.active
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.Name, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *GithubInstallationMutation) ResetActive() {
-	m.active = nil
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPermissions sets the "permissions" field.
-func (m *GithubInstallationMutation) SetPermissions(sip schema.GithubInstallationPermissions) {
-	m.permissions = &sip
+// SetDescription sets the "description" field.
+func (m *GroupMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Permissions returns the value of the "permissions" field in the mutation.
-func (m *GithubInstallationMutation) Permissions() (r schema.GithubInstallationPermissions, exists bool) {
-	v := m.permissions
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissions returns the old "permissions" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldPermissions(ctx context.Context) (v schema.GithubInstallationPermissions, err error) {
+func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissions requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Permissions, nil
+	return oldValue.Description, nil
 }
 
-// ClearPermissions clears the value of the "permissions" field.
-func (m *GithubInstallationMutation) ClearPermissions() {
-	m.permissions = nil
-	m.clearedFields[githubinstallation.FieldPermissions] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *GroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[group.FieldDescription] = struct{}{}
 }
 
-// PermissionsCleared returns if the "permissions" field was cleared in this mutation.
-func (m *GithubInstallationMutation) PermissionsCleared() bool {
-	_, ok := m.clearedFields[githubinstallation.FieldPermissions]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[group.FieldDescription]
 	return ok
 }
 
-// ResetPermissions resets all changes to the "permissions" field.
-func (m *GithubInstallationMutation) ResetPermissions() {
-	m.permissions = nil
-	delete(m.clearedFields, githubinstallation.FieldPermissions)
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, group.FieldDescription)
 }
 
-// SetEvents sets the "events" field.
-func (m *GithubInstallationMutation) SetEvents(s []string) {
-	m.events = &s
-	m.appendevents = nil
+// SetK8sRoleName sets the "k8s_role_name" field.
+func (m *GroupMutation) SetK8sRoleName(s string) {
+	m.k8s_role_name = &s
 }
 
-// Events returns the value of the "events" field in the mutation.
-func (m *GithubInstallationMutation) Events() (r []string, exists bool) {
-	v := m.events
+// K8sRoleName returns the value of the "k8s_role_name" field in the mutation.
+func (m *GroupMutation) K8sRoleName() (r string, exists bool) {
+	v := m.k8s_role_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvents returns the old "events" field's value of the GithubInstallation entity.
-// If the GithubInstallation object wasn't provided to the builder, the object is fetched from the database.
+// OldK8sRoleName returns the old "k8s_role_name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GithubInstallationMutation) OldEvents(ctx context.Context) (v []string, err error) {
+func (m *GroupMutation) OldK8sRoleName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+		return v, errors.New("OldK8sRoleName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEvents requires an ID field in the mutation")
+		return v, errors.New("OldK8sRoleName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+		return v, fmt.Errorf("querying old value for OldK8sRoleName: %w", err)
 	}
-	return oldValue.Events, nil
+	return oldValue.K8sRoleName, nil
 }
 
-// AppendEvents adds s to the "events" field.
-func (m *GithubInstallationMutation) AppendEvents(s []string) {
-	m.appendevents = append(m.appendevents, s...)
+// ClearK8sRoleName clears the value of the "k8s_role_name" field.
+func (m *GroupMutation) ClearK8sRoleName() {
+	m.k8s_role_name = nil
+	m.clearedFields[group.FieldK8sRoleName] = struct{}{}
 }
 
-// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
-func (m *GithubInstallationMutation) AppendedEvents() ([]string, bool) {
-	if len(m.appendevents) == 0 {
-		return nil, false
-	}
-	return m.appendevents, true
+// K8sRoleNameCleared returns if the "k8s_role_name" field was cleared in this mutation.
+func (m *GroupMutation) K8sRoleNameCleared() bool {
+	_, ok := m.clearedFields[group.FieldK8sRoleName]
+	return ok
 }
 
-// ClearEvents clears the value of the "events" field.
-func (m *GithubInstallationMutation) ClearEvents() {
-	m.events = nil
-	m.appendevents = nil
-	m.clearedFields[githubinstallation.FieldEvents] = struct{}{}
+// ResetK8sRoleName resets all changes to the "k8s_role_name" field.
+func (m *GroupMutation) ResetK8sRoleName() {
+	m.k8s_role_name = nil
+	delete(m.clearedFields, group.FieldK8sRoleName)
 }
 
-// EventsCleared returns if the "events" field was cleared in this mutation.
-func (m *GithubInstallationMutation) EventsCleared() bool {
-	_, ok := m.clearedFields[githubinstallation.FieldEvents]
-	return ok
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
 }
 
-// ResetEvents resets all changes to the "events" field.
-func (m *GithubInstallationMutation) ResetEvents() {
-	m.events = nil
-	m.appendevents = nil
-	delete(m.clearedFields, githubinstallation.FieldEvents)
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// ClearGithubApp clears the "github_app" edge to the GithubApp entity.
-func (m *GithubInstallationMutation) ClearGithubApp() {
-	m.clearedgithub_app = true
-	m.clearedFields[githubinstallation.FieldGithubAppID] = struct{}{}
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// GithubAppCleared reports if the "github_app" edge to the GithubApp entity was cleared.
-func (m *GithubInstallationMutation) GithubAppCleared() bool {
-	return m.clearedgithub_app
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
 }
 
-// GithubAppIDs returns the "github_app" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GithubAppID instead. It exists only for internal usage by the builders.
-func (m *GithubInstallationMutation) GithubAppIDs() (ids []int64) {
-	if id := m.github_app; id != nil {
-		ids = append(ids, *id)
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGithubApp resets all changes to the "github_app" edge.
-func (m *GithubInstallationMutation) ResetGithubApp() {
-	m.github_app = nil
-	m.clearedgithub_app = false
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddServiceIDs adds the "services" edge to the Service entity by ids.
-func (m *GithubInstallationMutation) AddServiceIDs(ids ...uuid.UUID) {
-	if m.services == nil {
-		m.services = make(map[uuid.UUID]struct{})
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
+func (m *GroupMutation) AddPermissionIDs(ids ...uuid.UUID) {
+	if m.permissions == nil {
+		m.permissions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.services[ids[i]] = struct{}{}
+		m.permissions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearServices clears the "services" edge to the Service entity.
-func (m *GithubInstallationMutation) ClearServices() {
-	m.clearedservices = true
+// ClearPermissions clears the "permissions" edge to the Permission entity.
+func (m *GroupMutation) ClearPermissions() {
+	m.clearedpermissions = true
 }
 
-// ServicesCleared reports if the "services" edge to the Service entity was cleared.
-func (m *GithubInstallationMutation) ServicesCleared() bool {
-	return m.clearedservices
+// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
+func (m *GroupMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
 }
 
-// RemoveServiceIDs removes the "services" edge to the Service entity by IDs.
-func (m *GithubInstallationMutation) RemoveServiceIDs(ids ...uuid.UUID) {
-	if m.removedservices == nil {
-		m.removedservices = make(map[uuid.UUID]struct{})
+// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
+func (m *GroupMutation) RemovePermissionIDs(ids ...uuid.UUID) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.services, ids[i])
-		m.removedservices[ids[i]] = struct{}{}
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedServices returns the removed IDs of the "services" edge to the Service entity.
-func (m *GithubInstallationMutation) RemovedServicesIDs() (ids []uuid.UUID) {
-	for id := range m.removedservices {
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
+func (m *GroupMutation) RemovedPermissionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpermissions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ServicesIDs returns the "services" edge IDs in the mutation.
-func (m *GithubInstallationMutation) ServicesIDs() (ids []uuid.UUID) {
-	for id := range m.services {
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *GroupMutation) PermissionsIDs() (ids []uuid.UUID) {
+	for id := range m.permissions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetServices resets all changes to the "services" edge.
-func (m *GithubInstallationMutation) ResetServices() {
-	m.services = nil
-	m.clearedservices = false
-	m.removedservices = nil
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *GroupMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
 }
 
-// Where appends a list predicates to the GithubInstallationMutation builder.
-func (m *GithubInstallationMutation) Where(ps ...predicate.GithubInstallation) {
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GithubInstallationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GithubInstallationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GithubInstallation, len(ps))
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5069,60 +8945,39 @@ func (m *GithubInstallationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GithubInstallationMutation) Op() Op {
+func (m *GroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GithubInstallationMutation) SetOp(op Op) {
+func (m *GroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GithubInstallation).
-func (m *GithubInstallationMutation) Type() string {
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GithubInstallationMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, githubinstallation.FieldCreatedAt)
+		fields = append(fields, group.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, githubinstallation.FieldUpdatedAt)
-	}
-	if m.github_app != nil {
-		fields = append(fields, githubinstallation.FieldGithubAppID)
-	}
-	if m.account_id != nil {
-		fields = append(fields, githubinstallation.FieldAccountID)
-	}
-	if m.account_login != nil {
-		fields = append(fields, githubinstallation.FieldAccountLogin)
-	}
-	if m.account_type != nil {
-		fields = append(fields, githubinstallation.FieldAccountType)
-	}
-	if m.account_url != nil {
-		fields = append(fields, githubinstallation.FieldAccountURL)
-	}
-	if m.repository_selection != nil {
-		fields = append(fields, githubinstallation.FieldRepositorySelection)
-	}
-	if m.suspended != nil {
-		fields = append(fields, githubinstallation.FieldSuspended)
-	}
-	if m.active != nil {
-		fields = append(fields, githubinstallation.FieldActive)
+		fields = append(fields, group.FieldUpdatedAt)
 	}
-	if m.permissions != nil {
-		fields = append(fields, githubinstallation.FieldPermissions)
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
 	}
-	if m.events != nil {
-		fields = append(fields, githubinstallation.FieldEvents)
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.k8s_role_name != nil {
+		fields = append(fields, group.FieldK8sRoleName)
 	}
 	return fields
 }
@@ -5130,32 +8985,18 @@ func (m *GithubInstallationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GithubInstallationMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case githubinstallation.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.CreatedAt()
-	case githubinstallation.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case githubinstallation.FieldGithubAppID:
-		return m.GithubAppID()
-	case githubinstallation.FieldAccountID:
-		return m.AccountID()
-	case githubinstallation.FieldAccountLogin:
-		return m.AccountLogin()
-	case githubinstallation.FieldAccountType:
-		return m.AccountType()
-	case githubinstallation.FieldAccountURL:
-		return m.AccountURL()
-	case githubinstallation.FieldRepositorySelection:
-		return m.RepositorySelection()
-	case githubinstallation.FieldSuspended:
-		return m.Suspended()
-	case githubinstallation.FieldActive:
-		return m.Active()
-	case githubinstallation.FieldPermissions:
-		return m.Permissions()
-	case githubinstallation.FieldEvents:
-		return m.Events()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	case group.FieldK8sRoleName:
+		return m.K8sRoleName()
 	}
 	return nil, false
 }
@@ -5163,267 +9004,170 @@ func (m *GithubInstallationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GithubInstallationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case githubinstallation.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case githubinstallation.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case githubinstallation.FieldGithubAppID:
-		return m.OldGithubAppID(ctx)
-	case githubinstallation.FieldAccountID:
-		return m.OldAccountID(ctx)
-	case githubinstallation.FieldAccountLogin:
-		return m.OldAccountLogin(ctx)
-	case githubinstallation.FieldAccountType:
-		return m.OldAccountType(ctx)
-	case githubinstallation.FieldAccountURL:
-		return m.OldAccountURL(ctx)
-	case githubinstallation.FieldRepositorySelection:
-		return m.OldRepositorySelection(ctx)
-	case githubinstallation.FieldSuspended:
-		return m.OldSuspended(ctx)
-	case githubinstallation.FieldActive:
-		return m.OldActive(ctx)
-	case githubinstallation.FieldPermissions:
-		return m.OldPermissions(ctx)
-	case githubinstallation.FieldEvents:
-		return m.OldEvents(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	case group.FieldK8sRoleName:
+		return m.OldK8sRoleName(ctx)
 	}
-	return nil, fmt.Errorf("unknown GithubInstallation field %s", name)
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GithubInstallationMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case githubinstallation.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case githubinstallation.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case githubinstallation.FieldGithubAppID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGithubAppID(v)
-		return nil
-	case githubinstallation.FieldAccountID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccountID(v)
-		return nil
-	case githubinstallation.FieldAccountLogin:
+	case group.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccountLogin(v)
-		return nil
-	case githubinstallation.FieldAccountType:
-		v, ok := value.(githubinstallation.AccountType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccountType(v)
+		m.SetName(v)
 		return nil
-	case githubinstallation.FieldAccountURL:
+	case group.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccountURL(v)
-		return nil
-	case githubinstallation.FieldRepositorySelection:
-		v, ok := value.(githubinstallation.RepositorySelection)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRepositorySelection(v)
-		return nil
-	case githubinstallation.FieldSuspended:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuspended(v)
-		return nil
-	case githubinstallation.FieldActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActive(v)
-		return nil
-	case githubinstallation.FieldPermissions:
-		v, ok := value.(schema.GithubInstallationPermissions)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPermissions(v)
+		m.SetDescription(v)
 		return nil
-	case githubinstallation.FieldEvents:
-		v, ok := value.([]string)
+	case group.FieldK8sRoleName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvents(v)
+		m.SetK8sRoleName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GithubInstallationMutation) AddedFields() []string {
-	var fields []string
-	if m.addaccount_id != nil {
-		fields = append(fields, githubinstallation.FieldAccountID)
-	}
-	return fields
+func (m *GroupMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GithubInstallationMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case githubinstallation.FieldAccountID:
-		return m.AddedAccountID()
-	}
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GithubInstallationMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case githubinstallation.FieldAccountID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAccountID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation numeric field %s", name)
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GithubInstallationMutation) ClearedFields() []string {
+func (m *GroupMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(githubinstallation.FieldPermissions) {
-		fields = append(fields, githubinstallation.FieldPermissions)
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
 	}
-	if m.FieldCleared(githubinstallation.FieldEvents) {
-		fields = append(fields, githubinstallation.FieldEvents)
+	if m.FieldCleared(group.FieldK8sRoleName) {
+		fields = append(fields, group.FieldK8sRoleName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GithubInstallationMutation) FieldCleared(name string) bool {
+func (m *GroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GithubInstallationMutation) ClearField(name string) error {
+func (m *GroupMutation) ClearField(name string) error {
 	switch name {
-	case githubinstallation.FieldPermissions:
-		m.ClearPermissions()
+	case group.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case githubinstallation.FieldEvents:
-		m.ClearEvents()
+	case group.FieldK8sRoleName:
+		m.ClearK8sRoleName()
 		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation nullable field %s", name)
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GithubInstallationMutation) ResetField(name string) error {
+func (m *GroupMutation) ResetField(name string) error {
 	switch name {
-	case githubinstallation.FieldCreatedAt:
+	case group.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case githubinstallation.FieldUpdatedAt:
+	case group.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case githubinstallation.FieldGithubAppID:
-		m.ResetGithubAppID()
-		return nil
-	case githubinstallation.FieldAccountID:
-		m.ResetAccountID()
-		return nil
-	case githubinstallation.FieldAccountLogin:
-		m.ResetAccountLogin()
-		return nil
-	case githubinstallation.FieldAccountType:
-		m.ResetAccountType()
-		return nil
-	case githubinstallation.FieldAccountURL:
-		m.ResetAccountURL()
-		return nil
-	case githubinstallation.FieldRepositorySelection:
-		m.ResetRepositorySelection()
-		return nil
-	case githubinstallation.FieldSuspended:
-		m.ResetSuspended()
-		return nil
-	case githubinstallation.FieldActive:
-		m.ResetActive()
+	case group.FieldName:
+		m.ResetName()
 		return nil
-	case githubinstallation.FieldPermissions:
-		m.ResetPermissions()
+	case group.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case githubinstallation.FieldEvents:
-		m.ResetEvents()
+	case group.FieldK8sRoleName:
+		m.ResetK8sRoleName()
 		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation field %s", name)
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GithubInstallationMutation) AddedEdges() []string {
+func (m *GroupMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.github_app != nil {
-		edges = append(edges, githubinstallation.EdgeGithubApp)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
 	}
-	if m.services != nil {
-		edges = append(edges, githubinstallation.EdgeServices)
+	if m.permissions != nil {
+		edges = append(edges, group.EdgePermissions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GithubInstallationMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case githubinstallation.EdgeGithubApp:
-		if id := m.github_app; id != nil {
-			return []ent.Value{*id}
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
 		}
-	case githubinstallation.EdgeServices:
-		ids := make([]ent.Value, 0, len(m.services))
-		for id := range m.services {
+		return ids
+	case group.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5432,21 +9176,30 @@ func (m *GithubInstallationMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GithubInstallationMutation) RemovedEdges() []string {
+func (m *GroupMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedservices != nil {
-		edges = append(edges, githubinstallation.EdgeServices)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.removedpermissions != nil {
+		edges = append(edges, group.EdgePermissions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GithubInstallationMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case githubinstallation.EdgeServices:
-		ids := make([]ent.Value, 0, len(m.removedservices))
-		for id := range m.removedservices {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5455,88 +9208,76 @@ func (m *GithubInstallationMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GithubInstallationMutation) ClearedEdges() []string {
+func (m *GroupMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedgithub_app {
-		edges = append(edges, githubinstallation.EdgeGithubApp)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
 	}
-	if m.clearedservices {
-		edges = append(edges, githubinstallation.EdgeServices)
+	if m.clearedpermissions {
+		edges = append(edges, group.EdgePermissions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GithubInstallationMutation) EdgeCleared(name string) bool {
+func (m *GroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case githubinstallation.EdgeGithubApp:
-		return m.clearedgithub_app
-	case githubinstallation.EdgeServices:
-		return m.clearedservices
+	case group.EdgeUsers:
+		return m.clearedusers
+	case group.EdgePermissions:
+		return m.clearedpermissions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GithubInstallationMutation) ClearEdge(name string) error {
+func (m *GroupMutation) ClearEdge(name string) error {
 	switch name {
-	case githubinstallation.EdgeGithubApp:
-		m.ClearGithubApp()
-		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation unique edge %s", name)
+	return fmt.Errorf("unknown Group unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GithubInstallationMutation) ResetEdge(name string) error {
+func (m *GroupMutation) ResetEdge(name string) error {
 	switch name {
-	case githubinstallation.EdgeGithubApp:
-		m.ResetGithubApp()
+	case group.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case githubinstallation.EdgeServices:
-		m.ResetServices()
+	case group.EdgePermissions:
+		m.ResetPermissions()
 		return nil
 	}
-	return fmt.Errorf("unknown GithubInstallation edge %s", name)
+	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// JWTKeyMutation represents an operation that mutates the JWTKey nodes in the graph.
+type JWTKeyMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	name               *string
-	description        *string
-	k8s_role_name      *string
-	clearedFields      map[string]struct{}
-	users              map[uuid.UUID]struct{}
-	removedusers       map[uuid.UUID]struct{}
-	clearedusers       bool
-	permissions        map[uuid.UUID]struct{}
-	removedpermissions map[uuid.UUID]struct{}
-	clearedpermissions bool
-	done               bool
-	oldValue           func(context.Context) (*Group, error)
-	predicates         []predicate.Group
+	op            Op
+	typ           string
+	id            *int
+	label         *string
+	private_key   *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JWTKey, error)
+	predicates    []predicate.JWTKey
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*JWTKeyMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// jwtkeyOption allows management of the mutation configuration using functional options.
+type jwtkeyOption func(*JWTKeyMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newJWTKeyMutation creates new mutation for the JWTKey entity.
+func newJWTKeyMutation(c config, op Op, opts ...jwtkeyOption) *JWTKeyMutation {
+	m := &JWTKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeJWTKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5545,20 +9286,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id uuid.UUID) groupOption {
-	return func(m *GroupMutation) {
+// withJWTKeyID sets the ID field of the mutation.
+func withJWTKeyID(id int) jwtkeyOption {
+	return func(m *JWTKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *JWTKey
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*JWTKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().JWTKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5567,10 +9308,10 @@ func withGroupID(id uuid.UUID) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withJWTKey sets the old JWTKey of the mutation.
+func withJWTKey(node *JWTKey) jwtkeyOption {
+	return func(m *JWTKeyMutation) {
+		m.oldValue = func(context.Context) (*JWTKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5579,380 +9320,132 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m JWTKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Group entities.
-func (m *GroupMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	client.init()
+	return client
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JWTKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JWTKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JWTKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JWTKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *GroupMutation) SetDescription(s string) {
-	m.description = &s
+// SetLabel sets the "label" field.
+func (m *JWTKeyMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *GroupMutation) Description() (r string, exists bool) {
-	v := m.description
+// Label returns the value of the "label" field in the mutation.
+func (m *JWTKeyMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the JWTKey entity.
+// If the JWTKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *JWTKeyMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *GroupMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[group.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *GroupMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[group.FieldDescription]
-	return ok
+	return oldValue.Label, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *GroupMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, group.FieldDescription)
+// ResetLabel resets all changes to the "label" field.
+func (m *JWTKeyMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetK8sRoleName sets the "k8s_role_name" field.
-func (m *GroupMutation) SetK8sRoleName(s string) {
-	m.k8s_role_name = &s
+// SetPrivateKey sets the "private_key" field.
+func (m *JWTKeyMutation) SetPrivateKey(b []byte) {
+	m.private_key = &b
 }
 
-// K8sRoleName returns the value of the "k8s_role_name" field in the mutation.
-func (m *GroupMutation) K8sRoleName() (r string, exists bool) {
-	v := m.k8s_role_name
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *JWTKeyMutation) PrivateKey() (r []byte, exists bool) {
+	v := m.private_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldK8sRoleName returns the old "k8s_role_name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldPrivateKey returns the old "private_key" field's value of the JWTKey entity.
+// If the JWTKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldK8sRoleName(ctx context.Context) (v *string, err error) {
+func (m *JWTKeyMutation) OldPrivateKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldK8sRoleName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldK8sRoleName requires an ID field in the mutation")
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldK8sRoleName: %w", err)
-	}
-	return oldValue.K8sRoleName, nil
-}
-
-// ClearK8sRoleName clears the value of the "k8s_role_name" field.
-func (m *GroupMutation) ClearK8sRoleName() {
-	m.k8s_role_name = nil
-	m.clearedFields[group.FieldK8sRoleName] = struct{}{}
-}
-
-// K8sRoleNameCleared returns if the "k8s_role_name" field was cleared in this mutation.
-func (m *GroupMutation) K8sRoleNameCleared() bool {
-	_, ok := m.clearedFields[group.FieldK8sRoleName]
-	return ok
-}
-
-// ResetK8sRoleName resets all changes to the "k8s_role_name" field.
-func (m *GroupMutation) ResetK8sRoleName() {
-	m.k8s_role_name = nil
-	delete(m.clearedFields, group.FieldK8sRoleName)
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
-}
-
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
-}
-
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
-}
-
-// AddPermissionIDs adds the "permissions" edge to the Permission entity by ids.
-func (m *GroupMutation) AddPermissionIDs(ids ...uuid.UUID) {
-	if m.permissions == nil {
-		m.permissions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.permissions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearPermissions clears the "permissions" edge to the Permission entity.
-func (m *GroupMutation) ClearPermissions() {
-	m.clearedpermissions = true
-}
-
-// PermissionsCleared reports if the "permissions" edge to the Permission entity was cleared.
-func (m *GroupMutation) PermissionsCleared() bool {
-	return m.clearedpermissions
-}
-
-// RemovePermissionIDs removes the "permissions" edge to the Permission entity by IDs.
-func (m *GroupMutation) RemovePermissionIDs(ids ...uuid.UUID) {
-	if m.removedpermissions == nil {
-		m.removedpermissions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.permissions, ids[i])
-		m.removedpermissions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedPermissions returns the removed IDs of the "permissions" edge to the Permission entity.
-func (m *GroupMutation) RemovedPermissionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpermissions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// PermissionsIDs returns the "permissions" edge IDs in the mutation.
-func (m *GroupMutation) PermissionsIDs() (ids []uuid.UUID) {
-	for id := range m.permissions {
-		ids = append(ids, id)
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
 	}
-	return
-}
-
-// ResetPermissions resets all changes to the "permissions" edge.
-func (m *GroupMutation) ResetPermissions() {
-	m.permissions = nil
-	m.clearedpermissions = false
-	m.removedpermissions = nil
+	return oldValue.PrivateKey, nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *JWTKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// Where appends a list predicates to the JWTKeyMutation builder.
+func (m *JWTKeyMutation) Where(ps ...predicate.JWTKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the JWTKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *JWTKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JWTKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5960,39 +9453,30 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *JWTKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *JWTKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (JWTKey).
+func (m *JWTKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, group.FieldDescription)
+func (m *JWTKeyMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.label != nil {
+		fields = append(fields, jwtkey.FieldLabel)
 	}
-	if m.k8s_role_name != nil {
-		fields = append(fields, group.FieldK8sRoleName)
+	if m.private_key != nil {
+		fields = append(fields, jwtkey.FieldPrivateKey)
 	}
 	return fields
 }
@@ -6000,18 +9484,12 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *JWTKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldCreatedAt:
-		return m.CreatedAt()
-	case group.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldDescription:
-		return m.Description()
-	case group.FieldK8sRoleName:
-		return m.K8sRoleName()
+	case jwtkey.FieldLabel:
+		return m.Label()
+	case jwtkey.FieldPrivateKey:
+		return m.PrivateKey()
 	}
 	return nil, false
 }
@@ -6019,448 +9497,567 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JWTKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldDescription:
-		return m.OldDescription(ctx)
-	case group.FieldK8sRoleName:
-		return m.OldK8sRoleName(ctx)
+	case jwtkey.FieldLabel:
+		return m.OldLabel(ctx)
+	case jwtkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown JWTKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *JWTKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case group.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case group.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case group.FieldDescription:
+	case jwtkey.FieldLabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetLabel(v)
 		return nil
-	case group.FieldK8sRoleName:
-		v, ok := value.(string)
+	case jwtkey.FieldPrivateKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetK8sRoleName(v)
+		m.SetPrivateKey(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown JWTKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *JWTKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JWTKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *JWTKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown JWTKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JWTKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JWTKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JWTKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JWTKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JWTKeyMutation) ResetField(name string) error {
 	switch name {
+	case jwtkey.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case jwtkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	}
+	return fmt.Errorf("unknown JWTKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JWTKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JWTKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JWTKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JWTKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JWTKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JWTKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JWTKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JWTKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JWTKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JWTKey edge %s", name)
+}
+
+// Oauth2CodeMutation represents an operation that mutates the Oauth2Code nodes in the graph.
+type Oauth2CodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	auth_code     *string
+	client_id     *string
+	scope         *string
+	expires_at    *time.Time
+	revoked       *bool
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Oauth2Code, error)
+	predicates    []predicate.Oauth2Code
+}
+
+var _ ent.Mutation = (*Oauth2CodeMutation)(nil)
+
+// oauth2codeOption allows management of the mutation configuration using functional options.
+type oauth2codeOption func(*Oauth2CodeMutation)
+
+// newOauth2CodeMutation creates new mutation for the Oauth2Code entity.
+func newOauth2CodeMutation(c config, op Op, opts ...oauth2codeOption) *Oauth2CodeMutation {
+	m := &Oauth2CodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOauth2Code,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOauth2CodeID sets the ID field of the mutation.
+func withOauth2CodeID(id uuid.UUID) oauth2codeOption {
+	return func(m *Oauth2CodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Oauth2Code
+		)
+		m.oldValue = func(ctx context.Context) (*Oauth2Code, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Oauth2Code.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(group.FieldDescription) {
-		fields = append(fields, group.FieldDescription)
-	}
-	if m.FieldCleared(group.FieldK8sRoleName) {
-		fields = append(fields, group.FieldK8sRoleName)
+// withOauth2Code sets the old Oauth2Code of the mutation.
+func withOauth2Code(node *Oauth2Code) oauth2codeOption {
+	return func(m *Oauth2CodeMutation) {
+		m.oldValue = func(context.Context) (*Oauth2Code, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m Oauth2CodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	switch name {
-	case group.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case group.FieldK8sRoleName:
-		m.ClearK8sRoleName()
-		return nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m Oauth2CodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
-	switch name {
-	case group.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case group.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case group.FieldName:
-		m.ResetName()
-		return nil
-	case group.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case group.FieldK8sRoleName:
-		m.ResetK8sRoleName()
-		return nil
-	}
-	return fmt.Errorf("unknown Group field %s", name)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Oauth2Code entities.
+func (m *Oauth2CodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.permissions != nil {
-		edges = append(edges, group.EdgePermissions)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *Oauth2CodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return edges
+	return *m.id, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.permissions))
-		for id := range m.permissions {
-			ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *Oauth2CodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		return ids
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Oauth2Code.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.removedpermissions != nil {
-		edges = append(edges, group.EdgePermissions)
-	}
-	return edges
+// SetCreatedAt sets the "created_at" field.
+func (m *Oauth2CodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgePermissions:
-		ids := make([]ent.Value, 0, len(m.removedpermissions))
-		for id := range m.removedpermissions {
-			ids = append(ids, id)
-		}
-		return ids
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *Oauth2CodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
+// OldCreatedAt returns the old "created_at" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2CodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedpermissions {
-		edges = append(edges, group.EdgePermissions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
-	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
-	case group.EdgePermissions:
-		return m.clearedpermissions
-	}
-	return false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *Oauth2CodeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	switch name {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *Oauth2CodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *Oauth2CodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case group.EdgePermissions:
-		m.ResetPermissions()
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2CodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// JWTKeyMutation represents an operation that mutates the JWTKey nodes in the graph.
-type JWTKeyMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	label         *string
-	private_key   *[]byte
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*JWTKey, error)
-	predicates    []predicate.JWTKey
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *Oauth2CodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAuthCode sets the "auth_code" field.
+func (m *Oauth2CodeMutation) SetAuthCode(s string) {
+	m.auth_code = &s
 }
 
-var _ ent.Mutation = (*JWTKeyMutation)(nil)
-
-// jwtkeyOption allows management of the mutation configuration using functional options.
-type jwtkeyOption func(*JWTKeyMutation)
+// AuthCode returns the value of the "auth_code" field in the mutation.
+func (m *Oauth2CodeMutation) AuthCode() (r string, exists bool) {
+	v := m.auth_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newJWTKeyMutation creates new mutation for the JWTKey entity.
-func newJWTKeyMutation(c config, op Op, opts ...jwtkeyOption) *JWTKeyMutation {
-	m := &JWTKeyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeJWTKey,
-		clearedFields: make(map[string]struct{}),
+// OldAuthCode returns the old "auth_code" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2CodeMutation) OldAuthCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthCode is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthCode requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthCode: %w", err)
+	}
+	return oldValue.AuthCode, nil
 }
 
-// withJWTKeyID sets the ID field of the mutation.
-func withJWTKeyID(id int) jwtkeyOption {
-	return func(m *JWTKeyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *JWTKey
-		)
-		m.oldValue = func(ctx context.Context) (*JWTKey, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().JWTKey.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetAuthCode resets all changes to the "auth_code" field.
+func (m *Oauth2CodeMutation) ResetAuthCode() {
+	m.auth_code = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *Oauth2CodeMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *Oauth2CodeMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withJWTKey sets the old JWTKey of the mutation.
-func withJWTKey(node *JWTKey) jwtkeyOption {
-	return func(m *JWTKeyMutation) {
-		m.oldValue = func(context.Context) (*JWTKey, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldClientID returns the old "client_id" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2CodeMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
+	return oldValue.ClientID, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JWTKeyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetClientID resets all changes to the "client_id" field.
+func (m *Oauth2CodeMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m JWTKeyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetScope sets the "scope" field.
+func (m *Oauth2CodeMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *JWTKeyMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Scope returns the value of the "scope" field in the mutation.
+func (m *Oauth2CodeMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *JWTKeyMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JWTKey.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldScope returns the old "scope" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2CodeMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
+	return oldValue.Scope, nil
 }
 
-// SetLabel sets the "label" field.
-func (m *JWTKeyMutation) SetLabel(s string) {
-	m.label = &s
+// ResetScope resets all changes to the "scope" field.
+func (m *Oauth2CodeMutation) ResetScope() {
+	m.scope = nil
 }
 
-// Label returns the value of the "label" field in the mutation.
-func (m *JWTKeyMutation) Label() (r string, exists bool) {
-	v := m.label
+// SetExpiresAt sets the "expires_at" field.
+func (m *Oauth2CodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *Oauth2CodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLabel returns the old "label" field's value of the JWTKey entity.
-// If the JWTKey object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JWTKeyMutation) OldLabel(ctx context.Context) (v string, err error) {
+func (m *Oauth2CodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLabel requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Label, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetLabel resets all changes to the "label" field.
-func (m *JWTKeyMutation) ResetLabel() {
-	m.label = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *Oauth2CodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetPrivateKey sets the "private_key" field.
-func (m *JWTKeyMutation) SetPrivateKey(b []byte) {
-	m.private_key = &b
+// SetRevoked sets the "revoked" field.
+func (m *Oauth2CodeMutation) SetRevoked(b bool) {
+	m.revoked = &b
 }
 
-// PrivateKey returns the value of the "private_key" field in the mutation.
-func (m *JWTKeyMutation) PrivateKey() (r []byte, exists bool) {
-	v := m.private_key
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *Oauth2CodeMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrivateKey returns the old "private_key" field's value of the JWTKey entity.
-// If the JWTKey object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the Oauth2Code entity.
+// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JWTKeyMutation) OldPrivateKey(ctx context.Context) (v []byte, err error) {
+func (m *Oauth2CodeMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
 	}
-	return oldValue.PrivateKey, nil
+	return oldValue.Revoked, nil
 }
 
-// ResetPrivateKey resets all changes to the "private_key" field.
-func (m *JWTKeyMutation) ResetPrivateKey() {
-	m.private_key = nil
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *Oauth2CodeMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *Oauth2CodeMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *Oauth2CodeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *Oauth2CodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *Oauth2CodeMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *Oauth2CodeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *Oauth2CodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the JWTKeyMutation builder.
-func (m *JWTKeyMutation) Where(ps ...predicate.JWTKey) {
+// Where appends a list predicates to the Oauth2CodeMutation builder.
+func (m *Oauth2CodeMutation) Where(ps ...predicate.Oauth2Code) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JWTKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the Oauth2CodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JWTKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JWTKey, len(ps))
+func (m *Oauth2CodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Oauth2Code, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6468,30 +10065,45 @@ func (m *JWTKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JWTKeyMutation) Op() Op {
+func (m *Oauth2CodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JWTKeyMutation) SetOp(op Op) {
+func (m *Oauth2CodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (JWTKey).
-func (m *JWTKeyMutation) Type() string {
+// Type returns the node type of this mutation (Oauth2Code).
+func (m *Oauth2CodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JWTKeyMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.label != nil {
-		fields = append(fields, jwtkey.FieldLabel)
+func (m *Oauth2CodeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, oauth2code.FieldCreatedAt)
 	}
-	if m.private_key != nil {
-		fields = append(fields, jwtkey.FieldPrivateKey)
+	if m.updated_at != nil {
+		fields = append(fields, oauth2code.FieldUpdatedAt)
+	}
+	if m.auth_code != nil {
+		fields = append(fields, oauth2code.FieldAuthCode)
+	}
+	if m.client_id != nil {
+		fields = append(fields, oauth2code.FieldClientID)
+	}
+	if m.scope != nil {
+		fields = append(fields, oauth2code.FieldScope)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, oauth2code.FieldExpiresAt)
+	}
+	if m.revoked != nil {
+		fields = append(fields, oauth2code.FieldRevoked)
 	}
 	return fields
 }
@@ -6499,12 +10111,22 @@ func (m *JWTKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JWTKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *Oauth2CodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case jwtkey.FieldLabel:
-		return m.Label()
-	case jwtkey.FieldPrivateKey:
-		return m.PrivateKey()
+	case oauth2code.FieldCreatedAt:
+		return m.CreatedAt()
+	case oauth2code.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case oauth2code.FieldAuthCode:
+		return m.AuthCode()
+	case oauth2code.FieldClientID:
+		return m.ClientID()
+	case oauth2code.FieldScope:
+		return m.Scope()
+	case oauth2code.FieldExpiresAt:
+		return m.ExpiresAt()
+	case oauth2code.FieldRevoked:
+		return m.Revoked()
 	}
 	return nil, false
 }
@@ -6512,174 +10134,262 @@ func (m *JWTKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JWTKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *Oauth2CodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case jwtkey.FieldLabel:
-		return m.OldLabel(ctx)
-	case jwtkey.FieldPrivateKey:
-		return m.OldPrivateKey(ctx)
+	case oauth2code.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case oauth2code.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case oauth2code.FieldAuthCode:
+		return m.OldAuthCode(ctx)
+	case oauth2code.FieldClientID:
+		return m.OldClientID(ctx)
+	case oauth2code.FieldScope:
+		return m.OldScope(ctx)
+	case oauth2code.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case oauth2code.FieldRevoked:
+		return m.OldRevoked(ctx)
 	}
-	return nil, fmt.Errorf("unknown JWTKey field %s", name)
+	return nil, fmt.Errorf("unknown Oauth2Code field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JWTKeyMutation) SetField(name string, value ent.Value) error {
+func (m *Oauth2CodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case jwtkey.FieldLabel:
+	case oauth2code.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case oauth2code.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case oauth2code.FieldAuthCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLabel(v)
+		m.SetAuthCode(v)
 		return nil
-	case jwtkey.FieldPrivateKey:
-		v, ok := value.([]byte)
+	case oauth2code.FieldClientID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrivateKey(v)
+		m.SetClientID(v)
+		return nil
+	case oauth2code.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case oauth2code.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case oauth2code.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JWTKey field %s", name)
+	return fmt.Errorf("unknown Oauth2Code field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JWTKeyMutation) AddedFields() []string {
+func (m *Oauth2CodeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JWTKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *Oauth2CodeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JWTKeyMutation) AddField(name string, value ent.Value) error {
+func (m *Oauth2CodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown JWTKey numeric field %s", name)
+	return fmt.Errorf("unknown Oauth2Code numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JWTKeyMutation) ClearedFields() []string {
+func (m *Oauth2CodeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JWTKeyMutation) FieldCleared(name string) bool {
+func (m *Oauth2CodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JWTKeyMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown JWTKey nullable field %s", name)
+func (m *Oauth2CodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Oauth2Code nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JWTKeyMutation) ResetField(name string) error {
+func (m *Oauth2CodeMutation) ResetField(name string) error {
 	switch name {
-	case jwtkey.FieldLabel:
-		m.ResetLabel()
+	case oauth2code.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case jwtkey.FieldPrivateKey:
-		m.ResetPrivateKey()
+	case oauth2code.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case oauth2code.FieldAuthCode:
+		m.ResetAuthCode()
+		return nil
+	case oauth2code.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case oauth2code.FieldScope:
+		m.ResetScope()
+		return nil
+	case oauth2code.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case oauth2code.FieldRevoked:
+		m.ResetRevoked()
 		return nil
 	}
-	return fmt.Errorf("unknown JWTKey field %s", name)
+	return fmt.Errorf("unknown Oauth2Code field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JWTKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *Oauth2CodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, oauth2code.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JWTKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *Oauth2CodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case oauth2code.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JWTKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *Oauth2CodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JWTKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *Oauth2CodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JWTKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *Oauth2CodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, oauth2code.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JWTKeyMutation) EdgeCleared(name string) bool {
+func (m *Oauth2CodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case oauth2code.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JWTKeyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown JWTKey unique edge %s", name)
+func (m *Oauth2CodeMutation) ClearEdge(name string) error {
+	switch name {
+	case oauth2code.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Code unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JWTKeyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown JWTKey edge %s", name)
+func (m *Oauth2CodeMutation) ResetEdge(name string) error {
+	switch name {
+	case oauth2code.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Code edge %s", name)
 }
 
-// Oauth2CodeMutation represents an operation that mutates the Oauth2Code nodes in the graph.
-type Oauth2CodeMutation struct {
+// Oauth2TokenMutation represents an operation that mutates the Oauth2Token nodes in the graph.
+type Oauth2TokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	created_at    *time.Time
 	updated_at    *time.Time
-	auth_code     *string
+	access_token  *string
+	refresh_token *string
 	client_id     *string
-	scope         *string
 	expires_at    *time.Time
 	revoked       *bool
+	scope         *string
+	device_info   *string
 	clearedFields map[string]struct{}
 	user          *uuid.UUID
 	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Oauth2Code, error)
-	predicates    []predicate.Oauth2Code
+	oldValue      func(context.Context) (*Oauth2Token, error)
+	predicates    []predicate.Oauth2Token
 }
 
-var _ ent.Mutation = (*Oauth2CodeMutation)(nil)
+var _ ent.Mutation = (*Oauth2TokenMutation)(nil)
 
-// oauth2codeOption allows management of the mutation configuration using functional options.
-type oauth2codeOption func(*Oauth2CodeMutation)
+// oauth2tokenOption allows management of the mutation configuration using functional options.
+type oauth2tokenOption func(*Oauth2TokenMutation)
 
-// newOauth2CodeMutation creates new mutation for the Oauth2Code entity.
-func newOauth2CodeMutation(c config, op Op, opts ...oauth2codeOption) *Oauth2CodeMutation {
-	m := &Oauth2CodeMutation{
+// newOauth2TokenMutation creates new mutation for the Oauth2Token entity.
+func newOauth2TokenMutation(c config, op Op, opts ...oauth2tokenOption) *Oauth2TokenMutation {
+	m := &Oauth2TokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOauth2Code,
+		typ:           TypeOauth2Token,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6688,20 +10398,20 @@ func newOauth2CodeMutation(c config, op Op, opts ...oauth2codeOption) *Oauth2Cod
 	return m
 }
 
-// withOauth2CodeID sets the ID field of the mutation.
-func withOauth2CodeID(id uuid.UUID) oauth2codeOption {
-	return func(m *Oauth2CodeMutation) {
+// withOauth2TokenID sets the ID field of the mutation.
+func withOauth2TokenID(id uuid.UUID) oauth2tokenOption {
+	return func(m *Oauth2TokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Oauth2Code
+			value *Oauth2Token
 		)
-		m.oldValue = func(ctx context.Context) (*Oauth2Code, error) {
+		m.oldValue = func(ctx context.Context) (*Oauth2Token, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Oauth2Code.Get(ctx, id)
+					value, err = m.Client().Oauth2Token.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6710,10 +10420,10 @@ func withOauth2CodeID(id uuid.UUID) oauth2codeOption {
 	}
 }
 
-// withOauth2Code sets the old Oauth2Code of the mutation.
-func withOauth2Code(node *Oauth2Code) oauth2codeOption {
-	return func(m *Oauth2CodeMutation) {
-		m.oldValue = func(context.Context) (*Oauth2Code, error) {
+// withOauth2Token sets the old Oauth2Token of the mutation.
+func withOauth2Token(node *Oauth2Token) oauth2tokenOption {
+	return func(m *Oauth2TokenMutation) {
+		m.oldValue = func(context.Context) (*Oauth2Token, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6722,7 +10432,7 @@ func withOauth2Code(node *Oauth2Code) oauth2codeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m Oauth2CodeMutation) Client() *Client {
+func (m Oauth2TokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6730,7 +10440,7 @@ func (m Oauth2CodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m Oauth2CodeMutation) Tx() (*Tx, error) {
+func (m Oauth2TokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6740,14 +10450,14 @@ func (m Oauth2CodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Oauth2Code entities.
-func (m *Oauth2CodeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Oauth2Token entities.
+func (m *Oauth2TokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *Oauth2CodeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *Oauth2TokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6758,7 +10468,7 @@ func (m *Oauth2CodeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *Oauth2CodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *Oauth2TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6767,19 +10477,19 @@ func (m *Oauth2CodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Oauth2Code.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Oauth2Token.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *Oauth2CodeMutation) SetCreatedAt(t time.Time) {
+func (m *Oauth2TokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *Oauth2CodeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *Oauth2TokenMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6787,10 +10497,10 @@ func (m *Oauth2CodeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *Oauth2TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6805,17 +10515,17 @@ func (m *Oauth2CodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *Oauth2CodeMutation) ResetCreatedAt() {
+func (m *Oauth2TokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *Oauth2CodeMutation) SetUpdatedAt(t time.Time) {
+func (m *Oauth2TokenMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *Oauth2CodeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *Oauth2TokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6823,10 +10533,10 @@ func (m *Oauth2CodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *Oauth2TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6841,125 +10551,125 @@ func (m *Oauth2CodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *Oauth2CodeMutation) ResetUpdatedAt() {
+func (m *Oauth2TokenMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetAuthCode sets the "auth_code" field.
-func (m *Oauth2CodeMutation) SetAuthCode(s string) {
-	m.auth_code = &s
+// SetAccessToken sets the "access_token" field.
+func (m *Oauth2TokenMutation) SetAccessToken(s string) {
+	m.access_token = &s
 }
 
-// AuthCode returns the value of the "auth_code" field in the mutation.
-func (m *Oauth2CodeMutation) AuthCode() (r string, exists bool) {
-	v := m.auth_code
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *Oauth2TokenMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthCode returns the old "auth_code" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessToken returns the old "access_token" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldAuthCode(ctx context.Context) (v string, err error) {
+func (m *Oauth2TokenMutation) OldAccessToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthCode requires an ID field in the mutation")
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
 	}
-	return oldValue.AuthCode, nil
+	return oldValue.AccessToken, nil
 }
 
-// ResetAuthCode resets all changes to the "auth_code" field.
-func (m *Oauth2CodeMutation) ResetAuthCode() {
-	m.auth_code = nil
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *Oauth2TokenMutation) ResetAccessToken() {
+	m.access_token = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *Oauth2CodeMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetRefreshToken sets the "refresh_token" field.
+func (m *Oauth2TokenMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *Oauth2CodeMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *Oauth2TokenMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshToken returns the old "refresh_token" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *Oauth2TokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.RefreshToken, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *Oauth2CodeMutation) ResetClientID() {
-	m.client_id = nil
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *Oauth2TokenMutation) ResetRefreshToken() {
+	m.refresh_token = nil
 }
 
-// SetScope sets the "scope" field.
-func (m *Oauth2CodeMutation) SetScope(s string) {
-	m.scope = &s
+// SetClientID sets the "client_id" field.
+func (m *Oauth2TokenMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// Scope returns the value of the "scope" field in the mutation.
-func (m *Oauth2CodeMutation) Scope() (r string, exists bool) {
-	v := m.scope
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *Oauth2TokenMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScope returns the old "scope" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldScope(ctx context.Context) (v string, err error) {
+func (m *Oauth2TokenMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScope requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Scope, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetScope resets all changes to the "scope" field.
-func (m *Oauth2CodeMutation) ResetScope() {
-	m.scope = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *Oauth2TokenMutation) ResetClientID() {
+	m.client_id = nil
 }
 
 // SetExpiresAt sets the "expires_at" field.
-func (m *Oauth2CodeMutation) SetExpiresAt(t time.Time) {
+func (m *Oauth2TokenMutation) SetExpiresAt(t time.Time) {
 	m.expires_at = &t
 }
 
 // ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *Oauth2CodeMutation) ExpiresAt() (r time.Time, exists bool) {
+func (m *Oauth2TokenMutation) ExpiresAt() (r time.Time, exists bool) {
 	v := m.expires_at
 	if v == nil {
 		return
@@ -6967,10 +10677,10 @@ func (m *Oauth2CodeMutation) ExpiresAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *Oauth2TokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
@@ -6985,17 +10695,17 @@ func (m *Oauth2CodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *Oauth2CodeMutation) ResetExpiresAt() {
+func (m *Oauth2TokenMutation) ResetExpiresAt() {
 	m.expires_at = nil
 }
 
 // SetRevoked sets the "revoked" field.
-func (m *Oauth2CodeMutation) SetRevoked(b bool) {
+func (m *Oauth2TokenMutation) SetRevoked(b bool) {
 	m.revoked = &b
 }
 
 // Revoked returns the value of the "revoked" field in the mutation.
-func (m *Oauth2CodeMutation) Revoked() (r bool, exists bool) {
+func (m *Oauth2TokenMutation) Revoked() (r bool, exists bool) {
 	v := m.revoked
 	if v == nil {
 		return
@@ -7003,45 +10713,130 @@ func (m *Oauth2CodeMutation) Revoked() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the Oauth2Code entity.
-// If the Oauth2Code object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2CodeMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *Oauth2TokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *Oauth2TokenMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *Oauth2TokenMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *Oauth2TokenMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2TokenMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *Oauth2TokenMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetDeviceInfo sets the "device_info" field.
+func (m *Oauth2TokenMutation) SetDeviceInfo(s string) {
+	m.device_info = &s
+}
+
+// DeviceInfo returns the value of the "device_info" field in the mutation.
+func (m *Oauth2TokenMutation) DeviceInfo() (r string, exists bool) {
+	v := m.device_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceInfo returns the old "device_info" field's value of the Oauth2Token entity.
+// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2TokenMutation) OldDeviceInfo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceInfo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceInfo: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.DeviceInfo, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *Oauth2CodeMutation) ResetRevoked() {
-	m.revoked = nil
+// ClearDeviceInfo clears the value of the "device_info" field.
+func (m *Oauth2TokenMutation) ClearDeviceInfo() {
+	m.device_info = nil
+	m.clearedFields[oauth2token.FieldDeviceInfo] = struct{}{}
+}
+
+// DeviceInfoCleared returns if the "device_info" field was cleared in this mutation.
+func (m *Oauth2TokenMutation) DeviceInfoCleared() bool {
+	_, ok := m.clearedFields[oauth2token.FieldDeviceInfo]
+	return ok
+}
+
+// ResetDeviceInfo resets all changes to the "device_info" field.
+func (m *Oauth2TokenMutation) ResetDeviceInfo() {
+	m.device_info = nil
+	delete(m.clearedFields, oauth2token.FieldDeviceInfo)
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *Oauth2CodeMutation) SetUserID(id uuid.UUID) {
+func (m *Oauth2TokenMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *Oauth2CodeMutation) ClearUser() {
+func (m *Oauth2TokenMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *Oauth2CodeMutation) UserCleared() bool {
+func (m *Oauth2TokenMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *Oauth2CodeMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *Oauth2TokenMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -7051,7 +10846,7 @@ func (m *Oauth2CodeMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *Oauth2CodeMutation) UserIDs() (ids []uuid.UUID) {
+func (m *Oauth2TokenMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7059,20 +10854,20 @@ func (m *Oauth2CodeMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *Oauth2CodeMutation) ResetUser() {
+func (m *Oauth2TokenMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the Oauth2CodeMutation builder.
-func (m *Oauth2CodeMutation) Where(ps ...predicate.Oauth2Code) {
+// Where appends a list predicates to the Oauth2TokenMutation builder.
+func (m *Oauth2TokenMutation) Where(ps ...predicate.Oauth2Token) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the Oauth2CodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the Oauth2TokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *Oauth2CodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Oauth2Code, len(ps))
+func (m *Oauth2TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Oauth2Token, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7080,45 +10875,51 @@ func (m *Oauth2CodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *Oauth2CodeMutation) Op() Op {
+func (m *Oauth2TokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *Oauth2CodeMutation) SetOp(op Op) {
+func (m *Oauth2TokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Oauth2Code).
-func (m *Oauth2CodeMutation) Type() string {
+// Type returns the node type of this mutation (Oauth2Token).
+func (m *Oauth2TokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *Oauth2CodeMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *Oauth2TokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, oauth2code.FieldCreatedAt)
+		fields = append(fields, oauth2token.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, oauth2code.FieldUpdatedAt)
+		fields = append(fields, oauth2token.FieldUpdatedAt)
 	}
-	if m.auth_code != nil {
-		fields = append(fields, oauth2code.FieldAuthCode)
+	if m.access_token != nil {
+		fields = append(fields, oauth2token.FieldAccessToken)
 	}
-	if m.client_id != nil {
-		fields = append(fields, oauth2code.FieldClientID)
+	if m.refresh_token != nil {
+		fields = append(fields, oauth2token.FieldRefreshToken)
 	}
-	if m.scope != nil {
-		fields = append(fields, oauth2code.FieldScope)
+	if m.client_id != nil {
+		fields = append(fields, oauth2token.FieldClientID)
 	}
 	if m.expires_at != nil {
-		fields = append(fields, oauth2code.FieldExpiresAt)
+		fields = append(fields, oauth2token.FieldExpiresAt)
 	}
 	if m.revoked != nil {
-		fields = append(fields, oauth2code.FieldRevoked)
+		fields = append(fields, oauth2token.FieldRevoked)
+	}
+	if m.scope != nil {
+		fields = append(fields, oauth2token.FieldScope)
+	}
+	if m.device_info != nil {
+		fields = append(fields, oauth2token.FieldDeviceInfo)
 	}
 	return fields
 }
@@ -7126,22 +10927,26 @@ func (m *Oauth2CodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *Oauth2CodeMutation) Field(name string) (ent.Value, bool) {
+func (m *Oauth2TokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case oauth2code.FieldCreatedAt:
+	case oauth2token.FieldCreatedAt:
 		return m.CreatedAt()
-	case oauth2code.FieldUpdatedAt:
+	case oauth2token.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case oauth2code.FieldAuthCode:
-		return m.AuthCode()
-	case oauth2code.FieldClientID:
+	case oauth2token.FieldAccessToken:
+		return m.AccessToken()
+	case oauth2token.FieldRefreshToken:
+		return m.RefreshToken()
+	case oauth2token.FieldClientID:
 		return m.ClientID()
-	case oauth2code.FieldScope:
-		return m.Scope()
-	case oauth2code.FieldExpiresAt:
+	case oauth2token.FieldExpiresAt:
 		return m.ExpiresAt()
-	case oauth2code.FieldRevoked:
+	case oauth2token.FieldRevoked:
 		return m.Revoked()
+	case oauth2token.FieldScope:
+		return m.Scope()
+	case oauth2token.FieldDeviceInfo:
+		return m.DeviceInfo()
 	}
 	return nil, false
 }
@@ -7149,168 +10954,201 @@ func (m *Oauth2CodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *Oauth2CodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *Oauth2TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauth2code.FieldCreatedAt:
+	case oauth2token.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case oauth2code.FieldUpdatedAt:
+	case oauth2token.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case oauth2code.FieldAuthCode:
-		return m.OldAuthCode(ctx)
-	case oauth2code.FieldClientID:
+	case oauth2token.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case oauth2token.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case oauth2token.FieldClientID:
 		return m.OldClientID(ctx)
-	case oauth2code.FieldScope:
-		return m.OldScope(ctx)
-	case oauth2code.FieldExpiresAt:
+	case oauth2token.FieldExpiresAt:
 		return m.OldExpiresAt(ctx)
-	case oauth2code.FieldRevoked:
+	case oauth2token.FieldRevoked:
 		return m.OldRevoked(ctx)
+	case oauth2token.FieldScope:
+		return m.OldScope(ctx)
+	case oauth2token.FieldDeviceInfo:
+		return m.OldDeviceInfo(ctx)
 	}
-	return nil, fmt.Errorf("unknown Oauth2Code field %s", name)
+	return nil, fmt.Errorf("unknown Oauth2Token field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2CodeMutation) SetField(name string, value ent.Value) error {
+func (m *Oauth2TokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauth2code.FieldCreatedAt:
+	case oauth2token.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case oauth2code.FieldUpdatedAt:
+	case oauth2token.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case oauth2code.FieldAuthCode:
+	case oauth2token.FieldAccessToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthCode(v)
+		m.SetAccessToken(v)
 		return nil
-	case oauth2code.FieldClientID:
+	case oauth2token.FieldRefreshToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetRefreshToken(v)
 		return nil
-	case oauth2code.FieldScope:
+	case oauth2token.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScope(v)
+		m.SetClientID(v)
 		return nil
-	case oauth2code.FieldExpiresAt:
+	case oauth2token.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiresAt(v)
 		return nil
-	case oauth2code.FieldRevoked:
+	case oauth2token.FieldRevoked:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRevoked(v)
 		return nil
+	case oauth2token.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case oauth2token.FieldDeviceInfo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceInfo(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Code field %s", name)
+	return fmt.Errorf("unknown Oauth2Token field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *Oauth2CodeMutation) AddedFields() []string {
+func (m *Oauth2TokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *Oauth2CodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *Oauth2TokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2CodeMutation) AddField(name string, value ent.Value) error {
+func (m *Oauth2TokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Oauth2Code numeric field %s", name)
+	return fmt.Errorf("unknown Oauth2Token numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *Oauth2CodeMutation) ClearedFields() []string {
-	return nil
+func (m *Oauth2TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oauth2token.FieldDeviceInfo) {
+		fields = append(fields, oauth2token.FieldDeviceInfo)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *Oauth2CodeMutation) FieldCleared(name string) bool {
+func (m *Oauth2TokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *Oauth2CodeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Oauth2Code nullable field %s", name)
+func (m *Oauth2TokenMutation) ClearField(name string) error {
+	switch name {
+	case oauth2token.FieldDeviceInfo:
+		m.ClearDeviceInfo()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Token nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *Oauth2CodeMutation) ResetField(name string) error {
+func (m *Oauth2TokenMutation) ResetField(name string) error {
 	switch name {
-	case oauth2code.FieldCreatedAt:
+	case oauth2token.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case oauth2code.FieldUpdatedAt:
+	case oauth2token.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case oauth2code.FieldAuthCode:
-		m.ResetAuthCode()
+	case oauth2token.FieldAccessToken:
+		m.ResetAccessToken()
 		return nil
-	case oauth2code.FieldClientID:
-		m.ResetClientID()
+	case oauth2token.FieldRefreshToken:
+		m.ResetRefreshToken()
 		return nil
-	case oauth2code.FieldScope:
-		m.ResetScope()
+	case oauth2token.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case oauth2code.FieldExpiresAt:
+	case oauth2token.FieldExpiresAt:
 		m.ResetExpiresAt()
 		return nil
-	case oauth2code.FieldRevoked:
+	case oauth2token.FieldRevoked:
 		m.ResetRevoked()
 		return nil
+	case oauth2token.FieldScope:
+		m.ResetScope()
+		return nil
+	case oauth2token.FieldDeviceInfo:
+		m.ResetDeviceInfo()
+		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Code field %s", name)
+	return fmt.Errorf("unknown Oauth2Token field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *Oauth2CodeMutation) AddedEdges() []string {
+func (m *Oauth2TokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, oauth2code.EdgeUser)
+		edges = append(edges, oauth2token.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *Oauth2CodeMutation) AddedIDs(name string) []ent.Value {
+func (m *Oauth2TokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case oauth2code.EdgeUser:
+	case oauth2token.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -7319,31 +11157,31 @@ func (m *Oauth2CodeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *Oauth2CodeMutation) RemovedEdges() []string {
+func (m *Oauth2TokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *Oauth2CodeMutation) RemovedIDs(name string) []ent.Value {
+func (m *Oauth2TokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *Oauth2CodeMutation) ClearedEdges() []string {
+func (m *Oauth2TokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, oauth2code.EdgeUser)
+		edges = append(edges, oauth2token.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *Oauth2CodeMutation) EdgeCleared(name string) bool {
+func (m *Oauth2TokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case oauth2code.EdgeUser:
+	case oauth2token.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -7351,60 +11189,54 @@ func (m *Oauth2CodeMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *Oauth2CodeMutation) ClearEdge(name string) error {
+func (m *Oauth2TokenMutation) ClearEdge(name string) error {
 	switch name {
-	case oauth2code.EdgeUser:
+	case oauth2token.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Code unique edge %s", name)
+	return fmt.Errorf("unknown Oauth2Token unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *Oauth2CodeMutation) ResetEdge(name string) error {
+func (m *Oauth2TokenMutation) ResetEdge(name string) error {
 	switch name {
-	case oauth2code.EdgeUser:
+	case oauth2token.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Code edge %s", name)
+	return fmt.Errorf("unknown Oauth2Token edge %s", name)
 }
 
-// Oauth2TokenMutation represents an operation that mutates the Oauth2Token nodes in the graph.
-type Oauth2TokenMutation struct {
+// PVCMetadataMutation represents an operation that mutates the PVCMetadata nodes in the graph.
+type PVCMetadataMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	created_at    *time.Time
 	updated_at    *time.Time
-	access_token  *string
-	refresh_token *string
-	client_id     *string
-	expires_at    *time.Time
-	revoked       *bool
-	scope         *string
-	device_info   *string
+	pvc_id        *string
+	name          *string
+	description   *string
 	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Oauth2Token, error)
-	predicates    []predicate.Oauth2Token
+	oldValue      func(context.Context) (*PVCMetadata, error)
+	predicates    []predicate.PVCMetadata
 }
 
-var _ ent.Mutation = (*Oauth2TokenMutation)(nil)
+var _ ent.Mutation = (*PVCMetadataMutation)(nil)
 
-// oauth2tokenOption allows management of the mutation configuration using functional options.
-type oauth2tokenOption func(*Oauth2TokenMutation)
+// pvcmetadataOption allows management of the mutation configuration using functional options.
+type pvcmetadataOption func(*PVCMetadataMutation)
 
-// newOauth2TokenMutation creates new mutation for the Oauth2Token entity.
-func newOauth2TokenMutation(c config, op Op, opts ...oauth2tokenOption) *Oauth2TokenMutation {
-	m := &Oauth2TokenMutation{
+// newPVCMetadataMutation creates new mutation for the PVCMetadata entity.
+func newPVCMetadataMutation(c config, op Op, opts ...pvcmetadataOption) *PVCMetadataMutation {
+	m := &PVCMetadataMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOauth2Token,
+		typ:           TypePVCMetadata,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7413,476 +11245,306 @@ func newOauth2TokenMutation(c config, op Op, opts ...oauth2tokenOption) *Oauth2T
 	return m
 }
 
-// withOauth2TokenID sets the ID field of the mutation.
-func withOauth2TokenID(id uuid.UUID) oauth2tokenOption {
-	return func(m *Oauth2TokenMutation) {
+// withPVCMetadataID sets the ID field of the mutation.
+func withPVCMetadataID(id uuid.UUID) pvcmetadataOption {
+	return func(m *PVCMetadataMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Oauth2Token
+			value *PVCMetadata
 		)
-		m.oldValue = func(ctx context.Context) (*Oauth2Token, error) {
+		m.oldValue = func(ctx context.Context) (*PVCMetadata, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Oauth2Token.Get(ctx, id)
+					value, err = m.Client().PVCMetadata.Get(ctx, id)
 				}
 			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withOauth2Token sets the old Oauth2Token of the mutation.
-func withOauth2Token(node *Oauth2Token) oauth2tokenOption {
-	return func(m *Oauth2TokenMutation) {
-		m.oldValue = func(context.Context) (*Oauth2Token, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m Oauth2TokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m Oauth2TokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Oauth2Token entities.
-func (m *Oauth2TokenMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *Oauth2TokenMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *Oauth2TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Oauth2Token.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *Oauth2TokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *Oauth2TokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *Oauth2TokenMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *Oauth2TokenMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *Oauth2TokenMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *Oauth2TokenMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetAccessToken sets the "access_token" field.
-func (m *Oauth2TokenMutation) SetAccessToken(s string) {
-	m.access_token = &s
-}
-
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *Oauth2TokenMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAccessToken returns the old "access_token" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldAccessToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
-	}
-	return oldValue.AccessToken, nil
-}
-
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *Oauth2TokenMutation) ResetAccessToken() {
-	m.access_token = nil
-}
-
-// SetRefreshToken sets the "refresh_token" field.
-func (m *Oauth2TokenMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
-}
-
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *Oauth2TokenMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRefreshToken returns the old "refresh_token" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.RefreshToken, nil
 }
 
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *Oauth2TokenMutation) ResetRefreshToken() {
-	m.refresh_token = nil
+// withPVCMetadata sets the old PVCMetadata of the mutation.
+func withPVCMetadata(node *PVCMetadata) pvcmetadataOption {
+	return func(m *PVCMetadataMutation) {
+		m.oldValue = func(context.Context) (*PVCMetadata, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *Oauth2TokenMutation) SetClientID(s string) {
-	m.client_id = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PVCMetadataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *Oauth2TokenMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PVCMetadataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldClientID returns the old "client_id" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldClientID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PVCMetadata entities.
+func (m *PVCMetadataMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PVCMetadataMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.ClientID, nil
+	return *m.id, true
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *Oauth2TokenMutation) ResetClientID() {
-	m.client_id = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PVCMetadataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PVCMetadata.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *Oauth2TokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *PVCMetadataMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *Oauth2TokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PVCMetadataMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PVCMetadata entity.
+// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *PVCMetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *Oauth2TokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PVCMetadataMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *Oauth2TokenMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PVCMetadataMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *Oauth2TokenMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PVCMetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PVCMetadata entity.
+// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *PVCMetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *Oauth2TokenMutation) ResetRevoked() {
-	m.revoked = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PVCMetadataMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetScope sets the "scope" field.
-func (m *Oauth2TokenMutation) SetScope(s string) {
-	m.scope = &s
+// SetPvcID sets the "pvc_id" field.
+func (m *PVCMetadataMutation) SetPvcID(s string) {
+	m.pvc_id = &s
 }
 
-// Scope returns the value of the "scope" field in the mutation.
-func (m *Oauth2TokenMutation) Scope() (r string, exists bool) {
-	v := m.scope
+// PvcID returns the value of the "pvc_id" field in the mutation.
+func (m *PVCMetadataMutation) PvcID() (r string, exists bool) {
+	v := m.pvc_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScope returns the old "scope" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// OldPvcID returns the old "pvc_id" field's value of the PVCMetadata entity.
+// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldScope(ctx context.Context) (v string, err error) {
+func (m *PVCMetadataMutation) OldPvcID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+		return v, errors.New("OldPvcID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScope requires an ID field in the mutation")
+		return v, errors.New("OldPvcID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+		return v, fmt.Errorf("querying old value for OldPvcID: %w", err)
 	}
-	return oldValue.Scope, nil
+	return oldValue.PvcID, nil
 }
 
-// ResetScope resets all changes to the "scope" field.
-func (m *Oauth2TokenMutation) ResetScope() {
-	m.scope = nil
+// ResetPvcID resets all changes to the "pvc_id" field.
+func (m *PVCMetadataMutation) ResetPvcID() {
+	m.pvc_id = nil
 }
 
-// SetDeviceInfo sets the "device_info" field.
-func (m *Oauth2TokenMutation) SetDeviceInfo(s string) {
-	m.device_info = &s
+// SetName sets the "name" field.
+func (m *PVCMetadataMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeviceInfo returns the value of the "device_info" field in the mutation.
-func (m *Oauth2TokenMutation) DeviceInfo() (r string, exists bool) {
-	v := m.device_info
+// Name returns the value of the "name" field in the mutation.
+func (m *PVCMetadataMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceInfo returns the old "device_info" field's value of the Oauth2Token entity.
-// If the Oauth2Token object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PVCMetadata entity.
+// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2TokenMutation) OldDeviceInfo(ctx context.Context) (v string, err error) {
+func (m *PVCMetadataMutation) OldName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceInfo is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceInfo requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceInfo: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DeviceInfo, nil
+	return oldValue.Name, nil
 }
 
-// ClearDeviceInfo clears the value of the "device_info" field.
-func (m *Oauth2TokenMutation) ClearDeviceInfo() {
-	m.device_info = nil
-	m.clearedFields[oauth2token.FieldDeviceInfo] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *PVCMetadataMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[pvcmetadata.FieldName] = struct{}{}
 }
 
-// DeviceInfoCleared returns if the "device_info" field was cleared in this mutation.
-func (m *Oauth2TokenMutation) DeviceInfoCleared() bool {
-	_, ok := m.clearedFields[oauth2token.FieldDeviceInfo]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *PVCMetadataMutation) NameCleared() bool {
+	_, ok := m.clearedFields[pvcmetadata.FieldName]
 	return ok
 }
 
-// ResetDeviceInfo resets all changes to the "device_info" field.
-func (m *Oauth2TokenMutation) ResetDeviceInfo() {
-	m.device_info = nil
-	delete(m.clearedFields, oauth2token.FieldDeviceInfo)
+// ResetName resets all changes to the "name" field.
+func (m *PVCMetadataMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, pvcmetadata.FieldName)
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *Oauth2TokenMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// SetDescription sets the "description" field.
+func (m *PVCMetadataMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *Oauth2TokenMutation) ClearUser() {
-	m.cleareduser = true
+// Description returns the value of the "description" field in the mutation.
+func (m *PVCMetadataMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *Oauth2TokenMutation) UserCleared() bool {
-	return m.cleareduser
+// OldDescription returns the old "description" field's value of the PVCMetadata entity.
+// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PVCMetadataMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *Oauth2TokenMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// ClearDescription clears the value of the "description" field.
+func (m *PVCMetadataMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[pvcmetadata.FieldDescription] = struct{}{}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *Oauth2TokenMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PVCMetadataMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[pvcmetadata.FieldDescription]
+	return ok
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *Oauth2TokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetDescription resets all changes to the "description" field.
+func (m *PVCMetadataMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, pvcmetadata.FieldDescription)
 }
 
-// Where appends a list predicates to the Oauth2TokenMutation builder.
-func (m *Oauth2TokenMutation) Where(ps ...predicate.Oauth2Token) {
+// Where appends a list predicates to the PVCMetadataMutation builder.
+func (m *PVCMetadataMutation) Where(ps ...predicate.PVCMetadata) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the Oauth2TokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PVCMetadataMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *Oauth2TokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Oauth2Token, len(ps))
+func (m *PVCMetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PVCMetadata, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7890,51 +11552,39 @@ func (m *Oauth2TokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *Oauth2TokenMutation) Op() Op {
+func (m *PVCMetadataMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *Oauth2TokenMutation) SetOp(op Op) {
+func (m *PVCMetadataMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Oauth2Token).
-func (m *Oauth2TokenMutation) Type() string {
+// Type returns the node type of this mutation (PVCMetadata).
+func (m *PVCMetadataMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *Oauth2TokenMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *PVCMetadataMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, oauth2token.FieldCreatedAt)
+		fields = append(fields, pvcmetadata.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, oauth2token.FieldUpdatedAt)
-	}
-	if m.access_token != nil {
-		fields = append(fields, oauth2token.FieldAccessToken)
-	}
-	if m.refresh_token != nil {
-		fields = append(fields, oauth2token.FieldRefreshToken)
-	}
-	if m.client_id != nil {
-		fields = append(fields, oauth2token.FieldClientID)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, oauth2token.FieldExpiresAt)
+		fields = append(fields, pvcmetadata.FieldUpdatedAt)
 	}
-	if m.revoked != nil {
-		fields = append(fields, oauth2token.FieldRevoked)
+	if m.pvc_id != nil {
+		fields = append(fields, pvcmetadata.FieldPvcID)
 	}
-	if m.scope != nil {
-		fields = append(fields, oauth2token.FieldScope)
+	if m.name != nil {
+		fields = append(fields, pvcmetadata.FieldName)
 	}
-	if m.device_info != nil {
-		fields = append(fields, oauth2token.FieldDeviceInfo)
+	if m.description != nil {
+		fields = append(fields, pvcmetadata.FieldDescription)
 	}
 	return fields
 }
@@ -7942,26 +11592,18 @@ func (m *Oauth2TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *Oauth2TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *PVCMetadataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case oauth2token.FieldCreatedAt:
+	case pvcmetadata.FieldCreatedAt:
 		return m.CreatedAt()
-	case oauth2token.FieldUpdatedAt:
+	case pvcmetadata.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case oauth2token.FieldAccessToken:
-		return m.AccessToken()
-	case oauth2token.FieldRefreshToken:
-		return m.RefreshToken()
-	case oauth2token.FieldClientID:
-		return m.ClientID()
-	case oauth2token.FieldExpiresAt:
-		return m.ExpiresAt()
-	case oauth2token.FieldRevoked:
-		return m.Revoked()
-	case oauth2token.FieldScope:
-		return m.Scope()
-	case oauth2token.FieldDeviceInfo:
-		return m.DeviceInfo()
+	case pvcmetadata.FieldPvcID:
+		return m.PvcID()
+	case pvcmetadata.FieldName:
+		return m.Name()
+	case pvcmetadata.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -7969,289 +11611,224 @@ func (m *Oauth2TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *Oauth2TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PVCMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauth2token.FieldCreatedAt:
+	case pvcmetadata.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case oauth2token.FieldUpdatedAt:
+	case pvcmetadata.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case oauth2token.FieldAccessToken:
-		return m.OldAccessToken(ctx)
-	case oauth2token.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	case oauth2token.FieldClientID:
-		return m.OldClientID(ctx)
-	case oauth2token.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case oauth2token.FieldRevoked:
-		return m.OldRevoked(ctx)
-	case oauth2token.FieldScope:
-		return m.OldScope(ctx)
-	case oauth2token.FieldDeviceInfo:
-		return m.OldDeviceInfo(ctx)
+	case pvcmetadata.FieldPvcID:
+		return m.OldPvcID(ctx)
+	case pvcmetadata.FieldName:
+		return m.OldName(ctx)
+	case pvcmetadata.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown Oauth2Token field %s", name)
+	return nil, fmt.Errorf("unknown PVCMetadata field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2TokenMutation) SetField(name string, value ent.Value) error {
+func (m *PVCMetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauth2token.FieldCreatedAt:
+	case pvcmetadata.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case oauth2token.FieldUpdatedAt:
+	case pvcmetadata.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case oauth2token.FieldAccessToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccessToken(v)
-		return nil
-	case oauth2token.FieldRefreshToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRefreshToken(v)
-		return nil
-	case oauth2token.FieldClientID:
+	case pvcmetadata.FieldPvcID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
-		return nil
-	case oauth2token.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case oauth2token.FieldRevoked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRevoked(v)
+		m.SetPvcID(v)
 		return nil
-	case oauth2token.FieldScope:
+	case pvcmetadata.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScope(v)
+		m.SetName(v)
 		return nil
-	case oauth2token.FieldDeviceInfo:
+	case pvcmetadata.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceInfo(v)
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Token field %s", name)
+	return fmt.Errorf("unknown PVCMetadata field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *Oauth2TokenMutation) AddedFields() []string {
+func (m *PVCMetadataMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *Oauth2TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PVCMetadataMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2TokenMutation) AddField(name string, value ent.Value) error {
+func (m *PVCMetadataMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Oauth2Token numeric field %s", name)
+	return fmt.Errorf("unknown PVCMetadata numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *Oauth2TokenMutation) ClearedFields() []string {
+func (m *PVCMetadataMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(oauth2token.FieldDeviceInfo) {
-		fields = append(fields, oauth2token.FieldDeviceInfo)
+	if m.FieldCleared(pvcmetadata.FieldName) {
+		fields = append(fields, pvcmetadata.FieldName)
+	}
+	if m.FieldCleared(pvcmetadata.FieldDescription) {
+		fields = append(fields, pvcmetadata.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *Oauth2TokenMutation) FieldCleared(name string) bool {
+func (m *PVCMetadataMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *Oauth2TokenMutation) ClearField(name string) error {
+func (m *PVCMetadataMutation) ClearField(name string) error {
 	switch name {
-	case oauth2token.FieldDeviceInfo:
-		m.ClearDeviceInfo()
+	case pvcmetadata.FieldName:
+		m.ClearName()
+		return nil
+	case pvcmetadata.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Token nullable field %s", name)
+	return fmt.Errorf("unknown PVCMetadata nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *Oauth2TokenMutation) ResetField(name string) error {
+func (m *PVCMetadataMutation) ResetField(name string) error {
 	switch name {
-	case oauth2token.FieldCreatedAt:
+	case pvcmetadata.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case oauth2token.FieldUpdatedAt:
+	case pvcmetadata.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case oauth2token.FieldAccessToken:
-		m.ResetAccessToken()
-		return nil
-	case oauth2token.FieldRefreshToken:
-		m.ResetRefreshToken()
-		return nil
-	case oauth2token.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case oauth2token.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case oauth2token.FieldRevoked:
-		m.ResetRevoked()
+	case pvcmetadata.FieldPvcID:
+		m.ResetPvcID()
 		return nil
-	case oauth2token.FieldScope:
-		m.ResetScope()
+	case pvcmetadata.FieldName:
+		m.ResetName()
 		return nil
-	case oauth2token.FieldDeviceInfo:
-		m.ResetDeviceInfo()
+	case pvcmetadata.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Token field %s", name)
+	return fmt.Errorf("unknown PVCMetadata field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *Oauth2TokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, oauth2token.EdgeUser)
-	}
+func (m *PVCMetadataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *Oauth2TokenMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case oauth2token.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PVCMetadataMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *Oauth2TokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PVCMetadataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *Oauth2TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *PVCMetadataMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *Oauth2TokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, oauth2token.EdgeUser)
-	}
+func (m *PVCMetadataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *Oauth2TokenMutation) EdgeCleared(name string) bool {
-	switch name {
-	case oauth2token.EdgeUser:
-		return m.cleareduser
-	}
+func (m *PVCMetadataMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *Oauth2TokenMutation) ClearEdge(name string) error {
-	switch name {
-	case oauth2token.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Oauth2Token unique edge %s", name)
+func (m *PVCMetadataMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PVCMetadata unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *Oauth2TokenMutation) ResetEdge(name string) error {
-	switch name {
-	case oauth2token.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Oauth2Token edge %s", name)
+func (m *PVCMetadataMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PVCMetadata edge %s", name)
 }
 
-// PVCMetadataMutation represents an operation that mutates the PVCMetadata nodes in the graph.
-type PVCMetadataMutation struct {
+// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
+type PermissionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	pvc_id        *string
-	name          *string
-	description   *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*PVCMetadata, error)
-	predicates    []predicate.PVCMetadata
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	action            *schema.PermittedAction
+	resource_type     *schema.ResourceType
+	resource_selector *schema.ResourceSelector
+	clearedFields     map[string]struct{}
+	groups            map[uuid.UUID]struct{}
+	removedgroups     map[uuid.UUID]struct{}
+	clearedgroups     bool
+	done              bool
+	oldValue          func(context.Context) (*Permission, error)
+	predicates        []predicate.Permission
 }
 
-var _ ent.Mutation = (*PVCMetadataMutation)(nil)
+var _ ent.Mutation = (*PermissionMutation)(nil)
 
-// pvcmetadataOption allows management of the mutation configuration using functional options.
-type pvcmetadataOption func(*PVCMetadataMutation)
+// permissionOption allows management of the mutation configuration using functional options.
+type permissionOption func(*PermissionMutation)
 
-// newPVCMetadataMutation creates new mutation for the PVCMetadata entity.
-func newPVCMetadataMutation(c config, op Op, opts ...pvcmetadataOption) *PVCMetadataMutation {
-	m := &PVCMetadataMutation{
+// newPermissionMutation creates new mutation for the Permission entity.
+func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
+	m := &PermissionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePVCMetadata,
+		typ:           TypePermission,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8260,20 +11837,20 @@ func newPVCMetadataMutation(c config, op Op, opts ...pvcmetadataOption) *PVCMeta
 	return m
 }
 
-// withPVCMetadataID sets the ID field of the mutation.
-func withPVCMetadataID(id uuid.UUID) pvcmetadataOption {
-	return func(m *PVCMetadataMutation) {
+// withPermissionID sets the ID field of the mutation.
+func withPermissionID(id uuid.UUID) permissionOption {
+	return func(m *PermissionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PVCMetadata
+			value *Permission
 		)
-		m.oldValue = func(ctx context.Context) (*PVCMetadata, error) {
+		m.oldValue = func(ctx context.Context) (*Permission, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PVCMetadata.Get(ctx, id)
+					value, err = m.Client().Permission.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8282,10 +11859,10 @@ func withPVCMetadataID(id uuid.UUID) pvcmetadataOption {
 	}
 }
 
-// withPVCMetadata sets the old PVCMetadata of the mutation.
-func withPVCMetadata(node *PVCMetadata) pvcmetadataOption {
-	return func(m *PVCMetadataMutation) {
-		m.oldValue = func(context.Context) (*PVCMetadata, error) {
+// withPermission sets the old Permission of the mutation.
+func withPermission(node *Permission) permissionOption {
+	return func(m *PermissionMutation) {
+		m.oldValue = func(context.Context) (*Permission, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8294,7 +11871,7 @@ func withPVCMetadata(node *PVCMetadata) pvcmetadataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PVCMetadataMutation) Client() *Client {
+func (m PermissionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8302,7 +11879,7 @@ func (m PVCMetadataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PVCMetadataMutation) Tx() (*Tx, error) {
+func (m PermissionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8312,14 +11889,14 @@ func (m PVCMetadataMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PVCMetadata entities.
-func (m *PVCMetadataMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Permission entities.
+func (m *PermissionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PVCMetadataMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PermissionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8330,7 +11907,7 @@ func (m *PVCMetadataMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PVCMetadataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PermissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8339,19 +11916,19 @@ func (m *PVCMetadataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PVCMetadata.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PVCMetadataMutation) SetCreatedAt(t time.Time) {
+func (m *PermissionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PVCMetadataMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8359,10 +11936,10 @@ func (m *PVCMetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PVCMetadata entity.
-// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PVCMetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8377,17 +11954,17 @@ func (m *PVCMetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PVCMetadataMutation) ResetCreatedAt() {
+func (m *PermissionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PVCMetadataMutation) SetUpdatedAt(t time.Time) {
+func (m *PermissionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PVCMetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PermissionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8395,10 +11972,10 @@ func (m *PVCMetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PVCMetadata entity.
-// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PVCMetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PermissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8413,153 +11990,181 @@ func (m *PVCMetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PVCMetadataMutation) ResetUpdatedAt() {
+func (m *PermissionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetPvcID sets the "pvc_id" field.
-func (m *PVCMetadataMutation) SetPvcID(s string) {
-	m.pvc_id = &s
+// SetAction sets the "action" field.
+func (m *PermissionMutation) SetAction(sa schema.PermittedAction) {
+	m.action = &sa
 }
 
-// PvcID returns the value of the "pvc_id" field in the mutation.
-func (m *PVCMetadataMutation) PvcID() (r string, exists bool) {
-	v := m.pvc_id
+// Action returns the value of the "action" field in the mutation.
+func (m *PermissionMutation) Action() (r schema.PermittedAction, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPvcID returns the old "pvc_id" field's value of the PVCMetadata entity.
-// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PVCMetadataMutation) OldPvcID(ctx context.Context) (v string, err error) {
+func (m *PermissionMutation) OldAction(ctx context.Context) (v schema.PermittedAction, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPvcID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPvcID requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPvcID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.PvcID, nil
+	return oldValue.Action, nil
 }
 
-// ResetPvcID resets all changes to the "pvc_id" field.
-func (m *PVCMetadataMutation) ResetPvcID() {
-	m.pvc_id = nil
+// ResetAction resets all changes to the "action" field.
+func (m *PermissionMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetName sets the "name" field.
-func (m *PVCMetadataMutation) SetName(s string) {
-	m.name = &s
+// SetResourceType sets the "resource_type" field.
+func (m *PermissionMutation) SetResourceType(st schema.ResourceType) {
+	m.resource_type = &st
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PVCMetadataMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *PermissionMutation) ResourceType() (r schema.ResourceType, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PVCMetadata entity.
-// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PVCMetadataMutation) OldName(ctx context.Context) (v *string, err error) {
+func (m *PermissionMutation) OldResourceType(ctx context.Context) (v schema.ResourceType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ResourceType, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *PVCMetadataMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[pvcmetadata.FieldName] = struct{}{}
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *PermissionMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *PVCMetadataMutation) NameCleared() bool {
-	_, ok := m.clearedFields[pvcmetadata.FieldName]
-	return ok
+// SetResourceSelector sets the "resource_selector" field.
+func (m *PermissionMutation) SetResourceSelector(ss schema.ResourceSelector) {
+	m.resource_selector = &ss
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PVCMetadataMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, pvcmetadata.FieldName)
+// ResourceSelector returns the value of the "resource_selector" field in the mutation.
+func (m *PermissionMutation) ResourceSelector() (r schema.ResourceSelector, exists bool) {
+	v := m.resource_selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceSelector returns the old "resource_selector" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldResourceSelector(ctx context.Context) (v schema.ResourceSelector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceSelector: %w", err)
+	}
+	return oldValue.ResourceSelector, nil
+}
+
+// ResetResourceSelector resets all changes to the "resource_selector" field.
+func (m *PermissionMutation) ResetResourceSelector() {
+	m.resource_selector = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *PermissionMutation) AddGroupIDs(ids ...uuid.UUID) {
+	if m.groups == nil {
+		m.groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *PVCMetadataMutation) SetDescription(s string) {
-	m.description = &s
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *PermissionMutation) ClearGroups() {
+	m.clearedgroups = true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PVCMetadataMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *PermissionMutation) GroupsCleared() bool {
+	return m.clearedgroups
 }
 
-// OldDescription returns the old "description" field's value of the PVCMetadata entity.
-// If the PVCMetadata object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PVCMetadataMutation) OldDescription(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *PermissionMutation) RemoveGroupIDs(ids ...uuid.UUID) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
 	}
-	return oldValue.Description, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PVCMetadataMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[pvcmetadata.FieldDescription] = struct{}{}
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *PermissionMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PVCMetadataMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[pvcmetadata.FieldDescription]
-	return ok
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *PermissionMutation) GroupsIDs() (ids []uuid.UUID) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PVCMetadataMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, pvcmetadata.FieldDescription)
+// ResetGroups resets all changes to the "groups" edge.
+func (m *PermissionMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
 }
 
-// Where appends a list predicates to the PVCMetadataMutation builder.
-func (m *PVCMetadataMutation) Where(ps ...predicate.PVCMetadata) {
+// Where appends a list predicates to the PermissionMutation builder.
+func (m *PermissionMutation) Where(ps ...predicate.Permission) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PVCMetadataMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PVCMetadataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PVCMetadata, len(ps))
+func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permission, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8567,39 +12172,39 @@ func (m *PVCMetadataMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PVCMetadataMutation) Op() Op {
+func (m *PermissionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PVCMetadataMutation) SetOp(op Op) {
+func (m *PermissionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PVCMetadata).
-func (m *PVCMetadataMutation) Type() string {
+// Type returns the node type of this mutation (Permission).
+func (m *PermissionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PVCMetadataMutation) Fields() []string {
+func (m *PermissionMutation) Fields() []string {
 	fields := make([]string, 0, 5)
 	if m.created_at != nil {
-		fields = append(fields, pvcmetadata.FieldCreatedAt)
+		fields = append(fields, permission.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, pvcmetadata.FieldUpdatedAt)
+		fields = append(fields, permission.FieldUpdatedAt)
 	}
-	if m.pvc_id != nil {
-		fields = append(fields, pvcmetadata.FieldPvcID)
+	if m.action != nil {
+		fields = append(fields, permission.FieldAction)
 	}
-	if m.name != nil {
-		fields = append(fields, pvcmetadata.FieldName)
+	if m.resource_type != nil {
+		fields = append(fields, permission.FieldResourceType)
 	}
-	if m.description != nil {
-		fields = append(fields, pvcmetadata.FieldDescription)
+	if m.resource_selector != nil {
+		fields = append(fields, permission.FieldResourceSelector)
 	}
 	return fields
 }
@@ -8607,18 +12212,18 @@ func (m *PVCMetadataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PVCMetadataMutation) Field(name string) (ent.Value, bool) {
+func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pvcmetadata.FieldCreatedAt:
+	case permission.FieldCreatedAt:
 		return m.CreatedAt()
-	case pvcmetadata.FieldUpdatedAt:
+	case permission.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case pvcmetadata.FieldPvcID:
-		return m.PvcID()
-	case pvcmetadata.FieldName:
-		return m.Name()
-	case pvcmetadata.FieldDescription:
-		return m.Description()
+	case permission.FieldAction:
+		return m.Action()
+	case permission.FieldResourceType:
+		return m.ResourceType()
+	case permission.FieldResourceSelector:
+		return m.ResourceSelector()
 	}
 	return nil, false
 }
@@ -8626,224 +12231,256 @@ func (m *PVCMetadataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PVCMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pvcmetadata.FieldCreatedAt:
+	case permission.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pvcmetadata.FieldUpdatedAt:
+	case permission.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case pvcmetadata.FieldPvcID:
-		return m.OldPvcID(ctx)
-	case pvcmetadata.FieldName:
-		return m.OldName(ctx)
-	case pvcmetadata.FieldDescription:
-		return m.OldDescription(ctx)
+	case permission.FieldAction:
+		return m.OldAction(ctx)
+	case permission.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case permission.FieldResourceSelector:
+		return m.OldResourceSelector(ctx)
 	}
-	return nil, fmt.Errorf("unknown PVCMetadata field %s", name)
+	return nil, fmt.Errorf("unknown Permission field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PVCMetadataMutation) SetField(name string, value ent.Value) error {
+func (m *PermissionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pvcmetadata.FieldCreatedAt:
+	case permission.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pvcmetadata.FieldUpdatedAt:
+	case permission.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case pvcmetadata.FieldPvcID:
-		v, ok := value.(string)
+	case permission.FieldAction:
+		v, ok := value.(schema.PermittedAction)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPvcID(v)
+		m.SetAction(v)
 		return nil
-	case pvcmetadata.FieldName:
-		v, ok := value.(string)
+	case permission.FieldResourceType:
+		v, ok := value.(schema.ResourceType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetResourceType(v)
 		return nil
-	case pvcmetadata.FieldDescription:
-		v, ok := value.(string)
+	case permission.FieldResourceSelector:
+		v, ok := value.(schema.ResourceSelector)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetResourceSelector(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PVCMetadata field %s", name)
+	return fmt.Errorf("unknown Permission field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PVCMetadataMutation) AddedFields() []string {
+func (m *PermissionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PVCMetadataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PVCMetadataMutation) AddField(name string, value ent.Value) error {
+func (m *PermissionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PVCMetadata numeric field %s", name)
+	return fmt.Errorf("unknown Permission numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PVCMetadataMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(pvcmetadata.FieldName) {
-		fields = append(fields, pvcmetadata.FieldName)
-	}
-	if m.FieldCleared(pvcmetadata.FieldDescription) {
-		fields = append(fields, pvcmetadata.FieldDescription)
-	}
-	return fields
+func (m *PermissionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PVCMetadataMutation) FieldCleared(name string) bool {
+func (m *PermissionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PVCMetadataMutation) ClearField(name string) error {
-	switch name {
-	case pvcmetadata.FieldName:
-		m.ClearName()
-		return nil
-	case pvcmetadata.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown PVCMetadata nullable field %s", name)
+func (m *PermissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Permission nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PVCMetadataMutation) ResetField(name string) error {
+func (m *PermissionMutation) ResetField(name string) error {
 	switch name {
-	case pvcmetadata.FieldCreatedAt:
+	case permission.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pvcmetadata.FieldUpdatedAt:
+	case permission.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case pvcmetadata.FieldPvcID:
-		m.ResetPvcID()
+	case permission.FieldAction:
+		m.ResetAction()
 		return nil
-	case pvcmetadata.FieldName:
-		m.ResetName()
+	case permission.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case pvcmetadata.FieldDescription:
-		m.ResetDescription()
+	case permission.FieldResourceSelector:
+		m.ResetResourceSelector()
 		return nil
 	}
-	return fmt.Errorf("unknown PVCMetadata field %s", name)
+	return fmt.Errorf("unknown Permission field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PVCMetadataMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.groups != nil {
+		edges = append(edges, permission.EdgeGroups)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PVCMetadataMutation) AddedIDs(name string) []ent.Value {
+func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PVCMetadataMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgroups != nil {
+		edges = append(edges, permission.EdgeGroups)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PVCMetadataMutation) RemovedIDs(name string) []ent.Value {
+func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PVCMetadataMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroups {
+		edges = append(edges, permission.EdgeGroups)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PVCMetadataMutation) EdgeCleared(name string) bool {
+func (m *PermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permission.EdgeGroups:
+		return m.clearedgroups
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PVCMetadataMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PVCMetadata unique edge %s", name)
+func (m *PermissionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permission unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PVCMetadataMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PVCMetadata edge %s", name)
+func (m *PermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case permission.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission edge %s", name)
 }
 
-// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
-type PermissionMutation struct {
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	created_at        *time.Time
-	updated_at        *time.Time
-	action            *schema.PermittedAction
-	resource_type     *schema.ResourceType
-	resource_selector *schema.ResourceSelector
-	clearedFields     map[string]struct{}
-	groups            map[uuid.UUID]struct{}
-	removedgroups     map[uuid.UUID]struct{}
-	clearedgroups     bool
-	done              bool
-	oldValue          func(context.Context) (*Permission, error)
-	predicates        []predicate.Permission
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	kubernetes_name            *string
+	name                       *string
+	description                *string
+	status                     *string
+	tags                       *[]string
+	appendtags                 []string
+	kubernetes_secret          *string
+	clearedFields              map[string]struct{}
+	team                       *uuid.UUID
+	clearedteam                bool
+	environments               map[uuid.UUID]struct{}
+	removedenvironments        map[uuid.UUID]struct{}
+	clearedenvironments        bool
+	default_environment        *uuid.UUID
+	cleareddefault_environment bool
+	project_webhooks           map[uuid.UUID]struct{}
+	removedproject_webhooks    map[uuid.UUID]struct{}
+	clearedproject_webhooks    bool
+	done                       bool
+	oldValue                   func(context.Context) (*Project, error)
+	predicates                 []predicate.Project
 }
 
-var _ ent.Mutation = (*PermissionMutation)(nil)
+var _ ent.Mutation = (*ProjectMutation)(nil)
 
-// permissionOption allows management of the mutation configuration using functional options.
-type permissionOption func(*PermissionMutation)
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
 
-// newPermissionMutation creates new mutation for the Permission entity.
-func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
-	m := &PermissionMutation{
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermission,
+		typ:           TypeProject,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8852,20 +12489,20 @@ func newPermissionMutation(c config, op Op, opts ...permissionOption) *Permissio
 	return m
 }
 
-// withPermissionID sets the ID field of the mutation.
-func withPermissionID(id uuid.UUID) permissionOption {
-	return func(m *PermissionMutation) {
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id uuid.UUID) projectOption {
+	return func(m *ProjectMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permission
+			value *Project
 		)
-		m.oldValue = func(ctx context.Context) (*Permission, error) {
+		m.oldValue = func(ctx context.Context) (*Project, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permission.Get(ctx, id)
+					value, err = m.Client().Project.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8874,10 +12511,10 @@ func withPermissionID(id uuid.UUID) permissionOption {
 	}
 }
 
-// withPermission sets the old Permission of the mutation.
-func withPermission(node *Permission) permissionOption {
-	return func(m *PermissionMutation) {
-		m.oldValue = func(context.Context) (*Permission, error) {
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8886,1095 +12523,1321 @@ func withPermission(node *Permission) permissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionMutation) Client() *Client {
+func (m ProjectMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PermissionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Project entities.
+func (m *ProjectMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetKubernetesName sets the "kubernetes_name" field.
+func (m *ProjectMutation) SetKubernetesName(s string) {
+	m.kubernetes_name = &s
+}
+
+// KubernetesName returns the value of the "kubernetes_name" field in the mutation.
+func (m *ProjectMutation) KubernetesName() (r string, exists bool) {
+	v := m.kubernetes_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKubernetesName returns the old "kubernetes_name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldKubernetesName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubernetesName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubernetesName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubernetesName: %w", err)
+	}
+	return oldValue.KubernetesName, nil
+}
+
+// ResetKubernetesName resets all changes to the "kubernetes_name" field.
+func (m *ProjectMutation) ResetKubernetesName() {
+	m.kubernetes_name = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Permission entities.
-func (m *PermissionMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PermissionMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PermissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PermissionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Description, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PermissionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[project.FieldDescription] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PermissionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[project.FieldDescription]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PermissionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, project.FieldDescription)
+}
+
+// SetStatus sets the "status" field.
+func (m *ProjectMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProjectMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProjectMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PermissionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ProjectMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetAction sets the "action" field.
-func (m *PermissionMutation) SetAction(sa schema.PermittedAction) {
-	m.action = &sa
+// SetTeamID sets the "team_id" field.
+func (m *ProjectMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *PermissionMutation) Action() (r schema.PermittedAction, exists bool) {
-	v := m.action
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *ProjectMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldAction(ctx context.Context) (v schema.PermittedAction, err error) {
+func (m *ProjectMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *PermissionMutation) ResetAction() {
-	m.action = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *ProjectMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// SetResourceType sets the "resource_type" field.
-func (m *PermissionMutation) SetResourceType(st schema.ResourceType) {
-	m.resource_type = &st
+// SetTags sets the "tags" field.
+func (m *ProjectMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// ResourceType returns the value of the "resource_type" field in the mutation.
-func (m *PermissionMutation) ResourceType() (r schema.ResourceType, exists bool) {
-	v := m.resource_type
+// Tags returns the value of the "tags" field in the mutation.
+func (m *ProjectMutation) Tags() (r []string, exists bool) {
+	v := m.tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceType returns the old "resource_type" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldTags returns the old "tags" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldResourceType(ctx context.Context) (v schema.ResourceType, err error) {
+func (m *ProjectMutation) OldTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceType requires an ID field in the mutation")
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
-	return oldValue.ResourceType, nil
+	return oldValue.Tags, nil
 }
 
-// ResetResourceType resets all changes to the "resource_type" field.
-func (m *PermissionMutation) ResetResourceType() {
-	m.resource_type = nil
+// AppendTags adds s to the "tags" field.
+func (m *ProjectMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
 }
 
-// SetResourceSelector sets the "resource_selector" field.
-func (m *PermissionMutation) SetResourceSelector(ss schema.ResourceSelector) {
-	m.resource_selector = &ss
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *ProjectMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
 }
 
-// ResourceSelector returns the value of the "resource_selector" field in the mutation.
-func (m *PermissionMutation) ResourceSelector() (r schema.ResourceSelector, exists bool) {
-	v := m.resource_selector
+// ClearTags clears the value of the "tags" field.
+func (m *ProjectMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[project.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *ProjectMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[project.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *ProjectMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, project.FieldTags)
+}
+
+// SetDefaultEnvironmentID sets the "default_environment_id" field.
+func (m *ProjectMutation) SetDefaultEnvironmentID(u uuid.UUID) {
+	m.default_environment = &u
+}
+
+// DefaultEnvironmentID returns the value of the "default_environment_id" field in the mutation.
+func (m *ProjectMutation) DefaultEnvironmentID() (r uuid.UUID, exists bool) {
+	v := m.default_environment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceSelector returns the old "resource_selector" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldDefaultEnvironmentID returns the old "default_environment_id" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldResourceSelector(ctx context.Context) (v schema.ResourceSelector, err error) {
+func (m *ProjectMutation) OldDefaultEnvironmentID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceSelector is only allowed on UpdateOne operations")
+		return v, errors.New("OldDefaultEnvironmentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceSelector requires an ID field in the mutation")
+		return v, errors.New("OldDefaultEnvironmentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceSelector: %w", err)
+		return v, fmt.Errorf("querying old value for OldDefaultEnvironmentID: %w", err)
 	}
-	return oldValue.ResourceSelector, nil
+	return oldValue.DefaultEnvironmentID, nil
 }
 
-// ResetResourceSelector resets all changes to the "resource_selector" field.
-func (m *PermissionMutation) ResetResourceSelector() {
-	m.resource_selector = nil
+// ClearDefaultEnvironmentID clears the value of the "default_environment_id" field.
+func (m *ProjectMutation) ClearDefaultEnvironmentID() {
+	m.default_environment = nil
+	m.clearedFields[project.FieldDefaultEnvironmentID] = struct{}{}
 }
 
-// AddGroupIDs adds the "groups" edge to the Group entity by ids.
-func (m *PermissionMutation) AddGroupIDs(ids ...uuid.UUID) {
-	if m.groups == nil {
-		m.groups = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
-	}
+// DefaultEnvironmentIDCleared returns if the "default_environment_id" field was cleared in this mutation.
+func (m *ProjectMutation) DefaultEnvironmentIDCleared() bool {
+	_, ok := m.clearedFields[project.FieldDefaultEnvironmentID]
+	return ok
 }
 
-// ClearGroups clears the "groups" edge to the Group entity.
-func (m *PermissionMutation) ClearGroups() {
-	m.clearedgroups = true
+// ResetDefaultEnvironmentID resets all changes to the "default_environment_id" field.
+func (m *ProjectMutation) ResetDefaultEnvironmentID() {
+	m.default_environment = nil
+	delete(m.clearedFields, project.FieldDefaultEnvironmentID)
 }
 
-// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
-func (m *PermissionMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// SetKubernetesSecret sets the "kubernetes_secret" field.
+func (m *ProjectMutation) SetKubernetesSecret(s string) {
+	m.kubernetes_secret = &s
 }
 
-// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
-func (m *PermissionMutation) RemoveGroupIDs(ids ...uuid.UUID) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.groups, ids[i])
-		m.removedgroups[ids[i]] = struct{}{}
+// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
+func (m *ProjectMutation) KubernetesSecret() (r string, exists bool) {
+	v := m.kubernetes_secret
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
-func (m *PermissionMutation) RemovedGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgroups {
-		ids = append(ids, id)
+// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// GroupsIDs returns the "groups" edge IDs in the mutation.
-func (m *PermissionMutation) GroupsIDs() (ids []uuid.UUID) {
-	for id := range m.groups {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetGroups resets all changes to the "groups" edge.
-func (m *PermissionMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
+	}
+	return oldValue.KubernetesSecret, nil
 }
 
-// Where appends a list predicates to the PermissionMutation builder.
-func (m *PermissionMutation) Where(ps ...predicate.Permission) {
-	m.predicates = append(m.predicates, ps...)
+// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
+func (m *ProjectMutation) ResetKubernetesSecret() {
+	m.kubernetes_secret = nil
 }
 
-// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permission, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *ProjectMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[project.FieldTeamID] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *PermissionMutation) Op() Op {
-	return m.op
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *ProjectMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PermissionMutation) SetOp(op Op) {
-	m.op = op
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *ProjectMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (Permission).
-func (m *PermissionMutation) Type() string {
-	return m.typ
+// ResetTeam resets all changes to the "team" edge.
+func (m *ProjectMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PermissionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, permission.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, permission.FieldUpdatedAt)
-	}
-	if m.action != nil {
-		fields = append(fields, permission.FieldAction)
-	}
-	if m.resource_type != nil {
-		fields = append(fields, permission.FieldResourceType)
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by ids.
+func (m *ProjectMutation) AddEnvironmentIDs(ids ...uuid.UUID) {
+	if m.environments == nil {
+		m.environments = make(map[uuid.UUID]struct{})
 	}
-	if m.resource_selector != nil {
-		fields = append(fields, permission.FieldResourceSelector)
+	for i := range ids {
+		m.environments[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case permission.FieldCreatedAt:
-		return m.CreatedAt()
-	case permission.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case permission.FieldAction:
-		return m.Action()
-	case permission.FieldResourceType:
-		return m.ResourceType()
-	case permission.FieldResourceSelector:
-		return m.ResourceSelector()
-	}
-	return nil, false
+// ClearEnvironments clears the "environments" edge to the Environment entity.
+func (m *ProjectMutation) ClearEnvironments() {
+	m.clearedenvironments = true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case permission.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case permission.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case permission.FieldAction:
-		return m.OldAction(ctx)
-	case permission.FieldResourceType:
-		return m.OldResourceType(ctx)
-	case permission.FieldResourceSelector:
-		return m.OldResourceSelector(ctx)
-	}
-	return nil, fmt.Errorf("unknown Permission field %s", name)
+// EnvironmentsCleared reports if the "environments" edge to the Environment entity was cleared.
+func (m *ProjectMutation) EnvironmentsCleared() bool {
+	return m.clearedenvironments
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PermissionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case permission.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case permission.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case permission.FieldAction:
-		v, ok := value.(schema.PermittedAction)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAction(v)
-		return nil
-	case permission.FieldResourceType:
-		v, ok := value.(schema.ResourceType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResourceType(v)
-		return nil
-	case permission.FieldResourceSelector:
-		v, ok := value.(schema.ResourceSelector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResourceSelector(v)
-		return nil
+// RemoveEnvironmentIDs removes the "environments" edge to the Environment entity by IDs.
+func (m *ProjectMutation) RemoveEnvironmentIDs(ids ...uuid.UUID) {
+	if m.removedenvironments == nil {
+		m.removedenvironments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.environments, ids[i])
+		m.removedenvironments[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PermissionMutation) AddedFields() []string {
-	return nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// RemovedEnvironments returns the removed IDs of the "environments" edge to the Environment entity.
+func (m *ProjectMutation) RemovedEnvironmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedenvironments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PermissionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// EnvironmentsIDs returns the "environments" edge IDs in the mutation.
+func (m *ProjectMutation) EnvironmentsIDs() (ids []uuid.UUID) {
+	for id := range m.environments {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Permission numeric field %s", name)
+	return
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PermissionMutation) ClearedFields() []string {
-	return nil
+// ResetEnvironments resets all changes to the "environments" edge.
+func (m *ProjectMutation) ResetEnvironments() {
+	m.environments = nil
+	m.clearedenvironments = false
+	m.removedenvironments = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PermissionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ClearDefaultEnvironment clears the "default_environment" edge to the Environment entity.
+func (m *ProjectMutation) ClearDefaultEnvironment() {
+	m.cleareddefault_environment = true
+	m.clearedFields[project.FieldDefaultEnvironmentID] = struct{}{}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PermissionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Permission nullable field %s", name)
+// DefaultEnvironmentCleared reports if the "default_environment" edge to the Environment entity was cleared.
+func (m *ProjectMutation) DefaultEnvironmentCleared() bool {
+	return m.DefaultEnvironmentIDCleared() || m.cleareddefault_environment
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PermissionMutation) ResetField(name string) error {
-	switch name {
-	case permission.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case permission.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case permission.FieldAction:
-		m.ResetAction()
-		return nil
-	case permission.FieldResourceType:
-		m.ResetResourceType()
-		return nil
-	case permission.FieldResourceSelector:
-		m.ResetResourceSelector()
-		return nil
+// DefaultEnvironmentIDs returns the "default_environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefaultEnvironmentID instead. It exists only for internal usage by the builders.
+func (m *ProjectMutation) DefaultEnvironmentIDs() (ids []uuid.UUID) {
+	if id := m.default_environment; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.groups != nil {
-		edges = append(edges, permission.EdgeGroups)
-	}
-	return edges
+// ResetDefaultEnvironment resets all changes to the "default_environment" edge.
+func (m *ProjectMutation) ResetDefaultEnvironment() {
+	m.default_environment = nil
+	m.cleareddefault_environment = false
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case permission.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
-			ids = append(ids, id)
-		}
-		return ids
+// AddProjectWebhookIDs adds the "project_webhooks" edge to the Webhook entity by ids.
+func (m *ProjectMutation) AddProjectWebhookIDs(ids ...uuid.UUID) {
+	if m.project_webhooks == nil {
+		m.project_webhooks = make(map[uuid.UUID]struct{})
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedgroups != nil {
-		edges = append(edges, permission.EdgeGroups)
+	for i := range ids {
+		m.project_webhooks[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case permission.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearProjectWebhooks clears the "project_webhooks" edge to the Webhook entity.
+func (m *ProjectMutation) ClearProjectWebhooks() {
+	m.clearedproject_webhooks = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedgroups {
-		edges = append(edges, permission.EdgeGroups)
-	}
-	return edges
+// ProjectWebhooksCleared reports if the "project_webhooks" edge to the Webhook entity was cleared.
+func (m *ProjectMutation) ProjectWebhooksCleared() bool {
+	return m.clearedproject_webhooks
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PermissionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case permission.EdgeGroups:
-		return m.clearedgroups
+// RemoveProjectWebhookIDs removes the "project_webhooks" edge to the Webhook entity by IDs.
+func (m *ProjectMutation) RemoveProjectWebhookIDs(ids ...uuid.UUID) {
+	if m.removedproject_webhooks == nil {
+		m.removedproject_webhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.project_webhooks, ids[i])
+		m.removedproject_webhooks[ids[i]] = struct{}{}
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PermissionMutation) ClearEdge(name string) error {
-	switch name {
+// RemovedProjectWebhooks returns the removed IDs of the "project_webhooks" edge to the Webhook entity.
+func (m *ProjectMutation) RemovedProjectWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedproject_webhooks {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Permission unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PermissionMutation) ResetEdge(name string) error {
-	switch name {
-	case permission.EdgeGroups:
-		m.ResetGroups()
-		return nil
+// ProjectWebhooksIDs returns the "project_webhooks" edge IDs in the mutation.
+func (m *ProjectMutation) ProjectWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.project_webhooks {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Permission edge %s", name)
+	return
 }
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
-	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	kubernetes_name            *string
-	name                       *string
-	description                *string
-	status                     *string
-	tags                       *[]string
-	appendtags                 []string
-	kubernetes_secret          *string
-	clearedFields              map[string]struct{}
-	team                       *uuid.UUID
-	clearedteam                bool
-	environments               map[uuid.UUID]struct{}
-	removedenvironments        map[uuid.UUID]struct{}
-	clearedenvironments        bool
-	default_environment        *uuid.UUID
-	cleareddefault_environment bool
-	project_webhooks           map[uuid.UUID]struct{}
-	removedproject_webhooks    map[uuid.UUID]struct{}
-	clearedproject_webhooks    bool
-	done                       bool
-	oldValue                   func(context.Context) (*Project, error)
-	predicates                 []predicate.Project
+// ResetProjectWebhooks resets all changes to the "project_webhooks" edge.
+func (m *ProjectMutation) ResetProjectWebhooks() {
+	m.project_webhooks = nil
+	m.clearedproject_webhooks = false
+	m.removedproject_webhooks = nil
 }
 
-var _ ent.Mutation = (*ProjectMutation)(nil)
-
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
-
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeProject,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id uuid.UUID) projectOption {
-	return func(m *ProjectMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Project
-		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Project.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.updated_at != nil {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	if m.kubernetes_name != nil {
+		fields = append(fields, project.FieldKubernetesName)
+	}
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.status != nil {
+		fields = append(fields, project.FieldStatus)
+	}
+	if m.team != nil {
+		fields = append(fields, project.FieldTeamID)
+	}
+	if m.tags != nil {
+		fields = append(fields, project.FieldTags)
+	}
+	if m.default_environment != nil {
+		fields = append(fields, project.FieldDefaultEnvironmentID)
+	}
+	if m.kubernetes_secret != nil {
+		fields = append(fields, project.FieldKubernetesSecret)
+	}
+	return fields
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Project entities.
-func (m *ProjectMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case project.FieldKubernetesName:
+		return m.KubernetesName()
+	case project.FieldName:
+		return m.Name()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldStatus:
+		return m.Status()
+	case project.FieldTeamID:
+		return m.TeamID()
+	case project.FieldTags:
+		return m.Tags()
+	case project.FieldDefaultEnvironmentID:
+		return m.DefaultEnvironmentID()
+	case project.FieldKubernetesSecret:
+		return m.KubernetesSecret()
+	}
+	return nil, false
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case project.FieldKubernetesName:
+		return m.OldKubernetesName(ctx)
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldStatus:
+		return m.OldStatus(ctx)
+	case project.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case project.FieldTags:
+		return m.OldTags(ctx)
+	case project.FieldDefaultEnvironmentID:
+		return m.OldDefaultEnvironmentID(ctx)
+	case project.FieldKubernetesSecret:
+		return m.OldKubernetesSecret(ctx)
 	}
-	return *m.id, true
+	return nil, fmt.Errorf("unknown Project field %s", name)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case project.FieldKubernetesName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesName(v)
+		return nil
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case project.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case project.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case project.FieldDefaultEnvironmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultEnvironmentID(v)
+		return nil
+	case project.FieldKubernetesSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesSecret(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Project field %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	return nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDescription) {
+		fields = append(fields, project.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(project.FieldTags) {
+		fields = append(fields, project.FieldTags)
 	}
-	return oldValue.CreatedAt, nil
+	if m.FieldCleared(project.FieldDefaultEnvironmentID) {
+		fields = append(fields, project.FieldDefaultEnvironmentID)
+	}
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProjectMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case project.FieldTags:
+		m.ClearTags()
+		return nil
+	case project.FieldDefaultEnvironmentID:
+		m.ClearDefaultEnvironmentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case project.FieldKubernetesName:
+		m.ResetKubernetesName()
+		return nil
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case project.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case project.FieldTags:
+		m.ResetTags()
+		return nil
+	case project.FieldDefaultEnvironmentID:
+		m.ResetDefaultEnvironmentID()
+		return nil
+	case project.FieldKubernetesSecret:
+		m.ResetKubernetesSecret()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Project field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.team != nil {
+		edges = append(edges, project.EdgeTeam)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.environments != nil {
+		edges = append(edges, project.EdgeEnvironments)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.default_environment != nil {
+		edges = append(edges, project.EdgeDefaultEnvironment)
 	}
-	return oldValue.UpdatedAt, nil
+	if m.project_webhooks != nil {
+		edges = append(edges, project.EdgeProjectWebhooks)
+	}
+	return edges
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProjectMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case project.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.environments))
+		for id := range m.environments {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeDefaultEnvironment:
+		if id := m.default_environment; id != nil {
+			return []ent.Value{*id}
+		}
+	case project.EdgeProjectWebhooks:
+		ids := make([]ent.Value, 0, len(m.project_webhooks))
+		for id := range m.project_webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetKubernetesName sets the "kubernetes_name" field.
-func (m *ProjectMutation) SetKubernetesName(s string) {
-	m.kubernetes_name = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedenvironments != nil {
+		edges = append(edges, project.EdgeEnvironments)
+	}
+	if m.removedproject_webhooks != nil {
+		edges = append(edges, project.EdgeProjectWebhooks)
+	}
+	return edges
 }
 
-// KubernetesName returns the value of the "kubernetes_name" field in the mutation.
-func (m *ProjectMutation) KubernetesName() (r string, exists bool) {
-	v := m.kubernetes_name
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.removedenvironments))
+		for id := range m.removedenvironments {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeProjectWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedproject_webhooks))
+		for id := range m.removedproject_webhooks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldKubernetesName returns the old "kubernetes_name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldKubernetesName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKubernetesName is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedteam {
+		edges = append(edges, project.EdgeTeam)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKubernetesName requires an ID field in the mutation")
+	if m.clearedenvironments {
+		edges = append(edges, project.EdgeEnvironments)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKubernetesName: %w", err)
+	if m.cleareddefault_environment {
+		edges = append(edges, project.EdgeDefaultEnvironment)
 	}
-	return oldValue.KubernetesName, nil
-}
-
-// ResetKubernetesName resets all changes to the "kubernetes_name" field.
-func (m *ProjectMutation) ResetKubernetesName() {
-	m.kubernetes_name = nil
+	if m.clearedproject_webhooks {
+		edges = append(edges, project.EdgeProjectWebhooks)
+	}
+	return edges
 }
 
-// SetName sets the "name" field.
-func (m *ProjectMutation) SetName(s string) {
-	m.name = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeTeam:
+		return m.clearedteam
+	case project.EdgeEnvironments:
+		return m.clearedenvironments
+	case project.EdgeDefaultEnvironment:
+		return m.cleareddefault_environment
+	case project.EdgeProjectWebhooks:
+		return m.clearedproject_webhooks
+	}
+	return false
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProjectMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	case project.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case project.EdgeDefaultEnvironment:
+		m.ClearDefaultEnvironment()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Project unique edge %s", name)
 }
 
-// OldName returns the old "name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case project.EdgeEnvironments:
+		m.ResetEnvironments()
+		return nil
+	case project.EdgeDefaultEnvironment:
+		m.ResetDefaultEnvironment()
+		return nil
+	case project.EdgeProjectWebhooks:
+		m.ResetProjectWebhooks()
+		return nil
 	}
-	return oldValue.Name, nil
+	return fmt.Errorf("unknown Project edge %s", name)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProjectMutation) ResetName() {
-	m.name = nil
+// RegistryMutation represents an operation that mutates the Registry nodes in the graph.
+type RegistryMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	host              *string
+	kubernetes_secret *string
+	is_default        *bool
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Registry, error)
+	predicates        []predicate.Registry
 }
 
-// SetDescription sets the "description" field.
-func (m *ProjectMutation) SetDescription(s string) {
-	m.description = &s
-}
+var _ ent.Mutation = (*RegistryMutation)(nil)
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProjectMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// registryOption allows management of the mutation configuration using functional options.
+type registryOption func(*RegistryMutation)
 
-// OldDescription returns the old "description" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDescription(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+// newRegistryMutation creates new mutation for the Registry entity.
+func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutation {
+	m := &RegistryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegistry,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProjectMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[project.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[project.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ProjectMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, project.FieldDescription)
+	return m
 }
 
-// SetStatus sets the "status" field.
-func (m *ProjectMutation) SetStatus(s string) {
-	m.status = &s
+// withRegistryID sets the ID field of the mutation.
+func withRegistryID(id uuid.UUID) registryOption {
+	return func(m *RegistryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Registry
+		)
+		m.oldValue = func(ctx context.Context) (*Registry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Registry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ProjectMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// withRegistry sets the old Registry of the mutation.
+func withRegistry(node *Registry) registryOption {
+	return func(m *RegistryMutation) {
+		m.oldValue = func(context.Context) (*Registry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegistryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ProjectMutation) ResetStatus() {
-	m.status = nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegistryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *ProjectMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Registry entities.
+func (m *RegistryMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *ProjectMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegistryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegistryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Registry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.TeamID, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *ProjectMutation) ResetTeamID() {
-	m.team = nil
-}
-
-// SetTags sets the "tags" field.
-func (m *ProjectMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *RegistryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *ProjectMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegistryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *RegistryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
-}
-
-// AppendTags adds s to the "tags" field.
-func (m *ProjectMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *ProjectMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return m.appendtags, true
-}
-
-// ClearTags clears the value of the "tags" field.
-func (m *ProjectMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[project.FieldTags] = struct{}{}
-}
-
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *ProjectMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[project.FieldTags]
-	return ok
-}
-
-// ResetTags resets all changes to the "tags" field.
-func (m *ProjectMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, project.FieldTags)
+	return oldValue.CreatedAt, nil
 }
 
-// SetDefaultEnvironmentID sets the "default_environment_id" field.
-func (m *ProjectMutation) SetDefaultEnvironmentID(u uuid.UUID) {
-	m.default_environment = &u
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegistryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// DefaultEnvironmentID returns the value of the "default_environment_id" field in the mutation.
-func (m *ProjectMutation) DefaultEnvironmentID() (r uuid.UUID, exists bool) {
-	v := m.default_environment
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegistryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegistryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultEnvironmentID returns the old "default_environment_id" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDefaultEnvironmentID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *RegistryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultEnvironmentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultEnvironmentID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultEnvironmentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.DefaultEnvironmentID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearDefaultEnvironmentID clears the value of the "default_environment_id" field.
-func (m *ProjectMutation) ClearDefaultEnvironmentID() {
-	m.default_environment = nil
-	m.clearedFields[project.FieldDefaultEnvironmentID] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegistryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DefaultEnvironmentIDCleared returns if the "default_environment_id" field was cleared in this mutation.
-func (m *ProjectMutation) DefaultEnvironmentIDCleared() bool {
-	_, ok := m.clearedFields[project.FieldDefaultEnvironmentID]
-	return ok
+// SetHost sets the "host" field.
+func (m *RegistryMutation) SetHost(s string) {
+	m.host = &s
 }
 
-// ResetDefaultEnvironmentID resets all changes to the "default_environment_id" field.
-func (m *ProjectMutation) ResetDefaultEnvironmentID() {
-	m.default_environment = nil
-	delete(m.clearedFields, project.FieldDefaultEnvironmentID)
+// Host returns the value of the "host" field in the mutation.
+func (m *RegistryMutation) Host() (r string, exists bool) {
+	v := m.host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHost returns the old "host" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
+	}
+	return oldValue.Host, nil
+}
+
+// ResetHost resets all changes to the "host" field.
+func (m *RegistryMutation) ResetHost() {
+	m.host = nil
 }
 
 // SetKubernetesSecret sets the "kubernetes_secret" field.
-func (m *ProjectMutation) SetKubernetesSecret(s string) {
+func (m *RegistryMutation) SetKubernetesSecret(s string) {
 	m.kubernetes_secret = &s
 }
 
 // KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
-func (m *ProjectMutation) KubernetesSecret() (r string, exists bool) {
+func (m *RegistryMutation) KubernetesSecret() (r string, exists bool) {
 	v := m.kubernetes_secret
 	if v == nil {
 		return
@@ -9982,10 +13845,10 @@ func (m *ProjectMutation) KubernetesSecret() (r string, exists bool) {
 	return *v, true
 }
 
-// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
+func (m *RegistryMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
 	}
@@ -10000,933 +13863,856 @@ func (m *ProjectMutation) OldKubernetesSecret(ctx context.Context) (v string, er
 }
 
 // ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
-func (m *ProjectMutation) ResetKubernetesSecret() {
+func (m *RegistryMutation) ResetKubernetesSecret() {
 	m.kubernetes_secret = nil
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *ProjectMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[project.FieldTeamID] = struct{}{}
+// SetIsDefault sets the "is_default" field.
+func (m *RegistryMutation) SetIsDefault(b bool) {
+	m.is_default = &b
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *ProjectMutation) TeamCleared() bool {
-	return m.clearedteam
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *RegistryMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *ProjectMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// OldIsDefault returns the old "is_default" field's value of the Registry entity.
+// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistryMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *ProjectMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *RegistryMutation) ResetIsDefault() {
+	m.is_default = nil
 }
 
-// AddEnvironmentIDs adds the "environments" edge to the Environment entity by ids.
-func (m *ProjectMutation) AddEnvironmentIDs(ids ...uuid.UUID) {
-	if m.environments == nil {
-		m.environments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.environments[ids[i]] = struct{}{}
+// Where appends a list predicates to the RegistryMutation builder.
+func (m *RegistryMutation) Where(ps ...predicate.Registry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegistryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Registry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearEnvironments clears the "environments" edge to the Environment entity.
-func (m *ProjectMutation) ClearEnvironments() {
-	m.clearedenvironments = true
+// Op returns the operation name.
+func (m *RegistryMutation) Op() Op {
+	return m.op
 }
 
-// EnvironmentsCleared reports if the "environments" edge to the Environment entity was cleared.
-func (m *ProjectMutation) EnvironmentsCleared() bool {
-	return m.clearedenvironments
+// SetOp allows setting the mutation operation.
+func (m *RegistryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemoveEnvironmentIDs removes the "environments" edge to the Environment entity by IDs.
-func (m *ProjectMutation) RemoveEnvironmentIDs(ids ...uuid.UUID) {
-	if m.removedenvironments == nil {
-		m.removedenvironments = make(map[uuid.UUID]struct{})
+// Type returns the node type of this mutation (Registry).
+func (m *RegistryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegistryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, registry.FieldCreatedAt)
 	}
-	for i := range ids {
-		delete(m.environments, ids[i])
-		m.removedenvironments[ids[i]] = struct{}{}
+	if m.updated_at != nil {
+		fields = append(fields, registry.FieldUpdatedAt)
+	}
+	if m.host != nil {
+		fields = append(fields, registry.FieldHost)
+	}
+	if m.kubernetes_secret != nil {
+		fields = append(fields, registry.FieldKubernetesSecret)
+	}
+	if m.is_default != nil {
+		fields = append(fields, registry.FieldIsDefault)
 	}
+	return fields
 }
 
-// RemovedEnvironments returns the removed IDs of the "environments" edge to the Environment entity.
-func (m *ProjectMutation) RemovedEnvironmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedenvironments {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registry.FieldCreatedAt:
+		return m.CreatedAt()
+	case registry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case registry.FieldHost:
+		return m.Host()
+	case registry.FieldKubernetesSecret:
+		return m.KubernetesSecret()
+	case registry.FieldIsDefault:
+		return m.IsDefault()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegistryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case registry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case registry.FieldHost:
+		return m.OldHost(ctx)
+	case registry.FieldKubernetesSecret:
+		return m.OldKubernetesSecret(ctx)
+	case registry.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	}
+	return nil, fmt.Errorf("unknown Registry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case registry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case registry.FieldHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHost(v)
+		return nil
+	case registry.FieldKubernetesSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesSecret(v)
+		return nil
+	case registry.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown Registry field %s", name)
 }
 
-// EnvironmentsIDs returns the "environments" edge IDs in the mutation.
-func (m *ProjectMutation) EnvironmentsIDs() (ids []uuid.UUID) {
-	for id := range m.environments {
-		ids = append(ids, id)
-	}
-	return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegistryMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetEnvironments resets all changes to the "environments" edge.
-func (m *ProjectMutation) ResetEnvironments() {
-	m.environments = nil
-	m.clearedenvironments = false
-	m.removedenvironments = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegistryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// ClearDefaultEnvironment clears the "default_environment" edge to the Environment entity.
-func (m *ProjectMutation) ClearDefaultEnvironment() {
-	m.cleareddefault_environment = true
-	m.clearedFields[project.FieldDefaultEnvironmentID] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Registry numeric field %s", name)
 }
 
-// DefaultEnvironmentCleared reports if the "default_environment" edge to the Environment entity was cleared.
-func (m *ProjectMutation) DefaultEnvironmentCleared() bool {
-	return m.DefaultEnvironmentIDCleared() || m.cleareddefault_environment
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegistryMutation) ClearedFields() []string {
+	return nil
 }
 
-// DefaultEnvironmentIDs returns the "default_environment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DefaultEnvironmentID instead. It exists only for internal usage by the builders.
-func (m *ProjectMutation) DefaultEnvironmentIDs() (ids []uuid.UUID) {
-	if id := m.default_environment; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegistryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetDefaultEnvironment resets all changes to the "default_environment" edge.
-func (m *ProjectMutation) ResetDefaultEnvironment() {
-	m.default_environment = nil
-	m.cleareddefault_environment = false
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegistryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Registry nullable field %s", name)
 }
 
-// AddProjectWebhookIDs adds the "project_webhooks" edge to the Webhook entity by ids.
-func (m *ProjectMutation) AddProjectWebhookIDs(ids ...uuid.UUID) {
-	if m.project_webhooks == nil {
-		m.project_webhooks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.project_webhooks[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegistryMutation) ResetField(name string) error {
+	switch name {
+	case registry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case registry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case registry.FieldHost:
+		m.ResetHost()
+		return nil
+	case registry.FieldKubernetesSecret:
+		m.ResetKubernetesSecret()
+		return nil
+	case registry.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
 	}
+	return fmt.Errorf("unknown Registry field %s", name)
 }
 
-// ClearProjectWebhooks clears the "project_webhooks" edge to the Webhook entity.
-func (m *ProjectMutation) ClearProjectWebhooks() {
-	m.clearedproject_webhooks = true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegistryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ProjectWebhooksCleared reports if the "project_webhooks" edge to the Webhook entity was cleared.
-func (m *ProjectMutation) ProjectWebhooksCleared() bool {
-	return m.clearedproject_webhooks
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegistryMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemoveProjectWebhookIDs removes the "project_webhooks" edge to the Webhook entity by IDs.
-func (m *ProjectMutation) RemoveProjectWebhookIDs(ids ...uuid.UUID) {
-	if m.removedproject_webhooks == nil {
-		m.removedproject_webhooks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.project_webhooks, ids[i])
-		m.removedproject_webhooks[ids[i]] = struct{}{}
-	}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegistryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RemovedProjectWebhooks returns the removed IDs of the "project_webhooks" edge to the Webhook entity.
-func (m *ProjectMutation) RemovedProjectWebhooksIDs() (ids []uuid.UUID) {
-	for id := range m.removedproject_webhooks {
-		ids = append(ids, id)
-	}
-	return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegistryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ProjectWebhooksIDs returns the "project_webhooks" edge IDs in the mutation.
-func (m *ProjectMutation) ProjectWebhooksIDs() (ids []uuid.UUID) {
-	for id := range m.project_webhooks {
-		ids = append(ids, id)
-	}
-	return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegistryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetProjectWebhooks resets all changes to the "project_webhooks" edge.
-func (m *ProjectMutation) ResetProjectWebhooks() {
-	m.project_webhooks = nil
-	m.clearedproject_webhooks = false
-	m.removedproject_webhooks = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegistryMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
-	m.predicates = append(m.predicates, ps...)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegistryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Registry unique edge %s", name)
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegistryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Registry edge %s", name)
 }
 
-// Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
-	return m.op
+// S3Mutation represents an operation that mutates the S3 nodes in the graph.
+type S3Mutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	name                         *string
+	endpoint                     *string
+	region                       *string
+	force_path_style             *bool
+	kubernetes_secret            *string
+	clearedFields                map[string]struct{}
+	team                         *uuid.UUID
+	clearedteam                  bool
+	service_backup_source        map[uuid.UUID]struct{}
+	removedservice_backup_source map[uuid.UUID]struct{}
+	clearedservice_backup_source bool
+	service_upload_source        map[uuid.UUID]struct{}
+	removedservice_upload_source map[uuid.UUID]struct{}
+	clearedservice_upload_source bool
+	done                         bool
+	oldValue                     func(context.Context) (*S3, error)
+	predicates                   []predicate.S3
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
-	m.op = op
-}
+var _ ent.Mutation = (*S3Mutation)(nil)
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
-	return m.typ
-}
+// s3Option allows management of the mutation configuration using functional options.
+type s3Option func(*S3Mutation)
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.created_at != nil {
-		fields = append(fields, project.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, project.FieldUpdatedAt)
-	}
-	if m.kubernetes_name != nil {
-		fields = append(fields, project.FieldKubernetesName)
-	}
-	if m.name != nil {
-		fields = append(fields, project.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, project.FieldDescription)
-	}
-	if m.status != nil {
-		fields = append(fields, project.FieldStatus)
-	}
-	if m.team != nil {
-		fields = append(fields, project.FieldTeamID)
-	}
-	if m.tags != nil {
-		fields = append(fields, project.FieldTags)
+// newS3Mutation creates new mutation for the S3 entity.
+func newS3Mutation(c config, op Op, opts ...s3Option) *S3Mutation {
+	m := &S3Mutation{
+		config:        c,
+		op:            op,
+		typ:           TypeS3,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.default_environment != nil {
-		fields = append(fields, project.FieldDefaultEnvironmentID)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.kubernetes_secret != nil {
-		fields = append(fields, project.FieldKubernetesSecret)
+	return m
+}
+
+// withS3ID sets the ID field of the mutation.
+func withS3ID(id uuid.UUID) s3Option {
+	return func(m *S3Mutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *S3
+		)
+		m.oldValue = func(ctx context.Context) (*S3, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().S3.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case project.FieldCreatedAt:
-		return m.CreatedAt()
-	case project.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case project.FieldKubernetesName:
-		return m.KubernetesName()
-	case project.FieldName:
-		return m.Name()
-	case project.FieldDescription:
-		return m.Description()
-	case project.FieldStatus:
-		return m.Status()
-	case project.FieldTeamID:
-		return m.TeamID()
-	case project.FieldTags:
-		return m.Tags()
-	case project.FieldDefaultEnvironmentID:
-		return m.DefaultEnvironmentID()
-	case project.FieldKubernetesSecret:
-		return m.KubernetesSecret()
+// withS3 sets the old S3 of the mutation.
+func withS3(node *S3) s3Option {
+	return func(m *S3Mutation) {
+		m.oldValue = func(context.Context) (*S3, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case project.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case project.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case project.FieldKubernetesName:
-		return m.OldKubernetesName(ctx)
-	case project.FieldName:
-		return m.OldName(ctx)
-	case project.FieldDescription:
-		return m.OldDescription(ctx)
-	case project.FieldStatus:
-		return m.OldStatus(ctx)
-	case project.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case project.FieldTags:
-		return m.OldTags(ctx)
-	case project.FieldDefaultEnvironmentID:
-		return m.OldDefaultEnvironmentID(ctx)
-	case project.FieldKubernetesSecret:
-		return m.OldKubernetesSecret(ctx)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m S3Mutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m S3Mutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case project.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case project.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case project.FieldKubernetesName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKubernetesName(v)
-		return nil
-	case project.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case project.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case project.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case project.FieldTeamID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTeamID(v)
-		return nil
-	case project.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case project.FieldDefaultEnvironmentID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDefaultEnvironmentID(v)
-		return nil
-	case project.FieldKubernetesSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKubernetesSecret(v)
-		return nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of S3 entities.
+func (m *S3Mutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *S3Mutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return *m.id, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProjectMutation) AddedFields() []string {
-	return nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *S3Mutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().S3.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetCreatedAt sets the "created_at" field.
+func (m *S3Mutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *S3Mutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ProjectMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(project.FieldDescription) {
-		fields = append(fields, project.FieldDescription)
+// OldCreatedAt returns the old "created_at" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(project.FieldTags) {
-		fields = append(fields, project.FieldTags)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(project.FieldDefaultEnvironmentID) {
-		fields = append(fields, project.FieldDefaultEnvironmentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return fields
+	return oldValue.CreatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *S3Mutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
-	switch name {
-	case project.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case project.FieldTags:
-		m.ClearTags()
-		return nil
-	case project.FieldDefaultEnvironmentID:
-		m.ClearDefaultEnvironmentID()
-		return nil
-	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *S3Mutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
-	switch name {
-	case project.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case project.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case project.FieldKubernetesName:
-		m.ResetKubernetesName()
-		return nil
-	case project.FieldName:
-		m.ResetName()
-		return nil
-	case project.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case project.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case project.FieldTeamID:
-		m.ResetTeamID()
-		return nil
-	case project.FieldTags:
-		m.ResetTags()
-		return nil
-	case project.FieldDefaultEnvironmentID:
-		m.ResetDefaultEnvironmentID()
-		return nil
-	case project.FieldKubernetesSecret:
-		m.ResetKubernetesSecret()
-		return nil
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *S3Mutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.team != nil {
-		edges = append(edges, project.EdgeTeam)
-	}
-	if m.environments != nil {
-		edges = append(edges, project.EdgeEnvironments)
+// OldUpdatedAt returns the old "updated_at" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.default_environment != nil {
-		edges = append(edges, project.EdgeDefaultEnvironment)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.project_webhooks != nil {
-		edges = append(edges, project.EdgeProjectWebhooks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return edges
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case project.EdgeEnvironments:
-		ids := make([]ent.Value, 0, len(m.environments))
-		for id := range m.environments {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeDefaultEnvironment:
-		if id := m.default_environment; id != nil {
-			return []ent.Value{*id}
-		}
-	case project.EdgeProjectWebhooks:
-		ids := make([]ent.Value, 0, len(m.project_webhooks))
-		for id := range m.project_webhooks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *S3Mutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedenvironments != nil {
-		edges = append(edges, project.EdgeEnvironments)
-	}
-	if m.removedproject_webhooks != nil {
-		edges = append(edges, project.EdgeProjectWebhooks)
-	}
-	return edges
+// SetName sets the "name" field.
+func (m *S3Mutation) SetName(s string) {
+	m.name = &s
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeEnvironments:
-		ids := make([]ent.Value, 0, len(m.removedenvironments))
-		for id := range m.removedenvironments {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeProjectWebhooks:
-		ids := make([]ent.Value, 0, len(m.removedproject_webhooks))
-		for id := range m.removedproject_webhooks {
-			ids = append(ids, id)
-		}
-		return ids
+// Name returns the value of the "name" field in the mutation.
+func (m *S3Mutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedteam {
-		edges = append(edges, project.EdgeTeam)
-	}
-	if m.clearedenvironments {
-		edges = append(edges, project.EdgeEnvironments)
+// OldName returns the old "name" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.cleareddefault_environment {
-		edges = append(edges, project.EdgeDefaultEnvironment)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.clearedproject_webhooks {
-		edges = append(edges, project.EdgeProjectWebhooks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return edges
+	return oldValue.Name, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
-	switch name {
-	case project.EdgeTeam:
-		return m.clearedteam
-	case project.EdgeEnvironments:
-		return m.clearedenvironments
-	case project.EdgeDefaultEnvironment:
-		return m.cleareddefault_environment
-	case project.EdgeProjectWebhooks:
-		return m.clearedproject_webhooks
-	}
-	return false
+// ResetName resets all changes to the "name" field.
+func (m *S3Mutation) ResetName() {
+	m.name = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
-	switch name {
-	case project.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case project.EdgeDefaultEnvironment:
-		m.ClearDefaultEnvironment()
-		return nil
-	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+// SetEndpoint sets the "endpoint" field.
+func (m *S3Mutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
-	switch name {
-	case project.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case project.EdgeEnvironments:
-		m.ResetEnvironments()
-		return nil
-	case project.EdgeDefaultEnvironment:
-		m.ResetDefaultEnvironment()
-		return nil
-	case project.EdgeProjectWebhooks:
-		m.ResetProjectWebhooks()
-		return nil
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *S3Mutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
-}
-
-// RegistryMutation represents an operation that mutates the Registry nodes in the graph.
-type RegistryMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	created_at        *time.Time
-	updated_at        *time.Time
-	host              *string
-	kubernetes_secret *string
-	is_default        *bool
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*Registry, error)
-	predicates        []predicate.Registry
+	return *v, true
 }
 
-var _ ent.Mutation = (*RegistryMutation)(nil)
-
-// registryOption allows management of the mutation configuration using functional options.
-type registryOption func(*RegistryMutation)
-
-// newRegistryMutation creates new mutation for the Registry entity.
-func newRegistryMutation(c config, op Op, opts ...registryOption) *RegistryMutation {
-	m := &RegistryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRegistry,
-		clearedFields: make(map[string]struct{}),
+// OldEndpoint returns the old "endpoint" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
 }
 
-// withRegistryID sets the ID field of the mutation.
-func withRegistryID(id uuid.UUID) registryOption {
-	return func(m *RegistryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Registry
-		)
-		m.oldValue = func(ctx context.Context) (*Registry, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Registry.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *S3Mutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// withRegistry sets the old Registry of the mutation.
-func withRegistry(node *Registry) registryOption {
-	return func(m *RegistryMutation) {
-		m.oldValue = func(context.Context) (*Registry, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetRegion sets the "region" field.
+func (m *S3Mutation) SetRegion(s string) {
+	m.region = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RegistryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Region returns the value of the "region" field in the mutation.
+func (m *S3Mutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RegistryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldRegion returns the old "region" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Registry entities.
-func (m *RegistryMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetRegion resets all changes to the "region" field.
+func (m *S3Mutation) ResetRegion() {
+	m.region = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RegistryMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetForcePathStyle sets the "force_path_style" field.
+func (m *S3Mutation) SetForcePathStyle(b bool) {
+	m.force_path_style = &b
+}
+
+// ForcePathStyle returns the value of the "force_path_style" field in the mutation.
+func (m *S3Mutation) ForcePathStyle() (r bool, exists bool) {
+	v := m.force_path_style
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RegistryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Registry.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldForcePathStyle returns the old "force_path_style" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *S3Mutation) OldForcePathStyle(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForcePathStyle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForcePathStyle requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForcePathStyle: %w", err)
+	}
+	return oldValue.ForcePathStyle, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RegistryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetForcePathStyle resets all changes to the "force_path_style" field.
+func (m *S3Mutation) ResetForcePathStyle() {
+	m.force_path_style = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RegistryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetKubernetesSecret sets the "kubernetes_secret" field.
+func (m *S3Mutation) SetKubernetesSecret(s string) {
+	m.kubernetes_secret = &s
+}
+
+// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
+func (m *S3Mutation) KubernetesSecret() (r string, exists bool) {
+	v := m.kubernetes_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *S3Mutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.KubernetesSecret, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RegistryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
+func (m *S3Mutation) ResetKubernetesSecret() {
+	m.kubernetes_secret = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RegistryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTeamID sets the "team_id" field.
+func (m *S3Mutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RegistryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *S3Mutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the S3 entity.
+// If the S3 object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *S3Mutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RegistryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *S3Mutation) ResetTeamID() {
+	m.team = nil
 }
 
-// SetHost sets the "host" field.
-func (m *RegistryMutation) SetHost(s string) {
-	m.host = &s
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *S3Mutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[s3.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *S3Mutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *S3Mutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *S3Mutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// AddServiceBackupSourceIDs adds the "service_backup_source" edge to the ServiceConfig entity by ids.
+func (m *S3Mutation) AddServiceBackupSourceIDs(ids ...uuid.UUID) {
+	if m.service_backup_source == nil {
+		m.service_backup_source = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.service_backup_source[ids[i]] = struct{}{}
+	}
 }
 
-// Host returns the value of the "host" field in the mutation.
-func (m *RegistryMutation) Host() (r string, exists bool) {
-	v := m.host
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearServiceBackupSource clears the "service_backup_source" edge to the ServiceConfig entity.
+func (m *S3Mutation) ClearServiceBackupSource() {
+	m.clearedservice_backup_source = true
 }
 
-// OldHost returns the old "host" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldHost(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHost is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHost requires an ID field in the mutation")
+// ServiceBackupSourceCleared reports if the "service_backup_source" edge to the ServiceConfig entity was cleared.
+func (m *S3Mutation) ServiceBackupSourceCleared() bool {
+	return m.clearedservice_backup_source
+}
+
+// RemoveServiceBackupSourceIDs removes the "service_backup_source" edge to the ServiceConfig entity by IDs.
+func (m *S3Mutation) RemoveServiceBackupSourceIDs(ids ...uuid.UUID) {
+	if m.removedservice_backup_source == nil {
+		m.removedservice_backup_source = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHost: %w", err)
+	for i := range ids {
+		delete(m.service_backup_source, ids[i])
+		m.removedservice_backup_source[ids[i]] = struct{}{}
 	}
-	return oldValue.Host, nil
 }
 
-// ResetHost resets all changes to the "host" field.
-func (m *RegistryMutation) ResetHost() {
-	m.host = nil
+// RemovedServiceBackupSource returns the removed IDs of the "service_backup_source" edge to the ServiceConfig entity.
+func (m *S3Mutation) RemovedServiceBackupSourceIDs() (ids []uuid.UUID) {
+	for id := range m.removedservice_backup_source {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetKubernetesSecret sets the "kubernetes_secret" field.
-func (m *RegistryMutation) SetKubernetesSecret(s string) {
-	m.kubernetes_secret = &s
+// ServiceBackupSourceIDs returns the "service_backup_source" edge IDs in the mutation.
+func (m *S3Mutation) ServiceBackupSourceIDs() (ids []uuid.UUID) {
+	for id := range m.service_backup_source {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
-func (m *RegistryMutation) KubernetesSecret() (r string, exists bool) {
-	v := m.kubernetes_secret
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetServiceBackupSource resets all changes to the "service_backup_source" edge.
+func (m *S3Mutation) ResetServiceBackupSource() {
+	m.service_backup_source = nil
+	m.clearedservice_backup_source = false
+	m.removedservice_backup_source = nil
 }
 
-// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
+// AddServiceUploadSourceIDs adds the "service_upload_source" edge to the ServiceConfig entity by ids.
+func (m *S3Mutation) AddServiceUploadSourceIDs(ids ...uuid.UUID) {
+	if m.service_upload_source == nil {
+		m.service_upload_source = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
+	for i := range ids {
+		m.service_upload_source[ids[i]] = struct{}{}
 	}
-	return oldValue.KubernetesSecret, nil
 }
 
-// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
-func (m *RegistryMutation) ResetKubernetesSecret() {
-	m.kubernetes_secret = nil
+// ClearServiceUploadSource clears the "service_upload_source" edge to the ServiceConfig entity.
+func (m *S3Mutation) ClearServiceUploadSource() {
+	m.clearedservice_upload_source = true
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *RegistryMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// ServiceUploadSourceCleared reports if the "service_upload_source" edge to the ServiceConfig entity was cleared.
+func (m *S3Mutation) ServiceUploadSourceCleared() bool {
+	return m.clearedservice_upload_source
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *RegistryMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
-	if v == nil {
-		return
+// RemoveServiceUploadSourceIDs removes the "service_upload_source" edge to the ServiceConfig entity by IDs.
+func (m *S3Mutation) RemoveServiceUploadSourceIDs(ids ...uuid.UUID) {
+	if m.removedservice_upload_source == nil {
+		m.removedservice_upload_source = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.service_upload_source, ids[i])
+		m.removedservice_upload_source[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the Registry entity.
-// If the Registry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegistryMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+// RemovedServiceUploadSource returns the removed IDs of the "service_upload_source" edge to the ServiceConfig entity.
+func (m *S3Mutation) RemovedServiceUploadSourceIDs() (ids []uuid.UUID) {
+	for id := range m.removedservice_upload_source {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	return
+}
+
+// ServiceUploadSourceIDs returns the "service_upload_source" edge IDs in the mutation.
+func (m *S3Mutation) ServiceUploadSourceIDs() (ids []uuid.UUID) {
+	for id := range m.service_upload_source {
+		ids = append(ids, id)
 	}
-	return oldValue.IsDefault, nil
+	return
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *RegistryMutation) ResetIsDefault() {
-	m.is_default = nil
+// ResetServiceUploadSource resets all changes to the "service_upload_source" edge.
+func (m *S3Mutation) ResetServiceUploadSource() {
+	m.service_upload_source = nil
+	m.clearedservice_upload_source = false
+	m.removedservice_upload_source = nil
 }
 
-// Where appends a list predicates to the RegistryMutation builder.
-func (m *RegistryMutation) Where(ps ...predicate.Registry) {
+// Where appends a list predicates to the S3Mutation builder.
+func (m *S3Mutation) Where(ps ...predicate.S3) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RegistryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the S3Mutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Registry, len(ps))
+func (m *S3Mutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.S3, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10934,39 +14720,48 @@ func (m *RegistryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RegistryMutation) Op() Op {
+func (m *S3Mutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RegistryMutation) SetOp(op Op) {
+func (m *S3Mutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Registry).
-func (m *RegistryMutation) Type() string {
+// Type returns the node type of this mutation (S3).
+func (m *S3Mutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RegistryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *S3Mutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, registry.FieldCreatedAt)
+		fields = append(fields, s3.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, registry.FieldUpdatedAt)
+		fields = append(fields, s3.FieldUpdatedAt)
 	}
-	if m.host != nil {
-		fields = append(fields, registry.FieldHost)
+	if m.name != nil {
+		fields = append(fields, s3.FieldName)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, s3.FieldEndpoint)
+	}
+	if m.region != nil {
+		fields = append(fields, s3.FieldRegion)
+	}
+	if m.force_path_style != nil {
+		fields = append(fields, s3.FieldForcePathStyle)
 	}
 	if m.kubernetes_secret != nil {
-		fields = append(fields, registry.FieldKubernetesSecret)
+		fields = append(fields, s3.FieldKubernetesSecret)
 	}
-	if m.is_default != nil {
-		fields = append(fields, registry.FieldIsDefault)
+	if m.team != nil {
+		fields = append(fields, s3.FieldTeamID)
 	}
 	return fields
 }
@@ -10974,18 +14769,24 @@ func (m *RegistryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
+func (m *S3Mutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case registry.FieldCreatedAt:
+	case s3.FieldCreatedAt:
 		return m.CreatedAt()
-	case registry.FieldUpdatedAt:
+	case s3.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case registry.FieldHost:
-		return m.Host()
-	case registry.FieldKubernetesSecret:
+	case s3.FieldName:
+		return m.Name()
+	case s3.FieldEndpoint:
+		return m.Endpoint()
+	case s3.FieldRegion:
+		return m.Region()
+	case s3.FieldForcePathStyle:
+		return m.ForcePathStyle()
+	case s3.FieldKubernetesSecret:
 		return m.KubernetesSecret()
-	case registry.FieldIsDefault:
-		return m.IsDefault()
+	case s3.FieldTeamID:
+		return m.TeamID()
 	}
 	return nil, false
 }
@@ -10993,213 +14794,325 @@ func (m *RegistryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RegistryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *S3Mutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case registry.FieldCreatedAt:
+	case s3.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case registry.FieldUpdatedAt:
+	case s3.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case registry.FieldHost:
-		return m.OldHost(ctx)
-	case registry.FieldKubernetesSecret:
+	case s3.FieldName:
+		return m.OldName(ctx)
+	case s3.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case s3.FieldRegion:
+		return m.OldRegion(ctx)
+	case s3.FieldForcePathStyle:
+		return m.OldForcePathStyle(ctx)
+	case s3.FieldKubernetesSecret:
 		return m.OldKubernetesSecret(ctx)
-	case registry.FieldIsDefault:
-		return m.OldIsDefault(ctx)
+	case s3.FieldTeamID:
+		return m.OldTeamID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Registry field %s", name)
+	return nil, fmt.Errorf("unknown S3 field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegistryMutation) SetField(name string, value ent.Value) error {
+func (m *S3Mutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case registry.FieldCreatedAt:
+	case s3.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case registry.FieldUpdatedAt:
+	case s3.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case registry.FieldHost:
+	case s3.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHost(v)
+		m.SetName(v)
 		return nil
-	case registry.FieldKubernetesSecret:
+	case s3.FieldEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKubernetesSecret(v)
+		m.SetEndpoint(v)
 		return nil
-	case registry.FieldIsDefault:
+	case s3.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case s3.FieldForcePathStyle:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetForcePathStyle(v)
+		return nil
+	case s3.FieldKubernetesSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubernetesSecret(v)
+		return nil
+	case s3.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Registry field %s", name)
+	return fmt.Errorf("unknown S3 field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RegistryMutation) AddedFields() []string {
+func (m *S3Mutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RegistryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *S3Mutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegistryMutation) AddField(name string, value ent.Value) error {
+func (m *S3Mutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Registry numeric field %s", name)
+	return fmt.Errorf("unknown S3 numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RegistryMutation) ClearedFields() []string {
+func (m *S3Mutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RegistryMutation) FieldCleared(name string) bool {
+func (m *S3Mutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RegistryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Registry nullable field %s", name)
+func (m *S3Mutation) ClearField(name string) error {
+	return fmt.Errorf("unknown S3 nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RegistryMutation) ResetField(name string) error {
+func (m *S3Mutation) ResetField(name string) error {
 	switch name {
-	case registry.FieldCreatedAt:
+	case s3.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case registry.FieldUpdatedAt:
+	case s3.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case registry.FieldHost:
-		m.ResetHost()
+	case s3.FieldName:
+		m.ResetName()
 		return nil
-	case registry.FieldKubernetesSecret:
+	case s3.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case s3.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case s3.FieldForcePathStyle:
+		m.ResetForcePathStyle()
+		return nil
+	case s3.FieldKubernetesSecret:
 		m.ResetKubernetesSecret()
 		return nil
-	case registry.FieldIsDefault:
-		m.ResetIsDefault()
+	case s3.FieldTeamID:
+		m.ResetTeamID()
 		return nil
 	}
-	return fmt.Errorf("unknown Registry field %s", name)
+	return fmt.Errorf("unknown S3 field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RegistryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *S3Mutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.team != nil {
+		edges = append(edges, s3.EdgeTeam)
+	}
+	if m.service_backup_source != nil {
+		edges = append(edges, s3.EdgeServiceBackupSource)
+	}
+	if m.service_upload_source != nil {
+		edges = append(edges, s3.EdgeServiceUploadSource)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RegistryMutation) AddedIDs(name string) []ent.Value {
+func (m *S3Mutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case s3.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case s3.EdgeServiceBackupSource:
+		ids := make([]ent.Value, 0, len(m.service_backup_source))
+		for id := range m.service_backup_source {
+			ids = append(ids, id)
+		}
+		return ids
+	case s3.EdgeServiceUploadSource:
+		ids := make([]ent.Value, 0, len(m.service_upload_source))
+		for id := range m.service_upload_source {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RegistryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *S3Mutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedservice_backup_source != nil {
+		edges = append(edges, s3.EdgeServiceBackupSource)
+	}
+	if m.removedservice_upload_source != nil {
+		edges = append(edges, s3.EdgeServiceUploadSource)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RegistryMutation) RemovedIDs(name string) []ent.Value {
+func (m *S3Mutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case s3.EdgeServiceBackupSource:
+		ids := make([]ent.Value, 0, len(m.removedservice_backup_source))
+		for id := range m.removedservice_backup_source {
+			ids = append(ids, id)
+		}
+		return ids
+	case s3.EdgeServiceUploadSource:
+		ids := make([]ent.Value, 0, len(m.removedservice_upload_source))
+		for id := range m.removedservice_upload_source {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RegistryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *S3Mutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedteam {
+		edges = append(edges, s3.EdgeTeam)
+	}
+	if m.clearedservice_backup_source {
+		edges = append(edges, s3.EdgeServiceBackupSource)
+	}
+	if m.clearedservice_upload_source {
+		edges = append(edges, s3.EdgeServiceUploadSource)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RegistryMutation) EdgeCleared(name string) bool {
+func (m *S3Mutation) EdgeCleared(name string) bool {
+	switch name {
+	case s3.EdgeTeam:
+		return m.clearedteam
+	case s3.EdgeServiceBackupSource:
+		return m.clearedservice_backup_source
+	case s3.EdgeServiceUploadSource:
+		return m.clearedservice_upload_source
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RegistryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Registry unique edge %s", name)
+func (m *S3Mutation) ClearEdge(name string) error {
+	switch name {
+	case s3.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown S3 unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RegistryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Registry edge %s", name)
+func (m *S3Mutation) ResetEdge(name string) error {
+	switch name {
+	case s3.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case s3.EdgeServiceBackupSource:
+		m.ResetServiceBackupSource()
+		return nil
+	case s3.EdgeServiceUploadSource:
+		m.ResetServiceUploadSource()
+		return nil
+	}
+	return fmt.Errorf("unknown S3 edge %s", name)
 }
 
-// S3Mutation represents an operation that mutates the S3 nodes in the graph.
-type S3Mutation struct {
+// SBOMPackageMutation represents an operation that mutates the SBOMPackage nodes in the graph.
+type SBOMPackageMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uuid.UUID
-	created_at                   *time.Time
-	updated_at                   *time.Time
-	name                         *string
-	endpoint                     *string
-	region                       *string
-	force_path_style             *bool
-	kubernetes_secret            *string
-	clearedFields                map[string]struct{}
-	team                         *uuid.UUID
-	clearedteam                  bool
-	service_backup_source        map[uuid.UUID]struct{}
-	removedservice_backup_source map[uuid.UUID]struct{}
-	clearedservice_backup_source bool
-	done                         bool
-	oldValue                     func(context.Context) (*S3, error)
-	predicates                   []predicate.S3
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	name              *string
+	version           *string
+	_type             *string
+	purl              *string
+	clearedFields     map[string]struct{}
+	deployment        *uuid.UUID
+	cleareddeployment bool
+	done              bool
+	oldValue          func(context.Context) (*SBOMPackage, error)
+	predicates        []predicate.SBOMPackage
 }
 
-var _ ent.Mutation = (*S3Mutation)(nil)
+var _ ent.Mutation = (*SBOMPackageMutation)(nil)
 
-// s3Option allows management of the mutation configuration using functional options.
-type s3Option func(*S3Mutation)
+// sbompackageOption allows management of the mutation configuration using functional options.
+type sbompackageOption func(*SBOMPackageMutation)
 
-// newS3Mutation creates new mutation for the S3 entity.
-func newS3Mutation(c config, op Op, opts ...s3Option) *S3Mutation {
-	m := &S3Mutation{
+// newSBOMPackageMutation creates new mutation for the SBOMPackage entity.
+func newSBOMPackageMutation(c config, op Op, opts ...sbompackageOption) *SBOMPackageMutation {
+	m := &SBOMPackageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeS3,
+		typ:           TypeSBOMPackage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11208,20 +15121,20 @@ func newS3Mutation(c config, op Op, opts ...s3Option) *S3Mutation {
 	return m
 }
 
-// withS3ID sets the ID field of the mutation.
-func withS3ID(id uuid.UUID) s3Option {
-	return func(m *S3Mutation) {
+// withSBOMPackageID sets the ID field of the mutation.
+func withSBOMPackageID(id uuid.UUID) sbompackageOption {
+	return func(m *SBOMPackageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *S3
+			value *SBOMPackage
 		)
-		m.oldValue = func(ctx context.Context) (*S3, error) {
+		m.oldValue = func(ctx context.Context) (*SBOMPackage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().S3.Get(ctx, id)
+					value, err = m.Client().SBOMPackage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11230,10 +15143,10 @@ func withS3ID(id uuid.UUID) s3Option {
 	}
 }
 
-// withS3 sets the old S3 of the mutation.
-func withS3(node *S3) s3Option {
-	return func(m *S3Mutation) {
-		m.oldValue = func(context.Context) (*S3, error) {
+// withSBOMPackage sets the old SBOMPackage of the mutation.
+func withSBOMPackage(node *SBOMPackage) sbompackageOption {
+	return func(m *SBOMPackageMutation) {
+		m.oldValue = func(context.Context) (*SBOMPackage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11242,7 +15155,7 @@ func withS3(node *S3) s3Option {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m S3Mutation) Client() *Client {
+func (m SBOMPackageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11250,7 +15163,7 @@ func (m S3Mutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m S3Mutation) Tx() (*Tx, error) {
+func (m SBOMPackageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11260,14 +15173,14 @@ func (m S3Mutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of S3 entities.
-func (m *S3Mutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SBOMPackage entities.
+func (m *SBOMPackageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *S3Mutation) ID() (id uuid.UUID, exists bool) {
+func (m *SBOMPackageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11278,7 +15191,7 @@ func (m *S3Mutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *S3Mutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SBOMPackageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11287,19 +15200,19 @@ func (m *S3Mutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().S3.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SBOMPackage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *S3Mutation) SetCreatedAt(t time.Time) {
+func (m *SBOMPackageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *S3Mutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SBOMPackageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -11307,10 +15220,10 @@ func (m *S3Mutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SBOMPackageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -11325,17 +15238,17 @@ func (m *S3Mutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *S3Mutation) ResetCreatedAt() {
+func (m *SBOMPackageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *S3Mutation) SetUpdatedAt(t time.Time) {
+func (m *SBOMPackageMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *S3Mutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SBOMPackageMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -11343,10 +15256,10 @@ func (m *S3Mutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SBOMPackageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -11360,317 +15273,227 @@ func (m *S3Mutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error)
 	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *S3Mutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetName sets the "name" field.
-func (m *S3Mutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *S3Mutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *S3Mutation) ResetName() {
-	m.name = nil
-}
-
-// SetEndpoint sets the "endpoint" field.
-func (m *S3Mutation) SetEndpoint(s string) {
-	m.endpoint = &s
-}
-
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *S3Mutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEndpoint returns the old "endpoint" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldEndpoint(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
-	}
-	return oldValue.Endpoint, nil
-}
-
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *S3Mutation) ResetEndpoint() {
-	m.endpoint = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SBOMPackageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetRegion sets the "region" field.
-func (m *S3Mutation) SetRegion(s string) {
-	m.region = &s
+// SetDeploymentID sets the "deployment_id" field.
+func (m *SBOMPackageMutation) SetDeploymentID(u uuid.UUID) {
+	m.deployment = &u
 }
 
-// Region returns the value of the "region" field in the mutation.
-func (m *S3Mutation) Region() (r string, exists bool) {
-	v := m.region
+// DeploymentID returns the value of the "deployment_id" field in the mutation.
+func (m *SBOMPackageMutation) DeploymentID() (r uuid.UUID, exists bool) {
+	v := m.deployment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegion returns the old "region" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldDeploymentID returns the old "deployment_id" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldRegion(ctx context.Context) (v string, err error) {
+func (m *SBOMPackageMutation) OldDeploymentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegion requires an ID field in the mutation")
+		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
 	}
-	return oldValue.Region, nil
+	return oldValue.DeploymentID, nil
 }
 
-// ResetRegion resets all changes to the "region" field.
-func (m *S3Mutation) ResetRegion() {
-	m.region = nil
+// ResetDeploymentID resets all changes to the "deployment_id" field.
+func (m *SBOMPackageMutation) ResetDeploymentID() {
+	m.deployment = nil
 }
 
-// SetForcePathStyle sets the "force_path_style" field.
-func (m *S3Mutation) SetForcePathStyle(b bool) {
-	m.force_path_style = &b
+// SetName sets the "name" field.
+func (m *SBOMPackageMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ForcePathStyle returns the value of the "force_path_style" field in the mutation.
-func (m *S3Mutation) ForcePathStyle() (r bool, exists bool) {
-	v := m.force_path_style
+// Name returns the value of the "name" field in the mutation.
+func (m *SBOMPackageMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldForcePathStyle returns the old "force_path_style" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldForcePathStyle(ctx context.Context) (v bool, err error) {
+func (m *SBOMPackageMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldForcePathStyle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldForcePathStyle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldForcePathStyle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ForcePathStyle, nil
+	return oldValue.Name, nil
 }
 
-// ResetForcePathStyle resets all changes to the "force_path_style" field.
-func (m *S3Mutation) ResetForcePathStyle() {
-	m.force_path_style = nil
+// ResetName resets all changes to the "name" field.
+func (m *SBOMPackageMutation) ResetName() {
+	m.name = nil
 }
 
-// SetKubernetesSecret sets the "kubernetes_secret" field.
-func (m *S3Mutation) SetKubernetesSecret(s string) {
-	m.kubernetes_secret = &s
+// SetVersion sets the "version" field.
+func (m *SBOMPackageMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// KubernetesSecret returns the value of the "kubernetes_secret" field in the mutation.
-func (m *S3Mutation) KubernetesSecret() (r string, exists bool) {
-	v := m.kubernetes_secret
+// Version returns the value of the "version" field in the mutation.
+func (m *SBOMPackageMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKubernetesSecret returns the old "kubernetes_secret" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldKubernetesSecret(ctx context.Context) (v string, err error) {
+func (m *SBOMPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKubernetesSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKubernetesSecret requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKubernetesSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.KubernetesSecret, nil
+	return oldValue.Version, nil
 }
 
-// ResetKubernetesSecret resets all changes to the "kubernetes_secret" field.
-func (m *S3Mutation) ResetKubernetesSecret() {
-	m.kubernetes_secret = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *SBOMPackageMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *S3Mutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// SetType sets the "type" field.
+func (m *SBOMPackageMutation) SetType(s string) {
+	m._type = &s
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *S3Mutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
+// GetType returns the value of the "type" field in the mutation.
+func (m *SBOMPackageMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the S3 entity.
-// If the S3 object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *S3Mutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SBOMPackageMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.TeamID, nil
-}
-
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *S3Mutation) ResetTeamID() {
-	m.team = nil
+	return oldValue.Type, nil
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *S3Mutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[s3.FieldTeamID] = struct{}{}
+// ResetType resets all changes to the "type" field.
+func (m *SBOMPackageMutation) ResetType() {
+	m._type = nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *S3Mutation) TeamCleared() bool {
-	return m.clearedteam
+// SetPurl sets the "purl" field.
+func (m *SBOMPackageMutation) SetPurl(s string) {
+	m.purl = &s
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *S3Mutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// Purl returns the value of the "purl" field in the mutation.
+func (m *SBOMPackageMutation) Purl() (r string, exists bool) {
+	v := m.purl
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetTeam resets all changes to the "team" edge.
-func (m *S3Mutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+	return *v, true
 }
 
-// AddServiceBackupSourceIDs adds the "service_backup_source" edge to the ServiceConfig entity by ids.
-func (m *S3Mutation) AddServiceBackupSourceIDs(ids ...uuid.UUID) {
-	if m.service_backup_source == nil {
-		m.service_backup_source = make(map[uuid.UUID]struct{})
+// OldPurl returns the old "purl" field's value of the SBOMPackage entity.
+// If the SBOMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SBOMPackageMutation) OldPurl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurl is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.service_backup_source[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurl requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurl: %w", err)
+	}
+	return oldValue.Purl, nil
 }
 
-// ClearServiceBackupSource clears the "service_backup_source" edge to the ServiceConfig entity.
-func (m *S3Mutation) ClearServiceBackupSource() {
-	m.clearedservice_backup_source = true
-}
-
-// ServiceBackupSourceCleared reports if the "service_backup_source" edge to the ServiceConfig entity was cleared.
-func (m *S3Mutation) ServiceBackupSourceCleared() bool {
-	return m.clearedservice_backup_source
+// ResetPurl resets all changes to the "purl" field.
+func (m *SBOMPackageMutation) ResetPurl() {
+	m.purl = nil
 }
 
-// RemoveServiceBackupSourceIDs removes the "service_backup_source" edge to the ServiceConfig entity by IDs.
-func (m *S3Mutation) RemoveServiceBackupSourceIDs(ids ...uuid.UUID) {
-	if m.removedservice_backup_source == nil {
-		m.removedservice_backup_source = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.service_backup_source, ids[i])
-		m.removedservice_backup_source[ids[i]] = struct{}{}
-	}
+// ClearDeployment clears the "deployment" edge to the Deployment entity.
+func (m *SBOMPackageMutation) ClearDeployment() {
+	m.cleareddeployment = true
+	m.clearedFields[sbompackage.FieldDeploymentID] = struct{}{}
 }
 
-// RemovedServiceBackupSource returns the removed IDs of the "service_backup_source" edge to the ServiceConfig entity.
-func (m *S3Mutation) RemovedServiceBackupSourceIDs() (ids []uuid.UUID) {
-	for id := range m.removedservice_backup_source {
-		ids = append(ids, id)
-	}
-	return
+// DeploymentCleared reports if the "deployment" edge to the Deployment entity was cleared.
+func (m *SBOMPackageMutation) DeploymentCleared() bool {
+	return m.cleareddeployment
 }
 
-// ServiceBackupSourceIDs returns the "service_backup_source" edge IDs in the mutation.
-func (m *S3Mutation) ServiceBackupSourceIDs() (ids []uuid.UUID) {
-	for id := range m.service_backup_source {
-		ids = append(ids, id)
+// DeploymentIDs returns the "deployment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeploymentID instead. It exists only for internal usage by the builders.
+func (m *SBOMPackageMutation) DeploymentIDs() (ids []uuid.UUID) {
+	if id := m.deployment; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetServiceBackupSource resets all changes to the "service_backup_source" edge.
-func (m *S3Mutation) ResetServiceBackupSource() {
-	m.service_backup_source = nil
-	m.clearedservice_backup_source = false
-	m.removedservice_backup_source = nil
+// ResetDeployment resets all changes to the "deployment" edge.
+func (m *SBOMPackageMutation) ResetDeployment() {
+	m.deployment = nil
+	m.cleareddeployment = false
 }
 
-// Where appends a list predicates to the S3Mutation builder.
-func (m *S3Mutation) Where(ps ...predicate.S3) {
+// Where appends a list predicates to the SBOMPackageMutation builder.
+func (m *SBOMPackageMutation) Where(ps ...predicate.SBOMPackage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the S3Mutation builder. Using this method,
+// WhereP appends storage-level predicates to the SBOMPackageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *S3Mutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.S3, len(ps))
+func (m *SBOMPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SBOMPackage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11678,48 +15501,45 @@ func (m *S3Mutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *S3Mutation) Op() Op {
+func (m *SBOMPackageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *S3Mutation) SetOp(op Op) {
+func (m *SBOMPackageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (S3).
-func (m *S3Mutation) Type() string {
+// Type returns the node type of this mutation (SBOMPackage).
+func (m *SBOMPackageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *S3Mutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *SBOMPackageMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, s3.FieldCreatedAt)
+		fields = append(fields, sbompackage.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, s3.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, s3.FieldName)
+		fields = append(fields, sbompackage.FieldUpdatedAt)
 	}
-	if m.endpoint != nil {
-		fields = append(fields, s3.FieldEndpoint)
+	if m.deployment != nil {
+		fields = append(fields, sbompackage.FieldDeploymentID)
 	}
-	if m.region != nil {
-		fields = append(fields, s3.FieldRegion)
+	if m.name != nil {
+		fields = append(fields, sbompackage.FieldName)
 	}
-	if m.force_path_style != nil {
-		fields = append(fields, s3.FieldForcePathStyle)
+	if m.version != nil {
+		fields = append(fields, sbompackage.FieldVersion)
 	}
-	if m.kubernetes_secret != nil {
-		fields = append(fields, s3.FieldKubernetesSecret)
+	if m._type != nil {
+		fields = append(fields, sbompackage.FieldType)
 	}
-	if m.team != nil {
-		fields = append(fields, s3.FieldTeamID)
+	if m.purl != nil {
+		fields = append(fields, sbompackage.FieldPurl)
 	}
 	return fields
 }
@@ -11727,24 +15547,22 @@ func (m *S3Mutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *S3Mutation) Field(name string) (ent.Value, bool) {
+func (m *SBOMPackageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case s3.FieldCreatedAt:
+	case sbompackage.FieldCreatedAt:
 		return m.CreatedAt()
-	case s3.FieldUpdatedAt:
+	case sbompackage.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case s3.FieldName:
+	case sbompackage.FieldDeploymentID:
+		return m.DeploymentID()
+	case sbompackage.FieldName:
 		return m.Name()
-	case s3.FieldEndpoint:
-		return m.Endpoint()
-	case s3.FieldRegion:
-		return m.Region()
-	case s3.FieldForcePathStyle:
-		return m.ForcePathStyle()
-	case s3.FieldKubernetesSecret:
-		return m.KubernetesSecret()
-	case s3.FieldTeamID:
-		return m.TeamID()
+	case sbompackage.FieldVersion:
+		return m.Version()
+	case sbompackage.FieldType:
+		return m.GetType()
+	case sbompackage.FieldPurl:
+		return m.Purl()
 	}
 	return nil, false
 }
@@ -11752,266 +15570,226 @@ func (m *S3Mutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *S3Mutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SBOMPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case s3.FieldCreatedAt:
+	case sbompackage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case s3.FieldUpdatedAt:
+	case sbompackage.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case s3.FieldName:
+	case sbompackage.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case sbompackage.FieldName:
 		return m.OldName(ctx)
-	case s3.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case s3.FieldRegion:
-		return m.OldRegion(ctx)
-	case s3.FieldForcePathStyle:
-		return m.OldForcePathStyle(ctx)
-	case s3.FieldKubernetesSecret:
-		return m.OldKubernetesSecret(ctx)
-	case s3.FieldTeamID:
-		return m.OldTeamID(ctx)
+	case sbompackage.FieldVersion:
+		return m.OldVersion(ctx)
+	case sbompackage.FieldType:
+		return m.OldType(ctx)
+	case sbompackage.FieldPurl:
+		return m.OldPurl(ctx)
 	}
-	return nil, fmt.Errorf("unknown S3 field %s", name)
+	return nil, fmt.Errorf("unknown SBOMPackage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *S3Mutation) SetField(name string, value ent.Value) error {
+func (m *SBOMPackageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case s3.FieldCreatedAt:
+	case sbompackage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case s3.FieldUpdatedAt:
+	case sbompackage.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case s3.FieldName:
-		v, ok := value.(string)
+	case sbompackage.FieldDeploymentID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDeploymentID(v)
 		return nil
-	case s3.FieldEndpoint:
+	case sbompackage.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpoint(v)
+		m.SetName(v)
 		return nil
-	case s3.FieldRegion:
+	case sbompackage.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegion(v)
-		return nil
-	case s3.FieldForcePathStyle:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetForcePathStyle(v)
+		m.SetVersion(v)
 		return nil
-	case s3.FieldKubernetesSecret:
+	case sbompackage.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKubernetesSecret(v)
+		m.SetType(v)
 		return nil
-	case s3.FieldTeamID:
-		v, ok := value.(uuid.UUID)
+	case sbompackage.FieldPurl:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetPurl(v)
 		return nil
 	}
-	return fmt.Errorf("unknown S3 field %s", name)
+	return fmt.Errorf("unknown SBOMPackage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *S3Mutation) AddedFields() []string {
+func (m *SBOMPackageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *S3Mutation) AddedField(name string) (ent.Value, bool) {
+func (m *SBOMPackageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *S3Mutation) AddField(name string, value ent.Value) error {
+func (m *SBOMPackageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown S3 numeric field %s", name)
+	return fmt.Errorf("unknown SBOMPackage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *S3Mutation) ClearedFields() []string {
+func (m *SBOMPackageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *S3Mutation) FieldCleared(name string) bool {
+func (m *SBOMPackageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *S3Mutation) ClearField(name string) error {
-	return fmt.Errorf("unknown S3 nullable field %s", name)
+func (m *SBOMPackageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SBOMPackage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *S3Mutation) ResetField(name string) error {
+func (m *SBOMPackageMutation) ResetField(name string) error {
 	switch name {
-	case s3.FieldCreatedAt:
+	case sbompackage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case s3.FieldUpdatedAt:
+	case sbompackage.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case s3.FieldName:
-		m.ResetName()
-		return nil
-	case s3.FieldEndpoint:
-		m.ResetEndpoint()
+	case sbompackage.FieldDeploymentID:
+		m.ResetDeploymentID()
 		return nil
-	case s3.FieldRegion:
-		m.ResetRegion()
+	case sbompackage.FieldName:
+		m.ResetName()
 		return nil
-	case s3.FieldForcePathStyle:
-		m.ResetForcePathStyle()
+	case sbompackage.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case s3.FieldKubernetesSecret:
-		m.ResetKubernetesSecret()
+	case sbompackage.FieldType:
+		m.ResetType()
 		return nil
-	case s3.FieldTeamID:
-		m.ResetTeamID()
+	case sbompackage.FieldPurl:
+		m.ResetPurl()
 		return nil
 	}
-	return fmt.Errorf("unknown S3 field %s", name)
+	return fmt.Errorf("unknown SBOMPackage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *S3Mutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, s3.EdgeTeam)
-	}
-	if m.service_backup_source != nil {
-		edges = append(edges, s3.EdgeServiceBackupSource)
+func (m *SBOMPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deployment != nil {
+		edges = append(edges, sbompackage.EdgeDeployment)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *S3Mutation) AddedIDs(name string) []ent.Value {
+func (m *SBOMPackageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case s3.EdgeTeam:
-		if id := m.team; id != nil {
+	case sbompackage.EdgeDeployment:
+		if id := m.deployment; id != nil {
 			return []ent.Value{*id}
 		}
-	case s3.EdgeServiceBackupSource:
-		ids := make([]ent.Value, 0, len(m.service_backup_source))
-		for id := range m.service_backup_source {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *S3Mutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedservice_backup_source != nil {
-		edges = append(edges, s3.EdgeServiceBackupSource)
-	}
+func (m *SBOMPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *S3Mutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case s3.EdgeServiceBackupSource:
-		ids := make([]ent.Value, 0, len(m.removedservice_backup_source))
-		for id := range m.removedservice_backup_source {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SBOMPackageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *S3Mutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, s3.EdgeTeam)
-	}
-	if m.clearedservice_backup_source {
-		edges = append(edges, s3.EdgeServiceBackupSource)
+func (m *SBOMPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeployment {
+		edges = append(edges, sbompackage.EdgeDeployment)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *S3Mutation) EdgeCleared(name string) bool {
+func (m *SBOMPackageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case s3.EdgeTeam:
-		return m.clearedteam
-	case s3.EdgeServiceBackupSource:
-		return m.clearedservice_backup_source
+	case sbompackage.EdgeDeployment:
+		return m.cleareddeployment
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *S3Mutation) ClearEdge(name string) error {
+func (m *SBOMPackageMutation) ClearEdge(name string) error {
 	switch name {
-	case s3.EdgeTeam:
-		m.ClearTeam()
+	case sbompackage.EdgeDeployment:
+		m.ClearDeployment()
 		return nil
 	}
-	return fmt.Errorf("unknown S3 unique edge %s", name)
+	return fmt.Errorf("unknown SBOMPackage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *S3Mutation) ResetEdge(name string) error {
+func (m *SBOMPackageMutation) ResetEdge(name string) error {
 	switch name {
-	case s3.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case s3.EdgeServiceBackupSource:
-		m.ResetServiceBackupSource()
+	case sbompackage.EdgeDeployment:
+		m.ResetDeployment()
 		return nil
 	}
-	return fmt.Errorf("unknown S3 edge %s", name)
+	return fmt.Errorf("unknown SBOMPackage edge %s", name)
 }
 
 // ServiceMutation represents an operation that mutates the Service nodes in the graph.
@@ -12030,15 +15808,22 @@ type ServiceMutation struct {
 	appenddetected_ports       []schema.PortSpec
 	database                   *string
 	database_version           *string
+	git_url                    *string
+	git_deploy_public_key      *string
+	git_ssh_host_key           *string
+	git_webhook_secret         *string
 	git_repository_owner       *string
 	git_repository             *string
 	kubernetes_secret          *string
 	template_instance_id       *uuid.UUID
+	deploy_hook_token_hash     *string
 	clearedFields              map[string]struct{}
 	environment                *uuid.UUID
 	clearedenvironment         bool
 	github_installation        *int64
 	clearedgithub_installation bool
+	gitlab_connection          *uuid.UUID
+	clearedgitlab_connection   bool
 	service_config             *uuid.UUID
 	clearedservice_config      bool
 	deployments                map[uuid.UUID]struct{}
@@ -12639,6 +16424,251 @@ func (m *ServiceMutation) ResetGithubInstallationID() {
 	delete(m.clearedFields, service.FieldGithubInstallationID)
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (m *ServiceMutation) SetGitlabConnectionID(u uuid.UUID) {
+	m.gitlab_connection = &u
+}
+
+// GitlabConnectionID returns the value of the "gitlab_connection_id" field in the mutation.
+func (m *ServiceMutation) GitlabConnectionID() (r uuid.UUID, exists bool) {
+	v := m.gitlab_connection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitlabConnectionID returns the old "gitlab_connection_id" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldGitlabConnectionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitlabConnectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitlabConnectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitlabConnectionID: %w", err)
+	}
+	return oldValue.GitlabConnectionID, nil
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (m *ServiceMutation) ClearGitlabConnectionID() {
+	m.gitlab_connection = nil
+	m.clearedFields[service.FieldGitlabConnectionID] = struct{}{}
+}
+
+// GitlabConnectionIDCleared returns if the "gitlab_connection_id" field was cleared in this mutation.
+func (m *ServiceMutation) GitlabConnectionIDCleared() bool {
+	_, ok := m.clearedFields[service.FieldGitlabConnectionID]
+	return ok
+}
+
+// ResetGitlabConnectionID resets all changes to the "gitlab_connection_id" field.
+func (m *ServiceMutation) ResetGitlabConnectionID() {
+	m.gitlab_connection = nil
+	delete(m.clearedFields, service.FieldGitlabConnectionID)
+}
+
+// SetGitURL sets the "git_url" field.
+func (m *ServiceMutation) SetGitURL(s string) {
+	m.git_url = &s
+}
+
+// GitURL returns the value of the "git_url" field in the mutation.
+func (m *ServiceMutation) GitURL() (r string, exists bool) {
+	v := m.git_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitURL returns the old "git_url" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldGitURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitURL: %w", err)
+	}
+	return oldValue.GitURL, nil
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (m *ServiceMutation) ClearGitURL() {
+	m.git_url = nil
+	m.clearedFields[service.FieldGitURL] = struct{}{}
+}
+
+// GitURLCleared returns if the "git_url" field was cleared in this mutation.
+func (m *ServiceMutation) GitURLCleared() bool {
+	_, ok := m.clearedFields[service.FieldGitURL]
+	return ok
+}
+
+// ResetGitURL resets all changes to the "git_url" field.
+func (m *ServiceMutation) ResetGitURL() {
+	m.git_url = nil
+	delete(m.clearedFields, service.FieldGitURL)
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (m *ServiceMutation) SetGitDeployPublicKey(s string) {
+	m.git_deploy_public_key = &s
+}
+
+// GitDeployPublicKey returns the value of the "git_deploy_public_key" field in the mutation.
+func (m *ServiceMutation) GitDeployPublicKey() (r string, exists bool) {
+	v := m.git_deploy_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitDeployPublicKey returns the old "git_deploy_public_key" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldGitDeployPublicKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitDeployPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitDeployPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitDeployPublicKey: %w", err)
+	}
+	return oldValue.GitDeployPublicKey, nil
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (m *ServiceMutation) ClearGitDeployPublicKey() {
+	m.git_deploy_public_key = nil
+	m.clearedFields[service.FieldGitDeployPublicKey] = struct{}{}
+}
+
+// GitDeployPublicKeyCleared returns if the "git_deploy_public_key" field was cleared in this mutation.
+func (m *ServiceMutation) GitDeployPublicKeyCleared() bool {
+	_, ok := m.clearedFields[service.FieldGitDeployPublicKey]
+	return ok
+}
+
+// ResetGitDeployPublicKey resets all changes to the "git_deploy_public_key" field.
+func (m *ServiceMutation) ResetGitDeployPublicKey() {
+	m.git_deploy_public_key = nil
+	delete(m.clearedFields, service.FieldGitDeployPublicKey)
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (m *ServiceMutation) SetGitSSHHostKey(s string) {
+	m.git_ssh_host_key = &s
+}
+
+// GitSSHHostKey returns the value of the "git_ssh_host_key" field in the mutation.
+func (m *ServiceMutation) GitSSHHostKey() (r string, exists bool) {
+	v := m.git_ssh_host_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitSSHHostKey returns the old "git_ssh_host_key" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldGitSSHHostKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitSSHHostKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitSSHHostKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitSSHHostKey: %w", err)
+	}
+	return oldValue.GitSSHHostKey, nil
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (m *ServiceMutation) ClearGitSSHHostKey() {
+	m.git_ssh_host_key = nil
+	m.clearedFields[service.FieldGitSSHHostKey] = struct{}{}
+}
+
+// GitSSHHostKeyCleared returns if the "git_ssh_host_key" field was cleared in this mutation.
+func (m *ServiceMutation) GitSSHHostKeyCleared() bool {
+	_, ok := m.clearedFields[service.FieldGitSSHHostKey]
+	return ok
+}
+
+// ResetGitSSHHostKey resets all changes to the "git_ssh_host_key" field.
+func (m *ServiceMutation) ResetGitSSHHostKey() {
+	m.git_ssh_host_key = nil
+	delete(m.clearedFields, service.FieldGitSSHHostKey)
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (m *ServiceMutation) SetGitWebhookSecret(s string) {
+	m.git_webhook_secret = &s
+}
+
+// GitWebhookSecret returns the value of the "git_webhook_secret" field in the mutation.
+func (m *ServiceMutation) GitWebhookSecret() (r string, exists bool) {
+	v := m.git_webhook_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGitWebhookSecret returns the old "git_webhook_secret" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldGitWebhookSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGitWebhookSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGitWebhookSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGitWebhookSecret: %w", err)
+	}
+	return oldValue.GitWebhookSecret, nil
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (m *ServiceMutation) ClearGitWebhookSecret() {
+	m.git_webhook_secret = nil
+	m.clearedFields[service.FieldGitWebhookSecret] = struct{}{}
+}
+
+// GitWebhookSecretCleared returns if the "git_webhook_secret" field was cleared in this mutation.
+func (m *ServiceMutation) GitWebhookSecretCleared() bool {
+	_, ok := m.clearedFields[service.FieldGitWebhookSecret]
+	return ok
+}
+
+// ResetGitWebhookSecret resets all changes to the "git_webhook_secret" field.
+func (m *ServiceMutation) ResetGitWebhookSecret() {
+	m.git_webhook_secret = nil
+	delete(m.clearedFields, service.FieldGitWebhookSecret)
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (m *ServiceMutation) SetGitRepositoryOwner(s string) {
 	m.git_repository_owner = &s
@@ -12969,6 +16999,55 @@ func (m *ServiceMutation) ResetServiceGroupID() {
 	delete(m.clearedFields, service.FieldServiceGroupID)
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (m *ServiceMutation) SetDeployHookTokenHash(s string) {
+	m.deploy_hook_token_hash = &s
+}
+
+// DeployHookTokenHash returns the value of the "deploy_hook_token_hash" field in the mutation.
+func (m *ServiceMutation) DeployHookTokenHash() (r string, exists bool) {
+	v := m.deploy_hook_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeployHookTokenHash returns the old "deploy_hook_token_hash" field's value of the Service entity.
+// If the Service object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceMutation) OldDeployHookTokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeployHookTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeployHookTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeployHookTokenHash: %w", err)
+	}
+	return oldValue.DeployHookTokenHash, nil
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (m *ServiceMutation) ClearDeployHookTokenHash() {
+	m.deploy_hook_token_hash = nil
+	m.clearedFields[service.FieldDeployHookTokenHash] = struct{}{}
+}
+
+// DeployHookTokenHashCleared returns if the "deploy_hook_token_hash" field was cleared in this mutation.
+func (m *ServiceMutation) DeployHookTokenHashCleared() bool {
+	_, ok := m.clearedFields[service.FieldDeployHookTokenHash]
+	return ok
+}
+
+// ResetDeployHookTokenHash resets all changes to the "deploy_hook_token_hash" field.
+func (m *ServiceMutation) ResetDeployHookTokenHash() {
+	m.deploy_hook_token_hash = nil
+	delete(m.clearedFields, service.FieldDeployHookTokenHash)
+}
+
 // ClearEnvironment clears the "environment" edge to the Environment entity.
 func (m *ServiceMutation) ClearEnvironment() {
 	m.clearedenvironment = true
@@ -13023,6 +17102,33 @@ func (m *ServiceMutation) ResetGithubInstallation() {
 	m.clearedgithub_installation = false
 }
 
+// ClearGitlabConnection clears the "gitlab_connection" edge to the GitlabConnection entity.
+func (m *ServiceMutation) ClearGitlabConnection() {
+	m.clearedgitlab_connection = true
+	m.clearedFields[service.FieldGitlabConnectionID] = struct{}{}
+}
+
+// GitlabConnectionCleared reports if the "gitlab_connection" edge to the GitlabConnection entity was cleared.
+func (m *ServiceMutation) GitlabConnectionCleared() bool {
+	return m.GitlabConnectionIDCleared() || m.clearedgitlab_connection
+}
+
+// GitlabConnectionIDs returns the "gitlab_connection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GitlabConnectionID instead. It exists only for internal usage by the builders.
+func (m *ServiceMutation) GitlabConnectionIDs() (ids []uuid.UUID) {
+	if id := m.gitlab_connection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGitlabConnection resets all changes to the "gitlab_connection" edge.
+func (m *ServiceMutation) ResetGitlabConnection() {
+	m.gitlab_connection = nil
+	m.clearedgitlab_connection = false
+}
+
 // SetServiceConfigID sets the "service_config" edge to the ServiceConfig entity by id.
 func (m *ServiceMutation) SetServiceConfigID(id uuid.UUID) {
 	m.service_config = &id
@@ -13285,7 +17391,7 @@ func (m *ServiceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ServiceMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+	fields := make([]string, 0, 24)
 	if m.created_at != nil {
 		fields = append(fields, service.FieldCreatedAt)
 	}
@@ -13319,6 +17425,21 @@ func (m *ServiceMutation) Fields() []string {
 	if m.github_installation != nil {
 		fields = append(fields, service.FieldGithubInstallationID)
 	}
+	if m.gitlab_connection != nil {
+		fields = append(fields, service.FieldGitlabConnectionID)
+	}
+	if m.git_url != nil {
+		fields = append(fields, service.FieldGitURL)
+	}
+	if m.git_deploy_public_key != nil {
+		fields = append(fields, service.FieldGitDeployPublicKey)
+	}
+	if m.git_ssh_host_key != nil {
+		fields = append(fields, service.FieldGitSSHHostKey)
+	}
+	if m.git_webhook_secret != nil {
+		fields = append(fields, service.FieldGitWebhookSecret)
+	}
 	if m.git_repository_owner != nil {
 		fields = append(fields, service.FieldGitRepositoryOwner)
 	}
@@ -13340,6 +17461,9 @@ func (m *ServiceMutation) Fields() []string {
 	if m.service_group != nil {
 		fields = append(fields, service.FieldServiceGroupID)
 	}
+	if m.deploy_hook_token_hash != nil {
+		fields = append(fields, service.FieldDeployHookTokenHash)
+	}
 	return fields
 }
 
@@ -13370,6 +17494,16 @@ func (m *ServiceMutation) Field(name string) (ent.Value, bool) {
 		return m.DatabaseVersion()
 	case service.FieldGithubInstallationID:
 		return m.GithubInstallationID()
+	case service.FieldGitlabConnectionID:
+		return m.GitlabConnectionID()
+	case service.FieldGitURL:
+		return m.GitURL()
+	case service.FieldGitDeployPublicKey:
+		return m.GitDeployPublicKey()
+	case service.FieldGitSSHHostKey:
+		return m.GitSSHHostKey()
+	case service.FieldGitWebhookSecret:
+		return m.GitWebhookSecret()
 	case service.FieldGitRepositoryOwner:
 		return m.GitRepositoryOwner()
 	case service.FieldGitRepository:
@@ -13384,6 +17518,8 @@ func (m *ServiceMutation) Field(name string) (ent.Value, bool) {
 		return m.TemplateInstanceID()
 	case service.FieldServiceGroupID:
 		return m.ServiceGroupID()
+	case service.FieldDeployHookTokenHash:
+		return m.DeployHookTokenHash()
 	}
 	return nil, false
 }
@@ -13415,6 +17551,16 @@ func (m *ServiceMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldDatabaseVersion(ctx)
 	case service.FieldGithubInstallationID:
 		return m.OldGithubInstallationID(ctx)
+	case service.FieldGitlabConnectionID:
+		return m.OldGitlabConnectionID(ctx)
+	case service.FieldGitURL:
+		return m.OldGitURL(ctx)
+	case service.FieldGitDeployPublicKey:
+		return m.OldGitDeployPublicKey(ctx)
+	case service.FieldGitSSHHostKey:
+		return m.OldGitSSHHostKey(ctx)
+	case service.FieldGitWebhookSecret:
+		return m.OldGitWebhookSecret(ctx)
 	case service.FieldGitRepositoryOwner:
 		return m.OldGitRepositoryOwner(ctx)
 	case service.FieldGitRepository:
@@ -13429,6 +17575,8 @@ func (m *ServiceMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldTemplateInstanceID(ctx)
 	case service.FieldServiceGroupID:
 		return m.OldServiceGroupID(ctx)
+	case service.FieldDeployHookTokenHash:
+		return m.OldDeployHookTokenHash(ctx)
 	}
 	return nil, fmt.Errorf("unknown Service field %s", name)
 }
@@ -13515,6 +17663,41 @@ func (m *ServiceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGithubInstallationID(v)
 		return nil
+	case service.FieldGitlabConnectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitlabConnectionID(v)
+		return nil
+	case service.FieldGitURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitURL(v)
+		return nil
+	case service.FieldGitDeployPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitDeployPublicKey(v)
+		return nil
+	case service.FieldGitSSHHostKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitSSHHostKey(v)
+		return nil
+	case service.FieldGitWebhookSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGitWebhookSecret(v)
+		return nil
 	case service.FieldGitRepositoryOwner:
 		v, ok := value.(string)
 		if !ok {
@@ -13564,6 +17747,13 @@ func (m *ServiceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetServiceGroupID(v)
 		return nil
+	case service.FieldDeployHookTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployHookTokenHash(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Service field %s", name)
 }
@@ -13612,6 +17802,21 @@ func (m *ServiceMutation) ClearedFields() []string {
 	if m.FieldCleared(service.FieldGithubInstallationID) {
 		fields = append(fields, service.FieldGithubInstallationID)
 	}
+	if m.FieldCleared(service.FieldGitlabConnectionID) {
+		fields = append(fields, service.FieldGitlabConnectionID)
+	}
+	if m.FieldCleared(service.FieldGitURL) {
+		fields = append(fields, service.FieldGitURL)
+	}
+	if m.FieldCleared(service.FieldGitDeployPublicKey) {
+		fields = append(fields, service.FieldGitDeployPublicKey)
+	}
+	if m.FieldCleared(service.FieldGitSSHHostKey) {
+		fields = append(fields, service.FieldGitSSHHostKey)
+	}
+	if m.FieldCleared(service.FieldGitWebhookSecret) {
+		fields = append(fields, service.FieldGitWebhookSecret)
+	}
 	if m.FieldCleared(service.FieldGitRepositoryOwner) {
 		fields = append(fields, service.FieldGitRepositoryOwner)
 	}
@@ -13630,6 +17835,9 @@ func (m *ServiceMutation) ClearedFields() []string {
 	if m.FieldCleared(service.FieldServiceGroupID) {
 		fields = append(fields, service.FieldServiceGroupID)
 	}
+	if m.FieldCleared(service.FieldDeployHookTokenHash) {
+		fields = append(fields, service.FieldDeployHookTokenHash)
+	}
 	return fields
 }
 
@@ -13659,6 +17867,21 @@ func (m *ServiceMutation) ClearField(name string) error {
 	case service.FieldGithubInstallationID:
 		m.ClearGithubInstallationID()
 		return nil
+	case service.FieldGitlabConnectionID:
+		m.ClearGitlabConnectionID()
+		return nil
+	case service.FieldGitURL:
+		m.ClearGitURL()
+		return nil
+	case service.FieldGitDeployPublicKey:
+		m.ClearGitDeployPublicKey()
+		return nil
+	case service.FieldGitSSHHostKey:
+		m.ClearGitSSHHostKey()
+		return nil
+	case service.FieldGitWebhookSecret:
+		m.ClearGitWebhookSecret()
+		return nil
 	case service.FieldGitRepositoryOwner:
 		m.ClearGitRepositoryOwner()
 		return nil
@@ -13677,6 +17900,9 @@ func (m *ServiceMutation) ClearField(name string) error {
 	case service.FieldServiceGroupID:
 		m.ClearServiceGroupID()
 		return nil
+	case service.FieldDeployHookTokenHash:
+		m.ClearDeployHookTokenHash()
+		return nil
 	}
 	return fmt.Errorf("unknown Service nullable field %s", name)
 }
@@ -13718,6 +17944,21 @@ func (m *ServiceMutation) ResetField(name string) error {
 	case service.FieldGithubInstallationID:
 		m.ResetGithubInstallationID()
 		return nil
+	case service.FieldGitlabConnectionID:
+		m.ResetGitlabConnectionID()
+		return nil
+	case service.FieldGitURL:
+		m.ResetGitURL()
+		return nil
+	case service.FieldGitDeployPublicKey:
+		m.ResetGitDeployPublicKey()
+		return nil
+	case service.FieldGitSSHHostKey:
+		m.ResetGitSSHHostKey()
+		return nil
+	case service.FieldGitWebhookSecret:
+		m.ResetGitWebhookSecret()
+		return nil
 	case service.FieldGitRepositoryOwner:
 		m.ResetGitRepositoryOwner()
 		return nil
@@ -13739,19 +17980,25 @@ func (m *ServiceMutation) ResetField(name string) error {
 	case service.FieldServiceGroupID:
 		m.ResetServiceGroupID()
 		return nil
+	case service.FieldDeployHookTokenHash:
+		m.ResetDeployHookTokenHash()
+		return nil
 	}
 	return fmt.Errorf("unknown Service field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ServiceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 9)
 	if m.environment != nil {
 		edges = append(edges, service.EdgeEnvironment)
 	}
 	if m.github_installation != nil {
 		edges = append(edges, service.EdgeGithubInstallation)
 	}
+	if m.gitlab_connection != nil {
+		edges = append(edges, service.EdgeGitlabConnection)
+	}
 	if m.service_config != nil {
 		edges = append(edges, service.EdgeServiceConfig)
 	}
@@ -13785,6 +18032,10 @@ func (m *ServiceMutation) AddedIDs(name string) []ent.Value {
 		if id := m.github_installation; id != nil {
 			return []ent.Value{*id}
 		}
+	case service.EdgeGitlabConnection:
+		if id := m.gitlab_connection; id != nil {
+			return []ent.Value{*id}
+		}
 	case service.EdgeServiceConfig:
 		if id := m.service_config; id != nil {
 			return []ent.Value{*id}
@@ -13819,7 +18070,7 @@ func (m *ServiceMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ServiceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 9)
 	if m.removeddeployments != nil {
 		edges = append(edges, service.EdgeDeployments)
 	}
@@ -13851,13 +18102,16 @@ func (m *ServiceMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ServiceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 9)
 	if m.clearedenvironment {
 		edges = append(edges, service.EdgeEnvironment)
 	}
 	if m.clearedgithub_installation {
 		edges = append(edges, service.EdgeGithubInstallation)
 	}
+	if m.clearedgitlab_connection {
+		edges = append(edges, service.EdgeGitlabConnection)
+	}
 	if m.clearedservice_config {
 		edges = append(edges, service.EdgeServiceConfig)
 	}
@@ -13887,6 +18141,8 @@ func (m *ServiceMutation) EdgeCleared(name string) bool {
 		return m.clearedenvironment
 	case service.EdgeGithubInstallation:
 		return m.clearedgithub_installation
+	case service.EdgeGitlabConnection:
+		return m.clearedgitlab_connection
 	case service.EdgeServiceConfig:
 		return m.clearedservice_config
 	case service.EdgeDeployments:
@@ -13913,6 +18169,9 @@ func (m *ServiceMutation) ClearEdge(name string) error {
 	case service.EdgeGithubInstallation:
 		m.ClearGithubInstallation()
 		return nil
+	case service.EdgeGitlabConnection:
+		m.ClearGitlabConnection()
+		return nil
 	case service.EdgeServiceConfig:
 		m.ClearServiceConfig()
 		return nil
@@ -13939,6 +18198,9 @@ func (m *ServiceMutation) ResetEdge(name string) error {
 	case service.EdgeGithubInstallation:
 		m.ResetGithubInstallation()
 		return nil
+	case service.EdgeGitlabConnection:
+		m.ResetGitlabConnection()
+		return nil
 	case service.EdgeServiceConfig:
 		m.ResetServiceConfig()
 		return nil
@@ -13973,10 +18235,23 @@ type ServiceConfigMutation struct {
 	icon                             *string
 	docker_builder_dockerfile_path   *string
 	docker_builder_build_context     *string
+	docker_builder_target            *string
+	docker_builder_build_args        *map[string]string
+	docker_builder_build_contexts    *map[string]string
+	buildpacks_builder_image         *string
+	platforms                        *[]string
+	appendplatforms                  []string
 	railpack_provider                *enum.Provider
 	railpack_framework               *enum.Framework
 	git_branch                       *string
 	git_tag                          *string
+	watch_paths                      *[]string
+	appendwatch_paths                []string
+	skip_deploy_marker               *string
+	ignored_commit_authors           *[]string
+	appendignored_commit_authors     []string
+	pr_preview_variables             *[]string
+	appendpr_preview_variables       []string
 	hosts                            *[]schema.HostSpec
 	appendhosts                      []schema.HostSpec
 	ports                            *[]schema.PortSpec
@@ -13984,17 +18259,26 @@ type ServiceConfigMutation struct {
 	replicas                         *int32
 	addreplicas                      *int32
 	auto_deploy                      *bool
+	auto_rollback                    *bool
+	pr_previews                      *bool
 	railpack_builder_install_command *string
 	railpack_builder_build_command   *string
 	run_command                      *string
+	pre_deploy_command               *string
+	rollout_strategy                 *schema.RolloutStrategy
+	canary_weight                    *int
+	addcanary_weight                 *int
 	is_public                        *bool
 	image                            *string
+	image_auto_update                *bool
+	image_digest                     *string
 	definition_version               *string
 	database_config                  **schema.DatabaseConfig
 	s3_backup_bucket                 *string
 	backup_schedule                  *string
 	backup_retention_count           *int
 	addbackup_retention_count        *int
+	upload_s3_bucket                 *string
 	volumes                          *[]schema.ServiceVolume
 	appendvolumes                    []schema.ServiceVolume
 	security_context                 **schema.SecurityContext
@@ -14011,6 +18295,8 @@ type ServiceConfigMutation struct {
 	clearedservice                   bool
 	s3_backup_sources                *uuid.UUID
 	cleareds3_backup_sources         bool
+	s3_upload_sources                *uuid.UUID
+	cleareds3_upload_sources         bool
 	done                             bool
 	oldValue                         func(context.Context) (*ServiceConfig, error)
 	predicates                       []predicate.ServiceConfig
@@ -14187,215 +18473,476 @@ func (m *ServiceConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ServiceConfigMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ServiceConfigMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetServiceID sets the "service_id" field.
+func (m *ServiceConfigMutation) SetServiceID(u uuid.UUID) {
+	m.service = &u
+}
+
+// ServiceID returns the value of the "service_id" field in the mutation.
+func (m *ServiceConfigMutation) ServiceID() (r uuid.UUID, exists bool) {
+	v := m.service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceID returns the old "service_id" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldServiceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
+	}
+	return oldValue.ServiceID, nil
+}
+
+// ResetServiceID resets all changes to the "service_id" field.
+func (m *ServiceConfigMutation) ResetServiceID() {
+	m.service = nil
+}
+
+// SetBuilder sets the "builder" field.
+func (m *ServiceConfigMutation) SetBuilder(sb schema.ServiceBuilder) {
+	m.builder = &sb
+}
+
+// Builder returns the value of the "builder" field in the mutation.
+func (m *ServiceConfigMutation) Builder() (r schema.ServiceBuilder, exists bool) {
+	v := m.builder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuilder returns the old "builder" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldBuilder(ctx context.Context) (v schema.ServiceBuilder, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuilder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuilder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuilder: %w", err)
+	}
+	return oldValue.Builder, nil
+}
+
+// ResetBuilder resets all changes to the "builder" field.
+func (m *ServiceConfigMutation) ResetBuilder() {
+	m.builder = nil
+}
+
+// SetIcon sets the "icon" field.
+func (m *ServiceConfigMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *ServiceConfigMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *ServiceConfigMutation) ResetIcon() {
+	m.icon = nil
+}
+
+// SetDockerBuilderDockerfilePath sets the "docker_builder_dockerfile_path" field.
+func (m *ServiceConfigMutation) SetDockerBuilderDockerfilePath(s string) {
+	m.docker_builder_dockerfile_path = &s
+}
+
+// DockerBuilderDockerfilePath returns the value of the "docker_builder_dockerfile_path" field in the mutation.
+func (m *ServiceConfigMutation) DockerBuilderDockerfilePath() (r string, exists bool) {
+	v := m.docker_builder_dockerfile_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDockerBuilderDockerfilePath returns the old "docker_builder_dockerfile_path" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldDockerBuilderDockerfilePath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerBuilderDockerfilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerBuilderDockerfilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerBuilderDockerfilePath: %w", err)
+	}
+	return oldValue.DockerBuilderDockerfilePath, nil
+}
+
+// ClearDockerBuilderDockerfilePath clears the value of the "docker_builder_dockerfile_path" field.
+func (m *ServiceConfigMutation) ClearDockerBuilderDockerfilePath() {
+	m.docker_builder_dockerfile_path = nil
+	m.clearedFields[serviceconfig.FieldDockerBuilderDockerfilePath] = struct{}{}
+}
+
+// DockerBuilderDockerfilePathCleared returns if the "docker_builder_dockerfile_path" field was cleared in this mutation.
+func (m *ServiceConfigMutation) DockerBuilderDockerfilePathCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderDockerfilePath]
+	return ok
+}
+
+// ResetDockerBuilderDockerfilePath resets all changes to the "docker_builder_dockerfile_path" field.
+func (m *ServiceConfigMutation) ResetDockerBuilderDockerfilePath() {
+	m.docker_builder_dockerfile_path = nil
+	delete(m.clearedFields, serviceconfig.FieldDockerBuilderDockerfilePath)
+}
+
+// SetDockerBuilderBuildContext sets the "docker_builder_build_context" field.
+func (m *ServiceConfigMutation) SetDockerBuilderBuildContext(s string) {
+	m.docker_builder_build_context = &s
+}
+
+// DockerBuilderBuildContext returns the value of the "docker_builder_build_context" field in the mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildContext() (r string, exists bool) {
+	v := m.docker_builder_build_context
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDockerBuilderBuildContext returns the old "docker_builder_build_context" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldDockerBuilderBuildContext(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDockerBuilderBuildContext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDockerBuilderBuildContext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildContext: %w", err)
+	}
+	return oldValue.DockerBuilderBuildContext, nil
+}
+
+// ClearDockerBuilderBuildContext clears the value of the "docker_builder_build_context" field.
+func (m *ServiceConfigMutation) ClearDockerBuilderBuildContext() {
+	m.docker_builder_build_context = nil
+	m.clearedFields[serviceconfig.FieldDockerBuilderBuildContext] = struct{}{}
+}
+
+// DockerBuilderBuildContextCleared returns if the "docker_builder_build_context" field was cleared in this mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildContextCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderBuildContext]
+	return ok
 }
 
-// SetServiceID sets the "service_id" field.
-func (m *ServiceConfigMutation) SetServiceID(u uuid.UUID) {
-	m.service = &u
+// ResetDockerBuilderBuildContext resets all changes to the "docker_builder_build_context" field.
+func (m *ServiceConfigMutation) ResetDockerBuilderBuildContext() {
+	m.docker_builder_build_context = nil
+	delete(m.clearedFields, serviceconfig.FieldDockerBuilderBuildContext)
 }
 
-// ServiceID returns the value of the "service_id" field in the mutation.
-func (m *ServiceConfigMutation) ServiceID() (r uuid.UUID, exists bool) {
-	v := m.service
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (m *ServiceConfigMutation) SetDockerBuilderTarget(s string) {
+	m.docker_builder_target = &s
+}
+
+// DockerBuilderTarget returns the value of the "docker_builder_target" field in the mutation.
+func (m *ServiceConfigMutation) DockerBuilderTarget() (r string, exists bool) {
+	v := m.docker_builder_target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServiceID returns the old "service_id" field's value of the ServiceConfig entity.
+// OldDockerBuilderTarget returns the old "docker_builder_target" field's value of the ServiceConfig entity.
 // If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceConfigMutation) OldServiceID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ServiceConfigMutation) OldDockerBuilderTarget(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServiceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDockerBuilderTarget is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServiceID requires an ID field in the mutation")
+		return v, errors.New("OldDockerBuilderTarget requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServiceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDockerBuilderTarget: %w", err)
 	}
-	return oldValue.ServiceID, nil
+	return oldValue.DockerBuilderTarget, nil
 }
 
-// ResetServiceID resets all changes to the "service_id" field.
-func (m *ServiceConfigMutation) ResetServiceID() {
-	m.service = nil
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (m *ServiceConfigMutation) ClearDockerBuilderTarget() {
+	m.docker_builder_target = nil
+	m.clearedFields[serviceconfig.FieldDockerBuilderTarget] = struct{}{}
 }
 
-// SetBuilder sets the "builder" field.
-func (m *ServiceConfigMutation) SetBuilder(sb schema.ServiceBuilder) {
-	m.builder = &sb
+// DockerBuilderTargetCleared returns if the "docker_builder_target" field was cleared in this mutation.
+func (m *ServiceConfigMutation) DockerBuilderTargetCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderTarget]
+	return ok
 }
 
-// Builder returns the value of the "builder" field in the mutation.
-func (m *ServiceConfigMutation) Builder() (r schema.ServiceBuilder, exists bool) {
-	v := m.builder
+// ResetDockerBuilderTarget resets all changes to the "docker_builder_target" field.
+func (m *ServiceConfigMutation) ResetDockerBuilderTarget() {
+	m.docker_builder_target = nil
+	delete(m.clearedFields, serviceconfig.FieldDockerBuilderTarget)
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (m *ServiceConfigMutation) SetDockerBuilderBuildArgs(value map[string]string) {
+	m.docker_builder_build_args = &value
+}
+
+// DockerBuilderBuildArgs returns the value of the "docker_builder_build_args" field in the mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildArgs() (r map[string]string, exists bool) {
+	v := m.docker_builder_build_args
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuilder returns the old "builder" field's value of the ServiceConfig entity.
+// OldDockerBuilderBuildArgs returns the old "docker_builder_build_args" field's value of the ServiceConfig entity.
 // If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceConfigMutation) OldBuilder(ctx context.Context) (v schema.ServiceBuilder, err error) {
+func (m *ServiceConfigMutation) OldDockerBuilderBuildArgs(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuilder is only allowed on UpdateOne operations")
+		return v, errors.New("OldDockerBuilderBuildArgs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuilder requires an ID field in the mutation")
+		return v, errors.New("OldDockerBuilderBuildArgs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuilder: %w", err)
+		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildArgs: %w", err)
 	}
-	return oldValue.Builder, nil
+	return oldValue.DockerBuilderBuildArgs, nil
 }
 
-// ResetBuilder resets all changes to the "builder" field.
-func (m *ServiceConfigMutation) ResetBuilder() {
-	m.builder = nil
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (m *ServiceConfigMutation) ClearDockerBuilderBuildArgs() {
+	m.docker_builder_build_args = nil
+	m.clearedFields[serviceconfig.FieldDockerBuilderBuildArgs] = struct{}{}
 }
 
-// SetIcon sets the "icon" field.
-func (m *ServiceConfigMutation) SetIcon(s string) {
-	m.icon = &s
+// DockerBuilderBuildArgsCleared returns if the "docker_builder_build_args" field was cleared in this mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildArgsCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderBuildArgs]
+	return ok
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *ServiceConfigMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// ResetDockerBuilderBuildArgs resets all changes to the "docker_builder_build_args" field.
+func (m *ServiceConfigMutation) ResetDockerBuilderBuildArgs() {
+	m.docker_builder_build_args = nil
+	delete(m.clearedFields, serviceconfig.FieldDockerBuilderBuildArgs)
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (m *ServiceConfigMutation) SetDockerBuilderBuildContexts(value map[string]string) {
+	m.docker_builder_build_contexts = &value
+}
+
+// DockerBuilderBuildContexts returns the value of the "docker_builder_build_contexts" field in the mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildContexts() (r map[string]string, exists bool) {
+	v := m.docker_builder_build_contexts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the ServiceConfig entity.
+// OldDockerBuilderBuildContexts returns the old "docker_builder_build_contexts" field's value of the ServiceConfig entity.
 // If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceConfigMutation) OldIcon(ctx context.Context) (v string, err error) {
+func (m *ServiceConfigMutation) OldDockerBuilderBuildContexts(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldDockerBuilderBuildContexts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
+		return v, errors.New("OldDockerBuilderBuildContexts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildContexts: %w", err)
 	}
-	return oldValue.Icon, nil
+	return oldValue.DockerBuilderBuildContexts, nil
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *ServiceConfigMutation) ResetIcon() {
-	m.icon = nil
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (m *ServiceConfigMutation) ClearDockerBuilderBuildContexts() {
+	m.docker_builder_build_contexts = nil
+	m.clearedFields[serviceconfig.FieldDockerBuilderBuildContexts] = struct{}{}
 }
 
-// SetDockerBuilderDockerfilePath sets the "docker_builder_dockerfile_path" field.
-func (m *ServiceConfigMutation) SetDockerBuilderDockerfilePath(s string) {
-	m.docker_builder_dockerfile_path = &s
+// DockerBuilderBuildContextsCleared returns if the "docker_builder_build_contexts" field was cleared in this mutation.
+func (m *ServiceConfigMutation) DockerBuilderBuildContextsCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderBuildContexts]
+	return ok
 }
 
-// DockerBuilderDockerfilePath returns the value of the "docker_builder_dockerfile_path" field in the mutation.
-func (m *ServiceConfigMutation) DockerBuilderDockerfilePath() (r string, exists bool) {
-	v := m.docker_builder_dockerfile_path
+// ResetDockerBuilderBuildContexts resets all changes to the "docker_builder_build_contexts" field.
+func (m *ServiceConfigMutation) ResetDockerBuilderBuildContexts() {
+	m.docker_builder_build_contexts = nil
+	delete(m.clearedFields, serviceconfig.FieldDockerBuilderBuildContexts)
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (m *ServiceConfigMutation) SetBuildpacksBuilderImage(s string) {
+	m.buildpacks_builder_image = &s
+}
+
+// BuildpacksBuilderImage returns the value of the "buildpacks_builder_image" field in the mutation.
+func (m *ServiceConfigMutation) BuildpacksBuilderImage() (r string, exists bool) {
+	v := m.buildpacks_builder_image
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDockerBuilderDockerfilePath returns the old "docker_builder_dockerfile_path" field's value of the ServiceConfig entity.
+// OldBuildpacksBuilderImage returns the old "buildpacks_builder_image" field's value of the ServiceConfig entity.
 // If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceConfigMutation) OldDockerBuilderDockerfilePath(ctx context.Context) (v *string, err error) {
+func (m *ServiceConfigMutation) OldBuildpacksBuilderImage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDockerBuilderDockerfilePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldBuildpacksBuilderImage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDockerBuilderDockerfilePath requires an ID field in the mutation")
+		return v, errors.New("OldBuildpacksBuilderImage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDockerBuilderDockerfilePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldBuildpacksBuilderImage: %w", err)
 	}
-	return oldValue.DockerBuilderDockerfilePath, nil
+	return oldValue.BuildpacksBuilderImage, nil
 }
 
-// ClearDockerBuilderDockerfilePath clears the value of the "docker_builder_dockerfile_path" field.
-func (m *ServiceConfigMutation) ClearDockerBuilderDockerfilePath() {
-	m.docker_builder_dockerfile_path = nil
-	m.clearedFields[serviceconfig.FieldDockerBuilderDockerfilePath] = struct{}{}
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (m *ServiceConfigMutation) ClearBuildpacksBuilderImage() {
+	m.buildpacks_builder_image = nil
+	m.clearedFields[serviceconfig.FieldBuildpacksBuilderImage] = struct{}{}
 }
 
-// DockerBuilderDockerfilePathCleared returns if the "docker_builder_dockerfile_path" field was cleared in this mutation.
-func (m *ServiceConfigMutation) DockerBuilderDockerfilePathCleared() bool {
-	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderDockerfilePath]
+// BuildpacksBuilderImageCleared returns if the "buildpacks_builder_image" field was cleared in this mutation.
+func (m *ServiceConfigMutation) BuildpacksBuilderImageCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldBuildpacksBuilderImage]
 	return ok
 }
 
-// ResetDockerBuilderDockerfilePath resets all changes to the "docker_builder_dockerfile_path" field.
-func (m *ServiceConfigMutation) ResetDockerBuilderDockerfilePath() {
-	m.docker_builder_dockerfile_path = nil
-	delete(m.clearedFields, serviceconfig.FieldDockerBuilderDockerfilePath)
+// ResetBuildpacksBuilderImage resets all changes to the "buildpacks_builder_image" field.
+func (m *ServiceConfigMutation) ResetBuildpacksBuilderImage() {
+	m.buildpacks_builder_image = nil
+	delete(m.clearedFields, serviceconfig.FieldBuildpacksBuilderImage)
 }
 
-// SetDockerBuilderBuildContext sets the "docker_builder_build_context" field.
-func (m *ServiceConfigMutation) SetDockerBuilderBuildContext(s string) {
-	m.docker_builder_build_context = &s
+// SetPlatforms sets the "platforms" field.
+func (m *ServiceConfigMutation) SetPlatforms(s []string) {
+	m.platforms = &s
+	m.appendplatforms = nil
 }
 
-// DockerBuilderBuildContext returns the value of the "docker_builder_build_context" field in the mutation.
-func (m *ServiceConfigMutation) DockerBuilderBuildContext() (r string, exists bool) {
-	v := m.docker_builder_build_context
+// Platforms returns the value of the "platforms" field in the mutation.
+func (m *ServiceConfigMutation) Platforms() (r []string, exists bool) {
+	v := m.platforms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDockerBuilderBuildContext returns the old "docker_builder_build_context" field's value of the ServiceConfig entity.
+// OldPlatforms returns the old "platforms" field's value of the ServiceConfig entity.
 // If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ServiceConfigMutation) OldDockerBuilderBuildContext(ctx context.Context) (v *string, err error) {
+func (m *ServiceConfigMutation) OldPlatforms(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDockerBuilderBuildContext is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlatforms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDockerBuilderBuildContext requires an ID field in the mutation")
+		return v, errors.New("OldPlatforms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDockerBuilderBuildContext: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlatforms: %w", err)
 	}
-	return oldValue.DockerBuilderBuildContext, nil
+	return oldValue.Platforms, nil
 }
 
-// ClearDockerBuilderBuildContext clears the value of the "docker_builder_build_context" field.
-func (m *ServiceConfigMutation) ClearDockerBuilderBuildContext() {
-	m.docker_builder_build_context = nil
-	m.clearedFields[serviceconfig.FieldDockerBuilderBuildContext] = struct{}{}
+// AppendPlatforms adds s to the "platforms" field.
+func (m *ServiceConfigMutation) AppendPlatforms(s []string) {
+	m.appendplatforms = append(m.appendplatforms, s...)
 }
 
-// DockerBuilderBuildContextCleared returns if the "docker_builder_build_context" field was cleared in this mutation.
-func (m *ServiceConfigMutation) DockerBuilderBuildContextCleared() bool {
-	_, ok := m.clearedFields[serviceconfig.FieldDockerBuilderBuildContext]
+// AppendedPlatforms returns the list of values that were appended to the "platforms" field in this mutation.
+func (m *ServiceConfigMutation) AppendedPlatforms() ([]string, bool) {
+	if len(m.appendplatforms) == 0 {
+		return nil, false
+	}
+	return m.appendplatforms, true
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (m *ServiceConfigMutation) ClearPlatforms() {
+	m.platforms = nil
+	m.appendplatforms = nil
+	m.clearedFields[serviceconfig.FieldPlatforms] = struct{}{}
+}
+
+// PlatformsCleared returns if the "platforms" field was cleared in this mutation.
+func (m *ServiceConfigMutation) PlatformsCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldPlatforms]
 	return ok
 }
 
-// ResetDockerBuilderBuildContext resets all changes to the "docker_builder_build_context" field.
-func (m *ServiceConfigMutation) ResetDockerBuilderBuildContext() {
-	m.docker_builder_build_context = nil
-	delete(m.clearedFields, serviceconfig.FieldDockerBuilderBuildContext)
+// ResetPlatforms resets all changes to the "platforms" field.
+func (m *ServiceConfigMutation) ResetPlatforms() {
+	m.platforms = nil
+	m.appendplatforms = nil
+	delete(m.clearedFields, serviceconfig.FieldPlatforms)
 }
 
 // SetRailpackProvider sets the "railpack_provider" field.
@@ -14582,16 +19129,260 @@ func (m *ServiceConfigMutation) ClearGitTag() {
 	m.clearedFields[serviceconfig.FieldGitTag] = struct{}{}
 }
 
-// GitTagCleared returns if the "git_tag" field was cleared in this mutation.
-func (m *ServiceConfigMutation) GitTagCleared() bool {
-	_, ok := m.clearedFields[serviceconfig.FieldGitTag]
+// GitTagCleared returns if the "git_tag" field was cleared in this mutation.
+func (m *ServiceConfigMutation) GitTagCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldGitTag]
+	return ok
+}
+
+// ResetGitTag resets all changes to the "git_tag" field.
+func (m *ServiceConfigMutation) ResetGitTag() {
+	m.git_tag = nil
+	delete(m.clearedFields, serviceconfig.FieldGitTag)
+}
+
+// SetWatchPaths sets the "watch_paths" field.
+func (m *ServiceConfigMutation) SetWatchPaths(s []string) {
+	m.watch_paths = &s
+	m.appendwatch_paths = nil
+}
+
+// WatchPaths returns the value of the "watch_paths" field in the mutation.
+func (m *ServiceConfigMutation) WatchPaths() (r []string, exists bool) {
+	v := m.watch_paths
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatchPaths returns the old "watch_paths" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldWatchPaths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatchPaths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatchPaths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatchPaths: %w", err)
+	}
+	return oldValue.WatchPaths, nil
+}
+
+// AppendWatchPaths adds s to the "watch_paths" field.
+func (m *ServiceConfigMutation) AppendWatchPaths(s []string) {
+	m.appendwatch_paths = append(m.appendwatch_paths, s...)
+}
+
+// AppendedWatchPaths returns the list of values that were appended to the "watch_paths" field in this mutation.
+func (m *ServiceConfigMutation) AppendedWatchPaths() ([]string, bool) {
+	if len(m.appendwatch_paths) == 0 {
+		return nil, false
+	}
+	return m.appendwatch_paths, true
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (m *ServiceConfigMutation) ClearWatchPaths() {
+	m.watch_paths = nil
+	m.appendwatch_paths = nil
+	m.clearedFields[serviceconfig.FieldWatchPaths] = struct{}{}
+}
+
+// WatchPathsCleared returns if the "watch_paths" field was cleared in this mutation.
+func (m *ServiceConfigMutation) WatchPathsCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldWatchPaths]
+	return ok
+}
+
+// ResetWatchPaths resets all changes to the "watch_paths" field.
+func (m *ServiceConfigMutation) ResetWatchPaths() {
+	m.watch_paths = nil
+	m.appendwatch_paths = nil
+	delete(m.clearedFields, serviceconfig.FieldWatchPaths)
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (m *ServiceConfigMutation) SetSkipDeployMarker(s string) {
+	m.skip_deploy_marker = &s
+}
+
+// SkipDeployMarker returns the value of the "skip_deploy_marker" field in the mutation.
+func (m *ServiceConfigMutation) SkipDeployMarker() (r string, exists bool) {
+	v := m.skip_deploy_marker
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipDeployMarker returns the old "skip_deploy_marker" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldSkipDeployMarker(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipDeployMarker is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipDeployMarker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipDeployMarker: %w", err)
+	}
+	return oldValue.SkipDeployMarker, nil
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (m *ServiceConfigMutation) ClearSkipDeployMarker() {
+	m.skip_deploy_marker = nil
+	m.clearedFields[serviceconfig.FieldSkipDeployMarker] = struct{}{}
+}
+
+// SkipDeployMarkerCleared returns if the "skip_deploy_marker" field was cleared in this mutation.
+func (m *ServiceConfigMutation) SkipDeployMarkerCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldSkipDeployMarker]
+	return ok
+}
+
+// ResetSkipDeployMarker resets all changes to the "skip_deploy_marker" field.
+func (m *ServiceConfigMutation) ResetSkipDeployMarker() {
+	m.skip_deploy_marker = nil
+	delete(m.clearedFields, serviceconfig.FieldSkipDeployMarker)
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (m *ServiceConfigMutation) SetIgnoredCommitAuthors(s []string) {
+	m.ignored_commit_authors = &s
+	m.appendignored_commit_authors = nil
+}
+
+// IgnoredCommitAuthors returns the value of the "ignored_commit_authors" field in the mutation.
+func (m *ServiceConfigMutation) IgnoredCommitAuthors() (r []string, exists bool) {
+	v := m.ignored_commit_authors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIgnoredCommitAuthors returns the old "ignored_commit_authors" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldIgnoredCommitAuthors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIgnoredCommitAuthors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIgnoredCommitAuthors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIgnoredCommitAuthors: %w", err)
+	}
+	return oldValue.IgnoredCommitAuthors, nil
+}
+
+// AppendIgnoredCommitAuthors adds s to the "ignored_commit_authors" field.
+func (m *ServiceConfigMutation) AppendIgnoredCommitAuthors(s []string) {
+	m.appendignored_commit_authors = append(m.appendignored_commit_authors, s...)
+}
+
+// AppendedIgnoredCommitAuthors returns the list of values that were appended to the "ignored_commit_authors" field in this mutation.
+func (m *ServiceConfigMutation) AppendedIgnoredCommitAuthors() ([]string, bool) {
+	if len(m.appendignored_commit_authors) == 0 {
+		return nil, false
+	}
+	return m.appendignored_commit_authors, true
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (m *ServiceConfigMutation) ClearIgnoredCommitAuthors() {
+	m.ignored_commit_authors = nil
+	m.appendignored_commit_authors = nil
+	m.clearedFields[serviceconfig.FieldIgnoredCommitAuthors] = struct{}{}
+}
+
+// IgnoredCommitAuthorsCleared returns if the "ignored_commit_authors" field was cleared in this mutation.
+func (m *ServiceConfigMutation) IgnoredCommitAuthorsCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldIgnoredCommitAuthors]
+	return ok
+}
+
+// ResetIgnoredCommitAuthors resets all changes to the "ignored_commit_authors" field.
+func (m *ServiceConfigMutation) ResetIgnoredCommitAuthors() {
+	m.ignored_commit_authors = nil
+	m.appendignored_commit_authors = nil
+	delete(m.clearedFields, serviceconfig.FieldIgnoredCommitAuthors)
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (m *ServiceConfigMutation) SetPrPreviewVariables(s []string) {
+	m.pr_preview_variables = &s
+	m.appendpr_preview_variables = nil
+}
+
+// PrPreviewVariables returns the value of the "pr_preview_variables" field in the mutation.
+func (m *ServiceConfigMutation) PrPreviewVariables() (r []string, exists bool) {
+	v := m.pr_preview_variables
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrPreviewVariables returns the old "pr_preview_variables" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldPrPreviewVariables(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrPreviewVariables is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrPreviewVariables requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrPreviewVariables: %w", err)
+	}
+	return oldValue.PrPreviewVariables, nil
+}
+
+// AppendPrPreviewVariables adds s to the "pr_preview_variables" field.
+func (m *ServiceConfigMutation) AppendPrPreviewVariables(s []string) {
+	m.appendpr_preview_variables = append(m.appendpr_preview_variables, s...)
+}
+
+// AppendedPrPreviewVariables returns the list of values that were appended to the "pr_preview_variables" field in this mutation.
+func (m *ServiceConfigMutation) AppendedPrPreviewVariables() ([]string, bool) {
+	if len(m.appendpr_preview_variables) == 0 {
+		return nil, false
+	}
+	return m.appendpr_preview_variables, true
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (m *ServiceConfigMutation) ClearPrPreviewVariables() {
+	m.pr_preview_variables = nil
+	m.appendpr_preview_variables = nil
+	m.clearedFields[serviceconfig.FieldPrPreviewVariables] = struct{}{}
+}
+
+// PrPreviewVariablesCleared returns if the "pr_preview_variables" field was cleared in this mutation.
+func (m *ServiceConfigMutation) PrPreviewVariablesCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldPrPreviewVariables]
 	return ok
 }
 
-// ResetGitTag resets all changes to the "git_tag" field.
-func (m *ServiceConfigMutation) ResetGitTag() {
-	m.git_tag = nil
-	delete(m.clearedFields, serviceconfig.FieldGitTag)
+// ResetPrPreviewVariables resets all changes to the "pr_preview_variables" field.
+func (m *ServiceConfigMutation) ResetPrPreviewVariables() {
+	m.pr_preview_variables = nil
+	m.appendpr_preview_variables = nil
+	delete(m.clearedFields, serviceconfig.FieldPrPreviewVariables)
 }
 
 // SetHosts sets the "hosts" field.
@@ -14816,6 +19607,78 @@ func (m *ServiceConfigMutation) ResetAutoDeploy() {
 	m.auto_deploy = nil
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (m *ServiceConfigMutation) SetAutoRollback(b bool) {
+	m.auto_rollback = &b
+}
+
+// AutoRollback returns the value of the "auto_rollback" field in the mutation.
+func (m *ServiceConfigMutation) AutoRollback() (r bool, exists bool) {
+	v := m.auto_rollback
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoRollback returns the old "auto_rollback" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldAutoRollback(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoRollback is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoRollback requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoRollback: %w", err)
+	}
+	return oldValue.AutoRollback, nil
+}
+
+// ResetAutoRollback resets all changes to the "auto_rollback" field.
+func (m *ServiceConfigMutation) ResetAutoRollback() {
+	m.auto_rollback = nil
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (m *ServiceConfigMutation) SetPrPreviews(b bool) {
+	m.pr_previews = &b
+}
+
+// PrPreviews returns the value of the "pr_previews" field in the mutation.
+func (m *ServiceConfigMutation) PrPreviews() (r bool, exists bool) {
+	v := m.pr_previews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrPreviews returns the old "pr_previews" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldPrPreviews(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrPreviews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrPreviews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrPreviews: %w", err)
+	}
+	return oldValue.PrPreviews, nil
+}
+
+// ResetPrPreviews resets all changes to the "pr_previews" field.
+func (m *ServiceConfigMutation) ResetPrPreviews() {
+	m.pr_previews = nil
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (m *ServiceConfigMutation) SetRailpackBuilderInstallCommand(s string) {
 	m.railpack_builder_install_command = &s
@@ -14963,6 +19826,147 @@ func (m *ServiceConfigMutation) ResetRunCommand() {
 	delete(m.clearedFields, serviceconfig.FieldRunCommand)
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (m *ServiceConfigMutation) SetPreDeployCommand(s string) {
+	m.pre_deploy_command = &s
+}
+
+// PreDeployCommand returns the value of the "pre_deploy_command" field in the mutation.
+func (m *ServiceConfigMutation) PreDeployCommand() (r string, exists bool) {
+	v := m.pre_deploy_command
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreDeployCommand returns the old "pre_deploy_command" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldPreDeployCommand(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreDeployCommand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreDeployCommand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreDeployCommand: %w", err)
+	}
+	return oldValue.PreDeployCommand, nil
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (m *ServiceConfigMutation) ClearPreDeployCommand() {
+	m.pre_deploy_command = nil
+	m.clearedFields[serviceconfig.FieldPreDeployCommand] = struct{}{}
+}
+
+// PreDeployCommandCleared returns if the "pre_deploy_command" field was cleared in this mutation.
+func (m *ServiceConfigMutation) PreDeployCommandCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldPreDeployCommand]
+	return ok
+}
+
+// ResetPreDeployCommand resets all changes to the "pre_deploy_command" field.
+func (m *ServiceConfigMutation) ResetPreDeployCommand() {
+	m.pre_deploy_command = nil
+	delete(m.clearedFields, serviceconfig.FieldPreDeployCommand)
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (m *ServiceConfigMutation) SetRolloutStrategy(ss schema.RolloutStrategy) {
+	m.rollout_strategy = &ss
+}
+
+// RolloutStrategy returns the value of the "rollout_strategy" field in the mutation.
+func (m *ServiceConfigMutation) RolloutStrategy() (r schema.RolloutStrategy, exists bool) {
+	v := m.rollout_strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolloutStrategy returns the old "rollout_strategy" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldRolloutStrategy(ctx context.Context) (v schema.RolloutStrategy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolloutStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolloutStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolloutStrategy: %w", err)
+	}
+	return oldValue.RolloutStrategy, nil
+}
+
+// ResetRolloutStrategy resets all changes to the "rollout_strategy" field.
+func (m *ServiceConfigMutation) ResetRolloutStrategy() {
+	m.rollout_strategy = nil
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (m *ServiceConfigMutation) SetCanaryWeight(i int) {
+	m.canary_weight = &i
+	m.addcanary_weight = nil
+}
+
+// CanaryWeight returns the value of the "canary_weight" field in the mutation.
+func (m *ServiceConfigMutation) CanaryWeight() (r int, exists bool) {
+	v := m.canary_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanaryWeight returns the old "canary_weight" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldCanaryWeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanaryWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanaryWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanaryWeight: %w", err)
+	}
+	return oldValue.CanaryWeight, nil
+}
+
+// AddCanaryWeight adds i to the "canary_weight" field.
+func (m *ServiceConfigMutation) AddCanaryWeight(i int) {
+	if m.addcanary_weight != nil {
+		*m.addcanary_weight += i
+	} else {
+		m.addcanary_weight = &i
+	}
+}
+
+// AddedCanaryWeight returns the value that was added to the "canary_weight" field in this mutation.
+func (m *ServiceConfigMutation) AddedCanaryWeight() (r int, exists bool) {
+	v := m.addcanary_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCanaryWeight resets all changes to the "canary_weight" field.
+func (m *ServiceConfigMutation) ResetCanaryWeight() {
+	m.canary_weight = nil
+	m.addcanary_weight = nil
+}
+
 // SetIsPublic sets the "is_public" field.
 func (m *ServiceConfigMutation) SetIsPublic(b bool) {
 	m.is_public = &b
@@ -15048,6 +20052,91 @@ func (m *ServiceConfigMutation) ResetImage() {
 	delete(m.clearedFields, serviceconfig.FieldImage)
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (m *ServiceConfigMutation) SetImageAutoUpdate(b bool) {
+	m.image_auto_update = &b
+}
+
+// ImageAutoUpdate returns the value of the "image_auto_update" field in the mutation.
+func (m *ServiceConfigMutation) ImageAutoUpdate() (r bool, exists bool) {
+	v := m.image_auto_update
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageAutoUpdate returns the old "image_auto_update" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldImageAutoUpdate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageAutoUpdate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageAutoUpdate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageAutoUpdate: %w", err)
+	}
+	return oldValue.ImageAutoUpdate, nil
+}
+
+// ResetImageAutoUpdate resets all changes to the "image_auto_update" field.
+func (m *ServiceConfigMutation) ResetImageAutoUpdate() {
+	m.image_auto_update = nil
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (m *ServiceConfigMutation) SetImageDigest(s string) {
+	m.image_digest = &s
+}
+
+// ImageDigest returns the value of the "image_digest" field in the mutation.
+func (m *ServiceConfigMutation) ImageDigest() (r string, exists bool) {
+	v := m.image_digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageDigest returns the old "image_digest" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldImageDigest(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageDigest: %w", err)
+	}
+	return oldValue.ImageDigest, nil
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (m *ServiceConfigMutation) ClearImageDigest() {
+	m.image_digest = nil
+	m.clearedFields[serviceconfig.FieldImageDigest] = struct{}{}
+}
+
+// ImageDigestCleared returns if the "image_digest" field was cleared in this mutation.
+func (m *ServiceConfigMutation) ImageDigestCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldImageDigest]
+	return ok
+}
+
+// ResetImageDigest resets all changes to the "image_digest" field.
+func (m *ServiceConfigMutation) ResetImageDigest() {
+	m.image_digest = nil
+	delete(m.clearedFields, serviceconfig.FieldImageDigest)
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (m *ServiceConfigMutation) SetDefinitionVersion(s string) {
 	m.definition_version = &s
@@ -15336,6 +20425,104 @@ func (m *ServiceConfigMutation) ResetBackupRetentionCount() {
 	m.addbackup_retention_count = nil
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (m *ServiceConfigMutation) SetUploadS3SourceID(u uuid.UUID) {
+	m.s3_upload_sources = &u
+}
+
+// UploadS3SourceID returns the value of the "upload_s3_source_id" field in the mutation.
+func (m *ServiceConfigMutation) UploadS3SourceID() (r uuid.UUID, exists bool) {
+	v := m.s3_upload_sources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadS3SourceID returns the old "upload_s3_source_id" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldUploadS3SourceID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadS3SourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadS3SourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadS3SourceID: %w", err)
+	}
+	return oldValue.UploadS3SourceID, nil
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (m *ServiceConfigMutation) ClearUploadS3SourceID() {
+	m.s3_upload_sources = nil
+	m.clearedFields[serviceconfig.FieldUploadS3SourceID] = struct{}{}
+}
+
+// UploadS3SourceIDCleared returns if the "upload_s3_source_id" field was cleared in this mutation.
+func (m *ServiceConfigMutation) UploadS3SourceIDCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldUploadS3SourceID]
+	return ok
+}
+
+// ResetUploadS3SourceID resets all changes to the "upload_s3_source_id" field.
+func (m *ServiceConfigMutation) ResetUploadS3SourceID() {
+	m.s3_upload_sources = nil
+	delete(m.clearedFields, serviceconfig.FieldUploadS3SourceID)
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (m *ServiceConfigMutation) SetUploadS3Bucket(s string) {
+	m.upload_s3_bucket = &s
+}
+
+// UploadS3Bucket returns the value of the "upload_s3_bucket" field in the mutation.
+func (m *ServiceConfigMutation) UploadS3Bucket() (r string, exists bool) {
+	v := m.upload_s3_bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadS3Bucket returns the old "upload_s3_bucket" field's value of the ServiceConfig entity.
+// If the ServiceConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ServiceConfigMutation) OldUploadS3Bucket(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadS3Bucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadS3Bucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadS3Bucket: %w", err)
+	}
+	return oldValue.UploadS3Bucket, nil
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (m *ServiceConfigMutation) ClearUploadS3Bucket() {
+	m.upload_s3_bucket = nil
+	m.clearedFields[serviceconfig.FieldUploadS3Bucket] = struct{}{}
+}
+
+// UploadS3BucketCleared returns if the "upload_s3_bucket" field was cleared in this mutation.
+func (m *ServiceConfigMutation) UploadS3BucketCleared() bool {
+	_, ok := m.clearedFields[serviceconfig.FieldUploadS3Bucket]
+	return ok
+}
+
+// ResetUploadS3Bucket resets all changes to the "upload_s3_bucket" field.
+func (m *ServiceConfigMutation) ResetUploadS3Bucket() {
+	m.upload_s3_bucket = nil
+	delete(m.clearedFields, serviceconfig.FieldUploadS3Bucket)
+}
+
 // SetVolumes sets the "volumes" field.
 func (m *ServiceConfigMutation) SetVolumes(sv []schema.ServiceVolume) {
 	m.volumes = &sv
@@ -15786,28 +20973,68 @@ func (m *ServiceConfigMutation) S3BackupSourcesCleared() bool {
 	return m.S3BackupSourceIDCleared() || m.cleareds3_backup_sources
 }
 
-// S3BackupSourcesID returns the "s3_backup_sources" edge ID in the mutation.
-func (m *ServiceConfigMutation) S3BackupSourcesID() (id uuid.UUID, exists bool) {
-	if m.s3_backup_sources != nil {
-		return *m.s3_backup_sources, true
+// S3BackupSourcesID returns the "s3_backup_sources" edge ID in the mutation.
+func (m *ServiceConfigMutation) S3BackupSourcesID() (id uuid.UUID, exists bool) {
+	if m.s3_backup_sources != nil {
+		return *m.s3_backup_sources, true
+	}
+	return
+}
+
+// S3BackupSourcesIDs returns the "s3_backup_sources" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// S3BackupSourcesID instead. It exists only for internal usage by the builders.
+func (m *ServiceConfigMutation) S3BackupSourcesIDs() (ids []uuid.UUID) {
+	if id := m.s3_backup_sources; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetS3BackupSources resets all changes to the "s3_backup_sources" edge.
+func (m *ServiceConfigMutation) ResetS3BackupSources() {
+	m.s3_backup_sources = nil
+	m.cleareds3_backup_sources = false
+}
+
+// SetS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by id.
+func (m *ServiceConfigMutation) SetS3UploadSourcesID(id uuid.UUID) {
+	m.s3_upload_sources = &id
+}
+
+// ClearS3UploadSources clears the "s3_upload_sources" edge to the S3 entity.
+func (m *ServiceConfigMutation) ClearS3UploadSources() {
+	m.cleareds3_upload_sources = true
+	m.clearedFields[serviceconfig.FieldUploadS3SourceID] = struct{}{}
+}
+
+// S3UploadSourcesCleared reports if the "s3_upload_sources" edge to the S3 entity was cleared.
+func (m *ServiceConfigMutation) S3UploadSourcesCleared() bool {
+	return m.UploadS3SourceIDCleared() || m.cleareds3_upload_sources
+}
+
+// S3UploadSourcesID returns the "s3_upload_sources" edge ID in the mutation.
+func (m *ServiceConfigMutation) S3UploadSourcesID() (id uuid.UUID, exists bool) {
+	if m.s3_upload_sources != nil {
+		return *m.s3_upload_sources, true
 	}
 	return
 }
 
-// S3BackupSourcesIDs returns the "s3_backup_sources" edge IDs in the mutation.
+// S3UploadSourcesIDs returns the "s3_upload_sources" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// S3BackupSourcesID instead. It exists only for internal usage by the builders.
-func (m *ServiceConfigMutation) S3BackupSourcesIDs() (ids []uuid.UUID) {
-	if id := m.s3_backup_sources; id != nil {
+// S3UploadSourcesID instead. It exists only for internal usage by the builders.
+func (m *ServiceConfigMutation) S3UploadSourcesIDs() (ids []uuid.UUID) {
+	if id := m.s3_upload_sources; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetS3BackupSources resets all changes to the "s3_backup_sources" edge.
-func (m *ServiceConfigMutation) ResetS3BackupSources() {
-	m.s3_backup_sources = nil
-	m.cleareds3_backup_sources = false
+// ResetS3UploadSources resets all changes to the "s3_upload_sources" edge.
+func (m *ServiceConfigMutation) ResetS3UploadSources() {
+	m.s3_upload_sources = nil
+	m.cleareds3_upload_sources = false
 }
 
 // Where appends a list predicates to the ServiceConfigMutation builder.
@@ -15844,7 +21071,7 @@ func (m *ServiceConfigMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ServiceConfigMutation) Fields() []string {
-	fields := make([]string, 0, 33)
+	fields := make([]string, 0, 51)
 	if m.created_at != nil {
 		fields = append(fields, serviceconfig.FieldCreatedAt)
 	}
@@ -15866,6 +21093,21 @@ func (m *ServiceConfigMutation) Fields() []string {
 	if m.docker_builder_build_context != nil {
 		fields = append(fields, serviceconfig.FieldDockerBuilderBuildContext)
 	}
+	if m.docker_builder_target != nil {
+		fields = append(fields, serviceconfig.FieldDockerBuilderTarget)
+	}
+	if m.docker_builder_build_args != nil {
+		fields = append(fields, serviceconfig.FieldDockerBuilderBuildArgs)
+	}
+	if m.docker_builder_build_contexts != nil {
+		fields = append(fields, serviceconfig.FieldDockerBuilderBuildContexts)
+	}
+	if m.buildpacks_builder_image != nil {
+		fields = append(fields, serviceconfig.FieldBuildpacksBuilderImage)
+	}
+	if m.platforms != nil {
+		fields = append(fields, serviceconfig.FieldPlatforms)
+	}
 	if m.railpack_provider != nil {
 		fields = append(fields, serviceconfig.FieldRailpackProvider)
 	}
@@ -15878,6 +21120,18 @@ func (m *ServiceConfigMutation) Fields() []string {
 	if m.git_tag != nil {
 		fields = append(fields, serviceconfig.FieldGitTag)
 	}
+	if m.watch_paths != nil {
+		fields = append(fields, serviceconfig.FieldWatchPaths)
+	}
+	if m.skip_deploy_marker != nil {
+		fields = append(fields, serviceconfig.FieldSkipDeployMarker)
+	}
+	if m.ignored_commit_authors != nil {
+		fields = append(fields, serviceconfig.FieldIgnoredCommitAuthors)
+	}
+	if m.pr_preview_variables != nil {
+		fields = append(fields, serviceconfig.FieldPrPreviewVariables)
+	}
 	if m.hosts != nil {
 		fields = append(fields, serviceconfig.FieldHosts)
 	}
@@ -15890,6 +21144,12 @@ func (m *ServiceConfigMutation) Fields() []string {
 	if m.auto_deploy != nil {
 		fields = append(fields, serviceconfig.FieldAutoDeploy)
 	}
+	if m.auto_rollback != nil {
+		fields = append(fields, serviceconfig.FieldAutoRollback)
+	}
+	if m.pr_previews != nil {
+		fields = append(fields, serviceconfig.FieldPrPreviews)
+	}
 	if m.railpack_builder_install_command != nil {
 		fields = append(fields, serviceconfig.FieldRailpackBuilderInstallCommand)
 	}
@@ -15899,12 +21159,27 @@ func (m *ServiceConfigMutation) Fields() []string {
 	if m.run_command != nil {
 		fields = append(fields, serviceconfig.FieldRunCommand)
 	}
+	if m.pre_deploy_command != nil {
+		fields = append(fields, serviceconfig.FieldPreDeployCommand)
+	}
+	if m.rollout_strategy != nil {
+		fields = append(fields, serviceconfig.FieldRolloutStrategy)
+	}
+	if m.canary_weight != nil {
+		fields = append(fields, serviceconfig.FieldCanaryWeight)
+	}
 	if m.is_public != nil {
 		fields = append(fields, serviceconfig.FieldIsPublic)
 	}
 	if m.image != nil {
 		fields = append(fields, serviceconfig.FieldImage)
 	}
+	if m.image_auto_update != nil {
+		fields = append(fields, serviceconfig.FieldImageAutoUpdate)
+	}
+	if m.image_digest != nil {
+		fields = append(fields, serviceconfig.FieldImageDigest)
+	}
 	if m.definition_version != nil {
 		fields = append(fields, serviceconfig.FieldDefinitionVersion)
 	}
@@ -15923,6 +21198,12 @@ func (m *ServiceConfigMutation) Fields() []string {
 	if m.backup_retention_count != nil {
 		fields = append(fields, serviceconfig.FieldBackupRetentionCount)
 	}
+	if m.s3_upload_sources != nil {
+		fields = append(fields, serviceconfig.FieldUploadS3SourceID)
+	}
+	if m.upload_s3_bucket != nil {
+		fields = append(fields, serviceconfig.FieldUploadS3Bucket)
+	}
 	if m.volumes != nil {
 		fields = append(fields, serviceconfig.FieldVolumes)
 	}
@@ -15966,6 +21247,16 @@ func (m *ServiceConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.DockerBuilderDockerfilePath()
 	case serviceconfig.FieldDockerBuilderBuildContext:
 		return m.DockerBuilderBuildContext()
+	case serviceconfig.FieldDockerBuilderTarget:
+		return m.DockerBuilderTarget()
+	case serviceconfig.FieldDockerBuilderBuildArgs:
+		return m.DockerBuilderBuildArgs()
+	case serviceconfig.FieldDockerBuilderBuildContexts:
+		return m.DockerBuilderBuildContexts()
+	case serviceconfig.FieldBuildpacksBuilderImage:
+		return m.BuildpacksBuilderImage()
+	case serviceconfig.FieldPlatforms:
+		return m.Platforms()
 	case serviceconfig.FieldRailpackProvider:
 		return m.RailpackProvider()
 	case serviceconfig.FieldRailpackFramework:
@@ -15974,6 +21265,14 @@ func (m *ServiceConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.GitBranch()
 	case serviceconfig.FieldGitTag:
 		return m.GitTag()
+	case serviceconfig.FieldWatchPaths:
+		return m.WatchPaths()
+	case serviceconfig.FieldSkipDeployMarker:
+		return m.SkipDeployMarker()
+	case serviceconfig.FieldIgnoredCommitAuthors:
+		return m.IgnoredCommitAuthors()
+	case serviceconfig.FieldPrPreviewVariables:
+		return m.PrPreviewVariables()
 	case serviceconfig.FieldHosts:
 		return m.Hosts()
 	case serviceconfig.FieldPorts:
@@ -15982,16 +21281,30 @@ func (m *ServiceConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.Replicas()
 	case serviceconfig.FieldAutoDeploy:
 		return m.AutoDeploy()
+	case serviceconfig.FieldAutoRollback:
+		return m.AutoRollback()
+	case serviceconfig.FieldPrPreviews:
+		return m.PrPreviews()
 	case serviceconfig.FieldRailpackBuilderInstallCommand:
 		return m.RailpackBuilderInstallCommand()
 	case serviceconfig.FieldRailpackBuilderBuildCommand:
 		return m.RailpackBuilderBuildCommand()
 	case serviceconfig.FieldRunCommand:
 		return m.RunCommand()
+	case serviceconfig.FieldPreDeployCommand:
+		return m.PreDeployCommand()
+	case serviceconfig.FieldRolloutStrategy:
+		return m.RolloutStrategy()
+	case serviceconfig.FieldCanaryWeight:
+		return m.CanaryWeight()
 	case serviceconfig.FieldIsPublic:
 		return m.IsPublic()
 	case serviceconfig.FieldImage:
 		return m.Image()
+	case serviceconfig.FieldImageAutoUpdate:
+		return m.ImageAutoUpdate()
+	case serviceconfig.FieldImageDigest:
+		return m.ImageDigest()
 	case serviceconfig.FieldDefinitionVersion:
 		return m.DefinitionVersion()
 	case serviceconfig.FieldDatabaseConfig:
@@ -16004,6 +21317,10 @@ func (m *ServiceConfigMutation) Field(name string) (ent.Value, bool) {
 		return m.BackupSchedule()
 	case serviceconfig.FieldBackupRetentionCount:
 		return m.BackupRetentionCount()
+	case serviceconfig.FieldUploadS3SourceID:
+		return m.UploadS3SourceID()
+	case serviceconfig.FieldUploadS3Bucket:
+		return m.UploadS3Bucket()
 	case serviceconfig.FieldVolumes:
 		return m.Volumes()
 	case serviceconfig.FieldSecurityContext:
@@ -16041,6 +21358,16 @@ func (m *ServiceConfigMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldDockerBuilderDockerfilePath(ctx)
 	case serviceconfig.FieldDockerBuilderBuildContext:
 		return m.OldDockerBuilderBuildContext(ctx)
+	case serviceconfig.FieldDockerBuilderTarget:
+		return m.OldDockerBuilderTarget(ctx)
+	case serviceconfig.FieldDockerBuilderBuildArgs:
+		return m.OldDockerBuilderBuildArgs(ctx)
+	case serviceconfig.FieldDockerBuilderBuildContexts:
+		return m.OldDockerBuilderBuildContexts(ctx)
+	case serviceconfig.FieldBuildpacksBuilderImage:
+		return m.OldBuildpacksBuilderImage(ctx)
+	case serviceconfig.FieldPlatforms:
+		return m.OldPlatforms(ctx)
 	case serviceconfig.FieldRailpackProvider:
 		return m.OldRailpackProvider(ctx)
 	case serviceconfig.FieldRailpackFramework:
@@ -16049,6 +21376,14 @@ func (m *ServiceConfigMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldGitBranch(ctx)
 	case serviceconfig.FieldGitTag:
 		return m.OldGitTag(ctx)
+	case serviceconfig.FieldWatchPaths:
+		return m.OldWatchPaths(ctx)
+	case serviceconfig.FieldSkipDeployMarker:
+		return m.OldSkipDeployMarker(ctx)
+	case serviceconfig.FieldIgnoredCommitAuthors:
+		return m.OldIgnoredCommitAuthors(ctx)
+	case serviceconfig.FieldPrPreviewVariables:
+		return m.OldPrPreviewVariables(ctx)
 	case serviceconfig.FieldHosts:
 		return m.OldHosts(ctx)
 	case serviceconfig.FieldPorts:
@@ -16057,16 +21392,30 @@ func (m *ServiceConfigMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldReplicas(ctx)
 	case serviceconfig.FieldAutoDeploy:
 		return m.OldAutoDeploy(ctx)
+	case serviceconfig.FieldAutoRollback:
+		return m.OldAutoRollback(ctx)
+	case serviceconfig.FieldPrPreviews:
+		return m.OldPrPreviews(ctx)
 	case serviceconfig.FieldRailpackBuilderInstallCommand:
 		return m.OldRailpackBuilderInstallCommand(ctx)
 	case serviceconfig.FieldRailpackBuilderBuildCommand:
 		return m.OldRailpackBuilderBuildCommand(ctx)
 	case serviceconfig.FieldRunCommand:
 		return m.OldRunCommand(ctx)
+	case serviceconfig.FieldPreDeployCommand:
+		return m.OldPreDeployCommand(ctx)
+	case serviceconfig.FieldRolloutStrategy:
+		return m.OldRolloutStrategy(ctx)
+	case serviceconfig.FieldCanaryWeight:
+		return m.OldCanaryWeight(ctx)
 	case serviceconfig.FieldIsPublic:
 		return m.OldIsPublic(ctx)
 	case serviceconfig.FieldImage:
 		return m.OldImage(ctx)
+	case serviceconfig.FieldImageAutoUpdate:
+		return m.OldImageAutoUpdate(ctx)
+	case serviceconfig.FieldImageDigest:
+		return m.OldImageDigest(ctx)
 	case serviceconfig.FieldDefinitionVersion:
 		return m.OldDefinitionVersion(ctx)
 	case serviceconfig.FieldDatabaseConfig:
@@ -16079,6 +21428,10 @@ func (m *ServiceConfigMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldBackupSchedule(ctx)
 	case serviceconfig.FieldBackupRetentionCount:
 		return m.OldBackupRetentionCount(ctx)
+	case serviceconfig.FieldUploadS3SourceID:
+		return m.OldUploadS3SourceID(ctx)
+	case serviceconfig.FieldUploadS3Bucket:
+		return m.OldUploadS3Bucket(ctx)
 	case serviceconfig.FieldVolumes:
 		return m.OldVolumes(ctx)
 	case serviceconfig.FieldSecurityContext:
@@ -16151,6 +21504,41 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDockerBuilderBuildContext(v)
 		return nil
+	case serviceconfig.FieldDockerBuilderTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderTarget(v)
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildArgs:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderBuildArgs(v)
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildContexts:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerBuilderBuildContexts(v)
+		return nil
+	case serviceconfig.FieldBuildpacksBuilderImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildpacksBuilderImage(v)
+		return nil
+	case serviceconfig.FieldPlatforms:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatforms(v)
+		return nil
 	case serviceconfig.FieldRailpackProvider:
 		v, ok := value.(enum.Provider)
 		if !ok {
@@ -16179,6 +21567,34 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGitTag(v)
 		return nil
+	case serviceconfig.FieldWatchPaths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatchPaths(v)
+		return nil
+	case serviceconfig.FieldSkipDeployMarker:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipDeployMarker(v)
+		return nil
+	case serviceconfig.FieldIgnoredCommitAuthors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIgnoredCommitAuthors(v)
+		return nil
+	case serviceconfig.FieldPrPreviewVariables:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrPreviewVariables(v)
+		return nil
 	case serviceconfig.FieldHosts:
 		v, ok := value.([]schema.HostSpec)
 		if !ok {
@@ -16207,6 +21623,20 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAutoDeploy(v)
 		return nil
+	case serviceconfig.FieldAutoRollback:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoRollback(v)
+		return nil
+	case serviceconfig.FieldPrPreviews:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrPreviews(v)
+		return nil
 	case serviceconfig.FieldRailpackBuilderInstallCommand:
 		v, ok := value.(string)
 		if !ok {
@@ -16228,6 +21658,27 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRunCommand(v)
 		return nil
+	case serviceconfig.FieldPreDeployCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreDeployCommand(v)
+		return nil
+	case serviceconfig.FieldRolloutStrategy:
+		v, ok := value.(schema.RolloutStrategy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolloutStrategy(v)
+		return nil
+	case serviceconfig.FieldCanaryWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanaryWeight(v)
+		return nil
 	case serviceconfig.FieldIsPublic:
 		v, ok := value.(bool)
 		if !ok {
@@ -16242,6 +21693,20 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetImage(v)
 		return nil
+	case serviceconfig.FieldImageAutoUpdate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageAutoUpdate(v)
+		return nil
+	case serviceconfig.FieldImageDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageDigest(v)
+		return nil
 	case serviceconfig.FieldDefinitionVersion:
 		v, ok := value.(string)
 		if !ok {
@@ -16284,6 +21749,20 @@ func (m *ServiceConfigMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBackupRetentionCount(v)
 		return nil
+	case serviceconfig.FieldUploadS3SourceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadS3SourceID(v)
+		return nil
+	case serviceconfig.FieldUploadS3Bucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadS3Bucket(v)
+		return nil
 	case serviceconfig.FieldVolumes:
 		v, ok := value.([]schema.ServiceVolume)
 		if !ok {
@@ -16344,6 +21823,9 @@ func (m *ServiceConfigMutation) AddedFields() []string {
 	if m.addreplicas != nil {
 		fields = append(fields, serviceconfig.FieldReplicas)
 	}
+	if m.addcanary_weight != nil {
+		fields = append(fields, serviceconfig.FieldCanaryWeight)
+	}
 	if m.addbackup_retention_count != nil {
 		fields = append(fields, serviceconfig.FieldBackupRetentionCount)
 	}
@@ -16357,6 +21839,8 @@ func (m *ServiceConfigMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case serviceconfig.FieldReplicas:
 		return m.AddedReplicas()
+	case serviceconfig.FieldCanaryWeight:
+		return m.AddedCanaryWeight()
 	case serviceconfig.FieldBackupRetentionCount:
 		return m.AddedBackupRetentionCount()
 	}
@@ -16375,6 +21859,13 @@ func (m *ServiceConfigMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddReplicas(v)
 		return nil
+	case serviceconfig.FieldCanaryWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCanaryWeight(v)
+		return nil
 	case serviceconfig.FieldBackupRetentionCount:
 		v, ok := value.(int)
 		if !ok {
@@ -16396,6 +21887,21 @@ func (m *ServiceConfigMutation) ClearedFields() []string {
 	if m.FieldCleared(serviceconfig.FieldDockerBuilderBuildContext) {
 		fields = append(fields, serviceconfig.FieldDockerBuilderBuildContext)
 	}
+	if m.FieldCleared(serviceconfig.FieldDockerBuilderTarget) {
+		fields = append(fields, serviceconfig.FieldDockerBuilderTarget)
+	}
+	if m.FieldCleared(serviceconfig.FieldDockerBuilderBuildArgs) {
+		fields = append(fields, serviceconfig.FieldDockerBuilderBuildArgs)
+	}
+	if m.FieldCleared(serviceconfig.FieldDockerBuilderBuildContexts) {
+		fields = append(fields, serviceconfig.FieldDockerBuilderBuildContexts)
+	}
+	if m.FieldCleared(serviceconfig.FieldBuildpacksBuilderImage) {
+		fields = append(fields, serviceconfig.FieldBuildpacksBuilderImage)
+	}
+	if m.FieldCleared(serviceconfig.FieldPlatforms) {
+		fields = append(fields, serviceconfig.FieldPlatforms)
+	}
 	if m.FieldCleared(serviceconfig.FieldRailpackProvider) {
 		fields = append(fields, serviceconfig.FieldRailpackProvider)
 	}
@@ -16408,6 +21914,18 @@ func (m *ServiceConfigMutation) ClearedFields() []string {
 	if m.FieldCleared(serviceconfig.FieldGitTag) {
 		fields = append(fields, serviceconfig.FieldGitTag)
 	}
+	if m.FieldCleared(serviceconfig.FieldWatchPaths) {
+		fields = append(fields, serviceconfig.FieldWatchPaths)
+	}
+	if m.FieldCleared(serviceconfig.FieldSkipDeployMarker) {
+		fields = append(fields, serviceconfig.FieldSkipDeployMarker)
+	}
+	if m.FieldCleared(serviceconfig.FieldIgnoredCommitAuthors) {
+		fields = append(fields, serviceconfig.FieldIgnoredCommitAuthors)
+	}
+	if m.FieldCleared(serviceconfig.FieldPrPreviewVariables) {
+		fields = append(fields, serviceconfig.FieldPrPreviewVariables)
+	}
 	if m.FieldCleared(serviceconfig.FieldHosts) {
 		fields = append(fields, serviceconfig.FieldHosts)
 	}
@@ -16423,9 +21941,15 @@ func (m *ServiceConfigMutation) ClearedFields() []string {
 	if m.FieldCleared(serviceconfig.FieldRunCommand) {
 		fields = append(fields, serviceconfig.FieldRunCommand)
 	}
+	if m.FieldCleared(serviceconfig.FieldPreDeployCommand) {
+		fields = append(fields, serviceconfig.FieldPreDeployCommand)
+	}
 	if m.FieldCleared(serviceconfig.FieldImage) {
 		fields = append(fields, serviceconfig.FieldImage)
 	}
+	if m.FieldCleared(serviceconfig.FieldImageDigest) {
+		fields = append(fields, serviceconfig.FieldImageDigest)
+	}
 	if m.FieldCleared(serviceconfig.FieldDefinitionVersion) {
 		fields = append(fields, serviceconfig.FieldDefinitionVersion)
 	}
@@ -16438,6 +21962,12 @@ func (m *ServiceConfigMutation) ClearedFields() []string {
 	if m.FieldCleared(serviceconfig.FieldS3BackupBucket) {
 		fields = append(fields, serviceconfig.FieldS3BackupBucket)
 	}
+	if m.FieldCleared(serviceconfig.FieldUploadS3SourceID) {
+		fields = append(fields, serviceconfig.FieldUploadS3SourceID)
+	}
+	if m.FieldCleared(serviceconfig.FieldUploadS3Bucket) {
+		fields = append(fields, serviceconfig.FieldUploadS3Bucket)
+	}
 	if m.FieldCleared(serviceconfig.FieldVolumes) {
 		fields = append(fields, serviceconfig.FieldVolumes)
 	}
@@ -16479,6 +22009,21 @@ func (m *ServiceConfigMutation) ClearField(name string) error {
 	case serviceconfig.FieldDockerBuilderBuildContext:
 		m.ClearDockerBuilderBuildContext()
 		return nil
+	case serviceconfig.FieldDockerBuilderTarget:
+		m.ClearDockerBuilderTarget()
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildArgs:
+		m.ClearDockerBuilderBuildArgs()
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildContexts:
+		m.ClearDockerBuilderBuildContexts()
+		return nil
+	case serviceconfig.FieldBuildpacksBuilderImage:
+		m.ClearBuildpacksBuilderImage()
+		return nil
+	case serviceconfig.FieldPlatforms:
+		m.ClearPlatforms()
+		return nil
 	case serviceconfig.FieldRailpackProvider:
 		m.ClearRailpackProvider()
 		return nil
@@ -16491,6 +22036,18 @@ func (m *ServiceConfigMutation) ClearField(name string) error {
 	case serviceconfig.FieldGitTag:
 		m.ClearGitTag()
 		return nil
+	case serviceconfig.FieldWatchPaths:
+		m.ClearWatchPaths()
+		return nil
+	case serviceconfig.FieldSkipDeployMarker:
+		m.ClearSkipDeployMarker()
+		return nil
+	case serviceconfig.FieldIgnoredCommitAuthors:
+		m.ClearIgnoredCommitAuthors()
+		return nil
+	case serviceconfig.FieldPrPreviewVariables:
+		m.ClearPrPreviewVariables()
+		return nil
 	case serviceconfig.FieldHosts:
 		m.ClearHosts()
 		return nil
@@ -16506,9 +22063,15 @@ func (m *ServiceConfigMutation) ClearField(name string) error {
 	case serviceconfig.FieldRunCommand:
 		m.ClearRunCommand()
 		return nil
+	case serviceconfig.FieldPreDeployCommand:
+		m.ClearPreDeployCommand()
+		return nil
 	case serviceconfig.FieldImage:
 		m.ClearImage()
 		return nil
+	case serviceconfig.FieldImageDigest:
+		m.ClearImageDigest()
+		return nil
 	case serviceconfig.FieldDefinitionVersion:
 		m.ClearDefinitionVersion()
 		return nil
@@ -16521,6 +22084,12 @@ func (m *ServiceConfigMutation) ClearField(name string) error {
 	case serviceconfig.FieldS3BackupBucket:
 		m.ClearS3BackupBucket()
 		return nil
+	case serviceconfig.FieldUploadS3SourceID:
+		m.ClearUploadS3SourceID()
+		return nil
+	case serviceconfig.FieldUploadS3Bucket:
+		m.ClearUploadS3Bucket()
+		return nil
 	case serviceconfig.FieldVolumes:
 		m.ClearVolumes()
 		return nil
@@ -16571,6 +22140,21 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 	case serviceconfig.FieldDockerBuilderBuildContext:
 		m.ResetDockerBuilderBuildContext()
 		return nil
+	case serviceconfig.FieldDockerBuilderTarget:
+		m.ResetDockerBuilderTarget()
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildArgs:
+		m.ResetDockerBuilderBuildArgs()
+		return nil
+	case serviceconfig.FieldDockerBuilderBuildContexts:
+		m.ResetDockerBuilderBuildContexts()
+		return nil
+	case serviceconfig.FieldBuildpacksBuilderImage:
+		m.ResetBuildpacksBuilderImage()
+		return nil
+	case serviceconfig.FieldPlatforms:
+		m.ResetPlatforms()
+		return nil
 	case serviceconfig.FieldRailpackProvider:
 		m.ResetRailpackProvider()
 		return nil
@@ -16583,6 +22167,18 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 	case serviceconfig.FieldGitTag:
 		m.ResetGitTag()
 		return nil
+	case serviceconfig.FieldWatchPaths:
+		m.ResetWatchPaths()
+		return nil
+	case serviceconfig.FieldSkipDeployMarker:
+		m.ResetSkipDeployMarker()
+		return nil
+	case serviceconfig.FieldIgnoredCommitAuthors:
+		m.ResetIgnoredCommitAuthors()
+		return nil
+	case serviceconfig.FieldPrPreviewVariables:
+		m.ResetPrPreviewVariables()
+		return nil
 	case serviceconfig.FieldHosts:
 		m.ResetHosts()
 		return nil
@@ -16595,6 +22191,12 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 	case serviceconfig.FieldAutoDeploy:
 		m.ResetAutoDeploy()
 		return nil
+	case serviceconfig.FieldAutoRollback:
+		m.ResetAutoRollback()
+		return nil
+	case serviceconfig.FieldPrPreviews:
+		m.ResetPrPreviews()
+		return nil
 	case serviceconfig.FieldRailpackBuilderInstallCommand:
 		m.ResetRailpackBuilderInstallCommand()
 		return nil
@@ -16604,12 +22206,27 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 	case serviceconfig.FieldRunCommand:
 		m.ResetRunCommand()
 		return nil
+	case serviceconfig.FieldPreDeployCommand:
+		m.ResetPreDeployCommand()
+		return nil
+	case serviceconfig.FieldRolloutStrategy:
+		m.ResetRolloutStrategy()
+		return nil
+	case serviceconfig.FieldCanaryWeight:
+		m.ResetCanaryWeight()
+		return nil
 	case serviceconfig.FieldIsPublic:
 		m.ResetIsPublic()
 		return nil
 	case serviceconfig.FieldImage:
 		m.ResetImage()
 		return nil
+	case serviceconfig.FieldImageAutoUpdate:
+		m.ResetImageAutoUpdate()
+		return nil
+	case serviceconfig.FieldImageDigest:
+		m.ResetImageDigest()
+		return nil
 	case serviceconfig.FieldDefinitionVersion:
 		m.ResetDefinitionVersion()
 		return nil
@@ -16628,6 +22245,12 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 	case serviceconfig.FieldBackupRetentionCount:
 		m.ResetBackupRetentionCount()
 		return nil
+	case serviceconfig.FieldUploadS3SourceID:
+		m.ResetUploadS3SourceID()
+		return nil
+	case serviceconfig.FieldUploadS3Bucket:
+		m.ResetUploadS3Bucket()
+		return nil
 	case serviceconfig.FieldVolumes:
 		m.ResetVolumes()
 		return nil
@@ -16655,13 +22278,16 @@ func (m *ServiceConfigMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ServiceConfigMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.service != nil {
 		edges = append(edges, serviceconfig.EdgeService)
 	}
 	if m.s3_backup_sources != nil {
 		edges = append(edges, serviceconfig.EdgeS3BackupSources)
 	}
+	if m.s3_upload_sources != nil {
+		edges = append(edges, serviceconfig.EdgeS3UploadSources)
+	}
 	return edges
 }
 
@@ -16677,13 +22303,17 @@ func (m *ServiceConfigMutation) AddedIDs(name string) []ent.Value {
 		if id := m.s3_backup_sources; id != nil {
 			return []ent.Value{*id}
 		}
+	case serviceconfig.EdgeS3UploadSources:
+		if id := m.s3_upload_sources; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ServiceConfigMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	return edges
 }
 
@@ -16695,13 +22325,16 @@ func (m *ServiceConfigMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ServiceConfigMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedservice {
 		edges = append(edges, serviceconfig.EdgeService)
 	}
 	if m.cleareds3_backup_sources {
 		edges = append(edges, serviceconfig.EdgeS3BackupSources)
 	}
+	if m.cleareds3_upload_sources {
+		edges = append(edges, serviceconfig.EdgeS3UploadSources)
+	}
 	return edges
 }
 
@@ -16713,6 +22346,8 @@ func (m *ServiceConfigMutation) EdgeCleared(name string) bool {
 		return m.clearedservice
 	case serviceconfig.EdgeS3BackupSources:
 		return m.cleareds3_backup_sources
+	case serviceconfig.EdgeS3UploadSources:
+		return m.cleareds3_upload_sources
 	}
 	return false
 }
@@ -16727,6 +22362,9 @@ func (m *ServiceConfigMutation) ClearEdge(name string) error {
 	case serviceconfig.EdgeS3BackupSources:
 		m.ClearS3BackupSources()
 		return nil
+	case serviceconfig.EdgeS3UploadSources:
+		m.ClearS3UploadSources()
+		return nil
 	}
 	return fmt.Errorf("unknown ServiceConfig unique edge %s", name)
 }
@@ -16741,6 +22379,9 @@ func (m *ServiceConfigMutation) ResetEdge(name string) error {
 	case serviceconfig.EdgeS3BackupSources:
 		m.ResetS3BackupSources()
 		return nil
+	case serviceconfig.EdgeS3UploadSources:
+		m.ResetS3UploadSources()
+		return nil
 	}
 	return fmt.Errorf("unknown ServiceConfig edge %s", name)
 }
@@ -17537,6 +23178,7 @@ type SystemSettingMutation struct {
 	updated_at        *time.Time
 	wildcard_base_url *string
 	buildkit_settings **schema.BuildkitSettings
+	build_settings    **schema.BuildSettings
 	clearedFields     map[string]struct{}
 	done              bool
 	oldValue          func(context.Context) (*SystemSetting, error)
@@ -17817,6 +23459,55 @@ func (m *SystemSettingMutation) ResetBuildkitSettings() {
 	delete(m.clearedFields, systemsetting.FieldBuildkitSettings)
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (m *SystemSettingMutation) SetBuildSettings(ss *schema.BuildSettings) {
+	m.build_settings = &ss
+}
+
+// BuildSettings returns the value of the "build_settings" field in the mutation.
+func (m *SystemSettingMutation) BuildSettings() (r *schema.BuildSettings, exists bool) {
+	v := m.build_settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildSettings returns the old "build_settings" field's value of the SystemSetting entity.
+// If the SystemSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SystemSettingMutation) OldBuildSettings(ctx context.Context) (v *schema.BuildSettings, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildSettings: %w", err)
+	}
+	return oldValue.BuildSettings, nil
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (m *SystemSettingMutation) ClearBuildSettings() {
+	m.build_settings = nil
+	m.clearedFields[systemsetting.FieldBuildSettings] = struct{}{}
+}
+
+// BuildSettingsCleared returns if the "build_settings" field was cleared in this mutation.
+func (m *SystemSettingMutation) BuildSettingsCleared() bool {
+	_, ok := m.clearedFields[systemsetting.FieldBuildSettings]
+	return ok
+}
+
+// ResetBuildSettings resets all changes to the "build_settings" field.
+func (m *SystemSettingMutation) ResetBuildSettings() {
+	m.build_settings = nil
+	delete(m.clearedFields, systemsetting.FieldBuildSettings)
+}
+
 // Where appends a list predicates to the SystemSettingMutation builder.
 func (m *SystemSettingMutation) Where(ps ...predicate.SystemSetting) {
 	m.predicates = append(m.predicates, ps...)
@@ -17851,7 +23542,7 @@ func (m *SystemSettingMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SystemSettingMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.created_at != nil {
 		fields = append(fields, systemsetting.FieldCreatedAt)
 	}
@@ -17864,6 +23555,9 @@ func (m *SystemSettingMutation) Fields() []string {
 	if m.buildkit_settings != nil {
 		fields = append(fields, systemsetting.FieldBuildkitSettings)
 	}
+	if m.build_settings != nil {
+		fields = append(fields, systemsetting.FieldBuildSettings)
+	}
 	return fields
 }
 
@@ -17880,6 +23574,8 @@ func (m *SystemSettingMutation) Field(name string) (ent.Value, bool) {
 		return m.WildcardBaseURL()
 	case systemsetting.FieldBuildkitSettings:
 		return m.BuildkitSettings()
+	case systemsetting.FieldBuildSettings:
+		return m.BuildSettings()
 	}
 	return nil, false
 }
@@ -17897,6 +23593,8 @@ func (m *SystemSettingMutation) OldField(ctx context.Context, name string) (ent.
 		return m.OldWildcardBaseURL(ctx)
 	case systemsetting.FieldBuildkitSettings:
 		return m.OldBuildkitSettings(ctx)
+	case systemsetting.FieldBuildSettings:
+		return m.OldBuildSettings(ctx)
 	}
 	return nil, fmt.Errorf("unknown SystemSetting field %s", name)
 }
@@ -17934,6 +23632,13 @@ func (m *SystemSettingMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBuildkitSettings(v)
 		return nil
+	case systemsetting.FieldBuildSettings:
+		v, ok := value.(*schema.BuildSettings)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildSettings(v)
+		return nil
 	}
 	return fmt.Errorf("unknown SystemSetting field %s", name)
 }
@@ -17970,6 +23675,9 @@ func (m *SystemSettingMutation) ClearedFields() []string {
 	if m.FieldCleared(systemsetting.FieldBuildkitSettings) {
 		fields = append(fields, systemsetting.FieldBuildkitSettings)
 	}
+	if m.FieldCleared(systemsetting.FieldBuildSettings) {
+		fields = append(fields, systemsetting.FieldBuildSettings)
+	}
 	return fields
 }
 
@@ -17990,6 +23698,9 @@ func (m *SystemSettingMutation) ClearField(name string) error {
 	case systemsetting.FieldBuildkitSettings:
 		m.ClearBuildkitSettings()
 		return nil
+	case systemsetting.FieldBuildSettings:
+		m.ClearBuildSettings()
+		return nil
 	}
 	return fmt.Errorf("unknown SystemSetting nullable field %s", name)
 }
@@ -18010,6 +23721,9 @@ func (m *SystemSettingMutation) ResetField(name string) error {
 	case systemsetting.FieldBuildkitSettings:
 		m.ResetBuildkitSettings()
 		return nil
+	case systemsetting.FieldBuildSettings:
+		m.ResetBuildSettings()
+		return nil
 	}
 	return fmt.Errorf("unknown SystemSetting field %s", name)
 }
@@ -20158,32 +25872,35 @@ func (m *TemplateMutation) ResetEdge(name string) error {
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	updated_at           *time.Time
-	email                *string
-	password_hash        *string
-	clearedFields        map[string]struct{}
-	oauth2_tokens        map[uuid.UUID]struct{}
-	removedoauth2_tokens map[uuid.UUID]struct{}
-	clearedoauth2_tokens bool
-	oauth2_codes         map[uuid.UUID]struct{}
-	removedoauth2_codes  map[uuid.UUID]struct{}
-	clearedoauth2_codes  bool
-	created_by           map[int64]struct{}
-	removedcreated_by    map[int64]struct{}
-	clearedcreated_by    bool
-	groups               map[uuid.UUID]struct{}
-	removedgroups        map[uuid.UUID]struct{}
-	clearedgroups        bool
-	teams                map[uuid.UUID]struct{}
-	removedteams         map[uuid.UUID]struct{}
-	clearedteams         bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	email                     *string
+	password_hash             *string
+	clearedFields             map[string]struct{}
+	oauth2_tokens             map[uuid.UUID]struct{}
+	removedoauth2_tokens      map[uuid.UUID]struct{}
+	clearedoauth2_tokens      bool
+	oauth2_codes              map[uuid.UUID]struct{}
+	removedoauth2_codes       map[uuid.UUID]struct{}
+	clearedoauth2_codes       bool
+	created_by                map[int64]struct{}
+	removedcreated_by         map[int64]struct{}
+	clearedcreated_by         bool
+	gitlab_connections        map[uuid.UUID]struct{}
+	removedgitlab_connections map[uuid.UUID]struct{}
+	clearedgitlab_connections bool
+	groups                    map[uuid.UUID]struct{}
+	removedgroups             map[uuid.UUID]struct{}
+	clearedgroups             bool
+	teams                     map[uuid.UUID]struct{}
+	removedteams              map[uuid.UUID]struct{}
+	clearedteams              bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -20596,6 +26313,60 @@ func (m *UserMutation) ResetCreatedBy() {
 	m.removedcreated_by = nil
 }
 
+// AddGitlabConnectionIDs adds the "gitlab_connections" edge to the GitlabConnection entity by ids.
+func (m *UserMutation) AddGitlabConnectionIDs(ids ...uuid.UUID) {
+	if m.gitlab_connections == nil {
+		m.gitlab_connections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.gitlab_connections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGitlabConnections clears the "gitlab_connections" edge to the GitlabConnection entity.
+func (m *UserMutation) ClearGitlabConnections() {
+	m.clearedgitlab_connections = true
+}
+
+// GitlabConnectionsCleared reports if the "gitlab_connections" edge to the GitlabConnection entity was cleared.
+func (m *UserMutation) GitlabConnectionsCleared() bool {
+	return m.clearedgitlab_connections
+}
+
+// RemoveGitlabConnectionIDs removes the "gitlab_connections" edge to the GitlabConnection entity by IDs.
+func (m *UserMutation) RemoveGitlabConnectionIDs(ids ...uuid.UUID) {
+	if m.removedgitlab_connections == nil {
+		m.removedgitlab_connections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.gitlab_connections, ids[i])
+		m.removedgitlab_connections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGitlabConnections returns the removed IDs of the "gitlab_connections" edge to the GitlabConnection entity.
+func (m *UserMutation) RemovedGitlabConnectionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgitlab_connections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GitlabConnectionsIDs returns the "gitlab_connections" edge IDs in the mutation.
+func (m *UserMutation) GitlabConnectionsIDs() (ids []uuid.UUID) {
+	for id := range m.gitlab_connections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGitlabConnections resets all changes to the "gitlab_connections" edge.
+func (m *UserMutation) ResetGitlabConnections() {
+	m.gitlab_connections = nil
+	m.clearedgitlab_connections = false
+	m.removedgitlab_connections = nil
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by ids.
 func (m *UserMutation) AddGroupIDs(ids ...uuid.UUID) {
 	if m.groups == nil {
@@ -20888,7 +26659,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.oauth2_tokens != nil {
 		edges = append(edges, user.EdgeOauth2Tokens)
 	}
@@ -20898,6 +26669,9 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.created_by != nil {
 		edges = append(edges, user.EdgeCreatedBy)
 	}
+	if m.gitlab_connections != nil {
+		edges = append(edges, user.EdgeGitlabConnections)
+	}
 	if m.groups != nil {
 		edges = append(edges, user.EdgeGroups)
 	}
@@ -20929,6 +26703,12 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeGitlabConnections:
+		ids := make([]ent.Value, 0, len(m.gitlab_connections))
+		for id := range m.gitlab_connections {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeGroups:
 		ids := make([]ent.Value, 0, len(m.groups))
 		for id := range m.groups {
@@ -20947,7 +26727,7 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.removedoauth2_tokens != nil {
 		edges = append(edges, user.EdgeOauth2Tokens)
 	}
@@ -20957,6 +26737,9 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedcreated_by != nil {
 		edges = append(edges, user.EdgeCreatedBy)
 	}
+	if m.removedgitlab_connections != nil {
+		edges = append(edges, user.EdgeGitlabConnections)
+	}
 	if m.removedgroups != nil {
 		edges = append(edges, user.EdgeGroups)
 	}
@@ -20988,6 +26771,12 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeGitlabConnections:
+		ids := make([]ent.Value, 0, len(m.removedgitlab_connections))
+		for id := range m.removedgitlab_connections {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeGroups:
 		ids := make([]ent.Value, 0, len(m.removedgroups))
 		for id := range m.removedgroups {
@@ -21006,7 +26795,7 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 6)
 	if m.clearedoauth2_tokens {
 		edges = append(edges, user.EdgeOauth2Tokens)
 	}
@@ -21016,6 +26805,9 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedcreated_by {
 		edges = append(edges, user.EdgeCreatedBy)
 	}
+	if m.clearedgitlab_connections {
+		edges = append(edges, user.EdgeGitlabConnections)
+	}
 	if m.clearedgroups {
 		edges = append(edges, user.EdgeGroups)
 	}
@@ -21035,6 +26827,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedoauth2_codes
 	case user.EdgeCreatedBy:
 		return m.clearedcreated_by
+	case user.EdgeGitlabConnections:
+		return m.clearedgitlab_connections
 	case user.EdgeGroups:
 		return m.clearedgroups
 	case user.EdgeTeams:
@@ -21064,6 +26858,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeCreatedBy:
 		m.ResetCreatedBy()
 		return nil
+	case user.EdgeGitlabConnections:
+		m.ResetGitlabConnections()
+		return nil
 	case user.EdgeGroups:
 		m.ResetGroups()
 		return nil
@@ -21,6 +21,9 @@ type GithubApp func(*sql.Selector)
 // GithubInstallation is the predicate function for githubinstallation builders.
 type GithubInstallation func(*sql.Selector)
 
+// GitlabConnection is the predicate function for gitlabconnection builders.
+type GitlabConnection func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
@@ -48,6 +51,9 @@ type Registry func(*sql.Selector)
 // S3 is the predicate function for s3 builders.
 type S3 func(*sql.Selector)
 
+// SBOMPackage is the predicate function for sbompackage builders.
+type SBOMPackage func(*sql.Selector)
+
 // Service is the predicate function for service builders.
 type Service func(*sql.Selector)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
 	"github.com/unbindapp/unbind-api/ent/oauth2token"
@@ -18,6 +19,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/pvcmetadata"
 	"github.com/unbindapp/unbind-api/ent/registry"
 	"github.com/unbindapp/unbind-api/ent/s3"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -52,9 +54,13 @@ func init() {
 	// deployment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	deployment.UpdateDefaultUpdatedAt = deploymentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// deploymentDescAttempts is the schema descriptor for attempts field.
-	deploymentDescAttempts := deploymentFields[13].Descriptor()
+	deploymentDescAttempts := deploymentFields[15].Descriptor()
 	// deployment.DefaultAttempts holds the default value on creation for the attempts field.
 	deployment.DefaultAttempts = deploymentDescAttempts.Default.(int)
+	// deploymentDescGithubCheckConcluded is the schema descriptor for github_check_concluded field.
+	deploymentDescGithubCheckConcluded := deploymentFields[35].Descriptor()
+	// deployment.DefaultGithubCheckConcluded holds the default value on creation for the github_check_concluded field.
+	deployment.DefaultGithubCheckConcluded = deploymentDescGithubCheckConcluded.Default.(bool)
 	// deploymentDescID is the schema descriptor for id field.
 	deploymentDescID := deploymentMixinFields0[0].Descriptor()
 	// deployment.DefaultID holds the default value on creation for the id field.
@@ -84,6 +90,10 @@ func init() {
 	environmentDescActive := environmentFields[3].Descriptor()
 	// environment.DefaultActive holds the default value on creation for the active field.
 	environment.DefaultActive = environmentDescActive.Default.(bool)
+	// environmentDescProtected is the schema descriptor for protected field.
+	environmentDescProtected := environmentFields[6].Descriptor()
+	// environment.DefaultProtected holds the default value on creation for the protected field.
+	environment.DefaultProtected = environmentDescProtected.Default.(bool)
 	// environmentDescID is the schema descriptor for id field.
 	environmentDescID := environmentMixinFields0[0].Descriptor()
 	// environment.DefaultID holds the default value on creation for the id field.
@@ -146,6 +156,39 @@ func init() {
 	githubinstallationDescID := githubinstallationFields[0].Descriptor()
 	// githubinstallation.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	githubinstallation.IDValidator = githubinstallationDescID.Validators[0].(func(int64) error)
+	gitlabconnectionMixin := schema.GitlabConnection{}.Mixin()
+	gitlabconnectionMixinFields0 := gitlabconnectionMixin[0].Fields()
+	_ = gitlabconnectionMixinFields0
+	gitlabconnectionMixinFields1 := gitlabconnectionMixin[1].Fields()
+	_ = gitlabconnectionMixinFields1
+	gitlabconnectionFields := schema.GitlabConnection{}.Fields()
+	_ = gitlabconnectionFields
+	// gitlabconnectionDescCreatedAt is the schema descriptor for created_at field.
+	gitlabconnectionDescCreatedAt := gitlabconnectionMixinFields1[0].Descriptor()
+	// gitlabconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gitlabconnection.DefaultCreatedAt = gitlabconnectionDescCreatedAt.Default.(func() time.Time)
+	// gitlabconnectionDescUpdatedAt is the schema descriptor for updated_at field.
+	gitlabconnectionDescUpdatedAt := gitlabconnectionMixinFields1[1].Descriptor()
+	// gitlabconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	gitlabconnection.DefaultUpdatedAt = gitlabconnectionDescUpdatedAt.Default.(func() time.Time)
+	// gitlabconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	gitlabconnection.UpdateDefaultUpdatedAt = gitlabconnectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// gitlabconnectionDescName is the schema descriptor for name field.
+	gitlabconnectionDescName := gitlabconnectionFields[1].Descriptor()
+	// gitlabconnection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	gitlabconnection.NameValidator = gitlabconnectionDescName.Validators[0].(func(string) error)
+	// gitlabconnectionDescURL is the schema descriptor for url field.
+	gitlabconnectionDescURL := gitlabconnectionFields[2].Descriptor()
+	// gitlabconnection.DefaultURL holds the default value on creation for the url field.
+	gitlabconnection.DefaultURL = gitlabconnectionDescURL.Default.(string)
+	// gitlabconnectionDescAccountUsername is the schema descriptor for account_username field.
+	gitlabconnectionDescAccountUsername := gitlabconnectionFields[5].Descriptor()
+	// gitlabconnection.AccountUsernameValidator is a validator for the "account_username" field. It is called by the builders before save.
+	gitlabconnection.AccountUsernameValidator = gitlabconnectionDescAccountUsername.Validators[0].(func(string) error)
+	// gitlabconnectionDescID is the schema descriptor for id field.
+	gitlabconnectionDescID := gitlabconnectionMixinFields0[0].Descriptor()
+	// gitlabconnection.DefaultID holds the default value on creation for the id field.
+	gitlabconnection.DefaultID = gitlabconnectionDescID.Default.(func() uuid.UUID)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinFields0 := groupMixin[0].Fields()
 	_ = groupMixinFields0
@@ -346,6 +389,31 @@ func init() {
 	s3DescID := s3MixinFields0[0].Descriptor()
 	// s3.DefaultID holds the default value on creation for the id field.
 	s3.DefaultID = s3DescID.Default.(func() uuid.UUID)
+	sbompackageMixin := schema.SBOMPackage{}.Mixin()
+	sbompackageMixinFields0 := sbompackageMixin[0].Fields()
+	_ = sbompackageMixinFields0
+	sbompackageMixinFields1 := sbompackageMixin[1].Fields()
+	_ = sbompackageMixinFields1
+	sbompackageFields := schema.SBOMPackage{}.Fields()
+	_ = sbompackageFields
+	// sbompackageDescCreatedAt is the schema descriptor for created_at field.
+	sbompackageDescCreatedAt := sbompackageMixinFields1[0].Descriptor()
+	// sbompackage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sbompackage.DefaultCreatedAt = sbompackageDescCreatedAt.Default.(func() time.Time)
+	// sbompackageDescUpdatedAt is the schema descriptor for updated_at field.
+	sbompackageDescUpdatedAt := sbompackageMixinFields1[1].Descriptor()
+	// sbompackage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sbompackage.DefaultUpdatedAt = sbompackageDescUpdatedAt.Default.(func() time.Time)
+	// sbompackage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sbompackage.UpdateDefaultUpdatedAt = sbompackageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sbompackageDescVersion is the schema descriptor for version field.
+	sbompackageDescVersion := sbompackageFields[2].Descriptor()
+	// sbompackage.DefaultVersion holds the default value on creation for the version field.
+	sbompackage.DefaultVersion = sbompackageDescVersion.Default.(string)
+	// sbompackageDescID is the schema descriptor for id field.
+	sbompackageDescID := sbompackageMixinFields0[0].Descriptor()
+	// sbompackage.DefaultID holds the default value on creation for the id field.
+	sbompackage.DefaultID = sbompackageDescID.Default.(func() uuid.UUID)
 	serviceMixin := schema.Service{}.Mixin()
 	serviceMixinFields0 := serviceMixin[0].Fields()
 	_ = serviceMixinFields0
@@ -389,23 +457,39 @@ func init() {
 	// serviceconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	serviceconfig.UpdateDefaultUpdatedAt = serviceconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// serviceconfigDescReplicas is the schema descriptor for replicas field.
-	serviceconfigDescReplicas := serviceconfigFields[11].Descriptor()
+	serviceconfigDescReplicas := serviceconfigFields[20].Descriptor()
 	// serviceconfig.DefaultReplicas holds the default value on creation for the replicas field.
 	serviceconfig.DefaultReplicas = serviceconfigDescReplicas.Default.(int32)
 	// serviceconfigDescAutoDeploy is the schema descriptor for auto_deploy field.
-	serviceconfigDescAutoDeploy := serviceconfigFields[12].Descriptor()
+	serviceconfigDescAutoDeploy := serviceconfigFields[21].Descriptor()
 	// serviceconfig.DefaultAutoDeploy holds the default value on creation for the auto_deploy field.
 	serviceconfig.DefaultAutoDeploy = serviceconfigDescAutoDeploy.Default.(bool)
+	// serviceconfigDescAutoRollback is the schema descriptor for auto_rollback field.
+	serviceconfigDescAutoRollback := serviceconfigFields[22].Descriptor()
+	// serviceconfig.DefaultAutoRollback holds the default value on creation for the auto_rollback field.
+	serviceconfig.DefaultAutoRollback = serviceconfigDescAutoRollback.Default.(bool)
+	// serviceconfigDescPrPreviews is the schema descriptor for pr_previews field.
+	serviceconfigDescPrPreviews := serviceconfigFields[23].Descriptor()
+	// serviceconfig.DefaultPrPreviews holds the default value on creation for the pr_previews field.
+	serviceconfig.DefaultPrPreviews = serviceconfigDescPrPreviews.Default.(bool)
+	// serviceconfigDescCanaryWeight is the schema descriptor for canary_weight field.
+	serviceconfigDescCanaryWeight := serviceconfigFields[29].Descriptor()
+	// serviceconfig.DefaultCanaryWeight holds the default value on creation for the canary_weight field.
+	serviceconfig.DefaultCanaryWeight = serviceconfigDescCanaryWeight.Default.(int)
 	// serviceconfigDescIsPublic is the schema descriptor for is_public field.
-	serviceconfigDescIsPublic := serviceconfigFields[16].Descriptor()
+	serviceconfigDescIsPublic := serviceconfigFields[30].Descriptor()
 	// serviceconfig.DefaultIsPublic holds the default value on creation for the is_public field.
 	serviceconfig.DefaultIsPublic = serviceconfigDescIsPublic.Default.(bool)
+	// serviceconfigDescImageAutoUpdate is the schema descriptor for image_auto_update field.
+	serviceconfigDescImageAutoUpdate := serviceconfigFields[32].Descriptor()
+	// serviceconfig.DefaultImageAutoUpdate holds the default value on creation for the image_auto_update field.
+	serviceconfig.DefaultImageAutoUpdate = serviceconfigDescImageAutoUpdate.Default.(bool)
 	// serviceconfigDescBackupSchedule is the schema descriptor for backup_schedule field.
-	serviceconfigDescBackupSchedule := serviceconfigFields[22].Descriptor()
+	serviceconfigDescBackupSchedule := serviceconfigFields[38].Descriptor()
 	// serviceconfig.DefaultBackupSchedule holds the default value on creation for the backup_schedule field.
 	serviceconfig.DefaultBackupSchedule = serviceconfigDescBackupSchedule.Default.(string)
 	// serviceconfigDescBackupRetentionCount is the schema descriptor for backup_retention_count field.
-	serviceconfigDescBackupRetentionCount := serviceconfigFields[23].Descriptor()
+	serviceconfigDescBackupRetentionCount := serviceconfigFields[39].Descriptor()
 	// serviceconfig.DefaultBackupRetentionCount holds the default value on creation for the backup_retention_count field.
 	serviceconfig.DefaultBackupRetentionCount = serviceconfigDescBackupRetentionCount.Default.(int)
 	// serviceconfigDescID is the schema descriptor for id field.
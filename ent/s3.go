@@ -48,9 +48,11 @@ type S3Edges struct {
 	Team *Team `json:"team,omitempty"`
 	// ServiceBackupSource holds the value of the service_backup_source edge.
 	ServiceBackupSource []*ServiceConfig `json:"service_backup_source,omitempty"`
+	// ServiceUploadSource holds the value of the service_upload_source edge.
+	ServiceUploadSource []*ServiceConfig `json:"service_upload_source,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TeamOrErr returns the Team value or an error if the edge
@@ -73,6 +75,15 @@ func (e S3Edges) ServiceBackupSourceOrErr() ([]*ServiceConfig, error) {
 	return nil, &NotLoadedError{edge: "service_backup_source"}
 }
 
+// ServiceUploadSourceOrErr returns the ServiceUploadSource value or an error if the edge
+// was not loaded in eager-loading.
+func (e S3Edges) ServiceUploadSourceOrErr() ([]*ServiceConfig, error) {
+	if e.loadedTypes[2] {
+		return e.ServiceUploadSource, nil
+	}
+	return nil, &NotLoadedError{edge: "service_upload_source"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*S3) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -178,6 +189,11 @@ func (s *S3) QueryServiceBackupSource() *ServiceConfigQuery {
 	return NewS3Client(s.config).QueryServiceBackupSource(s)
 }
 
+// QueryServiceUploadSource queries the "service_upload_source" edge of the S3 entity.
+func (s *S3) QueryServiceUploadSource() *ServiceConfigQuery {
+	return NewS3Client(s.config).QueryServiceUploadSource(s)
+}
+
 // Update returns a builder for updating this S3.
 // Note that you need to call S3.Unwrap() before calling this method if this S3
 // was returned from a transaction, and the transaction was committed or rolled back.
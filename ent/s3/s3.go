@@ -35,6 +35,8 @@ const (
 	EdgeTeam = "team"
 	// EdgeServiceBackupSource holds the string denoting the service_backup_source edge name in mutations.
 	EdgeServiceBackupSource = "service_backup_source"
+	// EdgeServiceUploadSource holds the string denoting the service_upload_source edge name in mutations.
+	EdgeServiceUploadSource = "service_upload_source"
 	// Table holds the table name of the s3 in the database.
 	Table = "s3_sources"
 	// TeamTable is the table that holds the team relation/edge.
@@ -51,6 +53,13 @@ const (
 	ServiceBackupSourceInverseTable = "service_configs"
 	// ServiceBackupSourceColumn is the table column denoting the service_backup_source relation/edge.
 	ServiceBackupSourceColumn = "s3_backup_source_id"
+	// ServiceUploadSourceTable is the table that holds the service_upload_source relation/edge.
+	ServiceUploadSourceTable = "service_configs"
+	// ServiceUploadSourceInverseTable is the table name for the ServiceConfig entity.
+	// It exists in this package in order to avoid circular dependency with the "serviceconfig" package.
+	ServiceUploadSourceInverseTable = "service_configs"
+	// ServiceUploadSourceColumn is the table column denoting the service_upload_source relation/edge.
+	ServiceUploadSourceColumn = "upload_s3_source_id"
 )
 
 // Columns holds all SQL columns for s3 fields.
@@ -159,6 +168,20 @@ func ByServiceBackupSource(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newServiceBackupSourceStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByServiceUploadSourceCount orders the results by service_upload_source count.
+func ByServiceUploadSourceCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServiceUploadSourceStep(), opts...)
+	}
+}
+
+// ByServiceUploadSource orders the results by service_upload_source terms.
+func ByServiceUploadSource(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServiceUploadSourceStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeamStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -173,3 +196,10 @@ func newServiceBackupSourceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ServiceBackupSourceTable, ServiceBackupSourceColumn),
 	)
 }
+func newServiceUploadSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServiceUploadSourceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServiceUploadSourceTable, ServiceUploadSourceColumn),
+	)
+}
@@ -512,6 +512,29 @@ func HasServiceBackupSourceWith(preds ...predicate.ServiceConfig) predicate.S3 {
 	})
 }
 
+// HasServiceUploadSource applies the HasEdge predicate on the "service_upload_source" edge.
+func HasServiceUploadSource() predicate.S3 {
+	return predicate.S3(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServiceUploadSourceTable, ServiceUploadSourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceUploadSourceWith applies the HasEdge predicate on the "service_upload_source" edge with a given conditions (other predicates).
+func HasServiceUploadSourceWith(preds ...predicate.ServiceConfig) predicate.S3 {
+	return predicate.S3(func(s *sql.Selector) {
+		step := newServiceUploadSourceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.S3) predicate.S3 {
 	return predicate.S3(sql.AndPredicates(predicates...))
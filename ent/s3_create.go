@@ -132,6 +132,21 @@ func (s *S3Create) AddServiceBackupSource(v ...*ServiceConfig) *S3Create {
 	return s.AddServiceBackupSourceIDs(ids...)
 }
 
+// AddServiceUploadSourceIDs adds the "service_upload_source" edge to the ServiceConfig entity by IDs.
+func (s *S3Create) AddServiceUploadSourceIDs(ids ...uuid.UUID) *S3Create {
+	s.mutation.AddServiceUploadSourceIDs(ids...)
+	return s
+}
+
+// AddServiceUploadSource adds the "service_upload_source" edges to the ServiceConfig entity.
+func (s *S3Create) AddServiceUploadSource(v ...*ServiceConfig) *S3Create {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return s.AddServiceUploadSourceIDs(ids...)
+}
+
 // Mutation returns the S3Mutation object of the builder.
 func (s *S3Create) Mutation() *S3Mutation {
 	return s.mutation
@@ -316,6 +331,22 @@ func (s *S3Create) createSpec() (*S3, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := s.mutation.ServiceUploadSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
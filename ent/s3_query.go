@@ -28,6 +28,7 @@ type S3Query struct {
 	predicates              []predicate.S3
 	withTeam                *TeamQuery
 	withServiceBackupSource *ServiceConfigQuery
+	withServiceUploadSource *ServiceConfigQuery
 	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -109,6 +110,28 @@ func (s *S3Query) QueryServiceBackupSource() *ServiceConfigQuery {
 	return query
 }
 
+// QueryServiceUploadSource chains the current query on the "service_upload_source" edge.
+func (s *S3Query) QueryServiceUploadSource() *ServiceConfigQuery {
+	query := (&ServiceConfigClient{config: s.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := s.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := s.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(s3.Table, s3.FieldID, selector),
+			sqlgraph.To(serviceconfig.Table, serviceconfig.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, s3.ServiceUploadSourceTable, s3.ServiceUploadSourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(s.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first S3 entity from the query.
 // Returns a *NotFoundError when no S3 was found.
 func (s *S3Query) First(ctx context.Context) (*S3, error) {
@@ -303,6 +326,7 @@ func (s *S3Query) Clone() *S3Query {
 		predicates:              append([]predicate.S3{}, s.predicates...),
 		withTeam:                s.withTeam.Clone(),
 		withServiceBackupSource: s.withServiceBackupSource.Clone(),
+		withServiceUploadSource: s.withServiceUploadSource.Clone(),
 		// clone intermediate query.
 		sql:       s.sql.Clone(),
 		path:      s.path,
@@ -332,6 +356,17 @@ func (s *S3Query) WithServiceBackupSource(opts ...func(*ServiceConfigQuery)) *S3
 	return s
 }
 
+// WithServiceUploadSource tells the query-builder to eager-load the nodes that are connected to
+// the "service_upload_source" edge. The optional arguments are used to configure the query builder of the edge.
+func (s *S3Query) WithServiceUploadSource(opts ...func(*ServiceConfigQuery)) *S3Query {
+	query := (&ServiceConfigClient{config: s.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	s.withServiceUploadSource = query
+	return s
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +445,10 @@ func (s *S3Query) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S3, error)
 	var (
 		nodes       = []*S3{}
 		_spec       = s.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			s.withTeam != nil,
 			s.withServiceBackupSource != nil,
+			s.withServiceUploadSource != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -449,6 +485,13 @@ func (s *S3Query) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S3, error)
 			return nil, err
 		}
 	}
+	if query := s.withServiceUploadSource; query != nil {
+		if err := s.loadServiceUploadSource(ctx, query, nodes,
+			func(n *S3) { n.Edges.ServiceUploadSource = []*ServiceConfig{} },
+			func(n *S3, e *ServiceConfig) { n.Edges.ServiceUploadSource = append(n.Edges.ServiceUploadSource, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -514,6 +557,39 @@ func (s *S3Query) loadServiceBackupSource(ctx context.Context, query *ServiceCon
 	}
 	return nil
 }
+func (s *S3Query) loadServiceUploadSource(ctx context.Context, query *ServiceConfigQuery, nodes []*S3, init func(*S3), assign func(*S3, *ServiceConfig)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*S3)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(serviceconfig.FieldUploadS3SourceID)
+	}
+	query.Where(predicate.ServiceConfig(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(s3.ServiceUploadSourceColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UploadS3SourceID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "upload_s3_source_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "upload_s3_source_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (s *S3Query) sqlCount(ctx context.Context) (int, error) {
 	_spec := s.querySpec()
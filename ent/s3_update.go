@@ -142,6 +142,21 @@ func (s *S3Update) AddServiceBackupSource(v ...*ServiceConfig) *S3Update {
 	return s.AddServiceBackupSourceIDs(ids...)
 }
 
+// AddServiceUploadSourceIDs adds the "service_upload_source" edge to the ServiceConfig entity by IDs.
+func (s *S3Update) AddServiceUploadSourceIDs(ids ...uuid.UUID) *S3Update {
+	s.mutation.AddServiceUploadSourceIDs(ids...)
+	return s
+}
+
+// AddServiceUploadSource adds the "service_upload_source" edges to the ServiceConfig entity.
+func (s *S3Update) AddServiceUploadSource(v ...*ServiceConfig) *S3Update {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return s.AddServiceUploadSourceIDs(ids...)
+}
+
 // Mutation returns the S3Mutation object of the builder.
 func (s *S3Update) Mutation() *S3Mutation {
 	return s.mutation
@@ -174,6 +189,27 @@ func (s *S3Update) RemoveServiceBackupSource(v ...*ServiceConfig) *S3Update {
 	return s.RemoveServiceBackupSourceIDs(ids...)
 }
 
+// ClearServiceUploadSource clears all "service_upload_source" edges to the ServiceConfig entity.
+func (s *S3Update) ClearServiceUploadSource() *S3Update {
+	s.mutation.ClearServiceUploadSource()
+	return s
+}
+
+// RemoveServiceUploadSourceIDs removes the "service_upload_source" edge to ServiceConfig entities by IDs.
+func (s *S3Update) RemoveServiceUploadSourceIDs(ids ...uuid.UUID) *S3Update {
+	s.mutation.RemoveServiceUploadSourceIDs(ids...)
+	return s
+}
+
+// RemoveServiceUploadSource removes "service_upload_source" edges to ServiceConfig entities.
+func (s *S3Update) RemoveServiceUploadSource(v ...*ServiceConfig) *S3Update {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return s.RemoveServiceUploadSourceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (s *S3Update) Save(ctx context.Context) (int, error) {
 	s.defaults()
@@ -333,6 +369,51 @@ func (s *S3Update) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if s.mutation.ServiceUploadSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := s.mutation.RemovedServiceUploadSourceIDs(); len(nodes) > 0 && !s.mutation.ServiceUploadSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := s.mutation.ServiceUploadSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(s.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, s.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -465,6 +546,21 @@ func (so *S3UpdateOne) AddServiceBackupSource(s ...*ServiceConfig) *S3UpdateOne
 	return so.AddServiceBackupSourceIDs(ids...)
 }
 
+// AddServiceUploadSourceIDs adds the "service_upload_source" edge to the ServiceConfig entity by IDs.
+func (so *S3UpdateOne) AddServiceUploadSourceIDs(ids ...uuid.UUID) *S3UpdateOne {
+	so.mutation.AddServiceUploadSourceIDs(ids...)
+	return so
+}
+
+// AddServiceUploadSource adds the "service_upload_source" edges to the ServiceConfig entity.
+func (so *S3UpdateOne) AddServiceUploadSource(v ...*ServiceConfig) *S3UpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return so.AddServiceUploadSourceIDs(ids...)
+}
+
 // Mutation returns the S3Mutation object of the builder.
 func (so *S3UpdateOne) Mutation() *S3Mutation {
 	return so.mutation
@@ -497,6 +593,27 @@ func (so *S3UpdateOne) RemoveServiceBackupSource(s ...*ServiceConfig) *S3UpdateO
 	return so.RemoveServiceBackupSourceIDs(ids...)
 }
 
+// ClearServiceUploadSource clears all "service_upload_source" edges to the ServiceConfig entity.
+func (so *S3UpdateOne) ClearServiceUploadSource() *S3UpdateOne {
+	so.mutation.ClearServiceUploadSource()
+	return so
+}
+
+// RemoveServiceUploadSourceIDs removes the "service_upload_source" edge to ServiceConfig entities by IDs.
+func (so *S3UpdateOne) RemoveServiceUploadSourceIDs(ids ...uuid.UUID) *S3UpdateOne {
+	so.mutation.RemoveServiceUploadSourceIDs(ids...)
+	return so
+}
+
+// RemoveServiceUploadSource removes "service_upload_source" edges to ServiceConfig entities.
+func (so *S3UpdateOne) RemoveServiceUploadSource(v ...*ServiceConfig) *S3UpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return so.RemoveServiceUploadSourceIDs(ids...)
+}
+
 // Where appends a list predicates to the S3Update builder.
 func (so *S3UpdateOne) Where(ps ...predicate.S3) *S3UpdateOne {
 	so.mutation.Where(ps...)
@@ -686,6 +803,51 @@ func (so *S3UpdateOne) sqlSave(ctx context.Context) (_node *S3, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if so.mutation.ServiceUploadSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := so.mutation.RemovedServiceUploadSourceIDs(); len(nodes) > 0 && !so.mutation.ServiceUploadSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := so.mutation.ServiceUploadSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   s3.ServiceUploadSourceTable,
+			Columns: []string{s3.ServiceUploadSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceconfig.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(so.modifiers...)
 	_node = &S3{config: so.config}
 	_spec.Assign = _node.assignValues
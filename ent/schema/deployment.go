@@ -19,12 +19,19 @@ import (
 type DeploymentStatus string
 
 const (
-	DeploymentStatusBuildPending   DeploymentStatus = "build-pending"
-	DeploymentStatusBuildQueued    DeploymentStatus = "build-queued"
-	DeploymentStatusBuildRunning   DeploymentStatus = "build-running"
-	DeploymentStatusBuildSucceeded DeploymentStatus = "build-succeeded"
-	DeploymentStatusBuildCancelled DeploymentStatus = "build-cancelled"
-	DeploymentStatusBuildFailed    DeploymentStatus = "build-failed"
+	DeploymentStatusAwaitingApproval DeploymentStatus = "awaiting-approval" // Waiting for an admin to approve (protected environments)
+	DeploymentStatusScheduled        DeploymentStatus = "scheduled"         // Waiting for its scheduled time
+	DeploymentStatusBuildPending     DeploymentStatus = "build-pending"
+	DeploymentStatusBuildQueued      DeploymentStatus = "build-queued"
+	DeploymentStatusBuildRunning     DeploymentStatus = "build-running"
+	DeploymentStatusBuildSucceeded   DeploymentStatus = "build-succeeded"
+	DeploymentStatusBuildCancelled   DeploymentStatus = "build-cancelled"
+	DeploymentStatusBuildFailed      DeploymentStatus = "build-failed"
+	DeploymentStatusSkipped          DeploymentStatus = "skipped" // Push that asked not to be deployed, never built
+	// * Blue-green and canary rollouts
+	DeploymentStatusStaged    DeploymentStatus = "staged"    // Running next to the current deployment, waiting to be promoted
+	DeploymentStatusPromoting DeploymentStatus = "promoting" // Serving all traffic while the service is moved over to it
+	DeploymentStatusAborted   DeploymentStatus = "aborted"   // Staged, then torn down without being promoted
 	// * POD/Instance related
 	DeploymentStatusActive      DeploymentStatus = "active"       // Running and healthy
 	DeploymentStatusLaunching   DeploymentStatus = "launching"    // Waiting for resources or other conditions
@@ -34,12 +41,18 @@ const (
 )
 
 var allDeploymentStatuses = []DeploymentStatus{
+	DeploymentStatusAwaitingApproval,
+	DeploymentStatusScheduled,
 	DeploymentStatusBuildPending,
 	DeploymentStatusBuildQueued,
 	DeploymentStatusBuildRunning,
 	DeploymentStatusBuildSucceeded,
 	DeploymentStatusBuildCancelled,
 	DeploymentStatusBuildFailed,
+	DeploymentStatusSkipped,
+	DeploymentStatusStaged,
+	DeploymentStatusPromoting,
+	DeploymentStatusAborted,
 	DeploymentStatusActive,
 	DeploymentStatusLaunching,
 	DeploymentStatusLaunchError,
@@ -75,11 +88,20 @@ type DeploymentSource string
 const (
 	DeploymentSourceManual DeploymentSource = "manual"
 	DeploymentSourceGit    DeploymentSource = "git"
+	// Triggered by external CI through the service's deploy hook URL
+	DeploymentSourceDeployHook DeploymentSource = "deploy-hook"
+	// Triggered by the image tag being re-pushed with a new digest
+	DeploymentSourceImageUpdate DeploymentSource = "image-update"
+	// Built from an uploaded source archive
+	DeploymentSourceUpload DeploymentSource = "upload"
 )
 
 var allDeploymentSources = []DeploymentSource{
 	DeploymentSourceManual,
 	DeploymentSourceGit,
+	DeploymentSourceDeployHook,
+	DeploymentSourceImageUpdate,
+	DeploymentSourceUpload,
 }
 
 // Values provides list valid values for Enum.
@@ -110,6 +132,14 @@ type GitCommitter struct {
 	AvatarURL string `json:"avatar_url"`
 }
 
+// Outcome of building one platform of a multi-platform image
+type PlatformBuild struct {
+	Platform        string  `json:"platform" doc:"e.g. linux/arm64"`
+	Succeeded       bool    `json:"succeeded"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty" required:"false"`
+}
+
 // Deployment holds the schema definition for the Deployment entity.
 type Deployment struct {
 	ent.Schema
@@ -144,6 +174,14 @@ func (Deployment) Fields() []ent.Field {
 			Comment("The git branch used for the deployment, if applicable"),
 		field.JSON("commit_author", &GitCommitter{}).
 			Optional(),
+		field.String("source_archive").
+			Optional().
+			Nillable().
+			Comment("Object key of the uploaded source archive the deployment was built from, if applicable"),
+		field.Time("scheduled_at").
+			Optional().
+			Nillable().
+			Comment("When a scheduled deployment is due to be queued"),
 		field.Time("queued_at").
 			Optional().
 			Nillable(),
@@ -168,6 +206,9 @@ func (Deployment) Fields() []ent.Field {
 		field.JSON("resource_definition", &v1.Service{}).
 			Optional().
 			Comment("The Kubernetes resource definition for the deployment"),
+		field.Strings("env_keys").
+			Optional().
+			Comment("Names of the variables the deployment was rolled out with, values are never stored"),
 		// Build-related fields to preserve for redeployment
 		field.Enum("builder").GoType(ServiceBuilder("")).
 			Comment("Builder used for this deployment"),
@@ -191,6 +232,46 @@ func (Deployment) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Build context path used for this deployment (docker builder only)"),
+		field.String("docker_builder_target").
+			Optional().
+			Nillable().
+			Comment("Dockerfile stage built for this deployment (docker builder only)"),
+		field.JSON("docker_builder_build_args", map[string]string{}).
+			Optional().
+			Comment("Build args used for this deployment (docker builder only)"),
+		field.JSON("docker_builder_build_contexts", map[string]string{}).
+			Optional().
+			Comment("Named additional build contexts used for this deployment (docker builder only)"),
+		field.String("buildpacks_builder_image").
+			Optional().
+			Nillable().
+			Comment("CNB builder image used for this deployment (buildpacks builder only)"),
+		field.Strings("platforms").
+			Optional().
+			Comment("Platforms the image was built for, empty for the builder's own platform"),
+		field.JSON("platform_builds", []PlatformBuild{}).
+			Optional().
+			Comment("Build time and failure of each platform, for multi-platform builds"),
+		field.String("rollback_reason").
+			Optional().
+			Nillable().
+			Comment("Why this deployment was created as an automatic rollback, if it was"),
+		field.String("skip_reason").
+			Optional().
+			Nillable().
+			Comment("Why a push didn't trigger a build, e.g. a skip marker in the commit message"),
+		field.Int64("github_check_run_id").
+			Optional().
+			Nillable().
+			Comment("Check run reporting this deployment on its commit"),
+		field.Enum("github_check_status").
+			GoType(DeploymentStatus("")).
+			Optional().
+			Nillable().
+			Comment("Status last reported to the check run"),
+		field.Bool("github_check_concluded").
+			Default(false).
+			Comment("Whether the check run has its final result"),
 	}
 }
 
@@ -199,6 +280,10 @@ func (Deployment) Edges() []ent.Edge {
 	return []ent.Edge{
 		// M2O edge to keep track of the service
 		edge.From("service", Service.Type).Ref("deployments").Field("service_id").Unique().Required(),
+		// O2M edge to the packages in the built image's SBOM
+		edge.To("sbom_packages", SBOMPackage.Type).Annotations(
+			entsql.Annotation{OnDelete: entsql.Cascade},
+		),
 	}
 }
 
@@ -1,13 +1,18 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
 	"github.com/unbindapp/unbind-api/ent/schema/mixin"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
 )
 
 // Environment holds the schema definition for the Environment entity.
@@ -23,6 +28,67 @@ func (Environment) Mixin() []ent.Mixin {
 	}
 }
 
+// FreezeWindow holds deployments, either recurring on a cron schedule or once between two times
+type FreezeWindow struct {
+	Name            string     `json:"name,omitempty" required:"false" doc:"Why deployments are frozen, e.g. 'Holidays'"`
+	Schedule        *string    `json:"schedule,omitempty" required:"false" doc:"Cron expression for when a recurring freeze starts (UTC), e.g. '0 18 * * 5'"`
+	DurationMinutes int        `json:"duration_minutes,omitempty" required:"false" doc:"How long a recurring freeze lasts"`
+	StartsAt        *time.Time `json:"starts_at,omitempty" required:"false" doc:"Start of a one-off freeze"`
+	EndsAt          *time.Time `json:"ends_at,omitempty" required:"false" doc:"End of a one-off freeze"`
+}
+
+func (self *FreezeWindow) Validate() error {
+	if self.Schedule != nil {
+		if err := utils.ValidateCronExpression(*self.Schedule); err != nil {
+			return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+		}
+		// e.g. February 30th, the window would never start
+		if schedule, err := cron.ParseStandard(*self.Schedule); err != nil || schedule.Next(time.Now()).IsZero() {
+			return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "schedule never runs")
+		}
+		if self.DurationMinutes <= 0 {
+			return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "duration_minutes must be set for recurring freeze windows")
+		}
+		return nil
+	}
+	if self.StartsAt == nil || self.EndsAt == nil || !self.EndsAt.After(*self.StartsAt) {
+		return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "freeze windows need a schedule, or starts_at before ends_at")
+	}
+	return nil
+}
+
+// ActiveUntil returns when the window ends if it's active at the given time, otherwise nil
+func (self *FreezeWindow) ActiveUntil(now time.Time) *time.Time {
+	if self.Schedule != nil {
+		schedule, err := cron.ParseStandard(*self.Schedule)
+		if err != nil || self.DurationMinutes <= 0 {
+			return nil
+		}
+		// The freeze is active if it last started within its duration
+		duration := time.Duration(self.DurationMinutes) * time.Minute
+		start := schedule.Next(now.UTC().Add(-duration))
+		if start.IsZero() || start.After(now) {
+			return nil
+		}
+		return utils.ToPtr(start.Add(duration))
+	}
+	if self.StartsAt != nil && self.EndsAt != nil && !now.Before(*self.StartsAt) && now.Before(*self.EndsAt) {
+		return self.EndsAt
+	}
+	return nil
+}
+
+// FrozenUntil returns when the latest active freeze window ends, nil if deployments aren't frozen
+func FrozenUntil(windows []FreezeWindow, now time.Time) *time.Time {
+	var until *time.Time
+	for _, window := range windows {
+		if end := window.ActiveUntil(now); end != nil && (until == nil || end.After(*until)) {
+			until = end
+		}
+	}
+	return until
+}
+
 // Fields of the Environment.
 func (Environment) Fields() []ent.Field {
 	return []ent.Field{
@@ -32,6 +98,12 @@ func (Environment) Fields() []ent.Field {
 		field.Bool("active").Default(true),
 		field.UUID("project_id", uuid.UUID{}),
 		field.String("kubernetes_secret").Comment("Kubernetes secret for this environment"),
+		field.Bool("protected").Default(false).Comment("Deployments to protected environments require approval from an environment admin"),
+		field.JSON("freeze_windows", []FreezeWindow{}).Optional().Comment("Windows during which deployments are held"),
+		field.UUID("preview_base_environment_id", uuid.UUID{}).Optional().Nillable().Comment("Environment a pull request preview environment was cloned from"),
+		field.String("preview_repository").Optional().Nillable().Comment("Repository of the pull request a preview environment was created for"),
+		field.Int("preview_pull_request").Optional().Nillable().Comment("Number of the pull request a preview environment was created for"),
+		field.Int64("preview_comment_id").Optional().Nillable().Comment("Pull request comment linking to the preview"),
 	}
 }
 
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent/schema/mixin"
+)
+
+// GitlabConnection holds the schema definition for the GitlabConnection entity.
+type GitlabConnection struct {
+	ent.Schema
+}
+
+// Mixin of the GitlabConnection.
+func (GitlabConnection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.PKMixin{},
+		mixin.TimeMixin{},
+	}
+}
+
+// Fields of the GitlabConnection.
+func (GitlabConnection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("The user that connected this GitLab account."),
+		field.String("name").
+			NotEmpty().
+			Comment("Display name of the connection"),
+		field.String("url").
+			Default("https://gitlab.com").
+			Comment("Base URL of the GitLab instance, e.g. a self-hosted one"),
+		field.Enum("auth_type").
+			Values("oauth", "token").
+			Comment("Whether the connection uses an OAuth application or a personal access token"),
+		// Account
+		field.Int64("account_id").
+			Comment("The GitLab user ID the token belongs to"),
+		field.String("account_username").
+			NotEmpty().
+			Comment("The GitLab username the token belongs to"),
+		// Credentials
+		field.String("access_token").
+			Sensitive().
+			Comment("OAuth access token or personal access token"),
+		field.String("refresh_token").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("OAuth refresh token"),
+		field.Time("token_expires_at").
+			Optional().
+			Nillable().
+			Comment("When the OAuth access token expires"),
+		field.String("client_id").
+			Optional().
+			Nillable().
+			Comment("Application ID of the GitLab OAuth application"),
+		field.String("client_secret").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Secret of the GitLab OAuth application"),
+		field.String("webhook_secret").
+			Sensitive().
+			Comment("Secret token GitLab sends with project webhooks"),
+	}
+}
+
+// Edges of the GitlabConnection.
+func (GitlabConnection) Edges() []ent.Edge {
+	return []ent.Edge{
+		// M2O with users
+		edge.From("users", User.Type).
+			Ref("gitlab_connections").
+			Field("created_by").
+			Required().
+			Unique(),
+		// O2M with services
+		edge.To("services", Service.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
+	}
+}
+
+// Annotations of the GitlabConnection
+func (GitlabConnection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "gitlab_connections",
+		},
+	}
+}
@@ -42,6 +42,8 @@ func (S3) Edges() []ent.Edge {
 		edge.From("team", Team.Type).Ref("s3_sources").Field("team_id").Unique().Required(),
 		// M2O from service_configs
 		edge.To("service_backup_source", ServiceConfig.Type),
+		// M2O from service_configs storing uploaded source archives
+		edge.To("service_upload_source", ServiceConfig.Type),
 	}
 }
 
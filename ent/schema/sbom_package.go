@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent/schema/mixin"
+)
+
+// A package listed in a built image's SBOM
+type ImagePackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Ecosystem from the package URL, e.g. npm, golang or deb
+	Type string `json:"type"`
+	PURL string `json:"purl"`
+}
+
+// SBOMPackage holds the schema definition for the SBOMPackage entity.
+type SBOMPackage struct {
+	ent.Schema
+}
+
+// Mixin of the SBOMPackage.
+func (SBOMPackage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.PKMixin{},
+		mixin.TimeMixin{},
+	}
+}
+
+// Fields of the SBOMPackage.
+func (SBOMPackage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("deployment_id", uuid.UUID{}),
+		field.String("name"),
+		field.String("version").Default(""),
+		field.String("type").Comment("Ecosystem from the package URL, e.g. npm, golang or deb"),
+		field.String("purl").Comment("Package URL identifying the package, e.g. pkg:npm/express@4.19.2"),
+	}
+}
+
+// Edges of the SBOMPackage.
+func (SBOMPackage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deployment", Deployment.Type).
+			Ref("sbom_packages").
+			Field("deployment_id").
+			Unique().
+			Required().
+			Comment("Deployment whose image contains this package"),
+	}
+}
+
+// Indexes of the SBOMPackage.
+func (SBOMPackage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deployment_id", "purl").Unique(),
+		// Finding the deployments that contain a package
+		index.Fields("name", "version"),
+	}
+}
+
+// Annotations of the SBOMPackage
+func (SBOMPackage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "sbom_packages",
+		},
+	}
+}
@@ -19,14 +19,21 @@ type ServiceType string
 
 const (
 	ServiceTypeGithub      ServiceType = "github"
+	ServiceTypeGitlab      ServiceType = "gitlab"
+	ServiceTypeGit         ServiceType = "git"
 	ServiceTypeDockerimage ServiceType = "docker-image"
 	ServiceTypeDatabase    ServiceType = "database"
+	// Built from source archives uploaded through the API, e.g. by a CLI
+	ServiceTypeUpload ServiceType = "upload"
 )
 
 var allServiceTypes = []ServiceType{
 	ServiceTypeGithub,
+	ServiceTypeGitlab,
+	ServiceTypeGit,
 	ServiceTypeDockerimage,
 	ServiceTypeDatabase,
+	ServiceTypeUpload,
 }
 
 // Values provides list valid values for Enum.
@@ -78,6 +85,13 @@ func (Service) Fields() []ent.Field {
 		field.String("database_version").Optional().Nillable().Comment("Version of the database"),
 		// Github
 		field.Int64("github_installation_id").Optional().Nillable().Comment("Optional reference to GitHub installation"),
+		// Gitlab
+		field.UUID("gitlab_connection_id", uuid.UUID{}).Optional().Nillable().Comment("Optional reference to GitLab connection"),
+		// Git (any host over SSH)
+		field.String("git_url").Optional().Nillable().Comment("SSH clone URL of a generic git repository"),
+		field.String("git_deploy_public_key").Optional().Nillable().Comment("Public half of the service's deploy key, in authorized_keys format"),
+		field.String("git_ssh_host_key").Optional().Nillable().Comment("Host key of the git server, pinned when the service is created"),
+		field.String("git_webhook_secret").Optional().Nillable().Sensitive().Comment("Secret the service's webhook URL is signed with"),
 		// Git (common)
 		field.String("git_repository_owner").Optional().Nillable().Comment("Git repository owner"),
 		field.String("git_repository").Optional().Nillable().Comment("Git repository name"),
@@ -86,6 +100,7 @@ func (Service) Fields() []ent.Field {
 		field.UUID("template_id", uuid.UUID{}).Optional().Nillable().Comment("Reference to the template this service was created from"),
 		field.UUID("template_instance_id", uuid.UUID{}).Optional().Nillable().Comment("Group reference of all services launched together from a template."),
 		field.UUID("service_group_id", uuid.UUID{}).Optional().Nillable().Comment("The group this service belongs to"),
+		field.String("deploy_hook_token_hash").Optional().Nillable().Unique().Sensitive().Comment("SHA-256 of the token in the service's deploy hook URL, nil if it's revoked"),
 	}
 }
 
@@ -96,6 +111,8 @@ func (Service) Edges() []ent.Edge {
 		edge.From("environment", Environment.Type).Ref("services").Field("environment_id").Unique().Required(),
 		// M2O edge to keep track of the GitHub installation
 		edge.From("github_installation", GithubInstallation.Type).Ref("services").Field("github_installation_id").Unique(),
+		// M2O edge to keep track of the GitLab connection
+		edge.From("gitlab_connection", GitlabConnection.Type).Ref("services").Field("gitlab_connection_id").Unique(),
 		// O2O edge to keep track of the service configuration
 		edge.To("service_config", ServiceConfig.Type).Unique().Annotations(
 			entsql.Annotation{OnDelete: entsql.Cascade},
@@ -33,22 +33,40 @@ func (ServiceConfig) Fields() []ent.Field {
 		// For builds from git using Dockerfile
 		field.String("docker_builder_dockerfile_path").Optional().Nillable().Comment("Path to Dockerfile if using docker builder"),
 		field.String("docker_builder_build_context").Optional().Nillable().Comment("Path to Dockerfile context if using docker builder"),
+		field.String("docker_builder_target").Optional().Nillable().Comment("Stage to build in a multi-stage Dockerfile if using docker builder"),
+		field.JSON("docker_builder_build_args", map[string]string{}).Optional().Comment("Build args if using docker builder, values can reference service variables as ${NAME}, which are stored in the image history"),
+		field.JSON("docker_builder_build_contexts", map[string]string{}).Optional().Comment("Named additional build contexts if using docker builder, a path in the repo or a source like docker-image://alpine:3.20"),
+		// For builds with Cloud Native Buildpacks
+		field.String("buildpacks_builder_image").Optional().Nillable().Comment("CNB builder image if using buildpacks builder, e.g. paketobuildpacks/builder-jammy-base"),
+		// Multi-architecture builds
+		field.Strings("platforms").Optional().Comment("Platforms to build the image for, e.g. linux/amd64 and linux/arm64, empty for the builder's own platform"),
 		// Provider and framework directly from railpack
 		field.Enum("railpack_provider").GoType(enum.Provider("")).Optional().Nillable().Comment("Provider (e.g. Go, Python, Node, Deno)"),
 		field.Enum("railpack_framework").GoType(enum.Framework("")).Optional().Nillable().Comment("Framework of service - corresponds mostly to railpack results - e.g. Django, Next, Express, Gin"),
 		// Branch to build from (git)
 		field.String("git_branch").Optional().Nillable().Comment("Branch to build from"),
 		field.String("git_tag").Optional().Nillable().Comment("Tag to build from, supports glob patterns"),
+		field.Strings("watch_paths").Optional().Comment("Glob patterns, a push only auto-deploys if it changes a matching file"),
+		field.String("skip_deploy_marker").Optional().Nillable().Comment("Commit message marker that skips auto-deploy, in addition to [skip deploy] and [skip ci]"),
+		field.Strings("ignored_commit_authors").Optional().Comment("Commit authors whose pushes don't auto-deploy, matched literally with * as wildcard, e.g. dependabot[bot]"),
+		field.Strings("pr_preview_variables").Optional().Comment("Variables copied into pull request preview environments, others are left out"),
 		// Generic CRD configuration
 		field.JSON("hosts", []HostSpec{}).Optional().Comment("External domains and paths for the service"),
 		field.JSON("ports", []PortSpec{}).Optional().Comment("Container ports to expose"),
 		field.Int32("replicas").Default(1).Comment("Number of replicas for the service"),
 		field.Bool("auto_deploy").Default(false).Comment("Whether to automatically deploy on git push"),
+		field.Bool("auto_rollback").Default(false).Comment("Whether to roll back to the previous deployment when a new one stays unhealthy"),
+		field.Bool("pr_previews").Default(false).Comment("Whether to deploy pull requests against the branch to ephemeral preview environments"),
 		field.String("railpack_builder_install_command").Optional().Nillable().Comment("Custom install command (railpack only)"),
 		field.String("railpack_builder_build_command").Optional().Nillable().Comment("Custom build command (railpack only)"),
 		field.String("run_command").Optional().Nillable().Comment("Custom run command"),
+		field.String("pre_deploy_command").Optional().Nillable().Comment("Command to run as a one-off job with the new image before rollout, e.g. migrations"),
+		field.Enum("rollout_strategy").GoType(RolloutStrategy("")).Default(string(RolloutStrategyRolling)).Comment("How new deployments replace the running one"),
+		field.Int("canary_weight").Default(10).Comment("Percentage of ingress traffic sent to a canary deployment"),
 		field.Bool("is_public").Default(false).Comment("Whether the service is publicly accessible, creates an ingress resource"),
 		field.String("image").Optional().Comment("Custom Docker image if not building from git"), // Only applies to type=docker-image
+		field.Bool("image_auto_update").Default(false).Comment("Whether to redeploy when the image tag is re-pushed with a new digest"),
+		field.String("image_digest").Optional().Nillable().Comment("Last seen digest of the image tag, used to detect re-pushes"),
 		// Database
 		field.String("definition_version").Optional().Nillable().Comment("Version of the database custom resource definition"),
 		field.JSON("database_config", &DatabaseConfig{}).Optional().Comment("Database configuration for the service"),
@@ -56,6 +74,9 @@ func (ServiceConfig) Fields() []ent.Field {
 		field.String("s3_backup_bucket").Optional().Nillable().Comment("S3 bucket to backup to"),
 		field.String("backup_schedule").Default("5 5 * * *").Comment("Cron expression for the backup schedule"),
 		field.Int("backup_retention_count").Default(3).Comment("Number of base backups to retain"),
+		// Uploaded source archives
+		field.UUID("upload_s3_source_id", uuid.UUID{}).Optional().Nillable().Comment("S3 source to store uploaded source archives in"),
+		field.String("upload_s3_bucket").Optional().Nillable().Comment("S3 bucket to store uploaded source archives in"),
 		// Volume
 		field.JSON("volumes", []ServiceVolume{}).Optional().Comment("Volumes to mount in the service"),
 		// Security context
@@ -78,6 +99,8 @@ func (ServiceConfig) Edges() []ent.Edge {
 		edge.From("service", Service.Type).Ref("service_config").Field("service_id").Unique().Required(),
 		// O2M to backup sources
 		edge.From("s3_backup_sources", S3.Type).Ref("service_backup_source").Field("s3_backup_source_id").Unique(),
+		// O2M to upload sources
+		edge.From("s3_upload_sources", S3.Type).Ref("service_upload_source").Field("upload_s3_source_id").Unique(),
 	}
 }
 
@@ -357,12 +357,15 @@ const (
 	ServiceBuilderRailpack ServiceBuilder = "railpack"
 	ServiceBuilderDocker   ServiceBuilder = "docker"
 	ServiceBuilderDatabase ServiceBuilder = "database"
+	// Cloud Native Buildpacks, e.g. Paketo
+	ServiceBuilderBuildpacks ServiceBuilder = "buildpacks"
 )
 
 var allServiceBuilders = []ServiceBuilder{
 	ServiceBuilderRailpack,
 	ServiceBuilderDocker,
 	ServiceBuilderDatabase,
+	ServiceBuilderBuildpacks,
 }
 
 // Values provides list valid values for Enum.
@@ -386,3 +389,40 @@ func (u ServiceBuilder) Schema(r huma.Registry) *huma.Schema {
 	}
 	return &huma.Schema{Ref: "#/components/schemas/ServiceBuilder"}
 }
+
+// Rollout strategy enum
+type RolloutStrategy string
+
+const (
+	RolloutStrategyRolling   RolloutStrategy = "rolling"    // Replace instances in place
+	RolloutStrategyBlueGreen RolloutStrategy = "blue-green" // Run the new version next to the old one, switch traffic once it's healthy
+	RolloutStrategyCanary    RolloutStrategy = "canary"     // Send a share of traffic to the new version until it's promoted or aborted
+)
+
+var allRolloutStrategies = []RolloutStrategy{
+	RolloutStrategyRolling,
+	RolloutStrategyBlueGreen,
+	RolloutStrategyCanary,
+}
+
+// Values provides list valid values for Enum.
+func (s RolloutStrategy) Values() (kinds []string) {
+	for _, s := range allRolloutStrategies {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// Register enum in OpenAPI specification
+// https://github.com/danielgtaylor/huma/issues/621
+func (u RolloutStrategy) Schema(r huma.Registry) *huma.Schema {
+	if r.Map()["RolloutStrategy"] == nil {
+		schemaRef := r.Schema(reflect.TypeOf(""), true, "RolloutStrategy")
+		schemaRef.Title = "RolloutStrategy"
+		for _, v := range allRolloutStrategies {
+			schemaRef.Enum = append(schemaRef.Enum, string(v))
+		}
+		r.Map()["RolloutStrategy"] = schemaRef
+	}
+	return &huma.Schema{Ref: "#/components/schemas/RolloutStrategy"}
+}
@@ -14,6 +14,11 @@ type BuildkitSettings struct {
 	Replicas       int `json:"replicas"`
 }
 
+type BuildSettings struct {
+	MaxConcurrentBuilds        int `json:"max_concurrent_builds" doc:"Maximum number of builds running at once across the system"`
+	MaxConcurrentBuildsPerTeam int `json:"max_concurrent_builds_per_team" doc:"Maximum number of builds running at once for a single team, 0 for no limit"`
+}
+
 // SystemSetting holds the schema definition for the SystemSetting entity.
 type SystemSetting struct {
 	ent.Schema
@@ -34,6 +39,9 @@ func (SystemSetting) Fields() []ent.Field {
 		field.JSON("buildkit_settings", &BuildkitSettings{}).
 			Optional().
 			Comment("Buildkit settings"),
+		field.JSON("build_settings", &BuildSettings{}).
+			Optional().
+			Comment("Build queue settings"),
 	}
 }
 
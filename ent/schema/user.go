@@ -40,6 +40,11 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		// O2M with gitlab_connections
+		edge.To("gitlab_connections", GitlabConnection.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 		edge.To("groups", Group.Type),
 		edge.To("teams", Team.Type),
 	}
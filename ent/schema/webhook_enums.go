@@ -84,17 +84,20 @@ func (u WebhookType) Schema(r huma.Registry) *huma.Schema {
 type WebhookEvent string
 
 const (
-	WebhookEventProjectCreated      WebhookEvent = "project.created"
-	WebhookEventProjectUpdated      WebhookEvent = "project.updated"
-	WebhookEventProjectDeleted      WebhookEvent = "project.deleted"
-	WebhookEventServiceCreated      WebhookEvent = "service.created"
-	WebhookEventServiceUpdated      WebhookEvent = "service.updated"
-	WebhookEventServiceDeleted      WebhookEvent = "service.deleted"
-	WebhookEventDeploymentQueued    WebhookEvent = "deployment.queued"
-	WebhookEventDeploymentBuilding  WebhookEvent = "deployment.building"
-	WebhookEventDeploymentSucceeded WebhookEvent = "deployment.succeeded"
-	WebhookEventDeploymentFailed    WebhookEvent = "deployment.failed"
-	WebhookEventDeploymentCancelled WebhookEvent = "deployment.cancelled"
+	WebhookEventProjectCreated              WebhookEvent = "project.created"
+	WebhookEventProjectUpdated              WebhookEvent = "project.updated"
+	WebhookEventProjectDeleted              WebhookEvent = "project.deleted"
+	WebhookEventServiceCreated              WebhookEvent = "service.created"
+	WebhookEventServiceUpdated              WebhookEvent = "service.updated"
+	WebhookEventServiceDeleted              WebhookEvent = "service.deleted"
+	WebhookEventDeploymentQueued            WebhookEvent = "deployment.queued"
+	WebhookEventDeploymentBuilding          WebhookEvent = "deployment.building"
+	WebhookEventDeploymentSucceeded         WebhookEvent = "deployment.succeeded"
+	WebhookEventDeploymentFailed            WebhookEvent = "deployment.failed"
+	WebhookEventDeploymentCancelled         WebhookEvent = "deployment.cancelled"
+	WebhookEventDeploymentRolledBack        WebhookEvent = "deployment.rolled_back"
+	WebhookEventDeploymentApprovalRequested WebhookEvent = "deployment.approval_requested"
+	WebhookEventDeploymentApproved          WebhookEvent = "deployment.approved"
 )
 
 var allWebhookEvents = []WebhookEvent{
@@ -109,6 +112,9 @@ var allWebhookEvents = []WebhookEvent{
 	WebhookEventDeploymentSucceeded,
 	WebhookEventDeploymentFailed,
 	WebhookEventDeploymentCancelled,
+	WebhookEventDeploymentRolledBack,
+	WebhookEventDeploymentApprovalRequested,
+	WebhookEventDeploymentApproved,
 }
 
 // Values provides list valid values for Enum.
@@ -156,6 +162,9 @@ func (u WebhookEvent) Schema(r huma.Registry) *huma.Schema {
 			string(WebhookEventDeploymentSucceeded),
 			string(WebhookEventDeploymentFailed),
 			string(WebhookEventDeploymentCancelled),
+			string(WebhookEventDeploymentRolledBack),
+			string(WebhookEventDeploymentApprovalRequested),
+			string(WebhookEventDeploymentApproved),
 		}
 
 		projectSchema := &huma.Schema{
@@ -14,6 +14,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -49,6 +50,16 @@ type Service struct {
 	DatabaseVersion *string `json:"database_version,omitempty"`
 	// Optional reference to GitHub installation
 	GithubInstallationID *int64 `json:"github_installation_id,omitempty"`
+	// Optional reference to GitLab connection
+	GitlabConnectionID *uuid.UUID `json:"gitlab_connection_id,omitempty"`
+	// SSH clone URL of a generic git repository
+	GitURL *string `json:"git_url,omitempty"`
+	// Public half of the service's deploy key, in authorized_keys format
+	GitDeployPublicKey *string `json:"git_deploy_public_key,omitempty"`
+	// Host key of the git server, pinned when the service is created
+	GitSSHHostKey *string `json:"git_ssh_host_key,omitempty"`
+	// Secret the service's webhook URL is signed with
+	GitWebhookSecret *string `json:"-"`
 	// Git repository owner
 	GitRepositoryOwner *string `json:"git_repository_owner,omitempty"`
 	// Git repository name
@@ -63,6 +74,8 @@ type Service struct {
 	TemplateInstanceID *uuid.UUID `json:"template_instance_id,omitempty"`
 	// The group this service belongs to
 	ServiceGroupID *uuid.UUID `json:"service_group_id,omitempty"`
+	// SHA-256 of the token in the service's deploy hook URL, nil if it's revoked
+	DeployHookTokenHash *string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ServiceQuery when eager-loading is set.
 	Edges        ServiceEdges `json:"edges"`
@@ -75,6 +88,8 @@ type ServiceEdges struct {
 	Environment *Environment `json:"environment,omitempty"`
 	// GithubInstallation holds the value of the github_installation edge.
 	GithubInstallation *GithubInstallation `json:"github_installation,omitempty"`
+	// GitlabConnection holds the value of the gitlab_connection edge.
+	GitlabConnection *GitlabConnection `json:"gitlab_connection,omitempty"`
 	// ServiceConfig holds the value of the service_config edge.
 	ServiceConfig *ServiceConfig `json:"service_config,omitempty"`
 	// Deployments holds the value of the deployments edge.
@@ -89,7 +104,7 @@ type ServiceEdges struct {
 	VariableReferences []*VariableReference `json:"variable_references,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [9]bool
 }
 
 // EnvironmentOrErr returns the Environment value or an error if the edge
@@ -114,12 +129,23 @@ func (e ServiceEdges) GithubInstallationOrErr() (*GithubInstallation, error) {
 	return nil, &NotLoadedError{edge: "github_installation"}
 }
 
+// GitlabConnectionOrErr returns the GitlabConnection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceEdges) GitlabConnectionOrErr() (*GitlabConnection, error) {
+	if e.GitlabConnection != nil {
+		return e.GitlabConnection, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: gitlabconnection.Label}
+	}
+	return nil, &NotLoadedError{edge: "gitlab_connection"}
+}
+
 // ServiceConfigOrErr returns the ServiceConfig value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e ServiceEdges) ServiceConfigOrErr() (*ServiceConfig, error) {
 	if e.ServiceConfig != nil {
 		return e.ServiceConfig, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: serviceconfig.Label}
 	}
 	return nil, &NotLoadedError{edge: "service_config"}
@@ -128,7 +154,7 @@ func (e ServiceEdges) ServiceConfigOrErr() (*ServiceConfig, error) {
 // DeploymentsOrErr returns the Deployments value or an error if the edge
 // was not loaded in eager-loading.
 func (e ServiceEdges) DeploymentsOrErr() ([]*Deployment, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Deployments, nil
 	}
 	return nil, &NotLoadedError{edge: "deployments"}
@@ -139,7 +165,7 @@ func (e ServiceEdges) DeploymentsOrErr() ([]*Deployment, error) {
 func (e ServiceEdges) CurrentDeploymentOrErr() (*Deployment, error) {
 	if e.CurrentDeployment != nil {
 		return e.CurrentDeployment, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: deployment.Label}
 	}
 	return nil, &NotLoadedError{edge: "current_deployment"}
@@ -150,7 +176,7 @@ func (e ServiceEdges) CurrentDeploymentOrErr() (*Deployment, error) {
 func (e ServiceEdges) TemplateOrErr() (*Template, error) {
 	if e.Template != nil {
 		return e.Template, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: template.Label}
 	}
 	return nil, &NotLoadedError{edge: "template"}
@@ -161,7 +187,7 @@ func (e ServiceEdges) TemplateOrErr() (*Template, error) {
 func (e ServiceEdges) ServiceGroupOrErr() (*ServiceGroup, error) {
 	if e.ServiceGroup != nil {
 		return e.ServiceGroup, nil
-	} else if e.loadedTypes[6] {
+	} else if e.loadedTypes[7] {
 		return nil, &NotFoundError{label: servicegroup.Label}
 	}
 	return nil, &NotLoadedError{edge: "service_group"}
@@ -170,7 +196,7 @@ func (e ServiceEdges) ServiceGroupOrErr() (*ServiceGroup, error) {
 // VariableReferencesOrErr returns the VariableReferences value or an error if the edge
 // was not loaded in eager-loading.
 func (e ServiceEdges) VariableReferencesOrErr() ([]*VariableReference, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.VariableReferences, nil
 	}
 	return nil, &NotLoadedError{edge: "variable_references"}
@@ -181,13 +207,13 @@ func (*Service) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case service.FieldCurrentDeploymentID, service.FieldTemplateID, service.FieldTemplateInstanceID, service.FieldServiceGroupID:
+		case service.FieldGitlabConnectionID, service.FieldCurrentDeploymentID, service.FieldTemplateID, service.FieldTemplateInstanceID, service.FieldServiceGroupID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case service.FieldDetectedPorts:
 			values[i] = new([]byte)
 		case service.FieldGithubInstallationID:
 			values[i] = new(sql.NullInt64)
-		case service.FieldType, service.FieldKubernetesName, service.FieldName, service.FieldDescription, service.FieldDatabase, service.FieldDatabaseVersion, service.FieldGitRepositoryOwner, service.FieldGitRepository, service.FieldKubernetesSecret:
+		case service.FieldType, service.FieldKubernetesName, service.FieldName, service.FieldDescription, service.FieldDatabase, service.FieldDatabaseVersion, service.FieldGitURL, service.FieldGitDeployPublicKey, service.FieldGitSSHHostKey, service.FieldGitWebhookSecret, service.FieldGitRepositoryOwner, service.FieldGitRepository, service.FieldKubernetesSecret, service.FieldDeployHookTokenHash:
 			values[i] = new(sql.NullString)
 		case service.FieldCreatedAt, service.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -285,6 +311,41 @@ func (s *Service) assignValues(columns []string, values []any) error {
 				s.GithubInstallationID = new(int64)
 				*s.GithubInstallationID = value.Int64
 			}
+		case service.FieldGitlabConnectionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field gitlab_connection_id", values[i])
+			} else if value.Valid {
+				s.GitlabConnectionID = new(uuid.UUID)
+				*s.GitlabConnectionID = *value.S.(*uuid.UUID)
+			}
+		case service.FieldGitURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field git_url", values[i])
+			} else if value.Valid {
+				s.GitURL = new(string)
+				*s.GitURL = value.String
+			}
+		case service.FieldGitDeployPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field git_deploy_public_key", values[i])
+			} else if value.Valid {
+				s.GitDeployPublicKey = new(string)
+				*s.GitDeployPublicKey = value.String
+			}
+		case service.FieldGitSSHHostKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field git_ssh_host_key", values[i])
+			} else if value.Valid {
+				s.GitSSHHostKey = new(string)
+				*s.GitSSHHostKey = value.String
+			}
+		case service.FieldGitWebhookSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field git_webhook_secret", values[i])
+			} else if value.Valid {
+				s.GitWebhookSecret = new(string)
+				*s.GitWebhookSecret = value.String
+			}
 		case service.FieldGitRepositoryOwner:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field git_repository_owner", values[i])
@@ -333,6 +394,13 @@ func (s *Service) assignValues(columns []string, values []any) error {
 				s.ServiceGroupID = new(uuid.UUID)
 				*s.ServiceGroupID = *value.S.(*uuid.UUID)
 			}
+		case service.FieldDeployHookTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deploy_hook_token_hash", values[i])
+			} else if value.Valid {
+				s.DeployHookTokenHash = new(string)
+				*s.DeployHookTokenHash = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -356,6 +424,11 @@ func (s *Service) QueryGithubInstallation() *GithubInstallationQuery {
 	return NewServiceClient(s.config).QueryGithubInstallation(s)
 }
 
+// QueryGitlabConnection queries the "gitlab_connection" edge of the Service entity.
+func (s *Service) QueryGitlabConnection() *GitlabConnectionQuery {
+	return NewServiceClient(s.config).QueryGitlabConnection(s)
+}
+
 // QueryServiceConfig queries the "service_config" edge of the Service entity.
 func (s *Service) QueryServiceConfig() *ServiceConfigQuery {
 	return NewServiceClient(s.config).QueryServiceConfig(s)
@@ -448,6 +521,28 @@ func (s *Service) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := s.GitlabConnectionID; v != nil {
+		builder.WriteString("gitlab_connection_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.GitURL; v != nil {
+		builder.WriteString("git_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.GitDeployPublicKey; v != nil {
+		builder.WriteString("git_deploy_public_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := s.GitSSHHostKey; v != nil {
+		builder.WriteString("git_ssh_host_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("git_webhook_secret=<sensitive>")
+	builder.WriteString(", ")
 	if v := s.GitRepositoryOwner; v != nil {
 		builder.WriteString("git_repository_owner=")
 		builder.WriteString(*v)
@@ -480,6 +575,8 @@ func (s *Service) String() string {
 		builder.WriteString("service_group_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("deploy_hook_token_hash=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
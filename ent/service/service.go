@@ -39,6 +39,16 @@ const (
 	FieldDatabaseVersion = "database_version"
 	// FieldGithubInstallationID holds the string denoting the github_installation_id field in the database.
 	FieldGithubInstallationID = "github_installation_id"
+	// FieldGitlabConnectionID holds the string denoting the gitlab_connection_id field in the database.
+	FieldGitlabConnectionID = "gitlab_connection_id"
+	// FieldGitURL holds the string denoting the git_url field in the database.
+	FieldGitURL = "git_url"
+	// FieldGitDeployPublicKey holds the string denoting the git_deploy_public_key field in the database.
+	FieldGitDeployPublicKey = "git_deploy_public_key"
+	// FieldGitSSHHostKey holds the string denoting the git_ssh_host_key field in the database.
+	FieldGitSSHHostKey = "git_ssh_host_key"
+	// FieldGitWebhookSecret holds the string denoting the git_webhook_secret field in the database.
+	FieldGitWebhookSecret = "git_webhook_secret"
 	// FieldGitRepositoryOwner holds the string denoting the git_repository_owner field in the database.
 	FieldGitRepositoryOwner = "git_repository_owner"
 	// FieldGitRepository holds the string denoting the git_repository field in the database.
@@ -53,10 +63,14 @@ const (
 	FieldTemplateInstanceID = "template_instance_id"
 	// FieldServiceGroupID holds the string denoting the service_group_id field in the database.
 	FieldServiceGroupID = "service_group_id"
+	// FieldDeployHookTokenHash holds the string denoting the deploy_hook_token_hash field in the database.
+	FieldDeployHookTokenHash = "deploy_hook_token_hash"
 	// EdgeEnvironment holds the string denoting the environment edge name in mutations.
 	EdgeEnvironment = "environment"
 	// EdgeGithubInstallation holds the string denoting the github_installation edge name in mutations.
 	EdgeGithubInstallation = "github_installation"
+	// EdgeGitlabConnection holds the string denoting the gitlab_connection edge name in mutations.
+	EdgeGitlabConnection = "gitlab_connection"
 	// EdgeServiceConfig holds the string denoting the service_config edge name in mutations.
 	EdgeServiceConfig = "service_config"
 	// EdgeDeployments holds the string denoting the deployments edge name in mutations.
@@ -85,6 +99,13 @@ const (
 	GithubInstallationInverseTable = "github_installations"
 	// GithubInstallationColumn is the table column denoting the github_installation relation/edge.
 	GithubInstallationColumn = "github_installation_id"
+	// GitlabConnectionTable is the table that holds the gitlab_connection relation/edge.
+	GitlabConnectionTable = "services"
+	// GitlabConnectionInverseTable is the table name for the GitlabConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "gitlabconnection" package.
+	GitlabConnectionInverseTable = "gitlab_connections"
+	// GitlabConnectionColumn is the table column denoting the gitlab_connection relation/edge.
+	GitlabConnectionColumn = "gitlab_connection_id"
 	// ServiceConfigTable is the table that holds the service_config relation/edge.
 	ServiceConfigTable = "service_configs"
 	// ServiceConfigInverseTable is the table name for the ServiceConfig entity.
@@ -143,6 +164,11 @@ var Columns = []string{
 	FieldDatabase,
 	FieldDatabaseVersion,
 	FieldGithubInstallationID,
+	FieldGitlabConnectionID,
+	FieldGitURL,
+	FieldGitDeployPublicKey,
+	FieldGitSSHHostKey,
+	FieldGitWebhookSecret,
 	FieldGitRepositoryOwner,
 	FieldGitRepository,
 	FieldKubernetesSecret,
@@ -150,6 +176,7 @@ var Columns = []string{
 	FieldTemplateID,
 	FieldTemplateInstanceID,
 	FieldServiceGroupID,
+	FieldDeployHookTokenHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -178,7 +205,7 @@ var (
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type schema.ServiceType) error {
 	switch _type {
-	case "github", "docker-image", "database":
+	case "github", "gitlab", "git", "docker-image", "database", "upload":
 		return nil
 	default:
 		return fmt.Errorf("service: invalid enum value for type field: %q", _type)
@@ -243,6 +270,31 @@ func ByGithubInstallationID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGithubInstallationID, opts...).ToFunc()
 }
 
+// ByGitlabConnectionID orders the results by the gitlab_connection_id field.
+func ByGitlabConnectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGitlabConnectionID, opts...).ToFunc()
+}
+
+// ByGitURL orders the results by the git_url field.
+func ByGitURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGitURL, opts...).ToFunc()
+}
+
+// ByGitDeployPublicKey orders the results by the git_deploy_public_key field.
+func ByGitDeployPublicKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGitDeployPublicKey, opts...).ToFunc()
+}
+
+// ByGitSSHHostKey orders the results by the git_ssh_host_key field.
+func ByGitSSHHostKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGitSSHHostKey, opts...).ToFunc()
+}
+
+// ByGitWebhookSecret orders the results by the git_webhook_secret field.
+func ByGitWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGitWebhookSecret, opts...).ToFunc()
+}
+
 // ByGitRepositoryOwner orders the results by the git_repository_owner field.
 func ByGitRepositoryOwner(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGitRepositoryOwner, opts...).ToFunc()
@@ -278,6 +330,11 @@ func ByServiceGroupID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldServiceGroupID, opts...).ToFunc()
 }
 
+// ByDeployHookTokenHash orders the results by the deploy_hook_token_hash field.
+func ByDeployHookTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeployHookTokenHash, opts...).ToFunc()
+}
+
 // ByEnvironmentField orders the results by environment field.
 func ByEnvironmentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -292,6 +349,13 @@ func ByGithubInstallationField(field string, opts ...sql.OrderTermOption) OrderO
 	}
 }
 
+// ByGitlabConnectionField orders the results by gitlab_connection field.
+func ByGitlabConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGitlabConnectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByServiceConfigField orders the results by service_config field.
 func ByServiceConfigField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -361,6 +425,13 @@ func newGithubInstallationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, GithubInstallationTable, GithubInstallationColumn),
 	)
 }
+func newGitlabConnectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GitlabConnectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GitlabConnectionTable, GitlabConnectionColumn),
+	)
+}
 func newServiceConfigStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -102,6 +102,31 @@ func GithubInstallationID(v int64) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldGithubInstallationID, v))
 }
 
+// GitlabConnectionID applies equality check predicate on the "gitlab_connection_id" field. It's identical to GitlabConnectionIDEQ.
+func GitlabConnectionID(v uuid.UUID) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitlabConnectionID, v))
+}
+
+// GitURL applies equality check predicate on the "git_url" field. It's identical to GitURLEQ.
+func GitURL(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitURL, v))
+}
+
+// GitDeployPublicKey applies equality check predicate on the "git_deploy_public_key" field. It's identical to GitDeployPublicKeyEQ.
+func GitDeployPublicKey(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitDeployPublicKey, v))
+}
+
+// GitSSHHostKey applies equality check predicate on the "git_ssh_host_key" field. It's identical to GitSSHHostKeyEQ.
+func GitSSHHostKey(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitSSHHostKey, v))
+}
+
+// GitWebhookSecret applies equality check predicate on the "git_webhook_secret" field. It's identical to GitWebhookSecretEQ.
+func GitWebhookSecret(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitWebhookSecret, v))
+}
+
 // GitRepositoryOwner applies equality check predicate on the "git_repository_owner" field. It's identical to GitRepositoryOwnerEQ.
 func GitRepositoryOwner(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldGitRepositoryOwner, v))
@@ -137,6 +162,11 @@ func ServiceGroupID(v uuid.UUID) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldServiceGroupID, v))
 }
 
+// DeployHookTokenHash applies equality check predicate on the "deploy_hook_token_hash" field. It's identical to DeployHookTokenHashEQ.
+func DeployHookTokenHash(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDeployHookTokenHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldCreatedAt, v))
@@ -662,6 +692,336 @@ func GithubInstallationIDNotNil() predicate.Service {
 	return predicate.Service(sql.FieldNotNull(FieldGithubInstallationID))
 }
 
+// GitlabConnectionIDEQ applies the EQ predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDEQ(v uuid.UUID) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitlabConnectionID, v))
+}
+
+// GitlabConnectionIDNEQ applies the NEQ predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDNEQ(v uuid.UUID) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldGitlabConnectionID, v))
+}
+
+// GitlabConnectionIDIn applies the In predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDIn(vs ...uuid.UUID) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldGitlabConnectionID, vs...))
+}
+
+// GitlabConnectionIDNotIn applies the NotIn predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDNotIn(vs ...uuid.UUID) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldGitlabConnectionID, vs...))
+}
+
+// GitlabConnectionIDIsNil applies the IsNil predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldGitlabConnectionID))
+}
+
+// GitlabConnectionIDNotNil applies the NotNil predicate on the "gitlab_connection_id" field.
+func GitlabConnectionIDNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldGitlabConnectionID))
+}
+
+// GitURLEQ applies the EQ predicate on the "git_url" field.
+func GitURLEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitURL, v))
+}
+
+// GitURLNEQ applies the NEQ predicate on the "git_url" field.
+func GitURLNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldGitURL, v))
+}
+
+// GitURLIn applies the In predicate on the "git_url" field.
+func GitURLIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldGitURL, vs...))
+}
+
+// GitURLNotIn applies the NotIn predicate on the "git_url" field.
+func GitURLNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldGitURL, vs...))
+}
+
+// GitURLGT applies the GT predicate on the "git_url" field.
+func GitURLGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldGitURL, v))
+}
+
+// GitURLGTE applies the GTE predicate on the "git_url" field.
+func GitURLGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldGitURL, v))
+}
+
+// GitURLLT applies the LT predicate on the "git_url" field.
+func GitURLLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldGitURL, v))
+}
+
+// GitURLLTE applies the LTE predicate on the "git_url" field.
+func GitURLLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldGitURL, v))
+}
+
+// GitURLContains applies the Contains predicate on the "git_url" field.
+func GitURLContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldGitURL, v))
+}
+
+// GitURLHasPrefix applies the HasPrefix predicate on the "git_url" field.
+func GitURLHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldGitURL, v))
+}
+
+// GitURLHasSuffix applies the HasSuffix predicate on the "git_url" field.
+func GitURLHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldGitURL, v))
+}
+
+// GitURLIsNil applies the IsNil predicate on the "git_url" field.
+func GitURLIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldGitURL))
+}
+
+// GitURLNotNil applies the NotNil predicate on the "git_url" field.
+func GitURLNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldGitURL))
+}
+
+// GitURLEqualFold applies the EqualFold predicate on the "git_url" field.
+func GitURLEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldGitURL, v))
+}
+
+// GitURLContainsFold applies the ContainsFold predicate on the "git_url" field.
+func GitURLContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldGitURL, v))
+}
+
+// GitDeployPublicKeyEQ applies the EQ predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyNEQ applies the NEQ predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyIn applies the In predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldGitDeployPublicKey, vs...))
+}
+
+// GitDeployPublicKeyNotIn applies the NotIn predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldGitDeployPublicKey, vs...))
+}
+
+// GitDeployPublicKeyGT applies the GT predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyGTE applies the GTE predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyLT applies the LT predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyLTE applies the LTE predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyContains applies the Contains predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyHasPrefix applies the HasPrefix predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyHasSuffix applies the HasSuffix predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyIsNil applies the IsNil predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldGitDeployPublicKey))
+}
+
+// GitDeployPublicKeyNotNil applies the NotNil predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldGitDeployPublicKey))
+}
+
+// GitDeployPublicKeyEqualFold applies the EqualFold predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldGitDeployPublicKey, v))
+}
+
+// GitDeployPublicKeyContainsFold applies the ContainsFold predicate on the "git_deploy_public_key" field.
+func GitDeployPublicKeyContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldGitDeployPublicKey, v))
+}
+
+// GitSSHHostKeyEQ applies the EQ predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyNEQ applies the NEQ predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyIn applies the In predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldGitSSHHostKey, vs...))
+}
+
+// GitSSHHostKeyNotIn applies the NotIn predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldGitSSHHostKey, vs...))
+}
+
+// GitSSHHostKeyGT applies the GT predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyGTE applies the GTE predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyLT applies the LT predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyLTE applies the LTE predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyContains applies the Contains predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyHasPrefix applies the HasPrefix predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyHasSuffix applies the HasSuffix predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyIsNil applies the IsNil predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldGitSSHHostKey))
+}
+
+// GitSSHHostKeyNotNil applies the NotNil predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldGitSSHHostKey))
+}
+
+// GitSSHHostKeyEqualFold applies the EqualFold predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldGitSSHHostKey, v))
+}
+
+// GitSSHHostKeyContainsFold applies the ContainsFold predicate on the "git_ssh_host_key" field.
+func GitSSHHostKeyContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldGitSSHHostKey, v))
+}
+
+// GitWebhookSecretEQ applies the EQ predicate on the "git_webhook_secret" field.
+func GitWebhookSecretEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretNEQ applies the NEQ predicate on the "git_webhook_secret" field.
+func GitWebhookSecretNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretIn applies the In predicate on the "git_webhook_secret" field.
+func GitWebhookSecretIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldGitWebhookSecret, vs...))
+}
+
+// GitWebhookSecretNotIn applies the NotIn predicate on the "git_webhook_secret" field.
+func GitWebhookSecretNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldGitWebhookSecret, vs...))
+}
+
+// GitWebhookSecretGT applies the GT predicate on the "git_webhook_secret" field.
+func GitWebhookSecretGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretGTE applies the GTE predicate on the "git_webhook_secret" field.
+func GitWebhookSecretGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretLT applies the LT predicate on the "git_webhook_secret" field.
+func GitWebhookSecretLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretLTE applies the LTE predicate on the "git_webhook_secret" field.
+func GitWebhookSecretLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretContains applies the Contains predicate on the "git_webhook_secret" field.
+func GitWebhookSecretContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretHasPrefix applies the HasPrefix predicate on the "git_webhook_secret" field.
+func GitWebhookSecretHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretHasSuffix applies the HasSuffix predicate on the "git_webhook_secret" field.
+func GitWebhookSecretHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretIsNil applies the IsNil predicate on the "git_webhook_secret" field.
+func GitWebhookSecretIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldGitWebhookSecret))
+}
+
+// GitWebhookSecretNotNil applies the NotNil predicate on the "git_webhook_secret" field.
+func GitWebhookSecretNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldGitWebhookSecret))
+}
+
+// GitWebhookSecretEqualFold applies the EqualFold predicate on the "git_webhook_secret" field.
+func GitWebhookSecretEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldGitWebhookSecret, v))
+}
+
+// GitWebhookSecretContainsFold applies the ContainsFold predicate on the "git_webhook_secret" field.
+func GitWebhookSecretContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldGitWebhookSecret, v))
+}
+
 // GitRepositoryOwnerEQ applies the EQ predicate on the "git_repository_owner" field.
 func GitRepositoryOwnerEQ(v string) predicate.Service {
 	return predicate.Service(sql.FieldEQ(FieldGitRepositoryOwner, v))
@@ -1017,6 +1377,81 @@ func ServiceGroupIDNotNil() predicate.Service {
 	return predicate.Service(sql.FieldNotNull(FieldServiceGroupID))
 }
 
+// DeployHookTokenHashEQ applies the EQ predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldEQ(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashNEQ applies the NEQ predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashNEQ(v string) predicate.Service {
+	return predicate.Service(sql.FieldNEQ(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashIn applies the In predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldIn(FieldDeployHookTokenHash, vs...))
+}
+
+// DeployHookTokenHashNotIn applies the NotIn predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashNotIn(vs ...string) predicate.Service {
+	return predicate.Service(sql.FieldNotIn(FieldDeployHookTokenHash, vs...))
+}
+
+// DeployHookTokenHashGT applies the GT predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashGT(v string) predicate.Service {
+	return predicate.Service(sql.FieldGT(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashGTE applies the GTE predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashGTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldGTE(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashLT applies the LT predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashLT(v string) predicate.Service {
+	return predicate.Service(sql.FieldLT(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashLTE applies the LTE predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashLTE(v string) predicate.Service {
+	return predicate.Service(sql.FieldLTE(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashContains applies the Contains predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashContains(v string) predicate.Service {
+	return predicate.Service(sql.FieldContains(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashHasPrefix applies the HasPrefix predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashHasPrefix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasPrefix(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashHasSuffix applies the HasSuffix predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashHasSuffix(v string) predicate.Service {
+	return predicate.Service(sql.FieldHasSuffix(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashIsNil applies the IsNil predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashIsNil() predicate.Service {
+	return predicate.Service(sql.FieldIsNull(FieldDeployHookTokenHash))
+}
+
+// DeployHookTokenHashNotNil applies the NotNil predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashNotNil() predicate.Service {
+	return predicate.Service(sql.FieldNotNull(FieldDeployHookTokenHash))
+}
+
+// DeployHookTokenHashEqualFold applies the EqualFold predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashEqualFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldEqualFold(FieldDeployHookTokenHash, v))
+}
+
+// DeployHookTokenHashContainsFold applies the ContainsFold predicate on the "deploy_hook_token_hash" field.
+func DeployHookTokenHashContainsFold(v string) predicate.Service {
+	return predicate.Service(sql.FieldContainsFold(FieldDeployHookTokenHash, v))
+}
+
 // HasEnvironment applies the HasEdge predicate on the "environment" edge.
 func HasEnvironment() predicate.Service {
 	return predicate.Service(func(s *sql.Selector) {
@@ -1063,6 +1498,29 @@ func HasGithubInstallationWith(preds ...predicate.GithubInstallation) predicate.
 	})
 }
 
+// HasGitlabConnection applies the HasEdge predicate on the "gitlab_connection" edge.
+func HasGitlabConnection() predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GitlabConnectionTable, GitlabConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGitlabConnectionWith applies the HasEdge predicate on the "gitlab_connection" edge with a given conditions (other predicates).
+func HasGitlabConnectionWith(preds ...predicate.GitlabConnection) predicate.Service {
+	return predicate.Service(func(s *sql.Selector) {
+		step := newGitlabConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasServiceConfig applies the HasEdge predicate on the "service_config" edge.
 func HasServiceConfig() predicate.Service {
 	return predicate.Service(func(s *sql.Selector) {
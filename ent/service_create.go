@@ -16,6 +16,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -146,6 +147,76 @@ func (sc *ServiceCreate) SetNillableGithubInstallationID(i *int64) *ServiceCreat
 	return sc
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (sc *ServiceCreate) SetGitlabConnectionID(v uuid.UUID) *ServiceCreate {
+	sc.mutation.SetGitlabConnectionID(v)
+	return sc
+}
+
+// SetNillableGitlabConnectionID sets the "gitlab_connection_id" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableGitlabConnectionID(v *uuid.UUID) *ServiceCreate {
+	if v != nil {
+		sc.SetGitlabConnectionID(*v)
+	}
+	return sc
+}
+
+// SetGitURL sets the "git_url" field.
+func (sc *ServiceCreate) SetGitURL(v string) *ServiceCreate {
+	sc.mutation.SetGitURL(v)
+	return sc
+}
+
+// SetNillableGitURL sets the "git_url" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableGitURL(v *string) *ServiceCreate {
+	if v != nil {
+		sc.SetGitURL(*v)
+	}
+	return sc
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (sc *ServiceCreate) SetGitDeployPublicKey(v string) *ServiceCreate {
+	sc.mutation.SetGitDeployPublicKey(v)
+	return sc
+}
+
+// SetNillableGitDeployPublicKey sets the "git_deploy_public_key" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableGitDeployPublicKey(v *string) *ServiceCreate {
+	if v != nil {
+		sc.SetGitDeployPublicKey(*v)
+	}
+	return sc
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (sc *ServiceCreate) SetGitSSHHostKey(v string) *ServiceCreate {
+	sc.mutation.SetGitSSHHostKey(v)
+	return sc
+}
+
+// SetNillableGitSSHHostKey sets the "git_ssh_host_key" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableGitSSHHostKey(v *string) *ServiceCreate {
+	if v != nil {
+		sc.SetGitSSHHostKey(*v)
+	}
+	return sc
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (sc *ServiceCreate) SetGitWebhookSecret(v string) *ServiceCreate {
+	sc.mutation.SetGitWebhookSecret(v)
+	return sc
+}
+
+// SetNillableGitWebhookSecret sets the "git_webhook_secret" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableGitWebhookSecret(v *string) *ServiceCreate {
+	if v != nil {
+		sc.SetGitWebhookSecret(*v)
+	}
+	return sc
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (sc *ServiceCreate) SetGitRepositoryOwner(s string) *ServiceCreate {
 	sc.mutation.SetGitRepositoryOwner(s)
@@ -236,6 +307,20 @@ func (sc *ServiceCreate) SetNillableServiceGroupID(u *uuid.UUID) *ServiceCreate
 	return sc
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (sc *ServiceCreate) SetDeployHookTokenHash(v string) *ServiceCreate {
+	sc.mutation.SetDeployHookTokenHash(v)
+	return sc
+}
+
+// SetNillableDeployHookTokenHash sets the "deploy_hook_token_hash" field if the given value is not nil.
+func (sc *ServiceCreate) SetNillableDeployHookTokenHash(v *string) *ServiceCreate {
+	if v != nil {
+		sc.SetDeployHookTokenHash(*v)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *ServiceCreate) SetID(u uuid.UUID) *ServiceCreate {
 	sc.mutation.SetID(u)
@@ -260,6 +345,11 @@ func (sc *ServiceCreate) SetGithubInstallation(g *GithubInstallation) *ServiceCr
 	return sc.SetGithubInstallationID(g.ID)
 }
 
+// SetGitlabConnection sets the "gitlab_connection" edge to the GitlabConnection entity.
+func (sc *ServiceCreate) SetGitlabConnection(v *GitlabConnection) *ServiceCreate {
+	return sc.SetGitlabConnectionID(v.ID)
+}
+
 // SetServiceConfigID sets the "service_config" edge to the ServiceConfig entity by ID.
 func (sc *ServiceCreate) SetServiceConfigID(id uuid.UUID) *ServiceCreate {
 	sc.mutation.SetServiceConfigID(id)
@@ -481,6 +571,22 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldDatabaseVersion, field.TypeString, value)
 		_node.DatabaseVersion = &value
 	}
+	if value, ok := sc.mutation.GitURL(); ok {
+		_spec.SetField(service.FieldGitURL, field.TypeString, value)
+		_node.GitURL = &value
+	}
+	if value, ok := sc.mutation.GitDeployPublicKey(); ok {
+		_spec.SetField(service.FieldGitDeployPublicKey, field.TypeString, value)
+		_node.GitDeployPublicKey = &value
+	}
+	if value, ok := sc.mutation.GitSSHHostKey(); ok {
+		_spec.SetField(service.FieldGitSSHHostKey, field.TypeString, value)
+		_node.GitSSHHostKey = &value
+	}
+	if value, ok := sc.mutation.GitWebhookSecret(); ok {
+		_spec.SetField(service.FieldGitWebhookSecret, field.TypeString, value)
+		_node.GitWebhookSecret = &value
+	}
 	if value, ok := sc.mutation.GitRepositoryOwner(); ok {
 		_spec.SetField(service.FieldGitRepositoryOwner, field.TypeString, value)
 		_node.GitRepositoryOwner = &value
@@ -497,6 +603,10 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_spec.SetField(service.FieldTemplateInstanceID, field.TypeUUID, value)
 		_node.TemplateInstanceID = &value
 	}
+	if value, ok := sc.mutation.DeployHookTokenHash(); ok {
+		_spec.SetField(service.FieldDeployHookTokenHash, field.TypeString, value)
+		_node.DeployHookTokenHash = &value
+	}
 	if nodes := sc.mutation.EnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -531,6 +641,23 @@ func (sc *ServiceCreate) createSpec() (*Service, *sqlgraph.CreateSpec) {
 		_node.GithubInstallationID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.GitlabConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.GitlabConnectionTable,
+			Columns: []string{service.GitlabConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GitlabConnectionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := sc.mutation.ServiceConfigIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -832,6 +959,96 @@ func (u *ServiceUpsert) ClearGithubInstallationID() *ServiceUpsert {
 	return u
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (u *ServiceUpsert) SetGitlabConnectionID(v uuid.UUID) *ServiceUpsert {
+	u.Set(service.FieldGitlabConnectionID, v)
+	return u
+}
+
+// UpdateGitlabConnectionID sets the "gitlab_connection_id" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateGitlabConnectionID() *ServiceUpsert {
+	u.SetExcluded(service.FieldGitlabConnectionID)
+	return u
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (u *ServiceUpsert) ClearGitlabConnectionID() *ServiceUpsert {
+	u.SetNull(service.FieldGitlabConnectionID)
+	return u
+}
+
+// SetGitURL sets the "git_url" field.
+func (u *ServiceUpsert) SetGitURL(v string) *ServiceUpsert {
+	u.Set(service.FieldGitURL, v)
+	return u
+}
+
+// UpdateGitURL sets the "git_url" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateGitURL() *ServiceUpsert {
+	u.SetExcluded(service.FieldGitURL)
+	return u
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (u *ServiceUpsert) ClearGitURL() *ServiceUpsert {
+	u.SetNull(service.FieldGitURL)
+	return u
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (u *ServiceUpsert) SetGitDeployPublicKey(v string) *ServiceUpsert {
+	u.Set(service.FieldGitDeployPublicKey, v)
+	return u
+}
+
+// UpdateGitDeployPublicKey sets the "git_deploy_public_key" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateGitDeployPublicKey() *ServiceUpsert {
+	u.SetExcluded(service.FieldGitDeployPublicKey)
+	return u
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (u *ServiceUpsert) ClearGitDeployPublicKey() *ServiceUpsert {
+	u.SetNull(service.FieldGitDeployPublicKey)
+	return u
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (u *ServiceUpsert) SetGitSSHHostKey(v string) *ServiceUpsert {
+	u.Set(service.FieldGitSSHHostKey, v)
+	return u
+}
+
+// UpdateGitSSHHostKey sets the "git_ssh_host_key" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateGitSSHHostKey() *ServiceUpsert {
+	u.SetExcluded(service.FieldGitSSHHostKey)
+	return u
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (u *ServiceUpsert) ClearGitSSHHostKey() *ServiceUpsert {
+	u.SetNull(service.FieldGitSSHHostKey)
+	return u
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (u *ServiceUpsert) SetGitWebhookSecret(v string) *ServiceUpsert {
+	u.Set(service.FieldGitWebhookSecret, v)
+	return u
+}
+
+// UpdateGitWebhookSecret sets the "git_webhook_secret" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateGitWebhookSecret() *ServiceUpsert {
+	u.SetExcluded(service.FieldGitWebhookSecret)
+	return u
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (u *ServiceUpsert) ClearGitWebhookSecret() *ServiceUpsert {
+	u.SetNull(service.FieldGitWebhookSecret)
+	return u
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (u *ServiceUpsert) SetGitRepositoryOwner(v string) *ServiceUpsert {
 	u.Set(service.FieldGitRepositoryOwner, v)
@@ -952,6 +1169,24 @@ func (u *ServiceUpsert) ClearServiceGroupID() *ServiceUpsert {
 	return u
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (u *ServiceUpsert) SetDeployHookTokenHash(v string) *ServiceUpsert {
+	u.Set(service.FieldDeployHookTokenHash, v)
+	return u
+}
+
+// UpdateDeployHookTokenHash sets the "deploy_hook_token_hash" field to the value that was provided on create.
+func (u *ServiceUpsert) UpdateDeployHookTokenHash() *ServiceUpsert {
+	u.SetExcluded(service.FieldDeployHookTokenHash)
+	return u
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (u *ServiceUpsert) ClearDeployHookTokenHash() *ServiceUpsert {
+	u.SetNull(service.FieldDeployHookTokenHash)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1178,6 +1413,111 @@ func (u *ServiceUpsertOne) ClearGithubInstallationID() *ServiceUpsertOne {
 	})
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (u *ServiceUpsertOne) SetGitlabConnectionID(v uuid.UUID) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitlabConnectionID(v)
+	})
+}
+
+// UpdateGitlabConnectionID sets the "gitlab_connection_id" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateGitlabConnectionID() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitlabConnectionID()
+	})
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (u *ServiceUpsertOne) ClearGitlabConnectionID() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitlabConnectionID()
+	})
+}
+
+// SetGitURL sets the "git_url" field.
+func (u *ServiceUpsertOne) SetGitURL(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitURL(v)
+	})
+}
+
+// UpdateGitURL sets the "git_url" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateGitURL() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitURL()
+	})
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (u *ServiceUpsertOne) ClearGitURL() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitURL()
+	})
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (u *ServiceUpsertOne) SetGitDeployPublicKey(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitDeployPublicKey(v)
+	})
+}
+
+// UpdateGitDeployPublicKey sets the "git_deploy_public_key" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateGitDeployPublicKey() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitDeployPublicKey()
+	})
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (u *ServiceUpsertOne) ClearGitDeployPublicKey() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitDeployPublicKey()
+	})
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (u *ServiceUpsertOne) SetGitSSHHostKey(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitSSHHostKey(v)
+	})
+}
+
+// UpdateGitSSHHostKey sets the "git_ssh_host_key" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateGitSSHHostKey() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitSSHHostKey()
+	})
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (u *ServiceUpsertOne) ClearGitSSHHostKey() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitSSHHostKey()
+	})
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (u *ServiceUpsertOne) SetGitWebhookSecret(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitWebhookSecret(v)
+	})
+}
+
+// UpdateGitWebhookSecret sets the "git_webhook_secret" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateGitWebhookSecret() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitWebhookSecret()
+	})
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (u *ServiceUpsertOne) ClearGitWebhookSecret() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitWebhookSecret()
+	})
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (u *ServiceUpsertOne) SetGitRepositoryOwner(v string) *ServiceUpsertOne {
 	return u.Update(func(s *ServiceUpsert) {
@@ -1318,6 +1658,27 @@ func (u *ServiceUpsertOne) ClearServiceGroupID() *ServiceUpsertOne {
 	})
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (u *ServiceUpsertOne) SetDeployHookTokenHash(v string) *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDeployHookTokenHash(v)
+	})
+}
+
+// UpdateDeployHookTokenHash sets the "deploy_hook_token_hash" field to the value that was provided on create.
+func (u *ServiceUpsertOne) UpdateDeployHookTokenHash() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDeployHookTokenHash()
+	})
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (u *ServiceUpsertOne) ClearDeployHookTokenHash() *ServiceUpsertOne {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearDeployHookTokenHash()
+	})
+}
+
 // Exec executes the query.
 func (u *ServiceUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1711,6 +2072,111 @@ func (u *ServiceUpsertBulk) ClearGithubInstallationID() *ServiceUpsertBulk {
 	})
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (u *ServiceUpsertBulk) SetGitlabConnectionID(v uuid.UUID) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitlabConnectionID(v)
+	})
+}
+
+// UpdateGitlabConnectionID sets the "gitlab_connection_id" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateGitlabConnectionID() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitlabConnectionID()
+	})
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (u *ServiceUpsertBulk) ClearGitlabConnectionID() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitlabConnectionID()
+	})
+}
+
+// SetGitURL sets the "git_url" field.
+func (u *ServiceUpsertBulk) SetGitURL(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitURL(v)
+	})
+}
+
+// UpdateGitURL sets the "git_url" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateGitURL() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitURL()
+	})
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (u *ServiceUpsertBulk) ClearGitURL() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitURL()
+	})
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (u *ServiceUpsertBulk) SetGitDeployPublicKey(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitDeployPublicKey(v)
+	})
+}
+
+// UpdateGitDeployPublicKey sets the "git_deploy_public_key" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateGitDeployPublicKey() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitDeployPublicKey()
+	})
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (u *ServiceUpsertBulk) ClearGitDeployPublicKey() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitDeployPublicKey()
+	})
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (u *ServiceUpsertBulk) SetGitSSHHostKey(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitSSHHostKey(v)
+	})
+}
+
+// UpdateGitSSHHostKey sets the "git_ssh_host_key" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateGitSSHHostKey() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitSSHHostKey()
+	})
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (u *ServiceUpsertBulk) ClearGitSSHHostKey() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitSSHHostKey()
+	})
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (u *ServiceUpsertBulk) SetGitWebhookSecret(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetGitWebhookSecret(v)
+	})
+}
+
+// UpdateGitWebhookSecret sets the "git_webhook_secret" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateGitWebhookSecret() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateGitWebhookSecret()
+	})
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (u *ServiceUpsertBulk) ClearGitWebhookSecret() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearGitWebhookSecret()
+	})
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (u *ServiceUpsertBulk) SetGitRepositoryOwner(v string) *ServiceUpsertBulk {
 	return u.Update(func(s *ServiceUpsert) {
@@ -1851,6 +2317,27 @@ func (u *ServiceUpsertBulk) ClearServiceGroupID() *ServiceUpsertBulk {
 	})
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (u *ServiceUpsertBulk) SetDeployHookTokenHash(v string) *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.SetDeployHookTokenHash(v)
+	})
+}
+
+// UpdateDeployHookTokenHash sets the "deploy_hook_token_hash" field to the value that was provided on create.
+func (u *ServiceUpsertBulk) UpdateDeployHookTokenHash() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.UpdateDeployHookTokenHash()
+	})
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (u *ServiceUpsertBulk) ClearDeployHookTokenHash() *ServiceUpsertBulk {
+	return u.Update(func(s *ServiceUpsert) {
+		s.ClearDeployHookTokenHash()
+	})
+}
+
 // Exec executes the query.
 func (u *ServiceUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/predicate"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -33,6 +34,7 @@ type ServiceQuery struct {
 	predicates             []predicate.Service
 	withEnvironment        *EnvironmentQuery
 	withGithubInstallation *GithubInstallationQuery
+	withGitlabConnection   *GitlabConnectionQuery
 	withServiceConfig      *ServiceConfigQuery
 	withDeployments        *DeploymentQuery
 	withCurrentDeployment  *DeploymentQuery
@@ -120,6 +122,28 @@ func (sq *ServiceQuery) QueryGithubInstallation() *GithubInstallationQuery {
 	return query
 }
 
+// QueryGitlabConnection chains the current query on the "gitlab_connection" edge.
+func (sq *ServiceQuery) QueryGitlabConnection() *GitlabConnectionQuery {
+	query := (&GitlabConnectionClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, selector),
+			sqlgraph.To(gitlabconnection.Table, gitlabconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, service.GitlabConnectionTable, service.GitlabConnectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryServiceConfig chains the current query on the "service_config" edge.
 func (sq *ServiceQuery) QueryServiceConfig() *ServiceConfigQuery {
 	query := (&ServiceConfigClient{config: sq.config}).Query()
@@ -446,6 +470,7 @@ func (sq *ServiceQuery) Clone() *ServiceQuery {
 		predicates:             append([]predicate.Service{}, sq.predicates...),
 		withEnvironment:        sq.withEnvironment.Clone(),
 		withGithubInstallation: sq.withGithubInstallation.Clone(),
+		withGitlabConnection:   sq.withGitlabConnection.Clone(),
 		withServiceConfig:      sq.withServiceConfig.Clone(),
 		withDeployments:        sq.withDeployments.Clone(),
 		withCurrentDeployment:  sq.withCurrentDeployment.Clone(),
@@ -481,6 +506,17 @@ func (sq *ServiceQuery) WithGithubInstallation(opts ...func(*GithubInstallationQ
 	return sq
 }
 
+// WithGitlabConnection tells the query-builder to eager-load the nodes that are connected to
+// the "gitlab_connection" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ServiceQuery) WithGitlabConnection(opts ...func(*GitlabConnectionQuery)) *ServiceQuery {
+	query := (&GitlabConnectionClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withGitlabConnection = query
+	return sq
+}
+
 // WithServiceConfig tells the query-builder to eager-load the nodes that are connected to
 // the "service_config" edge. The optional arguments are used to configure the query builder of the edge.
 func (sq *ServiceQuery) WithServiceConfig(opts ...func(*ServiceConfigQuery)) *ServiceQuery {
@@ -625,9 +661,10 @@ func (sq *ServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serv
 	var (
 		nodes       = []*Service{}
 		_spec       = sq.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [9]bool{
 			sq.withEnvironment != nil,
 			sq.withGithubInstallation != nil,
+			sq.withGitlabConnection != nil,
 			sq.withServiceConfig != nil,
 			sq.withDeployments != nil,
 			sq.withCurrentDeployment != nil,
@@ -669,6 +706,12 @@ func (sq *ServiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Serv
 			return nil, err
 		}
 	}
+	if query := sq.withGitlabConnection; query != nil {
+		if err := sq.loadGitlabConnection(ctx, query, nodes, nil,
+			func(n *Service, e *GitlabConnection) { n.Edges.GitlabConnection = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := sq.withServiceConfig; query != nil {
 		if err := sq.loadServiceConfig(ctx, query, nodes, nil,
 			func(n *Service, e *ServiceConfig) { n.Edges.ServiceConfig = e }); err != nil {
@@ -773,6 +816,38 @@ func (sq *ServiceQuery) loadGithubInstallation(ctx context.Context, query *Githu
 	}
 	return nil
 }
+func (sq *ServiceQuery) loadGitlabConnection(ctx context.Context, query *GitlabConnectionQuery, nodes []*Service, init func(*Service), assign func(*Service, *GitlabConnection)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Service)
+	for i := range nodes {
+		if nodes[i].GitlabConnectionID == nil {
+			continue
+		}
+		fk := *nodes[i].GitlabConnectionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(gitlabconnection.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "gitlab_connection_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (sq *ServiceQuery) loadServiceConfig(ctx context.Context, query *ServiceConfigQuery, nodes []*Service, init func(*Service), assign func(*Service, *ServiceConfig)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Service)
@@ -991,6 +1066,9 @@ func (sq *ServiceQuery) querySpec() *sqlgraph.QuerySpec {
 		if sq.withGithubInstallation != nil {
 			_spec.Node.AddColumnOnce(service.FieldGithubInstallationID)
 		}
+		if sq.withGitlabConnection != nil {
+			_spec.Node.AddColumnOnce(service.FieldGitlabConnectionID)
+		}
 		if sq.withCurrentDeployment != nil {
 			_spec.Node.AddColumnOnce(service.FieldCurrentDeploymentID)
 		}
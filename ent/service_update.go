@@ -16,6 +16,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/predicate"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
@@ -199,6 +200,106 @@ func (su *ServiceUpdate) ClearGithubInstallationID() *ServiceUpdate {
 	return su
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (su *ServiceUpdate) SetGitlabConnectionID(v uuid.UUID) *ServiceUpdate {
+	su.mutation.SetGitlabConnectionID(v)
+	return su
+}
+
+// SetNillableGitlabConnectionID sets the "gitlab_connection_id" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableGitlabConnectionID(v *uuid.UUID) *ServiceUpdate {
+	if v != nil {
+		su.SetGitlabConnectionID(*v)
+	}
+	return su
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (su *ServiceUpdate) ClearGitlabConnectionID() *ServiceUpdate {
+	su.mutation.ClearGitlabConnectionID()
+	return su
+}
+
+// SetGitURL sets the "git_url" field.
+func (su *ServiceUpdate) SetGitURL(v string) *ServiceUpdate {
+	su.mutation.SetGitURL(v)
+	return su
+}
+
+// SetNillableGitURL sets the "git_url" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableGitURL(v *string) *ServiceUpdate {
+	if v != nil {
+		su.SetGitURL(*v)
+	}
+	return su
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (su *ServiceUpdate) ClearGitURL() *ServiceUpdate {
+	su.mutation.ClearGitURL()
+	return su
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (su *ServiceUpdate) SetGitDeployPublicKey(v string) *ServiceUpdate {
+	su.mutation.SetGitDeployPublicKey(v)
+	return su
+}
+
+// SetNillableGitDeployPublicKey sets the "git_deploy_public_key" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableGitDeployPublicKey(v *string) *ServiceUpdate {
+	if v != nil {
+		su.SetGitDeployPublicKey(*v)
+	}
+	return su
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (su *ServiceUpdate) ClearGitDeployPublicKey() *ServiceUpdate {
+	su.mutation.ClearGitDeployPublicKey()
+	return su
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (su *ServiceUpdate) SetGitSSHHostKey(v string) *ServiceUpdate {
+	su.mutation.SetGitSSHHostKey(v)
+	return su
+}
+
+// SetNillableGitSSHHostKey sets the "git_ssh_host_key" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableGitSSHHostKey(v *string) *ServiceUpdate {
+	if v != nil {
+		su.SetGitSSHHostKey(*v)
+	}
+	return su
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (su *ServiceUpdate) ClearGitSSHHostKey() *ServiceUpdate {
+	su.mutation.ClearGitSSHHostKey()
+	return su
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (su *ServiceUpdate) SetGitWebhookSecret(v string) *ServiceUpdate {
+	su.mutation.SetGitWebhookSecret(v)
+	return su
+}
+
+// SetNillableGitWebhookSecret sets the "git_webhook_secret" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableGitWebhookSecret(v *string) *ServiceUpdate {
+	if v != nil {
+		su.SetGitWebhookSecret(*v)
+	}
+	return su
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (su *ServiceUpdate) ClearGitWebhookSecret() *ServiceUpdate {
+	su.mutation.ClearGitWebhookSecret()
+	return su
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (su *ServiceUpdate) SetGitRepositoryOwner(s string) *ServiceUpdate {
 	su.mutation.SetGitRepositoryOwner(s)
@@ -333,6 +434,26 @@ func (su *ServiceUpdate) ClearServiceGroupID() *ServiceUpdate {
 	return su
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (su *ServiceUpdate) SetDeployHookTokenHash(v string) *ServiceUpdate {
+	su.mutation.SetDeployHookTokenHash(v)
+	return su
+}
+
+// SetNillableDeployHookTokenHash sets the "deploy_hook_token_hash" field if the given value is not nil.
+func (su *ServiceUpdate) SetNillableDeployHookTokenHash(v *string) *ServiceUpdate {
+	if v != nil {
+		su.SetDeployHookTokenHash(*v)
+	}
+	return su
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (su *ServiceUpdate) ClearDeployHookTokenHash() *ServiceUpdate {
+	su.mutation.ClearDeployHookTokenHash()
+	return su
+}
+
 // SetEnvironment sets the "environment" edge to the Environment entity.
 func (su *ServiceUpdate) SetEnvironment(e *Environment) *ServiceUpdate {
 	return su.SetEnvironmentID(e.ID)
@@ -343,6 +464,11 @@ func (su *ServiceUpdate) SetGithubInstallation(g *GithubInstallation) *ServiceUp
 	return su.SetGithubInstallationID(g.ID)
 }
 
+// SetGitlabConnection sets the "gitlab_connection" edge to the GitlabConnection entity.
+func (su *ServiceUpdate) SetGitlabConnection(v *GitlabConnection) *ServiceUpdate {
+	return su.SetGitlabConnectionID(v.ID)
+}
+
 // SetServiceConfigID sets the "service_config" edge to the ServiceConfig entity by ID.
 func (su *ServiceUpdate) SetServiceConfigID(id uuid.UUID) *ServiceUpdate {
 	su.mutation.SetServiceConfigID(id)
@@ -424,6 +550,12 @@ func (su *ServiceUpdate) ClearGithubInstallation() *ServiceUpdate {
 	return su
 }
 
+// ClearGitlabConnection clears the "gitlab_connection" edge to the GitlabConnection entity.
+func (su *ServiceUpdate) ClearGitlabConnection() *ServiceUpdate {
+	su.mutation.ClearGitlabConnection()
+	return su
+}
+
 // ClearServiceConfig clears the "service_config" edge to the ServiceConfig entity.
 func (su *ServiceUpdate) ClearServiceConfig() *ServiceUpdate {
 	su.mutation.ClearServiceConfig()
@@ -603,6 +735,30 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.DatabaseVersionCleared() {
 		_spec.ClearField(service.FieldDatabaseVersion, field.TypeString)
 	}
+	if value, ok := su.mutation.GitURL(); ok {
+		_spec.SetField(service.FieldGitURL, field.TypeString, value)
+	}
+	if su.mutation.GitURLCleared() {
+		_spec.ClearField(service.FieldGitURL, field.TypeString)
+	}
+	if value, ok := su.mutation.GitDeployPublicKey(); ok {
+		_spec.SetField(service.FieldGitDeployPublicKey, field.TypeString, value)
+	}
+	if su.mutation.GitDeployPublicKeyCleared() {
+		_spec.ClearField(service.FieldGitDeployPublicKey, field.TypeString)
+	}
+	if value, ok := su.mutation.GitSSHHostKey(); ok {
+		_spec.SetField(service.FieldGitSSHHostKey, field.TypeString, value)
+	}
+	if su.mutation.GitSSHHostKeyCleared() {
+		_spec.ClearField(service.FieldGitSSHHostKey, field.TypeString)
+	}
+	if value, ok := su.mutation.GitWebhookSecret(); ok {
+		_spec.SetField(service.FieldGitWebhookSecret, field.TypeString, value)
+	}
+	if su.mutation.GitWebhookSecretCleared() {
+		_spec.ClearField(service.FieldGitWebhookSecret, field.TypeString)
+	}
 	if value, ok := su.mutation.GitRepositoryOwner(); ok {
 		_spec.SetField(service.FieldGitRepositoryOwner, field.TypeString, value)
 	}
@@ -624,6 +780,12 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.TemplateInstanceIDCleared() {
 		_spec.ClearField(service.FieldTemplateInstanceID, field.TypeUUID)
 	}
+	if value, ok := su.mutation.DeployHookTokenHash(); ok {
+		_spec.SetField(service.FieldDeployHookTokenHash, field.TypeString, value)
+	}
+	if su.mutation.DeployHookTokenHashCleared() {
+		_spec.ClearField(service.FieldDeployHookTokenHash, field.TypeString)
+	}
 	if su.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -682,6 +844,35 @@ func (su *ServiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.GitlabConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.GitlabConnectionTable,
+			Columns: []string{service.GitlabConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.GitlabConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.GitlabConnectionTable,
+			Columns: []string{service.GitlabConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if su.mutation.ServiceConfigCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -1070,6 +1261,106 @@ func (suo *ServiceUpdateOne) ClearGithubInstallationID() *ServiceUpdateOne {
 	return suo
 }
 
+// SetGitlabConnectionID sets the "gitlab_connection_id" field.
+func (suo *ServiceUpdateOne) SetGitlabConnectionID(v uuid.UUID) *ServiceUpdateOne {
+	suo.mutation.SetGitlabConnectionID(v)
+	return suo
+}
+
+// SetNillableGitlabConnectionID sets the "gitlab_connection_id" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableGitlabConnectionID(v *uuid.UUID) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetGitlabConnectionID(*v)
+	}
+	return suo
+}
+
+// ClearGitlabConnectionID clears the value of the "gitlab_connection_id" field.
+func (suo *ServiceUpdateOne) ClearGitlabConnectionID() *ServiceUpdateOne {
+	suo.mutation.ClearGitlabConnectionID()
+	return suo
+}
+
+// SetGitURL sets the "git_url" field.
+func (suo *ServiceUpdateOne) SetGitURL(v string) *ServiceUpdateOne {
+	suo.mutation.SetGitURL(v)
+	return suo
+}
+
+// SetNillableGitURL sets the "git_url" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableGitURL(v *string) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetGitURL(*v)
+	}
+	return suo
+}
+
+// ClearGitURL clears the value of the "git_url" field.
+func (suo *ServiceUpdateOne) ClearGitURL() *ServiceUpdateOne {
+	suo.mutation.ClearGitURL()
+	return suo
+}
+
+// SetGitDeployPublicKey sets the "git_deploy_public_key" field.
+func (suo *ServiceUpdateOne) SetGitDeployPublicKey(v string) *ServiceUpdateOne {
+	suo.mutation.SetGitDeployPublicKey(v)
+	return suo
+}
+
+// SetNillableGitDeployPublicKey sets the "git_deploy_public_key" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableGitDeployPublicKey(v *string) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetGitDeployPublicKey(*v)
+	}
+	return suo
+}
+
+// ClearGitDeployPublicKey clears the value of the "git_deploy_public_key" field.
+func (suo *ServiceUpdateOne) ClearGitDeployPublicKey() *ServiceUpdateOne {
+	suo.mutation.ClearGitDeployPublicKey()
+	return suo
+}
+
+// SetGitSSHHostKey sets the "git_ssh_host_key" field.
+func (suo *ServiceUpdateOne) SetGitSSHHostKey(v string) *ServiceUpdateOne {
+	suo.mutation.SetGitSSHHostKey(v)
+	return suo
+}
+
+// SetNillableGitSSHHostKey sets the "git_ssh_host_key" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableGitSSHHostKey(v *string) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetGitSSHHostKey(*v)
+	}
+	return suo
+}
+
+// ClearGitSSHHostKey clears the value of the "git_ssh_host_key" field.
+func (suo *ServiceUpdateOne) ClearGitSSHHostKey() *ServiceUpdateOne {
+	suo.mutation.ClearGitSSHHostKey()
+	return suo
+}
+
+// SetGitWebhookSecret sets the "git_webhook_secret" field.
+func (suo *ServiceUpdateOne) SetGitWebhookSecret(v string) *ServiceUpdateOne {
+	suo.mutation.SetGitWebhookSecret(v)
+	return suo
+}
+
+// SetNillableGitWebhookSecret sets the "git_webhook_secret" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableGitWebhookSecret(v *string) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetGitWebhookSecret(*v)
+	}
+	return suo
+}
+
+// ClearGitWebhookSecret clears the value of the "git_webhook_secret" field.
+func (suo *ServiceUpdateOne) ClearGitWebhookSecret() *ServiceUpdateOne {
+	suo.mutation.ClearGitWebhookSecret()
+	return suo
+}
+
 // SetGitRepositoryOwner sets the "git_repository_owner" field.
 func (suo *ServiceUpdateOne) SetGitRepositoryOwner(s string) *ServiceUpdateOne {
 	suo.mutation.SetGitRepositoryOwner(s)
@@ -1204,6 +1495,26 @@ func (suo *ServiceUpdateOne) ClearServiceGroupID() *ServiceUpdateOne {
 	return suo
 }
 
+// SetDeployHookTokenHash sets the "deploy_hook_token_hash" field.
+func (suo *ServiceUpdateOne) SetDeployHookTokenHash(v string) *ServiceUpdateOne {
+	suo.mutation.SetDeployHookTokenHash(v)
+	return suo
+}
+
+// SetNillableDeployHookTokenHash sets the "deploy_hook_token_hash" field if the given value is not nil.
+func (suo *ServiceUpdateOne) SetNillableDeployHookTokenHash(v *string) *ServiceUpdateOne {
+	if v != nil {
+		suo.SetDeployHookTokenHash(*v)
+	}
+	return suo
+}
+
+// ClearDeployHookTokenHash clears the value of the "deploy_hook_token_hash" field.
+func (suo *ServiceUpdateOne) ClearDeployHookTokenHash() *ServiceUpdateOne {
+	suo.mutation.ClearDeployHookTokenHash()
+	return suo
+}
+
 // SetEnvironment sets the "environment" edge to the Environment entity.
 func (suo *ServiceUpdateOne) SetEnvironment(e *Environment) *ServiceUpdateOne {
 	return suo.SetEnvironmentID(e.ID)
@@ -1214,6 +1525,11 @@ func (suo *ServiceUpdateOne) SetGithubInstallation(g *GithubInstallation) *Servi
 	return suo.SetGithubInstallationID(g.ID)
 }
 
+// SetGitlabConnection sets the "gitlab_connection" edge to the GitlabConnection entity.
+func (suo *ServiceUpdateOne) SetGitlabConnection(v *GitlabConnection) *ServiceUpdateOne {
+	return suo.SetGitlabConnectionID(v.ID)
+}
+
 // SetServiceConfigID sets the "service_config" edge to the ServiceConfig entity by ID.
 func (suo *ServiceUpdateOne) SetServiceConfigID(id uuid.UUID) *ServiceUpdateOne {
 	suo.mutation.SetServiceConfigID(id)
@@ -1295,6 +1611,12 @@ func (suo *ServiceUpdateOne) ClearGithubInstallation() *ServiceUpdateOne {
 	return suo
 }
 
+// ClearGitlabConnection clears the "gitlab_connection" edge to the GitlabConnection entity.
+func (suo *ServiceUpdateOne) ClearGitlabConnection() *ServiceUpdateOne {
+	suo.mutation.ClearGitlabConnection()
+	return suo
+}
+
 // ClearServiceConfig clears the "service_config" edge to the ServiceConfig entity.
 func (suo *ServiceUpdateOne) ClearServiceConfig() *ServiceUpdateOne {
 	suo.mutation.ClearServiceConfig()
@@ -1504,6 +1826,30 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 	if suo.mutation.DatabaseVersionCleared() {
 		_spec.ClearField(service.FieldDatabaseVersion, field.TypeString)
 	}
+	if value, ok := suo.mutation.GitURL(); ok {
+		_spec.SetField(service.FieldGitURL, field.TypeString, value)
+	}
+	if suo.mutation.GitURLCleared() {
+		_spec.ClearField(service.FieldGitURL, field.TypeString)
+	}
+	if value, ok := suo.mutation.GitDeployPublicKey(); ok {
+		_spec.SetField(service.FieldGitDeployPublicKey, field.TypeString, value)
+	}
+	if suo.mutation.GitDeployPublicKeyCleared() {
+		_spec.ClearField(service.FieldGitDeployPublicKey, field.TypeString)
+	}
+	if value, ok := suo.mutation.GitSSHHostKey(); ok {
+		_spec.SetField(service.FieldGitSSHHostKey, field.TypeString, value)
+	}
+	if suo.mutation.GitSSHHostKeyCleared() {
+		_spec.ClearField(service.FieldGitSSHHostKey, field.TypeString)
+	}
+	if value, ok := suo.mutation.GitWebhookSecret(); ok {
+		_spec.SetField(service.FieldGitWebhookSecret, field.TypeString, value)
+	}
+	if suo.mutation.GitWebhookSecretCleared() {
+		_spec.ClearField(service.FieldGitWebhookSecret, field.TypeString)
+	}
 	if value, ok := suo.mutation.GitRepositoryOwner(); ok {
 		_spec.SetField(service.FieldGitRepositoryOwner, field.TypeString, value)
 	}
@@ -1525,6 +1871,12 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 	if suo.mutation.TemplateInstanceIDCleared() {
 		_spec.ClearField(service.FieldTemplateInstanceID, field.TypeUUID)
 	}
+	if value, ok := suo.mutation.DeployHookTokenHash(); ok {
+		_spec.SetField(service.FieldDeployHookTokenHash, field.TypeString, value)
+	}
+	if suo.mutation.DeployHookTokenHashCleared() {
+		_spec.ClearField(service.FieldDeployHookTokenHash, field.TypeString)
+	}
 	if suo.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1583,6 +1935,35 @@ func (suo *ServiceUpdateOne) sqlSave(ctx context.Context) (_node *Service, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.GitlabConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.GitlabConnectionTable,
+			Columns: []string{service.GitlabConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.GitlabConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   service.GitlabConnectionTable,
+			Columns: []string{service.GitlabConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if suo.mutation.ServiceConfigCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
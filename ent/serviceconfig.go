@@ -38,6 +38,16 @@ type ServiceConfig struct {
 	DockerBuilderDockerfilePath *string `json:"docker_builder_dockerfile_path,omitempty"`
 	// Path to Dockerfile context if using docker builder
 	DockerBuilderBuildContext *string `json:"docker_builder_build_context,omitempty"`
+	// Stage to build in a multi-stage Dockerfile if using docker builder
+	DockerBuilderTarget *string `json:"docker_builder_target,omitempty"`
+	// Build args if using docker builder, values can reference service variables as ${NAME}, which are stored in the image history
+	DockerBuilderBuildArgs map[string]string `json:"docker_builder_build_args,omitempty"`
+	// Named additional build contexts if using docker builder, a path in the repo or a source like docker-image://alpine:3.20
+	DockerBuilderBuildContexts map[string]string `json:"docker_builder_build_contexts,omitempty"`
+	// CNB builder image if using buildpacks builder, e.g. paketobuildpacks/builder-jammy-base
+	BuildpacksBuilderImage *string `json:"buildpacks_builder_image,omitempty"`
+	// Platforms to build the image for, e.g. linux/amd64 and linux/arm64, empty for the builder's own platform
+	Platforms []string `json:"platforms,omitempty"`
 	// Provider (e.g. Go, Python, Node, Deno)
 	RailpackProvider *enum.Provider `json:"railpack_provider,omitempty"`
 	// Framework of service - corresponds mostly to railpack results - e.g. Django, Next, Express, Gin
@@ -46,6 +56,13 @@ type ServiceConfig struct {
 	GitBranch *string `json:"git_branch,omitempty"`
 	// Tag to build from, supports glob patterns
 	GitTag *string `json:"git_tag,omitempty"`
+	// Glob patterns, a push only auto-deploys if it changes a matching file
+	WatchPaths []string `json:"watch_paths,omitempty"`
+	// Commit message marker that skips auto-deploy, in addition to [skip deploy] and [skip ci]
+	SkipDeployMarker *string `json:"skip_deploy_marker,omitempty"`
+	// Commit authors whose pushes don't auto-deploy, matched literally with * as wildcard, e.g. dependabot[bot]
+	IgnoredCommitAuthors []string `json:"ignored_commit_authors,omitempty"`
+	PrPreviewVariables   []string `json:"pr_preview_variables,omitempty"`
 	// External domains and paths for the service
 	Hosts []schema.HostSpec `json:"hosts,omitempty"`
 	// Container ports to expose
@@ -54,16 +71,30 @@ type ServiceConfig struct {
 	Replicas int32 `json:"replicas,omitempty"`
 	// Whether to automatically deploy on git push
 	AutoDeploy bool `json:"auto_deploy,omitempty"`
+	// Whether to roll back to the previous deployment when a new one stays unhealthy
+	AutoRollback bool `json:"auto_rollback,omitempty"`
+	// Whether to deploy pull requests against the branch to ephemeral preview environments
+	PrPreviews bool `json:"pr_previews,omitempty"`
 	// Custom install command (railpack only)
 	RailpackBuilderInstallCommand *string `json:"railpack_builder_install_command,omitempty"`
 	// Custom build command (railpack only)
 	RailpackBuilderBuildCommand *string `json:"railpack_builder_build_command,omitempty"`
 	// Custom run command
 	RunCommand *string `json:"run_command,omitempty"`
+	// Command to run as a one-off job with the new image before rollout, e.g. migrations
+	PreDeployCommand *string `json:"pre_deploy_command,omitempty"`
+	// How new deployments replace the running one
+	RolloutStrategy schema.RolloutStrategy `json:"rollout_strategy,omitempty"`
+	// Percentage of ingress traffic sent to a canary deployment
+	CanaryWeight int `json:"canary_weight,omitempty"`
 	// Whether the service is publicly accessible, creates an ingress resource
 	IsPublic bool `json:"is_public,omitempty"`
 	// Custom Docker image if not building from git
 	Image string `json:"image,omitempty"`
+	// Whether to redeploy when the image tag is re-pushed with a new digest
+	ImageAutoUpdate bool `json:"image_auto_update,omitempty"`
+	// Last seen digest of the image tag, used to detect re-pushes
+	ImageDigest *string `json:"image_digest,omitempty"`
 	// Version of the database custom resource definition
 	DefinitionVersion *string `json:"definition_version,omitempty"`
 	// Database configuration for the service
@@ -76,6 +107,10 @@ type ServiceConfig struct {
 	BackupSchedule string `json:"backup_schedule,omitempty"`
 	// Number of base backups to retain
 	BackupRetentionCount int `json:"backup_retention_count,omitempty"`
+	// S3 source to store uploaded source archives in
+	UploadS3SourceID *uuid.UUID `json:"upload_s3_source_id,omitempty"`
+	// S3 bucket to store uploaded source archives in
+	UploadS3Bucket *string `json:"upload_s3_bucket,omitempty"`
 	// Volumes to mount in the service
 	Volumes []schema.ServiceVolume `json:"volumes,omitempty"`
 	// Security context for the service containers.
@@ -102,9 +137,11 @@ type ServiceConfigEdges struct {
 	Service *Service `json:"service,omitempty"`
 	// S3BackupSources holds the value of the s3_backup_sources edge.
 	S3BackupSources *S3 `json:"s3_backup_sources,omitempty"`
+	// S3UploadSources holds the value of the s3_upload_sources edge.
+	S3UploadSources *S3 `json:"s3_upload_sources,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ServiceOrErr returns the Service value or an error if the edge
@@ -129,20 +166,31 @@ func (e ServiceConfigEdges) S3BackupSourcesOrErr() (*S3, error) {
 	return nil, &NotLoadedError{edge: "s3_backup_sources"}
 }
 
+// S3UploadSourcesOrErr returns the S3UploadSources value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceConfigEdges) S3UploadSourcesOrErr() (*S3, error) {
+	if e.S3UploadSources != nil {
+		return e.S3UploadSources, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: s3.Label}
+	}
+	return nil, &NotLoadedError{edge: "s3_upload_sources"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ServiceConfig) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case serviceconfig.FieldS3BackupSourceID:
+		case serviceconfig.FieldS3BackupSourceID, serviceconfig.FieldUploadS3SourceID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case serviceconfig.FieldHosts, serviceconfig.FieldPorts, serviceconfig.FieldDatabaseConfig, serviceconfig.FieldVolumes, serviceconfig.FieldSecurityContext, serviceconfig.FieldHealthCheck, serviceconfig.FieldVariableMounts, serviceconfig.FieldProtectedVariables, serviceconfig.FieldInitContainers, serviceconfig.FieldResources:
+		case serviceconfig.FieldDockerBuilderBuildArgs, serviceconfig.FieldDockerBuilderBuildContexts, serviceconfig.FieldPlatforms, serviceconfig.FieldWatchPaths, serviceconfig.FieldIgnoredCommitAuthors, serviceconfig.FieldPrPreviewVariables, serviceconfig.FieldHosts, serviceconfig.FieldPorts, serviceconfig.FieldDatabaseConfig, serviceconfig.FieldVolumes, serviceconfig.FieldSecurityContext, serviceconfig.FieldHealthCheck, serviceconfig.FieldVariableMounts, serviceconfig.FieldProtectedVariables, serviceconfig.FieldInitContainers, serviceconfig.FieldResources:
 			values[i] = new([]byte)
-		case serviceconfig.FieldAutoDeploy, serviceconfig.FieldIsPublic:
+		case serviceconfig.FieldAutoDeploy, serviceconfig.FieldAutoRollback, serviceconfig.FieldPrPreviews, serviceconfig.FieldIsPublic, serviceconfig.FieldImageAutoUpdate:
 			values[i] = new(sql.NullBool)
-		case serviceconfig.FieldReplicas, serviceconfig.FieldBackupRetentionCount:
+		case serviceconfig.FieldReplicas, serviceconfig.FieldCanaryWeight, serviceconfig.FieldBackupRetentionCount:
 			values[i] = new(sql.NullInt64)
-		case serviceconfig.FieldBuilder, serviceconfig.FieldIcon, serviceconfig.FieldDockerBuilderDockerfilePath, serviceconfig.FieldDockerBuilderBuildContext, serviceconfig.FieldRailpackProvider, serviceconfig.FieldRailpackFramework, serviceconfig.FieldGitBranch, serviceconfig.FieldGitTag, serviceconfig.FieldRailpackBuilderInstallCommand, serviceconfig.FieldRailpackBuilderBuildCommand, serviceconfig.FieldRunCommand, serviceconfig.FieldImage, serviceconfig.FieldDefinitionVersion, serviceconfig.FieldS3BackupBucket, serviceconfig.FieldBackupSchedule:
+		case serviceconfig.FieldBuilder, serviceconfig.FieldIcon, serviceconfig.FieldDockerBuilderDockerfilePath, serviceconfig.FieldDockerBuilderBuildContext, serviceconfig.FieldDockerBuilderTarget, serviceconfig.FieldBuildpacksBuilderImage, serviceconfig.FieldRailpackProvider, serviceconfig.FieldRailpackFramework, serviceconfig.FieldGitBranch, serviceconfig.FieldGitTag, serviceconfig.FieldSkipDeployMarker, serviceconfig.FieldRailpackBuilderInstallCommand, serviceconfig.FieldRailpackBuilderBuildCommand, serviceconfig.FieldRunCommand, serviceconfig.FieldPreDeployCommand, serviceconfig.FieldRolloutStrategy, serviceconfig.FieldImage, serviceconfig.FieldImageDigest, serviceconfig.FieldDefinitionVersion, serviceconfig.FieldS3BackupBucket, serviceconfig.FieldBackupSchedule, serviceconfig.FieldUploadS3Bucket:
 			values[i] = new(sql.NullString)
 		case serviceconfig.FieldCreatedAt, serviceconfig.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -213,6 +261,44 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 				sc.DockerBuilderBuildContext = new(string)
 				*sc.DockerBuilderBuildContext = value.String
 			}
+		case serviceconfig.FieldDockerBuilderTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_target", values[i])
+			} else if value.Valid {
+				sc.DockerBuilderTarget = new(string)
+				*sc.DockerBuilderTarget = value.String
+			}
+		case serviceconfig.FieldDockerBuilderBuildArgs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_build_args", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.DockerBuilderBuildArgs); err != nil {
+					return fmt.Errorf("unmarshal field docker_builder_build_args: %w", err)
+				}
+			}
+		case serviceconfig.FieldDockerBuilderBuildContexts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field docker_builder_build_contexts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.DockerBuilderBuildContexts); err != nil {
+					return fmt.Errorf("unmarshal field docker_builder_build_contexts: %w", err)
+				}
+			}
+		case serviceconfig.FieldBuildpacksBuilderImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buildpacks_builder_image", values[i])
+			} else if value.Valid {
+				sc.BuildpacksBuilderImage = new(string)
+				*sc.BuildpacksBuilderImage = value.String
+			}
+		case serviceconfig.FieldPlatforms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field platforms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.Platforms); err != nil {
+					return fmt.Errorf("unmarshal field platforms: %w", err)
+				}
+			}
 		case serviceconfig.FieldRailpackProvider:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field railpack_provider", values[i])
@@ -241,6 +327,37 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 				sc.GitTag = new(string)
 				*sc.GitTag = value.String
 			}
+		case serviceconfig.FieldWatchPaths:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field watch_paths", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.WatchPaths); err != nil {
+					return fmt.Errorf("unmarshal field watch_paths: %w", err)
+				}
+			}
+		case serviceconfig.FieldSkipDeployMarker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_deploy_marker", values[i])
+			} else if value.Valid {
+				sc.SkipDeployMarker = new(string)
+				*sc.SkipDeployMarker = value.String
+			}
+		case serviceconfig.FieldIgnoredCommitAuthors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ignored_commit_authors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.IgnoredCommitAuthors); err != nil {
+					return fmt.Errorf("unmarshal field ignored_commit_authors: %w", err)
+				}
+			}
+		case serviceconfig.FieldPrPreviewVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pr_preview_variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.PrPreviewVariables); err != nil {
+					return fmt.Errorf("unmarshal field pr_preview_variables: %w", err)
+				}
+			}
 		case serviceconfig.FieldHosts:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field hosts", values[i])
@@ -269,6 +386,18 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sc.AutoDeploy = value.Bool
 			}
+		case serviceconfig.FieldAutoRollback:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_rollback", values[i])
+			} else if value.Valid {
+				sc.AutoRollback = value.Bool
+			}
+		case serviceconfig.FieldPrPreviews:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pr_previews", values[i])
+			} else if value.Valid {
+				sc.PrPreviews = value.Bool
+			}
 		case serviceconfig.FieldRailpackBuilderInstallCommand:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field railpack_builder_install_command", values[i])
@@ -290,6 +419,25 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 				sc.RunCommand = new(string)
 				*sc.RunCommand = value.String
 			}
+		case serviceconfig.FieldPreDeployCommand:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pre_deploy_command", values[i])
+			} else if value.Valid {
+				sc.PreDeployCommand = new(string)
+				*sc.PreDeployCommand = value.String
+			}
+		case serviceconfig.FieldRolloutStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rollout_strategy", values[i])
+			} else if value.Valid {
+				sc.RolloutStrategy = schema.RolloutStrategy(value.String)
+			}
+		case serviceconfig.FieldCanaryWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field canary_weight", values[i])
+			} else if value.Valid {
+				sc.CanaryWeight = int(value.Int64)
+			}
 		case serviceconfig.FieldIsPublic:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_public", values[i])
@@ -302,6 +450,19 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sc.Image = value.String
 			}
+		case serviceconfig.FieldImageAutoUpdate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field image_auto_update", values[i])
+			} else if value.Valid {
+				sc.ImageAutoUpdate = value.Bool
+			}
+		case serviceconfig.FieldImageDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_digest", values[i])
+			} else if value.Valid {
+				sc.ImageDigest = new(string)
+				*sc.ImageDigest = value.String
+			}
 		case serviceconfig.FieldDefinitionVersion:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field definition_version", values[i])
@@ -343,6 +504,20 @@ func (sc *ServiceConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sc.BackupRetentionCount = int(value.Int64)
 			}
+		case serviceconfig.FieldUploadS3SourceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_s3_source_id", values[i])
+			} else if value.Valid {
+				sc.UploadS3SourceID = new(uuid.UUID)
+				*sc.UploadS3SourceID = *value.S.(*uuid.UUID)
+			}
+		case serviceconfig.FieldUploadS3Bucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_s3_bucket", values[i])
+			} else if value.Valid {
+				sc.UploadS3Bucket = new(string)
+				*sc.UploadS3Bucket = value.String
+			}
 		case serviceconfig.FieldVolumes:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field volumes", values[i])
@@ -422,6 +597,11 @@ func (sc *ServiceConfig) QueryS3BackupSources() *S3Query {
 	return NewServiceConfigClient(sc.config).QueryS3BackupSources(sc)
 }
 
+// QueryS3UploadSources queries the "s3_upload_sources" edge of the ServiceConfig entity.
+func (sc *ServiceConfig) QueryS3UploadSources() *S3Query {
+	return NewServiceConfigClient(sc.config).QueryS3UploadSources(sc)
+}
+
 // Update returns a builder for updating this ServiceConfig.
 // Note that you need to call ServiceConfig.Unwrap() before calling this method if this ServiceConfig
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -470,6 +650,25 @@ func (sc *ServiceConfig) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := sc.DockerBuilderTarget; v != nil {
+		builder.WriteString("docker_builder_target=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("docker_builder_build_args=")
+	builder.WriteString(fmt.Sprintf("%v", sc.DockerBuilderBuildArgs))
+	builder.WriteString(", ")
+	builder.WriteString("docker_builder_build_contexts=")
+	builder.WriteString(fmt.Sprintf("%v", sc.DockerBuilderBuildContexts))
+	builder.WriteString(", ")
+	if v := sc.BuildpacksBuilderImage; v != nil {
+		builder.WriteString("buildpacks_builder_image=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("platforms=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Platforms))
+	builder.WriteString(", ")
 	if v := sc.RailpackProvider; v != nil {
 		builder.WriteString("railpack_provider=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -490,6 +689,20 @@ func (sc *ServiceConfig) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("watch_paths=")
+	builder.WriteString(fmt.Sprintf("%v", sc.WatchPaths))
+	builder.WriteString(", ")
+	if v := sc.SkipDeployMarker; v != nil {
+		builder.WriteString("skip_deploy_marker=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ignored_commit_authors=")
+	builder.WriteString(fmt.Sprintf("%v", sc.IgnoredCommitAuthors))
+	builder.WriteString(", ")
+	builder.WriteString("pr_preview_variables=")
+	builder.WriteString(fmt.Sprintf("%v", sc.PrPreviewVariables))
+	builder.WriteString(", ")
 	builder.WriteString("hosts=")
 	builder.WriteString(fmt.Sprintf("%v", sc.Hosts))
 	builder.WriteString(", ")
@@ -502,6 +715,12 @@ func (sc *ServiceConfig) String() string {
 	builder.WriteString("auto_deploy=")
 	builder.WriteString(fmt.Sprintf("%v", sc.AutoDeploy))
 	builder.WriteString(", ")
+	builder.WriteString("auto_rollback=")
+	builder.WriteString(fmt.Sprintf("%v", sc.AutoRollback))
+	builder.WriteString(", ")
+	builder.WriteString("pr_previews=")
+	builder.WriteString(fmt.Sprintf("%v", sc.PrPreviews))
+	builder.WriteString(", ")
 	if v := sc.RailpackBuilderInstallCommand; v != nil {
 		builder.WriteString("railpack_builder_install_command=")
 		builder.WriteString(*v)
@@ -517,12 +736,31 @@ func (sc *ServiceConfig) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := sc.PreDeployCommand; v != nil {
+		builder.WriteString("pre_deploy_command=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rollout_strategy=")
+	builder.WriteString(fmt.Sprintf("%v", sc.RolloutStrategy))
+	builder.WriteString(", ")
+	builder.WriteString("canary_weight=")
+	builder.WriteString(fmt.Sprintf("%v", sc.CanaryWeight))
+	builder.WriteString(", ")
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", sc.IsPublic))
 	builder.WriteString(", ")
 	builder.WriteString("image=")
 	builder.WriteString(sc.Image)
 	builder.WriteString(", ")
+	builder.WriteString("image_auto_update=")
+	builder.WriteString(fmt.Sprintf("%v", sc.ImageAutoUpdate))
+	builder.WriteString(", ")
+	if v := sc.ImageDigest; v != nil {
+		builder.WriteString("image_digest=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := sc.DefinitionVersion; v != nil {
 		builder.WriteString("definition_version=")
 		builder.WriteString(*v)
@@ -547,6 +785,16 @@ func (sc *ServiceConfig) String() string {
 	builder.WriteString("backup_retention_count=")
 	builder.WriteString(fmt.Sprintf("%v", sc.BackupRetentionCount))
 	builder.WriteString(", ")
+	if v := sc.UploadS3SourceID; v != nil {
+		builder.WriteString("upload_s3_source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sc.UploadS3Bucket; v != nil {
+		builder.WriteString("upload_s3_bucket=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("volumes=")
 	builder.WriteString(fmt.Sprintf("%v", sc.Volumes))
 	builder.WriteString(", ")
@@ -32,6 +32,16 @@ const (
 	FieldDockerBuilderDockerfilePath = "docker_builder_dockerfile_path"
 	// FieldDockerBuilderBuildContext holds the string denoting the docker_builder_build_context field in the database.
 	FieldDockerBuilderBuildContext = "docker_builder_build_context"
+	// FieldDockerBuilderTarget holds the string denoting the docker_builder_target field in the database.
+	FieldDockerBuilderTarget = "docker_builder_target"
+	// FieldDockerBuilderBuildArgs holds the string denoting the docker_builder_build_args field in the database.
+	FieldDockerBuilderBuildArgs = "docker_builder_build_args"
+	// FieldDockerBuilderBuildContexts holds the string denoting the docker_builder_build_contexts field in the database.
+	FieldDockerBuilderBuildContexts = "docker_builder_build_contexts"
+	// FieldBuildpacksBuilderImage holds the string denoting the buildpacks_builder_image field in the database.
+	FieldBuildpacksBuilderImage = "buildpacks_builder_image"
+	// FieldPlatforms holds the string denoting the platforms field in the database.
+	FieldPlatforms = "platforms"
 	// FieldRailpackProvider holds the string denoting the railpack_provider field in the database.
 	FieldRailpackProvider = "railpack_provider"
 	// FieldRailpackFramework holds the string denoting the railpack_framework field in the database.
@@ -40,6 +50,14 @@ const (
 	FieldGitBranch = "git_branch"
 	// FieldGitTag holds the string denoting the git_tag field in the database.
 	FieldGitTag = "git_tag"
+	// FieldWatchPaths holds the string denoting the watch_paths field in the database.
+	FieldWatchPaths = "watch_paths"
+	// FieldSkipDeployMarker holds the string denoting the skip_deploy_marker field in the database.
+	FieldSkipDeployMarker = "skip_deploy_marker"
+	// FieldIgnoredCommitAuthors holds the string denoting the ignored_commit_authors field in the database.
+	FieldIgnoredCommitAuthors = "ignored_commit_authors"
+	// FieldPrPreviewVariables holds the string denoting the pr_preview_variables field in the database.
+	FieldPrPreviewVariables = "pr_preview_variables"
 	// FieldHosts holds the string denoting the hosts field in the database.
 	FieldHosts = "hosts"
 	// FieldPorts holds the string denoting the ports field in the database.
@@ -48,16 +66,30 @@ const (
 	FieldReplicas = "replicas"
 	// FieldAutoDeploy holds the string denoting the auto_deploy field in the database.
 	FieldAutoDeploy = "auto_deploy"
+	// FieldAutoRollback holds the string denoting the auto_rollback field in the database.
+	FieldAutoRollback = "auto_rollback"
+	// FieldPrPreviews holds the string denoting the pr_previews field in the database.
+	FieldPrPreviews = "pr_previews"
 	// FieldRailpackBuilderInstallCommand holds the string denoting the railpack_builder_install_command field in the database.
 	FieldRailpackBuilderInstallCommand = "railpack_builder_install_command"
 	// FieldRailpackBuilderBuildCommand holds the string denoting the railpack_builder_build_command field in the database.
 	FieldRailpackBuilderBuildCommand = "railpack_builder_build_command"
 	// FieldRunCommand holds the string denoting the run_command field in the database.
 	FieldRunCommand = "run_command"
+	// FieldPreDeployCommand holds the string denoting the pre_deploy_command field in the database.
+	FieldPreDeployCommand = "pre_deploy_command"
+	// FieldRolloutStrategy holds the string denoting the rollout_strategy field in the database.
+	FieldRolloutStrategy = "rollout_strategy"
+	// FieldCanaryWeight holds the string denoting the canary_weight field in the database.
+	FieldCanaryWeight = "canary_weight"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
 	// FieldImage holds the string denoting the image field in the database.
 	FieldImage = "image"
+	// FieldImageAutoUpdate holds the string denoting the image_auto_update field in the database.
+	FieldImageAutoUpdate = "image_auto_update"
+	// FieldImageDigest holds the string denoting the image_digest field in the database.
+	FieldImageDigest = "image_digest"
 	// FieldDefinitionVersion holds the string denoting the definition_version field in the database.
 	FieldDefinitionVersion = "definition_version"
 	// FieldDatabaseConfig holds the string denoting the database_config field in the database.
@@ -70,6 +102,10 @@ const (
 	FieldBackupSchedule = "backup_schedule"
 	// FieldBackupRetentionCount holds the string denoting the backup_retention_count field in the database.
 	FieldBackupRetentionCount = "backup_retention_count"
+	// FieldUploadS3SourceID holds the string denoting the upload_s3_source_id field in the database.
+	FieldUploadS3SourceID = "upload_s3_source_id"
+	// FieldUploadS3Bucket holds the string denoting the upload_s3_bucket field in the database.
+	FieldUploadS3Bucket = "upload_s3_bucket"
 	// FieldVolumes holds the string denoting the volumes field in the database.
 	FieldVolumes = "volumes"
 	// FieldSecurityContext holds the string denoting the security_context field in the database.
@@ -88,6 +124,8 @@ const (
 	EdgeService = "service"
 	// EdgeS3BackupSources holds the string denoting the s3_backup_sources edge name in mutations.
 	EdgeS3BackupSources = "s3_backup_sources"
+	// EdgeS3UploadSources holds the string denoting the s3_upload_sources edge name in mutations.
+	EdgeS3UploadSources = "s3_upload_sources"
 	// Table holds the table name of the serviceconfig in the database.
 	Table = "service_configs"
 	// ServiceTable is the table that holds the service relation/edge.
@@ -104,6 +142,13 @@ const (
 	S3BackupSourcesInverseTable = "s3_sources"
 	// S3BackupSourcesColumn is the table column denoting the s3_backup_sources relation/edge.
 	S3BackupSourcesColumn = "s3_backup_source_id"
+	// S3UploadSourcesTable is the table that holds the s3_upload_sources relation/edge.
+	S3UploadSourcesTable = "service_configs"
+	// S3UploadSourcesInverseTable is the table name for the S3 entity.
+	// It exists in this package in order to avoid circular dependency with the "s3" package.
+	S3UploadSourcesInverseTable = "s3_sources"
+	// S3UploadSourcesColumn is the table column denoting the s3_upload_sources relation/edge.
+	S3UploadSourcesColumn = "upload_s3_source_id"
 )
 
 // Columns holds all SQL columns for serviceconfig fields.
@@ -116,25 +161,43 @@ var Columns = []string{
 	FieldIcon,
 	FieldDockerBuilderDockerfilePath,
 	FieldDockerBuilderBuildContext,
+	FieldDockerBuilderTarget,
+	FieldDockerBuilderBuildArgs,
+	FieldDockerBuilderBuildContexts,
+	FieldBuildpacksBuilderImage,
+	FieldPlatforms,
 	FieldRailpackProvider,
 	FieldRailpackFramework,
 	FieldGitBranch,
 	FieldGitTag,
+	FieldWatchPaths,
+	FieldSkipDeployMarker,
+	FieldIgnoredCommitAuthors,
+	FieldPrPreviewVariables,
 	FieldHosts,
 	FieldPorts,
 	FieldReplicas,
 	FieldAutoDeploy,
+	FieldAutoRollback,
+	FieldPrPreviews,
 	FieldRailpackBuilderInstallCommand,
 	FieldRailpackBuilderBuildCommand,
 	FieldRunCommand,
+	FieldPreDeployCommand,
+	FieldRolloutStrategy,
+	FieldCanaryWeight,
 	FieldIsPublic,
 	FieldImage,
+	FieldImageAutoUpdate,
+	FieldImageDigest,
 	FieldDefinitionVersion,
 	FieldDatabaseConfig,
 	FieldS3BackupSourceID,
 	FieldS3BackupBucket,
 	FieldBackupSchedule,
 	FieldBackupRetentionCount,
+	FieldUploadS3SourceID,
+	FieldUploadS3Bucket,
 	FieldVolumes,
 	FieldSecurityContext,
 	FieldHealthCheck,
@@ -165,8 +228,16 @@ var (
 	DefaultReplicas int32
 	// DefaultAutoDeploy holds the default value on creation for the "auto_deploy" field.
 	DefaultAutoDeploy bool
+	// DefaultAutoRollback holds the default value on creation for the "auto_rollback" field.
+	DefaultAutoRollback bool
+	// DefaultPrPreviews holds the default value on creation for the "pr_previews" field.
+	DefaultPrPreviews bool
+	// DefaultCanaryWeight holds the default value on creation for the "canary_weight" field.
+	DefaultCanaryWeight int
 	// DefaultIsPublic holds the default value on creation for the "is_public" field.
 	DefaultIsPublic bool
+	// DefaultImageAutoUpdate holds the default value on creation for the "image_auto_update" field.
+	DefaultImageAutoUpdate bool
 	// DefaultBackupSchedule holds the default value on creation for the "backup_schedule" field.
 	DefaultBackupSchedule string
 	// DefaultBackupRetentionCount holds the default value on creation for the "backup_retention_count" field.
@@ -178,7 +249,7 @@ var (
 // BuilderValidator is a validator for the "builder" field enum values. It is called by the builders before save.
 func BuilderValidator(b schema.ServiceBuilder) error {
 	switch b {
-	case "railpack", "docker", "database":
+	case "railpack", "docker", "database", "buildpacks":
 		return nil
 	default:
 		return fmt.Errorf("serviceconfig: invalid enum value for builder field: %q", b)
@@ -205,6 +276,18 @@ func RailpackFrameworkValidator(rf enum.Framework) error {
 	}
 }
 
+const DefaultRolloutStrategy schema.RolloutStrategy = "rolling"
+
+// RolloutStrategyValidator is a validator for the "rollout_strategy" field enum values. It is called by the builders before save.
+func RolloutStrategyValidator(rs schema.RolloutStrategy) error {
+	switch rs {
+	case "rolling", "blue-green", "canary":
+		return nil
+	default:
+		return fmt.Errorf("serviceconfig: invalid enum value for rollout_strategy field: %q", rs)
+	}
+}
+
 // OrderOption defines the ordering options for the ServiceConfig queries.
 type OrderOption func(*sql.Selector)
 
@@ -248,6 +331,16 @@ func ByDockerBuilderBuildContext(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDockerBuilderBuildContext, opts...).ToFunc()
 }
 
+// ByDockerBuilderTarget orders the results by the docker_builder_target field.
+func ByDockerBuilderTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDockerBuilderTarget, opts...).ToFunc()
+}
+
+// ByBuildpacksBuilderImage orders the results by the buildpacks_builder_image field.
+func ByBuildpacksBuilderImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildpacksBuilderImage, opts...).ToFunc()
+}
+
 // ByRailpackProvider orders the results by the railpack_provider field.
 func ByRailpackProvider(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRailpackProvider, opts...).ToFunc()
@@ -268,6 +361,11 @@ func ByGitTag(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGitTag, opts...).ToFunc()
 }
 
+// BySkipDeployMarker orders the results by the skip_deploy_marker field.
+func BySkipDeployMarker(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipDeployMarker, opts...).ToFunc()
+}
+
 // ByReplicas orders the results by the replicas field.
 func ByReplicas(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReplicas, opts...).ToFunc()
@@ -278,6 +376,16 @@ func ByAutoDeploy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAutoDeploy, opts...).ToFunc()
 }
 
+// ByAutoRollback orders the results by the auto_rollback field.
+func ByAutoRollback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoRollback, opts...).ToFunc()
+}
+
+// ByPrPreviews orders the results by the pr_previews field.
+func ByPrPreviews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrPreviews, opts...).ToFunc()
+}
+
 // ByRailpackBuilderInstallCommand orders the results by the railpack_builder_install_command field.
 func ByRailpackBuilderInstallCommand(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRailpackBuilderInstallCommand, opts...).ToFunc()
@@ -293,6 +401,21 @@ func ByRunCommand(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRunCommand, opts...).ToFunc()
 }
 
+// ByPreDeployCommand orders the results by the pre_deploy_command field.
+func ByPreDeployCommand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreDeployCommand, opts...).ToFunc()
+}
+
+// ByRolloutStrategy orders the results by the rollout_strategy field.
+func ByRolloutStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolloutStrategy, opts...).ToFunc()
+}
+
+// ByCanaryWeight orders the results by the canary_weight field.
+func ByCanaryWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanaryWeight, opts...).ToFunc()
+}
+
 // ByIsPublic orders the results by the is_public field.
 func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
@@ -303,6 +426,16 @@ func ByImage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImage, opts...).ToFunc()
 }
 
+// ByImageAutoUpdate orders the results by the image_auto_update field.
+func ByImageAutoUpdate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageAutoUpdate, opts...).ToFunc()
+}
+
+// ByImageDigest orders the results by the image_digest field.
+func ByImageDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageDigest, opts...).ToFunc()
+}
+
 // ByDefinitionVersion orders the results by the definition_version field.
 func ByDefinitionVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDefinitionVersion, opts...).ToFunc()
@@ -328,6 +461,16 @@ func ByBackupRetentionCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBackupRetentionCount, opts...).ToFunc()
 }
 
+// ByUploadS3SourceID orders the results by the upload_s3_source_id field.
+func ByUploadS3SourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadS3SourceID, opts...).ToFunc()
+}
+
+// ByUploadS3Bucket orders the results by the upload_s3_bucket field.
+func ByUploadS3Bucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadS3Bucket, opts...).ToFunc()
+}
+
 // ByServiceField orders the results by service field.
 func ByServiceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -341,6 +484,13 @@ func ByS3BackupSourcesField(field string, opts ...sql.OrderTermOption) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newS3BackupSourcesStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByS3UploadSourcesField orders the results by s3_upload_sources field.
+func ByS3UploadSourcesField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newS3UploadSourcesStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newServiceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -355,3 +505,10 @@ func newS3BackupSourcesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, S3BackupSourcesTable, S3BackupSourcesColumn),
 	)
 }
+func newS3UploadSourcesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(S3UploadSourcesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, S3UploadSourcesTable, S3UploadSourcesColumn),
+	)
+}
@@ -88,6 +88,16 @@ func DockerBuilderBuildContext(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldDockerBuilderBuildContext, v))
 }
 
+// DockerBuilderTarget applies equality check predicate on the "docker_builder_target" field. It's identical to DockerBuilderTargetEQ.
+func DockerBuilderTarget(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldDockerBuilderTarget, v))
+}
+
+// BuildpacksBuilderImage applies equality check predicate on the "buildpacks_builder_image" field. It's identical to BuildpacksBuilderImageEQ.
+func BuildpacksBuilderImage(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldBuildpacksBuilderImage, v))
+}
+
 // GitBranch applies equality check predicate on the "git_branch" field. It's identical to GitBranchEQ.
 func GitBranch(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldGitBranch, v))
@@ -98,6 +108,11 @@ func GitTag(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldGitTag, v))
 }
 
+// SkipDeployMarker applies equality check predicate on the "skip_deploy_marker" field. It's identical to SkipDeployMarkerEQ.
+func SkipDeployMarker(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldSkipDeployMarker, v))
+}
+
 // Replicas applies equality check predicate on the "replicas" field. It's identical to ReplicasEQ.
 func Replicas(v int32) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldReplicas, v))
@@ -108,6 +123,16 @@ func AutoDeploy(v bool) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldAutoDeploy, v))
 }
 
+// AutoRollback applies equality check predicate on the "auto_rollback" field. It's identical to AutoRollbackEQ.
+func AutoRollback(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldAutoRollback, v))
+}
+
+// PrPreviews applies equality check predicate on the "pr_previews" field. It's identical to PrPreviewsEQ.
+func PrPreviews(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldPrPreviews, v))
+}
+
 // RailpackBuilderInstallCommand applies equality check predicate on the "railpack_builder_install_command" field. It's identical to RailpackBuilderInstallCommandEQ.
 func RailpackBuilderInstallCommand(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldRailpackBuilderInstallCommand, v))
@@ -123,6 +148,16 @@ func RunCommand(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldRunCommand, v))
 }
 
+// PreDeployCommand applies equality check predicate on the "pre_deploy_command" field. It's identical to PreDeployCommandEQ.
+func PreDeployCommand(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldPreDeployCommand, v))
+}
+
+// CanaryWeight applies equality check predicate on the "canary_weight" field. It's identical to CanaryWeightEQ.
+func CanaryWeight(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldCanaryWeight, v))
+}
+
 // IsPublic applies equality check predicate on the "is_public" field. It's identical to IsPublicEQ.
 func IsPublic(v bool) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldIsPublic, v))
@@ -133,6 +168,16 @@ func Image(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldImage, v))
 }
 
+// ImageAutoUpdate applies equality check predicate on the "image_auto_update" field. It's identical to ImageAutoUpdateEQ.
+func ImageAutoUpdate(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldImageAutoUpdate, v))
+}
+
+// ImageDigest applies equality check predicate on the "image_digest" field. It's identical to ImageDigestEQ.
+func ImageDigest(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldImageDigest, v))
+}
+
 // DefinitionVersion applies equality check predicate on the "definition_version" field. It's identical to DefinitionVersionEQ.
 func DefinitionVersion(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldDefinitionVersion, v))
@@ -158,6 +203,16 @@ func BackupRetentionCount(v int) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldBackupRetentionCount, v))
 }
 
+// UploadS3SourceID applies equality check predicate on the "upload_s3_source_id" field. It's identical to UploadS3SourceIDEQ.
+func UploadS3SourceID(v uuid.UUID) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldUploadS3SourceID, v))
+}
+
+// UploadS3Bucket applies equality check predicate on the "upload_s3_bucket" field. It's identical to UploadS3BucketEQ.
+func UploadS3Bucket(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldUploadS3Bucket, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldCreatedAt, v))
@@ -503,6 +558,186 @@ func DockerBuilderBuildContextContainsFold(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldContainsFold(FieldDockerBuilderBuildContext, v))
 }
 
+// DockerBuilderTargetEQ applies the EQ predicate on the "docker_builder_target" field.
+func DockerBuilderTargetEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetNEQ applies the NEQ predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetIn applies the In predicate on the "docker_builder_target" field.
+func DockerBuilderTargetIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldDockerBuilderTarget, vs...))
+}
+
+// DockerBuilderTargetNotIn applies the NotIn predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldDockerBuilderTarget, vs...))
+}
+
+// DockerBuilderTargetGT applies the GT predicate on the "docker_builder_target" field.
+func DockerBuilderTargetGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetGTE applies the GTE predicate on the "docker_builder_target" field.
+func DockerBuilderTargetGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetLT applies the LT predicate on the "docker_builder_target" field.
+func DockerBuilderTargetLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetLTE applies the LTE predicate on the "docker_builder_target" field.
+func DockerBuilderTargetLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetContains applies the Contains predicate on the "docker_builder_target" field.
+func DockerBuilderTargetContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetHasPrefix applies the HasPrefix predicate on the "docker_builder_target" field.
+func DockerBuilderTargetHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetHasSuffix applies the HasSuffix predicate on the "docker_builder_target" field.
+func DockerBuilderTargetHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetIsNil applies the IsNil predicate on the "docker_builder_target" field.
+func DockerBuilderTargetIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldDockerBuilderTarget))
+}
+
+// DockerBuilderTargetNotNil applies the NotNil predicate on the "docker_builder_target" field.
+func DockerBuilderTargetNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldDockerBuilderTarget))
+}
+
+// DockerBuilderTargetEqualFold applies the EqualFold predicate on the "docker_builder_target" field.
+func DockerBuilderTargetEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderTargetContainsFold applies the ContainsFold predicate on the "docker_builder_target" field.
+func DockerBuilderTargetContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldDockerBuilderTarget, v))
+}
+
+// DockerBuilderBuildArgsIsNil applies the IsNil predicate on the "docker_builder_build_args" field.
+func DockerBuilderBuildArgsIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldDockerBuilderBuildArgs))
+}
+
+// DockerBuilderBuildArgsNotNil applies the NotNil predicate on the "docker_builder_build_args" field.
+func DockerBuilderBuildArgsNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldDockerBuilderBuildArgs))
+}
+
+// DockerBuilderBuildContextsIsNil applies the IsNil predicate on the "docker_builder_build_contexts" field.
+func DockerBuilderBuildContextsIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldDockerBuilderBuildContexts))
+}
+
+// DockerBuilderBuildContextsNotNil applies the NotNil predicate on the "docker_builder_build_contexts" field.
+func DockerBuilderBuildContextsNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldDockerBuilderBuildContexts))
+}
+
+// BuildpacksBuilderImageEQ applies the EQ predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageNEQ applies the NEQ predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageIn applies the In predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldBuildpacksBuilderImage, vs...))
+}
+
+// BuildpacksBuilderImageNotIn applies the NotIn predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldBuildpacksBuilderImage, vs...))
+}
+
+// BuildpacksBuilderImageGT applies the GT predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageGTE applies the GTE predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageLT applies the LT predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageLTE applies the LTE predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageContains applies the Contains predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageHasPrefix applies the HasPrefix predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageHasSuffix applies the HasSuffix predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageIsNil applies the IsNil predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldBuildpacksBuilderImage))
+}
+
+// BuildpacksBuilderImageNotNil applies the NotNil predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldBuildpacksBuilderImage))
+}
+
+// BuildpacksBuilderImageEqualFold applies the EqualFold predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldBuildpacksBuilderImage, v))
+}
+
+// BuildpacksBuilderImageContainsFold applies the ContainsFold predicate on the "buildpacks_builder_image" field.
+func BuildpacksBuilderImageContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldBuildpacksBuilderImage, v))
+}
+
+// PlatformsIsNil applies the IsNil predicate on the "platforms" field.
+func PlatformsIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldPlatforms))
+}
+
+// PlatformsNotNil applies the NotNil predicate on the "platforms" field.
+func PlatformsNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldPlatforms))
+}
+
 // RailpackProviderEQ applies the EQ predicate on the "railpack_provider" field.
 func RailpackProviderEQ(v enum.Provider) predicate.ServiceConfig {
 	vc := v
@@ -733,6 +968,111 @@ func GitTagContainsFold(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldContainsFold(FieldGitTag, v))
 }
 
+// WatchPathsIsNil applies the IsNil predicate on the "watch_paths" field.
+func WatchPathsIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldWatchPaths))
+}
+
+// WatchPathsNotNil applies the NotNil predicate on the "watch_paths" field.
+func WatchPathsNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldWatchPaths))
+}
+
+// SkipDeployMarkerEQ applies the EQ predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerNEQ applies the NEQ predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerIn applies the In predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldSkipDeployMarker, vs...))
+}
+
+// SkipDeployMarkerNotIn applies the NotIn predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldSkipDeployMarker, vs...))
+}
+
+// SkipDeployMarkerGT applies the GT predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerGTE applies the GTE predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerLT applies the LT predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerLTE applies the LTE predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerContains applies the Contains predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerHasPrefix applies the HasPrefix predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerHasSuffix applies the HasSuffix predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerIsNil applies the IsNil predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldSkipDeployMarker))
+}
+
+// SkipDeployMarkerNotNil applies the NotNil predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldSkipDeployMarker))
+}
+
+// SkipDeployMarkerEqualFold applies the EqualFold predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldSkipDeployMarker, v))
+}
+
+// SkipDeployMarkerContainsFold applies the ContainsFold predicate on the "skip_deploy_marker" field.
+func SkipDeployMarkerContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldSkipDeployMarker, v))
+}
+
+// IgnoredCommitAuthorsIsNil applies the IsNil predicate on the "ignored_commit_authors" field.
+func IgnoredCommitAuthorsIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldIgnoredCommitAuthors))
+}
+
+// IgnoredCommitAuthorsNotNil applies the NotNil predicate on the "ignored_commit_authors" field.
+func IgnoredCommitAuthorsNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldIgnoredCommitAuthors))
+}
+
+// PrPreviewVariablesIsNil applies the IsNil predicate on the "pr_preview_variables" field.
+func PrPreviewVariablesIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldPrPreviewVariables))
+}
+
+// PrPreviewVariablesNotNil applies the NotNil predicate on the "pr_preview_variables" field.
+func PrPreviewVariablesNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldPrPreviewVariables))
+}
+
 // HostsIsNil applies the IsNil predicate on the "hosts" field.
 func HostsIsNil() predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldIsNull(FieldHosts))
@@ -803,6 +1143,26 @@ func AutoDeployNEQ(v bool) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldNEQ(FieldAutoDeploy, v))
 }
 
+// AutoRollbackEQ applies the EQ predicate on the "auto_rollback" field.
+func AutoRollbackEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldAutoRollback, v))
+}
+
+// AutoRollbackNEQ applies the NEQ predicate on the "auto_rollback" field.
+func AutoRollbackNEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldAutoRollback, v))
+}
+
+// PrPreviewsEQ applies the EQ predicate on the "pr_previews" field.
+func PrPreviewsEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldPrPreviews, v))
+}
+
+// PrPreviewsNEQ applies the NEQ predicate on the "pr_previews" field.
+func PrPreviewsNEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldPrPreviews, v))
+}
+
 // RailpackBuilderInstallCommandEQ applies the EQ predicate on the "railpack_builder_install_command" field.
 func RailpackBuilderInstallCommandEQ(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldRailpackBuilderInstallCommand, v))
@@ -1028,6 +1388,151 @@ func RunCommandContainsFold(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldContainsFold(FieldRunCommand, v))
 }
 
+// PreDeployCommandEQ applies the EQ predicate on the "pre_deploy_command" field.
+func PreDeployCommandEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandNEQ applies the NEQ predicate on the "pre_deploy_command" field.
+func PreDeployCommandNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandIn applies the In predicate on the "pre_deploy_command" field.
+func PreDeployCommandIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldPreDeployCommand, vs...))
+}
+
+// PreDeployCommandNotIn applies the NotIn predicate on the "pre_deploy_command" field.
+func PreDeployCommandNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldPreDeployCommand, vs...))
+}
+
+// PreDeployCommandGT applies the GT predicate on the "pre_deploy_command" field.
+func PreDeployCommandGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandGTE applies the GTE predicate on the "pre_deploy_command" field.
+func PreDeployCommandGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandLT applies the LT predicate on the "pre_deploy_command" field.
+func PreDeployCommandLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandLTE applies the LTE predicate on the "pre_deploy_command" field.
+func PreDeployCommandLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandContains applies the Contains predicate on the "pre_deploy_command" field.
+func PreDeployCommandContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandHasPrefix applies the HasPrefix predicate on the "pre_deploy_command" field.
+func PreDeployCommandHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandHasSuffix applies the HasSuffix predicate on the "pre_deploy_command" field.
+func PreDeployCommandHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandIsNil applies the IsNil predicate on the "pre_deploy_command" field.
+func PreDeployCommandIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldPreDeployCommand))
+}
+
+// PreDeployCommandNotNil applies the NotNil predicate on the "pre_deploy_command" field.
+func PreDeployCommandNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldPreDeployCommand))
+}
+
+// PreDeployCommandEqualFold applies the EqualFold predicate on the "pre_deploy_command" field.
+func PreDeployCommandEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldPreDeployCommand, v))
+}
+
+// PreDeployCommandContainsFold applies the ContainsFold predicate on the "pre_deploy_command" field.
+func PreDeployCommandContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldPreDeployCommand, v))
+}
+
+// RolloutStrategyEQ applies the EQ predicate on the "rollout_strategy" field.
+func RolloutStrategyEQ(v schema.RolloutStrategy) predicate.ServiceConfig {
+	vc := v
+	return predicate.ServiceConfig(sql.FieldEQ(FieldRolloutStrategy, vc))
+}
+
+// RolloutStrategyNEQ applies the NEQ predicate on the "rollout_strategy" field.
+func RolloutStrategyNEQ(v schema.RolloutStrategy) predicate.ServiceConfig {
+	vc := v
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldRolloutStrategy, vc))
+}
+
+// RolloutStrategyIn applies the In predicate on the "rollout_strategy" field.
+func RolloutStrategyIn(vs ...schema.RolloutStrategy) predicate.ServiceConfig {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceConfig(sql.FieldIn(FieldRolloutStrategy, v...))
+}
+
+// RolloutStrategyNotIn applies the NotIn predicate on the "rollout_strategy" field.
+func RolloutStrategyNotIn(vs ...schema.RolloutStrategy) predicate.ServiceConfig {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldRolloutStrategy, v...))
+}
+
+// CanaryWeightEQ applies the EQ predicate on the "canary_weight" field.
+func CanaryWeightEQ(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldCanaryWeight, v))
+}
+
+// CanaryWeightNEQ applies the NEQ predicate on the "canary_weight" field.
+func CanaryWeightNEQ(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldCanaryWeight, v))
+}
+
+// CanaryWeightIn applies the In predicate on the "canary_weight" field.
+func CanaryWeightIn(vs ...int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldCanaryWeight, vs...))
+}
+
+// CanaryWeightNotIn applies the NotIn predicate on the "canary_weight" field.
+func CanaryWeightNotIn(vs ...int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldCanaryWeight, vs...))
+}
+
+// CanaryWeightGT applies the GT predicate on the "canary_weight" field.
+func CanaryWeightGT(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldCanaryWeight, v))
+}
+
+// CanaryWeightGTE applies the GTE predicate on the "canary_weight" field.
+func CanaryWeightGTE(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldCanaryWeight, v))
+}
+
+// CanaryWeightLT applies the LT predicate on the "canary_weight" field.
+func CanaryWeightLT(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldCanaryWeight, v))
+}
+
+// CanaryWeightLTE applies the LTE predicate on the "canary_weight" field.
+func CanaryWeightLTE(v int) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldCanaryWeight, v))
+}
+
 // IsPublicEQ applies the EQ predicate on the "is_public" field.
 func IsPublicEQ(v bool) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldIsPublic, v))
@@ -1113,6 +1618,91 @@ func ImageContainsFold(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldContainsFold(FieldImage, v))
 }
 
+// ImageAutoUpdateEQ applies the EQ predicate on the "image_auto_update" field.
+func ImageAutoUpdateEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldImageAutoUpdate, v))
+}
+
+// ImageAutoUpdateNEQ applies the NEQ predicate on the "image_auto_update" field.
+func ImageAutoUpdateNEQ(v bool) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldImageAutoUpdate, v))
+}
+
+// ImageDigestEQ applies the EQ predicate on the "image_digest" field.
+func ImageDigestEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldImageDigest, v))
+}
+
+// ImageDigestNEQ applies the NEQ predicate on the "image_digest" field.
+func ImageDigestNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldImageDigest, v))
+}
+
+// ImageDigestIn applies the In predicate on the "image_digest" field.
+func ImageDigestIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldImageDigest, vs...))
+}
+
+// ImageDigestNotIn applies the NotIn predicate on the "image_digest" field.
+func ImageDigestNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldImageDigest, vs...))
+}
+
+// ImageDigestGT applies the GT predicate on the "image_digest" field.
+func ImageDigestGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldImageDigest, v))
+}
+
+// ImageDigestGTE applies the GTE predicate on the "image_digest" field.
+func ImageDigestGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldImageDigest, v))
+}
+
+// ImageDigestLT applies the LT predicate on the "image_digest" field.
+func ImageDigestLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldImageDigest, v))
+}
+
+// ImageDigestLTE applies the LTE predicate on the "image_digest" field.
+func ImageDigestLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldImageDigest, v))
+}
+
+// ImageDigestContains applies the Contains predicate on the "image_digest" field.
+func ImageDigestContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldImageDigest, v))
+}
+
+// ImageDigestHasPrefix applies the HasPrefix predicate on the "image_digest" field.
+func ImageDigestHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldImageDigest, v))
+}
+
+// ImageDigestHasSuffix applies the HasSuffix predicate on the "image_digest" field.
+func ImageDigestHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldImageDigest, v))
+}
+
+// ImageDigestIsNil applies the IsNil predicate on the "image_digest" field.
+func ImageDigestIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldImageDigest))
+}
+
+// ImageDigestNotNil applies the NotNil predicate on the "image_digest" field.
+func ImageDigestNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldImageDigest))
+}
+
+// ImageDigestEqualFold applies the EqualFold predicate on the "image_digest" field.
+func ImageDigestEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldImageDigest, v))
+}
+
+// ImageDigestContainsFold applies the ContainsFold predicate on the "image_digest" field.
+func ImageDigestContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldImageDigest, v))
+}
+
 // DefinitionVersionEQ applies the EQ predicate on the "definition_version" field.
 func DefinitionVersionEQ(v string) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldEQ(FieldDefinitionVersion, v))
@@ -1408,6 +1998,111 @@ func BackupRetentionCountLTE(v int) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldLTE(FieldBackupRetentionCount, v))
 }
 
+// UploadS3SourceIDEQ applies the EQ predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDEQ(v uuid.UUID) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldUploadS3SourceID, v))
+}
+
+// UploadS3SourceIDNEQ applies the NEQ predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDNEQ(v uuid.UUID) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldUploadS3SourceID, v))
+}
+
+// UploadS3SourceIDIn applies the In predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDIn(vs ...uuid.UUID) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldUploadS3SourceID, vs...))
+}
+
+// UploadS3SourceIDNotIn applies the NotIn predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDNotIn(vs ...uuid.UUID) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldUploadS3SourceID, vs...))
+}
+
+// UploadS3SourceIDIsNil applies the IsNil predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldUploadS3SourceID))
+}
+
+// UploadS3SourceIDNotNil applies the NotNil predicate on the "upload_s3_source_id" field.
+func UploadS3SourceIDNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldUploadS3SourceID))
+}
+
+// UploadS3BucketEQ applies the EQ predicate on the "upload_s3_bucket" field.
+func UploadS3BucketEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEQ(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketNEQ applies the NEQ predicate on the "upload_s3_bucket" field.
+func UploadS3BucketNEQ(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNEQ(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketIn applies the In predicate on the "upload_s3_bucket" field.
+func UploadS3BucketIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIn(FieldUploadS3Bucket, vs...))
+}
+
+// UploadS3BucketNotIn applies the NotIn predicate on the "upload_s3_bucket" field.
+func UploadS3BucketNotIn(vs ...string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotIn(FieldUploadS3Bucket, vs...))
+}
+
+// UploadS3BucketGT applies the GT predicate on the "upload_s3_bucket" field.
+func UploadS3BucketGT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGT(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketGTE applies the GTE predicate on the "upload_s3_bucket" field.
+func UploadS3BucketGTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldGTE(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketLT applies the LT predicate on the "upload_s3_bucket" field.
+func UploadS3BucketLT(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLT(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketLTE applies the LTE predicate on the "upload_s3_bucket" field.
+func UploadS3BucketLTE(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldLTE(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketContains applies the Contains predicate on the "upload_s3_bucket" field.
+func UploadS3BucketContains(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContains(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketHasPrefix applies the HasPrefix predicate on the "upload_s3_bucket" field.
+func UploadS3BucketHasPrefix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasPrefix(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketHasSuffix applies the HasSuffix predicate on the "upload_s3_bucket" field.
+func UploadS3BucketHasSuffix(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldHasSuffix(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketIsNil applies the IsNil predicate on the "upload_s3_bucket" field.
+func UploadS3BucketIsNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldIsNull(FieldUploadS3Bucket))
+}
+
+// UploadS3BucketNotNil applies the NotNil predicate on the "upload_s3_bucket" field.
+func UploadS3BucketNotNil() predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldNotNull(FieldUploadS3Bucket))
+}
+
+// UploadS3BucketEqualFold applies the EqualFold predicate on the "upload_s3_bucket" field.
+func UploadS3BucketEqualFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldEqualFold(FieldUploadS3Bucket, v))
+}
+
+// UploadS3BucketContainsFold applies the ContainsFold predicate on the "upload_s3_bucket" field.
+func UploadS3BucketContainsFold(v string) predicate.ServiceConfig {
+	return predicate.ServiceConfig(sql.FieldContainsFold(FieldUploadS3Bucket, v))
+}
+
 // VolumesIsNil applies the IsNil predicate on the "volumes" field.
 func VolumesIsNil() predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.FieldIsNull(FieldVolumes))
@@ -1524,6 +2219,29 @@ func HasS3BackupSourcesWith(preds ...predicate.S3) predicate.ServiceConfig {
 	})
 }
 
+// HasS3UploadSources applies the HasEdge predicate on the "s3_upload_sources" edge.
+func HasS3UploadSources() predicate.ServiceConfig {
+	return predicate.ServiceConfig(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, S3UploadSourcesTable, S3UploadSourcesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasS3UploadSourcesWith applies the HasEdge predicate on the "s3_upload_sources" edge with a given conditions (other predicates).
+func HasS3UploadSourcesWith(preds ...predicate.S3) predicate.ServiceConfig {
+	return predicate.ServiceConfig(func(s *sql.Selector) {
+		step := newS3UploadSourcesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ServiceConfig) predicate.ServiceConfig {
 	return predicate.ServiceConfig(sql.AndPredicates(predicates...))
@@ -102,6 +102,52 @@ func (scc *ServiceConfigCreate) SetNillableDockerBuilderBuildContext(s *string)
 	return scc
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (scc *ServiceConfigCreate) SetDockerBuilderTarget(v string) *ServiceConfigCreate {
+	scc.mutation.SetDockerBuilderTarget(v)
+	return scc
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableDockerBuilderTarget(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetDockerBuilderTarget(*v)
+	}
+	return scc
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (scc *ServiceConfigCreate) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigCreate {
+	scc.mutation.SetDockerBuilderBuildArgs(v)
+	return scc
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (scc *ServiceConfigCreate) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigCreate {
+	scc.mutation.SetDockerBuilderBuildContexts(v)
+	return scc
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (scc *ServiceConfigCreate) SetBuildpacksBuilderImage(v string) *ServiceConfigCreate {
+	scc.mutation.SetBuildpacksBuilderImage(v)
+	return scc
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableBuildpacksBuilderImage(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetBuildpacksBuilderImage(*v)
+	}
+	return scc
+}
+
+// SetPlatforms sets the "platforms" field.
+func (scc *ServiceConfigCreate) SetPlatforms(v []string) *ServiceConfigCreate {
+	scc.mutation.SetPlatforms(v)
+	return scc
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (scc *ServiceConfigCreate) SetRailpackProvider(e enum.Provider) *ServiceConfigCreate {
 	scc.mutation.SetRailpackProvider(e)
@@ -158,6 +204,38 @@ func (scc *ServiceConfigCreate) SetNillableGitTag(s *string) *ServiceConfigCreat
 	return scc
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (scc *ServiceConfigCreate) SetWatchPaths(v []string) *ServiceConfigCreate {
+	scc.mutation.SetWatchPaths(v)
+	return scc
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (scc *ServiceConfigCreate) SetSkipDeployMarker(v string) *ServiceConfigCreate {
+	scc.mutation.SetSkipDeployMarker(v)
+	return scc
+}
+
+// SetNillableSkipDeployMarker sets the "skip_deploy_marker" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableSkipDeployMarker(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetSkipDeployMarker(*v)
+	}
+	return scc
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (scc *ServiceConfigCreate) SetIgnoredCommitAuthors(v []string) *ServiceConfigCreate {
+	scc.mutation.SetIgnoredCommitAuthors(v)
+	return scc
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (scc *ServiceConfigCreate) SetPrPreviewVariables(v []string) *ServiceConfigCreate {
+	scc.mutation.SetPrPreviewVariables(v)
+	return scc
+}
+
 // SetHosts sets the "hosts" field.
 func (scc *ServiceConfigCreate) SetHosts(ss []schema.HostSpec) *ServiceConfigCreate {
 	scc.mutation.SetHosts(ss)
@@ -198,6 +276,34 @@ func (scc *ServiceConfigCreate) SetNillableAutoDeploy(b *bool) *ServiceConfigCre
 	return scc
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (scc *ServiceConfigCreate) SetAutoRollback(v bool) *ServiceConfigCreate {
+	scc.mutation.SetAutoRollback(v)
+	return scc
+}
+
+// SetNillableAutoRollback sets the "auto_rollback" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableAutoRollback(v *bool) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetAutoRollback(*v)
+	}
+	return scc
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (scc *ServiceConfigCreate) SetPrPreviews(v bool) *ServiceConfigCreate {
+	scc.mutation.SetPrPreviews(v)
+	return scc
+}
+
+// SetNillablePrPreviews sets the "pr_previews" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillablePrPreviews(v *bool) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetPrPreviews(*v)
+	}
+	return scc
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (scc *ServiceConfigCreate) SetRailpackBuilderInstallCommand(s string) *ServiceConfigCreate {
 	scc.mutation.SetRailpackBuilderInstallCommand(s)
@@ -240,6 +346,48 @@ func (scc *ServiceConfigCreate) SetNillableRunCommand(s *string) *ServiceConfigC
 	return scc
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (scc *ServiceConfigCreate) SetPreDeployCommand(v string) *ServiceConfigCreate {
+	scc.mutation.SetPreDeployCommand(v)
+	return scc
+}
+
+// SetNillablePreDeployCommand sets the "pre_deploy_command" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillablePreDeployCommand(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetPreDeployCommand(*v)
+	}
+	return scc
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (scc *ServiceConfigCreate) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigCreate {
+	scc.mutation.SetRolloutStrategy(v)
+	return scc
+}
+
+// SetNillableRolloutStrategy sets the "rollout_strategy" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableRolloutStrategy(v *schema.RolloutStrategy) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetRolloutStrategy(*v)
+	}
+	return scc
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (scc *ServiceConfigCreate) SetCanaryWeight(v int) *ServiceConfigCreate {
+	scc.mutation.SetCanaryWeight(v)
+	return scc
+}
+
+// SetNillableCanaryWeight sets the "canary_weight" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableCanaryWeight(v *int) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetCanaryWeight(*v)
+	}
+	return scc
+}
+
 // SetIsPublic sets the "is_public" field.
 func (scc *ServiceConfigCreate) SetIsPublic(b bool) *ServiceConfigCreate {
 	scc.mutation.SetIsPublic(b)
@@ -268,6 +416,34 @@ func (scc *ServiceConfigCreate) SetNillableImage(s *string) *ServiceConfigCreate
 	return scc
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (scc *ServiceConfigCreate) SetImageAutoUpdate(v bool) *ServiceConfigCreate {
+	scc.mutation.SetImageAutoUpdate(v)
+	return scc
+}
+
+// SetNillableImageAutoUpdate sets the "image_auto_update" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableImageAutoUpdate(v *bool) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetImageAutoUpdate(*v)
+	}
+	return scc
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (scc *ServiceConfigCreate) SetImageDigest(v string) *ServiceConfigCreate {
+	scc.mutation.SetImageDigest(v)
+	return scc
+}
+
+// SetNillableImageDigest sets the "image_digest" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableImageDigest(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetImageDigest(*v)
+	}
+	return scc
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (scc *ServiceConfigCreate) SetDefinitionVersion(s string) *ServiceConfigCreate {
 	scc.mutation.SetDefinitionVersion(s)
@@ -344,6 +520,34 @@ func (scc *ServiceConfigCreate) SetNillableBackupRetentionCount(i *int) *Service
 	return scc
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (scc *ServiceConfigCreate) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigCreate {
+	scc.mutation.SetUploadS3SourceID(v)
+	return scc
+}
+
+// SetNillableUploadS3SourceID sets the "upload_s3_source_id" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableUploadS3SourceID(v *uuid.UUID) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetUploadS3SourceID(*v)
+	}
+	return scc
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (scc *ServiceConfigCreate) SetUploadS3Bucket(v string) *ServiceConfigCreate {
+	scc.mutation.SetUploadS3Bucket(v)
+	return scc
+}
+
+// SetNillableUploadS3Bucket sets the "upload_s3_bucket" field if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableUploadS3Bucket(v *string) *ServiceConfigCreate {
+	if v != nil {
+		scc.SetUploadS3Bucket(*v)
+	}
+	return scc
+}
+
 // SetVolumes sets the "volumes" field.
 func (scc *ServiceConfigCreate) SetVolumes(sv []schema.ServiceVolume) *ServiceConfigCreate {
 	scc.mutation.SetVolumes(sv)
@@ -424,6 +628,25 @@ func (scc *ServiceConfigCreate) SetS3BackupSources(s *S3) *ServiceConfigCreate {
 	return scc.SetS3BackupSourcesID(s.ID)
 }
 
+// SetS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID.
+func (scc *ServiceConfigCreate) SetS3UploadSourcesID(id uuid.UUID) *ServiceConfigCreate {
+	scc.mutation.SetS3UploadSourcesID(id)
+	return scc
+}
+
+// SetNillableS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID if the given value is not nil.
+func (scc *ServiceConfigCreate) SetNillableS3UploadSourcesID(id *uuid.UUID) *ServiceConfigCreate {
+	if id != nil {
+		scc = scc.SetS3UploadSourcesID(*id)
+	}
+	return scc
+}
+
+// SetS3UploadSources sets the "s3_upload_sources" edge to the S3 entity.
+func (scc *ServiceConfigCreate) SetS3UploadSources(v *S3) *ServiceConfigCreate {
+	return scc.SetS3UploadSourcesID(v.ID)
+}
+
 // Mutation returns the ServiceConfigMutation object of the builder.
 func (scc *ServiceConfigCreate) Mutation() *ServiceConfigMutation {
 	return scc.mutation
@@ -475,10 +698,30 @@ func (scc *ServiceConfigCreate) defaults() {
 		v := serviceconfig.DefaultAutoDeploy
 		scc.mutation.SetAutoDeploy(v)
 	}
+	if _, ok := scc.mutation.AutoRollback(); !ok {
+		v := serviceconfig.DefaultAutoRollback
+		scc.mutation.SetAutoRollback(v)
+	}
+	if _, ok := scc.mutation.PrPreviews(); !ok {
+		v := serviceconfig.DefaultPrPreviews
+		scc.mutation.SetPrPreviews(v)
+	}
+	if _, ok := scc.mutation.RolloutStrategy(); !ok {
+		v := serviceconfig.DefaultRolloutStrategy
+		scc.mutation.SetRolloutStrategy(v)
+	}
+	if _, ok := scc.mutation.CanaryWeight(); !ok {
+		v := serviceconfig.DefaultCanaryWeight
+		scc.mutation.SetCanaryWeight(v)
+	}
 	if _, ok := scc.mutation.IsPublic(); !ok {
 		v := serviceconfig.DefaultIsPublic
 		scc.mutation.SetIsPublic(v)
 	}
+	if _, ok := scc.mutation.ImageAutoUpdate(); !ok {
+		v := serviceconfig.DefaultImageAutoUpdate
+		scc.mutation.SetImageAutoUpdate(v)
+	}
 	if _, ok := scc.mutation.BackupSchedule(); !ok {
 		v := serviceconfig.DefaultBackupSchedule
 		scc.mutation.SetBackupSchedule(v)
@@ -531,9 +774,29 @@ func (scc *ServiceConfigCreate) check() error {
 	if _, ok := scc.mutation.AutoDeploy(); !ok {
 		return &ValidationError{Name: "auto_deploy", err: errors.New(`ent: missing required field "ServiceConfig.auto_deploy"`)}
 	}
+	if _, ok := scc.mutation.AutoRollback(); !ok {
+		return &ValidationError{Name: "auto_rollback", err: errors.New(`ent: missing required field "ServiceConfig.auto_rollback"`)}
+	}
+	if _, ok := scc.mutation.PrPreviews(); !ok {
+		return &ValidationError{Name: "pr_previews", err: errors.New(`ent: missing required field "ServiceConfig.pr_previews"`)}
+	}
+	if _, ok := scc.mutation.RolloutStrategy(); !ok {
+		return &ValidationError{Name: "rollout_strategy", err: errors.New(`ent: missing required field "ServiceConfig.rollout_strategy"`)}
+	}
+	if v, ok := scc.mutation.RolloutStrategy(); ok {
+		if err := serviceconfig.RolloutStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "rollout_strategy", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.rollout_strategy": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.CanaryWeight(); !ok {
+		return &ValidationError{Name: "canary_weight", err: errors.New(`ent: missing required field "ServiceConfig.canary_weight"`)}
+	}
 	if _, ok := scc.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "ServiceConfig.is_public"`)}
 	}
+	if _, ok := scc.mutation.ImageAutoUpdate(); !ok {
+		return &ValidationError{Name: "image_auto_update", err: errors.New(`ent: missing required field "ServiceConfig.image_auto_update"`)}
+	}
 	if _, ok := scc.mutation.BackupSchedule(); !ok {
 		return &ValidationError{Name: "backup_schedule", err: errors.New(`ent: missing required field "ServiceConfig.backup_schedule"`)}
 	}
@@ -608,6 +871,26 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldDockerBuilderBuildContext, field.TypeString, value)
 		_node.DockerBuilderBuildContext = &value
 	}
+	if value, ok := scc.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderTarget, field.TypeString, value)
+		_node.DockerBuilderTarget = &value
+	}
+	if value, ok := scc.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+		_node.DockerBuilderBuildArgs = value
+	}
+	if value, ok := scc.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+		_node.DockerBuilderBuildContexts = value
+	}
+	if value, ok := scc.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(serviceconfig.FieldBuildpacksBuilderImage, field.TypeString, value)
+		_node.BuildpacksBuilderImage = &value
+	}
+	if value, ok := scc.mutation.Platforms(); ok {
+		_spec.SetField(serviceconfig.FieldPlatforms, field.TypeJSON, value)
+		_node.Platforms = value
+	}
 	if value, ok := scc.mutation.RailpackProvider(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackProvider, field.TypeEnum, value)
 		_node.RailpackProvider = &value
@@ -624,6 +907,22 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldGitTag, field.TypeString, value)
 		_node.GitTag = &value
 	}
+	if value, ok := scc.mutation.WatchPaths(); ok {
+		_spec.SetField(serviceconfig.FieldWatchPaths, field.TypeJSON, value)
+		_node.WatchPaths = value
+	}
+	if value, ok := scc.mutation.SkipDeployMarker(); ok {
+		_spec.SetField(serviceconfig.FieldSkipDeployMarker, field.TypeString, value)
+		_node.SkipDeployMarker = &value
+	}
+	if value, ok := scc.mutation.IgnoredCommitAuthors(); ok {
+		_spec.SetField(serviceconfig.FieldIgnoredCommitAuthors, field.TypeJSON, value)
+		_node.IgnoredCommitAuthors = value
+	}
+	if value, ok := scc.mutation.PrPreviewVariables(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviewVariables, field.TypeJSON, value)
+		_node.PrPreviewVariables = value
+	}
 	if value, ok := scc.mutation.Hosts(); ok {
 		_spec.SetField(serviceconfig.FieldHosts, field.TypeJSON, value)
 		_node.Hosts = value
@@ -640,6 +939,14 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldAutoDeploy, field.TypeBool, value)
 		_node.AutoDeploy = value
 	}
+	if value, ok := scc.mutation.AutoRollback(); ok {
+		_spec.SetField(serviceconfig.FieldAutoRollback, field.TypeBool, value)
+		_node.AutoRollback = value
+	}
+	if value, ok := scc.mutation.PrPreviews(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviews, field.TypeBool, value)
+		_node.PrPreviews = value
+	}
 	if value, ok := scc.mutation.RailpackBuilderInstallCommand(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackBuilderInstallCommand, field.TypeString, value)
 		_node.RailpackBuilderInstallCommand = &value
@@ -652,6 +959,18 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldRunCommand, field.TypeString, value)
 		_node.RunCommand = &value
 	}
+	if value, ok := scc.mutation.PreDeployCommand(); ok {
+		_spec.SetField(serviceconfig.FieldPreDeployCommand, field.TypeString, value)
+		_node.PreDeployCommand = &value
+	}
+	if value, ok := scc.mutation.RolloutStrategy(); ok {
+		_spec.SetField(serviceconfig.FieldRolloutStrategy, field.TypeEnum, value)
+		_node.RolloutStrategy = value
+	}
+	if value, ok := scc.mutation.CanaryWeight(); ok {
+		_spec.SetField(serviceconfig.FieldCanaryWeight, field.TypeInt, value)
+		_node.CanaryWeight = value
+	}
 	if value, ok := scc.mutation.IsPublic(); ok {
 		_spec.SetField(serviceconfig.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
@@ -660,6 +979,14 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldImage, field.TypeString, value)
 		_node.Image = value
 	}
+	if value, ok := scc.mutation.ImageAutoUpdate(); ok {
+		_spec.SetField(serviceconfig.FieldImageAutoUpdate, field.TypeBool, value)
+		_node.ImageAutoUpdate = value
+	}
+	if value, ok := scc.mutation.ImageDigest(); ok {
+		_spec.SetField(serviceconfig.FieldImageDigest, field.TypeString, value)
+		_node.ImageDigest = &value
+	}
 	if value, ok := scc.mutation.DefinitionVersion(); ok {
 		_spec.SetField(serviceconfig.FieldDefinitionVersion, field.TypeString, value)
 		_node.DefinitionVersion = &value
@@ -680,6 +1007,10 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_spec.SetField(serviceconfig.FieldBackupRetentionCount, field.TypeInt, value)
 		_node.BackupRetentionCount = value
 	}
+	if value, ok := scc.mutation.UploadS3Bucket(); ok {
+		_spec.SetField(serviceconfig.FieldUploadS3Bucket, field.TypeString, value)
+		_node.UploadS3Bucket = &value
+	}
 	if value, ok := scc.mutation.Volumes(); ok {
 		_spec.SetField(serviceconfig.FieldVolumes, field.TypeJSON, value)
 		_node.Volumes = value
@@ -742,6 +1073,23 @@ func (scc *ServiceConfigCreate) createSpec() (*ServiceConfig, *sqlgraph.CreateSp
 		_node.S3BackupSourceID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := scc.mutation.S3UploadSourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceconfig.S3UploadSourcesTable,
+			Columns: []string{serviceconfig.S3UploadSourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UploadS3SourceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -878,6 +1226,96 @@ func (u *ServiceConfigUpsert) ClearDockerBuilderBuildContext() *ServiceConfigUps
 	return u
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *ServiceConfigUpsert) SetDockerBuilderTarget(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldDockerBuilderTarget, v)
+	return u
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateDockerBuilderTarget() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldDockerBuilderTarget)
+	return u
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *ServiceConfigUpsert) ClearDockerBuilderTarget() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldDockerBuilderTarget)
+	return u
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsert) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldDockerBuilderBuildArgs, v)
+	return u
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateDockerBuilderBuildArgs() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldDockerBuilderBuildArgs)
+	return u
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsert) ClearDockerBuilderBuildArgs() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldDockerBuilderBuildArgs)
+	return u
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsert) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldDockerBuilderBuildContexts, v)
+	return u
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateDockerBuilderBuildContexts() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldDockerBuilderBuildContexts)
+	return u
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsert) ClearDockerBuilderBuildContexts() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldDockerBuilderBuildContexts)
+	return u
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsert) SetBuildpacksBuilderImage(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldBuildpacksBuilderImage, v)
+	return u
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateBuildpacksBuilderImage() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldBuildpacksBuilderImage)
+	return u
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsert) ClearBuildpacksBuilderImage() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldBuildpacksBuilderImage)
+	return u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *ServiceConfigUpsert) SetPlatforms(v []string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldPlatforms, v)
+	return u
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdatePlatforms() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldPlatforms)
+	return u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *ServiceConfigUpsert) ClearPlatforms() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldPlatforms)
+	return u
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (u *ServiceConfigUpsert) SetRailpackProvider(v enum.Provider) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldRailpackProvider, v)
@@ -950,6 +1388,78 @@ func (u *ServiceConfigUpsert) ClearGitTag() *ServiceConfigUpsert {
 	return u
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (u *ServiceConfigUpsert) SetWatchPaths(v []string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldWatchPaths, v)
+	return u
+}
+
+// UpdateWatchPaths sets the "watch_paths" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateWatchPaths() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldWatchPaths)
+	return u
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (u *ServiceConfigUpsert) ClearWatchPaths() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldWatchPaths)
+	return u
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsert) SetSkipDeployMarker(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldSkipDeployMarker, v)
+	return u
+}
+
+// UpdateSkipDeployMarker sets the "skip_deploy_marker" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateSkipDeployMarker() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldSkipDeployMarker)
+	return u
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsert) ClearSkipDeployMarker() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldSkipDeployMarker)
+	return u
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsert) SetIgnoredCommitAuthors(v []string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldIgnoredCommitAuthors, v)
+	return u
+}
+
+// UpdateIgnoredCommitAuthors sets the "ignored_commit_authors" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateIgnoredCommitAuthors() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldIgnoredCommitAuthors)
+	return u
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsert) ClearIgnoredCommitAuthors() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldIgnoredCommitAuthors)
+	return u
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (u *ServiceConfigUpsert) SetPrPreviewVariables(v []string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldPrPreviewVariables, v)
+	return u
+}
+
+// UpdatePrPreviewVariables sets the "pr_preview_variables" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdatePrPreviewVariables() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldPrPreviewVariables)
+	return u
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (u *ServiceConfigUpsert) ClearPrPreviewVariables() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldPrPreviewVariables)
+	return u
+}
+
 // SetHosts sets the "hosts" field.
 func (u *ServiceConfigUpsert) SetHosts(v []schema.HostSpec) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldHosts, v)
@@ -1016,6 +1526,30 @@ func (u *ServiceConfigUpsert) UpdateAutoDeploy() *ServiceConfigUpsert {
 	return u
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (u *ServiceConfigUpsert) SetAutoRollback(v bool) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldAutoRollback, v)
+	return u
+}
+
+// UpdateAutoRollback sets the "auto_rollback" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateAutoRollback() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldAutoRollback)
+	return u
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (u *ServiceConfigUpsert) SetPrPreviews(v bool) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldPrPreviews, v)
+	return u
+}
+
+// UpdatePrPreviews sets the "pr_previews" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdatePrPreviews() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldPrPreviews)
+	return u
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (u *ServiceConfigUpsert) SetRailpackBuilderInstallCommand(v string) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldRailpackBuilderInstallCommand, v)
@@ -1070,6 +1604,54 @@ func (u *ServiceConfigUpsert) ClearRunCommand() *ServiceConfigUpsert {
 	return u
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (u *ServiceConfigUpsert) SetPreDeployCommand(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldPreDeployCommand, v)
+	return u
+}
+
+// UpdatePreDeployCommand sets the "pre_deploy_command" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdatePreDeployCommand() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldPreDeployCommand)
+	return u
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (u *ServiceConfigUpsert) ClearPreDeployCommand() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldPreDeployCommand)
+	return u
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (u *ServiceConfigUpsert) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldRolloutStrategy, v)
+	return u
+}
+
+// UpdateRolloutStrategy sets the "rollout_strategy" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateRolloutStrategy() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldRolloutStrategy)
+	return u
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (u *ServiceConfigUpsert) SetCanaryWeight(v int) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldCanaryWeight, v)
+	return u
+}
+
+// UpdateCanaryWeight sets the "canary_weight" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateCanaryWeight() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldCanaryWeight)
+	return u
+}
+
+// AddCanaryWeight adds v to the "canary_weight" field.
+func (u *ServiceConfigUpsert) AddCanaryWeight(v int) *ServiceConfigUpsert {
+	u.Add(serviceconfig.FieldCanaryWeight, v)
+	return u
+}
+
 // SetIsPublic sets the "is_public" field.
 func (u *ServiceConfigUpsert) SetIsPublic(v bool) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldIsPublic, v)
@@ -1100,6 +1682,36 @@ func (u *ServiceConfigUpsert) ClearImage() *ServiceConfigUpsert {
 	return u
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (u *ServiceConfigUpsert) SetImageAutoUpdate(v bool) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldImageAutoUpdate, v)
+	return u
+}
+
+// UpdateImageAutoUpdate sets the "image_auto_update" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateImageAutoUpdate() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldImageAutoUpdate)
+	return u
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (u *ServiceConfigUpsert) SetImageDigest(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldImageDigest, v)
+	return u
+}
+
+// UpdateImageDigest sets the "image_digest" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateImageDigest() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldImageDigest)
+	return u
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (u *ServiceConfigUpsert) ClearImageDigest() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldImageDigest)
+	return u
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (u *ServiceConfigUpsert) SetDefinitionVersion(v string) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldDefinitionVersion, v)
@@ -1202,6 +1814,42 @@ func (u *ServiceConfigUpsert) AddBackupRetentionCount(v int) *ServiceConfigUpser
 	return u
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsert) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldUploadS3SourceID, v)
+	return u
+}
+
+// UpdateUploadS3SourceID sets the "upload_s3_source_id" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateUploadS3SourceID() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldUploadS3SourceID)
+	return u
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsert) ClearUploadS3SourceID() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldUploadS3SourceID)
+	return u
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsert) SetUploadS3Bucket(v string) *ServiceConfigUpsert {
+	u.Set(serviceconfig.FieldUploadS3Bucket, v)
+	return u
+}
+
+// UpdateUploadS3Bucket sets the "upload_s3_bucket" field to the value that was provided on create.
+func (u *ServiceConfigUpsert) UpdateUploadS3Bucket() *ServiceConfigUpsert {
+	u.SetExcluded(serviceconfig.FieldUploadS3Bucket)
+	return u
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsert) ClearUploadS3Bucket() *ServiceConfigUpsert {
+	u.SetNull(serviceconfig.FieldUploadS3Bucket)
+	return u
+}
+
 // SetVolumes sets the "volumes" field.
 func (u *ServiceConfigUpsert) SetVolumes(v []schema.ServiceVolume) *ServiceConfigUpsert {
 	u.Set(serviceconfig.FieldVolumes, v)
@@ -1477,6 +2125,111 @@ func (u *ServiceConfigUpsertOne) ClearDockerBuilderBuildContext() *ServiceConfig
 	})
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *ServiceConfigUpsertOne) SetDockerBuilderTarget(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderTarget(v)
+	})
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateDockerBuilderTarget() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderTarget()
+	})
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *ServiceConfigUpsertOne) ClearDockerBuilderTarget() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderTarget()
+	})
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsertOne) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderBuildArgs(v)
+	})
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateDockerBuilderBuildArgs() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderBuildArgs()
+	})
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsertOne) ClearDockerBuilderBuildArgs() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderBuildArgs()
+	})
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsertOne) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderBuildContexts(v)
+	})
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateDockerBuilderBuildContexts() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderBuildContexts()
+	})
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsertOne) ClearDockerBuilderBuildContexts() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderBuildContexts()
+	})
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsertOne) SetBuildpacksBuilderImage(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetBuildpacksBuilderImage(v)
+	})
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateBuildpacksBuilderImage() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateBuildpacksBuilderImage()
+	})
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsertOne) ClearBuildpacksBuilderImage() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearBuildpacksBuilderImage()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *ServiceConfigUpsertOne) SetPlatforms(v []string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdatePlatforms() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *ServiceConfigUpsertOne) ClearPlatforms() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (u *ServiceConfigUpsertOne) SetRailpackProvider(v enum.Provider) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -1561,6 +2314,90 @@ func (u *ServiceConfigUpsertOne) ClearGitTag() *ServiceConfigUpsertOne {
 	})
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (u *ServiceConfigUpsertOne) SetWatchPaths(v []string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetWatchPaths(v)
+	})
+}
+
+// UpdateWatchPaths sets the "watch_paths" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateWatchPaths() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateWatchPaths()
+	})
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (u *ServiceConfigUpsertOne) ClearWatchPaths() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearWatchPaths()
+	})
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsertOne) SetSkipDeployMarker(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetSkipDeployMarker(v)
+	})
+}
+
+// UpdateSkipDeployMarker sets the "skip_deploy_marker" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateSkipDeployMarker() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateSkipDeployMarker()
+	})
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsertOne) ClearSkipDeployMarker() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearSkipDeployMarker()
+	})
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsertOne) SetIgnoredCommitAuthors(v []string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetIgnoredCommitAuthors(v)
+	})
+}
+
+// UpdateIgnoredCommitAuthors sets the "ignored_commit_authors" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateIgnoredCommitAuthors() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateIgnoredCommitAuthors()
+	})
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsertOne) ClearIgnoredCommitAuthors() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearIgnoredCommitAuthors()
+	})
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (u *ServiceConfigUpsertOne) SetPrPreviewVariables(v []string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPrPreviewVariables(v)
+	})
+}
+
+// UpdatePrPreviewVariables sets the "pr_preview_variables" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdatePrPreviewVariables() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePrPreviewVariables()
+	})
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (u *ServiceConfigUpsertOne) ClearPrPreviewVariables() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPrPreviewVariables()
+	})
+}
+
 // SetHosts sets the "hosts" field.
 func (u *ServiceConfigUpsertOne) SetHosts(v []schema.HostSpec) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -1638,6 +2475,34 @@ func (u *ServiceConfigUpsertOne) UpdateAutoDeploy() *ServiceConfigUpsertOne {
 	})
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (u *ServiceConfigUpsertOne) SetAutoRollback(v bool) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetAutoRollback(v)
+	})
+}
+
+// UpdateAutoRollback sets the "auto_rollback" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateAutoRollback() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateAutoRollback()
+	})
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (u *ServiceConfigUpsertOne) SetPrPreviews(v bool) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPrPreviews(v)
+	})
+}
+
+// UpdatePrPreviews sets the "pr_previews" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdatePrPreviews() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePrPreviews()
+	})
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (u *ServiceConfigUpsertOne) SetRailpackBuilderInstallCommand(v string) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -1673,31 +2538,87 @@ func (u *ServiceConfigUpsertOne) UpdateRailpackBuilderBuildCommand() *ServiceCon
 	})
 }
 
-// ClearRailpackBuilderBuildCommand clears the value of the "railpack_builder_build_command" field.
-func (u *ServiceConfigUpsertOne) ClearRailpackBuilderBuildCommand() *ServiceConfigUpsertOne {
+// ClearRailpackBuilderBuildCommand clears the value of the "railpack_builder_build_command" field.
+func (u *ServiceConfigUpsertOne) ClearRailpackBuilderBuildCommand() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearRailpackBuilderBuildCommand()
+	})
+}
+
+// SetRunCommand sets the "run_command" field.
+func (u *ServiceConfigUpsertOne) SetRunCommand(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetRunCommand(v)
+	})
+}
+
+// UpdateRunCommand sets the "run_command" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateRunCommand() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateRunCommand()
+	})
+}
+
+// ClearRunCommand clears the value of the "run_command" field.
+func (u *ServiceConfigUpsertOne) ClearRunCommand() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearRunCommand()
+	})
+}
+
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (u *ServiceConfigUpsertOne) SetPreDeployCommand(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPreDeployCommand(v)
+	})
+}
+
+// UpdatePreDeployCommand sets the "pre_deploy_command" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdatePreDeployCommand() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePreDeployCommand()
+	})
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (u *ServiceConfigUpsertOne) ClearPreDeployCommand() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPreDeployCommand()
+	})
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (u *ServiceConfigUpsertOne) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetRolloutStrategy(v)
+	})
+}
+
+// UpdateRolloutStrategy sets the "rollout_strategy" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateRolloutStrategy() *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
-		s.ClearRailpackBuilderBuildCommand()
+		s.UpdateRolloutStrategy()
 	})
 }
 
-// SetRunCommand sets the "run_command" field.
-func (u *ServiceConfigUpsertOne) SetRunCommand(v string) *ServiceConfigUpsertOne {
+// SetCanaryWeight sets the "canary_weight" field.
+func (u *ServiceConfigUpsertOne) SetCanaryWeight(v int) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
-		s.SetRunCommand(v)
+		s.SetCanaryWeight(v)
 	})
 }
 
-// UpdateRunCommand sets the "run_command" field to the value that was provided on create.
-func (u *ServiceConfigUpsertOne) UpdateRunCommand() *ServiceConfigUpsertOne {
+// AddCanaryWeight adds v to the "canary_weight" field.
+func (u *ServiceConfigUpsertOne) AddCanaryWeight(v int) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
-		s.UpdateRunCommand()
+		s.AddCanaryWeight(v)
 	})
 }
 
-// ClearRunCommand clears the value of the "run_command" field.
-func (u *ServiceConfigUpsertOne) ClearRunCommand() *ServiceConfigUpsertOne {
+// UpdateCanaryWeight sets the "canary_weight" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateCanaryWeight() *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
-		s.ClearRunCommand()
+		s.UpdateCanaryWeight()
 	})
 }
 
@@ -1736,6 +2657,41 @@ func (u *ServiceConfigUpsertOne) ClearImage() *ServiceConfigUpsertOne {
 	})
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (u *ServiceConfigUpsertOne) SetImageAutoUpdate(v bool) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetImageAutoUpdate(v)
+	})
+}
+
+// UpdateImageAutoUpdate sets the "image_auto_update" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateImageAutoUpdate() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateImageAutoUpdate()
+	})
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (u *ServiceConfigUpsertOne) SetImageDigest(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetImageDigest(v)
+	})
+}
+
+// UpdateImageDigest sets the "image_digest" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateImageDigest() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateImageDigest()
+	})
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (u *ServiceConfigUpsertOne) ClearImageDigest() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearImageDigest()
+	})
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (u *ServiceConfigUpsertOne) SetDefinitionVersion(v string) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -1855,6 +2811,48 @@ func (u *ServiceConfigUpsertOne) UpdateBackupRetentionCount() *ServiceConfigUpse
 	})
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsertOne) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetUploadS3SourceID(v)
+	})
+}
+
+// UpdateUploadS3SourceID sets the "upload_s3_source_id" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateUploadS3SourceID() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateUploadS3SourceID()
+	})
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsertOne) ClearUploadS3SourceID() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearUploadS3SourceID()
+	})
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsertOne) SetUploadS3Bucket(v string) *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetUploadS3Bucket(v)
+	})
+}
+
+// UpdateUploadS3Bucket sets the "upload_s3_bucket" field to the value that was provided on create.
+func (u *ServiceConfigUpsertOne) UpdateUploadS3Bucket() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateUploadS3Bucket()
+	})
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsertOne) ClearUploadS3Bucket() *ServiceConfigUpsertOne {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearUploadS3Bucket()
+	})
+}
+
 // SetVolumes sets the "volumes" field.
 func (u *ServiceConfigUpsertOne) SetVolumes(v []schema.ServiceVolume) *ServiceConfigUpsertOne {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2318,6 +3316,111 @@ func (u *ServiceConfigUpsertBulk) ClearDockerBuilderBuildContext() *ServiceConfi
 	})
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (u *ServiceConfigUpsertBulk) SetDockerBuilderTarget(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderTarget(v)
+	})
+}
+
+// UpdateDockerBuilderTarget sets the "docker_builder_target" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateDockerBuilderTarget() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderTarget()
+	})
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (u *ServiceConfigUpsertBulk) ClearDockerBuilderTarget() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderTarget()
+	})
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsertBulk) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderBuildArgs(v)
+	})
+}
+
+// UpdateDockerBuilderBuildArgs sets the "docker_builder_build_args" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateDockerBuilderBuildArgs() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderBuildArgs()
+	})
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (u *ServiceConfigUpsertBulk) ClearDockerBuilderBuildArgs() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderBuildArgs()
+	})
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsertBulk) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetDockerBuilderBuildContexts(v)
+	})
+}
+
+// UpdateDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateDockerBuilderBuildContexts() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateDockerBuilderBuildContexts()
+	})
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (u *ServiceConfigUpsertBulk) ClearDockerBuilderBuildContexts() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearDockerBuilderBuildContexts()
+	})
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsertBulk) SetBuildpacksBuilderImage(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetBuildpacksBuilderImage(v)
+	})
+}
+
+// UpdateBuildpacksBuilderImage sets the "buildpacks_builder_image" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateBuildpacksBuilderImage() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateBuildpacksBuilderImage()
+	})
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (u *ServiceConfigUpsertBulk) ClearBuildpacksBuilderImage() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearBuildpacksBuilderImage()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *ServiceConfigUpsertBulk) SetPlatforms(v []string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdatePlatforms() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *ServiceConfigUpsertBulk) ClearPlatforms() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (u *ServiceConfigUpsertBulk) SetRailpackProvider(v enum.Provider) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2402,6 +3505,90 @@ func (u *ServiceConfigUpsertBulk) ClearGitTag() *ServiceConfigUpsertBulk {
 	})
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (u *ServiceConfigUpsertBulk) SetWatchPaths(v []string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetWatchPaths(v)
+	})
+}
+
+// UpdateWatchPaths sets the "watch_paths" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateWatchPaths() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateWatchPaths()
+	})
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (u *ServiceConfigUpsertBulk) ClearWatchPaths() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearWatchPaths()
+	})
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsertBulk) SetSkipDeployMarker(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetSkipDeployMarker(v)
+	})
+}
+
+// UpdateSkipDeployMarker sets the "skip_deploy_marker" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateSkipDeployMarker() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateSkipDeployMarker()
+	})
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (u *ServiceConfigUpsertBulk) ClearSkipDeployMarker() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearSkipDeployMarker()
+	})
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsertBulk) SetIgnoredCommitAuthors(v []string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetIgnoredCommitAuthors(v)
+	})
+}
+
+// UpdateIgnoredCommitAuthors sets the "ignored_commit_authors" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateIgnoredCommitAuthors() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateIgnoredCommitAuthors()
+	})
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (u *ServiceConfigUpsertBulk) ClearIgnoredCommitAuthors() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearIgnoredCommitAuthors()
+	})
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (u *ServiceConfigUpsertBulk) SetPrPreviewVariables(v []string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPrPreviewVariables(v)
+	})
+}
+
+// UpdatePrPreviewVariables sets the "pr_preview_variables" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdatePrPreviewVariables() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePrPreviewVariables()
+	})
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (u *ServiceConfigUpsertBulk) ClearPrPreviewVariables() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPrPreviewVariables()
+	})
+}
+
 // SetHosts sets the "hosts" field.
 func (u *ServiceConfigUpsertBulk) SetHosts(v []schema.HostSpec) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2479,6 +3666,34 @@ func (u *ServiceConfigUpsertBulk) UpdateAutoDeploy() *ServiceConfigUpsertBulk {
 	})
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (u *ServiceConfigUpsertBulk) SetAutoRollback(v bool) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetAutoRollback(v)
+	})
+}
+
+// UpdateAutoRollback sets the "auto_rollback" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateAutoRollback() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateAutoRollback()
+	})
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (u *ServiceConfigUpsertBulk) SetPrPreviews(v bool) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPrPreviews(v)
+	})
+}
+
+// UpdatePrPreviews sets the "pr_previews" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdatePrPreviews() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePrPreviews()
+	})
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (u *ServiceConfigUpsertBulk) SetRailpackBuilderInstallCommand(v string) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2542,6 +3757,62 @@ func (u *ServiceConfigUpsertBulk) ClearRunCommand() *ServiceConfigUpsertBulk {
 	})
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (u *ServiceConfigUpsertBulk) SetPreDeployCommand(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetPreDeployCommand(v)
+	})
+}
+
+// UpdatePreDeployCommand sets the "pre_deploy_command" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdatePreDeployCommand() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdatePreDeployCommand()
+	})
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (u *ServiceConfigUpsertBulk) ClearPreDeployCommand() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearPreDeployCommand()
+	})
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (u *ServiceConfigUpsertBulk) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetRolloutStrategy(v)
+	})
+}
+
+// UpdateRolloutStrategy sets the "rollout_strategy" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateRolloutStrategy() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateRolloutStrategy()
+	})
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (u *ServiceConfigUpsertBulk) SetCanaryWeight(v int) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetCanaryWeight(v)
+	})
+}
+
+// AddCanaryWeight adds v to the "canary_weight" field.
+func (u *ServiceConfigUpsertBulk) AddCanaryWeight(v int) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.AddCanaryWeight(v)
+	})
+}
+
+// UpdateCanaryWeight sets the "canary_weight" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateCanaryWeight() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateCanaryWeight()
+	})
+}
+
 // SetIsPublic sets the "is_public" field.
 func (u *ServiceConfigUpsertBulk) SetIsPublic(v bool) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2577,6 +3848,41 @@ func (u *ServiceConfigUpsertBulk) ClearImage() *ServiceConfigUpsertBulk {
 	})
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (u *ServiceConfigUpsertBulk) SetImageAutoUpdate(v bool) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetImageAutoUpdate(v)
+	})
+}
+
+// UpdateImageAutoUpdate sets the "image_auto_update" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateImageAutoUpdate() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateImageAutoUpdate()
+	})
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (u *ServiceConfigUpsertBulk) SetImageDigest(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetImageDigest(v)
+	})
+}
+
+// UpdateImageDigest sets the "image_digest" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateImageDigest() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateImageDigest()
+	})
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (u *ServiceConfigUpsertBulk) ClearImageDigest() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearImageDigest()
+	})
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (u *ServiceConfigUpsertBulk) SetDefinitionVersion(v string) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
@@ -2696,6 +4002,48 @@ func (u *ServiceConfigUpsertBulk) UpdateBackupRetentionCount() *ServiceConfigUps
 	})
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsertBulk) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetUploadS3SourceID(v)
+	})
+}
+
+// UpdateUploadS3SourceID sets the "upload_s3_source_id" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateUploadS3SourceID() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateUploadS3SourceID()
+	})
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (u *ServiceConfigUpsertBulk) ClearUploadS3SourceID() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearUploadS3SourceID()
+	})
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsertBulk) SetUploadS3Bucket(v string) *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.SetUploadS3Bucket(v)
+	})
+}
+
+// UpdateUploadS3Bucket sets the "upload_s3_bucket" field to the value that was provided on create.
+func (u *ServiceConfigUpsertBulk) UpdateUploadS3Bucket() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.UpdateUploadS3Bucket()
+	})
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (u *ServiceConfigUpsertBulk) ClearUploadS3Bucket() *ServiceConfigUpsertBulk {
+	return u.Update(func(s *ServiceConfigUpsert) {
+		s.ClearUploadS3Bucket()
+	})
+}
+
 // SetVolumes sets the "volumes" field.
 func (u *ServiceConfigUpsertBulk) SetVolumes(v []schema.ServiceVolume) *ServiceConfigUpsertBulk {
 	return u.Update(func(s *ServiceConfigUpsert) {
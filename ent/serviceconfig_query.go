@@ -27,6 +27,7 @@ type ServiceConfigQuery struct {
 	predicates          []predicate.ServiceConfig
 	withService         *ServiceQuery
 	withS3BackupSources *S3Query
+	withS3UploadSources *S3Query
 	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +109,28 @@ func (scq *ServiceConfigQuery) QueryS3BackupSources() *S3Query {
 	return query
 }
 
+// QueryS3UploadSources chains the current query on the "s3_upload_sources" edge.
+func (scq *ServiceConfigQuery) QueryS3UploadSources() *S3Query {
+	query := (&S3Client{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serviceconfig.Table, serviceconfig.FieldID, selector),
+			sqlgraph.To(s3.Table, s3.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, serviceconfig.S3UploadSourcesTable, serviceconfig.S3UploadSourcesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ServiceConfig entity from the query.
 // Returns a *NotFoundError when no ServiceConfig was found.
 func (scq *ServiceConfigQuery) First(ctx context.Context) (*ServiceConfig, error) {
@@ -302,6 +325,7 @@ func (scq *ServiceConfigQuery) Clone() *ServiceConfigQuery {
 		predicates:          append([]predicate.ServiceConfig{}, scq.predicates...),
 		withService:         scq.withService.Clone(),
 		withS3BackupSources: scq.withS3BackupSources.Clone(),
+		withS3UploadSources: scq.withS3UploadSources.Clone(),
 		// clone intermediate query.
 		sql:       scq.sql.Clone(),
 		path:      scq.path,
@@ -331,6 +355,17 @@ func (scq *ServiceConfigQuery) WithS3BackupSources(opts ...func(*S3Query)) *Serv
 	return scq
 }
 
+// WithS3UploadSources tells the query-builder to eager-load the nodes that are connected to
+// the "s3_upload_sources" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *ServiceConfigQuery) WithS3UploadSources(opts ...func(*S3Query)) *ServiceConfigQuery {
+	query := (&S3Client{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withS3UploadSources = query
+	return scq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +444,10 @@ func (scq *ServiceConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*ServiceConfig{}
 		_spec       = scq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			scq.withService != nil,
 			scq.withS3BackupSources != nil,
+			scq.withS3UploadSources != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -447,6 +483,12 @@ func (scq *ServiceConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := scq.withS3UploadSources; query != nil {
+		if err := scq.loadS3UploadSources(ctx, query, nodes, nil,
+			func(n *ServiceConfig, e *S3) { n.Edges.S3UploadSources = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,6 +553,38 @@ func (scq *ServiceConfigQuery) loadS3BackupSources(ctx context.Context, query *S
 	}
 	return nil
 }
+func (scq *ServiceConfigQuery) loadS3UploadSources(ctx context.Context, query *S3Query, nodes []*ServiceConfig, init func(*ServiceConfig), assign func(*ServiceConfig, *S3)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ServiceConfig)
+	for i := range nodes {
+		if nodes[i].UploadS3SourceID == nil {
+			continue
+		}
+		fk := *nodes[i].UploadS3SourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(s3.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "upload_s3_source_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (scq *ServiceConfigQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := scq.querySpec()
@@ -546,6 +620,9 @@ func (scq *ServiceConfigQuery) querySpec() *sqlgraph.QuerySpec {
 		if scq.withS3BackupSources != nil {
 			_spec.Node.AddColumnOnce(serviceconfig.FieldS3BackupSourceID)
 		}
+		if scq.withS3UploadSources != nil {
+			_spec.Node.AddColumnOnce(serviceconfig.FieldUploadS3SourceID)
+		}
 	}
 	if ps := scq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
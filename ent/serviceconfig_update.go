@@ -123,6 +123,88 @@ func (scu *ServiceConfigUpdate) ClearDockerBuilderBuildContext() *ServiceConfigU
 	return scu
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (scu *ServiceConfigUpdate) SetDockerBuilderTarget(v string) *ServiceConfigUpdate {
+	scu.mutation.SetDockerBuilderTarget(v)
+	return scu
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableDockerBuilderTarget(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetDockerBuilderTarget(*v)
+	}
+	return scu
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (scu *ServiceConfigUpdate) ClearDockerBuilderTarget() *ServiceConfigUpdate {
+	scu.mutation.ClearDockerBuilderTarget()
+	return scu
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (scu *ServiceConfigUpdate) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigUpdate {
+	scu.mutation.SetDockerBuilderBuildArgs(v)
+	return scu
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (scu *ServiceConfigUpdate) ClearDockerBuilderBuildArgs() *ServiceConfigUpdate {
+	scu.mutation.ClearDockerBuilderBuildArgs()
+	return scu
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (scu *ServiceConfigUpdate) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigUpdate {
+	scu.mutation.SetDockerBuilderBuildContexts(v)
+	return scu
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (scu *ServiceConfigUpdate) ClearDockerBuilderBuildContexts() *ServiceConfigUpdate {
+	scu.mutation.ClearDockerBuilderBuildContexts()
+	return scu
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (scu *ServiceConfigUpdate) SetBuildpacksBuilderImage(v string) *ServiceConfigUpdate {
+	scu.mutation.SetBuildpacksBuilderImage(v)
+	return scu
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableBuildpacksBuilderImage(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetBuildpacksBuilderImage(*v)
+	}
+	return scu
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (scu *ServiceConfigUpdate) ClearBuildpacksBuilderImage() *ServiceConfigUpdate {
+	scu.mutation.ClearBuildpacksBuilderImage()
+	return scu
+}
+
+// SetPlatforms sets the "platforms" field.
+func (scu *ServiceConfigUpdate) SetPlatforms(v []string) *ServiceConfigUpdate {
+	scu.mutation.SetPlatforms(v)
+	return scu
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (scu *ServiceConfigUpdate) AppendPlatforms(v []string) *ServiceConfigUpdate {
+	scu.mutation.AppendPlatforms(v)
+	return scu
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (scu *ServiceConfigUpdate) ClearPlatforms() *ServiceConfigUpdate {
+	scu.mutation.ClearPlatforms()
+	return scu
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (scu *ServiceConfigUpdate) SetRailpackProvider(e enum.Provider) *ServiceConfigUpdate {
 	scu.mutation.SetRailpackProvider(e)
@@ -203,6 +285,80 @@ func (scu *ServiceConfigUpdate) ClearGitTag() *ServiceConfigUpdate {
 	return scu
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (scu *ServiceConfigUpdate) SetWatchPaths(v []string) *ServiceConfigUpdate {
+	scu.mutation.SetWatchPaths(v)
+	return scu
+}
+
+// AppendWatchPaths appends value to the "watch_paths" field.
+func (scu *ServiceConfigUpdate) AppendWatchPaths(v []string) *ServiceConfigUpdate {
+	scu.mutation.AppendWatchPaths(v)
+	return scu
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (scu *ServiceConfigUpdate) ClearWatchPaths() *ServiceConfigUpdate {
+	scu.mutation.ClearWatchPaths()
+	return scu
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (scu *ServiceConfigUpdate) SetSkipDeployMarker(v string) *ServiceConfigUpdate {
+	scu.mutation.SetSkipDeployMarker(v)
+	return scu
+}
+
+// SetNillableSkipDeployMarker sets the "skip_deploy_marker" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableSkipDeployMarker(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetSkipDeployMarker(*v)
+	}
+	return scu
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (scu *ServiceConfigUpdate) ClearSkipDeployMarker() *ServiceConfigUpdate {
+	scu.mutation.ClearSkipDeployMarker()
+	return scu
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (scu *ServiceConfigUpdate) SetIgnoredCommitAuthors(v []string) *ServiceConfigUpdate {
+	scu.mutation.SetIgnoredCommitAuthors(v)
+	return scu
+}
+
+// AppendIgnoredCommitAuthors appends value to the "ignored_commit_authors" field.
+func (scu *ServiceConfigUpdate) AppendIgnoredCommitAuthors(v []string) *ServiceConfigUpdate {
+	scu.mutation.AppendIgnoredCommitAuthors(v)
+	return scu
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (scu *ServiceConfigUpdate) ClearIgnoredCommitAuthors() *ServiceConfigUpdate {
+	scu.mutation.ClearIgnoredCommitAuthors()
+	return scu
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (scu *ServiceConfigUpdate) SetPrPreviewVariables(v []string) *ServiceConfigUpdate {
+	scu.mutation.SetPrPreviewVariables(v)
+	return scu
+}
+
+// AppendPrPreviewVariables appends value to the "pr_preview_variables" field.
+func (scu *ServiceConfigUpdate) AppendPrPreviewVariables(v []string) *ServiceConfigUpdate {
+	scu.mutation.AppendPrPreviewVariables(v)
+	return scu
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (scu *ServiceConfigUpdate) ClearPrPreviewVariables() *ServiceConfigUpdate {
+	scu.mutation.ClearPrPreviewVariables()
+	return scu
+}
+
 // SetHosts sets the "hosts" field.
 func (scu *ServiceConfigUpdate) SetHosts(ss []schema.HostSpec) *ServiceConfigUpdate {
 	scu.mutation.SetHosts(ss)
@@ -274,6 +430,34 @@ func (scu *ServiceConfigUpdate) SetNillableAutoDeploy(b *bool) *ServiceConfigUpd
 	return scu
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (scu *ServiceConfigUpdate) SetAutoRollback(v bool) *ServiceConfigUpdate {
+	scu.mutation.SetAutoRollback(v)
+	return scu
+}
+
+// SetNillableAutoRollback sets the "auto_rollback" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableAutoRollback(v *bool) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetAutoRollback(*v)
+	}
+	return scu
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (scu *ServiceConfigUpdate) SetPrPreviews(v bool) *ServiceConfigUpdate {
+	scu.mutation.SetPrPreviews(v)
+	return scu
+}
+
+// SetNillablePrPreviews sets the "pr_previews" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillablePrPreviews(v *bool) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetPrPreviews(*v)
+	}
+	return scu
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (scu *ServiceConfigUpdate) SetRailpackBuilderInstallCommand(s string) *ServiceConfigUpdate {
 	scu.mutation.SetRailpackBuilderInstallCommand(s)
@@ -334,6 +518,61 @@ func (scu *ServiceConfigUpdate) ClearRunCommand() *ServiceConfigUpdate {
 	return scu
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (scu *ServiceConfigUpdate) SetPreDeployCommand(v string) *ServiceConfigUpdate {
+	scu.mutation.SetPreDeployCommand(v)
+	return scu
+}
+
+// SetNillablePreDeployCommand sets the "pre_deploy_command" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillablePreDeployCommand(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetPreDeployCommand(*v)
+	}
+	return scu
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (scu *ServiceConfigUpdate) ClearPreDeployCommand() *ServiceConfigUpdate {
+	scu.mutation.ClearPreDeployCommand()
+	return scu
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (scu *ServiceConfigUpdate) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigUpdate {
+	scu.mutation.SetRolloutStrategy(v)
+	return scu
+}
+
+// SetNillableRolloutStrategy sets the "rollout_strategy" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableRolloutStrategy(v *schema.RolloutStrategy) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetRolloutStrategy(*v)
+	}
+	return scu
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (scu *ServiceConfigUpdate) SetCanaryWeight(v int) *ServiceConfigUpdate {
+	scu.mutation.ResetCanaryWeight()
+	scu.mutation.SetCanaryWeight(v)
+	return scu
+}
+
+// SetNillableCanaryWeight sets the "canary_weight" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableCanaryWeight(v *int) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetCanaryWeight(*v)
+	}
+	return scu
+}
+
+// AddCanaryWeight adds value to the "canary_weight" field.
+func (scu *ServiceConfigUpdate) AddCanaryWeight(v int) *ServiceConfigUpdate {
+	scu.mutation.AddCanaryWeight(v)
+	return scu
+}
+
 // SetIsPublic sets the "is_public" field.
 func (scu *ServiceConfigUpdate) SetIsPublic(b bool) *ServiceConfigUpdate {
 	scu.mutation.SetIsPublic(b)
@@ -368,6 +607,40 @@ func (scu *ServiceConfigUpdate) ClearImage() *ServiceConfigUpdate {
 	return scu
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (scu *ServiceConfigUpdate) SetImageAutoUpdate(v bool) *ServiceConfigUpdate {
+	scu.mutation.SetImageAutoUpdate(v)
+	return scu
+}
+
+// SetNillableImageAutoUpdate sets the "image_auto_update" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableImageAutoUpdate(v *bool) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetImageAutoUpdate(*v)
+	}
+	return scu
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (scu *ServiceConfigUpdate) SetImageDigest(v string) *ServiceConfigUpdate {
+	scu.mutation.SetImageDigest(v)
+	return scu
+}
+
+// SetNillableImageDigest sets the "image_digest" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableImageDigest(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetImageDigest(*v)
+	}
+	return scu
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (scu *ServiceConfigUpdate) ClearImageDigest() *ServiceConfigUpdate {
+	scu.mutation.ClearImageDigest()
+	return scu
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (scu *ServiceConfigUpdate) SetDefinitionVersion(s string) *ServiceConfigUpdate {
 	scu.mutation.SetDefinitionVersion(s)
@@ -475,6 +748,46 @@ func (scu *ServiceConfigUpdate) AddBackupRetentionCount(i int) *ServiceConfigUpd
 	return scu
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (scu *ServiceConfigUpdate) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigUpdate {
+	scu.mutation.SetUploadS3SourceID(v)
+	return scu
+}
+
+// SetNillableUploadS3SourceID sets the "upload_s3_source_id" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableUploadS3SourceID(v *uuid.UUID) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetUploadS3SourceID(*v)
+	}
+	return scu
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (scu *ServiceConfigUpdate) ClearUploadS3SourceID() *ServiceConfigUpdate {
+	scu.mutation.ClearUploadS3SourceID()
+	return scu
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (scu *ServiceConfigUpdate) SetUploadS3Bucket(v string) *ServiceConfigUpdate {
+	scu.mutation.SetUploadS3Bucket(v)
+	return scu
+}
+
+// SetNillableUploadS3Bucket sets the "upload_s3_bucket" field if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableUploadS3Bucket(v *string) *ServiceConfigUpdate {
+	if v != nil {
+		scu.SetUploadS3Bucket(*v)
+	}
+	return scu
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (scu *ServiceConfigUpdate) ClearUploadS3Bucket() *ServiceConfigUpdate {
+	scu.mutation.ClearUploadS3Bucket()
+	return scu
+}
+
 // SetVolumes sets the "volumes" field.
 func (scu *ServiceConfigUpdate) SetVolumes(sv []schema.ServiceVolume) *ServiceConfigUpdate {
 	scu.mutation.SetVolumes(sv)
@@ -607,6 +920,25 @@ func (scu *ServiceConfigUpdate) SetS3BackupSources(s *S3) *ServiceConfigUpdate {
 	return scu.SetS3BackupSourcesID(s.ID)
 }
 
+// SetS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID.
+func (scu *ServiceConfigUpdate) SetS3UploadSourcesID(id uuid.UUID) *ServiceConfigUpdate {
+	scu.mutation.SetS3UploadSourcesID(id)
+	return scu
+}
+
+// SetNillableS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID if the given value is not nil.
+func (scu *ServiceConfigUpdate) SetNillableS3UploadSourcesID(id *uuid.UUID) *ServiceConfigUpdate {
+	if id != nil {
+		scu = scu.SetS3UploadSourcesID(*id)
+	}
+	return scu
+}
+
+// SetS3UploadSources sets the "s3_upload_sources" edge to the S3 entity.
+func (scu *ServiceConfigUpdate) SetS3UploadSources(v *S3) *ServiceConfigUpdate {
+	return scu.SetS3UploadSourcesID(v.ID)
+}
+
 // Mutation returns the ServiceConfigMutation object of the builder.
 func (scu *ServiceConfigUpdate) Mutation() *ServiceConfigMutation {
 	return scu.mutation
@@ -624,6 +956,12 @@ func (scu *ServiceConfigUpdate) ClearS3BackupSources() *ServiceConfigUpdate {
 	return scu
 }
 
+// ClearS3UploadSources clears the "s3_upload_sources" edge to the S3 entity.
+func (scu *ServiceConfigUpdate) ClearS3UploadSources() *ServiceConfigUpdate {
+	scu.mutation.ClearS3UploadSources()
+	return scu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (scu *ServiceConfigUpdate) Save(ctx context.Context) (int, error) {
 	scu.defaults()
@@ -677,6 +1015,11 @@ func (scu *ServiceConfigUpdate) check() error {
 			return &ValidationError{Name: "railpack_framework", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.railpack_framework": %w`, err)}
 		}
 	}
+	if v, ok := scu.mutation.RolloutStrategy(); ok {
+		if err := serviceconfig.RolloutStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "rollout_strategy", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.rollout_strategy": %w`, err)}
+		}
+	}
 	if v, ok := scu.mutation.HealthCheck(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "health_check", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.health_check": %w`, err)}
@@ -727,6 +1070,41 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if scu.mutation.DockerBuilderBuildContextCleared() {
 		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildContext, field.TypeString)
 	}
+	if value, ok := scu.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderTarget, field.TypeString, value)
+	}
+	if scu.mutation.DockerBuilderTargetCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderTarget, field.TypeString)
+	}
+	if value, ok := scu.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+	}
+	if scu.mutation.DockerBuilderBuildArgsCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildArgs, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+	}
+	if scu.mutation.DockerBuilderBuildContextsCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildContexts, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(serviceconfig.FieldBuildpacksBuilderImage, field.TypeString, value)
+	}
+	if scu.mutation.BuildpacksBuilderImageCleared() {
+		_spec.ClearField(serviceconfig.FieldBuildpacksBuilderImage, field.TypeString)
+	}
+	if value, ok := scu.mutation.Platforms(); ok {
+		_spec.SetField(serviceconfig.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldPlatforms, value)
+		})
+	}
+	if scu.mutation.PlatformsCleared() {
+		_spec.ClearField(serviceconfig.FieldPlatforms, field.TypeJSON)
+	}
 	if value, ok := scu.mutation.RailpackProvider(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackProvider, field.TypeEnum, value)
 	}
@@ -751,6 +1129,45 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if scu.mutation.GitTagCleared() {
 		_spec.ClearField(serviceconfig.FieldGitTag, field.TypeString)
 	}
+	if value, ok := scu.mutation.WatchPaths(); ok {
+		_spec.SetField(serviceconfig.FieldWatchPaths, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedWatchPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldWatchPaths, value)
+		})
+	}
+	if scu.mutation.WatchPathsCleared() {
+		_spec.ClearField(serviceconfig.FieldWatchPaths, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.SkipDeployMarker(); ok {
+		_spec.SetField(serviceconfig.FieldSkipDeployMarker, field.TypeString, value)
+	}
+	if scu.mutation.SkipDeployMarkerCleared() {
+		_spec.ClearField(serviceconfig.FieldSkipDeployMarker, field.TypeString)
+	}
+	if value, ok := scu.mutation.IgnoredCommitAuthors(); ok {
+		_spec.SetField(serviceconfig.FieldIgnoredCommitAuthors, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedIgnoredCommitAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldIgnoredCommitAuthors, value)
+		})
+	}
+	if scu.mutation.IgnoredCommitAuthorsCleared() {
+		_spec.ClearField(serviceconfig.FieldIgnoredCommitAuthors, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.PrPreviewVariables(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviewVariables, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedPrPreviewVariables(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldPrPreviewVariables, value)
+		})
+	}
+	if scu.mutation.PrPreviewVariablesCleared() {
+		_spec.ClearField(serviceconfig.FieldPrPreviewVariables, field.TypeJSON)
+	}
 	if value, ok := scu.mutation.Hosts(); ok {
 		_spec.SetField(serviceconfig.FieldHosts, field.TypeJSON, value)
 	}
@@ -782,6 +1199,12 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := scu.mutation.AutoDeploy(); ok {
 		_spec.SetField(serviceconfig.FieldAutoDeploy, field.TypeBool, value)
 	}
+	if value, ok := scu.mutation.AutoRollback(); ok {
+		_spec.SetField(serviceconfig.FieldAutoRollback, field.TypeBool, value)
+	}
+	if value, ok := scu.mutation.PrPreviews(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviews, field.TypeBool, value)
+	}
 	if value, ok := scu.mutation.RailpackBuilderInstallCommand(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackBuilderInstallCommand, field.TypeString, value)
 	}
@@ -800,6 +1223,21 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if scu.mutation.RunCommandCleared() {
 		_spec.ClearField(serviceconfig.FieldRunCommand, field.TypeString)
 	}
+	if value, ok := scu.mutation.PreDeployCommand(); ok {
+		_spec.SetField(serviceconfig.FieldPreDeployCommand, field.TypeString, value)
+	}
+	if scu.mutation.PreDeployCommandCleared() {
+		_spec.ClearField(serviceconfig.FieldPreDeployCommand, field.TypeString)
+	}
+	if value, ok := scu.mutation.RolloutStrategy(); ok {
+		_spec.SetField(serviceconfig.FieldRolloutStrategy, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.CanaryWeight(); ok {
+		_spec.SetField(serviceconfig.FieldCanaryWeight, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.AddedCanaryWeight(); ok {
+		_spec.AddField(serviceconfig.FieldCanaryWeight, field.TypeInt, value)
+	}
 	if value, ok := scu.mutation.IsPublic(); ok {
 		_spec.SetField(serviceconfig.FieldIsPublic, field.TypeBool, value)
 	}
@@ -809,6 +1247,15 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if scu.mutation.ImageCleared() {
 		_spec.ClearField(serviceconfig.FieldImage, field.TypeString)
 	}
+	if value, ok := scu.mutation.ImageAutoUpdate(); ok {
+		_spec.SetField(serviceconfig.FieldImageAutoUpdate, field.TypeBool, value)
+	}
+	if value, ok := scu.mutation.ImageDigest(); ok {
+		_spec.SetField(serviceconfig.FieldImageDigest, field.TypeString, value)
+	}
+	if scu.mutation.ImageDigestCleared() {
+		_spec.ClearField(serviceconfig.FieldImageDigest, field.TypeString)
+	}
 	if value, ok := scu.mutation.DefinitionVersion(); ok {
 		_spec.SetField(serviceconfig.FieldDefinitionVersion, field.TypeString, value)
 	}
@@ -836,6 +1283,12 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := scu.mutation.AddedBackupRetentionCount(); ok {
 		_spec.AddField(serviceconfig.FieldBackupRetentionCount, field.TypeInt, value)
 	}
+	if value, ok := scu.mutation.UploadS3Bucket(); ok {
+		_spec.SetField(serviceconfig.FieldUploadS3Bucket, field.TypeString, value)
+	}
+	if scu.mutation.UploadS3BucketCleared() {
+		_spec.ClearField(serviceconfig.FieldUploadS3Bucket, field.TypeString)
+	}
 	if value, ok := scu.mutation.Volumes(); ok {
 		_spec.SetField(serviceconfig.FieldVolumes, field.TypeJSON, value)
 	}
@@ -956,6 +1409,35 @@ func (scu *ServiceConfigUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if scu.mutation.S3UploadSourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceconfig.S3UploadSourcesTable,
+			Columns: []string{serviceconfig.S3UploadSourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.S3UploadSourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceconfig.S3UploadSourcesTable,
+			Columns: []string{serviceconfig.S3UploadSourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(scu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1066,6 +1548,88 @@ func (scuo *ServiceConfigUpdateOne) ClearDockerBuilderBuildContext() *ServiceCon
 	return scuo
 }
 
+// SetDockerBuilderTarget sets the "docker_builder_target" field.
+func (scuo *ServiceConfigUpdateOne) SetDockerBuilderTarget(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetDockerBuilderTarget(v)
+	return scuo
+}
+
+// SetNillableDockerBuilderTarget sets the "docker_builder_target" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableDockerBuilderTarget(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetDockerBuilderTarget(*v)
+	}
+	return scuo
+}
+
+// ClearDockerBuilderTarget clears the value of the "docker_builder_target" field.
+func (scuo *ServiceConfigUpdateOne) ClearDockerBuilderTarget() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearDockerBuilderTarget()
+	return scuo
+}
+
+// SetDockerBuilderBuildArgs sets the "docker_builder_build_args" field.
+func (scuo *ServiceConfigUpdateOne) SetDockerBuilderBuildArgs(v map[string]string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetDockerBuilderBuildArgs(v)
+	return scuo
+}
+
+// ClearDockerBuilderBuildArgs clears the value of the "docker_builder_build_args" field.
+func (scuo *ServiceConfigUpdateOne) ClearDockerBuilderBuildArgs() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearDockerBuilderBuildArgs()
+	return scuo
+}
+
+// SetDockerBuilderBuildContexts sets the "docker_builder_build_contexts" field.
+func (scuo *ServiceConfigUpdateOne) SetDockerBuilderBuildContexts(v map[string]string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetDockerBuilderBuildContexts(v)
+	return scuo
+}
+
+// ClearDockerBuilderBuildContexts clears the value of the "docker_builder_build_contexts" field.
+func (scuo *ServiceConfigUpdateOne) ClearDockerBuilderBuildContexts() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearDockerBuilderBuildContexts()
+	return scuo
+}
+
+// SetBuildpacksBuilderImage sets the "buildpacks_builder_image" field.
+func (scuo *ServiceConfigUpdateOne) SetBuildpacksBuilderImage(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetBuildpacksBuilderImage(v)
+	return scuo
+}
+
+// SetNillableBuildpacksBuilderImage sets the "buildpacks_builder_image" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableBuildpacksBuilderImage(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetBuildpacksBuilderImage(*v)
+	}
+	return scuo
+}
+
+// ClearBuildpacksBuilderImage clears the value of the "buildpacks_builder_image" field.
+func (scuo *ServiceConfigUpdateOne) ClearBuildpacksBuilderImage() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearBuildpacksBuilderImage()
+	return scuo
+}
+
+// SetPlatforms sets the "platforms" field.
+func (scuo *ServiceConfigUpdateOne) SetPlatforms(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetPlatforms(v)
+	return scuo
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (scuo *ServiceConfigUpdateOne) AppendPlatforms(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.AppendPlatforms(v)
+	return scuo
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (scuo *ServiceConfigUpdateOne) ClearPlatforms() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearPlatforms()
+	return scuo
+}
+
 // SetRailpackProvider sets the "railpack_provider" field.
 func (scuo *ServiceConfigUpdateOne) SetRailpackProvider(e enum.Provider) *ServiceConfigUpdateOne {
 	scuo.mutation.SetRailpackProvider(e)
@@ -1146,6 +1710,80 @@ func (scuo *ServiceConfigUpdateOne) ClearGitTag() *ServiceConfigUpdateOne {
 	return scuo
 }
 
+// SetWatchPaths sets the "watch_paths" field.
+func (scuo *ServiceConfigUpdateOne) SetWatchPaths(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetWatchPaths(v)
+	return scuo
+}
+
+// AppendWatchPaths appends value to the "watch_paths" field.
+func (scuo *ServiceConfigUpdateOne) AppendWatchPaths(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.AppendWatchPaths(v)
+	return scuo
+}
+
+// ClearWatchPaths clears the value of the "watch_paths" field.
+func (scuo *ServiceConfigUpdateOne) ClearWatchPaths() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearWatchPaths()
+	return scuo
+}
+
+// SetSkipDeployMarker sets the "skip_deploy_marker" field.
+func (scuo *ServiceConfigUpdateOne) SetSkipDeployMarker(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetSkipDeployMarker(v)
+	return scuo
+}
+
+// SetNillableSkipDeployMarker sets the "skip_deploy_marker" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableSkipDeployMarker(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetSkipDeployMarker(*v)
+	}
+	return scuo
+}
+
+// ClearSkipDeployMarker clears the value of the "skip_deploy_marker" field.
+func (scuo *ServiceConfigUpdateOne) ClearSkipDeployMarker() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearSkipDeployMarker()
+	return scuo
+}
+
+// SetIgnoredCommitAuthors sets the "ignored_commit_authors" field.
+func (scuo *ServiceConfigUpdateOne) SetIgnoredCommitAuthors(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetIgnoredCommitAuthors(v)
+	return scuo
+}
+
+// AppendIgnoredCommitAuthors appends value to the "ignored_commit_authors" field.
+func (scuo *ServiceConfigUpdateOne) AppendIgnoredCommitAuthors(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.AppendIgnoredCommitAuthors(v)
+	return scuo
+}
+
+// ClearIgnoredCommitAuthors clears the value of the "ignored_commit_authors" field.
+func (scuo *ServiceConfigUpdateOne) ClearIgnoredCommitAuthors() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearIgnoredCommitAuthors()
+	return scuo
+}
+
+// SetPrPreviewVariables sets the "pr_preview_variables" field.
+func (scuo *ServiceConfigUpdateOne) SetPrPreviewVariables(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetPrPreviewVariables(v)
+	return scuo
+}
+
+// AppendPrPreviewVariables appends value to the "pr_preview_variables" field.
+func (scuo *ServiceConfigUpdateOne) AppendPrPreviewVariables(v []string) *ServiceConfigUpdateOne {
+	scuo.mutation.AppendPrPreviewVariables(v)
+	return scuo
+}
+
+// ClearPrPreviewVariables clears the value of the "pr_preview_variables" field.
+func (scuo *ServiceConfigUpdateOne) ClearPrPreviewVariables() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearPrPreviewVariables()
+	return scuo
+}
+
 // SetHosts sets the "hosts" field.
 func (scuo *ServiceConfigUpdateOne) SetHosts(ss []schema.HostSpec) *ServiceConfigUpdateOne {
 	scuo.mutation.SetHosts(ss)
@@ -1217,6 +1855,34 @@ func (scuo *ServiceConfigUpdateOne) SetNillableAutoDeploy(b *bool) *ServiceConfi
 	return scuo
 }
 
+// SetAutoRollback sets the "auto_rollback" field.
+func (scuo *ServiceConfigUpdateOne) SetAutoRollback(v bool) *ServiceConfigUpdateOne {
+	scuo.mutation.SetAutoRollback(v)
+	return scuo
+}
+
+// SetNillableAutoRollback sets the "auto_rollback" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableAutoRollback(v *bool) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetAutoRollback(*v)
+	}
+	return scuo
+}
+
+// SetPrPreviews sets the "pr_previews" field.
+func (scuo *ServiceConfigUpdateOne) SetPrPreviews(v bool) *ServiceConfigUpdateOne {
+	scuo.mutation.SetPrPreviews(v)
+	return scuo
+}
+
+// SetNillablePrPreviews sets the "pr_previews" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillablePrPreviews(v *bool) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetPrPreviews(*v)
+	}
+	return scuo
+}
+
 // SetRailpackBuilderInstallCommand sets the "railpack_builder_install_command" field.
 func (scuo *ServiceConfigUpdateOne) SetRailpackBuilderInstallCommand(s string) *ServiceConfigUpdateOne {
 	scuo.mutation.SetRailpackBuilderInstallCommand(s)
@@ -1277,6 +1943,61 @@ func (scuo *ServiceConfigUpdateOne) ClearRunCommand() *ServiceConfigUpdateOne {
 	return scuo
 }
 
+// SetPreDeployCommand sets the "pre_deploy_command" field.
+func (scuo *ServiceConfigUpdateOne) SetPreDeployCommand(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetPreDeployCommand(v)
+	return scuo
+}
+
+// SetNillablePreDeployCommand sets the "pre_deploy_command" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillablePreDeployCommand(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetPreDeployCommand(*v)
+	}
+	return scuo
+}
+
+// ClearPreDeployCommand clears the value of the "pre_deploy_command" field.
+func (scuo *ServiceConfigUpdateOne) ClearPreDeployCommand() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearPreDeployCommand()
+	return scuo
+}
+
+// SetRolloutStrategy sets the "rollout_strategy" field.
+func (scuo *ServiceConfigUpdateOne) SetRolloutStrategy(v schema.RolloutStrategy) *ServiceConfigUpdateOne {
+	scuo.mutation.SetRolloutStrategy(v)
+	return scuo
+}
+
+// SetNillableRolloutStrategy sets the "rollout_strategy" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableRolloutStrategy(v *schema.RolloutStrategy) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetRolloutStrategy(*v)
+	}
+	return scuo
+}
+
+// SetCanaryWeight sets the "canary_weight" field.
+func (scuo *ServiceConfigUpdateOne) SetCanaryWeight(v int) *ServiceConfigUpdateOne {
+	scuo.mutation.ResetCanaryWeight()
+	scuo.mutation.SetCanaryWeight(v)
+	return scuo
+}
+
+// SetNillableCanaryWeight sets the "canary_weight" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableCanaryWeight(v *int) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetCanaryWeight(*v)
+	}
+	return scuo
+}
+
+// AddCanaryWeight adds value to the "canary_weight" field.
+func (scuo *ServiceConfigUpdateOne) AddCanaryWeight(v int) *ServiceConfigUpdateOne {
+	scuo.mutation.AddCanaryWeight(v)
+	return scuo
+}
+
 // SetIsPublic sets the "is_public" field.
 func (scuo *ServiceConfigUpdateOne) SetIsPublic(b bool) *ServiceConfigUpdateOne {
 	scuo.mutation.SetIsPublic(b)
@@ -1311,6 +2032,40 @@ func (scuo *ServiceConfigUpdateOne) ClearImage() *ServiceConfigUpdateOne {
 	return scuo
 }
 
+// SetImageAutoUpdate sets the "image_auto_update" field.
+func (scuo *ServiceConfigUpdateOne) SetImageAutoUpdate(v bool) *ServiceConfigUpdateOne {
+	scuo.mutation.SetImageAutoUpdate(v)
+	return scuo
+}
+
+// SetNillableImageAutoUpdate sets the "image_auto_update" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableImageAutoUpdate(v *bool) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetImageAutoUpdate(*v)
+	}
+	return scuo
+}
+
+// SetImageDigest sets the "image_digest" field.
+func (scuo *ServiceConfigUpdateOne) SetImageDigest(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetImageDigest(v)
+	return scuo
+}
+
+// SetNillableImageDigest sets the "image_digest" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableImageDigest(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetImageDigest(*v)
+	}
+	return scuo
+}
+
+// ClearImageDigest clears the value of the "image_digest" field.
+func (scuo *ServiceConfigUpdateOne) ClearImageDigest() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearImageDigest()
+	return scuo
+}
+
 // SetDefinitionVersion sets the "definition_version" field.
 func (scuo *ServiceConfigUpdateOne) SetDefinitionVersion(s string) *ServiceConfigUpdateOne {
 	scuo.mutation.SetDefinitionVersion(s)
@@ -1418,6 +2173,46 @@ func (scuo *ServiceConfigUpdateOne) AddBackupRetentionCount(i int) *ServiceConfi
 	return scuo
 }
 
+// SetUploadS3SourceID sets the "upload_s3_source_id" field.
+func (scuo *ServiceConfigUpdateOne) SetUploadS3SourceID(v uuid.UUID) *ServiceConfigUpdateOne {
+	scuo.mutation.SetUploadS3SourceID(v)
+	return scuo
+}
+
+// SetNillableUploadS3SourceID sets the "upload_s3_source_id" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableUploadS3SourceID(v *uuid.UUID) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetUploadS3SourceID(*v)
+	}
+	return scuo
+}
+
+// ClearUploadS3SourceID clears the value of the "upload_s3_source_id" field.
+func (scuo *ServiceConfigUpdateOne) ClearUploadS3SourceID() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearUploadS3SourceID()
+	return scuo
+}
+
+// SetUploadS3Bucket sets the "upload_s3_bucket" field.
+func (scuo *ServiceConfigUpdateOne) SetUploadS3Bucket(v string) *ServiceConfigUpdateOne {
+	scuo.mutation.SetUploadS3Bucket(v)
+	return scuo
+}
+
+// SetNillableUploadS3Bucket sets the "upload_s3_bucket" field if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableUploadS3Bucket(v *string) *ServiceConfigUpdateOne {
+	if v != nil {
+		scuo.SetUploadS3Bucket(*v)
+	}
+	return scuo
+}
+
+// ClearUploadS3Bucket clears the value of the "upload_s3_bucket" field.
+func (scuo *ServiceConfigUpdateOne) ClearUploadS3Bucket() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearUploadS3Bucket()
+	return scuo
+}
+
 // SetVolumes sets the "volumes" field.
 func (scuo *ServiceConfigUpdateOne) SetVolumes(sv []schema.ServiceVolume) *ServiceConfigUpdateOne {
 	scuo.mutation.SetVolumes(sv)
@@ -1550,6 +2345,25 @@ func (scuo *ServiceConfigUpdateOne) SetS3BackupSources(s *S3) *ServiceConfigUpda
 	return scuo.SetS3BackupSourcesID(s.ID)
 }
 
+// SetS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID.
+func (scuo *ServiceConfigUpdateOne) SetS3UploadSourcesID(id uuid.UUID) *ServiceConfigUpdateOne {
+	scuo.mutation.SetS3UploadSourcesID(id)
+	return scuo
+}
+
+// SetNillableS3UploadSourcesID sets the "s3_upload_sources" edge to the S3 entity by ID if the given value is not nil.
+func (scuo *ServiceConfigUpdateOne) SetNillableS3UploadSourcesID(id *uuid.UUID) *ServiceConfigUpdateOne {
+	if id != nil {
+		scuo = scuo.SetS3UploadSourcesID(*id)
+	}
+	return scuo
+}
+
+// SetS3UploadSources sets the "s3_upload_sources" edge to the S3 entity.
+func (scuo *ServiceConfigUpdateOne) SetS3UploadSources(v *S3) *ServiceConfigUpdateOne {
+	return scuo.SetS3UploadSourcesID(v.ID)
+}
+
 // Mutation returns the ServiceConfigMutation object of the builder.
 func (scuo *ServiceConfigUpdateOne) Mutation() *ServiceConfigMutation {
 	return scuo.mutation
@@ -1567,6 +2381,12 @@ func (scuo *ServiceConfigUpdateOne) ClearS3BackupSources() *ServiceConfigUpdateO
 	return scuo
 }
 
+// ClearS3UploadSources clears the "s3_upload_sources" edge to the S3 entity.
+func (scuo *ServiceConfigUpdateOne) ClearS3UploadSources() *ServiceConfigUpdateOne {
+	scuo.mutation.ClearS3UploadSources()
+	return scuo
+}
+
 // Where appends a list predicates to the ServiceConfigUpdate builder.
 func (scuo *ServiceConfigUpdateOne) Where(ps ...predicate.ServiceConfig) *ServiceConfigUpdateOne {
 	scuo.mutation.Where(ps...)
@@ -1633,6 +2453,11 @@ func (scuo *ServiceConfigUpdateOne) check() error {
 			return &ValidationError{Name: "railpack_framework", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.railpack_framework": %w`, err)}
 		}
 	}
+	if v, ok := scuo.mutation.RolloutStrategy(); ok {
+		if err := serviceconfig.RolloutStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "rollout_strategy", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.rollout_strategy": %w`, err)}
+		}
+	}
 	if v, ok := scuo.mutation.HealthCheck(); ok {
 		if err := v.Validate(); err != nil {
 			return &ValidationError{Name: "health_check", err: fmt.Errorf(`ent: validator failed for field "ServiceConfig.health_check": %w`, err)}
@@ -1700,6 +2525,41 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if scuo.mutation.DockerBuilderBuildContextCleared() {
 		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildContext, field.TypeString)
 	}
+	if value, ok := scuo.mutation.DockerBuilderTarget(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderTarget, field.TypeString, value)
+	}
+	if scuo.mutation.DockerBuilderTargetCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderTarget, field.TypeString)
+	}
+	if value, ok := scuo.mutation.DockerBuilderBuildArgs(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildArgs, field.TypeJSON, value)
+	}
+	if scuo.mutation.DockerBuilderBuildArgsCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildArgs, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.DockerBuilderBuildContexts(); ok {
+		_spec.SetField(serviceconfig.FieldDockerBuilderBuildContexts, field.TypeJSON, value)
+	}
+	if scuo.mutation.DockerBuilderBuildContextsCleared() {
+		_spec.ClearField(serviceconfig.FieldDockerBuilderBuildContexts, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.BuildpacksBuilderImage(); ok {
+		_spec.SetField(serviceconfig.FieldBuildpacksBuilderImage, field.TypeString, value)
+	}
+	if scuo.mutation.BuildpacksBuilderImageCleared() {
+		_spec.ClearField(serviceconfig.FieldBuildpacksBuilderImage, field.TypeString)
+	}
+	if value, ok := scuo.mutation.Platforms(); ok {
+		_spec.SetField(serviceconfig.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldPlatforms, value)
+		})
+	}
+	if scuo.mutation.PlatformsCleared() {
+		_spec.ClearField(serviceconfig.FieldPlatforms, field.TypeJSON)
+	}
 	if value, ok := scuo.mutation.RailpackProvider(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackProvider, field.TypeEnum, value)
 	}
@@ -1724,6 +2584,45 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if scuo.mutation.GitTagCleared() {
 		_spec.ClearField(serviceconfig.FieldGitTag, field.TypeString)
 	}
+	if value, ok := scuo.mutation.WatchPaths(); ok {
+		_spec.SetField(serviceconfig.FieldWatchPaths, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedWatchPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldWatchPaths, value)
+		})
+	}
+	if scuo.mutation.WatchPathsCleared() {
+		_spec.ClearField(serviceconfig.FieldWatchPaths, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.SkipDeployMarker(); ok {
+		_spec.SetField(serviceconfig.FieldSkipDeployMarker, field.TypeString, value)
+	}
+	if scuo.mutation.SkipDeployMarkerCleared() {
+		_spec.ClearField(serviceconfig.FieldSkipDeployMarker, field.TypeString)
+	}
+	if value, ok := scuo.mutation.IgnoredCommitAuthors(); ok {
+		_spec.SetField(serviceconfig.FieldIgnoredCommitAuthors, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedIgnoredCommitAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldIgnoredCommitAuthors, value)
+		})
+	}
+	if scuo.mutation.IgnoredCommitAuthorsCleared() {
+		_spec.ClearField(serviceconfig.FieldIgnoredCommitAuthors, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.PrPreviewVariables(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviewVariables, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedPrPreviewVariables(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serviceconfig.FieldPrPreviewVariables, value)
+		})
+	}
+	if scuo.mutation.PrPreviewVariablesCleared() {
+		_spec.ClearField(serviceconfig.FieldPrPreviewVariables, field.TypeJSON)
+	}
 	if value, ok := scuo.mutation.Hosts(); ok {
 		_spec.SetField(serviceconfig.FieldHosts, field.TypeJSON, value)
 	}
@@ -1755,6 +2654,12 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if value, ok := scuo.mutation.AutoDeploy(); ok {
 		_spec.SetField(serviceconfig.FieldAutoDeploy, field.TypeBool, value)
 	}
+	if value, ok := scuo.mutation.AutoRollback(); ok {
+		_spec.SetField(serviceconfig.FieldAutoRollback, field.TypeBool, value)
+	}
+	if value, ok := scuo.mutation.PrPreviews(); ok {
+		_spec.SetField(serviceconfig.FieldPrPreviews, field.TypeBool, value)
+	}
 	if value, ok := scuo.mutation.RailpackBuilderInstallCommand(); ok {
 		_spec.SetField(serviceconfig.FieldRailpackBuilderInstallCommand, field.TypeString, value)
 	}
@@ -1773,6 +2678,21 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if scuo.mutation.RunCommandCleared() {
 		_spec.ClearField(serviceconfig.FieldRunCommand, field.TypeString)
 	}
+	if value, ok := scuo.mutation.PreDeployCommand(); ok {
+		_spec.SetField(serviceconfig.FieldPreDeployCommand, field.TypeString, value)
+	}
+	if scuo.mutation.PreDeployCommandCleared() {
+		_spec.ClearField(serviceconfig.FieldPreDeployCommand, field.TypeString)
+	}
+	if value, ok := scuo.mutation.RolloutStrategy(); ok {
+		_spec.SetField(serviceconfig.FieldRolloutStrategy, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.CanaryWeight(); ok {
+		_spec.SetField(serviceconfig.FieldCanaryWeight, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.AddedCanaryWeight(); ok {
+		_spec.AddField(serviceconfig.FieldCanaryWeight, field.TypeInt, value)
+	}
 	if value, ok := scuo.mutation.IsPublic(); ok {
 		_spec.SetField(serviceconfig.FieldIsPublic, field.TypeBool, value)
 	}
@@ -1782,6 +2702,15 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if scuo.mutation.ImageCleared() {
 		_spec.ClearField(serviceconfig.FieldImage, field.TypeString)
 	}
+	if value, ok := scuo.mutation.ImageAutoUpdate(); ok {
+		_spec.SetField(serviceconfig.FieldImageAutoUpdate, field.TypeBool, value)
+	}
+	if value, ok := scuo.mutation.ImageDigest(); ok {
+		_spec.SetField(serviceconfig.FieldImageDigest, field.TypeString, value)
+	}
+	if scuo.mutation.ImageDigestCleared() {
+		_spec.ClearField(serviceconfig.FieldImageDigest, field.TypeString)
+	}
 	if value, ok := scuo.mutation.DefinitionVersion(); ok {
 		_spec.SetField(serviceconfig.FieldDefinitionVersion, field.TypeString, value)
 	}
@@ -1809,6 +2738,12 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 	if value, ok := scuo.mutation.AddedBackupRetentionCount(); ok {
 		_spec.AddField(serviceconfig.FieldBackupRetentionCount, field.TypeInt, value)
 	}
+	if value, ok := scuo.mutation.UploadS3Bucket(); ok {
+		_spec.SetField(serviceconfig.FieldUploadS3Bucket, field.TypeString, value)
+	}
+	if scuo.mutation.UploadS3BucketCleared() {
+		_spec.ClearField(serviceconfig.FieldUploadS3Bucket, field.TypeString)
+	}
 	if value, ok := scuo.mutation.Volumes(); ok {
 		_spec.SetField(serviceconfig.FieldVolumes, field.TypeJSON, value)
 	}
@@ -1929,6 +2864,35 @@ func (scuo *ServiceConfigUpdateOne) sqlSave(ctx context.Context) (_node *Service
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if scuo.mutation.S3UploadSourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceconfig.S3UploadSourcesTable,
+			Columns: []string{serviceconfig.S3UploadSourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.S3UploadSourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceconfig.S3UploadSourcesTable,
+			Columns: []string{serviceconfig.S3UploadSourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(scuo.modifiers...)
 	_node = &ServiceConfig{config: scuo.config}
 	_spec.Assign = _node.assignValues
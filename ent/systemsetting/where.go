@@ -235,6 +235,16 @@ func BuildkitSettingsNotNil() predicate.SystemSetting {
 	return predicate.SystemSetting(sql.FieldNotNull(FieldBuildkitSettings))
 }
 
+// BuildSettingsIsNil applies the IsNil predicate on the "build_settings" field.
+func BuildSettingsIsNil() predicate.SystemSetting {
+	return predicate.SystemSetting(sql.FieldIsNull(FieldBuildSettings))
+}
+
+// BuildSettingsNotNil applies the NotNil predicate on the "build_settings" field.
+func BuildSettingsNotNil() predicate.SystemSetting {
+	return predicate.SystemSetting(sql.FieldNotNull(FieldBuildSettings))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SystemSetting) predicate.SystemSetting {
 	return predicate.SystemSetting(sql.AndPredicates(predicates...))
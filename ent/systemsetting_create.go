@@ -73,6 +73,12 @@ func (ssc *SystemSettingCreate) SetBuildkitSettings(ss *schema.BuildkitSettings)
 	return ssc
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (ssc *SystemSettingCreate) SetBuildSettings(v *schema.BuildSettings) *SystemSettingCreate {
+	ssc.mutation.SetBuildSettings(v)
+	return ssc
+}
+
 // SetID sets the "id" field.
 func (ssc *SystemSettingCreate) SetID(u uuid.UUID) *SystemSettingCreate {
 	ssc.mutation.SetID(u)
@@ -196,6 +202,10 @@ func (ssc *SystemSettingCreate) createSpec() (*SystemSetting, *sqlgraph.CreateSp
 		_spec.SetField(systemsetting.FieldBuildkitSettings, field.TypeJSON, value)
 		_node.BuildkitSettings = value
 	}
+	if value, ok := ssc.mutation.BuildSettings(); ok {
+		_spec.SetField(systemsetting.FieldBuildSettings, field.TypeJSON, value)
+		_node.BuildSettings = value
+	}
 	return _node, _spec
 }
 
@@ -296,6 +306,24 @@ func (u *SystemSettingUpsert) ClearBuildkitSettings() *SystemSettingUpsert {
 	return u
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (u *SystemSettingUpsert) SetBuildSettings(v *schema.BuildSettings) *SystemSettingUpsert {
+	u.Set(systemsetting.FieldBuildSettings, v)
+	return u
+}
+
+// UpdateBuildSettings sets the "build_settings" field to the value that was provided on create.
+func (u *SystemSettingUpsert) UpdateBuildSettings() *SystemSettingUpsert {
+	u.SetExcluded(systemsetting.FieldBuildSettings)
+	return u
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (u *SystemSettingUpsert) ClearBuildSettings() *SystemSettingUpsert {
+	u.SetNull(systemsetting.FieldBuildSettings)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -403,6 +431,27 @@ func (u *SystemSettingUpsertOne) ClearBuildkitSettings() *SystemSettingUpsertOne
 	})
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (u *SystemSettingUpsertOne) SetBuildSettings(v *schema.BuildSettings) *SystemSettingUpsertOne {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.SetBuildSettings(v)
+	})
+}
+
+// UpdateBuildSettings sets the "build_settings" field to the value that was provided on create.
+func (u *SystemSettingUpsertOne) UpdateBuildSettings() *SystemSettingUpsertOne {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.UpdateBuildSettings()
+	})
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (u *SystemSettingUpsertOne) ClearBuildSettings() *SystemSettingUpsertOne {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.ClearBuildSettings()
+	})
+}
+
 // Exec executes the query.
 func (u *SystemSettingUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -677,6 +726,27 @@ func (u *SystemSettingUpsertBulk) ClearBuildkitSettings() *SystemSettingUpsertBu
 	})
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (u *SystemSettingUpsertBulk) SetBuildSettings(v *schema.BuildSettings) *SystemSettingUpsertBulk {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.SetBuildSettings(v)
+	})
+}
+
+// UpdateBuildSettings sets the "build_settings" field to the value that was provided on create.
+func (u *SystemSettingUpsertBulk) UpdateBuildSettings() *SystemSettingUpsertBulk {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.UpdateBuildSettings()
+	})
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (u *SystemSettingUpsertBulk) ClearBuildSettings() *SystemSettingUpsertBulk {
+	return u.Update(func(s *SystemSettingUpsert) {
+		s.ClearBuildSettings()
+	})
+}
+
 // Exec executes the query.
 func (u *SystemSettingUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
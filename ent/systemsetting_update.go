@@ -68,6 +68,18 @@ func (ssu *SystemSettingUpdate) ClearBuildkitSettings() *SystemSettingUpdate {
 	return ssu
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (ssu *SystemSettingUpdate) SetBuildSettings(v *schema.BuildSettings) *SystemSettingUpdate {
+	ssu.mutation.SetBuildSettings(v)
+	return ssu
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (ssu *SystemSettingUpdate) ClearBuildSettings() *SystemSettingUpdate {
+	ssu.mutation.ClearBuildSettings()
+	return ssu
+}
+
 // Mutation returns the SystemSettingMutation object of the builder.
 func (ssu *SystemSettingUpdate) Mutation() *SystemSettingMutation {
 	return ssu.mutation
@@ -139,6 +151,12 @@ func (ssu *SystemSettingUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if ssu.mutation.BuildkitSettingsCleared() {
 		_spec.ClearField(systemsetting.FieldBuildkitSettings, field.TypeJSON)
 	}
+	if value, ok := ssu.mutation.BuildSettings(); ok {
+		_spec.SetField(systemsetting.FieldBuildSettings, field.TypeJSON, value)
+	}
+	if ssu.mutation.BuildSettingsCleared() {
+		_spec.ClearField(systemsetting.FieldBuildSettings, field.TypeJSON)
+	}
 	_spec.AddModifiers(ssu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -199,6 +217,18 @@ func (ssuo *SystemSettingUpdateOne) ClearBuildkitSettings() *SystemSettingUpdate
 	return ssuo
 }
 
+// SetBuildSettings sets the "build_settings" field.
+func (ssuo *SystemSettingUpdateOne) SetBuildSettings(v *schema.BuildSettings) *SystemSettingUpdateOne {
+	ssuo.mutation.SetBuildSettings(v)
+	return ssuo
+}
+
+// ClearBuildSettings clears the value of the "build_settings" field.
+func (ssuo *SystemSettingUpdateOne) ClearBuildSettings() *SystemSettingUpdateOne {
+	ssuo.mutation.ClearBuildSettings()
+	return ssuo
+}
+
 // Mutation returns the SystemSettingMutation object of the builder.
 func (ssuo *SystemSettingUpdateOne) Mutation() *SystemSettingMutation {
 	return ssuo.mutation
@@ -300,6 +330,12 @@ func (ssuo *SystemSettingUpdateOne) sqlSave(ctx context.Context) (_node *SystemS
 	if ssuo.mutation.BuildkitSettingsCleared() {
 		_spec.ClearField(systemsetting.FieldBuildkitSettings, field.TypeJSON)
 	}
+	if value, ok := ssuo.mutation.BuildSettings(); ok {
+		_spec.SetField(systemsetting.FieldBuildSettings, field.TypeJSON, value)
+	}
+	if ssuo.mutation.BuildSettingsCleared() {
+		_spec.ClearField(systemsetting.FieldBuildSettings, field.TypeJSON)
+	}
 	_spec.AddModifiers(ssuo.modifiers...)
 	_node = &SystemSetting{config: ssuo.config}
 	_spec.Assign = _node.assignValues
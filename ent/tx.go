@@ -24,6 +24,8 @@ type Tx struct {
 	GithubApp *GithubAppClient
 	// GithubInstallation is the client for interacting with the GithubInstallation builders.
 	GithubInstallation *GithubInstallationClient
+	// GitlabConnection is the client for interacting with the GitlabConnection builders.
+	GitlabConnection *GitlabConnectionClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// JWTKey is the client for interacting with the JWTKey builders.
@@ -42,6 +44,8 @@ type Tx struct {
 	Registry *RegistryClient
 	// S3 is the client for interacting with the S3 builders.
 	S3 *S3Client
+	// SBOMPackage is the client for interacting with the SBOMPackage builders.
+	SBOMPackage *SBOMPackageClient
 	// Service is the client for interacting with the Service builders.
 	Service *ServiceClient
 	// ServiceConfig is the client for interacting with the ServiceConfig builders.
@@ -196,6 +200,7 @@ func (tx *Tx) init() {
 	tx.Environment = NewEnvironmentClient(tx.config)
 	tx.GithubApp = NewGithubAppClient(tx.config)
 	tx.GithubInstallation = NewGithubInstallationClient(tx.config)
+	tx.GitlabConnection = NewGitlabConnectionClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.JWTKey = NewJWTKeyClient(tx.config)
 	tx.Oauth2Code = NewOauth2CodeClient(tx.config)
@@ -205,6 +210,7 @@ func (tx *Tx) init() {
 	tx.Project = NewProjectClient(tx.config)
 	tx.Registry = NewRegistryClient(tx.config)
 	tx.S3 = NewS3Client(tx.config)
+	tx.SBOMPackage = NewSBOMPackageClient(tx.config)
 	tx.Service = NewServiceClient(tx.config)
 	tx.ServiceConfig = NewServiceConfigClient(tx.config)
 	tx.ServiceGroup = NewServiceGroupClient(tx.config)
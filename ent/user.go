@@ -41,13 +41,15 @@ type UserEdges struct {
 	Oauth2Codes []*Oauth2Code `json:"oauth2_codes,omitempty"`
 	// CreatedBy holds the value of the created_by edge.
 	CreatedBy []*GithubApp `json:"created_by,omitempty"`
+	// GitlabConnections holds the value of the gitlab_connections edge.
+	GitlabConnections []*GitlabConnection `json:"gitlab_connections,omitempty"`
 	// Groups holds the value of the groups edge.
 	Groups []*Group `json:"groups,omitempty"`
 	// Teams holds the value of the teams edge.
 	Teams []*Team `json:"teams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // Oauth2TokensOrErr returns the Oauth2Tokens value or an error if the edge
@@ -77,10 +79,19 @@ func (e UserEdges) CreatedByOrErr() ([]*GithubApp, error) {
 	return nil, &NotLoadedError{edge: "created_by"}
 }
 
+// GitlabConnectionsOrErr returns the GitlabConnections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GitlabConnectionsOrErr() ([]*GitlabConnection, error) {
+	if e.loadedTypes[3] {
+		return e.GitlabConnections, nil
+	}
+	return nil, &NotLoadedError{edge: "gitlab_connections"}
+}
+
 // GroupsOrErr returns the Groups value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) GroupsOrErr() ([]*Group, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Groups, nil
 	}
 	return nil, &NotLoadedError{edge: "groups"}
@@ -89,7 +100,7 @@ func (e UserEdges) GroupsOrErr() ([]*Group, error) {
 // TeamsOrErr returns the Teams value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TeamsOrErr() ([]*Team, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Teams, nil
 	}
 	return nil, &NotLoadedError{edge: "teams"}
@@ -179,6 +190,11 @@ func (u *User) QueryCreatedBy() *GithubAppQuery {
 	return NewUserClient(u.config).QueryCreatedBy(u)
 }
 
+// QueryGitlabConnections queries the "gitlab_connections" edge of the User entity.
+func (u *User) QueryGitlabConnections() *GitlabConnectionQuery {
+	return NewUserClient(u.config).QueryGitlabConnections(u)
+}
+
 // QueryGroups queries the "groups" edge of the User entity.
 func (u *User) QueryGroups() *GroupQuery {
 	return NewUserClient(u.config).QueryGroups(u)
@@ -29,6 +29,8 @@ const (
 	EdgeOauth2Codes = "oauth2_codes"
 	// EdgeCreatedBy holds the string denoting the created_by edge name in mutations.
 	EdgeCreatedBy = "created_by"
+	// EdgeGitlabConnections holds the string denoting the gitlab_connections edge name in mutations.
+	EdgeGitlabConnections = "gitlab_connections"
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
@@ -56,6 +58,13 @@ const (
 	CreatedByInverseTable = "github_apps"
 	// CreatedByColumn is the table column denoting the created_by relation/edge.
 	CreatedByColumn = "created_by"
+	// GitlabConnectionsTable is the table that holds the gitlab_connections relation/edge.
+	GitlabConnectionsTable = "gitlab_connections"
+	// GitlabConnectionsInverseTable is the table name for the GitlabConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "gitlabconnection" package.
+	GitlabConnectionsInverseTable = "gitlab_connections"
+	// GitlabConnectionsColumn is the table column denoting the gitlab_connections relation/edge.
+	GitlabConnectionsColumn = "created_by"
 	// GroupsTable is the table that holds the groups relation/edge. The primary key declared below.
 	GroupsTable = "user_groups"
 	// GroupsInverseTable is the table name for the Group entity.
@@ -177,6 +186,20 @@ func ByCreatedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByGitlabConnectionsCount orders the results by gitlab_connections count.
+func ByGitlabConnectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGitlabConnectionsStep(), opts...)
+	}
+}
+
+// ByGitlabConnections orders the results by gitlab_connections terms.
+func ByGitlabConnections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGitlabConnectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByGroupsCount orders the results by groups count.
 func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -225,6 +248,13 @@ func newCreatedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CreatedByTable, CreatedByColumn),
 	)
 }
+func newGitlabConnectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GitlabConnectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GitlabConnectionsTable, GitlabConnectionsColumn),
+	)
+}
 func newGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -355,6 +355,29 @@ func HasCreatedByWith(preds ...predicate.GithubApp) predicate.User {
 	})
 }
 
+// HasGitlabConnections applies the HasEdge predicate on the "gitlab_connections" edge.
+func HasGitlabConnections() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GitlabConnectionsTable, GitlabConnectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGitlabConnectionsWith applies the HasEdge predicate on the "gitlab_connections" edge with a given conditions (other predicates).
+func HasGitlabConnectionsWith(preds ...predicate.GitlabConnection) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGitlabConnectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasGroups applies the HasEdge predicate on the "groups" edge.
 func HasGroups() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
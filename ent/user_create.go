@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
 	"github.com/unbindapp/unbind-api/ent/oauth2token"
@@ -128,6 +129,21 @@ func (uc *UserCreate) AddCreatedBy(g ...*GithubApp) *UserCreate {
 	return uc.AddCreatedByIDs(ids...)
 }
 
+// AddGitlabConnectionIDs adds the "gitlab_connections" edge to the GitlabConnection entity by IDs.
+func (uc *UserCreate) AddGitlabConnectionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddGitlabConnectionIDs(ids...)
+	return uc
+}
+
+// AddGitlabConnections adds the "gitlab_connections" edges to the GitlabConnection entity.
+func (uc *UserCreate) AddGitlabConnections(v ...*GitlabConnection) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uc.AddGitlabConnectionIDs(ids...)
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (uc *UserCreate) AddGroupIDs(ids ...uuid.UUID) *UserCreate {
 	uc.mutation.AddGroupIDs(ids...)
@@ -321,6 +337,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.GitlabConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
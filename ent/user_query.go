@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
 	"github.com/unbindapp/unbind-api/ent/oauth2token"
@@ -25,16 +26,17 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx              *QueryContext
-	order            []user.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.User
-	withOauth2Tokens *Oauth2TokenQuery
-	withOauth2Codes  *Oauth2CodeQuery
-	withCreatedBy    *GithubAppQuery
-	withGroups       *GroupQuery
-	withTeams        *TeamQuery
-	modifiers        []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []user.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.User
+	withOauth2Tokens      *Oauth2TokenQuery
+	withOauth2Codes       *Oauth2CodeQuery
+	withCreatedBy         *GithubAppQuery
+	withGitlabConnections *GitlabConnectionQuery
+	withGroups            *GroupQuery
+	withTeams             *TeamQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -137,6 +139,28 @@ func (uq *UserQuery) QueryCreatedBy() *GithubAppQuery {
 	return query
 }
 
+// QueryGitlabConnections chains the current query on the "gitlab_connections" edge.
+func (uq *UserQuery) QueryGitlabConnections() *GitlabConnectionQuery {
+	query := (&GitlabConnectionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(gitlabconnection.Table, gitlabconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GitlabConnectionsTable, user.GitlabConnectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryGroups chains the current query on the "groups" edge.
 func (uq *UserQuery) QueryGroups() *GroupQuery {
 	query := (&GroupClient{config: uq.config}).Query()
@@ -368,16 +392,17 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:           uq.config,
-		ctx:              uq.ctx.Clone(),
-		order:            append([]user.OrderOption{}, uq.order...),
-		inters:           append([]Interceptor{}, uq.inters...),
-		predicates:       append([]predicate.User{}, uq.predicates...),
-		withOauth2Tokens: uq.withOauth2Tokens.Clone(),
-		withOauth2Codes:  uq.withOauth2Codes.Clone(),
-		withCreatedBy:    uq.withCreatedBy.Clone(),
-		withGroups:       uq.withGroups.Clone(),
-		withTeams:        uq.withTeams.Clone(),
+		config:                uq.config,
+		ctx:                   uq.ctx.Clone(),
+		order:                 append([]user.OrderOption{}, uq.order...),
+		inters:                append([]Interceptor{}, uq.inters...),
+		predicates:            append([]predicate.User{}, uq.predicates...),
+		withOauth2Tokens:      uq.withOauth2Tokens.Clone(),
+		withOauth2Codes:       uq.withOauth2Codes.Clone(),
+		withCreatedBy:         uq.withCreatedBy.Clone(),
+		withGitlabConnections: uq.withGitlabConnections.Clone(),
+		withGroups:            uq.withGroups.Clone(),
+		withTeams:             uq.withTeams.Clone(),
 		// clone intermediate query.
 		sql:       uq.sql.Clone(),
 		path:      uq.path,
@@ -418,6 +443,17 @@ func (uq *UserQuery) WithCreatedBy(opts ...func(*GithubAppQuery)) *UserQuery {
 	return uq
 }
 
+// WithGitlabConnections tells the query-builder to eager-load the nodes that are connected to
+// the "gitlab_connections" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGitlabConnections(opts ...func(*GitlabConnectionQuery)) *UserQuery {
+	query := (&GitlabConnectionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGitlabConnections = query
+	return uq
+}
+
 // WithGroups tells the query-builder to eager-load the nodes that are connected to
 // the "groups" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithGroups(opts ...func(*GroupQuery)) *UserQuery {
@@ -518,10 +554,11 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			uq.withOauth2Tokens != nil,
 			uq.withOauth2Codes != nil,
 			uq.withCreatedBy != nil,
+			uq.withGitlabConnections != nil,
 			uq.withGroups != nil,
 			uq.withTeams != nil,
 		}
@@ -568,6 +605,13 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withGitlabConnections; query != nil {
+		if err := uq.loadGitlabConnections(ctx, query, nodes,
+			func(n *User) { n.Edges.GitlabConnections = []*GitlabConnection{} },
+			func(n *User, e *GitlabConnection) { n.Edges.GitlabConnections = append(n.Edges.GitlabConnections, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withGroups; query != nil {
 		if err := uq.loadGroups(ctx, query, nodes,
 			func(n *User) { n.Edges.Groups = []*Group{} },
@@ -677,6 +721,36 @@ func (uq *UserQuery) loadCreatedBy(ctx context.Context, query *GithubAppQuery, n
 	}
 	return nil
 }
+func (uq *UserQuery) loadGitlabConnections(ctx context.Context, query *GitlabConnectionQuery, nodes []*User, init func(*User), assign func(*User, *GitlabConnection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(gitlabconnection.FieldCreatedBy)
+	}
+	query.Where(predicate.GitlabConnection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GitlabConnectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedBy
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (uq *UserQuery) loadGroups(ctx context.Context, query *GroupQuery, nodes []*User, init func(*User), assign func(*User, *Group)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*User)
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/group"
 	"github.com/unbindapp/unbind-api/ent/oauth2code"
 	"github.com/unbindapp/unbind-api/ent/oauth2token"
@@ -114,6 +115,21 @@ func (uu *UserUpdate) AddCreatedBy(g ...*GithubApp) *UserUpdate {
 	return uu.AddCreatedByIDs(ids...)
 }
 
+// AddGitlabConnectionIDs adds the "gitlab_connections" edge to the GitlabConnection entity by IDs.
+func (uu *UserUpdate) AddGitlabConnectionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddGitlabConnectionIDs(ids...)
+	return uu
+}
+
+// AddGitlabConnections adds the "gitlab_connections" edges to the GitlabConnection entity.
+func (uu *UserUpdate) AddGitlabConnections(v ...*GitlabConnection) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uu.AddGitlabConnectionIDs(ids...)
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (uu *UserUpdate) AddGroupIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddGroupIDs(ids...)
@@ -212,6 +228,27 @@ func (uu *UserUpdate) RemoveCreatedBy(g ...*GithubApp) *UserUpdate {
 	return uu.RemoveCreatedByIDs(ids...)
 }
 
+// ClearGitlabConnections clears all "gitlab_connections" edges to the GitlabConnection entity.
+func (uu *UserUpdate) ClearGitlabConnections() *UserUpdate {
+	uu.mutation.ClearGitlabConnections()
+	return uu
+}
+
+// RemoveGitlabConnectionIDs removes the "gitlab_connections" edge to GitlabConnection entities by IDs.
+func (uu *UserUpdate) RemoveGitlabConnectionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveGitlabConnectionIDs(ids...)
+	return uu
+}
+
+// RemoveGitlabConnections removes "gitlab_connections" edges to GitlabConnection entities.
+func (uu *UserUpdate) RemoveGitlabConnections(v ...*GitlabConnection) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uu.RemoveGitlabConnectionIDs(ids...)
+}
+
 // ClearGroups clears all "groups" edges to the Group entity.
 func (uu *UserUpdate) ClearGroups() *UserUpdate {
 	uu.mutation.ClearGroups()
@@ -449,6 +486,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.GitlabConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGitlabConnectionsIDs(); len(nodes) > 0 && !uu.mutation.GitlabConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GitlabConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -640,6 +722,21 @@ func (uuo *UserUpdateOne) AddCreatedBy(g ...*GithubApp) *UserUpdateOne {
 	return uuo.AddCreatedByIDs(ids...)
 }
 
+// AddGitlabConnectionIDs adds the "gitlab_connections" edge to the GitlabConnection entity by IDs.
+func (uuo *UserUpdateOne) AddGitlabConnectionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddGitlabConnectionIDs(ids...)
+	return uuo
+}
+
+// AddGitlabConnections adds the "gitlab_connections" edges to the GitlabConnection entity.
+func (uuo *UserUpdateOne) AddGitlabConnections(v ...*GitlabConnection) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uuo.AddGitlabConnectionIDs(ids...)
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (uuo *UserUpdateOne) AddGroupIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddGroupIDs(ids...)
@@ -738,6 +835,27 @@ func (uuo *UserUpdateOne) RemoveCreatedBy(g ...*GithubApp) *UserUpdateOne {
 	return uuo.RemoveCreatedByIDs(ids...)
 }
 
+// ClearGitlabConnections clears all "gitlab_connections" edges to the GitlabConnection entity.
+func (uuo *UserUpdateOne) ClearGitlabConnections() *UserUpdateOne {
+	uuo.mutation.ClearGitlabConnections()
+	return uuo
+}
+
+// RemoveGitlabConnectionIDs removes the "gitlab_connections" edge to GitlabConnection entities by IDs.
+func (uuo *UserUpdateOne) RemoveGitlabConnectionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveGitlabConnectionIDs(ids...)
+	return uuo
+}
+
+// RemoveGitlabConnections removes "gitlab_connections" edges to GitlabConnection entities.
+func (uuo *UserUpdateOne) RemoveGitlabConnections(v ...*GitlabConnection) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uuo.RemoveGitlabConnectionIDs(ids...)
+}
+
 // ClearGroups clears all "groups" edges to the Group entity.
 func (uuo *UserUpdateOne) ClearGroups() *UserUpdateOne {
 	uuo.mutation.ClearGroups()
@@ -1005,6 +1123,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.GitlabConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedGitlabConnectionsIDs(); len(nodes) > 0 && !uuo.mutation.GitlabConnectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.GitlabConnectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GitlabConnectionsTable,
+			Columns: []string{user.GitlabConnectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gitlabconnection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
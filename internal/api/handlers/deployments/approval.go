@@ -0,0 +1,60 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type DeploymentApprovalInput struct {
+	server.BaseAuthInput
+	Body struct {
+		models.DeploymentApprovalInput
+	}
+}
+
+type DeploymentApprovalOutput struct {
+	Body struct {
+		Data *models.DeploymentResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) ApproveDeployment(ctx context.Context, input *DeploymentApprovalInput) (*DeploymentApprovalOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.ApproveDeployment(ctx, user.ID, &input.Body.DeploymentApprovalInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &DeploymentApprovalOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
+
+func (self *HandlerGroup) RejectDeployment(ctx context.Context, input *DeploymentApprovalInput) (*DeploymentApprovalOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.RejectDeployment(ctx, user.ID, &input.Body.DeploymentApprovalInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &DeploymentApprovalOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type CancelDeploymentInput struct {
+	server.BaseAuthInput
+	Body struct {
+		models.CancelDeploymentInput
+	}
+}
+
+type CancelDeploymentOutput struct {
+	Body struct {
+		Data *models.DeploymentResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) CancelDeployment(ctx context.Context, input *CancelDeploymentInput) (*CancelDeploymentOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.CancelDeployment(ctx, user.ID, &input.Body.CancelDeploymentInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &CancelDeploymentOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package deployments_handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/unbindapp/unbind-api/internal/api/oapi"
@@ -33,6 +34,22 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		Method:      http.MethodGet,
 	}, handlers.GetDeploymentByID)
 
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "diff-deployments",
+		Summary:     "Diff Deployments",
+		Description: "Compare two deployments of a service: image, variable names (never values), resources, hosts, ports, health check, run command, and the commits between them for GitHub services.",
+		Path:        "/diff",
+		Method:      http.MethodGet,
+	}, handlers.GetDeploymentDiff)
+
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "get-deployment-sbom",
+		Summary:     "Get Deployment SBOM",
+		Description: "List the packages in a deployment's image from its SBOM, or search the running deployments of every service you can view for a package, optionally at a version.",
+		Path:        "/sbom",
+		Method:      http.MethodGet,
+	}, handlers.GetDeploymentSBOM)
+
 	oapi.Register(grp, oapi.Invoke, huma.Operation{
 		OperationID: "trigger-deployment",
 		Summary:     "Trigger Deployment",
@@ -41,6 +58,16 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		Method:      http.MethodPost,
 	}, handlers.CreateDeployment, oapi.OpenWorld)
 
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID:     "upload-deployment",
+		Summary:         "Upload Deployment",
+		Description:     "Build and deploy a tar.gz of the source for an upload service, e.g. from a CLI. The source is analyzed for provider and ports like a new git service.",
+		Path:            "/upload",
+		Method:          http.MethodPost,
+		MaxBodyBytes:    maxSourceArchiveUploadBytes,
+		BodyReadTimeout: 5 * time.Minute,
+	}, handlers.UploadDeployment, oapi.OpenWorld)
+
 	oapi.Register(grp, oapi.Invoke, huma.Operation{
 		OperationID: "redeploy-deployment",
 		Summary:     "Redeploy Deployment",
@@ -48,4 +75,52 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		Path:        "/redeploy",
 		Method:      http.MethodPost,
 	}, handlers.CreateNewRedeployment, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "promote-deployment",
+		Summary:     "Promote Deployment",
+		Description: "Deploy a deployment's built image to the same-named service in another environment without rebuilding. The target keeps its own variables and configuration.",
+		Path:        "/promote",
+		Method:      http.MethodPost,
+	}, handlers.PromoteDeployment, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "cancel-deployment",
+		Summary:     "Cancel Deployment",
+		Description: "Cancel a deployment that is awaiting approval, pending, queued, or building. Removes it from the build queue or stops its running build.",
+		Path:        "/cancel",
+		Method:      http.MethodPost,
+	}, handlers.CancelDeployment, oapi.Confirm)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "approve-deployment",
+		Summary:     "Approve Deployment",
+		Description: "Approve a deployment to a protected environment that is awaiting approval, adding it to the build queue. Requires admin on the environment.",
+		Path:        "/approve",
+		Method:      http.MethodPost,
+	}, handlers.ApproveDeployment, oapi.Confirm)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "reject-deployment",
+		Summary:     "Reject Deployment",
+		Description: "Reject a deployment to a protected environment that is awaiting approval, cancelling it without building. Requires admin on the environment.",
+		Path:        "/reject",
+		Method:      http.MethodPost,
+	}, handlers.RejectDeployment, oapi.Confirm)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "promote-rollout",
+		Summary:     "Promote Rollout",
+		Description: "Promote a staged blue-green or canary deployment, sending all traffic to it and making it the service's current deployment.",
+		Path:        "/rollout/promote",
+		Method:      http.MethodPost,
+	}, handlers.PromoteRollout, oapi.Confirm)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "abort-rollout",
+		Summary:     "Abort Rollout",
+		Description: "Abort a staged blue-green or canary deployment, removing it while the current deployment keeps serving all traffic.",
+		Path:        "/rollout/abort",
+		Method:      http.MethodPost,
+	}, handlers.AbortRollout, oapi.Confirm)
 }
@@ -0,0 +1,44 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type GetDeploymentDiffInput struct {
+	server.BaseAuthInput
+	models.GetDeploymentDiffInput
+}
+
+type GetDeploymentDiffResponse struct {
+	Body struct {
+		Data *models.DeploymentDiffResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) GetDeploymentDiff(ctx context.Context, input *GetDeploymentDiffInput) (*GetDeploymentDiffResponse, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	diff, err := self.srv.DeploymentService.GetDeploymentDiff(ctx, user.ID, &input.GetDeploymentDiffInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	return &GetDeploymentDiffResponse{
+		Body: struct {
+			Data *models.DeploymentDiffResponse `json:"data"`
+		}{
+			Data: diff,
+		},
+	}, nil
+}
@@ -0,0 +1,42 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type PromoteDeploymentInput struct {
+	server.BaseAuthInput
+	Body struct {
+		models.PromoteDeploymentInput
+	}
+}
+
+type PromoteDeploymentOutput struct {
+	Body struct {
+		Data *models.DeploymentResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) PromoteDeployment(ctx context.Context, input *PromoteDeploymentInput) (*PromoteDeploymentOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.PromoteDeployment(ctx, user.ID, &input.Body.PromoteDeploymentInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &PromoteDeploymentOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
@@ -0,0 +1,60 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type DeploymentRolloutInput struct {
+	server.BaseAuthInput
+	Body struct {
+		models.DeploymentRolloutInput
+	}
+}
+
+type DeploymentRolloutOutput struct {
+	Body struct {
+		Data *models.DeploymentResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) PromoteRollout(ctx context.Context, input *DeploymentRolloutInput) (*DeploymentRolloutOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.PromoteRollout(ctx, user.ID, &input.Body.DeploymentRolloutInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &DeploymentRolloutOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
+
+func (self *HandlerGroup) AbortRollout(ctx context.Context, input *DeploymentRolloutInput) (*DeploymentRolloutOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, err := self.srv.DeploymentService.AbortRollout(ctx, user.ID, &input.Body.DeploymentRolloutInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &DeploymentRolloutOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
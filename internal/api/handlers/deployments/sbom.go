@@ -0,0 +1,40 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type GetDeploymentSBOMInput struct {
+	server.BaseAuthInput
+	models.GetDeploymentSBOMInput
+}
+
+type GetDeploymentSBOMResponse struct {
+	Body struct {
+		Data []*models.DeploymentSBOMResponse `json:"data" nullable:"false"`
+	}
+}
+
+func (self *HandlerGroup) GetDeploymentSBOM(ctx context.Context, input *GetDeploymentSBOMInput) (*GetDeploymentSBOMResponse, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	sboms, err := self.srv.DeploymentService.GetDeploymentSBOM(ctx, user.ID, &input.GetDeploymentSBOMInput)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &GetDeploymentSBOMResponse{}
+	resp.Body.Data = sboms
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package deployments_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+// Compressed size limit of uploaded source archives
+const maxSourceArchiveUploadBytes = 256 << 20
+
+type UploadDeploymentInput struct {
+	server.BaseAuthInput
+	models.UploadDeploymentInput
+	RawBody []byte `contentType:"application/gzip"`
+}
+
+type UploadDeploymentOutput struct {
+	Body struct {
+		Data             *models.DeploymentResponse `json:"data"`
+		SuggestedBuilder *schema.ServiceBuilder     `json:"suggested_builder,omitempty" doc:"Builder suggested for the source when the service uses another one, e.g. buildpacks for sources railpack doesn't recognize"`
+	}
+}
+
+func (self *HandlerGroup) UploadDeployment(ctx context.Context, input *UploadDeploymentInput) (*UploadDeploymentOutput, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployment, suggestedBuilder, err := self.srv.DeploymentService.CreateUploadDeployment(ctx, user.ID, &input.UploadDeploymentInput, input.RawBody)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &UploadDeploymentOutput{}
+	resp.Body.Data = deployment
+	resp.Body.SuggestedBuilder = suggestedBuilder
+	return resp, nil
+}
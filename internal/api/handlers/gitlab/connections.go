@@ -0,0 +1,254 @@
+package gitlab_handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
+	gitlab_repo "github.com/unbindapp/unbind-api/internal/repositories/gitlab"
+)
+
+// How long a user has to authorize the OAuth application
+const oauthStateExpiration = 30 * time.Minute
+
+// POST create a connection from an access token
+type CreateGitlabTokenConnectionInput struct {
+	server.BaseAuthInput
+	Body struct {
+		Name        string `json:"name" required:"true" minLength:"1"`
+		URL         string `json:"url" required:"false" default:"https://gitlab.com" doc:"URL of the GitLab instance"`
+		AccessToken string `json:"access_token" required:"true" minLength:"1"`
+	}
+}
+
+type GitlabConnectionResponse struct {
+	Body struct {
+		Data *GitlabConnectionAPIResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) HandleCreateGitlabTokenConnection(ctx context.Context, input *CreateGitlabTokenConnectionInput) (*GitlabConnectionResponse, error) {
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	instanceURL, err := normalizeInstanceURL(input.Body.URL)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid GitLab URL")
+	}
+
+	// Check the token before saving it
+	gitlabUser, err := self.srv.GitlabClient.GetCurrentUser(ctx, instanceURL, input.Body.AccessToken)
+	if err != nil {
+		log.Warn("Error validating gitlab token", "err", err, "url", instanceURL)
+		return nil, huma.Error400BadRequest("Unable to authenticate with GitLab using the token")
+	}
+
+	webhookSecret, err := utils.GenerateSecurePassword(32, true)
+	if err != nil {
+		log.Error("Error generating webhook secret", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to generate webhook secret")
+	}
+
+	connection, err := self.srv.Repository.Gitlab().CreateConnection(ctx, &gitlab_repo.CreateConnectionInput{
+		CreatedBy:       user.ID,
+		Name:            input.Body.Name,
+		URL:             instanceURL,
+		AuthType:        gitlabconnection.AuthTypeToken,
+		AccountID:       gitlabUser.ID,
+		AccountUsername: gitlabUser.Username,
+		AccessToken:     input.Body.AccessToken,
+		WebhookSecret:   webhookSecret,
+	})
+	if err != nil {
+		log.Error("Error creating gitlab connection", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to create gitlab connection")
+	}
+
+	resp := &GitlabConnectionResponse{}
+	resp.Body.Data = transformGitlabConnectionEntity(connection)
+	return resp, nil
+}
+
+// POST start connecting with an OAuth application
+type StartGitlabOAuthConnectionInput struct {
+	server.BaseAuthInput
+	Body struct {
+		Name         string `json:"name" required:"true" minLength:"1"`
+		URL          string `json:"url" required:"false" default:"https://gitlab.com" doc:"URL of the GitLab instance"`
+		ClientID     string `json:"client_id" required:"true" minLength:"1" doc:"Application ID of the GitLab OAuth application"`
+		ClientSecret string `json:"client_secret" required:"true" minLength:"1"`
+		RedirectURL  string `json:"redirect_url" required:"true" doc:"The client URL to redirect to after the connection is created"`
+	}
+}
+
+type StartGitlabOAuthConnectionResponse struct {
+	Body struct {
+		Data string `json:"data" doc:"The URL to send the user to, to authorize the application"`
+	}
+}
+
+func (self *HandlerGroup) HandleStartGitlabOAuthConnection(ctx context.Context, input *StartGitlabOAuthConnectionInput) (*StartGitlabOAuthConnectionResponse, error) {
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	instanceURL, err := normalizeInstanceURL(input.Body.URL)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid GitLab URL")
+	}
+	if _, err := url.ParseRequestURI(input.Body.RedirectURL); err != nil {
+		return nil, huma.Error400BadRequest("Invalid redirect URL")
+	}
+
+	state := uuid.New().String()
+	encoded, err := json.Marshal(&gitlab.OAuthState{
+		UserID:       user.ID,
+		Name:         input.Body.Name,
+		URL:          instanceURL,
+		ClientID:     input.Body.ClientID,
+		ClientSecret: input.Body.ClientSecret,
+		RedirectURL:  input.Body.RedirectURL,
+	})
+	if err != nil {
+		log.Error("Error marshaling oauth state", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to prepare oauth state")
+	}
+
+	if err := self.srv.StringCache.SetWithExpiration(ctx, gitlab.OAuthStateCacheKey(state), string(encoded), oauthStateExpiration); err != nil {
+		log.Error("Error setting state in cache", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to set state in cache")
+	}
+
+	resp := &StartGitlabOAuthConnectionResponse{}
+	resp.Body.Data = self.srv.GitlabClient.GetOAuthAuthorizeURL(instanceURL, input.Body.ClientID, state)
+	return resp, nil
+}
+
+// GET connections
+type GitlabConnectionListResponse struct {
+	Body struct {
+		Data []*GitlabConnectionAPIResponse `json:"data" nullable:"false"`
+	}
+}
+
+func (self *HandlerGroup) HandleListGitlabConnections(ctx context.Context, input *server.BaseAuthInput) (*GitlabConnectionListResponse, error) {
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	// ! TODO - RBAC
+	connections, err := self.srv.Repository.Gitlab().GetConnectionsByCreator(ctx, user.ID)
+	if err != nil {
+		log.Error("Error getting gitlab connections", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get gitlab connections")
+	}
+
+	resp := &GitlabConnectionListResponse{}
+	resp.Body.Data = transformGitlabConnectionEntities(connections)
+	return resp, nil
+}
+
+// DELETE connection
+type DeleteGitlabConnectionInput struct {
+	server.BaseAuthInput
+	Body struct {
+		ID uuid.UUID `json:"id" required:"true" format:"uuid"`
+	}
+}
+
+type DeleteGitlabConnectionResponse struct {
+	Body struct {
+		Data server.DeletedResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) HandleDeleteGitlabConnection(ctx context.Context, input *DeleteGitlabConnectionInput) (*DeleteGitlabConnectionResponse, error) {
+	connection, err := self.getUserConnection(ctx, input.Body.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := self.srv.Repository.Gitlab().DeleteConnection(ctx, connection.ID); err != nil {
+		log.Error("Error deleting gitlab connection", "err", err, "connectionID", connection.ID)
+		return nil, huma.Error500InternalServerError("Failed to delete gitlab connection")
+	}
+
+	resp := &DeleteGitlabConnectionResponse{}
+	resp.Body.Data = server.DeletedResponse{
+		ID:      connection.ID.String(),
+		Deleted: true,
+	}
+	return resp, nil
+}
+
+// normalizeInstanceURL checks a GitLab instance URL and strips its trailing slash
+func normalizeInstanceURL(instanceURL string) (string, error) {
+	parsed, err := url.ParseRequestURI(instanceURL)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Host == "" {
+		return "", errors.New("url must be an http or https url")
+	}
+	return strings.TrimSuffix(parsed.String(), "/"), nil
+}
+
+func transformGitlabConnectionEntity(entity *ent.GitlabConnection) *GitlabConnectionAPIResponse {
+	return &GitlabConnectionAPIResponse{
+		ID:              entity.ID,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+		CreatedBy:       entity.CreatedBy,
+		Name:            entity.Name,
+		URL:             entity.URL,
+		AuthType:        entity.AuthType,
+		AccountID:       entity.AccountID,
+		AccountUsername: entity.AccountUsername,
+		TokenExpiresAt:  entity.TokenExpiresAt,
+	}
+}
+
+func transformGitlabConnectionEntities(entities []*ent.GitlabConnection) []*GitlabConnectionAPIResponse {
+	result := make([]*GitlabConnectionAPIResponse, len(entities))
+	for i, entity := range entities {
+		result[i] = transformGitlabConnectionEntity(entity)
+	}
+	return result
+}
+
+type GitlabConnectionAPIResponse struct {
+	ID uuid.UUID `json:"id"`
+	// The time at which the entity was created.
+	CreatedAt time.Time `json:"created_at"`
+	// The time at which the entity was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+	// The user that created this connection.
+	CreatedBy uuid.UUID `json:"created_by"`
+	Name      string    `json:"name"`
+	// URL of the GitLab instance
+	URL      string                    `json:"url"`
+	AuthType gitlabconnection.AuthType `json:"auth_type"`
+	// The GitLab user the token belongs to
+	AccountID       int64  `json:"account_id"`
+	AccountUsername string `json:"account_username"`
+	// When the OAuth token expires, it's refreshed automatically
+	TokenExpiresAt *time.Time `json:"token_expires_at,omitempty"`
+}
@@ -0,0 +1,94 @@
+package gitlab_handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+)
+
+type HandlerGroup struct {
+	srv *server.Server
+}
+
+func RegisterHandlers(server *server.Server, grp *huma.Group) {
+	handlers := &HandlerGroup{
+		srv: server,
+	}
+
+	oapi.Register(grp, oapi.Create, huma.Operation{
+		OperationID: "create-gitlab-token-connection",
+		Summary:     "Connect With Token",
+		Description: "Connect a GitLab instance with a personal, group or project access token. The token needs the api scope.",
+		Path:        "/connections/token",
+		Method:      http.MethodPost,
+	}, handlers.HandleCreateGitlabTokenConnection, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "start-gitlab-oauth-connection",
+		Summary:     "Connect With OAuth",
+		Description: "Begin connecting a GitLab instance with an OAuth application, returning the URL to authorize it at.",
+		Path:        "/connections/oauth",
+		Method:      http.MethodPost,
+	}, handlers.HandleStartGitlabOAuthConnection)
+
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "list-gitlab-connections",
+		Summary:     "List Connections",
+		Description: "List the GitLab connections created by the user.",
+		Path:        "/connections",
+		Method:      http.MethodGet,
+	}, handlers.HandleListGitlabConnections)
+
+	oapi.Register(grp, oapi.Delete, huma.Operation{
+		OperationID: "delete-gitlab-connection",
+		Summary:     "Delete Connection",
+		Description: "Delete a GitLab connection. Services using it stop auto-deploying until they're reconnected.",
+		Path:        "/connections/delete",
+		Method:      http.MethodDelete,
+	}, handlers.HandleDeleteGitlabConnection)
+
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "list-gitlab-projects",
+		Summary:     "List Projects",
+		Description: "List projects the user maintains across their GitLab connections.",
+		Path:        "/projects",
+		Method:      http.MethodGet,
+	}, handlers.HandleListGitlabProjects, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "gitlab-project-detail",
+		Summary:     "Project Detail",
+		Description: "Get a GitLab project's branches and tags.",
+		Path:        "/projects/info",
+		Method:      http.MethodGet,
+	}, handlers.HandleGetGitlabProjectDetail, oapi.OpenWorld)
+}
+
+// getUserConnection gets a connection the user created
+func (self *HandlerGroup) getUserConnection(ctx context.Context, connectionID uuid.UUID) (*ent.GitlabConnection, error) {
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	// ! TODO - RBAC
+	connection, err := self.srv.Repository.Gitlab().GetConnectionByID(ctx, connectionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, huma.Error404NotFound("GitLab connection not found")
+		}
+		log.Error("Error getting gitlab connection", "err", err, "connectionID", connectionID)
+		return nil, huma.Error500InternalServerError("Failed to get gitlab connection")
+	}
+	if connection.CreatedBy != user.ID {
+		return nil, huma.Error404NotFound("GitLab connection not found")
+	}
+	return connection, nil
+}
@@ -0,0 +1,84 @@
+package gitlab_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
+)
+
+// GET projects across the user's connections
+type ListGitlabProjectsInput struct {
+	server.BaseAuthInput
+	Search string `query:"search" doc:"Filter projects by name"`
+}
+
+type GitlabProjectListResponse struct {
+	Body struct {
+		Data []*gitlab.GitlabProject `json:"data" nullable:"false"`
+	}
+}
+
+func (self *HandlerGroup) HandleListGitlabProjects(ctx context.Context, input *ListGitlabProjectsInput) (*GitlabProjectListResponse, error) {
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	// ! TODO - RBAC
+	connections, err := self.srv.Repository.Gitlab().GetConnectionsByCreator(ctx, user.ID)
+	if err != nil {
+		log.Error("Error getting gitlab connections", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get gitlab connections")
+	}
+
+	resp := &GitlabProjectListResponse{}
+	resp.Body.Data = []*gitlab.GitlabProject{}
+	for _, connection := range connections {
+		projects, err := self.srv.GitlabClient.ListProjects(ctx, connection, input.Search)
+		if err != nil {
+			log.Error("Error listing gitlab projects", "err", err, "connectionID", connection.ID)
+			return nil, huma.Error500InternalServerError("Failed to list gitlab projects")
+		}
+		resp.Body.Data = append(resp.Body.Data, projects...)
+	}
+	return resp, nil
+}
+
+// GET project details (branches, tags)
+type GitlabProjectDetailInput struct {
+	server.BaseAuthInput
+	ConnectionID uuid.UUID `query:"connection_id" required:"true" format:"uuid"`
+	Namespace    string    `query:"namespace" required:"true" doc:"Full path of the project's group or user namespace"`
+	Path         string    `query:"path" required:"true" doc:"Path of the project within the namespace"`
+}
+
+type GitlabProjectDetailResponse struct {
+	Body struct {
+		Data *gitlab.GitlabProjectDetail `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) HandleGetGitlabProjectDetail(ctx context.Context, input *GitlabProjectDetailInput) (*GitlabProjectDetailResponse, error) {
+	connection, err := self.getUserConnection(ctx, input.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := self.srv.GitlabClient.GetProjectDetail(ctx, connection, input.Namespace, input.Path)
+	if err != nil {
+		if gitlab.IsNotFound(err) {
+			return nil, huma.Error404NotFound("GitLab project not found")
+		}
+		log.Error("Error getting gitlab project detail", "err", err, "namespace", input.Namespace, "path", input.Path)
+		return nil, huma.Error500InternalServerError("Failed to get project details")
+	}
+
+	resp := &GitlabProjectDetailResponse{}
+	resp.Body.Data = detail
+	return resp, nil
+}
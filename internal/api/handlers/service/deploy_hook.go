@@ -0,0 +1,74 @@
+package service_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/api/server"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+type DeployHookInput struct {
+	server.BaseAuthInput
+	Body struct {
+		TeamID        uuid.UUID `json:"team_id" required:"true"`
+		ProjectID     uuid.UUID `json:"project_id" required:"true"`
+		EnvironmentID uuid.UUID `json:"environment_id" required:"true"`
+		ServiceID     uuid.UUID `json:"service_id" required:"true"`
+	}
+}
+
+type RotateDeployHookResponse struct {
+	Body struct {
+		Data *models.DeployHookResponse `json:"data"`
+	}
+}
+
+// RotateDeployHook generates a new deploy hook URL for the service, replacing the previous one
+func (self *HandlerGroup) RotateDeployHook(ctx context.Context, input *DeployHookInput) (*RotateDeployHookResponse, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	deployHook, err := self.srv.ServiceService.RotateDeployHook(ctx, user.ID, input.Body.TeamID, input.Body.ProjectID, input.Body.EnvironmentID, input.Body.ServiceID)
+	if err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &RotateDeployHookResponse{}
+	resp.Body.Data = deployHook
+	return resp, nil
+}
+
+type RevokeDeployHookResponse struct {
+	Body struct {
+		Data server.DeletedResponse `json:"data"`
+	}
+}
+
+// RevokeDeployHook removes the service's deploy hook URL
+func (self *HandlerGroup) RevokeDeployHook(ctx context.Context, input *DeployHookInput) (*RevokeDeployHookResponse, error) {
+	// Get caller
+	user, found := self.srv.GetUserFromContext(ctx)
+	if !found {
+		log.Error("Error getting user from context")
+		return nil, huma.Error401Unauthorized("Unable to retrieve user")
+	}
+
+	if err := self.srv.ServiceService.RevokeDeployHook(ctx, user.ID, input.Body.TeamID, input.Body.ProjectID, input.Body.EnvironmentID, input.Body.ServiceID); err != nil {
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &RevokeDeployHookResponse{}
+	resp.Body.Data = server.DeletedResponse{
+		ID:      input.Body.ServiceID.String(),
+		Deleted: true,
+	}
+	return resp, nil
+}
@@ -36,7 +36,7 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 	oapi.Register(grp, oapi.Create, huma.Operation{
 		OperationID: "create-service",
 		Summary:     "Create Service",
-		Description: "Create a service from a git repo, container image, or database. Does not deploy it; trigger a deployment separately.",
+		Description: "Create a service from a git repo, container image, database, or uploaded source archives. Does not deploy it; trigger a deployment separately.",
 		Path:        "/create",
 		Method:      http.MethodPost,
 	}, handlers.CreateService, oapi.OpenWorld)
@@ -80,4 +80,20 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		Path:        "/endpoints/list",
 		Method:      http.MethodGet,
 	}, handlers.ListEndpoints)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "rotate-service-deploy-hook",
+		Summary:     "Rotate Service Deploy Hook",
+		Description: "Generate a new deploy hook URL for the service, e.g. for external CI. The previous URL stops working.",
+		Path:        "/deploy-hook/rotate",
+		Method:      http.MethodPost,
+	}, handlers.RotateDeployHook, oapi.Confirm)
+
+	oapi.Register(grp, oapi.Delete, huma.Operation{
+		OperationID: "revoke-service-deploy-hook",
+		Summary:     "Revoke Service Deploy Hook",
+		Description: "Remove the service's deploy hook URL, requests to it are rejected afterwards.",
+		Path:        "/deploy-hook/revoke",
+		Method:      http.MethodDelete,
+	}, handlers.RevokeDeployHook)
 }
@@ -0,0 +1,33 @@
+package webhook_handler
+
+import (
+	"context"
+
+	"github.com/unbindapp/unbind-api/internal/api/oapi"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+// HandleDeployHook deploys a service from its deploy hook URL, e.g. from external CI
+type DeployHookInput struct {
+	Token string                  `path:"token"`
+	Body  *models.DeployHookInput `required:"false"`
+}
+
+type DeployHookOutput struct {
+	Body struct {
+		Data *models.DeploymentResponse `json:"data"`
+	}
+}
+
+func (self *HandlerGroup) HandleDeployHook(ctx context.Context, input *DeployHookInput) (*DeployHookOutput, error) {
+	deployment, err := self.srv.DeploymentService.CreateDeployHookDeployment(ctx, input.Token, input.Body)
+	if err != nil {
+		log.Error("Error creating deploy hook deployment", "err", err)
+		return nil, oapi.MapError(err)
+	}
+
+	resp := &DeployHookOutput{}
+	resp.Body.Data = deployment
+	return resp, nil
+}
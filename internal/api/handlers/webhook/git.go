@@ -0,0 +1,82 @@
+package webhook_handler
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
+)
+
+type GitWebhookInput struct {
+	RawBody   []byte
+	ServiceID string `path:"service_id"`
+	Signature string `path:"signature"`
+}
+
+type GitWebhookOutput struct {
+}
+
+// HandleGitWebhook handles pushes to generic git repositories, on the service's signed webhook URL
+func (self *HandlerGroup) HandleGitWebhook(ctx context.Context, input *GitWebhookInput) (*GitWebhookOutput, error) {
+	// Unknown services and bad signatures get the same answer
+	serviceID, err := uuid.Parse(input.ServiceID)
+	if err != nil {
+		return nil, huma.Error400BadRequest("Invalid signature")
+	}
+
+	service, err := self.srv.Repository.Service().GetByID(ctx, serviceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, huma.Error400BadRequest("Invalid signature")
+		}
+		log.Error("Error getting service", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get service")
+	}
+
+	if service.Type != schema.ServiceTypeGit || service.GitWebhookSecret == nil || !gitssh.ValidateWebhookSignature(*service.GitWebhookSecret, service.ID.String(), input.Signature) {
+		log.Error("Received git webhook with invalid signature", "serviceID", input.ServiceID)
+		return nil, huma.Error400BadRequest("Invalid signature")
+	}
+
+	event, err := gitssh.ParsePushEvent(input.RawBody)
+	if err != nil {
+		log.Errorf("Could not parse git webhook: %v", err)
+		return nil, huma.Error400BadRequest("Failed to parse git webhook")
+	}
+
+	// Hosts that don't send a payload, or a plain curl, deploy the service's branch
+	ref := event.Ref
+	if ref == "" {
+		if service.Edges.ServiceConfig.GitBranch == nil {
+			return &GitWebhookOutput{}, nil
+		}
+		ref = "refs/heads/" + *service.Edges.ServiceConfig.GitBranch
+	}
+
+	var committer *schema.GitCommitter
+	authors := event.Authors
+	if event.Committer != "" {
+		committer = &schema.GitCommitter{
+			Name: event.Committer,
+		}
+		authors = append(authors, event.Committer)
+	}
+
+	if err := self.deployPush(ctx, []*ent.Service{service}, &gitPush{
+		Repository:    *service.GitURL,
+		Ref:           ref,
+		CommitSHA:     event.CommitSHA,
+		CommitMessage: event.CommitMessage,
+		Committer:     committer,
+		Authors:       authors,
+		ChangedFiles:  event.ChangedFiles,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &GitWebhookOutput{}, nil
+}
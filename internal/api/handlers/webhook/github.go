@@ -17,8 +17,7 @@ import (
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/log"
-	"github.com/unbindapp/unbind-api/internal/common/utils"
-	"github.com/unbindapp/unbind-api/internal/deployctl"
+	environment_service "github.com/unbindapp/unbind-api/internal/services/environment"
 )
 
 // Connect the new github app to our instance, via manifest code exchange
@@ -247,6 +246,59 @@ func (self *HandlerGroup) HandleGithubWebhook(ctx context.Context, input *Github
 				return nil, huma.Error500InternalServerError("Failed to set installation as unsuspended")
 			}
 		}
+	case *github.PullRequestEvent:
+		if e.Repo == nil || e.Installation == nil || e.PullRequest == nil {
+			log.Errorf("Received pull request event with missing repo, installation or pull request %v", e)
+			return &GithubWebhookOutput{}, nil
+		}
+
+		pr := e.GetPullRequest()
+		repoName := e.Repo.GetName()
+
+		// Previews get the variables services allow for them, never run code from forks with them
+		if pr.GetHead().GetRepo().GetFullName() != e.Repo.GetFullName() {
+			log.Info("Skipping preview of pull request from a fork", "repo", repoName, "number", pr.GetNumber())
+			return &GithubWebhookOutput{}, nil
+		}
+
+		installation, err := self.srv.Repository.Github().GetInstallationByID(ctx, e.Installation.GetID())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				log.Info("Received event for installation not found in DB", "id", e.Installation.GetID())
+				return &GithubWebhookOutput{}, nil
+			}
+			log.Error("Error getting installation", "err", err)
+			return nil, huma.Error500InternalServerError("Failed to get installation")
+		}
+
+		switch e.GetAction() {
+		case "opened", "reopened", "synchronize":
+			var committer *schema.GitCommitter
+			if sender := e.GetSender(); sender != nil {
+				committer = &schema.GitCommitter{
+					Name:      sender.GetLogin(),
+					AvatarURL: sender.GetAvatarURL(),
+				}
+			}
+
+			if err := self.srv.EnvironmentService.DeployPreview(ctx, &environment_service.PullRequestPreview{
+				Installation: installation,
+				Repository:   repoName,
+				Number:       pr.GetNumber(),
+				Title:        pr.GetTitle(),
+				BaseBranch:   pr.GetBase().GetRef(),
+				HeadSHA:      pr.GetHead().GetSHA(),
+				Committer:    committer,
+			}); err != nil {
+				log.Error("Error deploying pull request preview", "err", err, "repo", repoName, "number", pr.GetNumber())
+				return nil, huma.Error500InternalServerError("Failed to deploy pull request preview")
+			}
+		case "closed":
+			if err := self.srv.EnvironmentService.DeletePreview(ctx, installation, repoName, pr.GetNumber()); err != nil {
+				log.Error("Error deleting pull request preview", "err", err, "repo", repoName, "number", pr.GetNumber())
+				return nil, huma.Error500InternalServerError("Failed to delete pull request preview")
+			}
+		}
 	case *github.PushEvent:
 		// Trigger a build if the push event is for a branch we care about
 		if e.Repo == nil || e.Installation == nil {
@@ -274,7 +326,6 @@ func (self *HandlerGroup) HandleGithubWebhook(ctx context.Context, input *Github
 		}
 
 		repoName := e.Repo.GetName()
-		repoUrl := e.Repo.GetCloneURL()
 		installationID := e.Installation.GetID()
 		ref := e.GetRef()
 
@@ -296,82 +347,18 @@ func (self *HandlerGroup) HandleGithubWebhook(ctx context.Context, input *Github
 			return nil, huma.Error500InternalServerError("Failed to get services")
 		}
 
-		servicesToBuild := make([]*ent.Service, 0)
-		for _, service := range services {
-			config := service.Edges.ServiceConfig
-
-			// Handle tag pushes
-			if strings.HasPrefix(ref, "refs/tags/") {
-				if config.GitTag == nil {
-					continue
-				}
-				tagName := strings.TrimPrefix(ref, "refs/tags/")
-				matched := utils.MatchesGlobPattern(tagName, *config.GitTag)
-				if matched {
-					servicesToBuild = append(servicesToBuild, service)
-				}
-				continue
-			}
-
-			// Handle branch pushes
-			var refToBuild *string
-			if config.GitBranch != nil {
-				if !strings.Contains(*config.GitBranch, "refs/heads/") {
-					refToBuild = utils.ToPtr("refs/heads/" + *config.GitBranch)
-				}
-			}
-
-			if refToBuild != nil && *refToBuild == ref {
-				servicesToBuild = append(servicesToBuild, service)
-			}
-		}
-
-		if len(servicesToBuild) == 0 {
-			// Nothing to do
-			return &GithubWebhookOutput{}, nil
-		}
-
-		// Get the tag name if this is a tag push
-		var tagName *string
-		if strings.HasPrefix(ref, "refs/tags/") {
-			tag := strings.TrimPrefix(ref, "refs/tags/")
-			tagName = &tag
-		}
-
-		// Trigger builds for each service
-		for _, service := range servicesToBuild {
-			if !service.Edges.ServiceConfig.AutoDeploy {
-				// Skip services that don't have auto-deploy enabled
-				continue
-			}
-
-			env, err := self.srv.DeploymentController.PopulateBuildEnvironment(ctx, service.ID, tagName, nil)
-			if err != nil {
-				log.Error("Error populating build environment", "err", err)
-				return nil, huma.Error500InternalServerError("Failed to populate build environment")
-			}
-
-			log.Info("Enqueuing build", "repo", repoName, "branch", ref, "serviceID", service.ID, "installationID", installationID, "appID", installation.GithubAppID, "repoUrl", repoUrl)
-			req := deployctl.DeploymentJobRequest{
-				ServiceID:     service.ID,
-				Environment:   env,
-				Source:        schema.DeploymentSourceGit,
-				CommitSHA:     commitSHA,
-				CommitMessage: commitMessage,
-				GitBranch:     ref,
-				Committer:     committer,
-			}
-			jobID, err := self.srv.DeploymentController.EnqueueDeploymentJob(
-				ctx,
-				req,
-			)
-
-			if err != nil {
-				log.Error("Error enqueuing build job", "err", err)
-				return nil, huma.Error500InternalServerError("Failed to enqueue build job")
-			}
-
-			log.Info("Enqueued build job", "jobID", jobID)
+		if err := self.deployPush(ctx, services, &gitPush{
+			Repository:    repoName,
+			Ref:           ref,
+			CommitSHA:     commitSHA,
+			CommitMessage: commitMessage,
+			Committer:     committer,
+			Authors:       pushAuthors(e),
+			ChangedFiles: func() ([]string, bool) {
+				return self.getPushChangedFiles(ctx, installation, e)
+			},
+		}); err != nil {
+			return nil, err
 		}
 	}
 
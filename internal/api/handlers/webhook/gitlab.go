@@ -0,0 +1,180 @@
+package webhook_handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
+	gitlab_repo "github.com/unbindapp/unbind-api/internal/repositories/gitlab"
+)
+
+// Finish connecting a GitLab OAuth application
+type HandleGitlabOAuthCallbackInput struct {
+	Code  string `query:"code" required:"true"`
+	State string `query:"state" required:"true"`
+}
+
+type HandleGitlabOAuthCallbackResponse struct {
+	Status int
+	Url    string `header:"Location"`
+}
+
+// Exchange the code, save the connection and redirect back to the client
+func (self *HandlerGroup) HandleGitlabOAuthCallback(ctx context.Context, input *HandleGitlabOAuthCallbackInput) (*HandleGitlabOAuthCallbackResponse, error) {
+	// Verify state
+	encoded, err := self.srv.StringCache.Getdel(ctx, gitlab.OAuthStateCacheKey(input.State))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, huma.Error400BadRequest("Invalid state")
+		}
+		log.Error("Error getting state from cache", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get state")
+	}
+
+	state := &gitlab.OAuthState{}
+	if err := json.Unmarshal([]byte(encoded), state); err != nil {
+		log.Error("Error parsing oauth state", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to parse state")
+	}
+
+	token, err := self.srv.GitlabClient.ExchangeOAuthCode(ctx, state.URL, state.ClientID, state.ClientSecret, input.Code)
+	if err != nil {
+		log.Error("Error exchanging gitlab oauth code", "err", err, "url", state.URL)
+		return nil, huma.Error400BadRequest("Failed to exchange code with GitLab")
+	}
+
+	gitlabUser, err := self.srv.GitlabClient.GetCurrentUser(ctx, state.URL, token.AccessToken)
+	if err != nil {
+		log.Error("Error getting gitlab user", "err", err, "url", state.URL)
+		return nil, huma.Error500InternalServerError("Failed to get GitLab user")
+	}
+
+	webhookSecret, err := utils.GenerateSecurePassword(32, true)
+	if err != nil {
+		log.Error("Error generating webhook secret", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to generate webhook secret")
+	}
+
+	connection, err := self.srv.Repository.Gitlab().CreateConnection(ctx, &gitlab_repo.CreateConnectionInput{
+		CreatedBy:       state.UserID,
+		Name:            state.Name,
+		URL:             state.URL,
+		AuthType:        gitlabconnection.AuthTypeOauth,
+		AccountID:       gitlabUser.ID,
+		AccountUsername: gitlabUser.Username,
+		AccessToken:     token.AccessToken,
+		RefreshToken:    utils.ToPtr(token.RefreshToken),
+		TokenExpiresAt:  token.ExpiresAt(),
+		ClientID:        utils.ToPtr(state.ClientID),
+		ClientSecret:    utils.ToPtr(state.ClientSecret),
+		WebhookSecret:   webhookSecret,
+	})
+	if err != nil {
+		log.Error("Error saving gitlab connection", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to save gitlab connection")
+	}
+
+	// Attach the connection as ?id to the client's redirect URL
+	redirectURL, err := url.Parse(state.RedirectURL)
+	if err != nil {
+		log.Error("Error parsing redirect URL", "err", err)
+		return nil, huma.Error500InternalServerError("Invalid redirect URL")
+	}
+	q := redirectURL.Query()
+	q.Set("id", connection.ID.String())
+	redirectURL.RawQuery = q.Encode()
+
+	return &HandleGitlabOAuthCallbackResponse{
+		Status: http.StatusTemporaryRedirect,
+		Url:    redirectURL.String(),
+	}, nil
+}
+
+// HandleGitlabWebhook handles incoming GitLab webhooks.
+type GitlabWebhookInput struct {
+	RawBody   []byte
+	Token     string `header:"X-Gitlab-Token"`
+	EventType string `header:"X-Gitlab-Event"`
+}
+
+type GitlabWebhookOutput struct {
+}
+
+func (self *HandlerGroup) HandleGitlabWebhook(ctx context.Context, input *GitlabWebhookInput) (*GitlabWebhookOutput, error) {
+	// Every connection registers hooks with its own secret, find the one this came from
+	connections, err := self.srv.Repository.Gitlab().GetConnections(ctx)
+	if err != nil {
+		log.Error("Error getting gitlab connections", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get gitlab connections")
+	}
+	var connection *ent.GitlabConnection
+	for _, c := range connections {
+		if gitlab.ValidateWebhookToken(input.Token, c.WebhookSecret) {
+			connection = c
+			break
+		}
+	}
+
+	if connection == nil {
+		log.Error("Received gitlab webhook with invalid token")
+		return nil, huma.Error400BadRequest("Invalid token")
+	}
+
+	if input.EventType != gitlab.EventPush && input.EventType != gitlab.EventTagPush {
+		// Nothing to do
+		return &GitlabWebhookOutput{}, nil
+	}
+
+	event, err := gitlab.ParsePushEvent(input.EventType, input.RawBody)
+	if err != nil {
+		log.Errorf("Could not parse gitlab webhook: %v", err)
+		return nil, huma.Error400BadRequest("Failed to parse gitlab webhook")
+	}
+
+	// Deleted branches and tags have no commit to deploy
+	if event.CheckoutSHA == "" {
+		return &GitlabWebhookOutput{}, nil
+	}
+
+	committer := &schema.GitCommitter{
+		Name:      event.UserUsername,
+		AvatarURL: event.UserAvatar,
+	}
+	authors := []string{event.UserUsername, event.UserName}
+	commitMessage := ""
+	if headCommit := event.HeadCommit(); headCommit != nil {
+		commitMessage = headCommit.Message
+		authors = append(authors, headCommit.Author.Name)
+	}
+
+	namespace, repoName := event.ProjectNamespaceAndPath()
+	services, err := self.srv.Repository.Service().GetByGitlabConnectionAndRepo(ctx, connection.ID, namespace, repoName)
+	if err != nil {
+		log.Error("Error getting services", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to get services")
+	}
+
+	if err := self.deployPush(ctx, services, &gitPush{
+		Repository:    event.Project.PathWithNamespace,
+		Ref:           event.Ref,
+		CommitSHA:     event.CheckoutSHA,
+		CommitMessage: commitMessage,
+		Committer:     committer,
+		Authors:       authors,
+		ChangedFiles:  event.ChangedFiles,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &GitlabWebhookOutput{}, nil
+}
@@ -0,0 +1,145 @@
+package webhook_handler
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+)
+
+// gitPush is a branch or tag push, from any git provider
+type gitPush struct {
+	Repository    string
+	Ref           string
+	CommitSHA     string
+	CommitMessage string
+	Committer     *schema.GitCommitter
+	// Names ignored commit author patterns are matched against
+	Authors []string
+	// ChangedFiles lists the files changed by the push, ok is false if they can't be determined
+	ChangedFiles func() (files []string, ok bool)
+}
+
+// deployPush enqueues a deployment of every auto-deployed service whose branch or tag matches the push
+func (self *HandlerGroup) deployPush(ctx context.Context, services []*ent.Service, push *gitPush) error {
+	ref := push.Ref
+	servicesToBuild := make([]*ent.Service, 0)
+	for _, service := range services {
+		config := service.Edges.ServiceConfig
+
+		// Handle tag pushes
+		if strings.HasPrefix(ref, "refs/tags/") {
+			if config.GitTag == nil {
+				continue
+			}
+			tagName := strings.TrimPrefix(ref, "refs/tags/")
+			matched := utils.MatchesGlobPattern(tagName, *config.GitTag)
+			if matched {
+				servicesToBuild = append(servicesToBuild, service)
+			}
+			continue
+		}
+
+		// Handle branch pushes
+		var refToBuild *string
+		if config.GitBranch != nil {
+			if !strings.Contains(*config.GitBranch, "refs/heads/") {
+				refToBuild = utils.ToPtr("refs/heads/" + *config.GitBranch)
+			}
+		}
+
+		if refToBuild != nil && *refToBuild == ref {
+			servicesToBuild = append(servicesToBuild, service)
+		}
+	}
+
+	// Monorepos, only deploy services whose watch paths match a changed file
+	if !strings.HasPrefix(ref, "refs/tags/") && slices.ContainsFunc(servicesToBuild, func(service *ent.Service) bool {
+		return len(service.Edges.ServiceConfig.WatchPaths) > 0
+	}) {
+		if changedFiles, ok := push.ChangedFiles(); ok {
+			servicesToBuild = slices.DeleteFunc(servicesToBuild, func(service *ent.Service) bool {
+				watchPaths := service.Edges.ServiceConfig.WatchPaths
+				if len(watchPaths) == 0 || matchesWatchPaths(changedFiles, watchPaths) {
+					return false
+				}
+				log.Info("Skipping build, no changed files match watch paths", "serviceID", service.ID, "repo", push.Repository, "ref", ref)
+				return true
+			})
+		}
+	}
+
+	if len(servicesToBuild) == 0 {
+		// Nothing to do
+		return nil
+	}
+
+	// Get the tag name if this is a tag push
+	var tagName *string
+	if strings.HasPrefix(ref, "refs/tags/") {
+		tag := strings.TrimPrefix(ref, "refs/tags/")
+		tagName = &tag
+	}
+
+	// Trigger builds for each service
+	for _, service := range servicesToBuild {
+		if !service.Edges.ServiceConfig.AutoDeploy {
+			// Skip services that don't have auto-deploy enabled
+			continue
+		}
+
+		// Record skipped pushes so the deployment history shows why nothing was built
+		if reason := skipDeployReason(service.Edges.ServiceConfig, push.CommitMessage, push.Authors); reason != "" {
+			log.Info("Skipping build", "reason", reason, "repo", push.Repository, "branch", ref, "serviceID", service.ID)
+			if err := self.srv.Repository.WithTx(ctx, func(tx repository.TxInterface) error {
+				deployment, err := self.srv.Repository.Deployment().Create(ctx, tx, service.ID, push.CommitSHA, push.CommitMessage, ref, push.Committer, schema.DeploymentSourceGit, schema.DeploymentStatusSkipped)
+				if err != nil {
+					return err
+				}
+				_, err = self.srv.Repository.Deployment().MarkSkipped(ctx, tx, deployment.ID, reason)
+				return err
+			}); err != nil {
+				log.Error("Error recording skipped deployment", "err", err)
+				return huma.Error500InternalServerError("Failed to record skipped deployment")
+			}
+			continue
+		}
+
+		env, err := self.srv.DeploymentController.PopulateBuildEnvironment(ctx, service.ID, tagName, nil)
+		if err != nil {
+			log.Error("Error populating build environment", "err", err)
+			return huma.Error500InternalServerError("Failed to populate build environment")
+		}
+
+		log.Info("Enqueuing build", "repo", push.Repository, "branch", ref, "serviceID", service.ID)
+		req := deployctl.DeploymentJobRequest{
+			ServiceID:     service.ID,
+			Environment:   env,
+			Source:        schema.DeploymentSourceGit,
+			CommitSHA:     push.CommitSHA,
+			CommitMessage: push.CommitMessage,
+			GitBranch:     ref,
+			Committer:     push.Committer,
+		}
+		jobID, err := self.srv.DeploymentController.EnqueueDeploymentJob(
+			ctx,
+			req,
+		)
+
+		if err != nil {
+			log.Error("Error enqueuing build job", "err", err)
+			return huma.Error500InternalServerError("Failed to enqueue build job")
+		}
+
+		log.Info("Enqueued build job", "jobID", jobID)
+	}
+
+	return nil
+}
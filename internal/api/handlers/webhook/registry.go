@@ -0,0 +1,48 @@
+package webhook_handler
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/registry"
+)
+
+type RegistryWebhookInput struct {
+	RawBody []byte
+	Secret  string `path:"secret"`
+}
+
+type RegistryWebhookOutput struct {
+	Body struct {
+		Redeployed int `json:"redeployed" doc:"Number of services that were redeployed"`
+	}
+}
+
+// HandleRegistryWebhook redeploys auto-updating services whose image tag was pushed
+// Docker Hub, Harbor, Quay and distribution notifications are understood
+func (self *HandlerGroup) HandleRegistryWebhook(ctx context.Context, input *RegistryWebhookInput) (*RegistryWebhookOutput, error) {
+	// Registries can't all send headers, so the secret is part of the URL
+	secret := self.srv.Cfg.RegistryWebhookSecret
+	if secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(input.Secret)) != 1 {
+		log.Error("Received registry webhook with invalid secret")
+		return nil, huma.Error400BadRequest("Invalid secret")
+	}
+
+	events, err := registry.ParsePushEvents(input.RawBody)
+	if err != nil {
+		log.Errorf("Could not parse registry webhook: %v", err)
+		return nil, huma.Error400BadRequest("Failed to parse registry webhook")
+	}
+
+	redeployed, err := self.srv.DeploymentService.HandleRegistryPush(ctx, events)
+	if err != nil {
+		log.Error("Error handling registry push", "err", err)
+		return nil, huma.Error500InternalServerError("Failed to handle registry push")
+	}
+
+	resp := &RegistryWebhookOutput{}
+	resp.Body.Redeployed = redeployed
+	return resp, nil
+}
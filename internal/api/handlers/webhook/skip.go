@@ -0,0 +1,55 @@
+package webhook_handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+)
+
+// Commit message markers that always skip auto-deploy
+var defaultSkipDeployMarkers = []string{"[skip deploy]", "[skip ci]"}
+
+// pushAuthors lists the names a push's ignore rules are matched against, e.g. dependabot[bot]
+func pushAuthors(e *github.PushEvent) []string {
+	var authors []string
+	if login := e.GetSender().GetLogin(); login != "" {
+		authors = append(authors, login)
+	}
+	if author := e.GetHeadCommit().GetAuthor(); author != nil {
+		if author.GetLogin() != "" {
+			authors = append(authors, author.GetLogin())
+		}
+		if author.GetName() != "" {
+			authors = append(authors, author.GetName())
+		}
+	}
+	return authors
+}
+
+// skipDeployReason returns why a push shouldn't deploy the service, empty if it should
+func skipDeployReason(config *ent.ServiceConfig, commitMessage string, authors []string) string {
+	markers := defaultSkipDeployMarkers
+	if config.SkipDeployMarker != nil && *config.SkipDeployMarker != "" {
+		markers = append([]string{*config.SkipDeployMarker}, markers...)
+	}
+
+	message := strings.ToLower(commitMessage)
+	for _, marker := range markers {
+		if strings.Contains(message, strings.ToLower(marker)) {
+			return fmt.Sprintf("Commit message contains %s", marker)
+		}
+	}
+
+	for _, author := range authors {
+		for _, pattern := range config.IgnoredCommitAuthors {
+			if utils.MatchesCommitAuthorPattern(author, pattern) {
+				return fmt.Sprintf("Commit author %s is ignored", author)
+			}
+		}
+	}
+
+	return ""
+}
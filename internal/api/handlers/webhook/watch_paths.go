@@ -0,0 +1,56 @@
+package webhook_handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+)
+
+// Push payloads list at most this many commits
+const maxPushPayloadCommits = 2048
+
+// getPushChangedFiles lists the files changed by a push, using the compare API if the payload's commit list is truncated
+// ok is false if they can't be determined, in which case every service should be deployed
+func (self *HandlerGroup) getPushChangedFiles(ctx context.Context, installation *ent.GithubInstallation, e *github.PushEvent) (files []string, ok bool) {
+	commits := e.Commits
+	if len(commits) > 0 && len(commits) < maxPushPayloadCommits {
+		for _, commit := range commits {
+			files = append(files, commit.Added...)
+			files = append(files, commit.Removed...)
+			files = append(files, commit.Modified...)
+		}
+		return files, true
+	}
+
+	// Nothing to compare against when a branch is created
+	before := e.GetBefore()
+	if before == "" || strings.Trim(before, "0") == "" || e.GetAfter() == "" {
+		return nil, false
+	}
+
+	files, complete, err := self.srv.GithubClient.GetChangedFiles(ctx, installation, installation.AccountLogin, e.Repo.GetName(), before, e.GetAfter())
+	if err != nil {
+		log.Warn("Error getting changed files for push, deploying all services", "err", err, "repo", e.Repo.GetName())
+		return nil, false
+	}
+	if !complete {
+		return nil, false
+	}
+	return files, true
+}
+
+// matchesWatchPaths reports whether any of the files matches one of the glob patterns
+func matchesWatchPaths(files, watchPaths []string) bool {
+	for _, file := range files {
+		for _, pattern := range watchPaths {
+			if utils.MatchesGlobPattern(file, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
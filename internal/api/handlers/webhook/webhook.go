@@ -32,4 +32,44 @@ func RegisterHandlers(server *server.Server, grp *huma.Group) {
 		Path:        "/github/app/save",
 		Method:      http.MethodGet,
 	}, handlers.HandleGithubAppSave, oapi.Public, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "gitlab-webhook",
+		Summary:     "GitLab Webhook",
+		Description: "Receive GitLab push and tag push events. Authenticated by the connection's X-Gitlab-Token secret, not a session.",
+		Path:        "/gitlab",
+		Method:      http.MethodPost,
+	}, handlers.HandleGitlabWebhook, oapi.Public)
+
+	oapi.Register(grp, oapi.Read, huma.Operation{
+		OperationID: "gitlab-oauth-callback",
+		Summary:     "GitLab OAuth Callback",
+		Description: "GitLab OAuth callback: exchanges the code, stores the connection, and redirects back to the client.",
+		Path:        "/gitlab/oauth/callback",
+		Method:      http.MethodGet,
+	}, handlers.HandleGitlabOAuthCallback, oapi.Public, oapi.OpenWorld)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "git-webhook",
+		Summary:     "Git Webhook",
+		Description: "Receive push events for a git service. Authenticated by the signature in the service's webhook URL, not a session.",
+		Path:        "/git/{service_id}/{signature}",
+		Method:      http.MethodPost,
+	}, handlers.HandleGitWebhook, oapi.Public)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "deploy-hook",
+		Summary:     "Deploy Hook",
+		Description: "Deploy a service, optionally at a ref or commit. Authenticated by the token in the service's deploy hook URL, not a session.",
+		Path:        "/deploy/{token}",
+		Method:      http.MethodPost,
+	}, handlers.HandleDeployHook, oapi.Public)
+
+	oapi.Register(grp, oapi.Invoke, huma.Operation{
+		OperationID: "registry-webhook",
+		Summary:     "Registry Webhook",
+		Description: "Receive image push events from a container registry. Authenticated by the shared secret in the path, not a session. Matching services with image auto-update are checked against the registry, the payload is only a hint.",
+		Path:        "/registry/{secret}",
+		Method:      http.MethodPost,
+	}, handlers.HandleRegistryWebhook, oapi.Public)
 }
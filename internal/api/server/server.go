@@ -13,6 +13,7 @@ import (
 	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/updater"
 	"github.com/unbindapp/unbind-api/internal/integrations/github"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
 	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
 	deployments_service "github.com/unbindapp/unbind-api/internal/services/deployments"
 	environment_service "github.com/unbindapp/unbind-api/internal/services/environment"
@@ -49,6 +50,7 @@ type Server struct {
 	KubeClient           *k8s.KubeClient
 	Cfg                  *config.Config
 	GithubClient         *github.GithubClient
+	GitlabClient         *gitlab.GitlabClient
 	Repository           repositories.RepositoriesInterface
 	StringCache          *cache.RedisCache[string]
 	HttpClient           *http.Client
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// MaxExtractedSourceSize caps how much an uploaded source archive may extract to
+const MaxExtractedSourceSize = 1 << 30
+
+// ErrArchiveTooLarge is returned when an archive extracts to more than the allowed size
+var ErrArchiveTooLarge = errors.New("archive is too large")
+
+// ExtractTarGz extracts a gzipped tarball into destDir, which must exist.
+// Entries escaping destDir, links pointing outside of it and more than maxSize bytes of file content are rejected.
+func ExtractTarGz(r io.Reader, destDir string, maxSize int64) error {
+	return extractTarGz(r, destDir, maxSize, nil)
+}
+
+// ExtractTarGzFiles extracts only the regular files include accepts, with their directories, links are skipped
+func ExtractTarGzFiles(r io.Reader, destDir string, maxSize int64, include func(name string, size int64) bool) error {
+	return extractTarGz(r, destDir, maxSize, include)
+}
+
+func extractTarGz(r io.Reader, destDir string, maxSize int64, include func(name string, size int64) bool) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("invalid gzip archive: %w", err)
+	}
+	defer gz.Close()
+
+	// Resolved so paths can be compared to resolved parents, the temp dir itself may be behind a link
+	root, err := filepath.Abs(destDir)
+	if err != nil {
+		return err
+	}
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gz)
+	var written int64
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+
+		target, err := archiveEntryPath(root, header.Name)
+		if err != nil {
+			return err
+		}
+		if target == root {
+			continue
+		}
+
+		if include != nil && (header.Typeflag != tar.TypeReg || !include(header.Name, header.Size)) {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if _, err := resolveArchiveParent(root, target); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			target, err = resolveArchiveParent(root, target)
+			if err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode)&0755|0600)
+			if err != nil {
+				return err
+			}
+			// Read one byte past the limit so we can tell it was exceeded
+			n, err := io.Copy(file, io.LimitReader(tr, maxSize-written+1))
+			file.Close()
+			if err != nil {
+				return err
+			}
+			written += n
+			if written > maxSize {
+				return ErrArchiveTooLarge
+			}
+		case tar.TypeSymlink:
+			target, err = resolveArchiveParent(root, target)
+			if err != nil {
+				return err
+			}
+			if !isArchiveLinkWithinDir(root, target, header.Linkname) {
+				return fmt.Errorf("archive link %s points outside of the archive", header.Name)
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		default:
+			// Hard links, devices and fifos have no place in source code
+			continue
+		}
+	}
+}
+
+// resolveArchiveParent creates the entry's parent directory and resolves any links in it, so nothing is written through a link leaving root
+// An existing link at the entry itself is removed rather than followed
+func resolveArchiveParent(root, target string) (string, error) {
+	parent := filepath.Dir(target)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return "", err
+	}
+	resolvedParent, err := filepath.EvalSymlinks(parent)
+	if err != nil {
+		return "", err
+	}
+	if !isWithinDir(root, resolvedParent) {
+		return "", fmt.Errorf("archive entry %s is outside of the archive", target)
+	}
+
+	resolved := filepath.Join(resolvedParent, filepath.Base(target))
+	if info, err := os.Lstat(resolved); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(resolved); err != nil {
+			return "", err
+		}
+	}
+	return resolved, nil
+}
+
+// isArchiveLinkWithinDir checks a link resolves inside root, also after later entries are extracted
+// Relative links are resolved against the link's directory, the kernel only cleans .. after following links,
+// so every step up to the last .. has to be an existing directory, with links d -> . and l -> d/.. escaping otherwise
+func isArchiveLinkWithinDir(root, link, linkname string) bool {
+	if filepath.IsAbs(linkname) {
+		return !slices.Contains(strings.Split(filepath.ToSlash(linkname), "/"), "..") && isWithinDir(root, filepath.Clean(linkname))
+	}
+
+	parts := strings.Split(filepath.ToSlash(linkname), "/")
+	lastUp := -1
+	for i, part := range parts {
+		if part == ".." {
+			lastUp = i
+		}
+	}
+
+	current := filepath.Dir(link)
+	for i, part := range parts {
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			current = filepath.Dir(current)
+		default:
+			current = filepath.Join(current, part)
+		}
+		if !isWithinDir(root, current) {
+			return false
+		}
+		if i < lastUp {
+			info, err := os.Lstat(current)
+			if err != nil || !info.IsDir() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// archiveEntryPath resolves an archive entry to its path under root
+func archiveEntryPath(root, name string) (string, error) {
+	name = filepath.FromSlash(name)
+	if filepath.IsAbs(name) {
+		return "", fmt.Errorf("archive entry %s has an absolute path", name)
+	}
+	target := filepath.Join(root, name)
+	if !isWithinDir(root, target) {
+		return "", fmt.Errorf("archive entry %s is outside of the archive", name)
+	}
+	return target, nil
+}
+
+func isWithinDir(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(os.PathSeparator))
+}
@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testArchiveEntry struct {
+	name     string
+	typeflag byte
+	body     string
+	linkname string
+}
+
+func buildTarGz(t *testing.T, entries []testArchiveEntry) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Mode:     0644,
+			Size:     int64(len(entry.body)),
+			Linkname: entry.linkname,
+		}
+		if entry.typeflag != tar.TypeReg {
+			header.Size = 0
+			header.Mode = 0755
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		if entry.typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(entry.body))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return &buf
+}
+
+func TestExtractTarGz(t *testing.T) {
+	t.Run("Extracts files, directories and links", func(t *testing.T) {
+		dir := t.TempDir()
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "./", typeflag: tar.TypeDir},
+			{name: "src/", typeflag: tar.TypeDir},
+			{name: "src/main.go", typeflag: tar.TypeReg, body: "package main"},
+			{name: "package.json", typeflag: tar.TypeReg, body: "{}"},
+			{name: "main.go", typeflag: tar.TypeSymlink, linkname: "src/main.go"},
+		})
+
+		require.NoError(t, ExtractTarGz(archive, dir, 1024))
+
+		content, err := os.ReadFile(filepath.Join(dir, "src", "main.go"))
+		require.NoError(t, err)
+		assert.Equal(t, "package main", string(content))
+
+		content, err = os.ReadFile(filepath.Join(dir, "main.go"))
+		require.NoError(t, err)
+		assert.Equal(t, "package main", string(content))
+
+		_, err = os.Stat(filepath.Join(dir, "package.json"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Rejects path traversal", func(t *testing.T) {
+		dir := t.TempDir()
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "../escape.txt", typeflag: tar.TypeReg, body: "nope"},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, dir, 1024))
+		_, err := os.Stat(filepath.Join(filepath.Dir(dir), "escape.txt"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Rejects absolute paths", func(t *testing.T) {
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "/etc/passwd", typeflag: tar.TypeReg, body: "nope"},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, t.TempDir(), 1024))
+	})
+
+	t.Run("Rejects links pointing outside", func(t *testing.T) {
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "link", typeflag: tar.TypeSymlink, linkname: "../../etc"},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, t.TempDir(), 1024))
+	})
+
+	t.Run("Does not write through links", func(t *testing.T) {
+		dir := t.TempDir()
+		outside := t.TempDir()
+		archive := buildTarGz(t, []testArchiveEntry{
+			// Points inside the archive, but lets a later link be created relative to the root
+			{name: "self", typeflag: tar.TypeSymlink, linkname: "."},
+			{name: "self/up", typeflag: tar.TypeSymlink, linkname: "../" + filepath.Base(outside)},
+			{name: "up/escape.txt", typeflag: tar.TypeReg, body: "nope"},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, dir, 1024))
+		_, err := os.Stat(filepath.Join(outside, "escape.txt"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Rejects chained links going up through a link", func(t *testing.T) {
+		dir := t.TempDir()
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "d", typeflag: tar.TypeSymlink, linkname: "."},
+			// Cleaned this is the root, but d/.. is resolved after following d, which is the root's parent
+			{name: "l", typeflag: tar.TypeSymlink, linkname: "d/.."},
+			{name: "l/escape.txt", typeflag: tar.TypeReg, body: "nope"},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, dir, 1024))
+		_, err := os.Lstat(filepath.Join(dir, "l"))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(filepath.Dir(dir), "escape.txt"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Rejects links going up through a later link", func(t *testing.T) {
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "l", typeflag: tar.TypeSymlink, linkname: "d/.."},
+			{name: "d", typeflag: tar.TypeSymlink, linkname: "."},
+		})
+
+		assert.Error(t, ExtractTarGz(archive, t.TempDir(), 1024))
+	})
+
+	t.Run("Extracts links going up through directories", func(t *testing.T) {
+		dir := t.TempDir()
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "shared/", typeflag: tar.TypeDir},
+			{name: "shared/config.json", typeflag: tar.TypeReg, body: "{}"},
+			{name: "apps/web/", typeflag: tar.TypeDir},
+			{name: "apps/web/config.json", typeflag: tar.TypeSymlink, linkname: "../../shared/config.json"},
+		})
+
+		require.NoError(t, ExtractTarGz(archive, dir, 1024))
+		content, err := os.ReadFile(filepath.Join(dir, "apps", "web", "config.json"))
+		require.NoError(t, err)
+		assert.Equal(t, "{}", string(content))
+	})
+
+	t.Run("Rejects archives over the size limit", func(t *testing.T) {
+		archive := buildTarGz(t, []testArchiveEntry{
+			{name: "a.txt", typeflag: tar.TypeReg, body: "0123456789"},
+			{name: "b.txt", typeflag: tar.TypeReg, body: "0123456789"},
+		})
+
+		assert.ErrorIs(t, ExtractTarGz(archive, t.TempDir(), 15), ErrArchiveTooLarge)
+	})
+
+	t.Run("Rejects invalid archives", func(t *testing.T) {
+		assert.Error(t, ExtractTarGz(bytes.NewBufferString("not an archive"), t.TempDir(), 1024))
+	})
+}
+
+func TestExtractTarGzFiles(t *testing.T) {
+	dir := t.TempDir()
+	archive := buildTarGz(t, []testArchiveEntry{
+		{name: "package.json", typeflag: tar.TypeReg, body: "{}"},
+		{name: "src/index.ts", typeflag: tar.TypeReg, body: "serve()"},
+		{name: "node_modules/dep/index.js", typeflag: tar.TypeReg, body: "module.exports = {}"},
+		{name: "index.ts", typeflag: tar.TypeSymlink, linkname: "src/index.ts"},
+	})
+
+	require.NoError(t, ExtractTarGzFiles(archive, dir, 1024, func(name string, size int64) bool {
+		return !strings.HasPrefix(name, "node_modules/")
+	}))
+
+	_, err := os.Stat(filepath.Join(dir, "package.json"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "src", "index.ts"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "node_modules"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Lstat(filepath.Join(dir, "index.ts"))
+	assert.True(t, os.IsNotExist(err))
+}
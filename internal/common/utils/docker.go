@@ -2,7 +2,12 @@ package utils
 
 import (
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/containerd/platforms"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
@@ -37,3 +42,77 @@ func GetExposedPortsFromRegistry(imageName string) ([]string, error) {
 
 	return ports, nil
 }
+
+var buildArgNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Sources buildkit fetches itself, anything else is a path in the repository
+var remoteBuildContextPrefixes = []string{"docker-image://", "oci-layout://", "https://", "http://", "git://", "git@", "ssh://"}
+
+// IsRemoteBuildContext reports whether a named build context is fetched by buildkit instead of read from the repository
+func IsRemoteBuildContext(source string) bool {
+	for _, prefix := range remoteBuildContextPrefixes {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDockerBuildArgs checks build arg names, values are free form
+func ValidateDockerBuildArgs(args map[string]string) error {
+	for name := range args {
+		if !buildArgNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid build arg name %s", name)
+		}
+	}
+	return nil
+}
+
+// ValidateDockerBuildContexts checks named build contexts, repository paths have to stay inside the repository
+func ValidateDockerBuildContexts(contexts map[string]string) error {
+	for name, source := range contexts {
+		if name == "" || strings.ContainsAny(name, " \t\n") {
+			return fmt.Errorf("invalid build context name %q", name)
+		}
+		if source == "" {
+			return fmt.Errorf("build context %s has no source", name)
+		}
+		if IsRemoteBuildContext(source) {
+			continue
+		}
+		if strings.HasPrefix(source, "/") {
+			return fmt.Errorf("build context %s must be a path relative to the repository", name)
+		}
+		if cleaned := path.Clean(source); cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return fmt.Errorf("build context %s is outside of the repository", name)
+		}
+	}
+	return nil
+}
+
+var supportedPlatformArchitectures = []string{"amd64", "arm64", "arm", "386", "ppc64le", "s390x", "riscv64"}
+
+// NormalizePlatforms checks image platforms like linux/arm64 and normalizes them, dropping duplicates
+func NormalizePlatforms(values []string) ([]string, error) {
+	normalized := []string{}
+	for _, value := range values {
+		// Parsing fills in the host's architecture if there is none, that's never what's meant here
+		if !strings.Contains(value, "/") {
+			return nil, fmt.Errorf("invalid platform %s, expected os/arch like linux/arm64", value)
+		}
+		platform, err := platforms.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid platform %s: %w", value, err)
+		}
+		platform = platforms.Normalize(platform)
+		// Images run on the cluster's nodes
+		if platform.OS != "linux" || !slices.Contains(supportedPlatformArchitectures, platform.Architecture) {
+			return nil, fmt.Errorf("unsupported platform %s", value)
+		}
+		formatted := platforms.Format(platform)
+		if !slices.Contains(normalized, formatted) {
+			normalized = append(normalized, formatted)
+		}
+	}
+	return normalized, nil
+}
@@ -95,3 +95,57 @@ func TestActualImgproxy(t *testing.T) {
 		assert.Contains(t, ports, "8080/tcp", "darthsim/imgproxy should expose port 8080")
 	}
 }
+
+func TestValidateDockerBuildArgs(t *testing.T) {
+	assert.NoError(t, ValidateDockerBuildArgs(map[string]string{"NODE_ENV": "production", "_VERSION2": "${VERSION}"}))
+	assert.NoError(t, ValidateDockerBuildArgs(nil))
+	assert.Error(t, ValidateDockerBuildArgs(map[string]string{"2FAST": "yes"}))
+	assert.Error(t, ValidateDockerBuildArgs(map[string]string{"WITH SPACE": "yes"}))
+	assert.Error(t, ValidateDockerBuildArgs(map[string]string{"": "yes"}))
+}
+
+func TestValidateDockerBuildContexts(t *testing.T) {
+	tests := []struct {
+		name        string
+		contexts    map[string]string
+		expectError bool
+	}{
+		{"Repository path", map[string]string{"shared": "packages/shared"}, false},
+		{"Docker image", map[string]string{"alpine": "docker-image://alpine:3.20"}, false},
+		{"Git", map[string]string{"protos": "https://github.com/org/protos.git#main"}, false},
+		{"Absolute path", map[string]string{"shared": "/etc"}, true},
+		{"Outside repository", map[string]string{"shared": "packages/../../secrets"}, true},
+		{"Empty source", map[string]string{"shared": ""}, true},
+		{"Invalid name", map[string]string{"my context": "packages/shared"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDockerBuildContexts(tt.contexts)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNormalizePlatforms(t *testing.T) {
+	normalized, err := NormalizePlatforms([]string{"linux/amd64", "Linux/ARM64", "linux/arm64/v8", "linux/arm/v7"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64", "linux/arm/v7"}, normalized)
+
+	normalized, err = NormalizePlatforms(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, normalized)
+
+	_, err = NormalizePlatforms([]string{"linux"})
+	assert.Error(t, err)
+
+	_, err = NormalizePlatforms([]string{"linux/not-an-arch"})
+	assert.Error(t, err)
+
+	_, err = NormalizePlatforms([]string{"windows/amd64"})
+	assert.Error(t, err)
+}
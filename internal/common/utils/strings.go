@@ -2,6 +2,7 @@ package utils
 
 import (
 	"strings"
+	"unicode"
 )
 
 // Makes sure a string contains one interpolation marker, ${} , allow escaping to ignore
@@ -28,7 +29,7 @@ func ContainsExactlyOneInterpolationMarker(s string) bool {
 	return count == 1
 }
 
-// IsValidGlobPattern checks if a string is a valid glob pattern
+// IsValidGlobPattern checks if a string is a valid glob pattern, for tags or file paths
 func IsValidGlobPattern(pattern string) bool {
 	if pattern == "" {
 		return false
@@ -39,7 +40,7 @@ func IsValidGlobPattern(pattern string) bool {
 		isLower := c >= 'a' && c <= 'z'
 		isUpper := c >= 'A' && c <= 'Z'
 		isDigit := c >= '0' && c <= '9'
-		isSpecial := c == '_' || c == '-' || c == '*' || c == '.'
+		isSpecial := c == '_' || c == '-' || c == '*' || c == '.' || c == '/'
 		if !isLower && !isUpper && !isDigit && !isSpecial {
 			return false
 		}
@@ -58,7 +59,35 @@ func MatchesGlobPattern(value, pattern string) bool {
 	if !IsValidGlobPattern(pattern) {
 		return false
 	}
+	return matchesWildcard(value, pattern)
+}
+
+// IsValidCommitAuthorPattern checks if a string is a valid commit author pattern
+// Authors are matched literally except for *, so names like dependabot[bot] or "Jane Doe" are allowed
+func IsValidCommitAuthorPattern(pattern string) bool {
+	if strings.TrimSpace(pattern) == "" || len(pattern) > 256 {
+		return false
+	}
+
+	for _, c := range pattern {
+		if unicode.IsControl(c) {
+			return false
+		}
+	}
+
+	return !strings.Contains(pattern, "**")
+}
+
+// MatchesCommitAuthorPattern checks if a commit author matches a pattern, case-insensitively
+func MatchesCommitAuthorPattern(author, pattern string) bool {
+	if !IsValidCommitAuthorPattern(pattern) {
+		return false
+	}
+	return matchesWildcard(strings.ToLower(author), strings.ToLower(pattern))
+}
 
+// matchesWildcard matches a value against a pattern where * is the only special character
+func matchesWildcard(value, pattern string) bool {
 	// Handle empty value
 	if value == "" {
 		return pattern == "*" || pattern == ""
@@ -100,6 +100,11 @@ func TestIsValidGlobPattern(t *testing.T) {
 			pattern:  "v1 *",
 			expected: false,
 		},
+		{
+			name:     "Valid path pattern",
+			pattern:  "apps/web/*",
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -263,6 +268,25 @@ func TestMatchesGlobPattern(t *testing.T) {
 			pattern:  "v1.2*beta*",
 			expected: true,
 		},
+		// File paths
+		{
+			name:     "Path in nested directory",
+			value:    "apps/web/src/index.ts",
+			pattern:  "apps/web/*",
+			expected: true,
+		},
+		{
+			name:     "Path in sibling directory",
+			value:    "apps/api/main.go",
+			pattern:  "apps/web/*",
+			expected: false,
+		},
+		{
+			name:     "Path by extension",
+			value:    "packages/ui/button.tsx",
+			pattern:  "packages/*.tsx",
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -272,3 +296,49 @@ func TestMatchesGlobPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidCommitAuthorPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		expected bool
+	}{
+		{name: "Login", pattern: "octocat", expected: true},
+		{name: "Bot login with brackets", pattern: "dependabot[bot]", expected: true},
+		{name: "Renovate bot", pattern: "renovate[bot]", expected: true},
+		{name: "Name with spaces", pattern: "Jane Doe", expected: true},
+		{name: "Wildcard", pattern: "*[bot]", expected: true},
+		{name: "Empty", pattern: "", expected: false},
+		{name: "Only whitespace", pattern: "   ", expected: false},
+		{name: "Control character", pattern: "bot\n", expected: false},
+		{name: "Consecutive wildcards", pattern: "**bot", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidCommitAuthorPattern(tt.pattern))
+		})
+	}
+}
+
+func TestMatchesCommitAuthorPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		author   string
+		pattern  string
+		expected bool
+	}{
+		{name: "Brackets match literally", author: "dependabot[bot]", pattern: "dependabot[bot]", expected: true},
+		{name: "Brackets are not a character class", author: "dependabotb", pattern: "dependabot[bot]", expected: false},
+		{name: "Case insensitive", author: "Renovate[bot]", pattern: "renovate[bot]", expected: true},
+		{name: "Name with spaces", author: "Jane Doe", pattern: "jane doe", expected: true},
+		{name: "Wildcard suffix", author: "github-actions[bot]", pattern: "*[bot]", expected: true},
+		{name: "Wildcard does not match other authors", author: "octocat", pattern: "*[bot]", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchesCommitAuthorPattern(tt.author, tt.pattern))
+		})
+	}
+}
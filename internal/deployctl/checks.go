@@ -0,0 +1,151 @@
+package deployctl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
+	"github.com/unbindapp/unbind-api/internal/integrations/github"
+)
+
+// Check runs are only kept up to date for deployments created within this window
+const GITHUB_CHECK_WINDOW = 24 * time.Hour
+
+// SyncGithubCheckRuns reports the status of recent deployments of github services as check runs on their commit
+func (self *DeploymentController) SyncGithubCheckRuns(ctx context.Context) error {
+	deployments, err := self.repo.Deployment().GetPendingGithubChecks(ctx, time.Now().Add(-GITHUB_CHECK_WINDOW))
+	if err != nil {
+		return fmt.Errorf("failed to get deployments pending github checks: %w", err)
+	}
+
+	for _, deployment := range deployments {
+		service := deployment.Edges.Service
+		if service == nil || service.Edges.GithubInstallation == nil || service.GitRepository == nil {
+			continue
+		}
+
+		status := deployment.Status
+		if status == schema.DeploymentStatusBuildSucceeded {
+			status, err = self.rolloutStatus(ctx, service, deployment)
+			if err != nil {
+				log.Error("Failed to get rollout status for github check", "err", err, "deployment_id", deployment.ID)
+				continue
+			}
+		}
+
+		if deployment.GithubCheckStatus != nil && *deployment.GithubCheckStatus == status {
+			continue
+		}
+
+		state := githubCheckRunState(status, deployment)
+		state.Name = fmt.Sprintf("unbind / %s", service.Name)
+		state.DetailsURL = self.deploymentURL(service, deployment.ID)
+		concluded := state.Status == "completed"
+
+		installation := service.Edges.GithubInstallation
+		checkRunID, err := self.githubClient.ReportCheckRun(ctx, installation, installation.AccountLogin, *service.GitRepository, *deployment.CommitSha, deployment.GithubCheckRunID, state)
+		if err == nil {
+			deployment.GithubCheckRunID = &checkRunID
+		} else {
+			log.Warn("Failed to report github check run", "err", err, "deployment_id", deployment.ID)
+			if deployment.GithubCheckRunID != nil {
+				// Try again on the next sync
+				continue
+			}
+			// Usually the app was installed without the checks permission, don't keep trying
+			concluded = true
+		}
+
+		if _, err := self.repo.Deployment().SetGithubCheckRun(ctx, deployment.ID, deployment.GithubCheckRunID, status, concluded); err != nil {
+			log.Error("Failed to record github check run", "err", err, "deployment_id", deployment.ID)
+		}
+	}
+
+	return nil
+}
+
+// rolloutStatus is how a built deployment is doing once rolled out, still build-succeeded while its instances are starting
+func (self *DeploymentController) rolloutStatus(ctx context.Context, service *ent.Service, deployment *ent.Deployment) (schema.DeploymentStatus, error) {
+	if service.CurrentDeploymentID == nil || *service.CurrentDeploymentID != deployment.ID {
+		return schema.DeploymentStatusRemoved, nil
+	}
+
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+	health, err := self.k8s.GetSimpleHealthStatus(ctx, namespace, map[string]string{
+		"unbind-deployment": deployment.ID.String(),
+		"unbind-service":    service.ID.String(),
+	}, utils.ToPtr(int(service.Edges.ServiceConfig.Replicas)), self.k8s.GetInternalClient())
+	if err != nil {
+		return "", err
+	}
+
+	switch health.Health {
+	case k8s.InstanceHealthActive:
+		return schema.DeploymentStatusActive, nil
+	case k8s.InstanceHealthCrashing:
+		return schema.DeploymentStatusCrashing, nil
+	}
+	return schema.DeploymentStatusBuildSucceeded, nil
+}
+
+// githubCheckRunState maps a deployment status to what its check run shows
+func githubCheckRunState(status schema.DeploymentStatus, deployment *ent.Deployment) github.CheckRunState {
+	switch status {
+	case schema.DeploymentStatusAwaitingApproval:
+		return github.CheckRunState{Status: "queued", Title: "Awaiting approval", Summary: "The deployment is waiting for an admin to approve it."}
+	case schema.DeploymentStatusScheduled:
+		return github.CheckRunState{Status: "queued", Title: "Scheduled", Summary: "The deployment is scheduled for later."}
+	case schema.DeploymentStatusBuildPending, schema.DeploymentStatusBuildQueued:
+		return github.CheckRunState{Status: "queued", Title: "Queued", Summary: "The deployment is waiting to be built."}
+	case schema.DeploymentStatusBuildRunning:
+		return github.CheckRunState{Status: "in_progress", Title: "Building", Summary: "The deployment is being built."}
+	case schema.DeploymentStatusBuildSucceeded:
+		return github.CheckRunState{Status: "in_progress", Title: "Rolling out", Summary: "The deployment was built, waiting for its instances to become healthy."}
+	case schema.DeploymentStatusStaged:
+		return github.CheckRunState{Status: "in_progress", Title: "Staged", Summary: "The deployment is running next to the current one, waiting to be promoted."}
+	case schema.DeploymentStatusPromoting:
+		return github.CheckRunState{Status: "in_progress", Title: "Promoting", Summary: "The deployment is taking over all traffic."}
+	case schema.DeploymentStatusActive:
+		return github.CheckRunState{Status: "completed", Conclusion: "success", Title: "Deployed", Summary: "The deployment is live and healthy."}
+	case schema.DeploymentStatusCrashing, schema.DeploymentStatusLaunchError:
+		return github.CheckRunState{Status: "completed", Conclusion: "failure", Title: "Crashing", Summary: "The deployment was rolled out, but its instances are crashing."}
+	case schema.DeploymentStatusBuildFailed:
+		summary := "The build failed."
+		if deployment.Error != "" {
+			summary = deployment.Error
+		}
+		return github.CheckRunState{Status: "completed", Conclusion: "failure", Title: "Build failed", Summary: summary}
+	case schema.DeploymentStatusBuildCancelled:
+		return github.CheckRunState{Status: "completed", Conclusion: "cancelled", Title: "Cancelled", Summary: "The deployment was cancelled."}
+	case schema.DeploymentStatusAborted:
+		return github.CheckRunState{Status: "completed", Conclusion: "cancelled", Title: "Rollout aborted", Summary: "The staged deployment was removed without being promoted."}
+	case schema.DeploymentStatusSkipped:
+		summary := "The push asked not to be deployed."
+		if deployment.SkipReason != nil {
+			summary = *deployment.SkipReason
+		}
+		return github.CheckRunState{Status: "completed", Conclusion: "skipped", Title: "Skipped", Summary: summary}
+	case schema.DeploymentStatusRemoved:
+		return github.CheckRunState{Status: "completed", Conclusion: "neutral", Title: "Replaced", Summary: "A newer deployment replaced this one before it became healthy."}
+	}
+	return github.CheckRunState{Status: "in_progress", Title: string(status), Summary: string(status)}
+}
+
+// deploymentURL links to the deployment and its build logs in the UI
+func (self *DeploymentController) deploymentURL(service *ent.Service, deploymentID uuid.UUID) string {
+	basePath, _ := utils.JoinURLPaths(
+		self.cfg.ExternalUIUrl,
+		service.Edges.Environment.Edges.Project.Edges.Team.ID.String(),
+		"project",
+		service.Edges.Environment.Edges.Project.ID.String(),
+	)
+	return basePath + "?environment=" + service.EnvironmentID.String() +
+		"&service=" + service.ID.String() +
+		"&deployment=" + deploymentID.String()
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/queue"
 	"github.com/unbindapp/unbind-api/internal/integrations/github"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
 	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
 	variables_service "github.com/unbindapp/unbind-api/internal/services/variables"
 	webhooks_service "github.com/unbindapp/unbind-api/internal/services/webooks"
@@ -29,6 +31,15 @@ import (
 // Redis key for the queue
 const BUILDER_QUEUE_KEY = "unbind:build:queue"
 const DEPENDENT_SERVICES_QUEUE_KEY = "unbind:dependent-services:queue"
+const APPROVAL_QUEUE_KEY = "unbind:approval:queue"
+const FREEZE_QUEUE_KEY = "unbind:freeze:queue"
+const SCHEDULED_QUEUE_KEY = "unbind:scheduled:queue"
+
+// Build queue priorities, these are added together so production always wins over manual
+const (
+	PRIORITY_MANUAL     = 1
+	PRIORITY_PRODUCTION = 2
+)
 
 // The request to deploy a service, includes environment for builder image
 type DeploymentJobRequest struct {
@@ -43,6 +54,10 @@ type DeploymentJobRequest struct {
 	Committer           *schema.GitCommitter    `json:"committer"`
 	DependsOnServiceIDs []uuid.UUID             `json:"depends_on_service_ids,omitempty"`
 	DisableBuildCache   bool                    `json:"disable_build_cache,omitempty"`
+	Priority            int                     `json:"priority,omitempty"`
+	OverrideFreeze      bool                    `json:"override_freeze,omitempty"`
+	Approved            bool                    `json:"approved,omitempty"` // An admin approved it for a protected environment
+	ScheduledAt         *time.Time              `json:"scheduled_at,omitempty"`
 }
 
 // Handles triggering builds for services
@@ -53,10 +68,14 @@ type DeploymentController struct {
 	k8s             k8s.KubeClientInterface
 	jobQueue        *queue.Queue[DeploymentJobRequest]
 	dependentQueue  *queue.Queue[DeploymentJobRequest]
+	approvalQueue   *queue.Queue[DeploymentJobRequest]
+	freezeQueue     *queue.Queue[DeploymentJobRequest]
+	scheduledQueue  *queue.Queue[DeploymentJobRequest]
 	ctx             context.Context
 	cancelFunc      context.CancelFunc
 	repo            repositories.RepositoriesInterface
 	githubClient    github.GithubClientInterface
+	gitlabClient    gitlab.GitlabClientInterface
 	webhookService  webhooks_service.WebhooksServiceInterface
 	variableService variables_service.VariablesServiceInterface
 }
@@ -69,20 +88,31 @@ func NewDeploymentController(
 	redisClient *redis.Client,
 	repositories repositories.RepositoriesInterface,
 	githubClient github.GithubClientInterface,
+	gitlabClient gitlab.GitlabClientInterface,
 	webeehookService webhooks_service.WebhooksServiceInterface,
 	variableService variables_service.VariablesServiceInterface) *DeploymentController {
 	jobQueue := queue.NewQueue[DeploymentJobRequest](redisClient, BUILDER_QUEUE_KEY)
 	dependentQueue := queue.NewQueue[DeploymentJobRequest](redisClient, DEPENDENT_SERVICES_QUEUE_KEY)
+	// Holds requests for protected environments, it's never processed, items are released by ApproveDeployment
+	approvalQueue := queue.NewQueue[DeploymentJobRequest](redisClient, APPROVAL_QUEUE_KEY)
+	// Holds auto-deploys during freeze windows, released by the freeze releaser once the window ends
+	freezeQueue := queue.NewQueue[DeploymentJobRequest](redisClient, FREEZE_QUEUE_KEY)
+	// Holds deployments scheduled for later, released by ReleaseScheduledDeployments
+	scheduledQueue := queue.NewQueue[DeploymentJobRequest](redisClient, SCHEDULED_QUEUE_KEY)
 
 	return &DeploymentController{
 		cfg:             cfg,
 		k8s:             k8s,
 		jobQueue:        jobQueue,
 		dependentQueue:  dependentQueue,
+		approvalQueue:   approvalQueue,
+		freezeQueue:     freezeQueue,
+		scheduledQueue:  scheduledQueue,
 		ctx:             ctx,
 		cancelFunc:      cancel,
 		repo:            repositories,
 		githubClient:    githubClient,
+		gitlabClient:    gitlabClient,
 		webhookService:  webeehookService,
 		variableService: variableService,
 	}
@@ -91,13 +121,22 @@ func NewDeploymentController(
 // Start queue processor
 func (self *DeploymentController) StartAsync() {
 	// Start the job processor
-	self.jobQueue.StartProcessor(self.ctx, self.processJob, self.k8s.CountActiveDeploymentJobs)
+	self.jobQueue.StartGroupedProcessor(self.ctx, self.processJob, queue.GroupedProcessorOptions[DeploymentJobRequest]{
+		// Round-robin between teams
+		GroupKey: func(item *queue.QueueItem[DeploymentJobRequest]) string {
+			return item.Data.Environment["SERVICE_TEAM_REF"]
+		},
+		ActiveJobs: self.k8s.CountActiveDeploymentJobsByTeam,
+		Limits:     self.GetBuildConcurrencyLimits,
+		GiveUp:     self.giveUpJob,
+	})
 
 	// Start the dependent services processor
 	self.dependentQueue.StartProcessor(self.ctx, self.processDependentJob, self.k8s.CountActiveDeploymentJobs)
 
 	// Start the job status synchronizer
 	go self.startStatusSynchronizer()
+
 }
 
 // Stop stops the deployment manager
@@ -105,6 +144,30 @@ func (self *DeploymentController) Stop() {
 	self.cancelFunc()
 }
 
+// GetBuildConcurrencyLimits reads the build concurrency limits from system settings, falling back to the queue default
+func (self *DeploymentController) GetBuildConcurrencyLimits(ctx context.Context) (queue.ConcurrencyLimits, error) {
+	limits := queue.ConcurrencyLimits{
+		Global: queue.QUEUE_CONCURRENCY,
+	}
+
+	settings, err := self.repo.System().GetSystemSettings(ctx, nil)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return limits, nil
+		}
+		return limits, fmt.Errorf("failed to get system settings: %w", err)
+	}
+
+	if settings.BuildSettings != nil {
+		if settings.BuildSettings.MaxConcurrentBuilds > 0 {
+			limits.Global = settings.BuildSettings.MaxConcurrentBuilds
+		}
+		limits.PerGroup = settings.BuildSettings.MaxConcurrentBuildsPerTeam
+	}
+
+	return limits, nil
+}
+
 // startStatusSynchronizer periodically synchronizes job statuses with Kubernetes
 func (self *DeploymentController) startStatusSynchronizer() {
 	ticker := time.NewTicker(30 * time.Second) // Sync every 30 seconds
@@ -253,26 +316,41 @@ func (self *DeploymentController) PopulateBuildEnvironment(ctx context.Context,
 	var builder schema.ServiceBuilder
 	var dockerfilePath *string
 	var buildContext *string
+	var dockerTarget *string
+	var dockerBuildArgs map[string]string
+	var dockerBuildContexts map[string]string
 	var railpackInstallCommand *string
 	var railpackBuildCommand *string
 	var runCommand *string
+	var buildpacksBuilderImage *string
+	var platforms []string
 
 	if deployment != nil {
 		// Use stored deployment build configuration
 		builder = deployment.Builder
 		dockerfilePath = deployment.DockerBuilderDockerfilePath
 		buildContext = deployment.DockerBuilderBuildContext
+		dockerTarget = deployment.DockerBuilderTarget
+		dockerBuildArgs = deployment.DockerBuilderBuildArgs
+		dockerBuildContexts = deployment.DockerBuilderBuildContexts
 		railpackInstallCommand = deployment.RailpackBuilderInstallCommand
 		railpackBuildCommand = deployment.RailpackBuilderBuildCommand
 		runCommand = deployment.RunCommand
+		buildpacksBuilderImage = deployment.BuildpacksBuilderImage
+		platforms = deployment.Platforms
 	} else {
 		// Use current service configuration
 		builder = service.Edges.ServiceConfig.Builder
 		dockerfilePath = service.Edges.ServiceConfig.DockerBuilderDockerfilePath
 		buildContext = service.Edges.ServiceConfig.DockerBuilderBuildContext
+		dockerTarget = service.Edges.ServiceConfig.DockerBuilderTarget
+		dockerBuildArgs = service.Edges.ServiceConfig.DockerBuilderBuildArgs
+		dockerBuildContexts = service.Edges.ServiceConfig.DockerBuilderBuildContexts
 		railpackInstallCommand = service.Edges.ServiceConfig.RailpackBuilderInstallCommand
 		railpackBuildCommand = service.Edges.ServiceConfig.RailpackBuilderBuildCommand
 		runCommand = service.Edges.ServiceConfig.RunCommand
+		buildpacksBuilderImage = service.Edges.ServiceConfig.BuildpacksBuilderImage
+		platforms = service.Edges.ServiceConfig.Platforms
 	}
 
 	// Add docker image override
@@ -290,6 +368,37 @@ func (self *DeploymentController) PopulateBuildEnvironment(ctx context.Context,
 		env["SERVICE_DOCKER_BUILDER_BUILD_CONTEXT"] = *buildContext
 	}
 
+	if dockerTarget != nil {
+		env["SERVICE_DOCKER_BUILDER_TARGET"] = *dockerTarget
+	}
+
+	// References to service variables in args are expanded by the builder
+	if len(dockerBuildArgs) > 0 {
+		buildArgsJSON, err := json.Marshal(dockerBuildArgs)
+		if err != nil {
+			return nil, err
+		}
+		env["SERVICE_DOCKER_BUILDER_BUILD_ARGS"] = string(buildArgsJSON)
+	}
+
+	if len(dockerBuildContexts) > 0 {
+		buildContextsJSON, err := json.Marshal(dockerBuildContexts)
+		if err != nil {
+			return nil, err
+		}
+		env["SERVICE_DOCKER_BUILDER_BUILD_CONTEXTS"] = string(buildContextsJSON)
+	}
+
+	// Add buildpacks builder override
+	if buildpacksBuilderImage != nil {
+		env["SERVICE_BUILDPACKS_BUILDER_IMAGE"] = *buildpacksBuilderImage
+	}
+
+	// Builds a manifest list, so the image runs on any of the platforms' nodes
+	if len(platforms) > 0 {
+		env["SERVICE_PLATFORMS"] = strings.Join(platforms, ",")
+	}
+
 	// Add Github fields
 	if service.GithubInstallationID != nil {
 		if service.GitRepository == nil || (service.Edges.ServiceConfig.GitBranch == nil && service.Edges.ServiceConfig.GitTag == nil) {
@@ -327,19 +436,97 @@ func (self *DeploymentController) PopulateBuildEnvironment(ctx context.Context,
 		}
 
 		env["GITHUB_REPO_URL"] = cloneUrl
+		env["GIT_REF"] = buildGitRef(service, gitTag)
+	}
 
-		if gitTag != nil {
-			if !strings.HasPrefix(*gitTag, "refs/tags/") {
-				*gitTag = "refs/tags/" + *gitTag
-			}
-			env["GIT_REF"] = *gitTag
-		} else {
-			ref := *service.Edges.ServiceConfig.GitBranch
-			if !strings.HasPrefix(ref, "refs/head") {
-				ref = "refs/heads/" + ref
+	// Add Gitlab fields
+	if service.GitlabConnectionID != nil {
+		if service.GitRepositoryOwner == nil || service.GitRepository == nil || (service.Edges.ServiceConfig.GitBranch == nil && service.Edges.ServiceConfig.GitTag == nil) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Missing required fields for GitLab service - doesn't have repository or git branch/tag")
+		}
+		connection, err := self.repo.Gitlab().GetConnectionByID(ctx, *service.GitlabConnectionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "GitLab connection not found")
 			}
-			env["GIT_REF"] = ref
+			return nil, err
+		}
+
+		// Verify project access
+		canAccess, cloneURL, _, err := self.gitlabClient.VerifyProjectAccess(ctx, connection, *service.GitRepositoryOwner, *service.GitRepository)
+		if err != nil {
+			log.Error("Error verifying project access", "err", err)
+			return nil, err
 		}
+
+		if !canAccess {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Project not accessible with the specified GitLab connection")
+		}
+
+		accessToken, err := self.gitlabClient.GetAccessToken(ctx, connection)
+		if err != nil {
+			log.Error("Error getting gitlab access token", "err", err)
+			return nil, err
+		}
+
+		env["GIT_PROVIDER"] = "gitlab"
+		env["GITLAB_ACCESS_TOKEN"] = accessToken
+		// The builder clones whatever repository URL it's given, whichever provider it's from
+		env["GITHUB_REPO_URL"] = cloneURL
+		env["GIT_REF"] = buildGitRef(service, gitTag)
+	}
+
+	// Add generic git fields
+	if service.Type == schema.ServiceTypeGit {
+		if service.GitURL == nil || service.GitSSHHostKey == nil || (service.Edges.ServiceConfig.GitBranch == nil && service.Edges.ServiceConfig.GitTag == nil) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Missing required fields for git service - doesn't have URL, host key or git branch/tag")
+		}
+
+		privateKey, err := self.k8s.GetSecretValue(ctx, gitssh.DeployKeySecretName(service.KubernetesSecret), namespace, gitssh.DeployKeySecretKey, self.k8s.GetInternalClient())
+		if err != nil {
+			log.Error("Error getting deploy key", "err", err)
+			return nil, err
+		}
+
+		env["GIT_PROVIDER"] = "ssh"
+		env["GIT_SSH_PRIVATE_KEY"] = string(privateKey)
+		env["GIT_SSH_HOST_KEY"] = *service.GitSSHHostKey
+		env["GITHUB_REPO_URL"] = *service.GitURL
+		env["GIT_REF"] = buildGitRef(service, gitTag)
+	}
+
+	// Add uploaded source archive fields
+	if service.Type == schema.ServiceTypeUpload {
+		if service.Edges.ServiceConfig.UploadS3SourceID == nil || service.Edges.ServiceConfig.UploadS3Bucket == nil {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Missing required fields for upload service - doesn't have S3 source or bucket")
+		}
+
+		// Rebuild the archive the deployment was built from, otherwise the latest upload
+		var archiveKey *string
+		if deployment != nil {
+			archiveKey = deployment.SourceArchive
+		} else if service.Edges.CurrentDeployment != nil {
+			archiveKey = service.Edges.CurrentDeployment.SourceArchive
+		}
+		if archiveKey != nil {
+			env["SOURCE_ARCHIVE_KEY"] = *archiveKey
+		}
+
+		s3Source, err := self.repo.S3().GetByID(ctx, *service.Edges.ServiceConfig.UploadS3SourceID)
+		if err != nil {
+			return nil, err
+		}
+		credentials, err := self.k8s.GetSecretMap(ctx, s3Source.KubernetesSecret, namespace, self.k8s.GetInternalClient())
+		if err != nil {
+			log.Error("Error getting S3 credentials", "err", err)
+			return nil, err
+		}
+
+		env["SOURCE_ARCHIVE_S3_ENDPOINT"] = s3Source.Endpoint
+		env["SOURCE_ARCHIVE_S3_REGION"] = s3Source.Region
+		env["SOURCE_ARCHIVE_S3_BUCKET"] = *service.Edges.ServiceConfig.UploadS3Bucket
+		env["SOURCE_ARCHIVE_S3_ACCESS_KEY_ID"] = string(credentials["access_key_id"])
+		env["SOURCE_ARCHIVE_S3_SECRET_KEY"] = string(credentials["secret_key"])
 	}
 
 	if service.Edges.ServiceConfig.RailpackProvider != nil {
@@ -395,6 +582,17 @@ func (self *DeploymentController) PopulateBuildEnvironment(ctx context.Context,
 		env["SERVICE_RUN_COMMAND"] = *runCommand
 	}
 
+	// Pre-deploy command always comes from the current config, since it belongs to the release rather than the build
+	if service.Edges.ServiceConfig.PreDeployCommand != nil {
+		env["SERVICE_PRE_DEPLOY_COMMAND"] = *service.Edges.ServiceConfig.PreDeployCommand
+	}
+
+	// The first deployment has nothing to run next to, so it's always rolled out in place
+	if service.Edges.ServiceConfig.RolloutStrategy != schema.RolloutStrategyRolling && service.CurrentDeploymentID != nil && service.Type != schema.ServiceTypeDatabase {
+		env["SERVICE_ROLLOUT_STRATEGY"] = string(service.Edges.ServiceConfig.RolloutStrategy)
+		env["SERVICE_CANARY_WEIGHT"] = strconv.Itoa(service.Edges.ServiceConfig.CanaryWeight)
+	}
+
 	if service.Edges.ServiceConfig.SecurityContext != nil {
 		// Marshal as string
 		marshalled, err := json.Marshal(service.Edges.ServiceConfig.SecurityContext.AsV1SecurityContext())
@@ -430,11 +628,53 @@ func (self *DeploymentController) PopulateBuildEnvironment(ctx context.Context,
 
 // EnqueueDeploymentJob adds a deployment to the queue
 func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req DeploymentJobRequest) (job *ent.Deployment, err error) {
+	deployAt := time.Now()
+	scheduled := req.ScheduledAt != nil && req.ScheduledAt.After(deployAt)
+	if scheduled {
+		deployAt = *req.ScheduledAt
+	}
+
+	environment, err := self.getEnvironment(ctx, req.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// New deployments to protected environments wait for an admin to approve them
+	// Until then they only supersede deployments that are still waiting for approval, not ones that were already approved
+	if !req.Approved && environment.Protected {
+		if err := self.cancelQueuedJobs(ctx, req.ServiceID, self.approvalQueue); err != nil {
+			return nil, fmt.Errorf("failed to cancel deployments awaiting approval: %w", err)
+		}
+		return self.enqueueForApproval(ctx, req)
+	}
+
+	// Freezes are checked every time, deployments released after approval or on schedule can run into one too
+	frozenUntil := schema.FrozenUntil(environment.FreezeWindows, deployAt)
+	if req.OverrideFreeze {
+		frozenUntil = nil
+	}
+
+	// Auto-deploys wait for the freeze to end, anything else has to override it explicitly
+	// Deployments that were already accepted, e.g. approved ones, are held instead of dropped
+	if frozenUntil != nil && req.ExistingJobID == nil && !waitsForFreeze(req.Source) {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeConflict, fmt.Sprintf("Deployments to %s are frozen until %s", environment.Name, frozenUntil.Format(time.RFC3339)))
+	}
+
+	// Scheduled deployments don't supersede anything until they're due, the freeze is checked again then
+	// Approved scheduled deployments also end up here
+	if scheduled {
+		return self.enqueueForSchedule(ctx, req)
+	}
+
 	// Cancel any existing queued jobs
 	if err := self.CancelExistingJobs(ctx, req.ServiceID); err != nil {
 		return nil, fmt.Errorf("failed to cancel existing jobs: %w", err)
 	}
 
+	if frozenUntil != nil {
+		return self.enqueueForFreeze(ctx, req)
+	}
+
 	// Create a record in the database
 	if req.ExistingJobID != nil {
 		// Verify the deployment exists
@@ -468,6 +708,7 @@ func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req
 		if err != nil {
 			return nil, fmt.Errorf("failed to create deployment record: %w", err)
 		}
+		self.recordSourceArchive(ctx, job.ID, req)
 	}
 
 	if req.DisableBuildCache {
@@ -496,6 +737,21 @@ func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req
 	// Add the referenced environment to the environment
 	req.Environment["ADDITIONAL_ENV"] = string(referencedEnvJSON)
 
+	// Keep the variable names around for diffing deployments, the values stay in the secret
+	envKeys := make([]string, 0, len(referencedEnv))
+	for k := range referencedEnv {
+		envKeys = append(envKeys, k)
+	}
+	var buildSecrets map[string]string
+	if err := json.Unmarshal([]byte(req.Environment["SERVICE_BUILD_SECRETS"]), &buildSecrets); err == nil {
+		for k := range buildSecrets {
+			envKeys = append(envKeys, k)
+		}
+	}
+	if _, err := self.repo.Deployment().SetEnvKeys(ctx, nil, job.ID, envKeys); err != nil {
+		log.Warn("Failed to record deployment variable names", "err", err, "deployment_id", job.ID)
+	}
+
 	// Get registry to use
 	registry, err := self.repo.System().GetDefaultRegistry(ctx)
 	if err != nil {
@@ -527,7 +783,8 @@ func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req
 	}
 
 	// Add to the queue
-	err = self.jobQueue.Enqueue(ctx, job.ID.String(), req)
+	req.Priority = self.GetQueuePriority(ctx, req)
+	err = self.jobQueue.EnqueueWithPriority(ctx, job.ID.String(), req, req.Priority)
 	if err != nil {
 		return nil, fmt.Errorf("failed to enqueue job: %w", err)
 	}
@@ -544,19 +801,9 @@ func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req
 			return
 		}
 
-		// Construct URL
-		basePath, _ := utils.JoinURLPaths(
-			self.cfg.ExternalUIUrl,
-			service.Edges.Environment.Edges.Project.Edges.Team.ID.String(),
-			"project",
-			service.Edges.Environment.Edges.Project.ID.String(),
-		)
-		url := basePath + "?environment=" + service.EnvironmentID.String() +
-			"&service=" + service.ID.String() +
-			"&deployment=" + job.ID.String()
 		data := webhooks_service.WebhookData{
 			Title: "Deployment Queued",
-			Url:   url,
+			Url:   self.deploymentURL(service, job.ID),
 			Fields: []webhooks_service.WebhookDataField{
 				{
 					Name:  "Service",
@@ -577,6 +824,253 @@ func (self *DeploymentController) EnqueueDeploymentJob(ctx context.Context, req
 	return job, nil
 }
 
+// getEnvironment gets the environment a service deploys to
+func (self *DeploymentController) getEnvironment(ctx context.Context, serviceID uuid.UUID) (*ent.Environment, error) {
+	service, err := self.repo.Service().GetByID(ctx, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	if service.Edges.Environment == nil {
+		return nil, fmt.Errorf("service %s has no environment", serviceID)
+	}
+
+	return service.Edges.Environment, nil
+}
+
+// enqueueForApproval creates the deployment as awaiting approval and holds the request until it's approved or rejected
+// Dependent and template deployments were already created as pending
+func (self *DeploymentController) enqueueForApproval(ctx context.Context, req DeploymentJobRequest) (*ent.Deployment, error) {
+	var job *ent.Deployment
+	var err error
+	if req.ExistingJobID == nil {
+		job, err = self.repo.Deployment().Create(
+			ctx,
+			nil,
+			req.ServiceID,
+			req.CommitSHA,
+			req.CommitMessage,
+			req.GitBranch,
+			req.Committer,
+			req.Source,
+			schema.DeploymentStatusAwaitingApproval,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create deployment record: %w", err)
+		}
+		self.recordSourceArchive(ctx, job.ID, req)
+		req.ExistingJobID = utils.ToPtr(job.ID)
+	} else {
+		job, err = self.repo.Deployment().MarkAwaitingApproval(ctx, nil, *req.ExistingJobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark deployment as awaiting approval: %w", err)
+		}
+	}
+
+	if err := self.approvalQueue.Enqueue(ctx, job.ID.String(), req); err != nil {
+		return nil, self.failWithErr(ctx, "Error holding deployment for approval", job.ID, err)
+	}
+
+	go self.triggerApprovalWebhook(req.ServiceID, job.ID, schema.WebhookEventDeploymentApprovalRequested)
+
+	return job, nil
+}
+
+// recordSourceArchive keeps the uploaded archive a deployment builds from, so redeploying it rebuilds the same source
+func (self *DeploymentController) recordSourceArchive(ctx context.Context, jobID uuid.UUID, req DeploymentJobRequest) {
+	key := req.Environment["SOURCE_ARCHIVE_KEY"]
+	if key == "" {
+		return
+	}
+	if _, err := self.repo.Deployment().SetSourceArchive(ctx, nil, jobID, key); err != nil {
+		log.Warn("Failed to record deployment source archive", "err", err, "deployment_id", jobID)
+	}
+}
+
+// waitsForFreeze reports whether deployments from the source are held until a freeze ends, rather than refused
+func waitsForFreeze(source schema.DeploymentSource) bool {
+	return source == schema.DeploymentSourceGit || source == schema.DeploymentSourceDeployHook || source == schema.DeploymentSourceImageUpdate
+}
+
+// enqueueForFreeze marks the deployment as pending and holds the request until the freeze ends
+func (self *DeploymentController) enqueueForFreeze(ctx context.Context, req DeploymentJobRequest) (*ent.Deployment, error) {
+	var job *ent.Deployment
+	var err error
+	if req.ExistingJobID == nil {
+		job, err = self.repo.Deployment().Create(
+			ctx,
+			nil,
+			req.ServiceID,
+			req.CommitSHA,
+			req.CommitMessage,
+			req.GitBranch,
+			req.Committer,
+			req.Source,
+			schema.DeploymentStatusBuildPending,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create deployment record: %w", err)
+		}
+		self.recordSourceArchive(ctx, job.ID, req)
+		req.ExistingJobID = utils.ToPtr(job.ID)
+	} else {
+		job, err = self.repo.Deployment().MarkPending(ctx, nil, *req.ExistingJobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark deployment as pending: %w", err)
+		}
+	}
+
+	if err := self.freezeQueue.Enqueue(ctx, job.ID.String(), req); err != nil {
+		return nil, self.failWithErr(ctx, "Error holding deployment during freeze", job.ID, err)
+	}
+
+	return job, nil
+}
+
+// enqueueForSchedule marks the deployment as scheduled and holds the request until it's due
+func (self *DeploymentController) enqueueForSchedule(ctx context.Context, req DeploymentJobRequest) (*ent.Deployment, error) {
+	if req.ExistingJobID == nil {
+		job, err := self.repo.Deployment().Create(
+			ctx,
+			nil,
+			req.ServiceID,
+			req.CommitSHA,
+			req.CommitMessage,
+			req.GitBranch,
+			req.Committer,
+			req.Source,
+			schema.DeploymentStatusScheduled,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create deployment record: %w", err)
+		}
+		self.recordSourceArchive(ctx, job.ID, req)
+		req.ExistingJobID = utils.ToPtr(job.ID)
+	}
+
+	job, err := self.repo.Deployment().MarkScheduled(ctx, nil, *req.ExistingJobID, *req.ScheduledAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark deployment as scheduled: %w", err)
+	}
+
+	if err := self.scheduledQueue.Enqueue(ctx, job.ID.String(), req); err != nil {
+		return nil, self.failWithErr(ctx, "Error holding scheduled deployment", job.ID, err)
+	}
+
+	return job, nil
+}
+
+// ReleaseScheduledDeployments enqueues scheduled deployments that are due
+func (self *DeploymentController) ReleaseScheduledDeployments(ctx context.Context) error {
+	heldJobs, err := self.scheduledQueue.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get jobs from scheduled queue: %w", err)
+	}
+
+	now := time.Now()
+	for _, item := range heldJobs {
+		if item.Data.ScheduledAt != nil && item.Data.ScheduledAt.After(now) {
+			continue
+		}
+
+		// Cancelled or released since we listed it
+		if err := self.scheduledQueue.Remove(ctx, item.ID); err != nil {
+			continue
+		}
+
+		if _, err := self.EnqueueDeploymentJob(ctx, item.Data); err != nil {
+			log.Error("Failed to enqueue scheduled deployment", "err", err, "deploymentID", item.ID)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseFrozenDeployments enqueues held deployments whose environment is no longer frozen
+func (self *DeploymentController) ReleaseFrozenDeployments(ctx context.Context) error {
+	heldJobs, err := self.freezeQueue.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get jobs from freeze queue: %w", err)
+	}
+
+	for _, item := range heldJobs {
+		environment, err := self.getEnvironment(ctx, item.Data.ServiceID)
+		if err != nil {
+			log.Error("Failed to get environment for frozen deployment", "err", err, "deploymentID", item.ID)
+			continue
+		}
+
+		if schema.FrozenUntil(environment.FreezeWindows, time.Now()) != nil {
+			continue
+		}
+
+		// Cancelled or released since we listed it
+		if err := self.freezeQueue.Remove(ctx, item.ID); err != nil {
+			continue
+		}
+
+		if _, err := self.EnqueueDeploymentJob(ctx, item.Data); err != nil {
+			log.Error("Failed to enqueue deployment after freeze", "err", err, "deploymentID", item.ID)
+		}
+	}
+
+	return nil
+}
+
+// ApproveDeployment releases a deployment that is awaiting approval onto the build queue
+func (self *DeploymentController) ApproveDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error) {
+	heldJobs, err := self.approvalQueue.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs from approval queue: %w", err)
+	}
+
+	var req *DeploymentJobRequest
+	for _, item := range heldJobs {
+		if item.ID == deployment.ID.String() {
+			req = &item.Data
+			break
+		}
+	}
+
+	// Remove fails if someone else already approved or rejected it
+	if req == nil || self.approvalQueue.Remove(ctx, deployment.ID.String()) != nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeConflict, "deployment is no longer awaiting approval")
+	}
+
+	req.Approved = true
+	job, err := self.EnqueueDeploymentJob(ctx, *req)
+	if err != nil {
+		return nil, err
+	}
+
+	go self.triggerApprovalWebhook(deployment.ServiceID, deployment.ID, schema.WebhookEventDeploymentApproved)
+
+	return job, nil
+}
+
+// GetQueuePriority ranks builds in the project's default (production) environment first, then manual deploys
+func (self *DeploymentController) GetQueuePriority(ctx context.Context, req DeploymentJobRequest) int {
+	priority := 0
+	if req.Source == schema.DeploymentSourceManual {
+		priority += PRIORITY_MANUAL
+	}
+
+	service, err := self.repo.Service().GetByID(ctx, req.ServiceID)
+	if err != nil {
+		log.Warnf("Failed to get service for queue priority: %v service: %s", err, req.ServiceID)
+		return priority
+	}
+
+	if service.Edges.Environment != nil && service.Edges.Environment.Edges.Project != nil {
+		project := service.Edges.Environment.Edges.Project
+		if project.DefaultEnvironmentID != nil && *project.DefaultEnvironmentID == service.EnvironmentID {
+			priority += PRIORITY_PRODUCTION
+		}
+	}
+
+	return priority
+}
+
 func (self *DeploymentController) failWithErr(ctx context.Context, msg string, deploymentID uuid.UUID, err error) error {
 	log.Error(msg, "err", err)
 	if _, failErr := self.repo.Deployment().MarkFailed(ctx, nil, deploymentID, err.Error(), time.Now()); failErr != nil {
@@ -585,89 +1079,253 @@ func (self *DeploymentController) failWithErr(ctx context.Context, msg string, d
 	return err
 }
 
+// giveUpJob fails a deployment the queue stopped retrying, otherwise it would stay queued
+func (self *DeploymentController) giveUpJob(ctx context.Context, item *queue.QueueItem[DeploymentJobRequest], err error) {
+	jobID, _ := uuid.Parse(item.ID)
+	self.failWithErr(ctx, "Giving up on deployment job", jobID, err)
+}
+
 // cancelExistingJobs marks all pending jobs for a service as cancelled in the DB
 // and removes them from the queue
 func (self *DeploymentController) CancelExistingJobs(ctx context.Context, serviceID uuid.UUID) error {
-	// 1. Get all queued jobs for this service from the queue
-	queuedJobs, err := self.jobQueue.GetAll(ctx)
+	// A newer deployment also supersedes any that are still waiting for approval or a freeze to end
+	return self.cancelQueuedJobs(ctx, serviceID, self.jobQueue, self.dependentQueue, self.approvalQueue, self.freezeQueue)
+}
+
+// cancelQueuedJobs removes the service's jobs from the given queues and marks them as cancelled
+func (self *DeploymentController) cancelQueuedJobs(ctx context.Context, serviceID uuid.UUID, queues ...*queue.Queue[DeploymentJobRequest]) error {
+	// Keep track of job IDs to mark as cancelled
+	var jobIDsToCancel []uuid.UUID
+
+	// 1. Remove matching jobs from the queues
+	for _, q := range queues {
+		queuedJobs, err := q.GetAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get jobs from queue: %w", err)
+		}
+		for _, item := range queuedJobs {
+			if item.Data.ServiceID == serviceID {
+				// Remove from queue
+				if err := q.Remove(ctx, item.ID); err != nil {
+					log.Errorf("Failed to remove job %s from queue: %v", item.ID, err)
+				}
+				idParsed, _ := uuid.Parse(item.ID)
+				jobIDsToCancel = append(jobIDsToCancel, idParsed)
+			}
+		}
+	}
+
+	// 2. Mark the jobs as cancelled in the database
+	if len(jobIDsToCancel) > 0 {
+		if err := self.repo.Deployment().MarkAsCancelled(ctx, jobIDsToCancel); err != nil {
+			return fmt.Errorf("failed to mark jobs as cancelled: %w", err)
+		}
+	}
+
+	// Trigger webhooks
+	for _, jobID := range jobIDsToCancel {
+		go self.triggerCancelledWebhook(serviceID, jobID)
+	}
+
+	return nil
+}
+
+// AbortStagedRollouts tears down the candidates of the service's staged blue-green or canary deployments, once a newer deployment supersedes them
+// Otherwise a candidate keeps running and taking its share of traffic, nothing but another candidate would replace it
+func (self *DeploymentController) AbortStagedRollouts(ctx context.Context, serviceID uuid.UUID, supersededBy uuid.UUID) error {
+	staged, err := self.repo.Deployment().GetStagedByServiceID(ctx, serviceID)
 	if err != nil {
-		return fmt.Errorf("failed to get jobs from queue: %w", err)
+		return fmt.Errorf("failed to get staged deployments: %w", err)
 	}
-	queuedDependentJobs, err := self.dependentQueue.GetAll(ctx)
+	if len(staged) == 0 {
+		return nil
+	}
+
+	namespace, err := self.repo.Service().GetDeploymentNamespace(ctx, serviceID)
 	if err != nil {
-		return fmt.Errorf("failed to get dependent jobs from queue: %w", err)
+		return fmt.Errorf("failed to get deployment namespace: %w", err)
 	}
 
-	// Keep track of job IDs to mark as cancelled
-	var jobIDsToCancel []uuid.UUID
+	for _, deployment := range staged {
+		if deployment.ID == supersededBy || deployment.ResourceDefinition == nil {
+			continue
+		}
+
+		if err := self.k8s.DeleteRolloutCandidate(ctx, namespace, deployment.ResourceDefinition.Name); err != nil {
+			return fmt.Errorf("failed to delete rollout candidate: %w", err)
+		}
 
-	// 2. Remove matching jobs from the queue
+		if _, err := self.repo.Deployment().MarkAborted(ctx, nil, deployment.ID, fmt.Sprintf("Superseded by deployment %s", supersededBy)); err != nil {
+			return fmt.Errorf("failed to mark staged deployment as aborted: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CancelDeployment cancels a single deployment, removing it from the queues or stopping its builder job
+func (self *DeploymentController) CancelDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error) {
+	// Remove from the build queue if it hasn't been picked up yet
+	queuedJobs, err := self.jobQueue.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs from queue: %w", err)
+	}
 	for _, item := range queuedJobs {
-		if item.Data.ServiceID == serviceID {
-			// Remove from queue
+		if item.ID == deployment.ID.String() {
 			if err := self.jobQueue.Remove(ctx, item.ID); err != nil {
 				log.Errorf("Failed to remove job %s from queue: %v", item.ID, err)
 			}
-			idParsed, _ := uuid.Parse(item.ID)
-			jobIDsToCancel = append(jobIDsToCancel, idParsed)
 		}
 	}
+
+	// Dependent queue items are keyed separately, match on the pending deployment instead
+	queuedDependentJobs, err := self.dependentQueue.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependent jobs from queue: %w", err)
+	}
 	for _, item := range queuedDependentJobs {
-		if item.Data.ServiceID == serviceID {
-			// Remove from queue
+		if item.Data.ExistingJobID != nil && *item.Data.ExistingJobID == deployment.ID {
 			if err := self.dependentQueue.Remove(ctx, item.ID); err != nil {
 				log.Errorf("Failed to remove job %s from queue: %v", item.ID, err)
 			}
-			idParsed, _ := uuid.Parse(item.ID)
-			jobIDsToCancel = append(jobIDsToCancel, idParsed)
 		}
 	}
 
-	// 3. Mark the jobs as cancelled in the database
-	if len(jobIDsToCancel) > 0 {
-		if err := self.repo.Deployment().MarkAsCancelled(ctx, jobIDsToCancel); err != nil {
-			return fmt.Errorf("failed to mark jobs as cancelled: %w", err)
+	// Rejecting a deployment that is awaiting approval, or dropping one held by a freeze or schedule
+	for _, heldQueue := range []*queue.Queue[DeploymentJobRequest]{self.approvalQueue, self.freezeQueue, self.scheduledQueue} {
+		heldJobs, err := heldQueue.GetAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get held jobs: %w", err)
+		}
+		for _, item := range heldJobs {
+			if item.ID == deployment.ID.String() {
+				if err := heldQueue.Remove(ctx, item.ID); err != nil {
+					log.Errorf("Failed to remove job %s from queue: %v", item.ID, err)
+				}
+			}
 		}
 	}
 
-	// Trigger webhooks
-	for _, jobID := range jobIDsToCancel {
-		// Trigger webhook
-		go func() {
-			event := schema.WebhookEventDeploymentCancelled
-			level := webhooks_service.WebhookLevelWarning
+	// Mark cancelled before stopping the builder, so the status synchronizer doesn't record it as failed
+	cancelled, err := self.repo.Deployment().MarkCancelled(ctx, nil, deployment.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeConflict, "deployment is no longer in progress")
+		}
+		return nil, fmt.Errorf("failed to mark deployment as cancelled: %w", err)
+	}
 
-			// Get service with edges
-			service, err := self.repo.Service().GetByID(context.Background(), serviceID)
-			if err != nil {
-				log.Errorf("Failed to get service %s: %v", serviceID.String(), err)
-				return
-			}
+	// Stop the builder job, the status we were given may predate the queue starting it
+	if err := self.k8s.CancelDeploymentJob(ctx, deployment.ID.String()); err != nil {
+		log.Errorf("Failed to cancel builder job for deployment %s: %v", deployment.ID, err)
+	}
 
-			// Construct URL
-			url, _ := utils.JoinURLPaths(self.cfg.ExternalUIUrl, service.Edges.Environment.Edges.Project.Edges.Team.ID.String(), "project", service.Edges.Environment.Edges.Project.ID.String(), "?environment="+service.EnvironmentID.String(), "&service="+service.ID.String(), "&deployment="+jobID.String())
-			data := webhooks_service.WebhookData{
-				Title: "Deployment Cancelled",
-				Url:   url,
-				Fields: []webhooks_service.WebhookDataField{
-					{
-						Name:  "Service",
-						Value: service.Name,
-					},
-					{
-						Name:  "Project & Environment",
-						Value: fmt.Sprintf("%s > %s", service.Edges.Environment.Edges.Project.Name, service.Edges.Environment.Name),
-					},
-				},
-			}
+	go self.triggerCancelledWebhook(deployment.ServiceID, deployment.ID)
 
-			if err := self.webhookService.TriggerWebhooks(context.Background(), level, event, data); err != nil {
-				log.Errorf("Failed to trigger webhook %s: %v", event, err)
-			}
-		}()
+	return cancelled, nil
+}
+
+// triggerCancelledWebhook notifies webhooks that a deployment was cancelled
+func (self *DeploymentController) triggerCancelledWebhook(serviceID uuid.UUID, deploymentID uuid.UUID) {
+	event := schema.WebhookEventDeploymentCancelled
+	level := webhooks_service.WebhookLevelWarning
+
+	// Get service with edges
+	service, err := self.repo.Service().GetByID(context.Background(), serviceID)
+	if err != nil {
+		log.Errorf("Failed to get service %s: %v", serviceID.String(), err)
+		return
 	}
 
-	return nil
+	// Construct URL
+	url, _ := utils.JoinURLPaths(self.cfg.ExternalUIUrl, service.Edges.Environment.Edges.Project.Edges.Team.ID.String(), "project", service.Edges.Environment.Edges.Project.ID.String(), "?environment="+service.EnvironmentID.String(), "&service="+service.ID.String(), "&deployment="+deploymentID.String())
+	data := webhooks_service.WebhookData{
+		Title: "Deployment Cancelled",
+		Url:   url,
+		Fields: []webhooks_service.WebhookDataField{
+			{
+				Name:  "Service",
+				Value: service.Name,
+			},
+			{
+				Name:  "Project & Environment",
+				Value: fmt.Sprintf("%s > %s", service.Edges.Environment.Edges.Project.Name, service.Edges.Environment.Name),
+			},
+		},
+	}
+
+	if err := self.webhookService.TriggerWebhooks(context.Background(), level, event, data); err != nil {
+		log.Errorf("Failed to trigger webhook %s: %v", event, err)
+	}
+}
+
+// triggerApprovalWebhook notifies webhooks that a deployment needs approval, or was approved
+func (self *DeploymentController) triggerApprovalWebhook(serviceID uuid.UUID, deploymentID uuid.UUID, event schema.WebhookEvent) {
+	level := webhooks_service.WebhookLevelInfo
+	title := "Deployment Awaiting Approval"
+	if event == schema.WebhookEventDeploymentApproved {
+		level = webhooks_service.WebhookLevelDeploymentQueued
+		title = "Deployment Approved"
+	}
+
+	// Get service with edges
+	service, err := self.repo.Service().GetByID(context.Background(), serviceID)
+	if err != nil {
+		log.Errorf("Failed to get service %s: %v", serviceID.String(), err)
+		return
+	}
+
+	// Construct URL
+	url, _ := utils.JoinURLPaths(self.cfg.ExternalUIUrl, service.Edges.Environment.Edges.Project.Edges.Team.ID.String(), "project", service.Edges.Environment.Edges.Project.ID.String(), "?environment="+service.EnvironmentID.String(), "&service="+service.ID.String(), "&deployment="+deploymentID.String())
+	data := webhooks_service.WebhookData{
+		Title: title,
+		Url:   url,
+		Fields: []webhooks_service.WebhookDataField{
+			{
+				Name:  "Service",
+				Value: service.Name,
+			},
+			{
+				Name:  "Project & Environment",
+				Value: fmt.Sprintf("%s > %s", service.Edges.Environment.Edges.Project.Name, service.Edges.Environment.Name),
+			},
+		},
+	}
+
+	if err := self.webhookService.TriggerWebhooks(context.Background(), level, event, data); err != nil {
+		log.Errorf("Failed to trigger webhook %s: %v", event, err)
+	}
+}
+
+// TriggerRolledBackWebhook notifies webhooks that an unhealthy deployment was automatically rolled back
+func (self *DeploymentController) TriggerRolledBackWebhook(ctx context.Context, service *ent.Service, rollbackDeploymentID uuid.UUID, reason string) {
+	event := schema.WebhookEventDeploymentRolledBack
+	level := webhooks_service.WebhookLevelWarning
+
+	// Construct URL
+	url, _ := utils.JoinURLPaths(self.cfg.ExternalUIUrl, service.Edges.Environment.Edges.Project.Edges.Team.ID.String(), "project", service.Edges.Environment.Edges.Project.ID.String(), "?environment="+service.EnvironmentID.String(), "&service="+service.ID.String(), "&deployment="+rollbackDeploymentID.String())
+	data := webhooks_service.WebhookData{
+		Title: "Deployment Rolled Back",
+		Url:   url,
+		Fields: []webhooks_service.WebhookDataField{
+			{
+				Name:  "Service",
+				Value: service.Name,
+			},
+			{
+				Name:  "Project & Environment",
+				Value: fmt.Sprintf("%s > %s", service.Edges.Environment.Edges.Project.Name, service.Edges.Environment.Name),
+			},
+			{
+				Name:  "Reason",
+				Value: reason,
+			},
+		},
+	}
+
+	if err := self.webhookService.TriggerWebhooks(ctx, level, event, data); err != nil {
+		log.Errorf("Failed to trigger webhook %s: %v", event, err)
+	}
 }
 
 // processJob processes a job from the queue
@@ -675,6 +1333,11 @@ func (self *DeploymentController) processJob(ctx context.Context, item *queue.Qu
 	jobID, _ := uuid.Parse(item.ID)
 	req := item.Data
 
+	// Staged rollouts are superseded by the new build, their candidates have to go before they're marked cancelled
+	if err := self.AbortStagedRollouts(ctx, req.ServiceID, jobID); err != nil {
+		log.Warnf("Failed to abort staged rollouts: %v service: %s", err, req.ServiceID)
+	}
+
 	// Update the job status in the database
 	err := self.repo.Deployment().MarkCancelledExcept(ctx, req.ServiceID, jobID)
 	if err != nil {
@@ -690,6 +1353,11 @@ func (self *DeploymentController) processJob(ctx context.Context, item *queue.Qu
 	_, err = self.repo.Deployment().MarkStarted(ctx, nil, jobID, time.Now())
 
 	if err != nil {
+		if ent.IsNotFound(err) {
+			// Cancelled while it was waiting in the queue
+			log.Infof("Skipping cancelled deployment %s", jobID)
+			return nil
+		}
 		return fmt.Errorf("failed to mark job started: %w", err)
 	}
 
@@ -703,8 +1371,20 @@ func (self *DeploymentController) processJob(ctx context.Context, item *queue.Qu
 
 		if dbErr != nil {
 			log.Error("Failed to update job failure status", "err", dbErr)
+			return err
 		}
-		return err
+		// Failure is recorded on the deployment, don't retry it
+		return nil
+	}
+
+	// Cancelled while the job was being created, CancelDeployment had no job to stop yet
+	current, err := self.repo.Deployment().GetByID(ctx, jobID)
+	if err == nil && current.Status == schema.DeploymentStatusBuildCancelled {
+		log.Infof("Stopping cancelled deployment %s", jobID)
+		if err := self.k8s.CancelDeploymentJob(ctx, jobID.String()); err != nil {
+			log.Error("Failed to cancel builder job", "err", err, "jobID", jobID)
+		}
+		return nil
 	}
 
 	// Update the Kubernetes job name in the database
@@ -759,7 +1439,7 @@ func (self *DeploymentController) processDependentJob(ctx context.Context, item
 	// Check if dependencies are ready
 	if !self.AreDependenciesReady(ctx, item.Data) {
 		// If dependencies aren't ready, put the job back in the queue
-		return self.dependentQueue.Enqueue(ctx, item.ID, item.Data)
+		return self.dependentQueue.EnqueueWithPriority(ctx, item.ID, item.Data, item.Priority)
 	}
 
 	// If dependencies are ready, enqueue to the real deployment queue
@@ -828,6 +1508,24 @@ func (self *DeploymentController) EnqueueDependentDeployment(ctx context.Context
 		return nil, fmt.Errorf("failed to create dependent deployment record: %w", err)
 	}
 	req.ExistingJobID = utils.ToPtr(job.ID)
+	req.Priority = self.GetQueuePriority(ctx, req)
 	// Add to the dependent queue
-	return job, self.dependentQueue.Enqueue(ctx, uuid.New().String(), req)
+	return job, self.dependentQueue.EnqueueWithPriority(ctx, uuid.New().String(), req, req.Priority)
+}
+
+// buildGitRef returns the full ref to build, the tag if one is given otherwise the service's branch
+func buildGitRef(service *ent.Service, gitTag *string) string {
+	if gitTag != nil {
+		if !strings.HasPrefix(*gitTag, "refs/tags/") {
+			*gitTag = "refs/tags/" + *gitTag
+		}
+		return *gitTag
+	}
+
+	ref := *service.Edges.ServiceConfig.GitBranch
+	// Branch names, or full refs like a pull request's head
+	if !strings.HasPrefix(ref, "refs/") {
+		ref = "refs/heads/" + ref
+	}
+	return ref
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/queue"
 )
 
 // DeploymentControllerInterface ...
@@ -15,14 +16,31 @@ type DeploymentControllerInterface interface {
 	StartAsync()
 	// Stop stops the deployment manager
 	Stop()
+	// GetBuildConcurrencyLimits reads the build concurrency limits from system settings, falling back to the queue default
+	GetBuildConcurrencyLimits(ctx context.Context) (queue.ConcurrencyLimits, error)
 	// Populate build environment, take tag separately so we can use it to build from tag
 	// If deployment is provided, use stored deployment values for build configuration instead of service config values
 	PopulateBuildEnvironment(ctx context.Context, serviceID uuid.UUID, gitTag *string, deployment *ent.Deployment) (map[string]string, error)
 	// EnqueueDeploymentJob adds a deployment to the queue
 	EnqueueDeploymentJob(ctx context.Context, req DeploymentJobRequest) (job *ent.Deployment, err error)
+	// ReleaseScheduledDeployments enqueues scheduled deployments that are due
+	ReleaseScheduledDeployments(ctx context.Context) error
+	// ReleaseFrozenDeployments enqueues held deployments whose environment is no longer frozen
+	ReleaseFrozenDeployments(ctx context.Context) error
+	// ApproveDeployment releases a deployment that is awaiting approval onto the build queue
+	ApproveDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error)
+	// GetQueuePriority ranks builds in the project's default (production) environment first, then manual deploys
+	GetQueuePriority(ctx context.Context, req DeploymentJobRequest) int
 	// cancelExistingJobs marks all pending jobs for a service as cancelled in the DB
 	// and removes them from the queue
 	CancelExistingJobs(ctx context.Context, serviceID uuid.UUID) error
+	// AbortStagedRollouts tears down the candidates of the service's staged blue-green or canary deployments, once a newer deployment supersedes them
+	// Otherwise a candidate keeps running and taking its share of traffic, nothing but another candidate would replace it
+	AbortStagedRollouts(ctx context.Context, serviceID uuid.UUID, supersededBy uuid.UUID) error
+	// CancelDeployment cancels a single deployment, removing it from the queues or stopping its builder job
+	CancelDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error)
+	// TriggerRolledBackWebhook notifies webhooks that an unhealthy deployment was automatically rolled back
+	TriggerRolledBackWebhook(ctx context.Context, service *ent.Service, rollbackDeploymentID uuid.UUID, reason string)
 	// SyncJobStatuses synchronizes the status of all processing jobs with Kubernetes
 	SyncJobStatuses(ctx context.Context) error
 	// AreDependenciesReady checks if all dependencies for a service are ready
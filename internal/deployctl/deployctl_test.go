@@ -2,6 +2,8 @@ package deployctl
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,14 +14,24 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/unbindapp/unbind-api/config"
+	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/queue"
+	"github.com/unbindapp/unbind-api/internal/integrations/github"
 	k8s_mocks "github.com/unbindapp/unbind-api/mocks/infrastructure/k8s"
 	github_mocks "github.com/unbindapp/unbind-api/mocks/integrations/github"
+	gitlab_mocks "github.com/unbindapp/unbind-api/mocks/integrations/gitlab"
 	repo_mocks "github.com/unbindapp/unbind-api/mocks/repositories"
+	deployment_mocks "github.com/unbindapp/unbind-api/mocks/repository/deployment"
 	service_mocks "github.com/unbindapp/unbind-api/mocks/repository/service"
+	system_mocks "github.com/unbindapp/unbind-api/mocks/repository/system"
 	variables_mocks "github.com/unbindapp/unbind-api/mocks/services/variables"
 	webhooks_mocks "github.com/unbindapp/unbind-api/mocks/services/webhooks"
+	v1 "github.com/unbindapp/unbind-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Test suite for DeploymentController
@@ -32,6 +44,7 @@ type DeploymentControllerTestSuite struct {
 	redisClient          *redis.Client
 	k8sMock              *k8s_mocks.KubeClientMock
 	githubMock           *github_mocks.GithubClientMock
+	gitlabMock           *gitlab_mocks.GitlabClientMock
 	repoMock             *repo_mocks.RepositoriesMock
 	webhooksMock         *webhooks_mocks.WebhooksServiceMock
 	variablesMock        *variables_mocks.VariablesServiceMock
@@ -60,6 +73,7 @@ func (suite *DeploymentControllerTestSuite) SetupTest() {
 	// Setup mocks
 	suite.k8sMock = &k8s_mocks.KubeClientMock{}
 	suite.githubMock = &github_mocks.GithubClientMock{}
+	suite.gitlabMock = &gitlab_mocks.GitlabClientMock{}
 	suite.repoMock = &repo_mocks.RepositoriesMock{}
 	suite.webhooksMock = &webhooks_mocks.WebhooksServiceMock{}
 	suite.variablesMock = &variables_mocks.VariablesServiceMock{}
@@ -73,6 +87,7 @@ func (suite *DeploymentControllerTestSuite) SetupTest() {
 		suite.redisClient,
 		suite.repoMock,
 		suite.githubMock,
+		suite.gitlabMock,
 		suite.webhooksMock,
 		suite.variablesMock,
 	)
@@ -91,6 +106,7 @@ func (suite *DeploymentControllerTestSuite) TestNewDeploymentController() {
 	assert.Equal(suite.T(), suite.k8sMock, suite.deploymentController.k8s)
 	assert.NotNil(suite.T(), suite.deploymentController.jobQueue)
 	assert.NotNil(suite.T(), suite.deploymentController.dependentQueue)
+	assert.NotNil(suite.T(), suite.deploymentController.approvalQueue)
 }
 
 func (suite *DeploymentControllerTestSuite) TestAreDependenciesReady_NoDependencies() {
@@ -288,6 +304,720 @@ func (suite *DeploymentControllerTestSuite) TestJobQueueOperations() {
 	suite.Assert().Len(depJobs, 0)
 }
 
+func (suite *DeploymentControllerTestSuite) TestGetQueuePriority() {
+	environmentID := uuid.New()
+	otherEnvironmentID := uuid.New()
+	serviceID := uuid.New()
+
+	serviceInEnvironment := func(envID uuid.UUID) *ent.Service {
+		return &ent.Service{
+			ID:            serviceID,
+			EnvironmentID: envID,
+			Edges: ent.ServiceEdges{
+				Environment: &ent.Environment{
+					ID: envID,
+					Edges: ent.EnvironmentEdges{
+						Project: &ent.Project{DefaultEnvironmentID: &environmentID},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		envID    uuid.UUID
+		source   schema.DeploymentSource
+		expected int
+	}{
+		{"production manual", environmentID, schema.DeploymentSourceManual, PRIORITY_PRODUCTION + PRIORITY_MANUAL},
+		{"production git", environmentID, schema.DeploymentSourceGit, PRIORITY_PRODUCTION},
+		{"other manual", otherEnvironmentID, schema.DeploymentSourceManual, PRIORITY_MANUAL},
+		{"other git", otherEnvironmentID, schema.DeploymentSourceGit, 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+			serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(serviceInEnvironment(tt.envID), nil)
+			repoMock := repo_mocks.NewRepositoriesMock(suite.T())
+			repoMock.EXPECT().Service().Return(serviceMock)
+			suite.deploymentController.repo = repoMock
+
+			priority := suite.deploymentController.GetQueuePriority(suite.ctx, DeploymentJobRequest{
+				ServiceID: serviceID,
+				Source:    tt.source,
+			})
+			suite.Assert().Equal(tt.expected, priority)
+		})
+	}
+}
+
+func (suite *DeploymentControllerTestSuite) TestGetQueuePriority_ServiceLookupFails() {
+	serviceID := uuid.New()
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	priority := suite.deploymentController.GetQueuePriority(suite.ctx, DeploymentJobRequest{
+		ServiceID: serviceID,
+		Source:    schema.DeploymentSourceManual,
+	})
+	suite.Assert().Equal(PRIORITY_MANUAL, priority)
+}
+
+func (suite *DeploymentControllerTestSuite) TestGetBuildConcurrencyLimits_Defaults() {
+	systemMock := system_mocks.NewSystemRepositoryMock(suite.T())
+	systemMock.EXPECT().GetSystemSettings(mock.Anything, mock.Anything).Return(nil, &ent.NotFoundError{})
+	suite.repoMock.EXPECT().System().Return(systemMock)
+
+	limits, err := suite.deploymentController.GetBuildConcurrencyLimits(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(queue.QUEUE_CONCURRENCY, limits.Global)
+	suite.Assert().Equal(0, limits.PerGroup)
+}
+
+func (suite *DeploymentControllerTestSuite) TestGetBuildConcurrencyLimits_FromSettings() {
+	systemMock := system_mocks.NewSystemRepositoryMock(suite.T())
+	systemMock.EXPECT().GetSystemSettings(mock.Anything, mock.Anything).Return(&ent.SystemSetting{
+		BuildSettings: &schema.BuildSettings{
+			MaxConcurrentBuilds:        6,
+			MaxConcurrentBuildsPerTeam: 2,
+		},
+	}, nil)
+	suite.repoMock.EXPECT().System().Return(systemMock)
+
+	limits, err := suite.deploymentController.GetBuildConcurrencyLimits(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(6, limits.Global)
+	suite.Assert().Equal(2, limits.PerGroup)
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_Queued() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	dependentDeploymentID := uuid.New()
+	queued := &ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusBuildQueued}
+
+	err := suite.deploymentController.jobQueue.Enqueue(suite.ctx, deploymentID.String(), DeploymentJobRequest{ServiceID: serviceID})
+	suite.Require().NoError(err)
+	err = suite.deploymentController.dependentQueue.Enqueue(suite.ctx, uuid.New().String(), DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &dependentDeploymentID,
+	})
+	suite.Require().NoError(err)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(&ent.Deployment{
+		ID:        deploymentID,
+		ServiceID: serviceID,
+		Status:    schema.DeploymentStatusBuildCancelled,
+	}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	// Webhook is triggered asynchronously
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	cancelled, err := suite.deploymentController.CancelDeployment(suite.ctx, queued)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(schema.DeploymentStatusBuildCancelled, cancelled.Status)
+
+	// Only the cancelled deployment is removed
+	mainJobs, err := suite.deploymentController.jobQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(mainJobs, 0)
+
+	depJobs, err := suite.deploymentController.dependentQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(depJobs, 1)
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_Dependent() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	pending := &ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusBuildPending}
+
+	err := suite.deploymentController.dependentQueue.Enqueue(suite.ctx, uuid.New().String(), DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &deploymentID,
+	})
+	suite.Require().NoError(err)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(&ent.Deployment{ID: deploymentID}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	_, err = suite.deploymentController.CancelDeployment(suite.ctx, pending)
+	suite.Require().NoError(err)
+
+	depJobs, err := suite.deploymentController.dependentQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(depJobs, 0)
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_Running() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	running := &ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusBuildRunning}
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(&ent.Deployment{ID: deploymentID}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	_, err := suite.deploymentController.CancelDeployment(suite.ctx, running)
+	suite.Require().NoError(err)
+	suite.k8sMock.AssertExpectations(suite.T())
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_Dequeued() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	// Picked up by the queue processor, but not marked started yet
+	queued := &ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusBuildQueued}
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(&ent.Deployment{ID: deploymentID}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	_, err := suite.deploymentController.CancelDeployment(suite.ctx, queued)
+	suite.Require().NoError(err)
+	suite.k8sMock.AssertExpectations(suite.T())
+}
+
+func (suite *DeploymentControllerTestSuite) TestProcessJob_CancelledWhileStarting() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().GetStagedByServiceID(mock.Anything, serviceID).Return(nil, nil)
+	deploymentMock.EXPECT().MarkCancelledExcept(mock.Anything, serviceID, deploymentID).Return(nil)
+	deploymentMock.EXPECT().MarkStarted(mock.Anything, mock.Anything, deploymentID, mock.Anything).
+		Return(&ent.Deployment{ID: deploymentID, Status: schema.DeploymentStatusBuildRunning}, nil)
+	deploymentMock.EXPECT().GetByID(mock.Anything, deploymentID).
+		Return(&ent.Deployment{ID: deploymentID, Status: schema.DeploymentStatusBuildCancelled}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	suite.k8sMock.EXPECT().CancelJobsByServiceID(mock.Anything, serviceID.String()).Return(nil)
+	suite.k8sMock.EXPECT().CreateDeployment(mock.Anything, deploymentID.String(), mock.Anything).Return("builder-job", nil)
+	// The job that was just created is stopped again
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	err := suite.deploymentController.processJob(suite.ctx, &queue.QueueItem[DeploymentJobRequest]{
+		ID:   deploymentID.String(),
+		Data: DeploymentJobRequest{ServiceID: serviceID, Environment: map[string]string{}},
+	})
+	suite.Require().NoError(err)
+	suite.k8sMock.AssertExpectations(suite.T())
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_AlreadyFinished() {
+	deploymentID := uuid.New()
+	running := &ent.Deployment{ID: deploymentID, ServiceID: uuid.New(), Status: schema.DeploymentStatusBuildRunning}
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(nil, &ent.NotFoundError{})
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	_, err := suite.deploymentController.CancelDeployment(suite.ctx, running)
+	suite.Require().Error(err)
+	suite.Assert().ErrorIs(err, errdefs.ErrConflict)
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelDeployment_AwaitingApproval() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	awaiting := &ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusAwaitingApproval}
+
+	err := suite.deploymentController.approvalQueue.Enqueue(suite.ctx, deploymentID.String(), DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &deploymentID,
+	})
+	suite.Require().NoError(err)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkCancelled(mock.Anything, mock.Anything, deploymentID).Return(&ent.Deployment{ID: deploymentID}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+	suite.k8sMock.EXPECT().CancelDeploymentJob(mock.Anything, deploymentID.String()).Return(nil)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	_, err = suite.deploymentController.CancelDeployment(suite.ctx, awaiting)
+	suite.Require().NoError(err)
+
+	heldJobs, err := suite.deploymentController.approvalQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(heldJobs, 0)
+}
+
+func (suite *DeploymentControllerTestSuite) TestAbortStagedRollouts() {
+	serviceID := uuid.New()
+	stagedID := uuid.New()
+	newDeploymentID := uuid.New()
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().GetStagedByServiceID(mock.Anything, serviceID).Return([]*ent.Deployment{
+		{
+			ID:                 stagedID,
+			ServiceID:          serviceID,
+			Status:             schema.DeploymentStatusStaged,
+			ResourceDefinition: &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web"}},
+		},
+	}, nil)
+	deploymentMock.EXPECT().MarkAborted(mock.Anything, mock.Anything, stagedID, mock.Anything).
+		Return(&ent.Deployment{ID: stagedID, Status: schema.DeploymentStatusAborted}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetDeploymentNamespace(mock.Anything, serviceID).Return("team-ns", nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	// The candidate and its canary ingress go, so it stops taking traffic
+	suite.k8sMock.EXPECT().DeleteRolloutCandidate(mock.Anything, "team-ns", "web").Return(nil)
+
+	err := suite.deploymentController.AbortStagedRollouts(suite.ctx, serviceID, newDeploymentID)
+	suite.Require().NoError(err)
+}
+
+func (suite *DeploymentControllerTestSuite) TestApproveDeployment_NotHeld() {
+	deployment := &ent.Deployment{ID: uuid.New(), ServiceID: uuid.New(), Status: schema.DeploymentStatusAwaitingApproval}
+
+	_, err := suite.deploymentController.ApproveDeployment(suite.ctx, deployment)
+	suite.Require().Error(err)
+	suite.Assert().ErrorIs(err, errdefs.ErrConflict)
+}
+
+func (suite *DeploymentControllerTestSuite) TestCancelExistingJobs_RemovesAwaitingApproval() {
+	serviceID := uuid.New()
+	otherServiceID := uuid.New()
+	deploymentID := uuid.New()
+
+	err := suite.deploymentController.approvalQueue.Enqueue(suite.ctx, deploymentID.String(), DeploymentJobRequest{ServiceID: serviceID})
+	suite.Require().NoError(err)
+	err = suite.deploymentController.approvalQueue.Enqueue(suite.ctx, uuid.New().String(), DeploymentJobRequest{ServiceID: otherServiceID})
+	suite.Require().NoError(err)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkAsCancelled(mock.Anything, []uuid.UUID{deploymentID}).Return(nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(nil, assert.AnError).Maybe()
+	suite.repoMock.EXPECT().Service().Return(serviceMock).Maybe()
+
+	err = suite.deploymentController.CancelExistingJobs(suite.ctx, serviceID)
+	suite.Require().NoError(err)
+
+	// The other service's deployment is still waiting
+	heldJobs, err := suite.deploymentController.approvalQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(heldJobs, 1)
+	suite.Assert().Equal(otherServiceID, heldJobs[0].Data.ServiceID)
+}
+
+func (suite *DeploymentControllerTestSuite) TestEnqueueDeploymentJob_ProtectedOnlySupersedesAwaitingApproval() {
+	serviceID := uuid.New()
+	queuedID := uuid.New()
+	awaitingID := uuid.New()
+	deploymentID := uuid.New()
+
+	// An approved build is queued and an older deployment is still waiting for approval
+	err := suite.deploymentController.jobQueue.Enqueue(suite.ctx, queuedID.String(), DeploymentJobRequest{ServiceID: serviceID})
+	suite.Require().NoError(err)
+	err = suite.deploymentController.approvalQueue.Enqueue(suite.ctx, awaitingID.String(), DeploymentJobRequest{ServiceID: serviceID, ExistingJobID: &awaitingID})
+	suite.Require().NoError(err)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(&ent.Service{
+		ID: serviceID,
+		Edges: ent.ServiceEdges{
+			Environment: &ent.Environment{
+				Protected: true,
+				Edges: ent.EnvironmentEdges{
+					Project: &ent.Project{
+						Edges: ent.ProjectEdges{
+							Team: &ent.Team{},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkAsCancelled(mock.Anything, []uuid.UUID{awaitingID}).Return(nil)
+	deploymentMock.EXPECT().Create(mock.Anything, mock.Anything, serviceID, "", "", "", (*schema.GitCommitter)(nil), schema.DeploymentSourceGit, schema.DeploymentStatusAwaitingApproval).
+		Return(&ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusAwaitingApproval}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	suite.webhooksMock.EXPECT().TriggerWebhooks(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	job, err := suite.deploymentController.EnqueueDeploymentJob(suite.ctx, DeploymentJobRequest{
+		ServiceID: serviceID,
+		Source:    schema.DeploymentSourceGit,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(schema.DeploymentStatusAwaitingApproval, job.Status)
+
+	// The approved build isn't touched until the new deployment is approved
+	queuedJobs, err := suite.deploymentController.jobQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(queuedJobs, 1)
+	suite.Assert().Equal(queuedID.String(), queuedJobs[0].ID)
+
+	heldJobs, err := suite.deploymentController.approvalQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(heldJobs, 1)
+	suite.Assert().Equal(deploymentID.String(), heldJobs[0].ID)
+}
+
+func (suite *DeploymentControllerTestSuite) TestReleaseFrozenDeployments_StillFrozen() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+
+	err := suite.deploymentController.freezeQueue.Enqueue(suite.ctx, deploymentID.String(), DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &deploymentID,
+	})
+	suite.Require().NoError(err)
+
+	// Recurring window that started within the last minute and lasts an hour
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(&ent.Service{
+		ID: serviceID,
+		Edges: ent.ServiceEdges{
+			Environment: &ent.Environment{
+				FreezeWindows: []schema.FreezeWindow{
+					{
+						Schedule:        utils.ToPtr("* * * * *"),
+						DurationMinutes: 60,
+					},
+				},
+			},
+		},
+	}, nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	err = suite.deploymentController.ReleaseFrozenDeployments(suite.ctx)
+	suite.Require().NoError(err)
+
+	heldJobs, err := suite.deploymentController.freezeQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(heldJobs, 1)
+}
+
+func (suite *DeploymentControllerTestSuite) TestEnqueueDeploymentJob_ApprovedDuringFreeze() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(&ent.Service{
+		ID: serviceID,
+		Edges: ent.ServiceEdges{
+			Environment: &ent.Environment{
+				Protected: true,
+				FreezeWindows: []schema.FreezeWindow{
+					{
+						StartsAt: utils.ToPtr(time.Now().Add(-time.Hour)),
+						EndsAt:   utils.ToPtr(time.Now().Add(time.Hour)),
+					},
+				},
+			},
+		},
+	}, nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkPending(mock.Anything, mock.Anything, deploymentID).
+		Return(&ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusBuildPending}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	// A manual deployment released after approval is held rather than refused
+	job, err := suite.deploymentController.EnqueueDeploymentJob(suite.ctx, DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &deploymentID,
+		Source:        schema.DeploymentSourceManual,
+		Approved:      true,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(schema.DeploymentStatusBuildPending, job.Status)
+
+	heldJobs, err := suite.deploymentController.freezeQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(heldJobs, 1)
+	suite.Assert().Equal(deploymentID, *heldJobs[0].Data.ExistingJobID)
+}
+
+func (suite *DeploymentControllerTestSuite) TestEnqueueDeploymentJob_DependentNeedsApproval() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(&ent.Service{
+		ID: serviceID,
+		Edges: ent.ServiceEdges{
+			Environment: &ent.Environment{
+				Protected: true,
+				Edges: ent.EnvironmentEdges{
+					Project: &ent.Project{
+						Edges: ent.ProjectEdges{
+							Team: &ent.Team{},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().MarkAwaitingApproval(mock.Anything, mock.Anything, deploymentID).
+		Return(&ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusAwaitingApproval}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	suite.webhooksMock.EXPECT().TriggerWebhooks(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Dependent and template deployments are created before they're enqueued, that doesn't make them approved
+	job, err := suite.deploymentController.EnqueueDeploymentJob(suite.ctx, DeploymentJobRequest{
+		ServiceID:     serviceID,
+		ExistingJobID: &deploymentID,
+		Source:        schema.DeploymentSourceManual,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(schema.DeploymentStatusAwaitingApproval, job.Status)
+
+	heldJobs, err := suite.deploymentController.approvalQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(heldJobs, 1)
+	suite.Assert().Equal(deploymentID.String(), heldJobs[0].ID)
+}
+
+func (suite *DeploymentControllerTestSuite) TestEnqueueDeploymentJob_Scheduled() {
+	serviceID := uuid.New()
+	deploymentID := uuid.New()
+	scheduledAt := time.Now().Add(6 * time.Hour)
+
+	// A queued build for the same service isn't superseded until the scheduled one is due
+	err := suite.deploymentController.jobQueue.Enqueue(suite.ctx, uuid.New().String(), DeploymentJobRequest{ServiceID: serviceID})
+	suite.Require().NoError(err)
+
+	serviceMock := service_mocks.NewServiceRepositoryMock(suite.T())
+	serviceMock.EXPECT().GetByID(mock.Anything, serviceID).Return(&ent.Service{
+		ID: serviceID,
+		Edges: ent.ServiceEdges{
+			Environment: &ent.Environment{},
+		},
+	}, nil)
+	suite.repoMock.EXPECT().Service().Return(serviceMock)
+
+	deploymentMock := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	deploymentMock.EXPECT().Create(mock.Anything, mock.Anything, serviceID, "", "", "", (*schema.GitCommitter)(nil), schema.DeploymentSourceManual, schema.DeploymentStatusScheduled).
+		Return(&ent.Deployment{ID: deploymentID, ServiceID: serviceID}, nil)
+	deploymentMock.EXPECT().MarkScheduled(mock.Anything, mock.Anything, deploymentID, scheduledAt).
+		Return(&ent.Deployment{ID: deploymentID, ServiceID: serviceID, Status: schema.DeploymentStatusScheduled, ScheduledAt: &scheduledAt}, nil)
+	suite.repoMock.EXPECT().Deployment().Return(deploymentMock)
+
+	job, err := suite.deploymentController.EnqueueDeploymentJob(suite.ctx, DeploymentJobRequest{
+		ServiceID:   serviceID,
+		Source:      schema.DeploymentSourceManual,
+		ScheduledAt: &scheduledAt,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().Equal(schema.DeploymentStatusScheduled, job.Status)
+
+	heldJobs, err := suite.deploymentController.scheduledQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(heldJobs, 1)
+	suite.Assert().Equal(deploymentID, *heldJobs[0].Data.ExistingJobID)
+
+	queuedJobs, err := suite.deploymentController.jobQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(queuedJobs, 1)
+}
+
+func (suite *DeploymentControllerTestSuite) TestReleaseScheduledDeployments_NotDue() {
+	deploymentID := uuid.New()
+
+	err := suite.deploymentController.scheduledQueue.Enqueue(suite.ctx, deploymentID.String(), DeploymentJobRequest{
+		ServiceID:     uuid.New(),
+		ExistingJobID: &deploymentID,
+		ScheduledAt:   utils.ToPtr(time.Now().Add(time.Hour)),
+	})
+	suite.Require().NoError(err)
+
+	err = suite.deploymentController.ReleaseScheduledDeployments(suite.ctx)
+	suite.Require().NoError(err)
+
+	heldJobs, err := suite.deploymentController.scheduledQueue.GetAll(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Assert().Len(heldJobs, 1)
+}
+
+func (suite *DeploymentControllerTestSuite) TestFrozenUntil() {
+	now := time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC)
+
+	oneOff := schema.FreezeWindow{
+		StartsAt: utils.ToPtr(now.Add(-time.Hour)),
+		EndsAt:   utils.ToPtr(now.Add(time.Hour)),
+	}
+	// Every friday at 18:00 for the weekend
+	weekend := schema.FreezeWindow{
+		Schedule:        utils.ToPtr("0 18 * * 5"),
+		DurationMinutes: 60 * 24 * 2,
+	}
+
+	suite.Assert().Equal(now.Add(time.Hour), *schema.FrozenUntil([]schema.FreezeWindow{oneOff}, now))
+	suite.Assert().Nil(schema.FrozenUntil([]schema.FreezeWindow{oneOff}, now.Add(2*time.Hour)))
+
+	// 2026-12-24 is a thursday
+	suite.Assert().Nil(schema.FrozenUntil([]schema.FreezeWindow{weekend}, now))
+	saturday := time.Date(2026, 12, 26, 9, 0, 0, 0, time.UTC)
+	suite.Assert().Equal(time.Date(2026, 12, 27, 18, 0, 0, 0, time.UTC), *schema.FrozenUntil([]schema.FreezeWindow{weekend}, saturday))
+
+	// The latest end wins when windows overlap
+	saturdayOneOff := schema.FreezeWindow{
+		StartsAt: utils.ToPtr(saturday.Add(-time.Hour)),
+		EndsAt:   utils.ToPtr(saturday.Add(time.Hour)),
+	}
+	suite.Assert().Equal(time.Date(2026, 12, 27, 18, 0, 0, 0, time.UTC), *schema.FrozenUntil([]schema.FreezeWindow{saturdayOneOff, weekend}, saturday))
+}
+
+func (suite *DeploymentControllerTestSuite) githubCheckDeployment(status schema.DeploymentStatus) *ent.Deployment {
+	team := &ent.Team{ID: uuid.New(), Namespace: "team-ns"}
+	project := &ent.Project{ID: uuid.New(), Edges: ent.ProjectEdges{Team: team}}
+	environment := &ent.Environment{ID: uuid.New(), Edges: ent.EnvironmentEdges{Project: project}}
+	service := &ent.Service{
+		ID:            uuid.New(),
+		Name:          "web",
+		EnvironmentID: environment.ID,
+		GitRepository: utils.ToPtr("app"),
+		Edges: ent.ServiceEdges{
+			Environment:        environment,
+			ServiceConfig:      &ent.ServiceConfig{Replicas: 1},
+			GithubInstallation: &ent.GithubInstallation{ID: 1, AccountLogin: "acme"},
+		},
+	}
+	return &ent.Deployment{
+		ID:        uuid.New(),
+		ServiceID: service.ID,
+		Status:    status,
+		CommitSha: utils.ToPtr("abc123"),
+		Edges:     ent.DeploymentEdges{Service: service},
+	}
+}
+
+func (suite *DeploymentControllerTestSuite) TestSyncGithubCheckRuns_CreatesCheckRun() {
+	deployment := suite.githubCheckDeployment(schema.DeploymentStatusBuildQueued)
+
+	deploymentRepo := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	suite.repoMock.EXPECT().Deployment().Return(deploymentRepo)
+	deploymentRepo.EXPECT().GetPendingGithubChecks(mock.Anything, mock.Anything).Return([]*ent.Deployment{deployment}, nil)
+
+	suite.githubMock.EXPECT().ReportCheckRun(mock.Anything, deployment.Edges.Service.Edges.GithubInstallation, "acme", "app", "abc123", (*int64)(nil), mock.MatchedBy(func(state github.CheckRunState) bool {
+		return state.Name == "unbind / web" && state.Status == "queued" && strings.Contains(state.DetailsURL, "deployment="+deployment.ID.String())
+	})).Return(int64(42), nil)
+	deploymentRepo.EXPECT().SetGithubCheckRun(mock.Anything, deployment.ID, utils.ToPtr(int64(42)), schema.DeploymentStatusBuildQueued, false).Return(deployment, nil)
+
+	suite.Require().NoError(suite.deploymentController.SyncGithubCheckRuns(suite.ctx))
+}
+
+func (suite *DeploymentControllerTestSuite) TestSyncGithubCheckRuns_Unchanged() {
+	deployment := suite.githubCheckDeployment(schema.DeploymentStatusBuildRunning)
+	deployment.GithubCheckRunID = utils.ToPtr(int64(42))
+	deployment.GithubCheckStatus = utils.ToPtr(schema.DeploymentStatusBuildRunning)
+
+	deploymentRepo := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	suite.repoMock.EXPECT().Deployment().Return(deploymentRepo)
+	deploymentRepo.EXPECT().GetPendingGithubChecks(mock.Anything, mock.Anything).Return([]*ent.Deployment{deployment}, nil)
+
+	suite.Require().NoError(suite.deploymentController.SyncGithubCheckRuns(suite.ctx))
+	suite.githubMock.AssertNotCalled(suite.T(), "ReportCheckRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeploymentControllerTestSuite) TestSyncGithubCheckRuns_RolledOut() {
+	deployment := suite.githubCheckDeployment(schema.DeploymentStatusBuildSucceeded)
+	deployment.GithubCheckRunID = utils.ToPtr(int64(42))
+	deployment.Edges.Service.CurrentDeploymentID = &deployment.ID
+
+	deploymentRepo := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	suite.repoMock.EXPECT().Deployment().Return(deploymentRepo)
+	deploymentRepo.EXPECT().GetPendingGithubChecks(mock.Anything, mock.Anything).Return([]*ent.Deployment{deployment}, nil)
+
+	suite.k8sMock.EXPECT().GetInternalClient().Return(nil)
+	suite.k8sMock.EXPECT().GetSimpleHealthStatus(mock.Anything, "team-ns", mock.Anything, mock.Anything, mock.Anything).Return(&k8s.SimpleHealthStatus{Health: k8s.InstanceHealthActive}, nil)
+
+	suite.githubMock.EXPECT().ReportCheckRun(mock.Anything, mock.Anything, "acme", "app", "abc123", deployment.GithubCheckRunID, mock.MatchedBy(func(state github.CheckRunState) bool {
+		return state.Status == "completed" && state.Conclusion == "success"
+	})).Return(int64(42), nil)
+	deploymentRepo.EXPECT().SetGithubCheckRun(mock.Anything, deployment.ID, utils.ToPtr(int64(42)), schema.DeploymentStatusActive, true).Return(deployment, nil)
+
+	suite.Require().NoError(suite.deploymentController.SyncGithubCheckRuns(suite.ctx))
+}
+
+func (suite *DeploymentControllerTestSuite) TestSyncGithubCheckRuns_CreateFails() {
+	deployment := suite.githubCheckDeployment(schema.DeploymentStatusBuildQueued)
+
+	deploymentRepo := deployment_mocks.NewDeploymentRepositoryMock(suite.T())
+	suite.repoMock.EXPECT().Deployment().Return(deploymentRepo)
+	deploymentRepo.EXPECT().GetPendingGithubChecks(mock.Anything, mock.Anything).Return([]*ent.Deployment{deployment}, nil)
+
+	suite.githubMock.EXPECT().ReportCheckRun(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("resource not accessible by integration"))
+	// Gives up instead of retrying forever
+	deploymentRepo.EXPECT().SetGithubCheckRun(mock.Anything, deployment.ID, (*int64)(nil), schema.DeploymentStatusBuildQueued, true).Return(deployment, nil)
+
+	suite.Require().NoError(suite.deploymentController.SyncGithubCheckRuns(suite.ctx))
+}
+
+func (suite *DeploymentControllerTestSuite) TestGithubCheckRunState() {
+	deployment := &ent.Deployment{Error: "exit code 1", SkipReason: utils.ToPtr("Commit message contains [skip deploy]")}
+
+	cases := []struct {
+		status     schema.DeploymentStatus
+		runStatus  string
+		conclusion string
+	}{
+		{schema.DeploymentStatusAwaitingApproval, "queued", ""},
+		{schema.DeploymentStatusBuildQueued, "queued", ""},
+		{schema.DeploymentStatusBuildRunning, "in_progress", ""},
+		{schema.DeploymentStatusBuildSucceeded, "in_progress", ""},
+		{schema.DeploymentStatusStaged, "in_progress", ""},
+		{schema.DeploymentStatusActive, "completed", "success"},
+		{schema.DeploymentStatusCrashing, "completed", "failure"},
+		{schema.DeploymentStatusBuildFailed, "completed", "failure"},
+		{schema.DeploymentStatusBuildCancelled, "completed", "cancelled"},
+		{schema.DeploymentStatusSkipped, "completed", "skipped"},
+		{schema.DeploymentStatusRemoved, "completed", "neutral"},
+	}
+	for _, c := range cases {
+		state := githubCheckRunState(c.status, deployment)
+		suite.Assert().Equal(c.runStatus, state.Status, c.status)
+		suite.Assert().Equal(c.conclusion, state.Conclusion, c.status)
+	}
+
+	suite.Assert().Equal("exit code 1", githubCheckRunState(schema.DeploymentStatusBuildFailed, deployment).Summary)
+	suite.Assert().Equal("Commit message contains [skip deploy]", githubCheckRunState(schema.DeploymentStatusSkipped, deployment).Summary)
+}
+
 func TestDeploymentControllerSuite(t *testing.T) {
 	suite.Run(t, new(DeploymentControllerTestSuite))
 }
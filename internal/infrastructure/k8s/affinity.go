@@ -0,0 +1,44 @@
+package k8s
+
+import (
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PlatformNodeAffinity requires a node with one of the architectures in platforms (os/arch[/variant])
+// Returns nil if there are none, so the pods can run anywhere
+func PlatformNodeAffinity(platforms []string) *corev1.Affinity {
+	var architectures []string
+	for _, platform := range platforms {
+		parts := strings.Split(strings.TrimSpace(platform), "/")
+		if len(parts) < 2 || parts[1] == "" {
+			continue
+		}
+		if !slices.Contains(architectures, parts[1]) {
+			architectures = append(architectures, parts[1])
+		}
+	}
+	if len(architectures) == 0 {
+		return nil
+	}
+
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      corev1.LabelArchStable,
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   architectures,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
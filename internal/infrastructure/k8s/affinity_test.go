@@ -0,0 +1,25 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPlatformNodeAffinity(t *testing.T) {
+	assert.Nil(t, PlatformNodeAffinity(nil))
+	assert.Nil(t, PlatformNodeAffinity([]string{""}))
+
+	affinity := PlatformNodeAffinity([]string{"linux/arm64", "linux/arm/v7", "linux/arm64/v8"})
+	require.NotNil(t, affinity)
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Len(t, terms, 1)
+	require.Len(t, terms[0].MatchExpressions, 1)
+	assert.Equal(t, corev1.NodeSelectorRequirement{
+		Key:      "kubernetes.io/arch",
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{"arm64", "arm"},
+	}, terms[0].MatchExpressions[0])
+}
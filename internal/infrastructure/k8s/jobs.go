@@ -28,16 +28,22 @@ func (self *KubeClient) CreateDeployment(ctx context.Context, deploymentID strin
 		"trigger-timestamp":       time.Now().Format(time.RFC3339),
 	}
 
+	labels := map[string]string{
+		"unbind-deployment-job":   "true",
+		"unbind-deployment-build": deploymentID,
+		"job-name":                jobName,
+	}
+	// Team label is used to enforce per-team build concurrency
+	if teamID, ok := env["SERVICE_TEAM_REF"]; ok && teamID != "" {
+		labels["unbind-team"] = teamID
+	}
+
 	// Define the Job object
 	// Define the Job object
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: jobName,
-			Labels: map[string]string{
-				"unbind-deployment-job":   "true",
-				"unbind-deployment-build": deploymentID,
-				"job-name":                jobName,
-			},
+			Name:        jobName,
+			Labels:      labels,
 			Annotations: annotations,
 		},
 		Spec: batchv1.JobSpec{
@@ -134,6 +140,26 @@ func (self *KubeClient) CancelJobsByServiceID(ctx context.Context, serviceID str
 	return nil
 }
 
+// CancelDeploymentJob deletes the builder job(s) created for a single deployment
+func (self *KubeClient) CancelDeploymentJob(ctx context.Context, deploymentID string) error {
+	jobList, err := self.clientset.BatchV1().Jobs(self.config.GetSystemNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("unbind-deployment-build=%s", deploymentID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list jobs for deployment ID %s: %v", deploymentID, err)
+	}
+	deletePolicy := metav1.DeletePropagationForeground
+	for _, job := range jobList.Items {
+		if err := self.clientset.BatchV1().Jobs(self.config.GetSystemNamespace()).Delete(ctx, job.Name, metav1.DeleteOptions{
+			PropagationPolicy: &deletePolicy,
+		}); err != nil {
+			return fmt.Errorf("failed to delete job %s: %v", job.Name, err)
+		}
+		log.Infof("Canceled job %s for deployment %s\n", job.Name, deploymentID)
+	}
+	return nil
+}
+
 func (self *KubeClient) CountActiveDeploymentJobs(ctx context.Context) (int, error) {
 	jobList, err := self.clientset.BatchV1().Jobs(self.config.GetSystemNamespace()).List(ctx, metav1.ListOptions{
 		LabelSelector: "unbind-deployment-job=true",
@@ -152,6 +178,26 @@ func (self *KubeClient) CountActiveDeploymentJobs(ctx context.Context) (int, err
 	return activeCount, nil
 }
 
+// CountActiveDeploymentJobsByTeam counts active build jobs grouped by the team that owns them
+// Jobs without a team label are counted under an empty team ID
+func (self *KubeClient) CountActiveDeploymentJobsByTeam(ctx context.Context) (map[string]int, error) {
+	jobList, err := self.clientset.BatchV1().Jobs(self.config.GetSystemNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: "unbind-deployment-job=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %v", err)
+	}
+
+	activeCounts := make(map[string]int)
+	for _, job := range jobList.Items {
+		if job.Status.Active > 0 {
+			activeCounts[job.Labels["unbind-team"]]++
+		}
+	}
+
+	return activeCounts, nil
+}
+
 // Get status of a kubernetes Job resource
 type JobConditionType int
 
@@ -250,9 +296,14 @@ func (self *KubeClient) GetJobStatus(ctx context.Context, jobName string) (JobSt
 
 // getJobPodsFailureReason attempts to get failure reasons from pods associated with the job
 func (self *KubeClient) getJobPodsFailureReason(ctx context.Context, jobName string) string {
+	return self.getJobPodsFailureReasonInNamespace(ctx, self.config.GetSystemNamespace(), jobName)
+}
+
+// getJobPodsFailureReasonInNamespace is getJobPodsFailureReason for jobs outside of the system namespace
+func (self *KubeClient) getJobPodsFailureReasonInNamespace(ctx context.Context, namespace, jobName string) string {
 	// Get pods with the job-name label
 	labelSelector := fmt.Sprintf("job-name=%s", jobName)
-	pods, err := self.clientset.CoreV1().Pods(self.config.GetSystemNamespace()).List(ctx, metav1.ListOptions{
+	pods, err := self.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
 
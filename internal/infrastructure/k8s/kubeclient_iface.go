@@ -41,7 +41,12 @@ type KubeClientInterface interface {
 	CreateDeployment(ctx context.Context, deploymentID string, env map[string]string) (jobName string, err error)
 	// For canceling jobs.
 	CancelJobsByServiceID(ctx context.Context, serviceID string) error
+	// CancelDeploymentJob deletes the builder job(s) created for a single deployment
+	CancelDeploymentJob(ctx context.Context, deploymentID string) error
 	CountActiveDeploymentJobs(ctx context.Context) (int, error)
+	// CountActiveDeploymentJobsByTeam counts active build jobs grouped by the team that owns them
+	// Jobs without a team label are counted under an empty team ID
+	CountActiveDeploymentJobsByTeam(ctx context.Context) (map[string]int, error)
 	GetJobStatus(ctx context.Context, jobName string) (JobStatus, error)
 	// This function is used to manage unbind-system resources
 	GetInternalClient() kubernetes.Interface
@@ -83,6 +88,17 @@ type KubeClientInterface interface {
 	RollingRestartPodsByLabel(ctx context.Context, namespace string, labelKey string, labelValue string, client kubernetes.Interface) error
 	// DeleteStatefulSetsWithOrphanCascade deletes StatefulSets matching the label selector with orphan cascade
 	DeleteStatefulSetsWithOrphanCascade(ctx context.Context, namespace string, labels map[string]string, client kubernetes.Interface) error
+	// RunPreDeployJob runs the pre-deploy command to completion and returns its output
+	// An error is returned if the command exits non-zero or doesn't finish within the timeout
+	RunPreDeployJob(ctx context.Context, params PreDeployJob) (output string, err error)
+	// SetCanaryTraffic routes the given percentage of the service's ingress traffic to its rollout candidate
+	// Does nothing if the service has no ingress, i.e. isn't public
+	SetCanaryTraffic(ctx context.Context, namespace, name string, weight int) error
+	// DeployRolloutCandidate runs a service resource next to the current deployment for a blue-green or canary rollout
+	// The candidate gets no ingress of its own, weight is the share of the service's traffic it gets until it's promoted
+	DeployRolloutCandidate(ctx context.Context, service *unbindv1.Service, weight int) error
+	// DeleteRolloutCandidate removes the candidate service resource and its canary ingress, if they exist
+	DeleteRolloutCandidate(ctx context.Context, namespace, name string) error
 	// CreateMultiRegistryCredentials creates or updates a kubernetes.io/dockerconfigjson secret for multiple container registries
 	CreateMultiRegistryCredentials(ctx context.Context, name, namespace string, credentials []RegistryCredential, client kubernetes.Interface) (*corev1.Secret, error)
 	// After you've retrieved the credentials Secret
@@ -0,0 +1,197 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreDeployJob describes a one-off job that runs a command with a freshly built image before it's rolled out
+type PreDeployJob struct {
+	Namespace        string
+	DeploymentID     string
+	TeamID           string
+	ProjectID        string
+	EnvironmentID    string
+	Image            string
+	Command          string
+	SecretName       string
+	EnvVars          []corev1.EnvVar
+	ImagePullSecrets []string
+	SecurityContext  *corev1.SecurityContext
+	Affinity         *corev1.Affinity
+	Timeout          time.Duration
+}
+
+// How often we check on the pre-deploy job
+const preDeployPollInterval = 2 * time.Second
+
+// buildPreDeployJob creates the Job object for a pre-deploy command
+// The pod carries the deployment label so its output shows up in the deployment logs, but not the service label,
+// so it's never mistaken for an instance of the service
+func buildPreDeployJob(params PreDeployJob) *batchv1.Job {
+	jobName := fmt.Sprintf("%s-predeploy-%d", params.DeploymentID, time.Now().Unix())
+
+	labels := map[string]string{
+		"unbind-pre-deploy": "true",
+		"unbind-deployment": params.DeploymentID,
+		"job-name":          jobName,
+	}
+	if params.TeamID != "" {
+		labels["unbind-team"] = params.TeamID
+	}
+	if params.ProjectID != "" {
+		labels["unbind-project"] = params.ProjectID
+	}
+	if params.EnvironmentID != "" {
+		labels["unbind-environment"] = params.EnvironmentID
+	}
+
+	var envFrom []corev1.EnvFromSource
+	if params.SecretName != "" {
+		envFrom = append(envFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: params.SecretName,
+				},
+			},
+		})
+	}
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	for _, secret := range params.ImagePullSecrets {
+		if secret == "" {
+			continue
+		}
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: params.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: utils.ToPtr[int32](300),
+			BackoffLimit:            utils.ToPtr[int32](0),
+			Parallelism:             utils.ToPtr[int32](1),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: imagePullSecrets,
+					Affinity:         params.Affinity,
+					Containers: []corev1.Container{
+						{
+							Name:            "pre-deploy",
+							Image:           params.Image,
+							Command:         []string{"sh", "-c", params.Command},
+							Env:             params.EnvVars,
+							EnvFrom:         envFrom,
+							SecurityContext: params.SecurityContext,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if params.Timeout > 0 {
+		job.Spec.ActiveDeadlineSeconds = utils.ToPtr(int64(params.Timeout.Seconds()))
+	}
+
+	return job
+}
+
+// RunPreDeployJob runs the pre-deploy command to completion and returns its output
+// An error is returned if the command exits non-zero or doesn't finish within the timeout
+func (self *KubeClient) RunPreDeployJob(ctx context.Context, params PreDeployJob) (output string, err error) {
+	job := buildPreDeployJob(params)
+
+	job, err = self.clientset.BatchV1().Jobs(params.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create pre-deploy job: %w", err)
+	}
+
+	waitCtx := ctx
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+
+	succeeded, err := self.waitForPreDeployJob(waitCtx, params.Namespace, job.Name)
+	output = self.getPreDeployJobOutput(ctx, params.Namespace, job.Name)
+	if err != nil {
+		// Don't leave the command running after we've given up on it
+		deletePolicy := metav1.DeletePropagationForeground
+		if delErr := self.clientset.BatchV1().Jobs(params.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{
+			PropagationPolicy: &deletePolicy,
+		}); delErr != nil {
+			log.Warnf("Failed to delete pre-deploy job %s: %v", job.Name, delErr)
+		}
+		return output, err
+	}
+
+	if !succeeded {
+		return output, fmt.Errorf("pre-deploy command failed: %s", self.getJobPodsFailureReasonInNamespace(ctx, params.Namespace, job.Name))
+	}
+
+	return output, nil
+}
+
+// waitForPreDeployJob polls the job until it finishes, returning whether it succeeded
+func (self *KubeClient) waitForPreDeployJob(ctx context.Context, namespace, jobName string) (bool, error) {
+	ticker := time.NewTicker(preDeployPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := self.clientset.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to get pre-deploy job %s: %w", jobName, err)
+		}
+
+		if job.Status.Succeeded > 0 {
+			return true, nil
+		}
+		if job.Status.Failed > 0 {
+			return false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("pre-deploy command did not finish in time: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// getPreDeployJobOutput collects the logs of the job's pod, best effort
+func (self *KubeClient) getPreDeployJobOutput(ctx context.Context, namespace, jobName string) string {
+	pods, err := self.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", jobName),
+	})
+	if err != nil || len(pods.Items) == 0 {
+		return ""
+	}
+
+	logs, err := self.clientset.CoreV1().Pods(namespace).GetLogs(pods.Items[0].Name, &corev1.PodLogOptions{
+		Container: "pre-deploy",
+	}).DoRaw(ctx)
+	if err != nil {
+		log.Warnf("Failed to get logs for pre-deploy job %s: %v", jobName, err)
+		return ""
+	}
+
+	return strings.TrimSpace(string(logs))
+}
@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestBuildPreDeployJob(t *testing.T) {
+	job := buildPreDeployJob(PreDeployJob{
+		Namespace:        "team-ns",
+		DeploymentID:     "deployment-1",
+		TeamID:           "team-1",
+		ProjectID:        "project-1",
+		EnvironmentID:    "environment-1",
+		Image:            "registry/app:sha",
+		Command:          "npm run migrate",
+		SecretName:       "service-secret",
+		ImagePullSecrets: []string{"registry-creds", ""},
+		Affinity:         PlatformNodeAffinity([]string{"linux/arm64"}),
+		Timeout:          5 * time.Minute,
+	})
+
+	assert.Equal(t, "team-ns", job.Namespace)
+	assert.Equal(t, "deployment-1", job.Spec.Template.Labels["unbind-deployment"])
+	assert.Equal(t, "team-1", job.Spec.Template.Labels["unbind-team"])
+	assert.Equal(t, "environment-1", job.Spec.Template.Labels["unbind-environment"])
+	// Must not be picked up as an instance of the service
+	assert.NotContains(t, job.Spec.Template.Labels, "unbind-service")
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int64(300), *job.Spec.ActiveDeadlineSeconds)
+
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "registry/app:sha", container.Image)
+	assert.Equal(t, []string{"sh", "-c", "npm run migrate"}, container.Command)
+	require.Len(t, container.EnvFrom, 1)
+	assert.Equal(t, "service-secret", container.EnvFrom[0].SecretRef.Name)
+
+	require.Len(t, job.Spec.Template.Spec.ImagePullSecrets, 1)
+	assert.Equal(t, "registry-creds", job.Spec.Template.Spec.ImagePullSecrets[0].Name)
+
+	require.NotNil(t, job.Spec.Template.Spec.Affinity)
+	assert.Equal(t, []string{"arm64"}, job.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values)
+}
+
+func TestRunPreDeployJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        batchv1.JobStatus
+		expectedError bool
+	}{
+		{
+			name:          "Command exits 0",
+			status:        batchv1.JobStatus{Succeeded: 1},
+			expectedError: false,
+		},
+		{
+			name:          "Command exits non-zero",
+			status:        batchv1.JobStatus{Failed: 1},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewSimpleClientset()
+			// Finish the job as soon as it's created
+			fakeClient.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+				job.Status = tt.status
+				return false, nil, nil
+			})
+
+			kubeClient := &KubeClient{
+				clientset: fakeClient,
+			}
+
+			_, err := kubeClient.RunPreDeployJob(context.Background(), PreDeployJob{
+				Namespace:    "team-ns",
+				DeploymentID: "deployment-1",
+				Image:        "registry/app:sha",
+				Command:      "exit 0",
+				Timeout:      time.Minute,
+			})
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			jobs, err := fakeClient.BatchV1().Jobs("team-ns").List(context.Background(), metav1.ListOptions{
+				LabelSelector: "unbind-pre-deploy=true",
+			})
+			require.NoError(t, err)
+			assert.Len(t, jobs.Items, 1)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	unbindv1 "github.com/unbindapp/unbind-operator/api/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutCandidateName is the name of the service resource (and its ingress) running a blue-green or canary deployment next to the current one
+func RolloutCandidateName(name string) string {
+	return fmt.Sprintf("%s-candidate", name)
+}
+
+// buildCanaryIngress mirrors the service's ingress, pointing it at the candidate with ingress-nginx canary annotations
+func buildCanaryIngress(main *networkingv1.Ingress, weight int) *networkingv1.Ingress {
+	candidateName := RolloutCandidateName(main.Name)
+
+	labels := make(map[string]string, len(main.Labels)+1)
+	for k, v := range main.Labels {
+		labels[k] = v
+	}
+	labels["unbind-rollout-candidate"] = "true"
+
+	spec := *main.Spec.DeepCopy()
+	if spec.DefaultBackend != nil && spec.DefaultBackend.Service != nil {
+		spec.DefaultBackend.Service.Name = candidateName
+	}
+	for i := range spec.Rules {
+		if spec.Rules[i].HTTP == nil {
+			continue
+		}
+		for j := range spec.Rules[i].HTTP.Paths {
+			if spec.Rules[i].HTTP.Paths[j].Backend.Service != nil {
+				spec.Rules[i].HTTP.Paths[j].Backend.Service.Name = candidateName
+			}
+		}
+	}
+	// Certificates are owned by the main ingress, nginx uses them for the canary too
+	spec.TLS = nil
+
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      candidateName,
+			Namespace: main.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"nginx.ingress.kubernetes.io/canary":        "true",
+				"nginx.ingress.kubernetes.io/canary-weight": strconv.Itoa(weight),
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// SetCanaryTraffic routes the given percentage of the service's ingress traffic to its rollout candidate
+// Does nothing if the service has no ingress, i.e. isn't public
+func (self *KubeClient) SetCanaryTraffic(ctx context.Context, namespace, name string, weight int) error {
+	main, err := self.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ingress %s: %w", name, err)
+	}
+
+	canary := buildCanaryIngress(main, weight)
+
+	existing, err := self.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, canary.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get canary ingress %s: %w", canary.Name, err)
+		}
+		if _, err := self.clientset.NetworkingV1().Ingresses(namespace).Create(ctx, canary, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create canary ingress %s: %w", canary.Name, err)
+		}
+		return nil
+	}
+
+	existing.Labels = canary.Labels
+	existing.Annotations = canary.Annotations
+	existing.Spec = canary.Spec
+	if _, err := self.clientset.NetworkingV1().Ingresses(namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update canary ingress %s: %w", canary.Name, err)
+	}
+	return nil
+}
+
+// DeployRolloutCandidate runs a service resource next to the current deployment for a blue-green or canary rollout
+// The candidate gets no ingress of its own, weight is the share of the service's traffic it gets until it's promoted
+func (self *KubeClient) DeployRolloutCandidate(ctx context.Context, service *unbindv1.Service, weight int) error {
+	candidate := service.DeepCopy()
+	candidate.Name = RolloutCandidateName(service.Name)
+	candidate.Spec.Name = candidate.Name
+	// Keep the candidate out of the service's instances until it's promoted
+	candidate.Spec.ServiceRef = ""
+	candidate.Spec.Config.Public = false
+	candidate.Spec.Config.Hosts = nil
+
+	if _, _, err := self.DeployUnbindService(ctx, candidate); err != nil {
+		return err
+	}
+
+	// Also resets the weight a previous candidate may have been promoted with
+	return self.SetCanaryTraffic(ctx, service.Namespace, service.Name, weight)
+}
+
+// DeleteRolloutCandidate removes the candidate service resource and its canary ingress, if they exist
+func (self *KubeClient) DeleteRolloutCandidate(ctx context.Context, namespace, name string) error {
+	candidateName := RolloutCandidateName(name)
+
+	if err := self.clientset.NetworkingV1().Ingresses(namespace).Delete(ctx, candidateName, metav1.DeleteOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete canary ingress %s: %w", candidateName, err)
+		}
+	} else {
+		log.Infof("Deleted canary ingress %s in namespace %s", candidateName, namespace)
+	}
+
+	return self.DeleteUnbindService(ctx, namespace, candidateName)
+}
@@ -0,0 +1,200 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	unbindv1 "github.com/unbindapp/unbind-operator/api/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testServiceIngress() *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "team-ns",
+			Labels: map[string]string{
+				"unbind-service": "service-1",
+			},
+			Annotations: map[string]string{
+				"cert-manager.io/cluster-issuer": "letsencrypt-prod",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"app.example.com"}, SecretName: "web-tls-secret"},
+			},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "app.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     "/",
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: "web",
+											Port: networkingv1.ServiceBackendPort{Number: 3000},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildCanaryIngress(t *testing.T) {
+	main := testServiceIngress()
+	canary := buildCanaryIngress(main, 25)
+
+	assert.Equal(t, "web-candidate", canary.Name)
+	assert.Equal(t, "team-ns", canary.Namespace)
+	assert.Equal(t, "true", canary.Annotations["nginx.ingress.kubernetes.io/canary"])
+	assert.Equal(t, "25", canary.Annotations["nginx.ingress.kubernetes.io/canary-weight"])
+	// Must not request its own certificate
+	assert.NotContains(t, canary.Annotations, "cert-manager.io/cluster-issuer")
+	assert.Empty(t, canary.Spec.TLS)
+	assert.Equal(t, "true", canary.Labels["unbind-rollout-candidate"])
+
+	require.Len(t, canary.Spec.Rules, 1)
+	assert.Equal(t, "app.example.com", canary.Spec.Rules[0].Host)
+	backend := canary.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	assert.Equal(t, "web-candidate", backend.Name)
+	assert.Equal(t, int32(3000), backend.Port.Number)
+
+	// The service's own ingress is left alone
+	assert.Equal(t, "web", main.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+}
+
+func TestSetCanaryTraffic(t *testing.T) {
+	t.Run("Creates and updates the canary ingress", func(t *testing.T) {
+		fakeClient := fake.NewSimpleClientset(testServiceIngress())
+		kubeClient := &KubeClient{
+			clientset: fakeClient,
+		}
+
+		require.NoError(t, kubeClient.SetCanaryTraffic(context.Background(), "team-ns", "web", 10))
+		canary, err := fakeClient.NetworkingV1().Ingresses("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "10", canary.Annotations["nginx.ingress.kubernetes.io/canary-weight"])
+
+		require.NoError(t, kubeClient.SetCanaryTraffic(context.Background(), "team-ns", "web", 100))
+		canary, err = fakeClient.NetworkingV1().Ingresses("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "100", canary.Annotations["nginx.ingress.kubernetes.io/canary-weight"])
+	})
+
+	t.Run("Service without ingress", func(t *testing.T) {
+		fakeClient := fake.NewSimpleClientset()
+		kubeClient := &KubeClient{
+			clientset: fakeClient,
+		}
+
+		require.NoError(t, kubeClient.SetCanaryTraffic(context.Background(), "team-ns", "web", 10))
+		ingresses, err := fakeClient.NetworkingV1().Ingresses("team-ns").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, ingresses.Items)
+	})
+}
+
+func TestDeleteRolloutCandidate(t *testing.T) {
+	serviceGVR := schema.GroupVersionResource{
+		Group:    "unbind.unbind.app",
+		Version:  "v1",
+		Resource: "services",
+	}
+
+	candidate := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "unbind.unbind.app/v1",
+			"kind":       "Service",
+			"metadata": map[string]any{
+				"name":      "web-candidate",
+				"namespace": "team-ns",
+			},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(testServiceIngress(), buildCanaryIngress(testServiceIngress(), 10))
+	fakeDynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), candidate)
+	kubeClient := &KubeClient{
+		clientset: fakeClient,
+		client:    fakeDynamicClient,
+	}
+
+	require.NoError(t, kubeClient.DeleteRolloutCandidate(context.Background(), "team-ns", "web"))
+
+	_, err := fakeClient.NetworkingV1().Ingresses("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = fakeDynamicClient.Resource(serviceGVR).Namespace("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// The service's own ingress stays
+	_, err = fakeClient.NetworkingV1().Ingresses("team-ns").Get(context.Background(), "web", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// Nothing left to delete is fine
+	assert.NoError(t, kubeClient.DeleteRolloutCandidate(context.Background(), "team-ns", "web"))
+}
+
+func TestDeployRolloutCandidate(t *testing.T) {
+	serviceGVR := schema.GroupVersionResource{
+		Group:    "unbind.unbind.app",
+		Version:  "v1",
+		Resource: "services",
+	}
+
+	service := &unbindv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "team-ns",
+		},
+		Spec: unbindv1.ServiceSpec{
+			Name:       "web",
+			ServiceRef: "service-1",
+			Config: unbindv1.ServiceConfigSpec{
+				Public: true,
+				Hosts:  []unbindv1.HostSpec{{Host: "web.example.com"}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewSimpleClientset(testServiceIngress())
+	fakeDynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	kubeClient := &KubeClient{
+		clientset: fakeClient,
+		client:    fakeDynamicClient,
+	}
+
+	require.NoError(t, kubeClient.DeployRolloutCandidate(context.Background(), service, 20))
+
+	candidate, err := fakeDynamicClient.Resource(serviceGVR).Namespace("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+	require.NoError(t, err)
+	serviceRef, _, _ := unstructured.NestedString(candidate.Object, "spec", "serviceRef")
+	assert.Empty(t, serviceRef)
+	hosts, _, _ := unstructured.NestedSlice(candidate.Object, "spec", "config", "hosts")
+	assert.Empty(t, hosts)
+
+	canary, err := fakeClient.NetworkingV1().Ingresses("team-ns").Get(context.Background(), "web-candidate", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "20", canary.Annotations["nginx.ingress.kubernetes.io/canary-weight"])
+
+	// The service itself is left alone
+	assert.Equal(t, "service-1", service.Spec.ServiceRef)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -13,14 +15,78 @@ import (
 // Default poll interval for processor
 const POLL_INTERVAL = 5 * time.Second
 
-// Default concurrency for processor
+// Default concurrency for processor, when no limits are configured
 const QUEUE_CONCURRENCY = 2
 
+// How long a leased item can be in-flight before it's considered abandoned and requeued
+const LEASE_DURATION = 5 * time.Minute
+
+// How many times the processor will attempt an item before dropping it
+const MAX_ATTEMPTS = 3
+
+// Suffix for the sorted set holding leased items, scored by lease expiry
+const IN_FLIGHT_KEY_SUFFIX = ":inflight"
+
+// Atomically moves up to N items from the queue to the in-flight set
+var leaseScript = redis.NewScript(`
+local items = redis.call('ZRANGE', KEYS[1], 0, tonumber(ARGV[1]) - 1)
+for _, member in ipairs(items) do
+	redis.call('ZREM', KEYS[1], member)
+	redis.call('ZADD', KEYS[2], ARGV[2], member)
+end
+return items
+`)
+
+// Atomically moves the given members from the queue to the in-flight set, skipping any already taken
+var leaseMembersScript = redis.NewScript(`
+local leased = {}
+for i = 2, #ARGV do
+	if redis.call('ZREM', KEYS[1], ARGV[i]) == 1 then
+		redis.call('ZADD', KEYS[2], ARGV[1], ARGV[i])
+		table.insert(leased, ARGV[i])
+	end
+end
+return leased
+`)
+
+// Atomically moves an item from the in-flight set back to the queue, only if it's still in-flight
+var requeueScript = redis.NewScript(`
+if redis.call('ZREM', KEYS[1], ARGV[1]) == 1 then
+	redis.call('ZADD', KEYS[2], ARGV[2], ARGV[3])
+	return 1
+end
+return 0
+`)
+
 // Prirority queue implementation using redis sorted set
 type Queue[T any] struct {
-	client       *redis.Client
-	key          string
-	pollInterval time.Duration
+	client        *redis.Client
+	key           string
+	inFlightKey   string
+	pollInterval  time.Duration
+	leaseDuration time.Duration
+	// Last group served by LeaseRoundRobin, so the next poll starts with the group after it
+	lastGroup string
+}
+
+// Concurrency limits for a grouped processor
+type ConcurrencyLimits struct {
+	// Max items processing at once across all groups
+	Global int
+	// Max items processing at once per group, 0 for no limit
+	PerGroup int
+}
+
+// Options for StartGroupedProcessor
+type GroupedProcessorOptions[T any] struct {
+	// Identifies the group an item belongs to, e.g. the team that owns it
+	GroupKey func(item *QueueItem[T]) string
+	// Returns the number of active jobs per group
+	ActiveJobs func(ctx context.Context) (map[string]int, error)
+	// Returns the current limits, called every poll so changes apply without a restart
+	Limits func(ctx context.Context) (ConcurrencyLimits, error)
+	// Optional, called with the last error when an item is dropped after MAX_ATTEMPTS
+	GiveUp func(ctx context.Context, item *QueueItem[T], err error)
 }
 
 // Item in queue and metadata
@@ -29,24 +95,36 @@ type QueueItem[T any] struct {
 	Data       T         `json:"data"`
 	EnqueuedAt time.Time `json:"enqueued_at"`
 	Priority   int       `json:"priority"`
+	Attempts   int       `json:"attempts"`
 }
 
 func NewQueue[T any](client *redis.Client, key string) *Queue[T] {
 	return &Queue[T]{
-		client:       client,
-		key:          key,
-		pollInterval: POLL_INTERVAL,
+		client:        client,
+		key:           key,
+		inFlightKey:   key + IN_FLIGHT_KEY_SUFFIX,
+		pollInterval:  POLL_INTERVAL,
+		leaseDuration: LEASE_DURATION,
 	}
 }
 
-// Add an item to queue
-// ! TODO - now we don't care about priority but we might in the future (e.g. production vs dev environment)
+// Score offset per priority level, larger than any millisecond timestamp so priority always wins over enqueue time
+const PRIORITY_SCORE_WEIGHT = 1e13
+
+// Add an item to queue with the default priority
 func (q *Queue[T]) Enqueue(ctx context.Context, id string, data T) error {
+	return q.EnqueueWithPriority(ctx, id, data, 0)
+}
+
+// EnqueueWithPriority adds an item to the queue, higher priority items are dequeued first
+// Items with the same priority are dequeued in the order they were added
+func (q *Queue[T]) EnqueueWithPriority(ctx context.Context, id string, data T, priority int) error {
+	now := time.Now()
 	item := &QueueItem[T]{
 		ID:         id,
 		Data:       data,
-		EnqueuedAt: time.Now(),
-		Priority:   0,
+		EnqueuedAt: now,
+		Priority:   priority,
 	}
 
 	// Serialize item to JSON
@@ -55,16 +133,17 @@ func (q *Queue[T]) Enqueue(ctx context.Context, id string, data T) error {
 		return err
 	}
 
-	// Calculate priority based on timestamp
-	// ! We would subtract priority * 1000000 here, for priority
-	score := float64(time.Now().Unix())
-
 	return q.client.ZAdd(ctx, q.key, redis.Z{
-		Score:  score,
+		Score:  item.score(),
 		Member: string(itemData),
 	}).Err()
 }
 
+// Lowest score is dequeued first, so subtract priority from the enqueue timestamp
+func (item *QueueItem[T]) score() float64 {
+	return float64(item.EnqueuedAt.UnixMilli()) - float64(item.Priority)*PRIORITY_SCORE_WEIGHT
+}
+
 // Dequeue removes and returns highest priority item
 func (q *Queue[T]) Dequeue(ctx context.Context) (*QueueItem[T], error) {
 	results, err := q.client.ZRange(ctx, q.key, 0, 0).Result()
@@ -116,7 +195,7 @@ func (q *Queue[T]) DequeueN(ctx context.Context, n int) ([]*QueueItem[T], error)
 		n = 1
 	}
 
-	// Get the oldest items (lowest scores)
+	// Get the highest priority, oldest items (lowest scores)
 	results, err := q.client.ZRange(ctx, q.key, 0, int64(n-1)).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get items from queue: %w", err)
@@ -145,7 +224,7 @@ func (q *Queue[T]) DequeueN(ctx context.Context, n int) ([]*QueueItem[T], error)
 	return items, nil
 }
 
-// GetAll returns all items in the queue ordered by insertion time
+// GetAll returns all items in the queue ordered by priority, then insertion time
 func (q *Queue[T]) GetAll(ctx context.Context) ([]*QueueItem[T], error) {
 	results, err := q.client.ZRange(ctx, q.key, 0, -1).Result()
 	if err != nil {
@@ -197,8 +276,241 @@ func (q *Queue[T]) Remove(ctx context.Context, id string) error {
 	return fmt.Errorf("item with ID %s not found in queue", id)
 }
 
+// Lease moves up to N items to the in-flight set and returns them
+// Leased items must be acknowledged with Ack or Nack, otherwise they are requeued once the lease expires
+func (q *Queue[T]) Lease(ctx context.Context, n int) ([]*QueueItem[T], error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	expiresAt := time.Now().Add(q.leaseDuration).UnixMilli()
+	results, err := leaseScript.Run(ctx, q.client, []string{q.key, q.inFlightKey}, n, expiresAt).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to lease items from queue: %w", err)
+	}
+
+	// Deserialize the items
+	items := make([]*QueueItem[T], 0, len(results))
+	for _, result := range results {
+		var item QueueItem[T]
+		if err := json.Unmarshal([]byte(result), &item); err != nil {
+			// Don't keep an unreadable item leased forever
+			q.client.ZRem(ctx, q.inFlightKey, result)
+			log.Errorf("Failed to deserialize leased queue item: %v", err)
+			continue
+		}
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// LeaseRoundRobin leases up to N items, taking one item from each group in turn
+// groupSlots returns how many more items a group may lease, or a negative number for no limit
+func (q *Queue[T]) LeaseRoundRobin(ctx context.Context, n int, groupKey func(item *QueueItem[T]) string, groupSlots func(group string) int) ([]*QueueItem[T], error) {
+	if n <= 0 {
+		return []*QueueItem[T]{}, nil
+	}
+
+	results, err := q.client.ZRange(ctx, q.key, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items from queue: %w", err)
+	}
+
+	// Split into per-group lists, groups are ordered by their first (highest priority, oldest) item
+	type pending struct {
+		member string
+		item   *QueueItem[T]
+	}
+	var groups []string
+	byGroup := make(map[string][]pending)
+	for _, result := range results {
+		var item QueueItem[T]
+		if err := json.Unmarshal([]byte(result), &item); err != nil {
+			continue
+		}
+		group := groupKey(&item)
+		if _, ok := byGroup[group]; !ok {
+			groups = append(groups, group)
+		}
+		byGroup[group] = append(byGroup[group], pending{member: result, item: &item})
+	}
+
+	// Start with the group after the one served last
+	for i, group := range groups {
+		if group == q.lastGroup {
+			groups = slices.Concat(groups[i+1:], groups[:i+1])
+			break
+		}
+	}
+
+	remaining := make(map[string]int, len(groups))
+	for _, group := range groups {
+		remaining[group] = groupSlots(group)
+	}
+
+	var members []any
+	selected := make(map[string]*QueueItem[T])
+	for len(selected) < n {
+		progressed := false
+		for _, group := range groups {
+			if len(selected) >= n {
+				break
+			}
+			if len(byGroup[group]) == 0 || remaining[group] == 0 {
+				continue
+			}
+			next := byGroup[group][0]
+			byGroup[group] = byGroup[group][1:]
+			remaining[group]--
+			members = append(members, next.member)
+			selected[next.member] = next.item
+			q.lastGroup = group
+			progressed = true
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	if len(members) == 0 {
+		return []*QueueItem[T]{}, nil
+	}
+
+	expiresAt := time.Now().Add(q.leaseDuration).UnixMilli()
+	leased, err := leaseMembersScript.Run(ctx, q.client, []string{q.key, q.inFlightKey}, append([]any{expiresAt}, members...)...).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to lease items from queue: %w", err)
+	}
+
+	items := make([]*QueueItem[T], 0, len(leased))
+	for _, member := range leased {
+		items = append(items, selected[member])
+	}
+
+	return items, nil
+}
+
+// Ack removes a leased item from the in-flight set once it's been processed
+func (q *Queue[T]) Ack(ctx context.Context, id string) error {
+	member, _, err := q.findInFlight(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := q.client.ZRem(ctx, q.inFlightKey, member).Err(); err != nil {
+		return fmt.Errorf("failed to remove item from in-flight set: %w", err)
+	}
+	return nil
+}
+
+// Nack returns a leased item to its original position in the queue, counting the failed attempt
+func (q *Queue[T]) Nack(ctx context.Context, id string) error {
+	member, item, err := q.findInFlight(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	item.Attempts++
+	_, err = q.requeue(ctx, member, item)
+	return err
+}
+
+// RequeueExpired returns items whose lease has expired to the queue, e.g. after a crash mid-processing
+func (q *Queue[T]) RequeueExpired(ctx context.Context) (int, error) {
+	results, err := q.client.ZRangeByScore(ctx, q.inFlightKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired items from in-flight set: %w", err)
+	}
+
+	requeued := 0
+	for _, result := range results {
+		var item QueueItem[T]
+		if err := json.Unmarshal([]byte(result), &item); err != nil {
+			q.client.ZRem(ctx, q.inFlightKey, result)
+			log.Errorf("Failed to deserialize expired queue item: %v", err)
+			continue
+		}
+
+		moved, err := q.requeue(ctx, result, &item)
+		if err != nil {
+			return requeued, err
+		}
+		if moved {
+			requeued++
+		}
+	}
+
+	return requeued, nil
+}
+
+// InFlightSize returns the number of leased items that have not been acknowledged
+func (q *Queue[T]) InFlightSize(ctx context.Context) (int64, error) {
+	return q.client.ZCard(ctx, q.inFlightKey).Result()
+}
+
+// requeue moves an in-flight member back to the queue, returns false if it was no longer in-flight
+func (q *Queue[T]) requeue(ctx context.Context, member string, item *QueueItem[T]) (bool, error) {
+	itemData, err := json.Marshal(item)
+	if err != nil {
+		return false, err
+	}
+
+	moved, err := requeueScript.Run(ctx, q.client, []string{q.inFlightKey, q.key}, member, item.score(), string(itemData)).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue item: %w", err)
+	}
+	return moved == 1, nil
+}
+
+// findInFlight finds a leased item by ID, returning the raw member and the deserialized item
+func (q *Queue[T]) findInFlight(ctx context.Context, id string) (string, *QueueItem[T], error) {
+	results, err := q.client.ZRange(ctx, q.inFlightKey, 0, -1).Result()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get items from in-flight set: %w", err)
+	}
+
+	for _, result := range results {
+		var item QueueItem[T]
+		if err := json.Unmarshal([]byte(result), &item); err != nil {
+			continue
+		}
+
+		if item.ID == id {
+			return result, &item, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("item with ID %s not found in in-flight set", id)
+}
+
 func (q *Queue[T]) StartProcessor(ctx context.Context, processor func(ctx context.Context, item *QueueItem[T]) error, jobCounter func(ctx context.Context) (int, error)) {
+	q.StartGroupedProcessor(ctx, processor, GroupedProcessorOptions[T]{
+		GroupKey: func(item *QueueItem[T]) string {
+			return ""
+		},
+		ActiveJobs: func(ctx context.Context) (map[string]int, error) {
+			activeJobs, err := jobCounter(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]int{"": activeJobs}, nil
+		},
+		Limits: func(ctx context.Context) (ConcurrencyLimits, error) {
+			return ConcurrencyLimits{Global: QUEUE_CONCURRENCY}, nil
+		},
+	})
+}
+
+// StartGroupedProcessor processes items fairly across groups, respecting global and per-group limits
+func (q *Queue[T]) StartGroupedProcessor(ctx context.Context, processor func(ctx context.Context, item *QueueItem[T]) error, opts GroupedProcessorOptions[T]) {
 	go func() {
+		// Pick up anything that was in-flight when we last stopped
+		q.requeueExpired(ctx)
+
 		ticker := time.NewTicker(q.pollInterval)
 		defer ticker.Stop()
 		for {
@@ -206,21 +518,38 @@ func (q *Queue[T]) StartProcessor(ctx context.Context, processor func(ctx contex
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
+				q.requeueExpired(ctx)
+
+				limits, err := opts.Limits(ctx)
+				if err != nil {
+					log.Errorf("Error getting concurrency limits: %v", err)
+					continue
+				}
+
 				// Check how many active jobs are running in Kubernetes
-				activeJobs, err := jobCounter(ctx)
+				activeJobs, err := opts.ActiveJobs(ctx)
 				if err != nil {
 					log.Errorf("Error counting active jobs: %v", err)
 					continue
 				}
 
 				// Calculate available slots
-				availableSlots := QUEUE_CONCURRENCY - activeJobs
+				totalActive := 0
+				for _, count := range activeJobs {
+					totalActive += count
+				}
+				availableSlots := limits.Global - totalActive
 				if availableSlots <= 0 {
 					continue
 				}
 
-				// Try to dequeue up to availableSlots items
-				items, err := q.DequeueN(ctx, availableSlots)
+				// Try to lease up to availableSlots items, one group at a time
+				items, err := q.LeaseRoundRobin(ctx, availableSlots, opts.GroupKey, func(group string) int {
+					if limits.PerGroup <= 0 {
+						return -1
+					}
+					return max(limits.PerGroup-activeJobs[group], 0)
+				})
 				if err != nil || len(items) == 0 {
 					continue
 				}
@@ -232,6 +561,21 @@ func (q *Queue[T]) StartProcessor(ctx context.Context, processor func(ctx contex
 					go func(i *QueueItem[T]) {
 						if err := processor(ctx, i); err != nil {
 							log.Errorf("Error processing item %s: %v", i.ID, err)
+
+							if i.Attempts+1 < MAX_ATTEMPTS {
+								if err := q.Nack(ctx, i.ID); err != nil {
+									log.Errorf("Error returning item %s to queue: %v", i.ID, err)
+								}
+								return
+							}
+							log.Errorf("Dropping item %s after %d attempts", i.ID, i.Attempts+1)
+							if opts.GiveUp != nil {
+								opts.GiveUp(ctx, i, err)
+							}
+						}
+
+						if err := q.Ack(ctx, i.ID); err != nil {
+							log.Errorf("Error acknowledging item %s: %v", i.ID, err)
 						}
 					}(item)
 				}
@@ -239,3 +583,14 @@ func (q *Queue[T]) StartProcessor(ctx context.Context, processor func(ctx contex
 		}
 	}()
 }
+
+func (q *Queue[T]) requeueExpired(ctx context.Context) {
+	requeued, err := q.RequeueExpired(ctx)
+	if err != nil {
+		log.Errorf("Error requeueing expired items: %v", err)
+		return
+	}
+	if requeued > 0 {
+		log.Infof("Requeued %d expired items in %s", requeued, q.key)
+	}
+}
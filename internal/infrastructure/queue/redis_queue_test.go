@@ -199,6 +199,44 @@ func (s *RedisQueueTestSuite) TestDequeue_FIFO() {
 	s.Equal(int64(0), size)
 }
 
+func (s *RedisQueueTestSuite) TestEnqueueWithPriority_Ordering() {
+	// Higher priority jumps ahead, equal priority stays FIFO
+	items := []struct {
+		id       string
+		priority int
+	}{
+		{"low-1", 0},
+		{"high-1", 2},
+		{"low-2", 0},
+		{"mid-1", 1},
+		{"high-2", 2},
+	}
+
+	for _, item := range items {
+		err := s.queue.EnqueueWithPriority(s.ctx, item.id, s.createTestData(item.id, item.priority), item.priority)
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	expectedOrder := []string{"high-1", "high-2", "mid-1", "low-1", "low-2"}
+	for _, expectedID := range expectedOrder {
+		item, err := s.queue.Dequeue(s.ctx)
+		s.NoError(err)
+		s.NotNil(item)
+		s.Equal(expectedID, item.ID)
+	}
+}
+
+func (s *RedisQueueTestSuite) TestEnqueueWithPriority_StoresPriority() {
+	err := s.queue.EnqueueWithPriority(s.ctx, "test-id", s.createTestData("test-item", 1), 3)
+	s.NoError(err)
+
+	item, err := s.queue.Peek(s.ctx)
+	s.NoError(err)
+	s.NotNil(item)
+	s.Equal(3, item.Priority)
+}
+
 func (s *RedisQueueTestSuite) TestPeek_Success() {
 	data := s.createTestData("test-item", 42)
 	id := "test-id-1"
@@ -410,6 +448,306 @@ func (s *RedisQueueTestSuite) TestRemove_EmptyQueue() {
 	s.Contains(err.Error(), "not found in queue")
 }
 
+func (s *RedisQueueTestSuite) TestLease_MovesItemsInFlight() {
+	for i := 1; i <= 3; i++ {
+		err := s.queue.Enqueue(s.ctx, fmt.Sprintf("id-%d", i), s.createTestData(fmt.Sprintf("item-%d", i), i))
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	items, err := s.queue.Lease(s.ctx, 2)
+	s.NoError(err)
+	s.Len(items, 2)
+	s.Equal("id-1", items[0].ID)
+	s.Equal("id-2", items[1].ID)
+
+	size, err := s.queue.Size(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(1), size)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(2), inFlight)
+}
+
+func (s *RedisQueueTestSuite) TestLease_EmptyQueue() {
+	items, err := s.queue.Lease(s.ctx, 2)
+	s.NoError(err)
+	s.Empty(items)
+}
+
+func (s *RedisQueueTestSuite) TestLeaseRoundRobin_AlternatesGroups() {
+	// Team a floods the queue before team b enqueues anything
+	for _, id := range []string{"a-1", "a-2", "a-3", "b-1", "b-2"} {
+		err := s.queue.Enqueue(s.ctx, id, TestData{Name: id[:1]})
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	groupKey := func(item *QueueItem[TestData]) string { return item.Data.Name }
+	unlimited := func(group string) int { return -1 }
+
+	items, err := s.queue.LeaseRoundRobin(s.ctx, 4, groupKey, unlimited)
+	s.NoError(err)
+	s.Len(items, 4)
+	s.Equal("a-1", items[0].ID)
+	s.Equal("b-1", items[1].ID)
+	s.Equal("a-2", items[2].ID)
+	s.Equal("b-2", items[3].ID)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(4), inFlight)
+}
+
+func (s *RedisQueueTestSuite) TestLeaseRoundRobin_ResumesAfterLastGroup() {
+	for _, id := range []string{"a-1", "a-2", "b-1", "b-2"} {
+		err := s.queue.Enqueue(s.ctx, id, TestData{Name: id[:1]})
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	groupKey := func(item *QueueItem[TestData]) string { return item.Data.Name }
+	unlimited := func(group string) int { return -1 }
+
+	// One slot per poll should still alternate between groups
+	var order []string
+	for range 4 {
+		items, err := s.queue.LeaseRoundRobin(s.ctx, 1, groupKey, unlimited)
+		s.NoError(err)
+		s.Len(items, 1)
+		order = append(order, items[0].ID)
+	}
+	s.Equal([]string{"a-1", "b-1", "a-2", "b-2"}, order)
+}
+
+func (s *RedisQueueTestSuite) TestLeaseRoundRobin_RespectsGroupSlots() {
+	for _, id := range []string{"a-1", "a-2", "a-3", "b-1"} {
+		err := s.queue.Enqueue(s.ctx, id, TestData{Name: id[:1]})
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	groupKey := func(item *QueueItem[TestData]) string { return item.Data.Name }
+	slots := func(group string) int {
+		if group == "a" {
+			return 1
+		}
+		return 0
+	}
+
+	items, err := s.queue.LeaseRoundRobin(s.ctx, 4, groupKey, slots)
+	s.NoError(err)
+	s.Len(items, 1)
+	s.Equal("a-1", items[0].ID)
+
+	size, err := s.queue.Size(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(3), size)
+}
+
+func (s *RedisQueueTestSuite) TestAck_RemovesInFlightItem() {
+	err := s.queue.Enqueue(s.ctx, "ack-id", s.createTestData("ack-item", 1))
+	s.NoError(err)
+
+	items, err := s.queue.Lease(s.ctx, 1)
+	s.NoError(err)
+	s.Len(items, 1)
+
+	err = s.queue.Ack(s.ctx, "ack-id")
+	s.NoError(err)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), inFlight)
+
+	size, err := s.queue.Size(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), size)
+}
+
+func (s *RedisQueueTestSuite) TestAck_NotFound() {
+	err := s.queue.Ack(s.ctx, "missing-id")
+	s.Error(err)
+	s.Contains(err.Error(), "not found in in-flight set")
+}
+
+func (s *RedisQueueTestSuite) TestNack_RequeuesAtOriginalPosition() {
+	err := s.queue.Enqueue(s.ctx, "first", s.createTestData("first-item", 1))
+	s.NoError(err)
+	time.Sleep(2 * time.Millisecond)
+
+	items, err := s.queue.Lease(s.ctx, 1)
+	s.NoError(err)
+	s.Len(items, 1)
+
+	// Enqueued after the leased item, so it should stay behind it
+	err = s.queue.Enqueue(s.ctx, "second", s.createTestData("second-item", 2))
+	s.NoError(err)
+
+	err = s.queue.Nack(s.ctx, "first")
+	s.NoError(err)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), inFlight)
+
+	item, err := s.queue.Dequeue(s.ctx)
+	s.NoError(err)
+	s.Equal("first", item.ID)
+	s.Equal(1, item.Attempts)
+}
+
+func (s *RedisQueueTestSuite) TestRequeueExpired() {
+	s.queue.leaseDuration = time.Second
+
+	err := s.queue.Enqueue(s.ctx, "expired-id", s.createTestData("expired-item", 1))
+	s.NoError(err)
+
+	items, err := s.queue.Lease(s.ctx, 1)
+	s.NoError(err)
+	s.Len(items, 1)
+
+	// Lease still valid, nothing to requeue
+	requeued, err := s.queue.RequeueExpired(s.ctx)
+	s.NoError(err)
+	s.Equal(0, requeued)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	requeued, err = s.queue.RequeueExpired(s.ctx)
+	s.NoError(err)
+	s.Equal(1, requeued)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), inFlight)
+
+	item, err := s.queue.Peek(s.ctx)
+	s.NoError(err)
+	s.Equal("expired-id", item.ID)
+}
+
+func (s *RedisQueueTestSuite) TestStartGroupedProcessor_PerGroupLimit() {
+	var mu sync.Mutex
+	var processedItems []string
+
+	processor := func(ctx context.Context, item *QueueItem[TestData]) error {
+		mu.Lock()
+		defer mu.Unlock()
+		processedItems = append(processedItems, item.ID)
+		return nil
+	}
+
+	// Team a already has a build running
+	opts := GroupedProcessorOptions[TestData]{
+		GroupKey: func(item *QueueItem[TestData]) string { return item.Data.Name },
+		ActiveJobs: func(ctx context.Context) (map[string]int, error) {
+			return map[string]int{"a": 1}, nil
+		},
+		Limits: func(ctx context.Context) (ConcurrencyLimits, error) {
+			return ConcurrencyLimits{Global: 3, PerGroup: 1}, nil
+		},
+	}
+
+	for _, id := range []string{"a-1", "a-2", "b-1", "b-2"} {
+		err := s.queue.Enqueue(s.ctx, id, TestData{Name: id[:1]})
+		s.NoError(err)
+		time.Sleep(2 * time.Millisecond) // Ensure different timestamps
+	}
+
+	s.queue.pollInterval = 50 * time.Millisecond
+	processorCtx, processorCancel := context.WithCancel(s.ctx)
+	s.queue.StartGroupedProcessor(processorCtx, processor, opts)
+
+	time.Sleep(80 * time.Millisecond)
+	processorCancel()
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"b-1"}, processedItems)
+
+	size, err := s.queue.Size(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(3), size)
+}
+
+func (s *RedisQueueTestSuite) TestStartGroupedProcessor_GiveUp() {
+	var mu sync.Mutex
+	var givenUp []string
+	var lastErr error
+
+	processor := func(ctx context.Context, item *QueueItem[TestData]) error {
+		return fmt.Errorf("processing error for item %s", item.ID)
+	}
+
+	opts := GroupedProcessorOptions[TestData]{
+		GroupKey: func(item *QueueItem[TestData]) string { return "" },
+		ActiveJobs: func(ctx context.Context) (map[string]int, error) {
+			return map[string]int{}, nil
+		},
+		Limits: func(ctx context.Context) (ConcurrencyLimits, error) {
+			return ConcurrencyLimits{Global: 1}, nil
+		},
+		GiveUp: func(ctx context.Context, item *QueueItem[TestData], err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			givenUp = append(givenUp, item.ID)
+			lastErr = err
+		},
+	}
+
+	err := s.queue.Enqueue(s.ctx, "error-id", s.createTestData("error-item", 42))
+	s.NoError(err)
+
+	s.queue.pollInterval = 50 * time.Millisecond
+	processorCtx, processorCancel := context.WithCancel(s.ctx)
+	s.queue.StartGroupedProcessor(processorCtx, processor, opts)
+
+	time.Sleep(400 * time.Millisecond)
+	processorCancel()
+
+	// Only called once, after the final attempt
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"error-id"}, givenUp)
+	s.EqualError(lastErr, "processing error for item error-id")
+}
+
+func (s *RedisQueueTestSuite) TestStartProcessor_RequeuesExpiredOnStartup() {
+	// Simulate a crash, item leased but never acknowledged
+	s.queue.leaseDuration = -time.Second
+	err := s.queue.Enqueue(s.ctx, "orphan-id", s.createTestData("orphan-item", 1))
+	s.NoError(err)
+	_, err = s.queue.Lease(s.ctx, 1)
+	s.NoError(err)
+
+	var processed atomic.Int64
+	processor := func(ctx context.Context, item *QueueItem[TestData]) error {
+		processed.Add(1)
+		return nil
+	}
+
+	jobCounter := func(ctx context.Context) (int, error) {
+		return 0, nil
+	}
+
+	s.queue.leaseDuration = LEASE_DURATION
+	s.queue.pollInterval = 50 * time.Millisecond
+
+	processorCtx, processorCancel := context.WithCancel(s.ctx)
+	s.queue.StartProcessor(processorCtx, processor, jobCounter)
+
+	time.Sleep(200 * time.Millisecond)
+	processorCancel()
+
+	s.Equal(int64(1), processed.Load())
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), inFlight)
+}
+
 func (s *RedisQueueTestSuite) TestStartProcessor_Basic() {
 	// Setup processed items tracking
 	var processedItems []string
@@ -564,19 +902,23 @@ func (s *RedisQueueTestSuite) TestStartProcessor_ProcessorError() {
 	err := s.queue.Enqueue(s.ctx, "error-id", data)
 	s.NoError(err)
 
-	// Wait for processing
-	time.Sleep(200 * time.Millisecond)
+	// Wait for processing and retries
+	time.Sleep(400 * time.Millisecond)
 
 	// Stop processor
 	processorCancel()
 
-	// Verify error was encountered
-	s.Equal(int64(1), errorCount.Load())
+	// Verify item was retried up to the attempt limit
+	s.Equal(int64(MAX_ATTEMPTS), errorCount.Load())
 
-	// Verify item was removed from queue despite error
+	// Verify item was dropped after the final attempt
 	size, err := s.queue.Size(s.ctx)
 	s.NoError(err)
 	s.Equal(int64(0), size)
+
+	inFlight, err := s.queue.InFlightSize(s.ctx)
+	s.NoError(err)
+	s.Equal(int64(0), inFlight)
 }
 
 func (s *RedisQueueTestSuite) TestStartProcessor_JobCounterError() {
@@ -696,6 +1038,8 @@ func (s *RedisQueueTestSuite) TestQueue_RedisFailure() {
 func (s *RedisQueueTestSuite) TestConstants() {
 	s.Equal(5*time.Second, POLL_INTERVAL)
 	s.Equal(2, QUEUE_CONCURRENCY)
+	s.Equal(5*time.Minute, LEASE_DURATION)
+	s.Equal(3, MAX_ATTEMPTS)
 }
 
 func TestRedisQueueTestSuite(t *testing.T) {
@@ -235,6 +235,188 @@ func (self *RegistryTester) checkManifestWithBasicAuth(ctx context.Context, regi
 	return resp.StatusCode == http.StatusOK, nil
 }
 
+// manifestMediaTypes are accepted when resolving a digest, so multi-arch tags resolve to their index rather than one platform
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// GetImageDigest resolves the digest the image's tag currently points to, using credentials from the configured registries if it isn't public
+func (self *RegistryTester) GetImageDigest(ctx context.Context, image string) (string, error) {
+	if strings.Contains(image, "@") {
+		return "", fmt.Errorf("image %s is already pinned to a digest", image)
+	}
+
+	registryHost, imageName, tag := ParseImageString(image)
+
+	// First, try without credentials (public image)
+	digest, err := self.getManifestDigest(ctx, registryHost, imageName, tag, "", "")
+	if err == nil {
+		return digest, nil
+	}
+
+	registries, regErr := self.repo.System().GetAllRegistries(ctx)
+	if regErr != nil {
+		return "", fmt.Errorf("failed to query registries: %w", regErr)
+	}
+
+	for _, registry := range registries {
+		if registry.Host != registryHost {
+			continue
+		}
+
+		secret, secretErr := self.kubeClient.GetSecret(ctx, registry.KubernetesSecret, self.cfg.SystemNamespace, self.kubeClient.GetInternalClient())
+		if secretErr != nil {
+			continue // Skip if can't get secret
+		}
+
+		username, password, credErr := self.kubeClient.ParseRegistryCredentials(secret)
+		if credErr != nil {
+			continue // Skip if can't parse credentials
+		}
+
+		digest, err = self.getManifestDigest(ctx, registry.Host, imageName, tag, username, password)
+		if err == nil {
+			return digest, nil
+		}
+	}
+
+	return "", err
+}
+
+// getManifestDigest reads the Docker-Content-Digest of a tag's manifest
+func (self *RegistryTester) getManifestDigest(ctx context.Context, registryHost, imageName, tag, username, password string) (string, error) {
+	var registryURL string
+	if registryHost == "docker.io" {
+		registryURL = "https://index.docker.io/v2"
+	} else if !strings.HasPrefix(registryHost, "http") {
+		registryURL = "https://" + registryHost + "/v2"
+	} else {
+		registryURL = registryHost + "/v2"
+	}
+	url := fmt.Sprintf("%s/%s/manifests/%s", registryURL, imageName, tag)
+
+	var authorization string
+	if registryHost == "docker.io" {
+		token, err := self.getDockerHubToken(ctx, imageName, username, password)
+		if err != nil {
+			return "", err
+		}
+		authorization = "Bearer " + token
+	}
+
+	resp, err := self.headManifest(ctx, url, authorization, username, password)
+	if err != nil {
+		return "", err
+	}
+
+	// Registries like ghcr.io want a bearer token even for public images, they say where to get it in the challenge
+	if resp.StatusCode == http.StatusUnauthorized && authorization == "" {
+		token, err := self.getChallengeToken(ctx, resp.Header.Get("WWW-Authenticate"), username, password)
+		if err != nil {
+			return "", err
+		}
+		resp, err = self.headManifest(ctx, url, "Bearer "+token, "", "")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get manifest for %s/%s:%s: %s", registryHost, imageName, tag, resp.Status)
+	}
+
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("registry %s didn't return a digest for %s:%s", registryHost, imageName, tag)
+	}
+	return digest, nil
+}
+
+// headManifest requests a manifest's headers, authenticating with the authorization header if set or basic auth otherwise
+func (self *RegistryTester) headManifest(ctx context.Context, url, authorization, username, password string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "HEAD", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	} else if username != "" && password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return resp, nil
+}
+
+// getChallengeToken obtains a bearer token from the realm in a registry's WWW-Authenticate challenge
+func (self *RegistryTester) getChallengeToken(ctx context.Context, challenge, username, password string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry auth challenge: %q", challenge)
+	}
+
+	values := make(map[string]string)
+	for _, param := range strings.Split(params, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found {
+			values[key] = strings.Trim(value, `"`)
+		}
+	}
+	if values["realm"] == "" {
+		return "", fmt.Errorf("registry auth challenge has no realm: %q", challenge)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", values["realm"], nil)
+	if err != nil {
+		return "", err
+	}
+	query := req.URL.Query()
+	if values["service"] != "" {
+		query.Set("service", values["service"])
+	}
+	if values["scope"] != "" {
+		query.Set("scope", values["scope"])
+	}
+	req.URL.RawQuery = query.Encode()
+
+	if username != "" && password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get token: %s", resp.Status)
+	}
+
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", err
+	}
+
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	return tokenResponse.AccessToken, nil
+}
+
 // TestRegistryCredentials tests if the provided credentials are valid for a given registry (docker.io, ghcr, quay, or arbitrary URL)
 func (self *RegistryTester) TestRegistryCredentials(ctx context.Context, registryHost, username, password string) (bool, error) {
 	// We'll try to access a known endpoint that requires authentication
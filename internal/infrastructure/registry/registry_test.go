@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -563,6 +564,115 @@ func (suite *RegistryTesterTestSuite) TestCanPullImage_InvalidCredentialsParsing
 	suite.mockKubeClient.AssertExpectations(suite.T())
 }
 
+func (suite *RegistryTesterTestSuite) TestGetImageDigest_BearerChallenge() {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			suite.Equal("ghcr.io", r.URL.Query().Get("service"))
+			suite.Equal("repository:org/app:pull", r.URL.Query().Get("scope"))
+			json.NewEncoder(w).Encode(map[string]string{"token": "anonymous-token"})
+		case "/v2/org/app/manifests/1.0":
+			if r.Header.Get("Authorization") != "Bearer anonymous-token" {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="ghcr.io",scope="repository:org/app:pull"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			suite.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json")
+			w.Header().Set("Docker-Content-Digest", "sha256:abc123")
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	suite.registryTester.httpClient = &http.Client{
+		Transport: &redirectRoundTripper{target: server.URL},
+		Timeout:   5 * time.Second,
+	}
+
+	digest, err := suite.registryTester.GetImageDigest(suite.ctx, "ghcr.io/org/app:1.0")
+
+	suite.NoError(err)
+	suite.Equal("sha256:abc123", digest)
+}
+
+func (suite *RegistryTesterTestSuite) TestGetImageDigest_PrivateImageWithCredentials() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.URL.Path != "/v2/myapp/manifests/latest" || !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", "sha256:def456")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	registry := &ent.Registry{
+		ID:               uuid.New(),
+		Host:             "registry.example.com",
+		KubernetesSecret: "registry-secret",
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "registry-secret",
+			Namespace: "default",
+		},
+	}
+
+	suite.mockSystemRepo.On("GetAllRegistries", suite.ctx).Return([]*ent.Registry{registry}, nil)
+	suite.mockKubeClient.On("GetInternalClient").Return(suite.fakeK8sClient)
+	suite.mockKubeClient.On("GetSecret", suite.ctx, "registry-secret", "default", suite.fakeK8sClient).Return(secret, nil)
+	suite.mockKubeClient.On("ParseRegistryCredentials", secret).Return("admin", "secret", nil)
+
+	suite.registryTester.httpClient = &http.Client{
+		Transport: &redirectRoundTripper{target: server.URL},
+		Timeout:   5 * time.Second,
+	}
+
+	digest, err := suite.registryTester.GetImageDigest(suite.ctx, "registry.example.com/myapp:latest")
+
+	suite.NoError(err)
+	suite.Equal("sha256:def456", digest)
+	suite.mockSystemRepo.AssertExpectations(suite.T())
+	suite.mockKubeClient.AssertExpectations(suite.T())
+}
+
+func (suite *RegistryTesterTestSuite) TestGetImageDigest_NotFound() {
+	suite.mockSystemRepo.On("GetAllRegistries", suite.ctx).Return([]*ent.Registry{}, nil)
+
+	digest, err := suite.registryTester.GetImageDigest(suite.ctx, "registry.example.com/missing:latest")
+
+	suite.Error(err)
+	suite.Empty(digest)
+}
+
+func (suite *RegistryTesterTestSuite) TestGetImageDigest_PinnedImage() {
+	digest, err := suite.registryTester.GetImageDigest(suite.ctx, "nginx:1.27@sha256:abc123")
+
+	suite.Error(err)
+	suite.Empty(digest)
+}
+
+// redirectRoundTripper sends every request to a test server, keeping the path and query
+type redirectRoundTripper struct {
+	target string
+}
+
+func (m *redirectRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	target, err := url.Parse(m.target)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.URL.Scheme = target.Scheme
+	req.URL.Host = target.Host
+	req.Host = target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
 // Mock HTTP transport for testing
 type MockRoundTripper struct {
 	Response  *http.Response
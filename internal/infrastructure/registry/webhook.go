@@ -0,0 +1,141 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PushEvent is a tag pushed to a registry, as reported by its webhook
+type PushEvent struct {
+	// Host is empty if the payload doesn't say which registry it came from
+	Host       string
+	Repository string
+	Tag        string
+}
+
+// Matches reports whether the push is for the image's repository and tag
+func (self PushEvent) Matches(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+
+	registryHost, imageName, tag := ParseImageString(image)
+	if self.Host != "" && !strings.EqualFold(normalizeRegistryHost(self.Host), registryHost) {
+		return false
+	}
+
+	repository := self.Repository
+	if registryHost == "docker.io" && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	return repository == imageName && self.Tag == tag
+}
+
+func normalizeRegistryHost(host string) string {
+	switch strings.ToLower(host) {
+	case "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com", "hub.docker.com":
+		return "docker.io"
+	}
+	return host
+}
+
+// registryWebhookPayload covers the push payloads of Docker Hub, Harbor, Quay and distribution (registry:2) notifications
+type registryWebhookPayload struct {
+	// Docker Hub
+	PushData *struct {
+		Tag string `json:"tag"`
+	} `json:"push_data"`
+	// Docker Hub sends an object, Quay sends the repository name as a string
+	Repository json.RawMessage `json:"repository"`
+	// Quay
+	DockerURL   string   `json:"docker_url"`
+	UpdatedTags []string `json:"updated_tags"`
+	// Harbor
+	Type      string `json:"type"`
+	EventData *struct {
+		Resources []struct {
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+	} `json:"event_data"`
+	// Distribution
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+// ParsePushEvents extracts the pushed tags from a registry webhook payload
+func ParsePushEvents(body []byte) ([]PushEvent, error) {
+	var payload registryWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid registry webhook payload: %w", err)
+	}
+
+	var events []PushEvent
+	switch {
+	case payload.PushData != nil:
+		var repository struct {
+			RepoName string `json:"repo_name"`
+		}
+		if err := json.Unmarshal(payload.Repository, &repository); err != nil {
+			return nil, fmt.Errorf("invalid docker hub repository: %w", err)
+		}
+		events = append(events, PushEvent{
+			Host:       "docker.io",
+			Repository: repository.RepoName,
+			Tag:        payload.PushData.Tag,
+		})
+	case payload.DockerURL != "":
+		host, repository, _ := strings.Cut(payload.DockerURL, "/")
+		for _, tag := range payload.UpdatedTags {
+			events = append(events, PushEvent{
+				Host:       host,
+				Repository: repository,
+				Tag:        tag,
+			})
+		}
+	case payload.EventData != nil:
+		if payload.Type != "PUSH_ARTIFACT" {
+			break
+		}
+		for _, resource := range payload.EventData.Resources {
+			// e.g. harbor.example.com/library/app:1.0
+			host, imageName, _ := ParseImageString(resource.ResourceURL)
+			events = append(events, PushEvent{
+				Host:       host,
+				Repository: imageName,
+				Tag:        resource.Tag,
+			})
+		}
+	default:
+		for _, event := range payload.Events {
+			// Pushes by digest only have no tag to redeploy
+			if event.Action != "push" || event.Target.Tag == "" {
+				continue
+			}
+			events = append(events, PushEvent{
+				Host:       event.Request.Host,
+				Repository: event.Target.Repository,
+				Tag:        event.Target.Tag,
+			})
+		}
+	}
+
+	// Drop anything we couldn't make sense of
+	valid := events[:0]
+	for _, event := range events {
+		if event.Repository != "" && event.Tag != "" {
+			valid = append(valid, event)
+		}
+	}
+	return valid, nil
+}
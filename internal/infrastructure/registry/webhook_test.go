@@ -0,0 +1,110 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dockerHubPushJSON = `{
+	"callback_url": "https://registry.hub.docker.com/u/myorg/api/hook/abc/",
+	"push_data": {"pushed_at": 1760700000, "pusher": "someone", "tag": "latest"},
+	"repository": {"name": "api", "namespace": "myorg", "repo_name": "myorg/api"}
+}`
+
+const distributionPushJSON = `{
+	"events": [
+		{
+			"action": "push",
+			"target": {"mediaType": "application/vnd.oci.image.index.v1+json", "repository": "team/app", "digest": "sha256:abc", "tag": "1.0"},
+			"request": {"host": "registry.example.com:5000"}
+		},
+		{
+			"action": "push",
+			"target": {"repository": "team/app", "digest": "sha256:def"},
+			"request": {"host": "registry.example.com:5000"}
+		},
+		{
+			"action": "pull",
+			"target": {"repository": "team/app", "tag": "1.0"},
+			"request": {"host": "registry.example.com:5000"}
+		}
+	]
+}`
+
+const harborPushJSON = `{
+	"type": "PUSH_ARTIFACT",
+	"occur_at": 1760700000,
+	"operator": "admin",
+	"event_data": {
+		"resources": [{"digest": "sha256:abc", "tag": "v2", "resource_url": "harbor.example.com/library/web:v2"}],
+		"repository": {"name": "web", "namespace": "library", "repo_full_name": "library/web"}
+	}
+}`
+
+const quayPushJSON = `{
+	"repository": "org/worker",
+	"namespace": "org",
+	"name": "worker",
+	"docker_url": "quay.io/org/worker",
+	"updated_tags": ["latest", "stable"]
+}`
+
+func TestParsePushEvents(t *testing.T) {
+	t.Run("Docker Hub", func(t *testing.T) {
+		events, err := ParsePushEvents([]byte(dockerHubPushJSON))
+		require.NoError(t, err)
+		assert.Equal(t, []PushEvent{{Host: "docker.io", Repository: "myorg/api", Tag: "latest"}}, events)
+	})
+
+	t.Run("Distribution skips digest pushes and pulls", func(t *testing.T) {
+		events, err := ParsePushEvents([]byte(distributionPushJSON))
+		require.NoError(t, err)
+		assert.Equal(t, []PushEvent{{Host: "registry.example.com:5000", Repository: "team/app", Tag: "1.0"}}, events)
+	})
+
+	t.Run("Harbor", func(t *testing.T) {
+		events, err := ParsePushEvents([]byte(harborPushJSON))
+		require.NoError(t, err)
+		assert.Equal(t, []PushEvent{{Host: "harbor.example.com", Repository: "library/web", Tag: "v2"}}, events)
+	})
+
+	t.Run("Quay", func(t *testing.T) {
+		events, err := ParsePushEvents([]byte(quayPushJSON))
+		require.NoError(t, err)
+		assert.Equal(t, []PushEvent{
+			{Host: "quay.io", Repository: "org/worker", Tag: "latest"},
+			{Host: "quay.io", Repository: "org/worker", Tag: "stable"},
+		}, events)
+	})
+
+	t.Run("Invalid payload", func(t *testing.T) {
+		_, err := ParsePushEvents([]byte("not json"))
+		assert.Error(t, err)
+	})
+}
+
+func TestPushEventMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   PushEvent
+		image   string
+		matches bool
+	}{
+		{"Docker Hub", PushEvent{Host: "docker.io", Repository: "myorg/api", Tag: "latest"}, "myorg/api", true},
+		{"Docker Hub index host", PushEvent{Host: "index.docker.io", Repository: "myorg/api", Tag: "latest"}, "myorg/api:latest", true},
+		{"Docker Hub official image", PushEvent{Repository: "nginx", Tag: "1.27"}, "nginx:1.27", true},
+		{"Other tag", PushEvent{Host: "docker.io", Repository: "myorg/api", Tag: "dev"}, "myorg/api:latest", false},
+		{"Other registry", PushEvent{Host: "ghcr.io", Repository: "myorg/api", Tag: "latest"}, "myorg/api:latest", false},
+		{"Private registry", PushEvent{Host: "harbor.example.com", Repository: "library/web", Tag: "v2"}, "harbor.example.com/library/web:v2", true},
+		{"Unknown host", PushEvent{Repository: "library/web", Tag: "v2"}, "harbor.example.com/library/web:v2", true},
+		{"Pinned image", PushEvent{Repository: "nginx", Tag: "1.27"}, "nginx:1.27@sha256:abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.matches, tt.event.Matches(tt.image))
+		})
+	}
+}
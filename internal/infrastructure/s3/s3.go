@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -27,6 +28,7 @@ import (
 type S3APIInterface interface {
 	ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
 	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
 	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
 	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
 }
@@ -51,6 +53,9 @@ func NewHttpClient() aws.HTTPClient {
 var (
 	onceHTTP sync.Once
 	httpFast aws.HTTPClient
+	// Transfers of large objects, the fast client would time out
+	onceTransferHTTP sync.Once
+	httpTransfer     aws.HTTPClient
 )
 
 func fastHTTP() aws.HTTPClient {
@@ -61,6 +66,27 @@ func fastHTTP() aws.HTTPClient {
 	return httpFast
 }
 
+func transferHTTP() aws.HTTPClient {
+	onceTransferHTTP.Do(func() {
+		httpTransfer = awshttp.NewBuildableClient().
+			WithTimeout(30 * time.Minute).
+			WithDialerOptions(func(d *net.Dialer) {
+				d.Timeout = 5 * time.Second
+				d.KeepAlive = 30 * time.Second
+			}).
+			WithTransportOptions(func(t *http.Transport) {
+				t.TLSHandshakeTimeout = 5 * time.Second
+				t.ResponseHeaderTimeout = 30 * time.Second
+			}).
+			Freeze()
+	})
+	return httpTransfer
+}
+
+func withTransferHTTP(o *s3.Options) {
+	o.HTTPClient = transferHTTP()
+}
+
 // S3Client provides methods to interact with S3-compatible storage.
 type S3Client struct {
 	client S3APIInterface
@@ -163,6 +189,33 @@ func (c *S3Client) ProbeBucketRW(ctx context.Context, bucket string) error {
 	return nil
 }
 
+// UploadObject writes the content to the key in the bucket.
+func (c *S3Client) UploadObject(ctx context.Context, bucket, key string, content []byte, contentType string) error {
+	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        &bucket,
+		Key:           &key,
+		Body:          bytes.NewReader(content),
+		ContentLength: aws.Int64(int64(len(content))),
+		ContentType:   aws.String(contentType),
+	}, withTransferHTTP)
+	if err != nil {
+		return mapS3Error(err)
+	}
+	return nil
+}
+
+// DownloadObject opens the object at key in the bucket, the caller has to close it.
+func (c *S3Client) DownloadObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}, withTransferHTTP)
+	if err != nil {
+		return nil, mapS3Error(err)
+	}
+	return out.Body, nil
+}
+
 // deleteSilent is best-effort cleanup.
 func (c *S3Client) deleteSilent(ctx context.Context, bucket, key string) error {
 	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
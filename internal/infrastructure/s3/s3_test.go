@@ -3,8 +3,10 @@ package s3
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,6 +40,14 @@ func (m *MockS3API) PutObject(ctx context.Context, params *s3.PutObjectInput, op
 	return args.Get(0).(*s3.PutObjectOutput), args.Error(1)
 }
 
+func (m *MockS3API) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*s3.GetObjectOutput), args.Error(1)
+}
+
 func (m *MockS3API) HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -280,6 +290,62 @@ func (suite *S3TestSuite) TestProbeBucketRW_HeadObjectError() {
 	suite.mockS3API.AssertExpectations(suite.T())
 }
 
+// Test UploadObject method
+func (suite *S3TestSuite) TestUploadObject_Success() {
+	suite.mockS3API.On("PutObject", suite.ctx, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+		return aws.ToString(input.Bucket) == "test-bucket" &&
+			aws.ToString(input.Key) == "uploads/archive.tar.gz" &&
+			aws.ToInt64(input.ContentLength) == 7 &&
+			aws.ToString(input.ContentType) == "application/gzip"
+	})).Return(&s3.PutObjectOutput{}, nil)
+
+	// Execute test
+	err := suite.s3Client.UploadObject(suite.ctx, "test-bucket", "uploads/archive.tar.gz", []byte("archive"), "application/gzip")
+
+	// Assertions
+	suite.NoError(err)
+	suite.mockS3API.AssertExpectations(suite.T())
+}
+
+// Test DownloadObject method
+func (suite *S3TestSuite) TestDownloadObject_Success() {
+	suite.mockS3API.On("GetObject", suite.ctx, mock.AnythingOfType("*s3.GetObjectInput")).Return(&s3.GetObjectOutput{
+		Body: io.NopCloser(strings.NewReader("archive")),
+	}, nil)
+
+	// Execute test
+	body, err := suite.s3Client.DownloadObject(suite.ctx, "test-bucket", "uploads/archive.tar.gz")
+
+	// Assertions
+	suite.NoError(err)
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	suite.NoError(err)
+	suite.Equal("archive", string(content))
+	suite.mockS3API.AssertExpectations(suite.T())
+}
+
+func (suite *S3TestSuite) TestDownloadObject_NotFound() {
+	httpErr := &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{
+			Response: &http.Response{StatusCode: 404},
+		},
+		Err: errors.New("not found"),
+	}
+
+	suite.mockS3API.On("GetObject", suite.ctx, mock.AnythingOfType("*s3.GetObjectInput")).Return(nil, httpErr)
+
+	// Execute test
+	body, err := suite.s3Client.DownloadObject(suite.ctx, "test-bucket", "uploads/missing.tar.gz")
+
+	// Assertions
+	suite.Nil(body)
+	suite.Error(err)
+	customErr := err.(*errdefs.CustomError)
+	suite.Equal(errdefs.ErrTypeNotFound, customErr.Type)
+	suite.mockS3API.AssertExpectations(suite.T())
+}
+
 // Test mapS3Error function
 func (suite *S3TestSuite) TestMapS3Error_403Forbidden() {
 	httpErr := &smithyhttp.ResponseError{
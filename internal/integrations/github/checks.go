@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/unbindapp/unbind-api/ent"
+)
+
+// CheckRunState is what a check run on a commit should show
+type CheckRunState struct {
+	Name       string
+	Status     string // queued, in_progress or completed
+	Conclusion string // success, failure, cancelled, skipped or neutral, only when completed
+	Title      string
+	Summary    string
+	DetailsURL string
+}
+
+// ReportCheckRun creates a check run on the commit, or updates it if checkRunID is set
+// Returns the ID of the check run
+func (self *GithubClient) ReportCheckRun(ctx context.Context, installation *ent.GithubInstallation, owner, repo, headSHA string, checkRunID *int64, state CheckRunState) (int64, error) {
+	if installation == nil || installation.Edges.GithubApp == nil {
+		return 0, fmt.Errorf("invalid installation: missing app edge or nil")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	authenticatedClient, err := self.GetAuthenticatedClient(timeoutCtx, installation.GithubAppID, installation.ID, installation.Edges.GithubApp.PrivateKey)
+	if err != nil {
+		return 0, fmt.Errorf("error getting authenticated client for %s: %v", installation.AccountLogin, err)
+	}
+	defer authenticatedClient.Client().CloseIdleConnections()
+
+	output := &github.CheckRunOutput{
+		Title:   github.Ptr(state.Title),
+		Summary: github.Ptr(state.Summary),
+	}
+	var conclusion *string
+	var completedAt *github.Timestamp
+	if state.Status == "completed" {
+		conclusion = github.Ptr(state.Conclusion)
+		completedAt = &github.Timestamp{Time: time.Now()}
+	}
+
+	if checkRunID == nil {
+		checkRun, _, err := authenticatedClient.Checks.CreateCheckRun(timeoutCtx, owner, repo, github.CreateCheckRunOptions{
+			Name:        state.Name,
+			HeadSHA:     headSHA,
+			DetailsURL:  github.Ptr(state.DetailsURL),
+			Status:      github.Ptr(state.Status),
+			Conclusion:  conclusion,
+			CompletedAt: completedAt,
+			Output:      output,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("error creating check run on %s for repository %s/%s: %v", headSHA, owner, repo, err)
+		}
+		return checkRun.GetID(), nil
+	}
+
+	checkRun, _, err := authenticatedClient.Checks.UpdateCheckRun(timeoutCtx, owner, repo, *checkRunID, github.UpdateCheckRunOptions{
+		Name:        state.Name,
+		DetailsURL:  github.Ptr(state.DetailsURL),
+		Status:      github.Ptr(state.Status),
+		Conclusion:  conclusion,
+		CompletedAt: completedAt,
+		Output:      output,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error updating check run %d for repository %s/%s: %v", *checkRunID, owner, repo, err)
+	}
+	return checkRun.GetID(), nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/unbindapp/unbind-api/ent"
+)
+
+// UpsertPullRequestComment comments on the pull request, or edits the comment if commentID is set
+// Returns the ID of the comment
+func (self *GithubClient) UpsertPullRequestComment(ctx context.Context, installation *ent.GithubInstallation, owner, repo string, number int, commentID *int64, body string) (int64, error) {
+	if installation == nil || installation.Edges.GithubApp == nil {
+		return 0, fmt.Errorf("invalid installation: missing app edge or nil")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	authenticatedClient, err := self.GetAuthenticatedClient(timeoutCtx, installation.GithubAppID, installation.ID, installation.Edges.GithubApp.PrivateKey)
+	if err != nil {
+		return 0, fmt.Errorf("error getting authenticated client for %s: %v", installation.AccountLogin, err)
+	}
+	defer authenticatedClient.Client().CloseIdleConnections()
+
+	// Pull request comments are issue comments
+	if commentID == nil {
+		comment, _, err := authenticatedClient.Issues.CreateComment(timeoutCtx, owner, repo, number, &github.IssueComment{
+			Body: github.Ptr(body),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("error commenting on pull request %d for repository %s/%s: %v", number, owner, repo, err)
+		}
+		return comment.GetID(), nil
+	}
+
+	comment, _, err := authenticatedClient.Issues.EditComment(timeoutCtx, owner, repo, *commentID, &github.IssueComment{
+		Body: github.Ptr(body),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error editing comment %d for repository %s/%s: %v", *commentID, owner, repo, err)
+	}
+	return comment.GetID(), nil
+}
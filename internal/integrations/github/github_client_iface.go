@@ -32,5 +32,16 @@ type GithubClientInterface interface {
 	// Get branch head summary - sha, message, author
 	// GetCommitSummary - get summary for a specific commit or branch head
 	GetCommitSummary(ctx context.Context, installation *ent.GithubInstallation, owner, repo string, branchOrSHA string, isCommitSHA bool) (commitSHA, commitMessage string, committer *schema.GitCommitter, err error)
+	// CompareCommits gets the commits between base and head, github returns at most 250 of them
+	CompareCommits(ctx context.Context, installation *ent.GithubInstallation, owner, repo, base, head string) (*GithubCommitComparison, error)
+	// GetChangedFiles gets the paths of the files changed between base and head
+	// complete is false if github truncated the list
+	GetChangedFiles(ctx context.Context, installation *ent.GithubInstallation, owner, repo, base, head string) (files []string, complete bool, err error)
+	// ReportCheckRun creates a check run on the commit, or updates it if checkRunID is set
+	// Returns the ID of the check run
+	ReportCheckRun(ctx context.Context, installation *ent.GithubInstallation, owner, repo, headSHA string, checkRunID *int64, state CheckRunState) (int64, error)
+	// UpsertPullRequestComment comments on the pull request, or edits the comment if commentID is set
+	// Returns the ID of the comment
+	UpsertPullRequestComment(ctx context.Context, installation *ent.GithubInstallation, owner, repo string, number int, commentID *int64, body string) (int64, error)
 	ReadUserAdminOrganizations(ctx context.Context, installation *ent.GithubInstallation) ([]*github.Organization, error)
 }
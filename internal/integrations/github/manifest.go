@@ -29,6 +29,7 @@ type HookAttributes struct {
 
 // DefaultPermissions contains permission settings
 type DefaultPermissions struct {
+	Checks       string `json:"checks"`
 	Contents     string `json:"contents"`
 	Issues       string `json:"issues"`
 	Metadata     string `json:"metadata"`
@@ -57,6 +58,7 @@ func (self *GithubClient) CreateAppManifest(redirectUrl string, setupUrl string,
 		SetupUrl:    setupUrl,
 		Public:      false,
 		DefaultPermissions: DefaultPermissions{
+			Checks:       "write",
 			Contents:     "read",
 			Issues:       "write",
 			Metadata:     "read",
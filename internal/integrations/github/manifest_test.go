@@ -51,6 +51,7 @@ func (suite *ManifestTestSuite) TestCreateAppManifest_ForUser() {
 	suite.False(manifest.Public)
 
 	// Check permissions
+	suite.Equal("write", manifest.DefaultPermissions.Checks)
 	suite.Equal("read", manifest.DefaultPermissions.Contents)
 	suite.Equal("write", manifest.DefaultPermissions.Issues)
 	suite.Equal("read", manifest.DefaultPermissions.Metadata)
@@ -85,6 +86,7 @@ func (suite *ManifestTestSuite) TestCreateAppManifest_ForOrganization() {
 	suite.False(manifest.Public)
 
 	// Check permissions (should include members for organization)
+	suite.Equal("write", manifest.DefaultPermissions.Checks)
 	suite.Equal("read", manifest.DefaultPermissions.Contents)
 	suite.Equal("write", manifest.DefaultPermissions.Issues)
 	suite.Equal("read", manifest.DefaultPermissions.Metadata)
@@ -562,3 +562,104 @@ func (self *GithubClient) GetCommitSummary(ctx context.Context, installation *en
 
 	return commitSHA, commitMessage, committer, nil
 }
+
+// GithubCommit is a single commit in a comparison
+type GithubCommit struct {
+	SHA     string               `json:"sha"`
+	Message string               `json:"message"`
+	Author  *schema.GitCommitter `json:"author,omitempty"`
+	HTMLURL string               `json:"html_url"`
+}
+
+// GithubCommitComparison is the range of commits between two commits
+type GithubCommitComparison struct {
+	Status       string         `json:"status" doc:"ahead, behind, diverged or identical"`
+	AheadBy      int            `json:"ahead_by"`
+	BehindBy     int            `json:"behind_by"`
+	TotalCommits int            `json:"total_commits"`
+	HTMLURL      string         `json:"html_url"`
+	Commits      []GithubCommit `json:"commits" nullable:"false"`
+}
+
+// CompareCommits gets the commits between base and head, github returns at most 250 of them
+func (self *GithubClient) CompareCommits(ctx context.Context, installation *ent.GithubInstallation, owner, repo, base, head string) (*GithubCommitComparison, error) {
+	if installation == nil || installation.Edges.GithubApp == nil {
+		return nil, fmt.Errorf("invalid installation: missing app edge or nil")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	authenticatedClient, err := self.GetAuthenticatedClient(timeoutCtx, installation.GithubAppID, installation.ID, installation.Edges.GithubApp.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error getting authenticated client for %s: %v", installation.AccountLogin, err)
+	}
+	defer authenticatedClient.Client().CloseIdleConnections()
+
+	comparison, _, err := authenticatedClient.Repositories.CompareCommits(timeoutCtx, owner, repo, base, head, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fmt.Errorf("error comparing %s...%s for repository %s/%s: %v", base, head, owner, repo, err)
+	}
+
+	result := &GithubCommitComparison{
+		Status:       comparison.GetStatus(),
+		AheadBy:      comparison.GetAheadBy(),
+		BehindBy:     comparison.GetBehindBy(),
+		TotalCommits: comparison.GetTotalCommits(),
+		HTMLURL:      comparison.GetHTMLURL(),
+		Commits:      make([]GithubCommit, 0, len(comparison.Commits)),
+	}
+
+	for _, repoCommit := range comparison.Commits {
+		commit := GithubCommit{
+			SHA:     repoCommit.GetSHA(),
+			Message: repoCommit.GetCommit().GetMessage(),
+			HTMLURL: repoCommit.GetHTMLURL(),
+		}
+		if author := repoCommit.GetAuthor(); author != nil {
+			commit.Author = &schema.GitCommitter{
+				Name:      author.GetLogin(),
+				AvatarURL: author.GetAvatarURL(),
+			}
+		}
+		result.Commits = append(result.Commits, commit)
+	}
+
+	return result, nil
+}
+
+// The compare API lists at most this many changed files
+const maxComparedFiles = 300
+
+// GetChangedFiles gets the paths of the files changed between base and head
+// complete is false if github truncated the list
+func (self *GithubClient) GetChangedFiles(ctx context.Context, installation *ent.GithubInstallation, owner, repo, base, head string) (files []string, complete bool, err error) {
+	if installation == nil || installation.Edges.GithubApp == nil {
+		return nil, false, fmt.Errorf("invalid installation: missing app edge or nil")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	authenticatedClient, err := self.GetAuthenticatedClient(timeoutCtx, installation.GithubAppID, installation.ID, installation.Edges.GithubApp.PrivateKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting authenticated client for %s: %v", installation.AccountLogin, err)
+	}
+	defer authenticatedClient.Client().CloseIdleConnections()
+
+	comparison, _, err := authenticatedClient.Repositories.CompareCommits(timeoutCtx, owner, repo, base, head, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("error comparing %s...%s for repository %s/%s: %v", base, head, owner, repo, err)
+	}
+
+	files = make([]string, 0, len(comparison.Files))
+	for _, file := range comparison.Files {
+		files = append(files, file.GetFilename())
+		// A rename changes both paths
+		if file.GetPreviousFilename() != "" {
+			files = append(files, file.GetPreviousFilename())
+		}
+	}
+
+	return files, len(comparison.Files) < maxComparedFiles, nil
+}
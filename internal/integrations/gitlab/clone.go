@@ -0,0 +1,21 @@
+package gitlab
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitclone"
+)
+
+// CloneRepository clones a project over HTTPS, without authentication if no token is given
+func (self *GitlabClient) CloneRepository(ctx context.Context, accessToken string, repoURL string, refName string, commitSHA string) (string, error) {
+	if accessToken == "" {
+		return gitclone.Clone(ctx, repoURL, refName, commitSHA, nil)
+	}
+
+	// GitLab accepts OAuth and personal access tokens as the password of the oauth2 user
+	return gitclone.Clone(ctx, repoURL, refName, commitSHA, &http.BasicAuth{
+		Username: "oauth2",
+		Password: accessToken,
+	})
+}
@@ -0,0 +1,185 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unbindapp/unbind-api/config"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
+)
+
+// Refresh OAuth tokens this long before they expire, so a clone started with one doesn't fail half way
+const tokenRefreshMargin = 5 * time.Minute
+
+// Pages of 100 to read at most when listing
+const maxListPages = 10
+
+// GitlabClient handles GitLab integration operations, against gitlab.com or self-hosted instances
+//
+//go:generate go run -mod=mod github.com/vburenin/ifacemaker -f "*.go" -i GitlabClientInterface -p gitlab -s GitlabClient -o gitlab_client_iface.go
+type GitlabClient struct {
+	cfg        *config.Config
+	repo       repositories.RepositoriesInterface
+	httpClient *http.Client
+}
+
+func NewGitlabClient(cfg *config.Config, repo repositories.RepositoriesInterface) *GitlabClient {
+	return &GitlabClient{
+		cfg:  cfg,
+		repo: repo,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// APIError is a non-2xx response from the GitLab API
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("gitlab api returned %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 from the GitLab API
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// GetAccessToken returns the connection's token, refreshing and storing it first if it's an expiring OAuth token
+func (self *GitlabClient) GetAccessToken(ctx context.Context, connection *ent.GitlabConnection) (string, error) {
+	if connection.AuthType != gitlabconnection.AuthTypeOauth ||
+		connection.TokenExpiresAt == nil ||
+		time.Until(*connection.TokenExpiresAt) > tokenRefreshMargin {
+		return connection.AccessToken, nil
+	}
+
+	if connection.RefreshToken == nil || connection.ClientID == nil || connection.ClientSecret == nil {
+		return "", fmt.Errorf("gitlab connection %s token expired and can't be refreshed", connection.ID)
+	}
+
+	token, err := self.requestOAuthToken(ctx, connection.URL, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {*connection.RefreshToken},
+		"client_id":     {*connection.ClientID},
+		"client_secret": {*connection.ClientSecret},
+		"redirect_uri":  {self.cfg.GitlabOAuthCallbackURL},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh gitlab token: %w", err)
+	}
+
+	updated, err := self.repo.Gitlab().UpdateTokens(ctx, connection.ID, token.AccessToken, &token.RefreshToken, token.ExpiresAt())
+	if err != nil {
+		return "", err
+	}
+
+	connection.AccessToken = updated.AccessToken
+	connection.RefreshToken = updated.RefreshToken
+	connection.TokenExpiresAt = updated.TokenExpiresAt
+	return connection.AccessToken, nil
+}
+
+// apiURL builds the URL of a v4 API endpoint on the instance
+func apiURL(baseURL, endpoint string, query url.Values) string {
+	u := strings.TrimSuffix(baseURL, "/") + "/api/v4/" + strings.TrimPrefix(endpoint, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// projectEndpoint builds the path of a project endpoint, GitLab takes the url-encoded full path in place of the ID
+func projectEndpoint(projectPath string, parts ...string) string {
+	return strings.Join(append([]string{"projects", url.QueryEscape(projectPath)}, parts...), "/")
+}
+
+// doRequest sends an API request with the token, decoding the JSON response into out if it's not nil
+func (self *GitlabClient) doRequest(ctx context.Context, token, method, requestURL string, body any, out any) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp, &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp, fmt.Errorf("failed to decode gitlab response: %w", err)
+		}
+	}
+	return resp, nil
+}
+
+// get sends an authenticated GET for the connection
+func (self *GitlabClient) get(ctx context.Context, connection *ent.GitlabConnection, endpoint string, query url.Values, out any) (*http.Response, error) {
+	token, err := self.GetAccessToken(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	return self.doRequest(ctx, token, http.MethodGet, apiURL(connection.URL, endpoint, query), nil, out)
+}
+
+// listAll follows X-Next-Page through a paginated endpoint
+func listAll[T any](ctx context.Context, client *GitlabClient, connection *ent.GitlabConnection, endpoint string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("per_page", "100")
+
+	var all []T
+	page := 1
+	for range maxListPages {
+		query.Set("page", strconv.Itoa(page))
+		var items []T
+		resp, err := client.get(ctx, connection, endpoint, query, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		next, err := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+		if err != nil || next <= page {
+			break
+		}
+		page = next
+	}
+	return all, nil
+}
@@ -0,0 +1,196 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/unbindapp/unbind-api/config"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+	mocks_repositories "github.com/unbindapp/unbind-api/mocks/repositories"
+	mocks_repository_gitlab "github.com/unbindapp/unbind-api/mocks/repository/gitlab"
+)
+
+type GitlabClientTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	cfg            *config.Config
+	mockRepo       *mocks_repositories.RepositoriesMock
+	mockGitlabRepo *mocks_repository_gitlab.GitlabRepositoryMock
+	client         *GitlabClient
+}
+
+func (suite *GitlabClientTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.cfg = &config.Config{
+		GitlabWebhookURL:       "https://api.test.com/webhook/gitlab",
+		GitlabOAuthCallbackURL: "https://api.test.com/webhook/gitlab/oauth/callback",
+	}
+	suite.mockRepo = mocks_repositories.NewRepositoriesMock(suite.T())
+	suite.mockGitlabRepo = mocks_repository_gitlab.NewGitlabRepositoryMock(suite.T())
+	suite.client = NewGitlabClient(suite.cfg, suite.mockRepo)
+}
+
+func (suite *GitlabClientTestSuite) connection(url string) *ent.GitlabConnection {
+	return &ent.GitlabConnection{
+		ID:            uuid.New(),
+		URL:           url,
+		AuthType:      gitlabconnection.AuthTypeToken,
+		AccessToken:   "glpat-token",
+		WebhookSecret: "secret",
+	}
+}
+
+func (suite *GitlabClientTestSuite) TestGetAccessToken_PersonalAccessToken() {
+	token, err := suite.client.GetAccessToken(suite.ctx, suite.connection("https://gitlab.test.com"))
+
+	suite.NoError(err)
+	suite.Equal("glpat-token", token)
+}
+
+func (suite *GitlabClientTestSuite) TestGetAccessToken_OAuthNotExpiring() {
+	expiresAt := time.Now().Add(time.Hour)
+	connection := suite.connection("https://gitlab.test.com")
+	connection.AuthType = gitlabconnection.AuthTypeOauth
+	connection.TokenExpiresAt = &expiresAt
+
+	token, err := suite.client.GetAccessToken(suite.ctx, connection)
+
+	suite.NoError(err)
+	suite.Equal("glpat-token", token)
+}
+
+func (suite *GitlabClientTestSuite) TestGetAccessToken_RefreshesExpiringOAuthToken() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("/oauth/token", r.URL.Path)
+		suite.Require().NoError(r.ParseForm())
+		suite.Equal("refresh_token", r.PostForm.Get("grant_type"))
+		suite.Equal("old-refresh", r.PostForm.Get("refresh_token"))
+		suite.Equal("client-id", r.PostForm.Get("client_id"))
+		suite.Equal("client-secret", r.PostForm.Get("client_secret"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "new-access",
+			"refresh_token": "new-refresh",
+			"expires_in":    7200,
+			"created_at":    time.Now().Unix(),
+		})
+	}))
+	defer server.Close()
+
+	expiresAt := time.Now().Add(time.Minute)
+	refreshToken, clientID, clientSecret := "old-refresh", "client-id", "client-secret"
+	connection := suite.connection(server.URL)
+	connection.AuthType = gitlabconnection.AuthTypeOauth
+	connection.AccessToken = "old-access"
+	connection.TokenExpiresAt = &expiresAt
+	connection.RefreshToken = &refreshToken
+	connection.ClientID = &clientID
+	connection.ClientSecret = &clientSecret
+
+	newRefresh := "new-refresh"
+	newExpiresAt := time.Now().Add(2 * time.Hour)
+	suite.mockRepo.EXPECT().Gitlab().Return(suite.mockGitlabRepo)
+	suite.mockGitlabRepo.EXPECT().UpdateTokens(suite.ctx, connection.ID, "new-access", mock.MatchedBy(func(token *string) bool {
+		return token != nil && *token == "new-refresh"
+	}), mock.AnythingOfType("*time.Time")).Return(&ent.GitlabConnection{
+		ID:             connection.ID,
+		AccessToken:    "new-access",
+		RefreshToken:   &newRefresh,
+		TokenExpiresAt: &newExpiresAt,
+	}, nil)
+
+	token, err := suite.client.GetAccessToken(suite.ctx, connection)
+
+	suite.NoError(err)
+	suite.Equal("new-access", token)
+	suite.Equal("new-access", connection.AccessToken)
+	suite.Equal("new-refresh", *connection.RefreshToken)
+	suite.Equal(newExpiresAt, *connection.TokenExpiresAt)
+}
+
+func (suite *GitlabClientTestSuite) TestGetAccessToken_ExpiredWithoutRefreshToken() {
+	expiresAt := time.Now().Add(-time.Minute)
+	connection := suite.connection("https://gitlab.test.com")
+	connection.AuthType = gitlabconnection.AuthTypeOauth
+	connection.TokenExpiresAt = &expiresAt
+
+	_, err := suite.client.GetAccessToken(suite.ctx, connection)
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "can't be refreshed")
+}
+
+func (suite *GitlabClientTestSuite) TestGetCurrentUser() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/api/v4/user", r.URL.Path)
+		suite.Equal("Bearer glpat-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 42, "username": "jane", "name": "Jane Doe"}`))
+	}))
+	defer server.Close()
+
+	user, err := suite.client.GetCurrentUser(suite.ctx, server.URL+"/", "glpat-token")
+
+	suite.NoError(err)
+	suite.Equal(int64(42), user.ID)
+	suite.Equal("jane", user.Username)
+}
+
+func (suite *GitlabClientTestSuite) TestGetCurrentUser_Unauthorized() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "401 Unauthorized"}`))
+	}))
+	defer server.Close()
+
+	_, err := suite.client.GetCurrentUser(suite.ctx, server.URL, "bad-token")
+
+	suite.Error(err)
+	var apiErr *APIError
+	suite.ErrorAs(err, &apiErr)
+	suite.Equal(http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func (suite *GitlabClientTestSuite) TestGetOAuthAuthorizeURL() {
+	authorizeURL := suite.client.GetOAuthAuthorizeURL("https://gitlab.test.com/", "client-id", "state-123")
+
+	suite.Contains(authorizeURL, "https://gitlab.test.com/oauth/authorize?")
+	suite.Contains(authorizeURL, "client_id=client-id")
+	suite.Contains(authorizeURL, "state=state-123")
+	suite.Contains(authorizeURL, "redirect_uri=https%3A%2F%2Fapi.test.com%2Fwebhook%2Fgitlab%2Foauth%2Fcallback")
+}
+
+func (suite *GitlabClientTestSuite) TestExchangeOAuthCode() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Require().NoError(r.ParseForm())
+		suite.Equal("authorization_code", r.PostForm.Get("grant_type"))
+		suite.Equal("the-code", r.PostForm.Get("code"))
+		suite.Equal(suite.cfg.GitlabOAuthCallbackURL, r.PostForm.Get("redirect_uri"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "access", "refresh_token": "refresh", "expires_in": 7200, "created_at": 1700000000}`))
+	}))
+	defer server.Close()
+
+	token, err := suite.client.ExchangeOAuthCode(suite.ctx, server.URL, "client-id", "client-secret", "the-code")
+
+	suite.NoError(err)
+	suite.Equal("access", token.AccessToken)
+	suite.Equal("refresh", token.RefreshToken)
+	suite.Equal(time.Unix(1700000000+7200, 0), *token.ExpiresAt())
+}
+
+func TestGitlabClientSuite(t *testing.T) {
+	suite.Run(t, new(GitlabClientTestSuite))
+}
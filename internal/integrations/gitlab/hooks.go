@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/unbindapp/unbind-api/ent"
+)
+
+type gitlabAPIHook struct {
+	ID  int64  `json:"id"`
+	URL string `json:"url"`
+}
+
+type gitlabAPIHookInput struct {
+	URL                   string `json:"url"`
+	Token                 string `json:"token"`
+	PushEvents            bool   `json:"push_events"`
+	TagPushEvents         bool   `json:"tag_push_events"`
+	EnableSSLVerification bool   `json:"enable_ssl_verification"`
+}
+
+// EnsureProjectHook registers our push and tag push webhook on the project, updating it if it's already there
+func (self *GitlabClient) EnsureProjectHook(ctx context.Context, connection *ent.GitlabConnection, namespace, name string) error {
+	projectPath := namespace + "/" + name
+
+	token, err := self.GetAccessToken(ctx, connection)
+	if err != nil {
+		return err
+	}
+
+	hooks, err := listAll[gitlabAPIHook](ctx, self, connection, projectEndpoint(projectPath, "hooks"), nil)
+	if err != nil {
+		return fmt.Errorf("error listing hooks of gitlab project %s: %w", projectPath, err)
+	}
+
+	input := &gitlabAPIHookInput{
+		URL:                   self.cfg.GitlabWebhookURL,
+		Token:                 connection.WebhookSecret,
+		PushEvents:            true,
+		TagPushEvents:         true,
+		EnableSSLVerification: true,
+	}
+
+	for _, hook := range hooks {
+		if hook.URL == self.cfg.GitlabWebhookURL {
+			if _, err := self.doRequest(ctx, token, http.MethodPut, apiURL(connection.URL, projectEndpoint(projectPath, "hooks", fmt.Sprint(hook.ID)), nil), input, nil); err != nil {
+				return fmt.Errorf("error updating hook of gitlab project %s: %w", projectPath, err)
+			}
+			return nil
+		}
+	}
+
+	if _, err := self.doRequest(ctx, token, http.MethodPost, apiURL(connection.URL, projectEndpoint(projectPath, "hooks"), nil), input, nil); err != nil {
+		return fmt.Errorf("error creating hook of gitlab project %s: %w", projectPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scopes requested for OAuth connections, api is needed to register project webhooks
+const oauthScopes = "api read_user read_repository"
+
+// OAuthToken is GitLab's token endpoint response
+type OAuthToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// ExpiresAt returns when the access token expires, nil if it doesn't
+func (self *OAuthToken) ExpiresAt() *time.Time {
+	if self.ExpiresIn <= 0 {
+		return nil
+	}
+	issued := time.Now()
+	if self.CreatedAt > 0 {
+		issued = time.Unix(self.CreatedAt, 0)
+	}
+	expiresAt := issued.Add(time.Duration(self.ExpiresIn) * time.Second)
+	return &expiresAt
+}
+
+// OAuthState is what the OAuth callback needs to finish creating a connection, cached under the state parameter
+type OAuthState struct {
+	UserID       uuid.UUID `json:"user_id"`
+	Name         string    `json:"name"`
+	URL          string    `json:"url"`
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret"`
+	RedirectURL  string    `json:"redirect_url"`
+}
+
+// OAuthStateCacheKey is the cache key of an OAuth state
+func OAuthStateCacheKey(state string) string {
+	return "gitlab-oauth-" + state
+}
+
+// GetOAuthAuthorizeURL returns the URL to send the user to, to authorize the OAuth application
+func (self *GitlabClient) GetOAuthAuthorizeURL(baseURL, clientID, state string) string {
+	query := url.Values{
+		"client_id":     {clientID},
+		"redirect_uri":  {self.cfg.GitlabOAuthCallbackURL},
+		"response_type": {"code"},
+		"state":         {state},
+		"scope":         {oauthScopes},
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/oauth/authorize?" + query.Encode()
+}
+
+// ExchangeOAuthCode exchanges the code GitLab redirected back with for a token
+func (self *GitlabClient) ExchangeOAuthCode(ctx context.Context, baseURL, clientID, clientSecret, code string) (*OAuthToken, error) {
+	return self.requestOAuthToken(ctx, baseURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"redirect_uri":  {self.cfg.GitlabOAuthCallbackURL},
+	})
+}
+
+func (self *GitlabClient) requestOAuthToken(ctx context.Context, baseURL string, form url.Values) (*OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(baseURL, "/")+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+
+	token := &OAuthToken{}
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, fmt.Errorf("failed to decode gitlab token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("gitlab token response has no access token")
+	}
+	return token, nil
+}
@@ -0,0 +1,179 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"golang.org/x/sync/errgroup"
+)
+
+// Minimum access level to list a project, maintainers can add the webhook we deploy from
+const maintainerAccessLevel = "40"
+
+type gitlabAPIProject struct {
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Path              string    `json:"path"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	Description       string    `json:"description"`
+	DefaultBranch     string    `json:"default_branch"`
+	Visibility        string    `json:"visibility"`
+	WebURL            string    `json:"web_url"`
+	HTTPURLToRepo     string    `json:"http_url_to_repo"`
+	Archived          bool      `json:"archived"`
+	LastActivityAt    time.Time `json:"last_activity_at"`
+	Namespace         struct {
+		FullPath string `json:"full_path"`
+	} `json:"namespace"`
+}
+
+type gitlabAPIRef struct {
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+	Default   bool   `json:"default"`
+	Commit    struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+type GitlabProject struct {
+	ID           int64     `json:"id"`
+	ConnectionID uuid.UUID `json:"connection_id"`
+	Name         string    `json:"name"`
+	// Namespace is the project's full group path, services store it as the repository owner
+	Namespace      string    `json:"namespace"`
+	Path           string    `json:"path"`
+	FullPath       string    `json:"full_path"`
+	WebURL         string    `json:"web_url"`
+	CloneURL       string    `json:"clone_url"`
+	DefaultBranch  string    `json:"default_branch"`
+	Private        bool      `json:"private"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+}
+
+// GitlabProjectDetail contains a project's branches and tags
+type GitlabProjectDetail struct {
+	GitlabProject
+	Description string          `json:"description"`
+	Branches    []*GitlabBranch `json:"branches" nullable:"false"`
+	Tags        []*GitlabTag    `json:"tags" nullable:"false"`
+}
+
+// GitlabBranch contains information about a branch
+type GitlabBranch struct {
+	Name      string `json:"name"`
+	Ref       string `json:"ref"`
+	Protected bool   `json:"protected"`
+	SHA       string `json:"sha"`
+}
+
+// GitlabTag contains information about a tag
+type GitlabTag struct {
+	Name string `json:"name"`
+	Ref  string `json:"ref"`
+	SHA  string `json:"sha"`
+}
+
+func formatProject(project *gitlabAPIProject, connectionID uuid.UUID) GitlabProject {
+	return GitlabProject{
+		ID:             project.ID,
+		ConnectionID:   connectionID,
+		Name:           project.Name,
+		Namespace:      project.Namespace.FullPath,
+		Path:           project.Path,
+		FullPath:       project.PathWithNamespace,
+		WebURL:         project.WebURL,
+		CloneURL:       project.HTTPURLToRepo,
+		DefaultBranch:  project.DefaultBranch,
+		Private:        project.Visibility != "public",
+		LastActivityAt: project.LastActivityAt,
+	}
+}
+
+// ListProjects lists the projects the connection's user maintains, optionally filtered by name
+func (self *GitlabClient) ListProjects(ctx context.Context, connection *ent.GitlabConnection, search string) ([]*GitlabProject, error) {
+	query := url.Values{
+		"membership":       {"true"},
+		"min_access_level": {maintainerAccessLevel},
+		"archived":         {"false"},
+		"order_by":         {"last_activity_at"},
+	}
+	if search != "" {
+		query.Set("search", search)
+	}
+
+	projects, err := listAll[gitlabAPIProject](ctx, self, connection, "projects", query)
+	if err != nil {
+		return nil, fmt.Errorf("error listing gitlab projects: %w", err)
+	}
+
+	result := make([]*GitlabProject, len(projects))
+	for i := range projects {
+		project := formatProject(&projects[i], connection.ID)
+		result[i] = &project
+	}
+	return result, nil
+}
+
+// GetProjectDetail gets a project by namespace and path, with its branches and tags
+func (self *GitlabClient) GetProjectDetail(ctx context.Context, connection *ent.GitlabConnection, namespace, name string) (*GitlabProjectDetail, error) {
+	projectPath := namespace + "/" + name
+
+	project := &gitlabAPIProject{}
+	if _, err := self.get(ctx, connection, projectEndpoint(projectPath), nil, project); err != nil {
+		return nil, fmt.Errorf("error getting gitlab project %s: %w", projectPath, err)
+	}
+
+	var branches, tags []gitlabAPIRef
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		branches, err = listAll[gitlabAPIRef](gctx, self, connection, projectEndpoint(projectPath, "repository", "branches"), nil)
+		return err
+	})
+	g.Go(func() (err error) {
+		tags, err = listAll[gitlabAPIRef](gctx, self, connection, projectEndpoint(projectPath, "repository", "tags"), nil)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("error getting refs of gitlab project %s: %w", projectPath, err)
+	}
+
+	detail := &GitlabProjectDetail{
+		GitlabProject: formatProject(project, connection.ID),
+		Description:   project.Description,
+		Branches:      make([]*GitlabBranch, len(branches)),
+		Tags:          make([]*GitlabTag, len(tags)),
+	}
+	for i, branch := range branches {
+		detail.Branches[i] = &GitlabBranch{
+			Name:      branch.Name,
+			Ref:       "refs/heads/" + branch.Name,
+			Protected: branch.Protected,
+			SHA:       branch.Commit.ID,
+		}
+	}
+	for i, tag := range tags {
+		detail.Tags[i] = &GitlabTag{
+			Name: tag.Name,
+			Ref:  "refs/tags/" + tag.Name,
+			SHA:  tag.Commit.ID,
+		}
+	}
+	return detail, nil
+}
+
+// VerifyProjectAccess checks the connection can read the project, returning its clone URL and default branch
+func (self *GitlabClient) VerifyProjectAccess(ctx context.Context, connection *ent.GitlabConnection, namespace, name string) (canAccess bool, cloneURL string, defaultBranch string, err error) {
+	project := &gitlabAPIProject{}
+	if _, err := self.get(ctx, connection, projectEndpoint(namespace+"/"+name), nil, project); err != nil {
+		if IsNotFound(err) {
+			return false, "", "", nil
+		}
+		return false, "", "", err
+	}
+	return true, project.HTTPURLToRepo, project.DefaultBranch, nil
+}
@@ -0,0 +1,179 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/unbindapp/unbind-api/config"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+)
+
+type ProjectsTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *GitlabClient
+	server     *httptest.Server
+	mux        *http.ServeMux
+	connection *ent.GitlabConnection
+}
+
+func (suite *ProjectsTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mux = http.NewServeMux()
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("Bearer glpat-token", r.Header.Get("Authorization"))
+		suite.mux.ServeHTTP(w, r)
+	}))
+	suite.client = NewGitlabClient(&config.Config{
+		GitlabWebhookURL: "https://api.test.com/webhook/gitlab",
+	}, nil)
+	suite.connection = &ent.GitlabConnection{
+		ID:            uuid.New(),
+		URL:           suite.server.URL,
+		AuthType:      gitlabconnection.AuthTypeToken,
+		AccessToken:   "glpat-token",
+		WebhookSecret: "webhook-secret",
+	}
+}
+
+func (suite *ProjectsTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func project(id int64, namespace, path string) map[string]any {
+	return map[string]any{
+		"id":                  id,
+		"name":                path,
+		"path":                path,
+		"path_with_namespace": namespace + "/" + path,
+		"default_branch":      "main",
+		"visibility":          "private",
+		"http_url_to_repo":    "https://gitlab.test.com/" + namespace + "/" + path + ".git",
+		"namespace":           map[string]any{"full_path": namespace},
+	}
+}
+
+func (suite *ProjectsTestSuite) TestListProjects_FollowsPagination() {
+	suite.mux.HandleFunc("GET /api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("true", r.URL.Query().Get("membership"))
+		suite.Equal("40", r.URL.Query().Get("min_access_level"))
+		suite.Equal("api", r.URL.Query().Get("search"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			json.NewEncoder(w).Encode([]any{project(1, "group/sub", "api")})
+		case "2":
+			json.NewEncoder(w).Encode([]any{project(2, "group", "api-docs")})
+		default:
+			suite.Fail("unexpected page")
+		}
+	})
+
+	projects, err := suite.client.ListProjects(suite.ctx, suite.connection, "api")
+
+	suite.NoError(err)
+	suite.Len(projects, 2)
+	suite.Equal(int64(1), projects[0].ID)
+	suite.Equal("group/sub", projects[0].Namespace)
+	suite.Equal("api", projects[0].Path)
+	suite.Equal("group/sub/api", projects[0].FullPath)
+	suite.True(projects[0].Private)
+	suite.Equal(suite.connection.ID, projects[0].ConnectionID)
+	suite.Equal("group", projects[1].Namespace)
+}
+
+func (suite *ProjectsTestSuite) TestGetProjectDetail() {
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("group/sub/api", r.PathValue("id"))
+		json.NewEncoder(w).Encode(project(1, "group/sub", "api"))
+	})
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}/repository/branches", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "main", "protected": true, "commit": {"id": "abc123"}}]`))
+	})
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "v1.0.0", "commit": {"id": "def456"}}]`))
+	})
+
+	detail, err := suite.client.GetProjectDetail(suite.ctx, suite.connection, "group/sub", "api")
+
+	suite.NoError(err)
+	suite.Equal("group/sub/api", detail.FullPath)
+	suite.Len(detail.Branches, 1)
+	suite.Equal("refs/heads/main", detail.Branches[0].Ref)
+	suite.Equal("abc123", detail.Branches[0].SHA)
+	suite.True(detail.Branches[0].Protected)
+	suite.Len(detail.Tags, 1)
+	suite.Equal("refs/tags/v1.0.0", detail.Tags[0].Ref)
+	suite.Equal("def456", detail.Tags[0].SHA)
+}
+
+func (suite *ProjectsTestSuite) TestVerifyProjectAccess() {
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "group/api" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "404 Project Not Found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(project(1, "group", "api"))
+	})
+
+	canAccess, cloneURL, defaultBranch, err := suite.client.VerifyProjectAccess(suite.ctx, suite.connection, "group", "api")
+	suite.NoError(err)
+	suite.True(canAccess)
+	suite.Equal("https://gitlab.test.com/group/api.git", cloneURL)
+	suite.Equal("main", defaultBranch)
+
+	canAccess, _, _, err = suite.client.VerifyProjectAccess(suite.ctx, suite.connection, "group", "missing")
+	suite.NoError(err)
+	suite.False(canAccess)
+}
+
+func (suite *ProjectsTestSuite) TestEnsureProjectHook_CreatesHook() {
+	var created gitlabAPIHookInput
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}/hooks", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": 1, "url": "https://other.test.com/hook"}]`))
+	})
+	suite.mux.HandleFunc("POST /api/v4/projects/{id}/hooks", func(w http.ResponseWriter, r *http.Request) {
+		suite.Require().NoError(json.NewDecoder(r.Body).Decode(&created))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := suite.client.EnsureProjectHook(suite.ctx, suite.connection, "group", "api")
+
+	suite.NoError(err)
+	suite.Equal("https://api.test.com/webhook/gitlab", created.URL)
+	suite.Equal("webhook-secret", created.Token)
+	suite.True(created.PushEvents)
+	suite.True(created.TagPushEvents)
+}
+
+func (suite *ProjectsTestSuite) TestEnsureProjectHook_UpdatesExistingHook() {
+	var updatedID string
+	suite.mux.HandleFunc("GET /api/v4/projects/{id}/hooks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"id": 7, "url": %q}]`, "https://api.test.com/webhook/gitlab")
+	})
+	suite.mux.HandleFunc("PUT /api/v4/projects/{id}/hooks/{hookID}", func(w http.ResponseWriter, r *http.Request) {
+		updatedID = r.PathValue("hookID")
+	})
+	suite.mux.HandleFunc("POST /api/v4/projects/{id}/hooks", func(w http.ResponseWriter, r *http.Request) {
+		suite.Fail("hook should not be created twice")
+	})
+
+	err := suite.client.EnsureProjectHook(suite.ctx, suite.connection, "group", "api")
+
+	suite.NoError(err)
+	suite.Equal("7", updatedID)
+}
+
+func TestProjectsSuite(t *testing.T) {
+	suite.Run(t, new(ProjectsTestSuite))
+}
@@ -0,0 +1,23 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+)
+
+type GitlabUser struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	WebURL    string `json:"web_url"`
+}
+
+// GetCurrentUser returns the user a token belongs to, used to check a token before a connection is saved
+func (self *GitlabClient) GetCurrentUser(ctx context.Context, baseURL, token string) (*GitlabUser, error) {
+	user := &GitlabUser{}
+	if _, err := self.doRequest(ctx, token, http.MethodGet, apiURL(baseURL, "user", nil), nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Values of the X-Gitlab-Event header we handle
+const (
+	EventPush    = "Push Hook"
+	EventTagPush = "Tag Push Hook"
+)
+
+// PushEvent is the payload of push and tag push webhooks
+type PushEvent struct {
+	ObjectKind        string `json:"object_kind"`
+	Before            string `json:"before"`
+	After             string `json:"after"`
+	Ref               string `json:"ref"`
+	CheckoutSHA       string `json:"checkout_sha"`
+	UserName          string `json:"user_name"`
+	UserUsername      string `json:"user_username"`
+	UserAvatar        string `json:"user_avatar"`
+	TotalCommitsCount int    `json:"total_commits_count"`
+	Project           struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		GitHTTPURL        string `json:"git_http_url"`
+		WebURL            string `json:"web_url"`
+	} `json:"project"`
+	Commits []*PushEventCommit `json:"commits"`
+}
+
+type PushEventCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+	Added    []string `json:"added"`
+	Modified []string `json:"modified"`
+	Removed  []string `json:"removed"`
+}
+
+// ValidateWebhookToken compares the X-Gitlab-Token header to a connection's webhook secret
+func ValidateWebhookToken(token, secret string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// ParsePushEvent parses a push or tag push payload, other events return an error
+func ParsePushEvent(eventType string, payload []byte) (*PushEvent, error) {
+	if eventType != EventPush && eventType != EventTagPush {
+		return nil, fmt.Errorf("unsupported gitlab event %s", eventType)
+	}
+
+	event := &PushEvent{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ProjectNamespaceAndPath splits the project's full path into its group path and project path
+func (self *PushEvent) ProjectNamespaceAndPath() (namespace, path string) {
+	idx := strings.LastIndex(self.Project.PathWithNamespace, "/")
+	if idx < 0 {
+		return "", self.Project.PathWithNamespace
+	}
+	return self.Project.PathWithNamespace[:idx], self.Project.PathWithNamespace[idx+1:]
+}
+
+// HeadCommit returns the pushed commit, nil if the payload doesn't list it e.g. when a branch is deleted
+func (self *PushEvent) HeadCommit() *PushEventCommit {
+	sha := self.CheckoutSHA
+	if sha == "" {
+		sha = self.After
+	}
+	for _, commit := range self.Commits {
+		if commit.ID == sha {
+			return commit
+		}
+	}
+	return nil
+}
+
+// ChangedFiles lists the files changed by the push, ok is false if the payload's commit list is truncated
+func (self *PushEvent) ChangedFiles() (files []string, ok bool) {
+	if len(self.Commits) == 0 || self.TotalCommitsCount > len(self.Commits) {
+		return nil, false
+	}
+	for _, commit := range self.Commits {
+		files = append(files, commit.Added...)
+		files = append(files, commit.Removed...)
+		files = append(files, commit.Modified...)
+	}
+	return files, true
+}
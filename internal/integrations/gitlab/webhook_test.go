@@ -0,0 +1,52 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const pushPayload = `{
+	"object_kind": "push",
+	"before": "95790bf891e76fee5e1747ab589903a6a1f80f22",
+	"after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+	"ref": "refs/heads/main",
+	"checkout_sha": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+	"user_username": "jsmith",
+	"project": {"path_with_namespace": "group/sub/api", "git_http_url": "https://gitlab.test.com/group/sub/api.git"},
+	"commits": [
+		{"id": "b6568db1bc1dcd7f8b4d5a946b0b91f9dacd7327", "message": "first", "added": ["a.go"], "modified": [], "removed": []},
+		{"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "message": "second", "added": [], "modified": ["b.go"], "removed": ["c.go"]}
+	],
+	"total_commits_count": 2
+}`
+
+func TestParsePushEvent(t *testing.T) {
+	event, err := ParsePushEvent(EventPush, []byte(pushPayload))
+	assert.NoError(t, err)
+
+	namespace, path := event.ProjectNamespaceAndPath()
+	assert.Equal(t, "group/sub", namespace)
+	assert.Equal(t, "api", path)
+
+	assert.Equal(t, "second", event.HeadCommit().Message)
+
+	files, ok := event.ChangedFiles()
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{"a.go", "b.go", "c.go"}, files)
+
+	event.TotalCommitsCount = 30
+	_, ok = event.ChangedFiles()
+	assert.False(t, ok)
+}
+
+func TestParsePushEvent_UnsupportedEvent(t *testing.T) {
+	_, err := ParsePushEvent("Merge Request Hook", []byte(pushPayload))
+	assert.Error(t, err)
+}
+
+func TestValidateWebhookToken(t *testing.T) {
+	assert.True(t, ValidateWebhookToken("secret", "secret"))
+	assert.False(t, ValidateWebhookToken("wrong", "secret"))
+	assert.False(t, ValidateWebhookToken("", ""))
+}
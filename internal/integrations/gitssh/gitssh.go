@@ -0,0 +1,139 @@
+package gitssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gogitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitclone"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// DeployKeySecretKey is the key of the private deploy key in the service's deploy key secret
+	DeployKeySecretKey = "ssh-privatekey"
+	// Default user when the URL doesn't specify one, every major git host uses it
+	defaultUser = "git"
+	// Time allowed to connect to the git server and read its host key
+	scanTimeout = 10 * time.Second
+)
+
+// DeployKeySecretName is the name of the secret holding a service's private deploy key
+// It's kept apart from the service secret, which is exposed to the service and its builds as environment
+func DeployKeySecretName(serviceSecret string) string {
+	return serviceSecret + "-deploy-key"
+}
+
+// ParseURL parses an SSH clone URL, either ssh://[user@]host[:port]/path or user@host:path
+func ParseURL(repoURL string) (*transport.Endpoint, error) {
+	endpoint, err := transport.NewEndpoint(strings.TrimSpace(repoURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid git URL: %w", err)
+	}
+	if endpoint.Protocol != "ssh" {
+		return nil, fmt.Errorf("git URL must be an SSH URL, got %s", endpoint.Protocol)
+	}
+	if endpoint.Host == "" || strings.Trim(endpoint.Path, "/") == "" {
+		return nil, fmt.Errorf("git URL must have a host and a repository path")
+	}
+	if endpoint.User == "" {
+		endpoint.User = defaultUser
+	}
+	if endpoint.Port == 0 {
+		endpoint.Port = 22
+	}
+	return endpoint, nil
+}
+
+// RepositoryName returns the last element of the URL's path without .git, used to name services
+func RepositoryName(endpoint *transport.Endpoint) string {
+	path := strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git")
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// GenerateDeployKey generates an ed25519 key pair, returning the private key as PEM and the public key in authorized_keys format
+func GenerateDeployKey(comment string) (privateKeyPEM string, publicKey string, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	block, err := ssh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return "", "", err
+	}
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return "", "", err
+	}
+
+	publicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+	if comment != "" {
+		publicKey += " " + comment
+	}
+	return string(pem.EncodeToMemory(block)), publicKey, nil
+}
+
+// errHostKeyCaptured aborts the handshake once we have the server's host key
+var errHostKeyCaptured = errors.New("host key captured")
+
+// ScanHostKey connects to the git server and returns its host key in authorized_keys format, without authenticating
+func ScanHostKey(ctx context.Context, endpoint *transport.Endpoint) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, scanTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	var hostKey ssh.PublicKey
+	_, _, _, err = ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
+		User: endpoint.User,
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return errHostKeyCaptured
+		},
+	})
+	if hostKey == nil {
+		return "", fmt.Errorf("failed to read host key of %s: %w", addr, err)
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey))), nil
+}
+
+// CloneRepository clones a repository over SSH with the deploy key, only trusting the pinned host key
+func CloneRepository(ctx context.Context, repoURL string, privateKeyPEM string, hostKey string, refName string, commitSHA string) (string, error) {
+	endpoint, err := ParseURL(repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := gogitssh.NewPublicKeys(endpoint.User, []byte(privateKeyPEM), "")
+	if err != nil {
+		return "", fmt.Errorf("invalid deploy key: %w", err)
+	}
+
+	pinned, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return "", fmt.Errorf("invalid host key: %w", err)
+	}
+	auth.HostKeyCallback = ssh.FixedHostKey(pinned)
+
+	return gitclone.Clone(ctx, repoURL, refName, commitSHA, auth)
+}
@@ -0,0 +1,102 @@
+package gitssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseURL(t *testing.T) {
+	endpoint, err := ParseURL("git@codeberg.org:owner/repo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "git", endpoint.User)
+	assert.Equal(t, "codeberg.org", endpoint.Host)
+	assert.Equal(t, 22, endpoint.Port)
+	assert.Equal(t, "repo", RepositoryName(endpoint))
+
+	endpoint, err = ParseURL("ssh://forgejo@git.example.com:2222/group/sub/app")
+	require.NoError(t, err)
+	assert.Equal(t, "forgejo", endpoint.User)
+	assert.Equal(t, "git.example.com", endpoint.Host)
+	assert.Equal(t, 2222, endpoint.Port)
+	assert.Equal(t, "app", RepositoryName(endpoint))
+
+	endpoint, err = ParseURL("ssh://git.example.com/repo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "git", endpoint.User)
+	assert.Equal(t, 22, endpoint.Port)
+}
+
+func TestParseURL_Invalid(t *testing.T) {
+	for _, repoURL := range []string{
+		"https://codeberg.org/owner/repo.git",
+		"/srv/git/repo.git",
+		"ssh://git.example.com",
+		"",
+	} {
+		_, err := ParseURL(repoURL)
+		assert.Error(t, err, repoURL)
+	}
+}
+
+func TestGenerateDeployKey(t *testing.T) {
+	privateKey, publicKey, err := GenerateDeployKey("unbind-api")
+	require.NoError(t, err)
+
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	require.NoError(t, err)
+
+	parsed, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	require.NoError(t, err)
+	assert.Equal(t, "unbind-api", comment)
+	assert.Equal(t, ssh.KeyAlgoED25519, parsed.Type())
+	assert.Equal(t, signer.PublicKey().Marshal(), parsed.Marshal())
+}
+
+func TestScanHostKey(t *testing.T) {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPrivateKey)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		config := &ssh.ServerConfig{NoClientAuth: true}
+		config.AddHostKey(hostSigner)
+		ssh.NewServerConn(conn, config)
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	endpoint, err := ParseURL("ssh://git@127.0.0.1:" + strings.TrimPrefix(addr.String(), "127.0.0.1:") + "/repo.git")
+	require.NoError(t, err)
+
+	hostKey, err := ScanHostKey(context.Background(), endpoint)
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostSigner.PublicKey()))), hostKey)
+}
+
+func TestCloneRepository_InvalidKeys(t *testing.T) {
+	privateKey, publicKey, err := GenerateDeployKey("")
+	require.NoError(t, err)
+
+	_, err = CloneRepository(context.Background(), "git@codeberg.org:owner/repo.git", "not a key", publicKey, "refs/heads/main", "")
+	assert.ErrorContains(t, err, "invalid deploy key")
+
+	_, err = CloneRepository(context.Background(), "git@codeberg.org:owner/repo.git", privateKey, "not a key", "refs/heads/main", "")
+	assert.ErrorContains(t, err, "invalid host key")
+}
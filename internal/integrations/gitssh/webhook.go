@@ -0,0 +1,182 @@
+package gitssh
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// SignWebhook signs a service ID with the service's webhook secret, the signature is part of the service's webhook URL
+func SignWebhook(secret string, serviceID string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(serviceID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateWebhookSignature checks a webhook URL's signature in constant time
+func ValidateWebhookSignature(secret string, serviceID string, signature string) bool {
+	return secret != "" && hmac.Equal([]byte(SignWebhook(secret, serviceID)), []byte(signature))
+}
+
+// PushEvent is what we need from a push webhook, whichever host sent it
+type PushEvent struct {
+	// Full ref that was pushed, empty if the payload doesn't say
+	Ref           string
+	CommitSHA     string
+	CommitMessage string
+	Committer     string
+	Authors       []string
+	files         []string
+	filesComplete bool
+}
+
+// Gitea, Forgejo and GitHub style push payload
+type genericPushPayload struct {
+	Ref        string                  `json:"ref"`
+	After      string                  `json:"after"`
+	TotalCount int                     `json:"total_commits"`
+	HeadCommit *genericPayloadCommit   `json:"head_commit"`
+	Commits    []*genericPayloadCommit `json:"commits"`
+	Pusher     struct {
+		Login    string `json:"login"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"pusher"`
+}
+
+type genericPayloadCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name     string `json:"name"`
+		Username string `json:"username"`
+	} `json:"author"`
+	Added    []string `json:"added"`
+	Modified []string `json:"modified"`
+	Removed  []string `json:"removed"`
+}
+
+// Bitbucket push payload
+type bitbucketPushPayload struct {
+	Actor struct {
+		DisplayName string `json:"display_name"`
+		Nickname    string `json:"nickname"`
+	} `json:"actor"`
+	Push *struct {
+		Changes []struct {
+			New *struct {
+				Type   string `json:"type"`
+				Name   string `json:"name"`
+				Target struct {
+					Hash    string `json:"hash"`
+					Message string `json:"message"`
+					Author  struct {
+						Raw  string `json:"raw"`
+						User *struct {
+							DisplayName string `json:"display_name"`
+						} `json:"user"`
+					} `json:"author"`
+				} `json:"target"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+// ParsePushEvent parses a push payload from Gitea, Forgejo, Bitbucket or anything sending GitHub style payloads
+// An empty or unknown payload isn't an error, it's a push with nothing but the service's own branch known
+func ParsePushEvent(payload []byte) (*PushEvent, error) {
+	if len(strings.TrimSpace(string(payload))) == 0 {
+		return &PushEvent{}, nil
+	}
+
+	bitbucket := &bitbucketPushPayload{}
+	if err := json.Unmarshal(payload, bitbucket); err != nil {
+		return nil, err
+	}
+	if bitbucket.Push != nil {
+		return parseBitbucketPush(bitbucket), nil
+	}
+
+	generic := &genericPushPayload{}
+	if err := json.Unmarshal(payload, generic); err != nil {
+		return nil, err
+	}
+
+	event := &PushEvent{
+		Ref:       generic.Ref,
+		CommitSHA: generic.After,
+		Committer: firstNonEmpty(generic.Pusher.Login, generic.Pusher.Username, generic.Pusher.Name),
+	}
+
+	head := generic.HeadCommit
+	for _, commit := range generic.Commits {
+		if head == nil && commit.ID == generic.After {
+			head = commit
+		}
+		event.files = append(event.files, commit.Added...)
+		event.files = append(event.files, commit.Removed...)
+		event.files = append(event.files, commit.Modified...)
+		if author := firstNonEmpty(commit.Author.Username, commit.Author.Name); author != "" {
+			event.Authors = append(event.Authors, author)
+		}
+	}
+	// Gitea and Forgejo cap the listed commits, total_commits tells us if some are missing
+	event.filesComplete = len(generic.Commits) > 0 && generic.TotalCount <= len(generic.Commits)
+
+	if head != nil {
+		event.CommitMessage = head.Message
+		if event.CommitSHA == "" {
+			event.CommitSHA = head.ID
+		}
+	}
+	return event, nil
+}
+
+func parseBitbucketPush(payload *bitbucketPushPayload) *PushEvent {
+	event := &PushEvent{
+		Committer: firstNonEmpty(payload.Actor.Nickname, payload.Actor.DisplayName),
+	}
+
+	// Bitbucket lists one change per pushed ref, the last one that still exists is the one we build
+	for _, change := range payload.Push.Changes {
+		if change.New == nil {
+			continue
+		}
+		switch change.New.Type {
+		case "branch":
+			event.Ref = "refs/heads/" + change.New.Name
+		case "tag":
+			event.Ref = "refs/tags/" + change.New.Name
+		default:
+			continue
+		}
+		event.CommitSHA = change.New.Target.Hash
+		event.CommitMessage = change.New.Target.Message
+		event.Authors = nil
+		if change.New.Target.Author.User != nil {
+			event.Authors = append(event.Authors, change.New.Target.Author.User.DisplayName)
+		} else if change.New.Target.Author.Raw != "" {
+			event.Authors = append(event.Authors, change.New.Target.Author.Raw)
+		}
+	}
+	return event
+}
+
+// ChangedFiles lists the files changed by the push, ok is false if the payload doesn't list them all
+func (self *PushEvent) ChangedFiles() (files []string, ok bool) {
+	if !self.filesComplete {
+		return nil, false
+	}
+	return self.files, true
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
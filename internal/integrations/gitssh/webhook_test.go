@@ -0,0 +1,85 @@
+package gitssh
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const giteaPushJSON = `{
+	"ref": "refs/heads/main",
+	"before": "95790bf891e76fee5e1747ab589903a6a1f80f22",
+	"after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+	"total_commits": 2,
+	"head_commit": {"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "message": "second"},
+	"commits": [
+		{"id": "b6568db1bc1dcd7f8b4d5a946b0b91f9dacd7327", "message": "first", "author": {"name": "Jane", "username": "jane"}, "added": ["a.go"], "modified": [], "removed": []},
+		{"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "message": "second", "author": {"name": "Renovate Bot", "username": ""}, "added": [], "modified": ["b.go"], "removed": ["c.go"]}
+	],
+	"pusher": {"login": "jane", "username": "jane"}
+}`
+
+const bitbucketPushJSON = `{
+	"actor": {"display_name": "Jane Smith", "nickname": "jane"},
+	"push": {"changes": [
+		{"old": {"type": "branch", "name": "feature"}, "new": null},
+		{"new": {"type": "branch", "name": "main", "target": {"hash": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "message": "second\n", "author": {"raw": "Jane Smith <jane@example.com>", "user": {"display_name": "Jane Smith"}}}}}
+	]}
+}`
+
+func TestParsePushEvent_Gitea(t *testing.T) {
+	event, err := ParsePushEvent([]byte(giteaPushJSON))
+	require.NoError(t, err)
+
+	assert.Equal(t, "refs/heads/main", event.Ref)
+	assert.Equal(t, "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", event.CommitSHA)
+	assert.Equal(t, "second", event.CommitMessage)
+	assert.Equal(t, "jane", event.Committer)
+	assert.Equal(t, []string{"jane", "Renovate Bot"}, event.Authors)
+
+	files, ok := event.ChangedFiles()
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{"a.go", "b.go", "c.go"}, files)
+}
+
+func TestParsePushEvent_TruncatedCommits(t *testing.T) {
+	event, err := ParsePushEvent([]byte(`{"ref": "refs/heads/main", "after": "abc", "total_commits": 30, "commits": [{"id": "abc", "added": ["a.go"]}]}`))
+	require.NoError(t, err)
+
+	_, ok := event.ChangedFiles()
+	assert.False(t, ok)
+}
+
+func TestParsePushEvent_Bitbucket(t *testing.T) {
+	event, err := ParsePushEvent([]byte(bitbucketPushJSON))
+	require.NoError(t, err)
+
+	assert.Equal(t, "refs/heads/main", event.Ref)
+	assert.Equal(t, "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", event.CommitSHA)
+	assert.Equal(t, "second\n", event.CommitMessage)
+	assert.Equal(t, "jane", event.Committer)
+	assert.Equal(t, []string{"Jane Smith"}, event.Authors)
+
+	// Bitbucket doesn't list changed files
+	_, ok := event.ChangedFiles()
+	assert.False(t, ok)
+}
+
+func TestParsePushEvent_Empty(t *testing.T) {
+	event, err := ParsePushEvent(nil)
+	require.NoError(t, err)
+	assert.Empty(t, event.Ref)
+
+	_, err = ParsePushEvent([]byte("payload=%7B%7D"))
+	assert.Error(t, err)
+}
+
+func TestValidateWebhookSignature(t *testing.T) {
+	signature := SignWebhook("secret", "service-id")
+	assert.Len(t, signature, 64)
+	assert.True(t, ValidateWebhookSignature("secret", "service-id", signature))
+	assert.False(t, ValidateWebhookSignature("secret", "other-service-id", signature))
+	assert.False(t, ValidateWebhookSignature("other-secret", "service-id", signature))
+	assert.False(t, ValidateWebhookSignature("", "service-id", SignWebhook("", "service-id")))
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/integrations/github"
+)
+
+// DeploymentValueChange is a value that differs between two deployments
+type DeploymentValueChange struct {
+	From *string `json:"from,omitempty" required:"false"`
+	To   *string `json:"to,omitempty" required:"false"`
+}
+
+// DeploymentKeysChange lists variable names only in one of two deployments
+type DeploymentKeysChange struct {
+	Added   []string `json:"added" nullable:"false"`
+	Removed []string `json:"removed" nullable:"false"`
+}
+
+type DeploymentHostsChange struct {
+	Added   []schema.HostSpec `json:"added" nullable:"false"`
+	Removed []schema.HostSpec `json:"removed" nullable:"false"`
+}
+
+type DeploymentPortsChange struct {
+	Added   []schema.PortSpec `json:"added" nullable:"false"`
+	Removed []schema.PortSpec `json:"removed" nullable:"false"`
+}
+
+type DeploymentResourcesChange struct {
+	From *schema.Resources `json:"from,omitempty" required:"false"`
+	To   *schema.Resources `json:"to,omitempty" required:"false"`
+}
+
+type DeploymentHealthCheckChange struct {
+	From *schema.HealthCheck `json:"from,omitempty" required:"false"`
+	To   *schema.HealthCheck `json:"to,omitempty" required:"false"`
+}
+
+// DeploymentDiffResponse is what changed going from one deployment to another, unchanged fields are omitted
+type DeploymentDiffResponse struct {
+	From        *DeploymentResponse            `json:"from"`
+	To          *DeploymentResponse            `json:"to"`
+	Image       *DeploymentValueChange         `json:"image,omitempty" required:"false"`
+	Builder     *DeploymentValueChange         `json:"builder,omitempty" required:"false"`
+	RunCommand  *DeploymentValueChange         `json:"run_command,omitempty" required:"false"`
+	EnvKeys     *DeploymentKeysChange          `json:"env_keys,omitempty" required:"false" doc:"Variable names only, values are never returned"`
+	Resources   *DeploymentResourcesChange     `json:"resources,omitempty" required:"false"`
+	Hosts       *DeploymentHostsChange         `json:"hosts,omitempty" required:"false"`
+	Ports       *DeploymentPortsChange         `json:"ports,omitempty" required:"false"`
+	HealthCheck *DeploymentHealthCheckChange   `json:"health_check,omitempty" required:"false"`
+	Commits     *github.GithubCommitComparison `json:"commits,omitempty" required:"false" doc:"Commits between the two deployments, when both were built from a github repository"`
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent/schema"
 )
@@ -30,6 +32,12 @@ type GetDeploymentByIDInput struct {
 	DeploymentID uuid.UUID `query:"deployment_id" required:"true" doc:"The ID of the deployment"`
 }
 
+type GetDeploymentDiffInput struct {
+	GetDeploymentBaseInput
+	FromDeploymentID uuid.UUID `query:"from" required:"true" doc:"The deployment to compare from, e.g. the one to roll back to"`
+	ToDeploymentID   uuid.UUID `query:"to" required:"true" doc:"The deployment to compare to, e.g. the current one"`
+}
+
 func (self *GetDeploymentBaseInput) GetTeamID() uuid.UUID {
 	return self.TeamID
 }
@@ -48,12 +56,20 @@ func (self *GetDeploymentBaseInput) GetEnvironmentID() uuid.UUID {
 
 // Triggering build
 
+// DeployHookInput is the optional body of a deploy hook request
+type DeployHookInput struct {
+	Ref       *string `json:"ref,omitempty" required:"false" doc:"Branch or tag to deploy, e.g. 'main' or 'refs/tags/v1.2.0', defaults to the service's branch"`
+	CommitSHA *string `json:"commit_sha,omitempty" required:"false" doc:"Commit to deploy, defaults to the head of the ref"`
+}
+
 type CreateDeploymentInput struct {
-	TeamID        uuid.UUID `format:"uuid" required:"true" json:"team_id"`
-	ProjectID     uuid.UUID `format:"uuid" required:"true" json:"project_id"`
-	ServiceID     uuid.UUID `format:"uuid" required:"true" json:"service_id"`
-	EnvironmentID uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
-	GitSha        *string   `json:"git_sha" required:"false" doc:"The git sha of the deployment"`
+	TeamID         uuid.UUID  `format:"uuid" required:"true" json:"team_id"`
+	ProjectID      uuid.UUID  `format:"uuid" required:"true" json:"project_id"`
+	ServiceID      uuid.UUID  `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID  uuid.UUID  `format:"uuid" required:"true" json:"environment_id"`
+	GitSha         *string    `json:"git_sha" required:"false" doc:"The git sha of the deployment"`
+	OverrideFreeze bool       `json:"override_freeze,omitempty" required:"false" doc:"Deploy during a freeze window, requires admin on the environment"`
+	ScheduledAt    *time.Time `json:"scheduled_at,omitempty" required:"false" doc:"Queue the deployment at this time instead of now"`
 }
 
 func (self *CreateDeploymentInput) GetTeamID() uuid.UUID {
@@ -74,13 +90,14 @@ func (self *CreateDeploymentInput) GetEnvironmentID() uuid.UUID {
 
 // Re-deploying specific deployment ID
 type RedeployExistingDeploymentInput struct {
-	TeamID            uuid.UUID `format:"uuid" required:"true" json:"team_id"`
-	ProjectID         uuid.UUID `format:"uuid" required:"true" json:"project_id"`
-	ServiceID         uuid.UUID `format:"uuid" required:"true" json:"service_id"`
-	EnvironmentID     uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
-	DeploymentID      uuid.UUID `format:"uuid" required:"true" json:"deployment_id"`
-	DisableBuildCache bool      `json:"disable_build_cache" required:"false" doc:"Disable build cache for this redeployment"`
-	SmartRedeploy     bool      `json:"smart_redeploy" required:"false" doc:"Try to intelligently redeploy without rebuilding if possible"`
+	TeamID            uuid.UUID  `format:"uuid" required:"true" json:"team_id"`
+	ProjectID         uuid.UUID  `format:"uuid" required:"true" json:"project_id"`
+	ServiceID         uuid.UUID  `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID     uuid.UUID  `format:"uuid" required:"true" json:"environment_id"`
+	DeploymentID      uuid.UUID  `format:"uuid" required:"true" json:"deployment_id"`
+	DisableBuildCache bool       `json:"disable_build_cache" required:"false" doc:"Disable build cache for this redeployment"`
+	SmartRedeploy     bool       `json:"smart_redeploy" required:"false" doc:"Try to intelligently redeploy without rebuilding if possible"`
+	ScheduledAt       *time.Time `json:"scheduled_at,omitempty" required:"false" doc:"Queue the redeployment at this time instead of now, always rebuilds"`
 }
 
 func (self *RedeployExistingDeploymentInput) GetTeamID() uuid.UUID {
@@ -98,3 +115,129 @@ func (self *RedeployExistingDeploymentInput) GetServiceID() uuid.UUID {
 func (self *RedeployExistingDeploymentInput) GetEnvironmentID() uuid.UUID {
 	return self.EnvironmentID
 }
+
+// Cancelling a queued or building deployment
+type CancelDeploymentInput struct {
+	TeamID        uuid.UUID `format:"uuid" required:"true" json:"team_id"`
+	ProjectID     uuid.UUID `format:"uuid" required:"true" json:"project_id"`
+	ServiceID     uuid.UUID `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
+	DeploymentID  uuid.UUID `format:"uuid" required:"true" json:"deployment_id"`
+}
+
+func (self *CancelDeploymentInput) GetTeamID() uuid.UUID {
+	return self.TeamID
+}
+
+func (self *CancelDeploymentInput) GetProjectID() uuid.UUID {
+	return self.ProjectID
+}
+
+func (self *CancelDeploymentInput) GetServiceID() uuid.UUID {
+	return self.ServiceID
+}
+
+func (self *CancelDeploymentInput) GetEnvironmentID() uuid.UUID {
+	return self.EnvironmentID
+}
+
+// Approving or rejecting a deployment to a protected environment
+type DeploymentApprovalInput struct {
+	TeamID        uuid.UUID `format:"uuid" required:"true" json:"team_id"`
+	ProjectID     uuid.UUID `format:"uuid" required:"true" json:"project_id"`
+	ServiceID     uuid.UUID `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
+	DeploymentID  uuid.UUID `format:"uuid" required:"true" json:"deployment_id"`
+}
+
+func (self *DeploymentApprovalInput) GetTeamID() uuid.UUID {
+	return self.TeamID
+}
+
+func (self *DeploymentApprovalInput) GetProjectID() uuid.UUID {
+	return self.ProjectID
+}
+
+func (self *DeploymentApprovalInput) GetServiceID() uuid.UUID {
+	return self.ServiceID
+}
+
+func (self *DeploymentApprovalInput) GetEnvironmentID() uuid.UUID {
+	return self.EnvironmentID
+}
+
+// Promoting or aborting a staged blue-green or canary deployment
+type DeploymentRolloutInput struct {
+	TeamID        uuid.UUID `format:"uuid" required:"true" json:"team_id"`
+	ProjectID     uuid.UUID `format:"uuid" required:"true" json:"project_id"`
+	ServiceID     uuid.UUID `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
+	DeploymentID  uuid.UUID `format:"uuid" required:"true" json:"deployment_id"`
+}
+
+func (self *DeploymentRolloutInput) GetTeamID() uuid.UUID {
+	return self.TeamID
+}
+
+func (self *DeploymentRolloutInput) GetProjectID() uuid.UUID {
+	return self.ProjectID
+}
+
+func (self *DeploymentRolloutInput) GetServiceID() uuid.UUID {
+	return self.ServiceID
+}
+
+func (self *DeploymentRolloutInput) GetEnvironmentID() uuid.UUID {
+	return self.EnvironmentID
+}
+
+// Promoting a deployment's image to another environment
+type PromoteDeploymentInput struct {
+	TeamID              uuid.UUID `format:"uuid" required:"true" json:"team_id"`
+	ProjectID           uuid.UUID `format:"uuid" required:"true" json:"project_id"`
+	ServiceID           uuid.UUID `format:"uuid" required:"true" json:"service_id"`
+	EnvironmentID       uuid.UUID `format:"uuid" required:"true" json:"environment_id"`
+	DeploymentID        uuid.UUID `format:"uuid" required:"true" json:"deployment_id" doc:"The deployment whose image to promote"`
+	TargetEnvironmentID uuid.UUID `format:"uuid" required:"true" json:"target_environment_id" doc:"The environment to deploy the image to, the service with the same name there is updated"`
+}
+
+func (self *PromoteDeploymentInput) GetTeamID() uuid.UUID {
+	return self.TeamID
+}
+
+func (self *PromoteDeploymentInput) GetProjectID() uuid.UUID {
+	return self.ProjectID
+}
+
+func (self *PromoteDeploymentInput) GetServiceID() uuid.UUID {
+	return self.ServiceID
+}
+
+func (self *PromoteDeploymentInput) GetEnvironmentID() uuid.UUID {
+	return self.EnvironmentID
+}
+
+// Deploying an uploaded source archive, the archive itself is the request body
+type UploadDeploymentInput struct {
+	TeamID         uuid.UUID `query:"team_id" required:"true" doc:"The ID of the team"`
+	ProjectID      uuid.UUID `query:"project_id" required:"true" doc:"The ID of the project"`
+	EnvironmentID  uuid.UUID `query:"environment_id" required:"true" doc:"The ID of the environment"`
+	ServiceID      uuid.UUID `query:"service_id" required:"true" doc:"The ID of the service"`
+	OverrideFreeze bool      `query:"override_freeze" required:"false" doc:"Deploy during a freeze window, requires admin on the environment"`
+}
+
+func (self *UploadDeploymentInput) GetTeamID() uuid.UUID {
+	return self.TeamID
+}
+
+func (self *UploadDeploymentInput) GetProjectID() uuid.UUID {
+	return self.ProjectID
+}
+
+func (self *UploadDeploymentInput) GetServiceID() uuid.UUID {
+	return self.ServiceID
+}
+
+func (self *UploadDeploymentInput) GetEnvironmentID() uuid.UUID {
+	return self.EnvironmentID
+}
@@ -29,7 +29,16 @@ type DeploymentResponse struct {
 	RunCommand                    *string                 `json:"run_command,omitempty"`
 	DockerBuilderDockerfilePath   *string                 `json:"docker_builder_dockerfile_path,omitempty"`
 	DockerBuilderBuildContext     *string                 `json:"docker_builder_build_context,omitempty"`
+	DockerBuilderTarget           *string                 `json:"docker_builder_target,omitempty"`
+	DockerBuilderBuildArgs        map[string]string       `json:"docker_builder_build_args,omitempty"`
+	DockerBuilderBuildContexts    map[string]string       `json:"docker_builder_build_contexts,omitempty"`
+	BuildpacksBuilderImage        *string                 `json:"buildpacks_builder_image,omitempty"`
+	Platforms                     []string                `json:"platforms,omitempty"`
+	PlatformBuilds                []schema.PlatformBuild  `json:"platform_builds,omitempty" required:"false"`
+	RollbackReason                *string                 `json:"rollback_reason,omitempty" required:"false"`
+	SkipReason                    *string                 `json:"skip_reason,omitempty" required:"false"`
 	CreatedAt                     time.Time               `json:"created_at"`
+	ScheduledAt                   *time.Time              `json:"scheduled_at,omitempty"`
 	QueuedAt                      *time.Time              `json:"queued_at,omitempty"`
 	StartedAt                     *time.Time              `json:"started_at,omitempty"`
 	CompletedAt                   *time.Time              `json:"completed_at,omitempty"`
@@ -53,6 +62,7 @@ func TransformDeploymentEntity(entity *ent.Deployment) *DeploymentResponse {
 			CommitAuthor:                  entity.CommitAuthor,
 			Image:                         entity.Image,
 			CreatedAt:                     entity.CreatedAt,
+			ScheduledAt:                   entity.ScheduledAt,
 			QueuedAt:                      entity.QueuedAt,
 			StartedAt:                     entity.StartedAt,
 			CompletedAt:                   entity.CompletedAt,
@@ -65,6 +75,14 @@ func TransformDeploymentEntity(entity *ent.Deployment) *DeploymentResponse {
 			RunCommand:                    entity.RunCommand,
 			DockerBuilderDockerfilePath:   entity.DockerBuilderDockerfilePath,
 			DockerBuilderBuildContext:     entity.DockerBuilderBuildContext,
+			DockerBuilderTarget:           entity.DockerBuilderTarget,
+			DockerBuilderBuildArgs:        entity.DockerBuilderBuildArgs,
+			DockerBuilderBuildContexts:    entity.DockerBuilderBuildContexts,
+			BuildpacksBuilderImage:        entity.BuildpacksBuilderImage,
+			Platforms:                     entity.Platforms,
+			PlatformBuilds:                entity.PlatformBuilds,
+			RollbackReason:                entity.RollbackReason,
+			SkipReason:                    entity.SkipReason,
 		}
 	}
 	return response
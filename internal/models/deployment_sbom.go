@@ -0,0 +1,64 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+)
+
+type GetDeploymentSBOMInput struct {
+	DeploymentID uuid.UUID `query:"deployment_id" required:"false" format:"uuid" doc:"List the packages of this deployment, otherwise search the current deployments of every service you can view"`
+	Package      string    `query:"package" required:"false" doc:"Only packages with this name, e.g. log4j-core - required when searching"`
+	Version      string    `query:"version" required:"false" doc:"Only this version of the package"`
+}
+
+type SBOMPackageResponse struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Type    string `json:"type" doc:"Ecosystem from the package URL, e.g. npm, golang or deb"`
+	PURL    string `json:"purl"`
+}
+
+// DeploymentSBOMResponse lists the packages in a deployment's image, with where the deployment runs
+type DeploymentSBOMResponse struct {
+	TeamID        uuid.UUID              `json:"team_id"`
+	ProjectID     uuid.UUID              `json:"project_id"`
+	EnvironmentID uuid.UUID              `json:"environment_id"`
+	ServiceID     uuid.UUID              `json:"service_id"`
+	ServiceName   string                 `json:"service_name"`
+	DeploymentID  uuid.UUID              `json:"deployment_id"`
+	Image         *string                `json:"image,omitempty"`
+	Packages      []*SBOMPackageResponse `json:"packages" nullable:"false"`
+}
+
+// TransformSBOMPackageEntities transforms ent.SBOMPackage entities into responses
+func TransformSBOMPackageEntities(entities []*ent.SBOMPackage) []*SBOMPackageResponse {
+	responses := make([]*SBOMPackageResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = &SBOMPackageResponse{
+			Name:    entity.Name,
+			Version: entity.Version,
+			Type:    entity.Type,
+			PURL:    entity.Purl,
+		}
+	}
+	return responses
+}
+
+// TransformDeploymentSBOM builds the response for a deployment of the service, which needs its environment and project edges
+func TransformDeploymentSBOM(service *ent.Service, deployment *ent.Deployment, packages []*ent.SBOMPackage) *DeploymentSBOMResponse {
+	response := &DeploymentSBOMResponse{
+		ServiceID:    service.ID,
+		ServiceName:  service.Name,
+		DeploymentID: deployment.ID,
+		Image:        deployment.Image,
+		Packages:     TransformSBOMPackageEntities(packages),
+	}
+	if service.Edges.Environment != nil {
+		response.EnvironmentID = service.Edges.Environment.ID
+		if service.Edges.Environment.Edges.Project != nil {
+			response.ProjectID = service.Edges.Environment.Edges.Project.ID
+			response.TeamID = service.Edges.Environment.Edges.Project.TeamID
+		}
+	}
+	return response
+}
@@ -5,17 +5,22 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
 )
 
 type EnvironmentResponse struct {
-	ID             uuid.UUID `json:"id"`
-	KubernetesName string    `json:"kubernetes_name"`
-	Name           string    `json:"name"`
-	Description    string    `json:"description"`
-	Active         bool      `json:"active"`
-	ServiceCount   int       `json:"service_count,omitempty"`
-	ServiceIcons   []string  `json:"service_icons,omitempty" nullable:"false"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             uuid.UUID             `json:"id"`
+	KubernetesName string                `json:"kubernetes_name"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description"`
+	Active         bool                  `json:"active"`
+	Protected      bool                  `json:"protected"`
+	FreezeWindows  []schema.FreezeWindow `json:"freeze_windows" nullable:"false"`
+	PreviewOf      *uuid.UUID            `json:"preview_of,omitempty" doc:"For pull request previews, the environment it was cloned from"`
+	PullRequest    *int                  `json:"pull_request,omitempty" doc:"For pull request previews, the number of the pull request"`
+	ServiceCount   int                   `json:"service_count,omitempty"`
+	ServiceIcons   []string              `json:"service_icons,omitempty" nullable:"false"`
+	CreatedAt      time.Time             `json:"created_at"`
 }
 
 // TransformEnvironmentEntity transforms an ent.Environment entity into an EnvironmentResponse
@@ -32,9 +37,16 @@ func TransformEnvironmentEntity(entity *ent.Environment) *EnvironmentResponse {
 			Name:           entity.Name,
 			Description:    description,
 			Active:         entity.Active,
+			Protected:      entity.Protected,
+			FreezeWindows:  entity.FreezeWindows,
+			PreviewOf:      entity.PreviewBaseEnvironmentID,
+			PullRequest:    entity.PreviewPullRequest,
 			CreatedAt:      entity.CreatedAt,
 			ServiceIcons:   []string{},
 		}
+		if response.FreezeWindows == nil {
+			response.FreezeWindows = []schema.FreezeWindow{}
+		}
 	}
 	return response
 }
@@ -8,27 +8,48 @@ import (
 
 // ServiceConfigResponse defines the configuration response for a service
 type ServiceConfigResponse struct {
-	GitBranch                     *string               `json:"git_branch,omitempty"`
-	GitTag                        *string               `json:"git_tag,omitempty"`
-	Builder                       schema.ServiceBuilder `json:"builder"`
-	Icon                          string                `json:"icon"`
-	Hosts                         []schema.HostSpec     `json:"hosts" nullable:"false"`
-	Ports                         []schema.PortSpec     `json:"ports" nullable:"false"`
-	Replicas                      int32                 `json:"replicas"`
-	AutoDeploy                    bool                  `json:"auto_deploy"`
-	RailpackBuilderInstallCommand *string               `json:"railpack_builder_install_command,omitempty"`
-	RailpackBuilderBuildCommand   *string               `json:"railpack_builder_build_command,omitempty"`
-	RunCommand                    *string               `json:"run_command,omitempty"`
-	IsPublic                      bool                  `json:"is_public"`
-	Image                         string                `json:"image,omitempty"`
+	GitBranch                     *string                `json:"git_branch,omitempty"`
+	GitTag                        *string                `json:"git_tag,omitempty"`
+	WatchPaths                    []string               `json:"watch_paths" nullable:"false"`
+	SkipDeployMarker              *string                `json:"skip_deploy_marker,omitempty"`
+	IgnoredCommitAuthors          []string               `json:"ignored_commit_authors" nullable:"false"`
+	Builder                       schema.ServiceBuilder  `json:"builder"`
+	Icon                          string                 `json:"icon"`
+	Hosts                         []schema.HostSpec      `json:"hosts" nullable:"false"`
+	Ports                         []schema.PortSpec      `json:"ports" nullable:"false"`
+	Replicas                      int32                  `json:"replicas"`
+	AutoDeploy                    bool                   `json:"auto_deploy"`
+	AutoRollback                  bool                   `json:"auto_rollback"`
+	PrPreviews                    bool                   `json:"pr_previews"`
+	PrPreviewVariables            []string               `json:"pr_preview_variables" nullable:"false"`
+	RailpackBuilderInstallCommand *string                `json:"railpack_builder_install_command,omitempty"`
+	RailpackBuilderBuildCommand   *string                `json:"railpack_builder_build_command,omitempty"`
+	RunCommand                    *string                `json:"run_command,omitempty"`
+	PreDeployCommand              *string                `json:"pre_deploy_command,omitempty"`
+	RolloutStrategy               schema.RolloutStrategy `json:"rollout_strategy"`
+	CanaryWeight                  int                    `json:"canary_weight"`
+	IsPublic                      bool                   `json:"is_public"`
+	Image                         string                 `json:"image,omitempty"`
+	ImageAutoUpdate               bool                   `json:"image_auto_update"`
+	ImageDigest                   *string                `json:"image_digest,omitempty" doc:"Last seen digest of the image tag"`
 	// Dockerfile build overrides
-	DockerBuilderDockerfilePath *string `json:"docker_builder_dockerfile_path,omitempty"`
-	DockerBuilderBuildContext   *string `json:"docker_builder_build_context,omitempty"`
+	DockerBuilderDockerfilePath *string           `json:"docker_builder_dockerfile_path,omitempty"`
+	DockerBuilderBuildContext   *string           `json:"docker_builder_build_context,omitempty"`
+	DockerBuilderTarget         *string           `json:"docker_builder_target,omitempty"`
+	DockerBuilderBuildArgs      map[string]string `json:"docker_builder_build_args,omitempty"`
+	DockerBuilderBuildContexts  map[string]string `json:"docker_builder_build_contexts,omitempty"`
+	// Buildpacks build overrides
+	BuildpacksBuilderImage *string `json:"buildpacks_builder_image,omitempty"`
+	// Platforms the image is built for, empty for the builder's own
+	Platforms []string `json:"platforms,omitempty"`
 	// For backups
 	S3BackupSourceID     *uuid.UUID `json:"s3_backup_source_id,omitempty"`
 	S3BackupBucket       *string    `json:"s3_backup_bucket,omitempty"`
 	BackupSchedule       string     `json:"backup_schedule"`
 	BackupRetentionCount int        `json:"backup_retention_count"`
+	// For uploaded source archives
+	UploadS3SourceID *uuid.UUID `json:"upload_s3_source_id,omitempty"`
+	UploadS3Bucket   *string    `json:"upload_s3_bucket,omitempty"`
 	// Volume
 	Volumes []*PVCInfo `json:"volumes" nullable:"false"`
 	// Security context
@@ -52,21 +73,34 @@ func TransformServiceConfigEntity(entity *ent.ServiceConfig) *ServiceConfigRespo
 		response = &ServiceConfigResponse{
 			GitBranch:                     entity.GitBranch,
 			GitTag:                        entity.GitTag,
+			WatchPaths:                    entity.WatchPaths,
+			SkipDeployMarker:              entity.SkipDeployMarker,
+			IgnoredCommitAuthors:          entity.IgnoredCommitAuthors,
 			Builder:                       entity.Builder,
 			Icon:                          entity.Icon,
 			Hosts:                         entity.Hosts,
 			Ports:                         entity.Ports,
 			Replicas:                      entity.Replicas,
 			AutoDeploy:                    entity.AutoDeploy,
+			AutoRollback:                  entity.AutoRollback,
+			PrPreviews:                    entity.PrPreviews,
+			PrPreviewVariables:            entity.PrPreviewVariables,
 			RailpackBuilderInstallCommand: entity.RailpackBuilderInstallCommand,
 			RailpackBuilderBuildCommand:   entity.RailpackBuilderBuildCommand,
 			RunCommand:                    entity.RunCommand,
+			PreDeployCommand:              entity.PreDeployCommand,
+			RolloutStrategy:               entity.RolloutStrategy,
+			CanaryWeight:                  entity.CanaryWeight,
 			IsPublic:                      entity.IsPublic,
 			Image:                         entity.Image,
+			ImageAutoUpdate:               entity.ImageAutoUpdate,
+			ImageDigest:                   entity.ImageDigest,
 			S3BackupSourceID:              entity.S3BackupSourceID,
 			S3BackupBucket:                entity.S3BackupBucket,
 			BackupSchedule:                entity.BackupSchedule,
 			BackupRetentionCount:          entity.BackupRetentionCount,
+			UploadS3SourceID:              entity.UploadS3SourceID,
+			UploadS3Bucket:                entity.UploadS3Bucket,
 			SecurityContext:               entity.SecurityContext,
 			HealthCheck:                   entity.HealthCheck,
 			VariableMounts:                entity.VariableMounts,
@@ -76,6 +110,20 @@ func TransformServiceConfigEntity(entity *ent.ServiceConfig) *ServiceConfigRespo
 			Resources:                     entity.Resources,
 			DockerBuilderDockerfilePath:   entity.DockerBuilderDockerfilePath,
 			DockerBuilderBuildContext:     entity.DockerBuilderBuildContext,
+			DockerBuilderTarget:           entity.DockerBuilderTarget,
+			DockerBuilderBuildArgs:        entity.DockerBuilderBuildArgs,
+			DockerBuilderBuildContexts:    entity.DockerBuilderBuildContexts,
+			BuildpacksBuilderImage:        entity.BuildpacksBuilderImage,
+			Platforms:                     entity.Platforms,
+		}
+		if response.WatchPaths == nil {
+			response.WatchPaths = []string{}
+		}
+		if response.IgnoredCommitAuthors == nil {
+			response.IgnoredCommitAuthors = []string{}
+		}
+		if response.PrPreviewVariables == nil {
+			response.PrPreviewVariables = []string{}
 		}
 		if response.ProtectedVariables == nil {
 			response.ProtectedVariables = []string{}
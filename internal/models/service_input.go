@@ -18,20 +18,42 @@ type CreateServiceInput struct {
 	RepositoryOwner      *string `json:"repository_owner,omitempty"`
 	RepositoryName       *string `json:"repository_name,omitempty"`
 
+	// GitLab integration, the repository owner is the project's full namespace path
+	GitlabConnectionID *uuid.UUID `json:"gitlab_connection_id,omitempty" format:"uuid"`
+
+	// Any git host over SSH, cloned with a deploy key generated for the service
+	GitURL    *string `json:"git_url,omitempty" required:"false" doc:"SSH clone URL, e.g. 'git@codeberg.org:owner/repo.git' or 'ssh://git@host:2222/owner/repo.git'"`
+	GitBranch *string `json:"git_branch,omitempty" required:"false" doc:"Branch to deploy for git services, defaults to main"`
+
 	// Configuration
-	Type                          schema.ServiceType    `required:"true" doc:"Type of service, e.g. 'github', 'docker-image'" json:"type"`
-	Builder                       schema.ServiceBuilder `required:"true" doc:"Builder of the service - docker, nixpacks, railpack" json:"builder"`
-	Hosts                         []schema.HostSpec     `json:"hosts,omitempty"`
-	Ports                         []schema.PortSpec     `json:"ports,omitempty"`
-	Replicas                      *int32                `minimum:"0" maximum:"10" json:"replicas,omitempty"`
-	AutoDeploy                    *bool                 `json:"auto_deploy,omitempty"`
-	RailpackBuilderInstallCommand *string               `json:"railpack_builder_install_command,omitempty"`
-	RailpackBuilderBuildCommand   *string               `json:"railpack_builder_build_command,omitempty"`
-	RunCommand                    *string               `json:"run_command,omitempty"`
-	IsPublic                      *bool                 `json:"is_public,omitempty"`
-	Image                         *string               `json:"image,omitempty"`
-	DockerBuilderDockerfilePath   *string               `json:"docker_builder_dockerfile_path,omitempty" required:"false" doc:"Optional path to Dockerfile, if using docker builder"`
-	DockerBuilderBuildContext     *string               `json:"docker_builder_build_context,omitempty" required:"false" doc:"Optional path to Dockerfile context, if using docker builder"`
+	Type                          schema.ServiceType      `required:"true" doc:"Type of service, e.g. 'github', 'gitlab', 'git', 'docker-image'" json:"type"`
+	Builder                       schema.ServiceBuilder   `required:"false" doc:"Builder of the service - docker, railpack, buildpacks, defaults to the one suggested for the source" json:"builder,omitempty"`
+	Hosts                         []schema.HostSpec       `json:"hosts,omitempty"`
+	Ports                         []schema.PortSpec       `json:"ports,omitempty"`
+	Replicas                      *int32                  `minimum:"0" maximum:"10" json:"replicas,omitempty"`
+	AutoDeploy                    *bool                   `json:"auto_deploy,omitempty"`
+	AutoRollback                  *bool                   `json:"auto_rollback,omitempty" doc:"Roll back to the previous deployment if a new one keeps crashing"`
+	PrPreviews                    *bool                   `json:"pr_previews,omitempty" required:"false" doc:"Deploy pull requests against the branch to ephemeral preview environments"`
+	PrPreviewVariables            []string                `json:"pr_preview_variables,omitempty" required:"false" doc:"Variables of the service and its environment copied into previews, others are left out"`
+	WatchPaths                    []string                `json:"watch_paths,omitempty" required:"false" doc:"Only auto-deploy pushes that change a file matching one of these glob patterns, e.g. 'apps/web/*'"`
+	SkipDeployMarker              *string                 `json:"skip_deploy_marker,omitempty" required:"false" doc:"Commit message marker that skips auto-deploy, in addition to [skip deploy] and [skip ci]"`
+	IgnoredCommitAuthors          []string                `json:"ignored_commit_authors,omitempty" required:"false" doc:"Commit authors whose pushes don't auto-deploy, matched literally with * as wildcard, e.g. 'dependabot[bot]'"`
+	RailpackBuilderInstallCommand *string                 `json:"railpack_builder_install_command,omitempty"`
+	RailpackBuilderBuildCommand   *string                 `json:"railpack_builder_build_command,omitempty"`
+	RunCommand                    *string                 `json:"run_command,omitempty"`
+	PreDeployCommand              *string                 `json:"pre_deploy_command,omitempty" required:"false" doc:"Command to run with the new image before rollout, e.g. migrations - the rollout only proceeds if it exits 0"`
+	RolloutStrategy               *schema.RolloutStrategy `json:"rollout_strategy,omitempty" required:"false" doc:"How new deployments replace the running one, defaults to rolling"`
+	CanaryWeight                  *int                    `json:"canary_weight,omitempty" required:"false" minimum:"1" maximum:"99" doc:"Percentage of traffic sent to a canary deployment"`
+	IsPublic                      *bool                   `json:"is_public,omitempty"`
+	Image                         *string                 `json:"image,omitempty"`
+	ImageAutoUpdate               *bool                   `json:"image_auto_update,omitempty" required:"false" doc:"Redeploy when the image tag is re-pushed with a new digest, docker image services only"`
+	DockerBuilderDockerfilePath   *string                 `json:"docker_builder_dockerfile_path,omitempty" required:"false" doc:"Optional path to Dockerfile, if using docker builder"`
+	DockerBuilderBuildContext     *string                 `json:"docker_builder_build_context,omitempty" required:"false" doc:"Optional path to Dockerfile context, if using docker builder"`
+	DockerBuilderTarget           *string                 `json:"docker_builder_target,omitempty" required:"false" doc:"Optional stage to build in a multi-stage Dockerfile, if using docker builder"`
+	DockerBuilderBuildArgs        map[string]string       `json:"docker_builder_build_args,omitempty" required:"false" doc:"Optional build args, if using docker builder - values can reference service variables as ${NAME}, they are stored in the image history so read secrets with RUN --mount=type=secret,id=NAME instead"`
+	DockerBuilderBuildContexts    map[string]string       `json:"docker_builder_build_contexts,omitempty" required:"false" doc:"Optional named build contexts, if using docker builder - a path in the repo or a source like docker-image://alpine:3.20"`
+	BuildpacksBuilderImage        *string                 `json:"buildpacks_builder_image,omitempty" required:"false" doc:"Optional CNB builder image, if using buildpacks builder"`
+	Platforms                     []string                `json:"platforms,omitempty" required:"false" doc:"Optional platforms to build a multi-platform image for, e.g. linux/amd64 and linux/arm64"`
 
 	// Databases (special case)
 	DatabaseType         *string                `json:"database_type,omitempty"`
@@ -41,6 +63,10 @@ type CreateServiceInput struct {
 	BackupSchedule       *string                `json:"backup_schedule,omitempty" required:"false" doc:"Cron expression for the backup schedule, e.g. '0 0 * * *'"`
 	BackupRetentionCount *int                   `json:"backup_retention,omitempty" required:"false" doc:"Number of base backups to retain, e.g. 3"`
 
+	// Uploads
+	UploadS3SourceID *uuid.UUID `json:"upload_s3_source_id,omitempty" format:"uuid" required:"false" doc:"S3 source uploaded source archives are stored in, required for upload services"`
+	UploadS3Bucket   *string    `json:"upload_s3_bucket,omitempty" required:"false" doc:"Bucket uploaded source archives are stored in, required for upload services"`
+
 	// PVC
 	Volumes []schema.ServiceVolume `json:"volumes,omitempty" required:"false" doc:"Volumes to mount in the service"`
 
@@ -67,24 +93,39 @@ type UpdateServiceInput struct {
 	Description   *string   `required:"false" json:"description"`
 
 	// Configuration
-	GitBranch                     *string                `json:"git_branch,omitempty" required:"false"`
-	GitTag                        *string                `json:"git_tag,omitempty" required:"false" doc:"Tag to build from, supports glob patterns"`
-	Builder                       *schema.ServiceBuilder `json:"builder,omitempty" required:"false"`
-	OverwriteHosts                []schema.HostSpec      `json:"overwrite_hosts,omitempty" required:"false"`
-	UpsertHosts                   []schema.HostSpec      `json:"upsert_hosts,omitempty" required:"false" doc:"Additional hosts to add, will not remove existing hosts"`
-	RemoveHosts                   []schema.HostSpec      `json:"remove_hosts,omitempty" required:"false" doc:"Hosts to remove"`
-	AddPorts                      []schema.PortSpec      `json:"add_ports,omitempty" required:"false" doc:"Additional ports to add, will not remove existing ports"`
-	RemovePorts                   []schema.PortSpec      `json:"remove_ports,omitempty" required:"false" doc:"Ports to remove"`
-	OverwritePorts                []schema.PortSpec      `json:"overwrite_ports,omitempty" required:"false"`
-	Replicas                      *int32                 `json:"replicas,omitempty" required:"false"`
-	AutoDeploy                    *bool                  `json:"auto_deploy,omitempty" required:"false"`
-	RailpackBuilderInstallCommand *string                `json:"railpack_builder_install_command,omitempty"`
-	RailpackBuilderBuildCommand   *string                `json:"railpack_builder_build_command,omitempty"`
-	RunCommand                    *string                `json:"run_command,omitempty" required:"false"`
-	IsPublic                      *bool                  `json:"is_public,omitempty" required:"false"`
-	Image                         *string                `json:"image,omitempty" required:"false"`
-	DockerBuilderDockerfilePath   *string                `json:"docker_builder_dockerfile_path,omitempty" required:"false" doc:"Optional path to Dockerfile, if using docker builder - set empty string to reset to default"`
-	DockerBuilderBuildContext     *string                `json:"docker_builder_build_context,omitempty" required:"false" doc:"Optional path to Dockerfile context, if using docker builder - set empty string to reset to default"`
+	GitBranch                     *string                 `json:"git_branch,omitempty" required:"false"`
+	GitTag                        *string                 `json:"git_tag,omitempty" required:"false" doc:"Tag to build from, supports glob patterns"`
+	WatchPaths                    *[]string               `json:"watch_paths,omitempty" required:"false" doc:"Only auto-deploy pushes that change a file matching one of these glob patterns, e.g. 'apps/web/*' - set empty to deploy on any change"`
+	SkipDeployMarker              *string                 `json:"skip_deploy_marker,omitempty" required:"false" doc:"Commit message marker that skips auto-deploy, in addition to [skip deploy] and [skip ci] - set empty string to remove"`
+	IgnoredCommitAuthors          *[]string               `json:"ignored_commit_authors,omitempty" required:"false" doc:"Commit authors whose pushes don't auto-deploy, matched literally with * as wildcard, e.g. 'dependabot[bot]' - set empty to remove"`
+	Builder                       *schema.ServiceBuilder  `json:"builder,omitempty" required:"false"`
+	OverwriteHosts                []schema.HostSpec       `json:"overwrite_hosts,omitempty" required:"false"`
+	UpsertHosts                   []schema.HostSpec       `json:"upsert_hosts,omitempty" required:"false" doc:"Additional hosts to add, will not remove existing hosts"`
+	RemoveHosts                   []schema.HostSpec       `json:"remove_hosts,omitempty" required:"false" doc:"Hosts to remove"`
+	AddPorts                      []schema.PortSpec       `json:"add_ports,omitempty" required:"false" doc:"Additional ports to add, will not remove existing ports"`
+	RemovePorts                   []schema.PortSpec       `json:"remove_ports,omitempty" required:"false" doc:"Ports to remove"`
+	OverwritePorts                []schema.PortSpec       `json:"overwrite_ports,omitempty" required:"false"`
+	Replicas                      *int32                  `json:"replicas,omitempty" required:"false"`
+	AutoDeploy                    *bool                   `json:"auto_deploy,omitempty" required:"false"`
+	AutoRollback                  *bool                   `json:"auto_rollback,omitempty" required:"false" doc:"Roll back to the previous deployment if a new one keeps crashing"`
+	PrPreviews                    *bool                   `json:"pr_previews,omitempty" required:"false" doc:"Deploy pull requests against the branch to ephemeral preview environments"`
+	PrPreviewVariables            *[]string               `json:"pr_preview_variables,omitempty" required:"false" doc:"Variables of the service and its environment copied into previews, others are left out - set empty to remove"`
+	RailpackBuilderInstallCommand *string                 `json:"railpack_builder_install_command,omitempty"`
+	RailpackBuilderBuildCommand   *string                 `json:"railpack_builder_build_command,omitempty"`
+	RunCommand                    *string                 `json:"run_command,omitempty" required:"false"`
+	PreDeployCommand              *string                 `json:"pre_deploy_command,omitempty" required:"false" doc:"Command to run with the new image before rollout, e.g. migrations - set empty string to remove"`
+	RolloutStrategy               *schema.RolloutStrategy `json:"rollout_strategy,omitempty" required:"false" doc:"How new deployments replace the running one"`
+	CanaryWeight                  *int                    `json:"canary_weight,omitempty" required:"false" minimum:"1" maximum:"99" doc:"Percentage of traffic sent to a canary deployment"`
+	IsPublic                      *bool                   `json:"is_public,omitempty" required:"false"`
+	Image                         *string                 `json:"image,omitempty" required:"false"`
+	ImageAutoUpdate               *bool                   `json:"image_auto_update,omitempty" required:"false" doc:"Redeploy when the image tag is re-pushed with a new digest, docker image services only"`
+	DockerBuilderDockerfilePath   *string                 `json:"docker_builder_dockerfile_path,omitempty" required:"false" doc:"Optional path to Dockerfile, if using docker builder - set empty string to reset to default"`
+	DockerBuilderBuildContext     *string                 `json:"docker_builder_build_context,omitempty" required:"false" doc:"Optional path to Dockerfile context, if using docker builder - set empty string to reset to default"`
+	DockerBuilderTarget           *string                 `json:"docker_builder_target,omitempty" required:"false" doc:"Optional stage to build in a multi-stage Dockerfile, if using docker builder - set empty string to build the last stage"`
+	DockerBuilderBuildArgs        *map[string]string      `json:"docker_builder_build_args,omitempty" required:"false" doc:"Optional build args, if using docker builder - values can reference service variables as ${NAME}, they are stored in the image history so read secrets with RUN --mount=type=secret,id=NAME instead - set empty to remove"`
+	DockerBuilderBuildContexts    *map[string]string      `json:"docker_builder_build_contexts,omitempty" required:"false" doc:"Optional named build contexts, if using docker builder - a path in the repo or a source like docker-image://alpine:3.20, set empty to remove"`
+	BuildpacksBuilderImage        *string                 `json:"buildpacks_builder_image,omitempty" required:"false" doc:"Optional CNB builder image, if using buildpacks builder - set empty string to reset to default"`
+	Platforms                     *[]string               `json:"platforms,omitempty" required:"false" doc:"Optional platforms to build a multi-platform image for, e.g. linux/amd64 and linux/arm64 - set empty to build for the builder's platform"`
 
 	// Databases
 	DatabaseConfig       *schema.DatabaseConfig `json:"database_config,omitempty"`
@@ -93,6 +134,10 @@ type UpdateServiceInput struct {
 	BackupSchedule       *string                `json:"backup_schedule,omitempty" required:"false" doc:"Cron expression for the backup schedule, e.g. '0 0 * * *'"`
 	BackupRetentionCount *int                   `json:"backup_retention,omitempty" required:"false" doc:"Number of base backups to retain, e.g. 3"`
 
+	// Uploads
+	UploadS3SourceID *uuid.UUID `json:"upload_s3_source_id,omitempty" format:"uuid" required:"false" doc:"S3 source uploaded source archives are stored in, upload services only"`
+	UploadS3Bucket   *string    `json:"upload_s3_bucket,omitempty" required:"false" doc:"Bucket uploaded source archives are stored in, upload services only"`
+
 	// Volumes
 	OverwriteVolumes []schema.ServiceVolume `json:"overwrite_volumes,omitempty" required:"false" doc:"Volumes to attach to the service"`
 	AddVolumes       []schema.ServiceVolume `json:"add_volumes,omitempty" required:"false" doc:"Additional volumes to add, will not remove existing volumes"`
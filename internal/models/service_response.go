@@ -17,8 +17,14 @@ type ServiceResponse struct {
 	Description              string                 `json:"description"`
 	EnvironmentID            uuid.UUID              `json:"environment_id"`
 	GitHubInstallationID     *int64                 `json:"github_installation_id,omitempty"`
+	GitlabConnectionID       *uuid.UUID             `json:"gitlab_connection_id,omitempty"`
 	GitRepository            *string                `json:"git_repository,omitempty"`
 	GitRepositoryOwner       *string                `json:"git_repository_owner,omitempty"`
+	GitURL                   *string                `json:"git_url,omitempty"`
+	GitDeployPublicKey       *string                `json:"git_deploy_public_key,omitempty" doc:"Add this key to the repository as a read-only deploy key"`
+	GitWebhookURL            *string                `json:"git_webhook_url,omitempty" doc:"Add this URL to the repository as a push webhook to auto-deploy, only returned to editors of the service"`
+	HasDeployHook            bool                   `json:"has_deploy_hook" doc:"Whether the service has a deploy hook, its URL is only returned when it's rotated"`
+	SuggestedBuilder         *schema.ServiceBuilder `json:"suggested_builder,omitempty" doc:"On creation, the builder suggested for the source when the service uses another one"`
 	CreatedAt                time.Time              `json:"created_at"`
 	UpdatedAt                time.Time              `json:"updated_at"`
 	CurrentDeployment        *DeploymentResponse    `json:"current_deployment,omitempty"`
@@ -47,8 +53,12 @@ func TransformServiceEntity(entity *ent.Service) *ServiceResponse {
 			Description:          entity.Description,
 			EnvironmentID:        entity.EnvironmentID,
 			GitHubInstallationID: entity.GithubInstallationID,
+			GitlabConnectionID:   entity.GitlabConnectionID,
 			GitRepository:        entity.GitRepository,
 			GitRepositoryOwner:   entity.GitRepositoryOwner,
+			GitURL:               entity.GitURL,
+			GitDeployPublicKey:   entity.GitDeployPublicKey,
+			HasDeployHook:        entity.DeployHookTokenHash != nil,
 			CreatedAt:            entity.CreatedAt,
 			UpdatedAt:            entity.UpdatedAt,
 			DatabaseVersion:      entity.DatabaseVersion,
@@ -106,3 +116,9 @@ func TransformServiceEntities(entities []*ent.Service) []*ServiceResponse {
 	}
 	return responses
 }
+
+// DeployHookResponse is a service's deploy hook URL, returned when it's rotated
+type DeployHookResponse struct {
+	ServiceID uuid.UUID `json:"service_id"`
+	URL       string    `json:"url"`
+}
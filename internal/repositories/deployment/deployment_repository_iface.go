@@ -17,24 +17,64 @@ import (
 type DeploymentRepositoryInterface interface {
 	Create(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, CommitSHA, CommitMessage string, GitBranch string, committer *schema.GitCommitter, source schema.DeploymentSource, initialStatus schema.DeploymentStatus) (*ent.Deployment, error)
 	MarkQueued(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, queuedAt time.Time) (*ent.Deployment, error)
+	// MarkPending holds a deployment that was already accepted, e.g. until a freeze ends
+	MarkPending(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error)
+	// MarkAwaitingApproval holds a deployment that was already created until an admin approves it
+	MarkAwaitingApproval(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error)
+	// MarkScheduled holds a deployment until its scheduled time
+	MarkScheduled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, scheduledAt time.Time) (*ent.Deployment, error)
 	MarkStarted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time) (*ent.Deployment, error)
 	MarkFailed(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string, failedAt time.Time) (*ent.Deployment, error)
+	// MarkStaged records that a blue-green or canary deployment is running next to the current one
+	MarkStaged(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error)
+	// MarkPromoting records that a staged deployment is taking over the service
+	MarkPromoting(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error)
+	// MarkAborted records that a staged deployment was torn down without being promoted
+	MarkAborted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string) (*ent.Deployment, error)
 	MarkSucceeded(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error)
 	// Cancels all jobs that are not in a finished state
 	MarkCancelledExcept(ctx context.Context, serviceID uuid.UUID, deploymentID uuid.UUID) error
 	// Mark cancelled by IDs
 	MarkAsCancelled(ctx context.Context, jobIDs []uuid.UUID) error
+	// MarkCancelled cancels a single deployment that has not finished building yet
+	MarkCancelled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error)
+	// MarkSkipped records that a push wasn't built, and why
+	MarkSkipped(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error)
+	// SetGithubCheckRun records what was last reported to the deployment's check run
+	SetGithubCheckRun(ctx context.Context, deploymentID uuid.UUID, checkRunID *int64, status schema.DeploymentStatus, concluded bool) (*ent.Deployment, error)
+	// SetRollbackReason records why a deployment was created as an automatic rollback
+	SetRollbackReason(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error)
+	// SetEnvKeys records the names of the variables a deployment is rolled out with
+	SetEnvKeys(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, keys []string) (*ent.Deployment, error)
+	// SetSourceArchive records the uploaded source archive a deployment is built from
+	SetSourceArchive(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, key string) (*ent.Deployment, error)
+	// SetPlatformBuilds records how the build went for each platform of a multi-platform image
+	SetPlatformBuilds(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, builds []schema.PlatformBuild) (*ent.Deployment, error)
+	// SetSBOMPackages replaces the packages indexed from the SBOM of a deployment's image
+	SetSBOMPackages(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, packages []schema.ImagePackage) error
 	// Assigns the kubernetes "Job" name to the build job
 	AssignKubernetesJobName(ctx context.Context, deploymentID uuid.UUID, jobName string) (*ent.Deployment, error)
 	SetKubernetesJobStatus(ctx context.Context, deploymentID uuid.UUID, status string) (*ent.Deployment, error)
 	AttachDeploymentMetadata(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, imageName string, resourceDefinition *v1.Service) (*ent.Deployment, error)
 	// Create a copy with all metadata, except for failed_at, completed_at, and status
 	CreateCopy(ctx context.Context, tx repository.TxInterface, deployment *ent.Deployment) (*ent.Deployment, error)
+	// CreateCopyForService copies a deployment like CreateCopy, but attaches the copy to another service (e.g. promoting to another environment)
+	CreateCopyForService(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, deployment *ent.Deployment) (*ent.Deployment, error)
+	// GetSBOMPackages gets the packages in a deployment's image, optionally only those with the given name and version
+	GetSBOMPackages(ctx context.Context, deploymentID uuid.UUID, name, version string) ([]*ent.SBOMPackage, error)
 	GetByID(ctx context.Context, deploymentID uuid.UUID) (*ent.Deployment, error)
 	ExistsInEnvironment(ctx context.Context, deploymentID uuid.UUID, environmentID uuid.UUID) (bool, error)
 	ExistsInProject(ctx context.Context, deploymentID uuid.UUID, projectID uuid.UUID) (bool, error)
 	ExistsInTeam(ctx context.Context, deploymentID uuid.UUID, teamID uuid.UUID) (bool, error)
 	GetLastSuccessfulDeployment(ctx context.Context, serviceID uuid.UUID) (*ent.Deployment, error)
+	// GetPreviousSuccessfulDeployment gets the last successful deployment with an image created before the given deployment
+	GetPreviousSuccessfulDeployment(ctx context.Context, serviceID uuid.UUID, before *ent.Deployment) (*ent.Deployment, error)
 	GetJobsByStatus(ctx context.Context, status schema.DeploymentStatus) ([]*ent.Deployment, error)
+	// GetInProgressRollouts gets staged and promoting deployments, with the service, its config and team
+	GetInProgressRollouts(ctx context.Context) ([]*ent.Deployment, error)
+	// GetStagedByServiceID gets a service's blue-green and canary deployments that are waiting to be promoted
+	GetStagedByServiceID(ctx context.Context, serviceID uuid.UUID) ([]*ent.Deployment, error)
+	// GetPendingGithubChecks gets deployments of github services created since the given time whose check run isn't final yet
+	GetPendingGithubChecks(ctx context.Context, since time.Time) ([]*ent.Deployment, error)
 	GetByServiceIDPaginated(ctx context.Context, serviceID uuid.UUID, perPage int, cursor *time.Time, statusFilter []schema.DeploymentStatus) (jobs []*ent.Deployment, nextCursor *time.Time, err error)
 }
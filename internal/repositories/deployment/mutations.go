@@ -2,12 +2,14 @@ package deployment_repo
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/deployment"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
@@ -61,6 +63,21 @@ func (self *DeploymentRepository) Create(ctx context.Context,
 	if service.Edges.ServiceConfig.DockerBuilderBuildContext != nil {
 		c.SetDockerBuilderBuildContext(*service.Edges.ServiceConfig.DockerBuilderBuildContext)
 	}
+	if service.Edges.ServiceConfig.DockerBuilderTarget != nil {
+		c.SetDockerBuilderTarget(*service.Edges.ServiceConfig.DockerBuilderTarget)
+	}
+	if len(service.Edges.ServiceConfig.DockerBuilderBuildArgs) > 0 {
+		c.SetDockerBuilderBuildArgs(service.Edges.ServiceConfig.DockerBuilderBuildArgs)
+	}
+	if len(service.Edges.ServiceConfig.DockerBuilderBuildContexts) > 0 {
+		c.SetDockerBuilderBuildContexts(service.Edges.ServiceConfig.DockerBuilderBuildContexts)
+	}
+	if service.Edges.ServiceConfig.BuildpacksBuilderImage != nil {
+		c.SetBuildpacksBuilderImage(*service.Edges.ServiceConfig.BuildpacksBuilderImage)
+	}
+	if len(service.Edges.ServiceConfig.Platforms) > 0 {
+		c.SetPlatforms(service.Edges.ServiceConfig.Platforms)
+	}
 
 	if CommitSHA != "" {
 		c.SetCommitSha(CommitSHA)
@@ -89,6 +106,43 @@ func (self *DeploymentRepository) MarkQueued(ctx context.Context, tx repository.
 		Save(ctx)
 }
 
+// MarkPending holds a deployment that was already accepted, e.g. until a freeze ends
+func (self *DeploymentRepository) MarkPending(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetStatus(schema.DeploymentStatusBuildPending).
+		Save(ctx)
+}
+
+// MarkAwaitingApproval holds a deployment that was already created until an admin approves it
+func (self *DeploymentRepository) MarkAwaitingApproval(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetStatus(schema.DeploymentStatusAwaitingApproval).
+		Save(ctx)
+}
+
+// MarkScheduled holds a deployment until its scheduled time
+func (self *DeploymentRepository) MarkScheduled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, scheduledAt time.Time) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetStatus(schema.DeploymentStatusScheduled).
+		SetScheduledAt(scheduledAt).
+		Save(ctx)
+}
+
 func (self *DeploymentRepository) MarkStarted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time) (*ent.Deployment, error) {
 	db := self.base.DB
 	if tx != nil {
@@ -96,6 +150,9 @@ func (self *DeploymentRepository) MarkStarted(ctx context.Context, tx repository
 	}
 
 	return db.Deployment.UpdateOneID(deploymentID).
+		Where(
+			deployment.StatusNEQ(schema.DeploymentStatusBuildCancelled),
+		).
 		SetStatus(schema.DeploymentStatusBuildRunning).
 		// ! TODO - retry deployments?
 		SetAttempts(1).
@@ -119,6 +176,53 @@ func (self *DeploymentRepository) MarkFailed(ctx context.Context, tx repository.
 		Save(ctx)
 }
 
+// MarkStaged records that a blue-green or canary deployment is running next to the current one
+func (self *DeploymentRepository) MarkStaged(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		Where(
+			deployment.StatusNEQ(schema.DeploymentStatusBuildCancelled),
+		).
+		SetStatus(schema.DeploymentStatusStaged).
+		SetCompletedAt(completedAt).
+		Save(ctx)
+}
+
+// MarkPromoting records that a staged deployment is taking over the service
+func (self *DeploymentRepository) MarkPromoting(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		Where(
+			deployment.StatusEQ(schema.DeploymentStatusStaged),
+		).
+		SetStatus(schema.DeploymentStatusPromoting).
+		Save(ctx)
+}
+
+// MarkAborted records that a staged deployment was torn down without being promoted
+func (self *DeploymentRepository) MarkAborted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		Where(
+			deployment.StatusEQ(schema.DeploymentStatusStaged),
+		).
+		SetStatus(schema.DeploymentStatusAborted).
+		SetError(message).
+		Save(ctx)
+}
+
 func (self *DeploymentRepository) MarkSucceeded(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error) {
 	db := self.base.DB
 	if tx != nil {
@@ -139,7 +243,9 @@ func (self *DeploymentRepository) MarkCancelledExcept(ctx context.Context, servi
 		Where(
 			deployment.ServiceIDEQ(serviceID),
 			deployment.IDNEQ(deploymentID),
-			deployment.StatusNotIn(schema.DeploymentStatusBuildFailed, schema.DeploymentStatusBuildCancelled, schema.DeploymentStatusBuildSucceeded),
+			// Awaiting approval is resolved by an admin, not by another build starting, and scheduled deployments wait for their time
+			// A promoting deployment is already live, staged ones are superseded by the new build
+			deployment.StatusNotIn(schema.DeploymentStatusAwaitingApproval, schema.DeploymentStatusScheduled, schema.DeploymentStatusPromoting, schema.DeploymentStatusAborted, schema.DeploymentStatusSkipped, schema.DeploymentStatusBuildFailed, schema.DeploymentStatusBuildCancelled, schema.DeploymentStatusBuildSucceeded),
 		).
 		Exec(ctx)
 }
@@ -151,11 +257,131 @@ func (self *DeploymentRepository) MarkAsCancelled(ctx context.Context, jobIDs []
 		SetCompletedAt(time.Now()).
 		Where(
 			deployment.IDIn(jobIDs...),
-			deployment.StatusNotIn(schema.DeploymentStatusBuildRunning, schema.DeploymentStatusBuildFailed, schema.DeploymentStatusBuildCancelled, schema.DeploymentStatusBuildSucceeded),
+			deployment.StatusNotIn(schema.DeploymentStatusBuildRunning, schema.DeploymentStatusSkipped, schema.DeploymentStatusBuildFailed, schema.DeploymentStatusBuildCancelled, schema.DeploymentStatusBuildSucceeded),
 		).
 		Exec(ctx)
 }
 
+// MarkCancelled cancels a single deployment that has not finished building yet
+func (self *DeploymentRepository) MarkCancelled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		Where(
+			deployment.StatusIn(schema.DeploymentStatusAwaitingApproval, schema.DeploymentStatusScheduled, schema.DeploymentStatusBuildPending, schema.DeploymentStatusBuildQueued, schema.DeploymentStatusBuildRunning),
+		).
+		SetStatus(schema.DeploymentStatusBuildCancelled).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// MarkSkipped records that a push wasn't built, and why
+func (self *DeploymentRepository) MarkSkipped(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetStatus(schema.DeploymentStatusSkipped).
+		SetSkipReason(reason).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// SetGithubCheckRun records what was last reported to the deployment's check run
+func (self *DeploymentRepository) SetGithubCheckRun(ctx context.Context, deploymentID uuid.UUID, checkRunID *int64, status schema.DeploymentStatus, concluded bool) (*ent.Deployment, error) {
+	return self.base.DB.Deployment.UpdateOneID(deploymentID).
+		SetNillableGithubCheckRunID(checkRunID).
+		SetGithubCheckStatus(status).
+		SetGithubCheckConcluded(concluded).
+		Save(ctx)
+}
+
+// SetRollbackReason records why a deployment was created as an automatic rollback
+func (self *DeploymentRepository) SetRollbackReason(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetRollbackReason(reason).
+		Save(ctx)
+}
+
+// SetEnvKeys records the names of the variables a deployment is rolled out with
+func (self *DeploymentRepository) SetEnvKeys(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, keys []string) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	keys = slices.Compact(slices.Sorted(slices.Values(keys)))
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetEnvKeys(keys).
+		Save(ctx)
+}
+
+// SetSourceArchive records the uploaded source archive a deployment is built from
+func (self *DeploymentRepository) SetSourceArchive(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, key string) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetSourceArchive(key).
+		Save(ctx)
+}
+
+// SetPlatformBuilds records how the build went for each platform of a multi-platform image
+func (self *DeploymentRepository) SetPlatformBuilds(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, builds []schema.PlatformBuild) (*ent.Deployment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Deployment.UpdateOneID(deploymentID).
+		SetPlatformBuilds(builds).
+		Save(ctx)
+}
+
+// Keeps bulk inserts well below postgres' limit of bind parameters
+const sbomPackageBatchSize = 1000
+
+// SetSBOMPackages replaces the packages indexed from the SBOM of a deployment's image
+func (self *DeploymentRepository) SetSBOMPackages(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, packages []schema.ImagePackage) error {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	if _, err := db.SBOMPackage.Delete().Where(sbompackage.DeploymentIDEQ(deploymentID)).Exec(ctx); err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(packages, sbomPackageBatchSize) {
+		creates := make([]*ent.SBOMPackageCreate, len(batch))
+		for i, pkg := range batch {
+			creates[i] = db.SBOMPackage.Create().
+				SetDeploymentID(deploymentID).
+				SetName(pkg.Name).
+				SetVersion(pkg.Version).
+				SetType(pkg.Type).
+				SetPurl(pkg.PURL)
+		}
+		if err := db.SBOMPackage.CreateBulk(creates...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Assigns the kubernetes "Job" name to the build job
 func (self *DeploymentRepository) AssignKubernetesJobName(ctx context.Context, deploymentID uuid.UUID, jobName string) (*ent.Deployment, error) {
 	return self.base.DB.Deployment.UpdateOneID(deploymentID).
@@ -188,13 +414,18 @@ func (self *DeploymentRepository) AttachDeploymentMetadata(ctx context.Context,
 
 // Create a copy with all metadata, except for failed_at, completed_at, and status
 func (self *DeploymentRepository) CreateCopy(ctx context.Context, tx repository.TxInterface, deployment *ent.Deployment) (*ent.Deployment, error) {
+	return self.CreateCopyForService(ctx, tx, deployment.ServiceID, deployment)
+}
+
+// CreateCopyForService copies a deployment like CreateCopy, but attaches the copy to another service (e.g. promoting to another environment)
+func (self *DeploymentRepository) CreateCopyForService(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, deployment *ent.Deployment) (*ent.Deployment, error) {
 	db := self.base.DB
 	if tx != nil {
 		db = tx.Client()
 	}
 
 	return db.Deployment.Create().
-		SetServiceID(deployment.ServiceID).
+		SetServiceID(serviceID).
 		SetStatus(schema.DeploymentStatusBuildQueued).
 		SetNillableCommitSha(deployment.CommitSha).
 		SetNillableCommitMessage(deployment.CommitMessage).
@@ -210,5 +441,11 @@ func (self *DeploymentRepository) CreateCopy(ctx context.Context, tx repository.
 		SetNillableRunCommand(deployment.RunCommand).
 		SetNillableDockerBuilderDockerfilePath(deployment.DockerBuilderDockerfilePath).
 		SetNillableDockerBuilderBuildContext(deployment.DockerBuilderBuildContext).
+		SetNillableDockerBuilderTarget(deployment.DockerBuilderTarget).
+		SetDockerBuilderBuildArgs(deployment.DockerBuilderBuildArgs).
+		SetDockerBuilderBuildContexts(deployment.DockerBuilderBuildContexts).
+		SetNillableBuildpacksBuilderImage(deployment.BuildpacksBuilderImage).
+		SetPlatforms(deployment.Platforms).
+		SetNillableSourceArchive(deployment.SourceArchive).
 		Save(ctx)
 }
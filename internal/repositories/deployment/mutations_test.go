@@ -230,6 +230,120 @@ func (suite *DeploymentMutationsSuite) TestMarkQueued() {
 	})
 }
 
+func (suite *DeploymentMutationsSuite) TestMarkPending() {
+	suite.Run("MarkPending Success", func() {
+		deployment, err := suite.deploymentRepo.MarkPending(
+			suite.Ctx,
+			nil,
+			suite.testData.deployment.ID,
+		)
+
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusBuildPending, deployment.Status)
+	})
+
+	suite.Run("MarkPending Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.MarkPending(
+			suite.Ctx,
+			nil,
+			uuid.New(),
+		)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestMarkAwaitingApproval() {
+	suite.Run("MarkAwaitingApproval Success", func() {
+		deployment, err := suite.deploymentRepo.MarkAwaitingApproval(
+			suite.Ctx,
+			nil,
+			suite.testData.deployment.ID,
+		)
+
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusAwaitingApproval, deployment.Status)
+	})
+
+	suite.Run("MarkAwaitingApproval Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.MarkAwaitingApproval(
+			suite.Ctx,
+			nil,
+			uuid.New(),
+		)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestMarkScheduled() {
+	suite.Run("MarkScheduled Success", func() {
+		scheduledAt := time.Now().Add(6 * time.Hour)
+		deployment, err := suite.deploymentRepo.MarkScheduled(
+			suite.Ctx,
+			nil,
+			suite.testData.deployment.ID,
+			scheduledAt,
+		)
+
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusScheduled, deployment.Status)
+		suite.NotNil(deployment.ScheduledAt)
+		suite.WithinDuration(scheduledAt, *deployment.ScheduledAt, time.Second)
+	})
+
+	suite.Run("MarkScheduled Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.MarkScheduled(
+			suite.Ctx,
+			nil,
+			uuid.New(),
+			time.Now(),
+		)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestRolloutStatuses() {
+	suite.Run("Staged, then promoted", func() {
+		deployment, err := suite.deploymentRepo.MarkStaged(suite.Ctx, nil, suite.testData.deployment.ID, time.Now())
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusStaged, deployment.Status)
+		suite.NotNil(deployment.CompletedAt)
+
+		deployment, err = suite.deploymentRepo.MarkPromoting(suite.Ctx, nil, suite.testData.deployment.ID)
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusPromoting, deployment.Status)
+
+		rollouts, err := suite.deploymentRepo.GetInProgressRollouts(suite.Ctx)
+		suite.NoError(err)
+		suite.Len(rollouts, 1)
+		suite.NotNil(rollouts[0].Edges.Service)
+		suite.NotNil(rollouts[0].Edges.Service.Edges.ServiceConfig)
+
+		// Only staged deployments can be aborted
+		_, err = suite.deploymentRepo.MarkAborted(suite.Ctx, nil, suite.testData.deployment.ID, "Rollout aborted")
+		suite.Error(err)
+	})
+
+	suite.Run("Staged, then aborted", func() {
+		_, err := suite.deploymentRepo.MarkStaged(suite.Ctx, nil, suite.testData.deployment.ID, time.Now())
+		suite.NoError(err)
+
+		deployment, err := suite.deploymentRepo.MarkAborted(suite.Ctx, nil, suite.testData.deployment.ID, "Rollout aborted")
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusAborted, deployment.Status)
+		suite.Equal("Rollout aborted", deployment.Error)
+
+		rollouts, err := suite.deploymentRepo.GetInProgressRollouts(suite.Ctx)
+		suite.NoError(err)
+		suite.Empty(rollouts)
+	})
+}
+
 func (suite *DeploymentMutationsSuite) TestMarkStarted() {
 	suite.Run("MarkStarted Success", func() {
 		startedTime := time.Now()
@@ -521,6 +635,64 @@ func (suite *DeploymentMutationsSuite) TestMarkAsCancelled() {
 	})
 }
 
+func (suite *DeploymentMutationsSuite) TestMarkCancelled() {
+	suite.Run("MarkCancelled Success", func() {
+		deployment, err := suite.deploymentRepo.MarkCancelled(suite.Ctx, nil, suite.testData.deployment.ID)
+
+		suite.NoError(err)
+		suite.NotNil(deployment)
+		suite.Equal(schema.DeploymentStatusBuildCancelled, deployment.Status)
+		suite.NotNil(deployment.CompletedAt)
+	})
+
+	suite.Run("MarkCancelled Success on Running Deployment", func() {
+		runningDeployment := suite.DB.Deployment.Create().
+			SetServiceID(suite.testData.service.ID).
+			SetStatus(schema.DeploymentStatusBuildRunning).
+			SetSource(schema.DeploymentSourceManual).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SetCommitAuthor(&schema.GitCommitter{
+				Name:      "Test User",
+				AvatarURL: "https://github.com/test.png",
+			}).
+			SaveX(suite.Ctx)
+
+		deployment, err := suite.deploymentRepo.MarkCancelled(suite.Ctx, nil, runningDeployment.ID)
+
+		suite.NoError(err)
+		suite.Equal(schema.DeploymentStatusBuildCancelled, deployment.Status)
+	})
+
+	suite.Run("MarkCancelled Not Found on Finished Deployment", func() {
+		succeededDeployment := suite.DB.Deployment.Create().
+			SetServiceID(suite.testData.service.ID).
+			SetStatus(schema.DeploymentStatusBuildSucceeded).
+			SetSource(schema.DeploymentSourceManual).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SetCommitAuthor(&schema.GitCommitter{
+				Name:      "Test User",
+				AvatarURL: "https://github.com/test.png",
+			}).
+			SaveX(suite.Ctx)
+
+		_, err := suite.deploymentRepo.MarkCancelled(suite.Ctx, nil, succeededDeployment.ID)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+
+		deployment := suite.DB.Deployment.GetX(suite.Ctx, succeededDeployment.ID)
+		suite.Equal(schema.DeploymentStatusBuildSucceeded, deployment.Status)
+	})
+
+	suite.Run("MarkCancelled Error when DB closed", func() {
+		suite.DB.Close()
+		_, err := suite.deploymentRepo.MarkCancelled(suite.Ctx, nil, suite.testData.deployment.ID)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "database is closed")
+	})
+}
+
 func (suite *DeploymentMutationsSuite) TestAssignKubernetesJobName() {
 	suite.Run("AssignKubernetesJobName Success", func() {
 		jobName := "test-job-12345"
@@ -599,6 +771,128 @@ func (suite *DeploymentMutationsSuite) TestSetKubernetesJobStatus() {
 	})
 }
 
+func (suite *DeploymentMutationsSuite) TestMarkSkipped() {
+	deployment, err := suite.deploymentRepo.MarkSkipped(suite.Ctx, nil, suite.testData.deployment.ID, "Commit message contains [skip ci]")
+	suite.NoError(err)
+	suite.Equal(schema.DeploymentStatusSkipped, deployment.Status)
+	suite.Require().NotNil(deployment.SkipReason)
+	suite.Equal("Commit message contains [skip ci]", *deployment.SkipReason)
+	suite.NotNil(deployment.CompletedAt)
+
+	// Skipped deployments are finished, nothing to cancel
+	err = suite.deploymentRepo.MarkAsCancelled(suite.Ctx, []uuid.UUID{deployment.ID})
+	suite.NoError(err)
+	deployment, err = suite.deploymentRepo.GetByID(suite.Ctx, deployment.ID)
+	suite.NoError(err)
+	suite.Equal(schema.DeploymentStatusSkipped, deployment.Status)
+}
+
+func (suite *DeploymentMutationsSuite) TestSetGithubCheckRun() {
+	checkRunID := int64(42)
+	deployment, err := suite.deploymentRepo.SetGithubCheckRun(suite.Ctx, suite.testData.deployment.ID, &checkRunID, schema.DeploymentStatusBuildRunning, false)
+	suite.NoError(err)
+	suite.Require().NotNil(deployment.GithubCheckRunID)
+	suite.Equal(checkRunID, *deployment.GithubCheckRunID)
+	suite.Equal(schema.DeploymentStatusBuildRunning, *deployment.GithubCheckStatus)
+	suite.False(deployment.GithubCheckConcluded)
+
+	// Keeps the check run when none is given
+	deployment, err = suite.deploymentRepo.SetGithubCheckRun(suite.Ctx, suite.testData.deployment.ID, nil, schema.DeploymentStatusActive, true)
+	suite.NoError(err)
+	suite.Require().NotNil(deployment.GithubCheckRunID)
+	suite.Equal(checkRunID, *deployment.GithubCheckRunID)
+	suite.Equal(schema.DeploymentStatusActive, *deployment.GithubCheckStatus)
+	suite.True(deployment.GithubCheckConcluded)
+}
+
+func (suite *DeploymentMutationsSuite) TestSetEnvKeys() {
+	suite.Run("SetEnvKeys sorts and dedupes", func() {
+		deployment, err := suite.deploymentRepo.SetEnvKeys(
+			suite.Ctx,
+			nil,
+			suite.testData.deployment.ID,
+			[]string{"PORT", "DATABASE_URL", "PORT"},
+		)
+
+		suite.NoError(err)
+		suite.Equal([]string{"DATABASE_URL", "PORT"}, deployment.EnvKeys)
+	})
+
+	suite.Run("SetEnvKeys Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.SetEnvKeys(suite.Ctx, nil, uuid.New(), []string{"PORT"})
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestSetSourceArchive() {
+	suite.Run("SetSourceArchive Success", func() {
+		key := "uploads/" + suite.testData.service.ID.String() + "/source.tar.gz"
+		deployment, err := suite.deploymentRepo.SetSourceArchive(suite.Ctx, nil, suite.testData.deployment.ID, key)
+
+		suite.NoError(err)
+		suite.Require().NotNil(deployment.SourceArchive)
+		suite.Equal(key, *deployment.SourceArchive)
+	})
+
+	suite.Run("SetSourceArchive Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.SetSourceArchive(suite.Ctx, nil, uuid.New(), "uploads/source.tar.gz")
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestSetPlatformBuilds() {
+	suite.Run("SetPlatformBuilds Success", func() {
+		builds := []schema.PlatformBuild{
+			{Platform: "linux/amd64", Succeeded: true, DurationSeconds: 42.5},
+			{Platform: "linux/arm64", Succeeded: false, DurationSeconds: 12, Error: "exit code 1"},
+		}
+		deployment, err := suite.deploymentRepo.SetPlatformBuilds(suite.Ctx, nil, suite.testData.deployment.ID, builds)
+
+		suite.NoError(err)
+		suite.Equal(builds, deployment.PlatformBuilds)
+	})
+
+	suite.Run("SetPlatformBuilds Error with Invalid ID", func() {
+		_, err := suite.deploymentRepo.SetPlatformBuilds(suite.Ctx, nil, uuid.New(), nil)
+
+		suite.Error(err)
+		suite.ErrorContains(err, "not found")
+	})
+}
+
+func (suite *DeploymentMutationsSuite) TestSetSBOMPackages() {
+	suite.Run("SetSBOMPackages Success", func() {
+		err := suite.deploymentRepo.SetSBOMPackages(suite.Ctx, nil, suite.testData.deployment.ID, []schema.ImagePackage{
+			{Name: "express", Version: "4.19.2", Type: "npm", PURL: "pkg:npm/express@4.19.2"},
+			{Name: "libssl3", Version: "3.0.13", Type: "deb", PURL: "pkg:deb/debian/libssl3@3.0.13"},
+		})
+		suite.NoError(err)
+
+		packages, err := suite.deploymentRepo.GetSBOMPackages(suite.Ctx, suite.testData.deployment.ID, "", "")
+		suite.NoError(err)
+		suite.Len(packages, 2)
+		suite.Equal("express", packages[0].Name)
+		suite.Equal("npm", packages[0].Type)
+		suite.Equal("pkg:deb/debian/libssl3@3.0.13", packages[1].Purl)
+	})
+
+	suite.Run("SetSBOMPackages Replaces Existing", func() {
+		err := suite.deploymentRepo.SetSBOMPackages(suite.Ctx, nil, suite.testData.deployment.ID, []schema.ImagePackage{
+			{Name: "express", Version: "4.21.0", Type: "npm", PURL: "pkg:npm/express@4.21.0"},
+		})
+		suite.NoError(err)
+
+		packages, err := suite.deploymentRepo.GetSBOMPackages(suite.Ctx, suite.testData.deployment.ID, "", "")
+		suite.NoError(err)
+		suite.Len(packages, 1)
+		suite.Equal("4.21.0", packages[0].Version)
+	})
+}
+
 func (suite *DeploymentMutationsSuite) TestAttachDeploymentMetadata() {
 	suite.Run("AttachDeploymentMetadata Success", func() {
 		imageName := "test-image:v1.0.0"
@@ -687,6 +981,11 @@ func (suite *DeploymentMutationsSuite) TestCreateCopy() {
 		// First, populate the original deployment with metadata
 		originalDeployment := suite.DB.Deployment.UpdateOneID(suite.testData.deployment.ID).
 			SetImage("original-image:v1.0.0").
+			SetSourceArchive("uploads/source.tar.gz").
+			SetBuildpacksBuilderImage("paketobuildpacks/builder-jammy-full").
+			SetDockerBuilderTarget("runtime").
+			SetPlatforms([]string{"linux/amd64", "linux/arm64"}).
+			SetDockerBuilderBuildArgs(map[string]string{"NODE_ENV": "production"}).
 			SetResourceDefinition(&v1.Service{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "Service",
@@ -717,6 +1016,11 @@ func (suite *DeploymentMutationsSuite) TestCreateCopy() {
 		suite.Equal(originalDeployment.CommitAuthor, copy.CommitAuthor)
 		suite.Equal(originalDeployment.Image, copy.Image)
 		suite.Equal(originalDeployment.ResourceDefinition, copy.ResourceDefinition)
+		suite.Equal(originalDeployment.SourceArchive, copy.SourceArchive)
+		suite.Equal(originalDeployment.BuildpacksBuilderImage, copy.BuildpacksBuilderImage)
+		suite.Equal(originalDeployment.DockerBuilderTarget, copy.DockerBuilderTarget)
+		suite.Equal(originalDeployment.Platforms, copy.Platforms)
+		suite.Equal(originalDeployment.DockerBuilderBuildArgs, copy.DockerBuilderBuildArgs)
 		// Ensure reset fields are nil/default
 		suite.Nil(copy.CompletedAt)
 		suite.Nil(copy.StartedAt)
@@ -736,6 +1040,42 @@ func (suite *DeploymentMutationsSuite) TestCreateCopy() {
 	})
 }
 
+func (suite *DeploymentMutationsSuite) TestCreateCopyForService() {
+	suite.Run("CreateCopyForService Success", func() {
+		stagingEnvironment := suite.DB.Environment.Create().
+			SetKubernetesName("test-env-staging").
+			SetName("Staging").
+			SetProjectID(suite.testData.project.ID).
+			SetKubernetesSecret("test-env-staging-secret").
+			SaveX(suite.Ctx)
+		targetService := suite.DB.Service.Create().
+			SetType(schema.ServiceTypeGithub).
+			SetKubernetesName("test-service-staging").
+			SetName("Test Service").
+			SetEnvironmentID(stagingEnvironment.ID).
+			SetKubernetesSecret("test-service-staging-secret").
+			SaveX(suite.Ctx)
+
+		originalDeployment := suite.DB.Deployment.UpdateOneID(suite.testData.deployment.ID).
+			SetImage("original-image:v1.0.0").
+			SaveX(suite.Ctx)
+
+		copy, err := suite.deploymentRepo.CreateCopyForService(
+			suite.Ctx,
+			nil,
+			targetService.ID,
+			originalDeployment,
+		)
+
+		suite.NoError(err)
+		suite.NotEqual(originalDeployment.ID, copy.ID)
+		suite.Equal(targetService.ID, copy.ServiceID)
+		suite.Equal(schema.DeploymentStatusBuildQueued, copy.Status)
+		suite.Equal(originalDeployment.CommitSha, copy.CommitSha)
+		suite.Equal(originalDeployment.Image, copy.Image)
+	})
+}
+
 func TestDeploymentMutationsSuite(t *testing.T) {
 	suite.Run(t, new(DeploymentMutationsSuite))
 }
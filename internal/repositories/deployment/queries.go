@@ -9,11 +9,26 @@ import (
 	"github.com/unbindapp/unbind-api/ent/deployment"
 	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/project"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/team"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
 )
 
+// GetSBOMPackages gets the packages in a deployment's image, optionally only those with the given name and version
+func (self *DeploymentRepository) GetSBOMPackages(ctx context.Context, deploymentID uuid.UUID, name, version string) ([]*ent.SBOMPackage, error) {
+	q := self.base.DB.SBOMPackage.Query().
+		Where(sbompackage.DeploymentIDEQ(deploymentID))
+	if name != "" {
+		q.Where(sbompackage.NameEQ(name))
+	}
+	if version != "" {
+		q.Where(sbompackage.VersionEQ(version))
+	}
+	return q.Order(ent.Asc(sbompackage.FieldName), ent.Asc(sbompackage.FieldVersion)).All(ctx)
+}
+
 func (self *DeploymentRepository) GetByID(ctx context.Context, deploymentID uuid.UUID) (*ent.Deployment, error) {
 	return self.base.DB.Deployment.Query().
 		Where(deployment.IDEQ(deploymentID)).
@@ -73,12 +88,78 @@ func (self *DeploymentRepository) GetLastSuccessfulDeployment(ctx context.Contex
 		First(ctx)
 }
 
+// GetPreviousSuccessfulDeployment gets the last successful deployment with an image created before the given deployment
+func (self *DeploymentRepository) GetPreviousSuccessfulDeployment(ctx context.Context, serviceID uuid.UUID, before *ent.Deployment) (*ent.Deployment, error) {
+	return self.base.DB.Deployment.Query().
+		Where(
+			deployment.ServiceIDEQ(serviceID),
+			deployment.IDNEQ(before.ID),
+			deployment.StatusEQ(schema.DeploymentStatusBuildSucceeded),
+			deployment.ImageNotNil(),
+			deployment.ResourceDefinitionNotNil(),
+			deployment.CreatedAtLT(before.CreatedAt),
+		).
+		Order(ent.Desc(deployment.FieldCreatedAt)).
+		First(ctx)
+}
+
 func (self *DeploymentRepository) GetJobsByStatus(ctx context.Context, status schema.DeploymentStatus) ([]*ent.Deployment, error) {
 	return self.base.DB.Deployment.Query().
 		Where(deployment.StatusEQ(status)).
 		All(ctx)
 }
 
+// GetInProgressRollouts gets staged and promoting deployments, with the service, its config and team
+func (self *DeploymentRepository) GetInProgressRollouts(ctx context.Context) ([]*ent.Deployment, error) {
+	return self.base.DB.Deployment.Query().
+		Where(deployment.StatusIn(schema.DeploymentStatusStaged, schema.DeploymentStatusPromoting)).
+		WithService(func(sq *ent.ServiceQuery) {
+			sq.WithServiceConfig()
+			sq.WithEnvironment(func(eq *ent.EnvironmentQuery) {
+				eq.WithProject(func(pq *ent.ProjectQuery) {
+					pq.WithTeam()
+				})
+			})
+		}).
+		All(ctx)
+}
+
+// GetStagedByServiceID gets a service's blue-green and canary deployments that are waiting to be promoted
+func (self *DeploymentRepository) GetStagedByServiceID(ctx context.Context, serviceID uuid.UUID) ([]*ent.Deployment, error) {
+	return self.base.DB.Deployment.Query().
+		Where(
+			deployment.ServiceIDEQ(serviceID),
+			deployment.StatusEQ(schema.DeploymentStatusStaged),
+		).
+		All(ctx)
+}
+
+// GetPendingGithubChecks gets deployments of github services created since the given time whose check run isn't final yet
+func (self *DeploymentRepository) GetPendingGithubChecks(ctx context.Context, since time.Time) ([]*ent.Deployment, error) {
+	return self.base.DB.Deployment.Query().
+		Where(
+			deployment.CommitShaNotNil(),
+			deployment.CreatedAtGTE(since),
+			deployment.GithubCheckConcluded(false),
+			deployment.HasServiceWith(
+				service.GithubInstallationIDNotNil(),
+				service.GitRepositoryNotNil(),
+			),
+		).
+		WithService(func(sq *ent.ServiceQuery) {
+			sq.WithServiceConfig()
+			sq.WithGithubInstallation(func(gq *ent.GithubInstallationQuery) {
+				gq.WithGithubApp()
+			})
+			sq.WithEnvironment(func(eq *ent.EnvironmentQuery) {
+				eq.WithProject(func(pq *ent.ProjectQuery) {
+					pq.WithTeam()
+				})
+			})
+		}).
+		All(ctx)
+}
+
 func (self *DeploymentRepository) GetByServiceIDPaginated(ctx context.Context, serviceID uuid.UUID, perPage int, cursor *time.Time, statusFilter []schema.DeploymentStatus) (jobs []*ent.Deployment, nextCursor *time.Time, err error) {
 	query := self.base.DB.Deployment.Query().
 		Where(deployment.ServiceIDEQ(serviceID))
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
+	v1 "github.com/unbindapp/unbind-operator/api/v1"
 )
 
 type DeploymentQueriesSuite struct {
@@ -109,6 +111,46 @@ func (suite *DeploymentQueriesSuite) TestGetByID() {
 	})
 }
 
+func (suite *DeploymentQueriesSuite) TestGetSBOMPackages() {
+	for _, pkg := range []schema.ImagePackage{
+		{Name: "log4j-core", Version: "2.14.1", Type: "maven", PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		{Name: "log4j-core", Version: "2.17.1", Type: "maven", PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1"},
+		{Name: "express", Version: "4.19.2", Type: "npm", PURL: "pkg:npm/express@4.19.2"},
+	} {
+		suite.DB.SBOMPackage.Create().
+			SetDeploymentID(suite.testDeployment.ID).
+			SetName(pkg.Name).
+			SetVersion(pkg.Version).
+			SetType(pkg.Type).
+			SetPurl(pkg.PURL).
+			SaveX(suite.Ctx)
+	}
+
+	suite.Run("GetSBOMPackages All", func() {
+		packages, err := suite.deploymentRepo.GetSBOMPackages(suite.Ctx, suite.testDeployment.ID, "", "")
+		suite.NoError(err)
+		suite.Len(packages, 3)
+		suite.Equal("express", packages[0].Name)
+	})
+
+	suite.Run("GetSBOMPackages By Name And Version", func() {
+		packages, err := suite.deploymentRepo.GetSBOMPackages(suite.Ctx, suite.testDeployment.ID, "log4j-core", "")
+		suite.NoError(err)
+		suite.Len(packages, 2)
+
+		packages, err = suite.deploymentRepo.GetSBOMPackages(suite.Ctx, suite.testDeployment.ID, "log4j-core", "2.14.1")
+		suite.NoError(err)
+		suite.Len(packages, 1)
+		suite.Equal("2.14.1", packages[0].Version)
+	})
+
+	suite.Run("GetSBOMPackages Other Deployment", func() {
+		packages, err := suite.deploymentRepo.GetSBOMPackages(suite.Ctx, uuid.New(), "", "")
+		suite.NoError(err)
+		suite.Empty(packages)
+	})
+}
+
 func (suite *DeploymentQueriesSuite) TestExistsInEnvironment() {
 	suite.Run("ExistsInEnvironment True", func() {
 		exists, err := suite.deploymentRepo.ExistsInEnvironment(suite.Ctx, suite.testDeployment.ID, suite.testEnvironment.ID)
@@ -306,6 +348,51 @@ func (suite *DeploymentQueriesSuite) TestGetLastSuccessfulDeployment() {
 	})
 }
 
+func (suite *DeploymentQueriesSuite) TestGetPreviousSuccessfulDeployment() {
+	now := time.Now()
+	createSucceeded := func(sha string, createdAt time.Time, image *string) *ent.Deployment {
+		create := suite.DB.Deployment.Create().
+			SetServiceID(suite.testService.ID).
+			SetCommitSha(sha).
+			SetSource(schema.DeploymentSourceGit).
+			SetStatus(schema.DeploymentStatusBuildSucceeded).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SetNillableImage(image).
+			SetCreatedAt(createdAt).
+			SetCompletedAt(createdAt)
+		if image != nil {
+			create.SetResourceDefinition(&v1.Service{})
+		}
+		return create.SaveX(suite.Ctx)
+	}
+
+	older := createSucceeded("older", now.Add(-3*time.Hour), utils.ToPtr("registry/app:older"))
+	previous := createSucceeded("previous", now.Add(-2*time.Hour), utils.ToPtr("registry/app:previous"))
+	// Succeeded without an image can't be rolled back to
+	createSucceeded("noimage", now.Add(-90*time.Minute), nil)
+	current := createSucceeded("current", now.Add(-time.Hour), utils.ToPtr("registry/app:current"))
+	createSucceeded("newer", now, utils.ToPtr("registry/app:newer"))
+
+	suite.Run("Returns the deployment before the given one", func() {
+		deployment, err := suite.deploymentRepo.GetPreviousSuccessfulDeployment(suite.Ctx, suite.testService.ID, current)
+		suite.NoError(err)
+		suite.Equal(previous.ID, deployment.ID)
+	})
+
+	suite.Run("Walks back from an older deployment", func() {
+		deployment, err := suite.deploymentRepo.GetPreviousSuccessfulDeployment(suite.Ctx, suite.testService.ID, previous)
+		suite.NoError(err)
+		suite.Equal(older.ID, deployment.ID)
+	})
+
+	suite.Run("Not found when nothing precedes it", func() {
+		deployment, err := suite.deploymentRepo.GetPreviousSuccessfulDeployment(suite.Ctx, suite.testService.ID, older)
+		suite.Error(err)
+		suite.True(ent.IsNotFound(err))
+		suite.Nil(deployment)
+	})
+}
+
 func (suite *DeploymentQueriesSuite) TestGetJobsByStatus() {
 	suite.Run("GetJobsByStatus Success", func() {
 		// Create deployments with different statuses
@@ -364,6 +451,44 @@ func (suite *DeploymentQueriesSuite) TestGetJobsByStatus() {
 	})
 }
 
+func (suite *DeploymentQueriesSuite) TestGetStagedByServiceID() {
+	suite.Run("GetStagedByServiceID Success", func() {
+		stagedDeployment := suite.DB.Deployment.Create().
+			SetServiceID(suite.testService.ID).
+			SetSource(schema.DeploymentSourceGit).
+			SetStatus(schema.DeploymentStatusStaged).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SaveX(suite.Ctx)
+
+		otherService := suite.DB.Service.Create().
+			SetName("other-service").
+			SetType(schema.ServiceTypeDockerimage).
+			SetEnvironmentID(suite.testEnvironment.ID).
+			SetKubernetesSecret("other-secret").
+			SetKubernetesName("other-service-k8s").
+			SaveX(suite.Ctx)
+		suite.DB.Deployment.Create().
+			SetServiceID(otherService.ID).
+			SetSource(schema.DeploymentSourceGit).
+			SetStatus(schema.DeploymentStatusStaged).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SaveX(suite.Ctx)
+
+		staged, err := suite.deploymentRepo.GetStagedByServiceID(suite.Ctx, suite.testService.ID)
+		suite.NoError(err)
+		suite.Len(staged, 1)
+		suite.Equal(stagedDeployment.ID, staged[0].ID)
+	})
+
+	suite.Run("GetStagedByServiceID Error when DB closed", func() {
+		suite.DB.Close()
+		staged, err := suite.deploymentRepo.GetStagedByServiceID(suite.Ctx, suite.testService.ID)
+		suite.Error(err)
+		suite.Nil(staged)
+		suite.ErrorContains(err, "database is closed")
+	})
+}
+
 func (suite *DeploymentQueriesSuite) TestGetByServiceIDPaginated() {
 	// Create multiple deployments for pagination testing
 	deployments := make([]*ent.Deployment, 5)
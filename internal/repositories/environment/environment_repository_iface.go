@@ -8,15 +8,22 @@ import (
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/predicate"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 )
 
 // EnvironmentRepositoryInterface ...
 type EnvironmentRepositoryInterface interface {
 	Create(ctx context.Context, tx repository.TxInterface, kubernetesName, name, kuberneteSecret string, description *string, projectID uuid.UUID) (*ent.Environment, error)
+	// CreatePreview creates an ephemeral environment for a pull request, cloned from the base environment
+	CreatePreview(ctx context.Context, tx repository.TxInterface, kubernetesName, name, kuberneteSecret string, description *string, projectID uuid.UUID, baseEnvironmentID uuid.UUID, gitRepository string, pullRequest int) (*ent.Environment, error)
+	// SetPreviewCommentID records the pull request comment linking to a preview environment
+	SetPreviewCommentID(ctx context.Context, environmentID uuid.UUID, commentID int64) (*ent.Environment, error)
 	Delete(ctx context.Context, tx repository.TxInterface, environmentID uuid.UUID) error
-	Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string) (*ent.Environment, error)
+	Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string, protected *bool, freezeWindows *[]schema.FreezeWindow) (*ent.Environment, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Environment, error)
+	// GetPreviews gets the preview environments of a pull request, one per base environment
+	GetPreviews(ctx context.Context, gitRepository string, pullRequest int) ([]*ent.Environment, error)
 	// Return all environments for a project with service edge populated
 	GetForProject(ctx context.Context, tx repository.TxInterface, projectID uuid.UUID, authPredicate predicate.Environment) ([]*ent.Environment, error)
 }
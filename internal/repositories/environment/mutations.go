@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 )
 
@@ -23,6 +24,32 @@ func (self *EnvironmentRepository) Create(ctx context.Context, tx repository.TxI
 		Save(ctx)
 }
 
+// CreatePreview creates an ephemeral environment for a pull request, cloned from the base environment
+func (self *EnvironmentRepository) CreatePreview(ctx context.Context, tx repository.TxInterface, kubernetesName, name, kuberneteSecret string, description *string, projectID uuid.UUID, baseEnvironmentID uuid.UUID, gitRepository string, pullRequest int) (*ent.Environment, error) {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	return db.Environment.Create().
+		SetKubernetesName(kubernetesName).
+		SetName(name).
+		SetNillableDescription(description).
+		SetProjectID(projectID).
+		SetKubernetesSecret(kuberneteSecret).
+		SetPreviewBaseEnvironmentID(baseEnvironmentID).
+		SetPreviewRepository(gitRepository).
+		SetPreviewPullRequest(pullRequest).
+		Save(ctx)
+}
+
+// SetPreviewCommentID records the pull request comment linking to a preview environment
+func (self *EnvironmentRepository) SetPreviewCommentID(ctx context.Context, environmentID uuid.UUID, commentID int64) (*ent.Environment, error) {
+	return self.base.DB.Environment.UpdateOneID(environmentID).
+		SetPreviewCommentID(commentID).
+		Save(ctx)
+}
+
 func (self *EnvironmentRepository) Delete(ctx context.Context, tx repository.TxInterface, environmentID uuid.UUID) error {
 	db := self.base.DB
 	if tx != nil {
@@ -32,7 +59,7 @@ func (self *EnvironmentRepository) Delete(ctx context.Context, tx repository.TxI
 	return db.Environment.DeleteOneID(environmentID).Exec(ctx)
 }
 
-func (self *EnvironmentRepository) Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string) (*ent.Environment, error) {
+func (self *EnvironmentRepository) Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string, protected *bool, freezeWindows *[]schema.FreezeWindow) (*ent.Environment, error) {
 	upd := self.base.DB.Environment.UpdateOneID(environmentID)
 	if name != nil {
 		upd.SetName(*name)
@@ -40,5 +67,11 @@ func (self *EnvironmentRepository) Update(ctx context.Context, environmentID uui
 	if description != nil {
 		upd.SetNillableDescription(description)
 	}
+	if protected != nil {
+		upd.SetProtected(*protected)
+	}
+	if freezeWindows != nil {
+		upd.SetFreezeWindows(*freezeWindows)
+	}
 	return upd.Save(ctx)
 }
@@ -257,6 +257,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			&newName,
 			nil,
+			nil,
+			nil,
 		)
 		suite.NoError(err)
 		suite.NotNil(updated)
@@ -272,6 +274,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			nil,
 			&newDescription,
+			nil,
+			nil,
 		)
 		suite.NoError(err)
 		suite.NotNil(updated)
@@ -287,6 +291,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			&newName,
 			&newDescription,
+			nil,
+			nil,
 		)
 		suite.NoError(err)
 		suite.NotNil(updated)
@@ -301,6 +307,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			&newName,
 			utils.ToPtr(""),
+			nil,
+			nil,
 		)
 		suite.NoError(err)
 		suite.NotNil(updated)
@@ -319,6 +327,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			nil,
 			nil,
+			nil,
+			nil,
 		)
 		suite.NoError(err)
 		suite.NotNil(updated)
@@ -326,6 +336,31 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 		suite.Equal(*current.Description, *updated.Description)
 	})
 
+	suite.Run("Update Success - Protected", func() {
+		updated, err := suite.environmentRepo.Update(
+			suite.Ctx,
+			testEnvironment.ID,
+			nil,
+			nil,
+			utils.ToPtr(true),
+			nil,
+		)
+		suite.NoError(err)
+		suite.NotNil(updated)
+		suite.True(updated.Protected)
+
+		updated, err = suite.environmentRepo.Update(
+			suite.Ctx,
+			testEnvironment.ID,
+			nil,
+			nil,
+			utils.ToPtr(false),
+			nil,
+		)
+		suite.NoError(err)
+		suite.False(updated.Protected)
+	})
+
 	suite.Run("Update Error - Non-existent Environment", func() {
 		nonExistentID := uuid.New()
 		newName := "Non-existent"
@@ -334,6 +369,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			nonExistentID,
 			&newName,
 			nil,
+			nil,
+			nil,
 		)
 		suite.Error(err)
 		suite.Nil(updated)
@@ -347,6 +384,8 @@ func (suite *EnvironmentMutationsSuite) TestUpdate() {
 			testEnvironment.ID,
 			&newName,
 			nil,
+			nil,
+			nil,
 		)
 		suite.Error(err)
 		suite.Nil(updated)
@@ -16,6 +16,19 @@ func (self *EnvironmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 	}).Only(ctx)
 }
 
+// GetPreviews gets the preview environments of a pull request, one per base environment
+func (self *EnvironmentRepository) GetPreviews(ctx context.Context, gitRepository string, pullRequest int) ([]*ent.Environment, error) {
+	return self.base.DB.Environment.Query().
+		Where(
+			environment.PreviewRepository(gitRepository),
+			environment.PreviewPullRequest(pullRequest),
+		).
+		WithProject(func(q *ent.ProjectQuery) {
+			q.WithTeam()
+		}).
+		All(ctx)
+}
+
 // Return all environments for a project with service edge populated
 func (self *EnvironmentRepository) GetForProject(ctx context.Context, tx repository.TxInterface, projectID uuid.UUID, authPredicate predicate.Environment) ([]*ent.Environment, error) {
 	db := self.base.DB
@@ -80,6 +80,31 @@ func (suite *EnvironmentQueriesSuite) TestGetByID() {
 	})
 }
 
+func (suite *EnvironmentQueriesSuite) TestGetPreviews() {
+	description := "Preview of acme/app#5: Add login"
+	preview, err := suite.environmentRepo.CreatePreview(suite.Ctx, nil, "pr-5-abc", "pr-5", "pr-5-secret", &description, suite.testProject.ID, suite.testEnvironment.ID, "acme/app", 5)
+	suite.Require().NoError(err)
+	suite.Equal(suite.testEnvironment.ID, *preview.PreviewBaseEnvironmentID)
+
+	// Another repository's pull request with the same number
+	_, err = suite.environmentRepo.CreatePreview(suite.Ctx, nil, "pr-5-def", "pr-5", "pr-5-secret", nil, suite.testProject.ID, suite.testEnvironment.ID, "acme/api", 5)
+	suite.Require().NoError(err)
+
+	previews, err := suite.environmentRepo.GetPreviews(suite.Ctx, "acme/app", 5)
+	suite.NoError(err)
+	suite.Require().Len(previews, 1)
+	suite.Equal(preview.ID, previews[0].ID)
+	suite.NotNil(previews[0].Edges.Project.Edges.Team)
+
+	previews, err = suite.environmentRepo.GetPreviews(suite.Ctx, "acme/app", 6)
+	suite.NoError(err)
+	suite.Empty(previews)
+
+	updated, err := suite.environmentRepo.SetPreviewCommentID(suite.Ctx, preview.ID, 42)
+	suite.NoError(err)
+	suite.Equal(int64(42), *updated.PreviewCommentID)
+}
+
 func (suite *EnvironmentQueriesSuite) TestGetForProject() {
 	suite.Run("GetForProject Success", func() {
 		environments, err := suite.environmentRepo.GetForProject(suite.Ctx, nil, suite.testProject.ID, nil)
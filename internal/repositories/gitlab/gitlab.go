@@ -0,0 +1,20 @@
+package gitlab_repo
+
+import (
+	"github.com/unbindapp/unbind-api/ent"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+)
+
+// GitlabRepository handles GitLab-related database operations
+//
+//go:generate go run -mod=mod github.com/vburenin/ifacemaker -f "*.go" -i GitlabRepositoryInterface -p gitlab_repo -s GitlabRepository -o gitlab_repository_iface.go
+type GitlabRepository struct {
+	base *repository.BaseRepository
+}
+
+// NewGitlabRepository creates a new GitLab repository
+func NewGitlabRepository(db *ent.Client) *GitlabRepository {
+	return &GitlabRepository{
+		base: &repository.BaseRepository{DB: db},
+	}
+}
@@ -0,0 +1,72 @@
+package gitlab_repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+)
+
+type CreateConnectionInput struct {
+	CreatedBy       uuid.UUID
+	Name            string
+	URL             string
+	AuthType        gitlabconnection.AuthType
+	AccountID       int64
+	AccountUsername string
+	AccessToken     string
+	RefreshToken    *string
+	TokenExpiresAt  *time.Time
+	ClientID        *string
+	ClientSecret    *string
+	WebhookSecret   string
+}
+
+func (self *GitlabRepository) CreateConnection(ctx context.Context, input *CreateConnectionInput) (*ent.GitlabConnection, error) {
+	return self.base.DB.GitlabConnection.Create().
+		SetCreatedBy(input.CreatedBy).
+		SetName(input.Name).
+		SetURL(input.URL).
+		SetAuthType(input.AuthType).
+		SetAccountID(input.AccountID).
+		SetAccountUsername(input.AccountUsername).
+		SetAccessToken(input.AccessToken).
+		SetNillableRefreshToken(input.RefreshToken).
+		SetNillableTokenExpiresAt(input.TokenExpiresAt).
+		SetNillableClientID(input.ClientID).
+		SetNillableClientSecret(input.ClientSecret).
+		SetWebhookSecret(input.WebhookSecret).
+		Save(ctx)
+}
+
+func (self *GitlabRepository) GetConnectionByID(ctx context.Context, ID uuid.UUID) (*ent.GitlabConnection, error) {
+	return self.base.DB.GitlabConnection.Query().Where(gitlabconnection.ID(ID)).Only(ctx)
+}
+
+// GetConnections returns every connection, e.g. to find the one a webhook belongs to
+func (self *GitlabRepository) GetConnections(ctx context.Context) ([]*ent.GitlabConnection, error) {
+	return self.base.DB.GitlabConnection.Query().All(ctx)
+}
+
+func (self *GitlabRepository) GetConnectionsByCreator(ctx context.Context, createdBy uuid.UUID) ([]*ent.GitlabConnection, error) {
+	return self.base.DB.GitlabConnection.Query().
+		Where(gitlabconnection.CreatedByEQ(createdBy)).
+		Order(ent.Asc(gitlabconnection.FieldCreatedAt)).
+		All(ctx)
+}
+
+// UpdateTokens stores a refreshed OAuth token
+func (self *GitlabRepository) UpdateTokens(ctx context.Context, ID uuid.UUID, accessToken string, refreshToken *string, tokenExpiresAt *time.Time) (*ent.GitlabConnection, error) {
+	return self.base.DB.GitlabConnection.UpdateOneID(ID).
+		SetAccessToken(accessToken).
+		SetNillableRefreshToken(refreshToken).
+		SetNillableTokenExpiresAt(tokenExpiresAt).
+		Save(ctx)
+}
+
+// DeleteConnection removes the connection, its services are kept but no longer deploy on push
+func (self *GitlabRepository) DeleteConnection(ctx context.Context, ID uuid.UUID) error {
+	return self.base.DB.GitlabConnection.DeleteOneID(ID).Exec(ctx)
+}
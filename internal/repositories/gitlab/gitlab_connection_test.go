@@ -0,0 +1,122 @@
+package gitlab_repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type GitlabConnectionSuite struct {
+	repository.RepositoryBaseSuite
+	gitlabRepo *GitlabRepository
+	testUser   *ent.User
+}
+
+func (suite *GitlabConnectionSuite) SetupTest() {
+	suite.RepositoryBaseSuite.SetupTest()
+	suite.gitlabRepo = NewGitlabRepository(suite.DB)
+
+	pwd, _ := bcrypt.GenerateFromPassword([]byte("test-password"), 1)
+	suite.testUser = suite.DB.User.Create().
+		SetEmail("test@example.com").
+		SetPasswordHash(string(pwd)).
+		SaveX(suite.Ctx)
+}
+
+func (suite *GitlabConnectionSuite) TearDownTest() {
+	suite.RepositoryBaseSuite.TearDownTest()
+	suite.gitlabRepo = nil
+	suite.testUser = nil
+}
+
+func (suite *GitlabConnectionSuite) createConnection(name string) *ent.GitlabConnection {
+	connection, err := suite.gitlabRepo.CreateConnection(suite.Ctx, &CreateConnectionInput{
+		CreatedBy:       suite.testUser.ID,
+		Name:            name,
+		URL:             "https://gitlab.example.com",
+		AuthType:        gitlabconnection.AuthTypeToken,
+		AccountID:       42,
+		AccountUsername: "jdoe",
+		AccessToken:     "glpat-test",
+		WebhookSecret:   "webhook-secret",
+	})
+	suite.Require().NoError(err)
+	return connection
+}
+
+func (suite *GitlabConnectionSuite) TestCreateConnection() {
+	connection := suite.createConnection("Self-hosted")
+
+	suite.Equal("Self-hosted", connection.Name)
+	suite.Equal("https://gitlab.example.com", connection.URL)
+	suite.Equal(gitlabconnection.AuthTypeToken, connection.AuthType)
+	suite.Equal("jdoe", connection.AccountUsername)
+	suite.Nil(connection.RefreshToken)
+	suite.Nil(connection.TokenExpiresAt)
+
+	fetched, err := suite.gitlabRepo.GetConnectionByID(suite.Ctx, connection.ID)
+	suite.NoError(err)
+	suite.Equal("glpat-test", fetched.AccessToken)
+	suite.Equal("webhook-secret", fetched.WebhookSecret)
+}
+
+func (suite *GitlabConnectionSuite) TestGetConnectionByID_NotFound() {
+	_, err := suite.gitlabRepo.GetConnectionByID(suite.Ctx, uuid.New())
+	suite.True(ent.IsNotFound(err))
+}
+
+func (suite *GitlabConnectionSuite) TestGetConnectionsByCreator() {
+	suite.createConnection("First")
+	suite.createConnection("Second")
+
+	otherUser := suite.DB.User.Create().
+		SetEmail("other@example.com").
+		SetPasswordHash("hash").
+		SaveX(suite.Ctx)
+
+	connections, err := suite.gitlabRepo.GetConnectionsByCreator(suite.Ctx, suite.testUser.ID)
+	suite.NoError(err)
+	suite.Len(connections, 2)
+	suite.Equal("First", connections[0].Name)
+
+	connections, err = suite.gitlabRepo.GetConnectionsByCreator(suite.Ctx, otherUser.ID)
+	suite.NoError(err)
+	suite.Empty(connections)
+
+	connections, err = suite.gitlabRepo.GetConnections(suite.Ctx)
+	suite.NoError(err)
+	suite.Len(connections, 2)
+}
+
+func (suite *GitlabConnectionSuite) TestUpdateTokens() {
+	connection := suite.createConnection("OAuth")
+	expiresAt := time.Now().Add(2 * time.Hour)
+
+	updated, err := suite.gitlabRepo.UpdateTokens(suite.Ctx, connection.ID, "new-access", utils.ToPtr("new-refresh"), &expiresAt)
+	suite.NoError(err)
+	suite.Equal("new-access", updated.AccessToken)
+	suite.Require().NotNil(updated.RefreshToken)
+	suite.Equal("new-refresh", *updated.RefreshToken)
+	suite.Require().NotNil(updated.TokenExpiresAt)
+	suite.WithinDuration(expiresAt, *updated.TokenExpiresAt, time.Second)
+}
+
+func (suite *GitlabConnectionSuite) TestDeleteConnection() {
+	connection := suite.createConnection("Delete me")
+
+	suite.NoError(suite.gitlabRepo.DeleteConnection(suite.Ctx, connection.ID))
+
+	_, err := suite.gitlabRepo.GetConnectionByID(suite.Ctx, connection.ID)
+	suite.True(ent.IsNotFound(err))
+}
+
+func TestGitlabConnectionSuite(t *testing.T) {
+	suite.Run(t, new(GitlabConnectionSuite))
+}
@@ -9,6 +9,7 @@ import (
 	deployment_repo "github.com/unbindapp/unbind-api/internal/repositories/deployment"
 	environment_repo "github.com/unbindapp/unbind-api/internal/repositories/environment"
 	github_repo "github.com/unbindapp/unbind-api/internal/repositories/github"
+	gitlab_repo "github.com/unbindapp/unbind-api/internal/repositories/gitlab"
 	group_repo "github.com/unbindapp/unbind-api/internal/repositories/group"
 	oauth_repo "github.com/unbindapp/unbind-api/internal/repositories/oauth"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
@@ -31,6 +32,7 @@ type Repositories struct {
 	db           *ent.Client
 	base         *repository.BaseRepository
 	github       github_repo.GithubRepositoryInterface
+	gitlab       gitlab_repo.GitlabRepositoryInterface
 	user         user_repo.UserRepositoryInterface
 	oauth        oauth_repo.OauthRepositoryInterface
 	group        group_repo.GroupRepositoryInterface
@@ -53,6 +55,7 @@ type Repositories struct {
 func NewRepositories(db *ent.Client) *Repositories {
 	base := repository.NewBaseRepository(db)
 	githubRepo := github_repo.NewGithubRepository(db)
+	gitlabRepo := gitlab_repo.NewGitlabRepository(db)
 	oauthRepo := oauth_repo.NewOauthRepository(db)
 	userRepo := user_repo.NewUserRepository(db)
 	projectRepo := project_repo.NewProjectRepository(db)
@@ -73,6 +76,7 @@ func NewRepositories(db *ent.Client) *Repositories {
 		db:           db,
 		base:         base,
 		github:       githubRepo,
+		gitlab:       gitlabRepo,
 		user:         userRepo,
 		oauth:        oauthRepo,
 		group:        groupRepo,
@@ -102,6 +106,11 @@ func (r *Repositories) Github() github_repo.GithubRepositoryInterface {
 	return r.github
 }
 
+// Gitlab returns the GitLab repository
+func (r *Repositories) Gitlab() gitlab_repo.GitlabRepositoryInterface {
+	return r.gitlab
+}
+
 // User returns the User repository
 func (r *Repositories) User() user_repo.UserRepositoryInterface {
 	return r.user
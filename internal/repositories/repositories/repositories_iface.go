@@ -11,6 +11,7 @@ import (
 	deployment_repo "github.com/unbindapp/unbind-api/internal/repositories/deployment"
 	environment_repo "github.com/unbindapp/unbind-api/internal/repositories/environment"
 	github_repo "github.com/unbindapp/unbind-api/internal/repositories/github"
+	gitlab_repo "github.com/unbindapp/unbind-api/internal/repositories/gitlab"
 	group_repo "github.com/unbindapp/unbind-api/internal/repositories/group"
 	oauth_repo "github.com/unbindapp/unbind-api/internal/repositories/oauth"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
@@ -32,6 +33,8 @@ type RepositoriesInterface interface {
 	Ent() *ent.Client
 	// Github returns the GitHub repository
 	Github() github_repo.GithubRepositoryInterface
+	// Gitlab returns the GitLab repository
+	Gitlab() gitlab_repo.GitlabRepositoryInterface
 	// User returns the User repository
 	User() user_repo.UserRepositoryInterface
 	// Oauth returns the OAuth repository
@@ -2,6 +2,8 @@ package service_repo
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -21,8 +23,13 @@ type CreateServiceInput struct {
 	Description          string
 	EnvironmentID        uuid.UUID
 	GitHubInstallationID *int64
+	GitlabConnectionID   *uuid.UUID
 	GitRepository        *string
 	GitRepositoryOwner   *string
+	GitURL               *string
+	GitDeployPublicKey   *string
+	GitSSHHostKey        *string
+	GitWebhookSecret     *string
 	KubernetesSecret     string
 	Database             *string
 	DatabaseVersion      *string
@@ -50,8 +57,13 @@ func (self *ServiceRepository) Create(
 		SetDescription(input.Description).
 		SetEnvironmentID(input.EnvironmentID).
 		SetNillableGithubInstallationID(input.GitHubInstallationID).
+		SetNillableGitlabConnectionID(input.GitlabConnectionID).
 		SetNillableGitRepository(input.GitRepository).
 		SetNillableGitRepositoryOwner(input.GitRepositoryOwner).
+		SetNillableGitURL(input.GitURL).
+		SetNillableGitDeployPublicKey(input.GitDeployPublicKey).
+		SetNillableGitSSHHostKey(input.GitSSHHostKey).
+		SetNillableGitWebhookSecret(input.GitWebhookSecret).
 		SetKubernetesSecret(input.KubernetesSecret).
 		SetNillableDatabase(input.Database).
 		SetNillableTemplateID(input.TemplateID).
@@ -69,6 +81,9 @@ type MutateConfigInput struct {
 	Framework                     *enum.Framework
 	GitBranch                     *string
 	GitTag                        *string
+	WatchPaths                    *[]string
+	SkipDeployMarker              *string
+	IgnoredCommitAuthors          *[]string
 	Icon                          *string
 	OverwritePorts                []schema.PortSpec
 	AddPorts                      []schema.PortSpec
@@ -78,17 +93,31 @@ type MutateConfigInput struct {
 	RemoveHosts                   []schema.HostSpec
 	Replicas                      *int32
 	AutoDeploy                    *bool
+	AutoRollback                  *bool
+	PrPreviews                    *bool
+	PrPreviewVariables            *[]string
 	RailpackBuilderInstallCommand *string
 	RailpackBuilderBuildCommand   *string
 	RunCommand                    *string
+	PreDeployCommand              *string
+	RolloutStrategy               *schema.RolloutStrategy
+	CanaryWeight                  *int
 	Public                        *bool
 	Image                         *string
+	ImageAutoUpdate               *bool
 	DockerBuilderDockerfilePath   *string
 	DockerBuilderBuildContext     *string
+	DockerBuilderTarget           *string
+	DockerBuilderBuildArgs        *map[string]string
+	DockerBuilderBuildContexts    *map[string]string
+	BuildpacksBuilderImage        *string
+	Platforms                     *[]string
 	CustomDefinitionVersion       *string
 	DatabaseConfig                *schema.DatabaseConfig
 	S3BackupSourceID              *uuid.UUID
 	S3BackupBucket                *string
+	UploadS3SourceID              *uuid.UUID
+	UploadS3Bucket                *string
 	BackupSchedule                *string
 	BackupRetentionCount          *int
 	SecurityContext               *schema.SecurityContext
@@ -147,16 +176,26 @@ func (self *ServiceRepository) CreateConfig(
 		SetNillableGitBranch(input.GitBranch).
 		SetNillableReplicas(input.Replicas).
 		SetNillableAutoDeploy(input.AutoDeploy).
+		SetNillableAutoRollback(input.AutoRollback).
+		SetNillablePrPreviews(input.PrPreviews).
 		SetNillableRailpackBuilderInstallCommand(input.RailpackBuilderInstallCommand).
 		SetNillableRailpackBuilderBuildCommand(input.RailpackBuilderBuildCommand).
 		SetNillableRunCommand(input.RunCommand).
+		SetNillablePreDeployCommand(input.PreDeployCommand).
+		SetNillableRolloutStrategy(input.RolloutStrategy).
+		SetNillableCanaryWeight(input.CanaryWeight).
 		SetNillableIsPublic(input.Public).
 		SetNillableImage(input.Image).
+		SetNillableImageAutoUpdate(input.ImageAutoUpdate).
 		SetNillableDockerBuilderDockerfilePath(input.DockerBuilderDockerfilePath).
 		SetNillableDockerBuilderBuildContext(input.DockerBuilderBuildContext).
+		SetNillableDockerBuilderTarget(input.DockerBuilderTarget).
+		SetNillableBuildpacksBuilderImage(input.BuildpacksBuilderImage).
 		SetNillableDefinitionVersion(input.CustomDefinitionVersion).
 		SetNillableS3BackupSourceID(input.S3BackupSourceID).
 		SetNillableS3BackupBucket(input.S3BackupBucket).
+		SetNillableUploadS3SourceID(input.UploadS3SourceID).
+		SetNillableUploadS3Bucket(input.UploadS3Bucket).
 		SetNillableBackupSchedule(input.BackupSchedule).
 		SetNillableBackupRetentionCount(input.BackupRetentionCount)
 
@@ -176,6 +215,34 @@ func (self *ServiceRepository) CreateConfig(
 		c.SetProtectedVariables(*input.ProtectedVariables)
 	}
 
+	if input.WatchPaths != nil && len(*input.WatchPaths) > 0 {
+		c.SetWatchPaths(*input.WatchPaths)
+	}
+
+	if input.SkipDeployMarker != nil && *input.SkipDeployMarker != "" {
+		c.SetSkipDeployMarker(*input.SkipDeployMarker)
+	}
+
+	if input.IgnoredCommitAuthors != nil && len(*input.IgnoredCommitAuthors) > 0 {
+		c.SetIgnoredCommitAuthors(*input.IgnoredCommitAuthors)
+	}
+
+	if input.PrPreviewVariables != nil && len(*input.PrPreviewVariables) > 0 {
+		c.SetPrPreviewVariables(*input.PrPreviewVariables)
+	}
+
+	if input.DockerBuilderBuildArgs != nil && len(*input.DockerBuilderBuildArgs) > 0 {
+		c.SetDockerBuilderBuildArgs(*input.DockerBuilderBuildArgs)
+	}
+
+	if input.DockerBuilderBuildContexts != nil && len(*input.DockerBuilderBuildContexts) > 0 {
+		c.SetDockerBuilderBuildContexts(*input.DockerBuilderBuildContexts)
+	}
+
+	if input.Platforms != nil && len(*input.Platforms) > 0 {
+		c.SetPlatforms(*input.Platforms)
+	}
+
 	if len(input.OverwriteVariableMounts) > 0 {
 		c.SetVariableMounts(input.OverwriteVariableMounts)
 	}
@@ -243,14 +310,27 @@ func (self *ServiceRepository) UpdateConfig(
 
 	upd := db.ServiceConfig.UpdateOneID(existingConfig.ID).
 		SetNillableBuilder(input.Builder).
+		SetNillableRailpackProvider(input.Provider).
+		SetNillableRailpackFramework(input.Framework).
+		SetNillableIcon(input.Icon).
 		SetNillableReplicas(input.Replicas).
 		SetNillableAutoDeploy(input.AutoDeploy).
+		SetNillableAutoRollback(input.AutoRollback).
+		SetNillablePrPreviews(input.PrPreviews).
+		SetNillableRolloutStrategy(input.RolloutStrategy).
+		SetNillableCanaryWeight(input.CanaryWeight).
 		SetNillableIsPublic(input.Public).
 		SetNillableImage(input.Image).
+		SetNillableImageAutoUpdate(input.ImageAutoUpdate).
 		SetNillableDefinitionVersion(input.CustomDefinitionVersion).
 		SetNillableBackupSchedule(input.BackupSchedule).
 		SetNillableBackupRetentionCount(input.BackupRetentionCount)
 
+	// A different image starts over, the next check records its digest
+	if input.Image != nil && *input.Image != existingConfig.Image {
+		upd.ClearImageDigest()
+	}
+
 	if input.Resources != nil {
 		// If all values are < 1, we clear the resources
 		if input.Resources.CPULimitsMillicores < 1 &&
@@ -327,6 +407,14 @@ func (self *ServiceRepository) UpdateConfig(
 		}
 	}
 
+	if input.PreDeployCommand != nil {
+		if *input.PreDeployCommand == "" {
+			upd.ClearPreDeployCommand()
+		} else {
+			upd.SetPreDeployCommand(*input.PreDeployCommand)
+		}
+	}
+
 	if input.GitBranch != nil {
 		if *input.GitBranch == "" {
 			upd.ClearGitBranch()
@@ -342,6 +430,38 @@ func (self *ServiceRepository) UpdateConfig(
 		}
 	}
 
+	if input.WatchPaths != nil {
+		if len(*input.WatchPaths) == 0 {
+			upd.ClearWatchPaths()
+		} else {
+			upd.SetWatchPaths(*input.WatchPaths)
+		}
+	}
+
+	if input.SkipDeployMarker != nil {
+		if *input.SkipDeployMarker == "" {
+			upd.ClearSkipDeployMarker()
+		} else {
+			upd.SetSkipDeployMarker(*input.SkipDeployMarker)
+		}
+	}
+
+	if input.IgnoredCommitAuthors != nil {
+		if len(*input.IgnoredCommitAuthors) == 0 {
+			upd.ClearIgnoredCommitAuthors()
+		} else {
+			upd.SetIgnoredCommitAuthors(*input.IgnoredCommitAuthors)
+		}
+	}
+
+	if input.PrPreviewVariables != nil {
+		if len(*input.PrPreviewVariables) == 0 {
+			upd.ClearPrPreviewVariables()
+		} else {
+			upd.SetPrPreviewVariables(*input.PrPreviewVariables)
+		}
+	}
+
 	if input.S3BackupBucket != nil {
 		if *input.S3BackupBucket == "" {
 			upd.ClearS3BackupBucket()
@@ -358,6 +478,22 @@ func (self *ServiceRepository) UpdateConfig(
 		}
 	}
 
+	if input.UploadS3Bucket != nil {
+		if *input.UploadS3Bucket == "" {
+			upd.ClearUploadS3Bucket()
+		} else {
+			upd.SetUploadS3Bucket(*input.UploadS3Bucket)
+		}
+	}
+
+	if input.UploadS3SourceID != nil {
+		if *input.UploadS3SourceID == uuid.Nil {
+			upd.ClearUploadS3SourceID()
+		} else {
+			upd.SetUploadS3SourceID(*input.UploadS3SourceID)
+		}
+	}
+
 	if input.DatabaseConfig != nil {
 		upd.SetDatabaseConfig(input.DatabaseConfig)
 
@@ -388,6 +524,46 @@ func (self *ServiceRepository) UpdateConfig(
 		}
 	}
 
+	if input.DockerBuilderTarget != nil {
+		if *input.DockerBuilderTarget == "" {
+			upd.ClearDockerBuilderTarget()
+		} else {
+			upd.SetDockerBuilderTarget(*input.DockerBuilderTarget)
+		}
+	}
+
+	if input.DockerBuilderBuildArgs != nil {
+		if len(*input.DockerBuilderBuildArgs) == 0 {
+			upd.ClearDockerBuilderBuildArgs()
+		} else {
+			upd.SetDockerBuilderBuildArgs(*input.DockerBuilderBuildArgs)
+		}
+	}
+
+	if input.DockerBuilderBuildContexts != nil {
+		if len(*input.DockerBuilderBuildContexts) == 0 {
+			upd.ClearDockerBuilderBuildContexts()
+		} else {
+			upd.SetDockerBuilderBuildContexts(*input.DockerBuilderBuildContexts)
+		}
+	}
+
+	if input.BuildpacksBuilderImage != nil {
+		if *input.BuildpacksBuilderImage == "" {
+			upd.ClearBuildpacksBuilderImage()
+		} else {
+			upd.SetBuildpacksBuilderImage(*input.BuildpacksBuilderImage)
+		}
+	}
+
+	if input.Platforms != nil {
+		if len(*input.Platforms) == 0 {
+			upd.ClearPlatforms()
+		} else {
+			upd.SetPlatforms(*input.Platforms)
+		}
+	}
+
 	// * A bunch of jsonb merging logic for volumes, ports, hosts, and variable mounts
 	if len(input.OverwriteVariableMounts) > 0 {
 		upd.SetVariableMounts(input.OverwriteVariableMounts)
@@ -569,6 +745,44 @@ func (self *ServiceRepository) SetCurrentDeployment(ctx context.Context, tx repo
 	return db.Service.UpdateOneID(serviceID).SetCurrentDeploymentID(deploymentID).Exec(ctx)
 }
 
+// SetDeployHookToken replaces the service's deploy hook token, nil revokes the deploy hook
+// Only a hash of the token is stored
+func (self *ServiceRepository) SetDeployHookToken(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, token *string) error {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	if token == nil {
+		return db.Service.UpdateOneID(serviceID).ClearDeployHookTokenHash().Exec(ctx)
+	}
+	return db.Service.UpdateOneID(serviceID).SetDeployHookTokenHash(hashDeployHookToken(*token)).Exec(ctx)
+}
+
+func hashDeployHookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetImageDigest records the last seen digest of the service's image tag, nil forgets it
+func (self *ServiceRepository) SetImageDigest(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, digest *string) error {
+	db := self.base.DB
+	if tx != nil {
+		db = tx.Client()
+	}
+
+	if digest == nil {
+		return db.ServiceConfig.Update().
+			Where(serviceconfig.ServiceID(serviceID)).
+			ClearImageDigest().
+			Exec(ctx)
+	}
+	return db.ServiceConfig.Update().
+		Where(serviceconfig.ServiceID(serviceID)).
+		SetImageDigest(*digest).
+		Exec(ctx)
+}
+
 func (self *ServiceRepository) UpdateVariableMounts(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, variableMounts []*schema.VariableMount) error {
 	db := self.base.DB
 	if tx != nil {
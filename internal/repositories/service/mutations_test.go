@@ -452,6 +452,58 @@ func (suite *ServiceMutationsSuite) TestUpdateConfig() {
 		suite.Equal("node:20", updated.Image)
 	})
 
+	suite.Run("UpdateConfig Detected Source", func() {
+		err := suite.serviceRepo.UpdateConfig(suite.Ctx, nil, &MutateConfigInput{
+			ServiceID: suite.testService.ID,
+			Provider:  utils.ToPtr(enum.Node),
+			Framework: utils.ToPtr(enum.Next),
+			Icon:      utils.ToPtr(string(enum.Next)),
+		})
+		suite.NoError(err)
+
+		updated, err := suite.DB.ServiceConfig.Query().
+			Where(serviceconfig.ServiceID(suite.testService.ID)).
+			Only(suite.Ctx)
+		suite.NoError(err)
+		suite.Require().NotNil(updated.RailpackProvider)
+		suite.Equal(enum.Node, *updated.RailpackProvider)
+		suite.Require().NotNil(updated.RailpackFramework)
+		suite.Equal(enum.Next, *updated.RailpackFramework)
+		suite.Equal(string(enum.Next), updated.Icon)
+	})
+
+	suite.Run("UpdateConfig New Image Clears Digest", func() {
+		err := suite.serviceRepo.SetImageDigest(suite.Ctx, nil, suite.testService.ID, utils.ToPtr("sha256:abc123"))
+		suite.NoError(err)
+
+		// Same image keeps the digest
+		err = suite.serviceRepo.UpdateConfig(suite.Ctx, nil, &MutateConfigInput{
+			ServiceID:       suite.testService.ID,
+			Image:           utils.ToPtr("node:20"),
+			ImageAutoUpdate: utils.ToPtr(true),
+		})
+		suite.NoError(err)
+
+		updated, err := suite.DB.ServiceConfig.Query().
+			Where(serviceconfig.ServiceID(suite.testService.ID)).
+			Only(suite.Ctx)
+		suite.NoError(err)
+		suite.True(updated.ImageAutoUpdate)
+		suite.NotNil(updated.ImageDigest)
+
+		err = suite.serviceRepo.UpdateConfig(suite.Ctx, nil, &MutateConfigInput{
+			ServiceID: suite.testService.ID,
+			Image:     utils.ToPtr("node:22"),
+		})
+		suite.NoError(err)
+
+		updated, err = suite.DB.ServiceConfig.Query().
+			Where(serviceconfig.ServiceID(suite.testService.ID)).
+			Only(suite.Ctx)
+		suite.NoError(err)
+		suite.Nil(updated.ImageDigest)
+	})
+
 	suite.Run("UpdateConfig Add/Remove Ports", func() {
 		input := &MutateConfigInput{
 			ServiceID: suite.testService.ID,
@@ -650,6 +702,32 @@ func (suite *ServiceMutationsSuite) TestUpdateConfig() {
 		suite.Nil(updated.Resources)
 	})
 
+	suite.Run("UpdateConfig Set and Clear Watch Paths", func() {
+		err := suite.serviceRepo.UpdateConfig(suite.Ctx, nil, &MutateConfigInput{
+			ServiceID:  suite.testService.ID,
+			WatchPaths: &[]string{"apps/web/*", "packages/*"},
+		})
+		suite.NoError(err)
+
+		updated, err := suite.DB.ServiceConfig.Query().
+			Where(serviceconfig.ServiceID(suite.testService.ID)).
+			Only(suite.Ctx)
+		suite.NoError(err)
+		suite.Equal([]string{"apps/web/*", "packages/*"}, updated.WatchPaths)
+
+		err = suite.serviceRepo.UpdateConfig(suite.Ctx, nil, &MutateConfigInput{
+			ServiceID:  suite.testService.ID,
+			WatchPaths: &[]string{},
+		})
+		suite.NoError(err)
+
+		updated, err = suite.DB.ServiceConfig.Query().
+			Where(serviceconfig.ServiceID(suite.testService.ID)).
+			Only(suite.Ctx)
+		suite.NoError(err)
+		suite.Empty(updated.WatchPaths)
+	})
+
 	suite.Run("UpdateConfig Error when DB closed", func() {
 		input := &MutateConfigInput{
 			ServiceID: suite.testService.ID,
@@ -722,6 +800,54 @@ func (suite *ServiceMutationsSuite) TestSetCurrentDeployment() {
 	})
 }
 
+func (suite *ServiceMutationsSuite) TestSetDeployHookToken() {
+	suite.Run("SetDeployHookToken Rotate and Revoke", func() {
+		err := suite.serviceRepo.SetDeployHookToken(suite.Ctx, nil, suite.testService.ID, utils.ToPtr("first-token"))
+		suite.NoError(err)
+		err = suite.serviceRepo.SetDeployHookToken(suite.Ctx, nil, suite.testService.ID, utils.ToPtr("second-token"))
+		suite.NoError(err)
+
+		updated, err := suite.DB.Service.Get(suite.Ctx, suite.testService.ID)
+		suite.NoError(err)
+		// Only the hash of the token is stored
+		suite.NotNil(updated.DeployHookTokenHash)
+		suite.Equal(hashDeployHookToken("second-token"), *updated.DeployHookTokenHash)
+		suite.NotContains(*updated.DeployHookTokenHash, "second-token")
+
+		err = suite.serviceRepo.SetDeployHookToken(suite.Ctx, nil, suite.testService.ID, nil)
+		suite.NoError(err)
+
+		updated, err = suite.DB.Service.Get(suite.Ctx, suite.testService.ID)
+		suite.NoError(err)
+		suite.Nil(updated.DeployHookTokenHash)
+	})
+
+	suite.Run("SetDeployHookToken Non-existent Service", func() {
+		err := suite.serviceRepo.SetDeployHookToken(suite.Ctx, nil, uuid.New(), utils.ToPtr("token"))
+		suite.Error(err)
+		suite.True(ent.IsNotFound(err))
+	})
+}
+
+func (suite *ServiceMutationsSuite) TestSetImageDigest() {
+	suite.Run("SetImageDigest Set and Clear", func() {
+		err := suite.serviceRepo.SetImageDigest(suite.Ctx, nil, suite.testService.ID, utils.ToPtr("sha256:abc123"))
+		suite.NoError(err)
+
+		config, err := suite.DB.ServiceConfig.Get(suite.Ctx, suite.testConfig.ID)
+		suite.NoError(err)
+		suite.NotNil(config.ImageDigest)
+		suite.Equal("sha256:abc123", *config.ImageDigest)
+
+		err = suite.serviceRepo.SetImageDigest(suite.Ctx, nil, suite.testService.ID, nil)
+		suite.NoError(err)
+
+		config, err = suite.DB.ServiceConfig.Get(suite.Ctx, suite.testConfig.ID)
+		suite.NoError(err)
+		suite.Nil(config.ImageDigest)
+	})
+}
+
 func (suite *ServiceMutationsSuite) TestUpdateVariableMounts() {
 	suite.Run("UpdateVariableMounts Success", func() {
 		variableMounts := []*schema.VariableMount{
@@ -6,13 +6,16 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/deployment"
+	"github.com/unbindapp/unbind-api/ent/environment"
 	"github.com/unbindapp/unbind-api/ent/githubapp"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
 	"github.com/unbindapp/unbind-api/ent/predicate"
+	"github.com/unbindapp/unbind-api/ent/sbompackage"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/ent/serviceconfig"
@@ -111,6 +114,30 @@ func (self *ServiceRepository) GetDatabases(ctx context.Context) ([]*ent.Service
 		All(ctx)
 }
 
+// GetAutoRollbackCandidates gets services with auto rollback enabled whose current deployment completed after the given time
+func (self *ServiceRepository) GetAutoRollbackCandidates(ctx context.Context, completedAfter time.Time) ([]*ent.Service, error) {
+	return self.base.DB.Service.Query().
+		Where(
+			service.TypeNEQ(schema.ServiceTypeDatabase),
+			service.HasServiceConfigWith(serviceconfig.AutoRollback(true)),
+			service.HasCurrentDeploymentWith(
+				deployment.CompletedAtGT(completedAfter),
+				// Never roll back a rollback
+				deployment.RollbackReasonIsNil(),
+			),
+		).
+		WithServiceConfig().
+		WithCurrentDeployment().
+		WithEnvironment(
+			func(eq *ent.EnvironmentQuery) {
+				eq.WithProject(func(pq *ent.ProjectQuery) {
+					pq.WithTeam()
+				})
+			},
+		).
+		All(ctx)
+}
+
 func (self *ServiceRepository) GetByInstallationIDAndRepoName(ctx context.Context, installationID int64, repoName string) ([]*ent.Service, error) {
 	return self.base.DB.Service.Query().
 		Where(service.GithubInstallationIDEQ(installationID)).
@@ -121,6 +148,89 @@ func (self *ServiceRepository) GetByInstallationIDAndRepoName(ctx context.Contex
 		All(ctx)
 }
 
+// GetByGitlabConnectionAndRepo gets the services of a GitLab project, the owner is the project's full namespace path
+func (self *ServiceRepository) GetByGitlabConnectionAndRepo(ctx context.Context, connectionID uuid.UUID, owner, repoName string) ([]*ent.Service, error) {
+	return self.base.DB.Service.Query().
+		Where(
+			service.GitlabConnectionIDEQ(connectionID),
+			service.GitRepositoryOwnerEQ(owner),
+			service.GitRepositoryEQ(repoName),
+		).
+		WithServiceConfig().
+		WithCurrentDeployment().
+		Order(ent.Desc(service.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetByDeployHookToken gets the service a deploy hook token belongs to
+func (self *ServiceRepository) GetByDeployHookToken(ctx context.Context, token string) (*ent.Service, error) {
+	return self.base.DB.Service.Query().
+		Where(service.DeployHookTokenHashEQ(hashDeployHookToken(token))).
+		WithServiceConfig().
+		Only(ctx)
+}
+
+// GetImageAutoUpdateServices gets docker image services that redeploy when their image tag is re-pushed
+func (self *ServiceRepository) GetImageAutoUpdateServices(ctx context.Context) ([]*ent.Service, error) {
+	return self.base.DB.Service.Query().
+		Where(
+			service.TypeEQ(schema.ServiceTypeDockerimage),
+			service.HasServiceConfigWith(serviceconfig.ImageAutoUpdate(true)),
+		).
+		WithServiceConfig().
+		Order(ent.Asc(service.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetPrPreviewServices gets services of the repo with pull request previews enabled, excluding services of preview environments
+func (self *ServiceRepository) GetPrPreviewServices(ctx context.Context, installationID int64, repoName string) ([]*ent.Service, error) {
+	return self.base.DB.Service.Query().
+		Where(
+			service.GithubInstallationIDEQ(installationID),
+			service.GitRepositoryEQ(repoName),
+			service.HasServiceConfigWith(serviceconfig.PrPreviews(true)),
+			service.HasEnvironmentWith(environment.PreviewPullRequestIsNil()),
+		).
+		WithServiceConfig().
+		WithEnvironment(
+			func(eq *ent.EnvironmentQuery) {
+				eq.WithProject(func(pq *ent.ProjectQuery) {
+					pq.WithTeam()
+				})
+			},
+		).
+		Order(ent.Asc(service.FieldCreatedAt)).
+		All(ctx)
+}
+
+// GetRunningWithPackage gets services whose current deployment's image contains the package, with the matching packages on the deployment
+func (self *ServiceRepository) GetRunningWithPackage(ctx context.Context, authPredicate predicate.Service, name, version string) ([]*ent.Service, error) {
+	packagePredicates := []predicate.SBOMPackage{sbompackage.NameEQ(name)}
+	if version != "" {
+		packagePredicates = append(packagePredicates, sbompackage.VersionEQ(version))
+	}
+
+	q := self.base.DB.Service.Query().
+		Where(service.HasCurrentDeploymentWith(deployment.HasSbomPackagesWith(packagePredicates...))).
+		WithCurrentDeployment(func(dq *ent.DeploymentQuery) {
+			dq.WithSbomPackages(func(pq *ent.SBOMPackageQuery) {
+				pq.Where(packagePredicates...).Order(ent.Asc(sbompackage.FieldVersion))
+			})
+		}).
+		WithEnvironment(
+			func(eq *ent.EnvironmentQuery) {
+				eq.WithProject()
+			},
+		).
+		Order(ent.Asc(service.FieldCreatedAt))
+
+	if authPredicate != nil {
+		q = q.Where(authPredicate)
+	}
+
+	return q.All(ctx)
+}
+
 func (self *ServiceRepository) GetByEnvironmentID(ctx context.Context, environmentID uuid.UUID, authPredicate predicate.Service, withLatestDeployment bool) ([]*ent.Service, error) {
 	q := self.base.DB.Service.Query().
 		Where(service.EnvironmentIDEQ(environmentID)).
@@ -2,11 +2,13 @@ package service_repo
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/githubinstallation"
+	"github.com/unbindapp/unbind-api/ent/gitlabconnection"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	entService "github.com/unbindapp/unbind-api/ent/service"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
@@ -324,6 +326,99 @@ func (suite *ServiceQueriesSuite) TestGetByName() {
 	})
 }
 
+func (suite *ServiceQueriesSuite) TestGetRunningWithPackage() {
+	suite.DB.SBOMPackage.Create().
+		SetDeploymentID(suite.testDeployment.ID).
+		SetName("log4j-core").
+		SetVersion("2.14.1").
+		SetType("maven").
+		SetPurl("pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1").
+		SaveX(suite.Ctx)
+
+	suite.Run("GetRunningWithPackage Success", func() {
+		services, err := suite.serviceRepo.GetRunningWithPackage(suite.Ctx, nil, "log4j-core", "")
+		suite.NoError(err)
+		suite.Len(services, 1)
+		suite.Equal(suite.testService.ID, services[0].ID)
+		suite.Equal(suite.testDeployment.ID, services[0].Edges.CurrentDeployment.ID)
+		suite.Len(services[0].Edges.CurrentDeployment.Edges.SbomPackages, 1)
+		suite.Equal(suite.testProject.ID, services[0].Edges.Environment.Edges.Project.ID)
+	})
+
+	suite.Run("GetRunningWithPackage Other Version", func() {
+		services, err := suite.serviceRepo.GetRunningWithPackage(suite.Ctx, nil, "log4j-core", "2.17.1")
+		suite.NoError(err)
+		suite.Empty(services)
+	})
+
+	suite.Run("GetRunningWithPackage Not Current Deployment", func() {
+		suite.DB.Service.UpdateOneID(suite.testService.ID).ClearCurrentDeployment().ExecX(suite.Ctx)
+
+		services, err := suite.serviceRepo.GetRunningWithPackage(suite.Ctx, nil, "log4j-core", "")
+		suite.NoError(err)
+		suite.Empty(services)
+	})
+}
+
+func (suite *ServiceQueriesSuite) TestGetByDeployHookToken() {
+	suite.Run("GetByDeployHookToken Success", func() {
+		err := suite.serviceRepo.SetDeployHookToken(suite.Ctx, nil, suite.testService.ID, utils.ToPtr("deploy-token"))
+		suite.NoError(err)
+
+		service, err := suite.serviceRepo.GetByDeployHookToken(suite.Ctx, "deploy-token")
+		suite.NoError(err)
+		suite.Equal(suite.testService.ID, service.ID)
+		suite.NotNil(service.Edges.ServiceConfig)
+	})
+
+	suite.Run("GetByDeployHookToken Unknown Token", func() {
+		_, err := suite.serviceRepo.GetByDeployHookToken(suite.Ctx, "wrong-token")
+		suite.Error(err)
+		suite.True(ent.IsNotFound(err))
+	})
+}
+
+func (suite *ServiceQueriesSuite) TestGetImageAutoUpdateServices() {
+	suite.Run("GetImageAutoUpdateServices Success", func() {
+		imageService := suite.DB.Service.Create().
+			SetType(schema.ServiceTypeDockerimage).
+			SetKubernetesName("image-service").
+			SetName("Image Service").
+			SetEnvironmentID(suite.testEnvironment.ID).
+			SetKubernetesSecret("image-secret").
+			SaveX(suite.Ctx)
+		suite.DB.ServiceConfig.Create().
+			SetServiceID(imageService.ID).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SetIcon("docker").
+			SetImage("nginx:1.27").
+			SetImageAutoUpdate(true).
+			SaveX(suite.Ctx)
+
+		// Not opted in
+		otherService := suite.DB.Service.Create().
+			SetType(schema.ServiceTypeDockerimage).
+			SetKubernetesName("other-image-service").
+			SetName("Other Image Service").
+			SetEnvironmentID(suite.testEnvironment.ID).
+			SetKubernetesSecret("other-image-secret").
+			SaveX(suite.Ctx)
+		suite.DB.ServiceConfig.Create().
+			SetServiceID(otherService.ID).
+			SetBuilder(schema.ServiceBuilderDocker).
+			SetIcon("docker").
+			SetImage("redis:7").
+			SaveX(suite.Ctx)
+
+		services, err := suite.serviceRepo.GetImageAutoUpdateServices(suite.Ctx)
+		suite.NoError(err)
+		suite.Len(services, 1)
+		suite.Equal(imageService.ID, services[0].ID)
+		suite.NotNil(services[0].Edges.ServiceConfig)
+		suite.Equal("nginx:1.27", services[0].Edges.ServiceConfig.Image)
+	})
+}
+
 func (suite *ServiceQueriesSuite) TestGetDatabaseType() {
 	suite.Run("GetDatabaseType Success", func() {
 		// Create a database service
@@ -417,6 +512,81 @@ func (suite *ServiceQueriesSuite) TestGetDatabases() {
 	})
 }
 
+func (suite *ServiceQueriesSuite) TestGetPrPreviewServices() {
+	suite.Run("Skips services without previews", func() {
+		services, err := suite.serviceRepo.GetPrPreviewServices(suite.Ctx, suite.testGithubInstallation.ID, "test-repo")
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+
+	suite.DB.ServiceConfig.UpdateOneID(suite.testConfig.ID).
+		SetPrPreviews(true).
+		ExecX(suite.Ctx)
+
+	suite.Run("Returns services with previews enabled", func() {
+		services, err := suite.serviceRepo.GetPrPreviewServices(suite.Ctx, suite.testGithubInstallation.ID, "test-repo")
+		suite.NoError(err)
+		suite.Require().Len(services, 1)
+		suite.Equal(suite.testService.ID, services[0].ID)
+		suite.NotNil(services[0].Edges.ServiceConfig)
+		suite.NotNil(services[0].Edges.Environment.Edges.Project.Edges.Team)
+	})
+
+	suite.Run("Skips services of preview environments", func() {
+		suite.DB.Environment.UpdateOneID(suite.testEnvironment.ID).
+			SetPreviewBaseEnvironmentID(uuid.New()).
+			SetPreviewRepository("test-org/test-repo").
+			SetPreviewPullRequest(5).
+			ExecX(suite.Ctx)
+
+		services, err := suite.serviceRepo.GetPrPreviewServices(suite.Ctx, suite.testGithubInstallation.ID, "test-repo")
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+}
+
+func (suite *ServiceQueriesSuite) TestGetAutoRollbackCandidates() {
+	suite.DB.Deployment.UpdateOneID(suite.testDeployment.ID).
+		SetCompletedAt(time.Now().Add(-10 * time.Minute)).
+		ExecX(suite.Ctx)
+
+	suite.Run("Skips services without auto rollback", func() {
+		services, err := suite.serviceRepo.GetAutoRollbackCandidates(suite.Ctx, time.Now().Add(-30*time.Minute))
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+
+	suite.DB.ServiceConfig.UpdateOneID(suite.testConfig.ID).
+		SetAutoRollback(true).
+		ExecX(suite.Ctx)
+
+	suite.Run("Returns services with a recent deployment", func() {
+		services, err := suite.serviceRepo.GetAutoRollbackCandidates(suite.Ctx, time.Now().Add(-30*time.Minute))
+		suite.NoError(err)
+		suite.Require().Len(services, 1)
+		suite.Equal(suite.testService.ID, services[0].ID)
+		suite.NotNil(services[0].Edges.ServiceConfig)
+		suite.NotNil(services[0].Edges.CurrentDeployment)
+		suite.NotNil(services[0].Edges.Environment.Edges.Project.Edges.Team)
+	})
+
+	suite.Run("Skips deployments outside the window", func() {
+		services, err := suite.serviceRepo.GetAutoRollbackCandidates(suite.Ctx, time.Now().Add(-5*time.Minute))
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+
+	suite.Run("Skips deployments that are already rollbacks", func() {
+		suite.DB.Deployment.UpdateOneID(suite.testDeployment.ID).
+			SetRollbackReason("crashing").
+			ExecX(suite.Ctx)
+
+		services, err := suite.serviceRepo.GetAutoRollbackCandidates(suite.Ctx, time.Now().Add(-30*time.Minute))
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+}
+
 func (suite *ServiceQueriesSuite) TestGetByInstallationIDAndRepoName() {
 	suite.Run("GetByInstallationIDAndRepoName Success", func() {
 		services, err := suite.serviceRepo.GetByInstallationIDAndRepoName(suite.Ctx, suite.testGithubInstallation.ID, "test-repo")
@@ -441,6 +611,56 @@ func (suite *ServiceQueriesSuite) TestGetByInstallationIDAndRepoName() {
 	})
 }
 
+func (suite *ServiceQueriesSuite) TestGetByGitlabConnectionAndRepo() {
+	connection := suite.DB.GitlabConnection.Create().
+		SetCreatedBy(suite.testUser.ID).
+		SetName("Self-hosted").
+		SetURL("https://gitlab.example.com").
+		SetAuthType(gitlabconnection.AuthTypeToken).
+		SetAccountID(42).
+		SetAccountUsername("jdoe").
+		SetAccessToken("glpat-test").
+		SetWebhookSecret("webhook-secret").
+		SaveX(suite.Ctx)
+
+	gitlabService := suite.DB.Service.Create().
+		SetType(schema.ServiceTypeGitlab).
+		SetKubernetesName("gitlab-service").
+		SetName("GitLab Service").
+		SetEnvironmentID(suite.testEnvironment.ID).
+		SetKubernetesSecret("gitlab-service-secret").
+		SetGitlabConnectionID(connection.ID).
+		SetGitRepositoryOwner("platform/backend").
+		SetGitRepository("test-repo").
+		SaveX(suite.Ctx)
+	suite.DB.ServiceConfig.Create().
+		SetServiceID(gitlabService.ID).
+		SetBuilder(schema.ServiceBuilderRailpack).
+		SetIcon("gitlab").
+		SetGitBranch("main").
+		SaveX(suite.Ctx)
+
+	suite.Run("Matches connection, namespace and project", func() {
+		services, err := suite.serviceRepo.GetByGitlabConnectionAndRepo(suite.Ctx, connection.ID, "platform/backend", "test-repo")
+		suite.NoError(err)
+		suite.Require().Len(services, 1)
+		suite.Equal(gitlabService.ID, services[0].ID)
+		suite.NotNil(services[0].Edges.ServiceConfig)
+	})
+
+	suite.Run("Same project name in another namespace", func() {
+		services, err := suite.serviceRepo.GetByGitlabConnectionAndRepo(suite.Ctx, connection.ID, "platform/frontend", "test-repo")
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+
+	suite.Run("Other connection", func() {
+		services, err := suite.serviceRepo.GetByGitlabConnectionAndRepo(suite.Ctx, uuid.New(), "platform/backend", "test-repo")
+		suite.NoError(err)
+		suite.Len(services, 0)
+	})
+}
+
 func (suite *ServiceQueriesSuite) TestGetByEnvironmentID() {
 	suite.Run("GetByEnvironmentID Success", func() {
 		// Create another service in the same environment
@@ -4,6 +4,7 @@ package service_repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
@@ -24,6 +25,10 @@ type ServiceRepositoryInterface interface {
 	UpdateConfig(ctx context.Context, tx repository.TxInterface, input *MutateConfigInput) error
 	Delete(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID) error
 	SetCurrentDeployment(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, deploymentID uuid.UUID) error
+	// SetDeployHookToken replaces the service's deploy hook token, nil revokes the deploy hook
+	SetDeployHookToken(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, token *string) error
+	// SetImageDigest records the last seen digest of the service's image tag, nil forgets it
+	SetImageDigest(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, digest *string) error
 	UpdateVariableMounts(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, variableMounts []*schema.VariableMount) error
 	UpdateDatabaseStorageSize(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, newSize string) (*schema.DatabaseConfig, error)
 	GetByID(ctx context.Context, serviceID uuid.UUID) (svc *ent.Service, err error)
@@ -31,7 +36,19 @@ type ServiceRepositoryInterface interface {
 	GetByName(ctx context.Context, name string) (*ent.Service, error)
 	GetDatabaseType(ctx context.Context, serviceID uuid.UUID) (string, error)
 	GetDatabases(ctx context.Context) ([]*ent.Service, error)
+	// GetAutoRollbackCandidates gets services with auto rollback enabled whose current deployment completed after the given time
+	GetAutoRollbackCandidates(ctx context.Context, completedAfter time.Time) ([]*ent.Service, error)
 	GetByInstallationIDAndRepoName(ctx context.Context, installationID int64, repoName string) ([]*ent.Service, error)
+	// GetByGitlabConnectionAndRepo gets the services of a GitLab project, the owner is the project's full namespace path
+	GetByGitlabConnectionAndRepo(ctx context.Context, connectionID uuid.UUID, owner, repoName string) ([]*ent.Service, error)
+	// GetByDeployHookToken gets the service a deploy hook token belongs to
+	GetByDeployHookToken(ctx context.Context, token string) (*ent.Service, error)
+	// GetImageAutoUpdateServices gets docker image services that redeploy when their image tag is re-pushed
+	GetImageAutoUpdateServices(ctx context.Context) ([]*ent.Service, error)
+	// GetPrPreviewServices gets services of the repo with pull request previews enabled, excluding services of preview environments
+	GetPrPreviewServices(ctx context.Context, installationID int64, repoName string) ([]*ent.Service, error)
+	// GetRunningWithPackage gets services whose current deployment's image contains the package, with the matching packages on the deployment
+	GetRunningWithPackage(ctx context.Context, authPredicate predicate.Service, name, version string) ([]*ent.Service, error)
 	GetByEnvironmentID(ctx context.Context, environmentID uuid.UUID, authPredicate predicate.Service, withLatestDeployment bool) ([]*ent.Service, error)
 	GetGithubPrivateKey(ctx context.Context, serviceID uuid.UUID) (string, error)
 	CountDomainCollisons(ctx context.Context, tx repository.TxInterface, domain string, excludingServiceID *uuid.UUID) (int, error)
@@ -22,6 +22,7 @@ func (self *SystemRepository) GetSystemSettings(ctx context.Context, tx reposito
 type SystemSettingUpdateInput struct {
 	WildcardDomain   *string                  `json:"wildcard_domain" doc:"Wildcard domain for the system"`
 	BuildkitSettings *schema.BuildkitSettings `json:"buildkit_settings" doc:"Buildkit settings"`
+	BuildSettings    *schema.BuildSettings    `json:"build_settings" doc:"Build queue concurrency settings"`
 }
 
 func (self *SystemRepository) UpdateSystemSettings(ctx context.Context, input *SystemSettingUpdateInput) (settings *ent.SystemSetting, err error) {
@@ -56,6 +57,10 @@ func (self *SystemRepository) UpdateSystemSettings(ctx context.Context, input *S
 			m.SetBuildkitSettings(input.BuildkitSettings)
 		}
 
+		if input.BuildSettings != nil {
+			m.SetBuildSettings(input.BuildSettings)
+		}
+
 		// Save system settings
 		settings, err = m.Save(ctx)
 
@@ -129,6 +129,25 @@ func (suite *SettingsSuite) TestUpdateSystemSettings() {
 		suite.Equal(2, settings.BuildkitSettings.Replicas)
 	})
 
+	suite.Run("Update Build Settings", func() {
+		// Clean up any existing settings first
+		suite.DB.SystemSetting.Delete().ExecX(suite.Ctx)
+
+		input := &SystemSettingUpdateInput{
+			BuildSettings: &schema.BuildSettings{
+				MaxConcurrentBuilds:        4,
+				MaxConcurrentBuildsPerTeam: 1,
+			},
+		}
+
+		settings, err := suite.systemRepo.UpdateSystemSettings(suite.Ctx, input)
+		suite.NoError(err)
+		suite.NotNil(settings)
+		suite.NotNil(settings.BuildSettings)
+		suite.Equal(4, settings.BuildSettings.MaxConcurrentBuilds)
+		suite.Equal(1, settings.BuildSettings.MaxConcurrentBuildsPerTeam)
+	})
+
 	suite.Run("Domain Prefix Stripping", func() {
 		testCases := []struct {
 			input    string
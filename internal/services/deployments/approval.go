@@ -0,0 +1,95 @@
+package deployments_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// ApproveDeployment releases a deployment to a protected environment onto the build queue
+func (self *DeploymentService) ApproveDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentApprovalInput) (*models.DeploymentResponse, error) {
+	deployment, err := self.getDeploymentAwaitingApproval(ctx, requesterUserId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := self.deploymentController.ApproveDeployment(ctx, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(approved), nil
+}
+
+// RejectDeployment cancels a deployment to a protected environment without building it
+func (self *DeploymentService) RejectDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentApprovalInput) (*models.DeploymentResponse, error) {
+	deployment, err := self.getDeploymentAwaitingApproval(ctx, requesterUserId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := self.deploymentController.CancelDeployment(ctx, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(rejected), nil
+}
+
+func (self *DeploymentService) getDeploymentAwaitingApproval(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentApprovalInput) (*ent.Deployment, error) {
+	// Only environment admins can approve or reject
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionAdmin,
+			ResourceType: schema.ResourceTypeEnvironment,
+			ResourceID:   input.EnvironmentID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get deployment
+	deployment, err := self.repo.Deployment().GetByID(ctx, input.DeploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+		}
+		return nil, err
+	}
+
+	if deployment.ServiceID != service.ID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+	}
+
+	if deployment.Status != schema.DeploymentStatusAwaitingApproval {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Deployment is not awaiting approval")
+	}
+
+	return deployment, nil
+}
+
+// canSkipApproval reports whether the requester can deploy straight to the environment, without the build queue
+// Only environment admins can do that for protected environments
+func (self *DeploymentService) canSkipApproval(ctx context.Context, requesterUserId uuid.UUID, environment *ent.Environment) bool {
+	if !environment.Protected {
+		return true
+	}
+
+	return self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionAdmin,
+			ResourceType: schema.ResourceTypeEnvironment,
+			ResourceID:   environment.ID,
+		},
+	}) == nil
+}
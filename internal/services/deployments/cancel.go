@@ -0,0 +1,56 @@
+package deployments_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+func (self *DeploymentService) CancelDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.CancelDeploymentInput) (*models.DeploymentResponse, error) {
+	// Editor can cancel deployments
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   input.ServiceID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get deployment
+	deployment, err := self.repo.Deployment().GetByID(ctx, input.DeploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+		}
+		return nil, err
+	}
+
+	if deployment.ServiceID != service.ID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+	}
+
+	switch deployment.Status {
+	case schema.DeploymentStatusAwaitingApproval, schema.DeploymentStatusScheduled, schema.DeploymentStatusBuildPending, schema.DeploymentStatusBuildQueued, schema.DeploymentStatusBuildRunning:
+	default:
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Only awaiting approval, scheduled, pending, queued, or building deployments can be cancelled")
+	}
+
+	cancelled, err := self.deploymentController.CancelDeployment(ctx, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(cancelled), nil
+}
@@ -25,6 +25,23 @@ func (self *DeploymentService) CreateManualDeployment(ctx context.Context, reque
 		return nil, err
 	}
 
+	// Only environment admins can deploy through a freeze
+	if input.OverrideFreeze {
+		if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+			{
+				Action:       schema.ActionAdmin,
+				ResourceType: schema.ResourceTypeEnvironment,
+				ResourceID:   input.EnvironmentID,
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateScheduledAt(input.ScheduledAt); err != nil {
+		return nil, err
+	}
+
 	service, err := self.validateInputs(ctx, input)
 	if err != nil {
 		return nil, err
@@ -76,15 +93,20 @@ func (self *DeploymentService) CreateManualDeployment(ctx context.Context, reque
 	if input.GitSha != nil {
 		env["CHECKOUT_COMMIT_SHA"] = *input.GitSha
 	}
+	if service.Type == schema.ServiceTypeUpload && env["SOURCE_ARCHIVE_KEY"] == "" {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Upload a source archive to deploy this service")
+	}
 
 	job, err := self.deploymentController.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
-		ServiceID:     input.ServiceID,
-		Environment:   env,
-		Source:        schema.DeploymentSourceManual,
-		CommitSHA:     commitSHA,
-		GitBranch:     gitBranch,
-		CommitMessage: commitMessage,
-		Committer:     committer,
+		ServiceID:      input.ServiceID,
+		Environment:    env,
+		Source:         schema.DeploymentSourceManual,
+		CommitSHA:      commitSHA,
+		GitBranch:      gitBranch,
+		CommitMessage:  commitMessage,
+		Committer:      committer,
+		OverrideFreeze: input.OverrideFreeze,
+		ScheduledAt:    input.ScheduledAt,
 	})
 	if err != nil {
 		return nil, err
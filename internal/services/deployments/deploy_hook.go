@@ -0,0 +1,102 @@
+package deployments_service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	"github.com/unbindapp/unbind-api/internal/models"
+)
+
+// CreateDeployHookDeployment deploys the service a deploy hook token belongs to, the token is the only credential
+func (self *DeploymentService) CreateDeployHookDeployment(ctx context.Context, token string, input *models.DeployHookInput) (*models.DeploymentResponse, error) {
+	service, err := self.repo.Service().GetByDeployHookToken(ctx, token)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deploy hook not found")
+		}
+		return nil, err
+	}
+
+	if input == nil {
+		input = &models.DeployHookInput{}
+	}
+
+	isGitService := service.GithubInstallationID != nil || service.GitlabConnectionID != nil || service.Type == schema.ServiceTypeGit
+	if !isGitService && (input.Ref != nil || input.CommitSHA != nil) {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Only git services can deploy a ref or commit")
+	}
+
+	// Default to the service's branch, refs that aren't fully qualified are branches
+	var ref string
+	if input.Ref != nil && *input.Ref != "" {
+		ref = *input.Ref
+		if !strings.HasPrefix(ref, "refs/") {
+			ref = "refs/heads/" + ref
+		}
+	} else if service.Edges.ServiceConfig.GitBranch != nil {
+		ref = "refs/heads/" + *service.Edges.ServiceConfig.GitBranch
+	}
+
+	var gitTag *string
+	if strings.HasPrefix(ref, "refs/tags/") {
+		tag := strings.TrimPrefix(ref, "refs/tags/")
+		gitTag = &tag
+	}
+
+	env, err := self.deploymentController.PopulateBuildEnvironment(ctx, service.ID, gitTag, nil)
+	if err != nil {
+		return nil, err
+	}
+	if isGitService && ref != "" && gitTag == nil {
+		env["GIT_REF"] = ref
+	}
+
+	var commitSHA, commitMessage string
+	var committer *schema.GitCommitter
+	if input.CommitSHA != nil {
+		commitSHA = *input.CommitSHA
+		env["CHECKOUT_COMMIT_SHA"] = commitSHA
+	}
+
+	// Fill in the commit for the deployment history, the deploy shouldn't fail because GitHub can't tell us about it
+	if service.GithubInstallationID != nil && service.GitRepository != nil && ref != "" {
+		installation, err := self.repo.Github().GetInstallationByID(ctx, *service.GithubInstallationID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if installation != nil {
+			summaryTarget := strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+			isCommitHash := false
+			if commitSHA != "" {
+				summaryTarget = commitSHA
+				isCommitHash = true
+			}
+			sha, message, author, err := self.githubClient.GetCommitSummary(ctx, installation, installation.AccountLogin, *service.GitRepository, summaryTarget, isCommitHash)
+			if err != nil {
+				log.Warn("Error getting commit summary for deploy hook", "err", err, "service_id", service.ID)
+			} else {
+				commitSHA, commitMessage, committer = sha, message, author
+			}
+		}
+	}
+
+	job, err := self.deploymentController.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
+		ServiceID:     service.ID,
+		Environment:   env,
+		Source:        schema.DeploymentSourceDeployHook,
+		CommitSHA:     commitSHA,
+		CommitMessage: commitMessage,
+		GitBranch:     ref,
+		Committer:     committer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(job), nil
+}
@@ -2,6 +2,7 @@ package deployments_service
 
 import (
 	"context"
+	"time"
 
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
@@ -89,3 +90,11 @@ func (self *DeploymentService) validateInputs(ctx context.Context, input models.
 
 	return service, nil
 }
+
+// validateScheduledAt ensures a scheduled deployment is in the future
+func validateScheduledAt(scheduledAt *time.Time) error {
+	if scheduledAt != nil && !scheduledAt.After(time.Now()) {
+		return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "scheduled_at must be in the future")
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package deployments_service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+	v1 "github.com/unbindapp/unbind-operator/api/v1"
+)
+
+// GetDeploymentDiff compares what two deployments of a service were rolled out with, e.g. before rolling back
+func (self *DeploymentService) GetDeploymentDiff(ctx context.Context, requesterUserId uuid.UUID, input *models.GetDeploymentDiffInput) (*models.DeploymentDiffResponse, error) {
+	// Check permissions
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionViewer,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   input.ServiceID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := self.getServiceDeployment(ctx, service, input.FromDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := self.getServiceDeployment(ctx, service, input.ToDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffDeployments(from, to)
+
+	// Commit range, best effort since the repository may have been force pushed or the installation removed
+	if from.CommitSha != nil && to.CommitSha != nil && *from.CommitSha != *to.CommitSha &&
+		service.GithubInstallationID != nil && service.GitRepository != nil {
+		installation, err := self.repo.Github().GetInstallationByID(ctx, *service.GithubInstallationID)
+		if err != nil {
+			log.Warn("Error getting github installation for deployment diff", "err", err, "service_id", service.ID)
+			return diff, nil
+		}
+
+		diff.Commits, err = self.githubClient.CompareCommits(ctx,
+			installation,
+			installation.AccountLogin,
+			*service.GitRepository,
+			*from.CommitSha,
+			*to.CommitSha)
+		if err != nil {
+			log.Warn("Error comparing commits for deployment diff", "err", err, "service_id", service.ID)
+		}
+	}
+
+	return diff, nil
+}
+
+func (self *DeploymentService) getServiceDeployment(ctx context.Context, service *ent.Service, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	deployment, err := self.repo.Deployment().GetByID(ctx, deploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, deploymentID.String())
+		}
+		return nil, err
+	}
+
+	if deployment.ServiceID != service.ID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, deploymentID.String())
+	}
+
+	return deployment, nil
+}
+
+// diffDeployments lists everything that changed between two deployments, variable values are never included
+func diffDeployments(from, to *ent.Deployment) *models.DeploymentDiffResponse {
+	diff := &models.DeploymentDiffResponse{
+		From: models.TransformDeploymentEntity(from),
+		To:   models.TransformDeploymentEntity(to),
+	}
+
+	diff.Image = diffValue(from.Image, to.Image)
+	diff.Builder = diffValue(nonEmpty(string(from.Builder)), nonEmpty(string(to.Builder)))
+	diff.RunCommand = diffValue(from.RunCommand, to.RunCommand)
+
+	added, removed := diffSets(from.EnvKeys, to.EnvKeys, func(key string) string { return key })
+	if len(added) > 0 || len(removed) > 0 {
+		diff.EnvKeys = &models.DeploymentKeysChange{Added: added, Removed: removed}
+	}
+
+	fromConfig := deploymentConfig(from)
+	toConfig := deploymentConfig(to)
+
+	fromResources := resourcesFromV1(fromConfig.Resources)
+	toResources := resourcesFromV1(toConfig.Resources)
+	if !reflect.DeepEqual(fromResources, toResources) {
+		diff.Resources = &models.DeploymentResourcesChange{From: fromResources, To: toResources}
+	}
+
+	addedHosts, removedHosts := diffSets(hostsFromV1(fromConfig.Hosts), hostsFromV1(toConfig.Hosts), func(host schema.HostSpec) string {
+		return fmt.Sprintf("%s%s:%d", host.Host, host.Path, utils.FromPtr(host.TargetPort))
+	})
+	if len(addedHosts) > 0 || len(removedHosts) > 0 {
+		diff.Hosts = &models.DeploymentHostsChange{Added: addedHosts, Removed: removedHosts}
+	}
+
+	addedPorts, removedPorts := diffSets(portsFromV1(fromConfig.Ports), portsFromV1(toConfig.Ports), func(port schema.PortSpec) string {
+		return fmt.Sprintf("%d/%s:%d", port.Port, utils.FromPtr(port.Protocol), utils.FromPtr(port.NodePort))
+	})
+	if len(addedPorts) > 0 || len(removedPorts) > 0 {
+		diff.Ports = &models.DeploymentPortsChange{Added: addedPorts, Removed: removedPorts}
+	}
+
+	fromHealthCheck := healthCheckFromV1(fromConfig.HealthCheck)
+	toHealthCheck := healthCheckFromV1(toConfig.HealthCheck)
+	if !reflect.DeepEqual(fromHealthCheck, toHealthCheck) {
+		diff.HealthCheck = &models.DeploymentHealthCheckChange{From: fromHealthCheck, To: toHealthCheck}
+	}
+
+	return diff
+}
+
+// deploymentConfig is the config a deployment was rolled out with, empty if it never got that far
+func deploymentConfig(deployment *ent.Deployment) v1.ServiceConfigSpec {
+	if deployment.ResourceDefinition == nil {
+		return v1.ServiceConfigSpec{}
+	}
+	return deployment.ResourceDefinition.Spec.Config
+}
+
+func diffValue(from, to *string) *models.DeploymentValueChange {
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return &models.DeploymentValueChange{From: from, To: to}
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// diffSets returns the items only in to (added) and only in from (removed), identified by key
+func diffSets[T any](from, to []T, key func(T) string) (added, removed []T) {
+	fromKeys := make(map[string]bool, len(from))
+	for _, item := range from {
+		fromKeys[key(item)] = true
+	}
+	toKeys := make(map[string]bool, len(to))
+	for _, item := range to {
+		toKeys[key(item)] = true
+	}
+
+	added = []T{}
+	for _, item := range to {
+		if !fromKeys[key(item)] {
+			added = append(added, item)
+		}
+	}
+	removed = []T{}
+	for _, item := range from {
+		if !toKeys[key(item)] {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed
+}
+
+func resourcesFromV1(resources *v1.ResourceSpec) *schema.Resources {
+	if resources == nil {
+		return nil
+	}
+	return &schema.Resources{
+		CPURequestsMillicores:   resources.CPURequestsMillicores,
+		CPULimitsMillicores:     resources.CPULimitsMillicores,
+		MemoryRequestsMegabytes: resources.MemoryRequestsMegabytes,
+		MemoryLimitsMegabytes:   resources.MemoryLimitsMegabytes,
+	}
+}
+
+func hostsFromV1(hosts []v1.HostSpec) []schema.HostSpec {
+	result := make([]schema.HostSpec, len(hosts))
+	for i, host := range hosts {
+		result[i] = schema.HostSpec{
+			Host:       host.Host,
+			Path:       host.Path,
+			TargetPort: host.Port,
+		}
+	}
+	return result
+}
+
+func portsFromV1(ports []v1.PortSpec) []schema.PortSpec {
+	result := make([]schema.PortSpec, len(ports))
+	for i, port := range ports {
+		result[i] = schema.PortSpec{
+			IsNodePort: port.NodePort != nil,
+			NodePort:   port.NodePort,
+			Port:       port.Port,
+		}
+		if port.Protocol != nil {
+			result[i].Protocol = utils.ToPtr(schema.Protocol(*port.Protocol))
+		}
+	}
+	slices.SortFunc(result, func(a, b schema.PortSpec) int {
+		return int(a.Port - b.Port)
+	})
+	return result
+}
+
+func healthCheckFromV1(healthCheck *v1.HealthCheckSpec) *schema.HealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+	return &schema.HealthCheck{
+		Type:                    utils.ToPtr(schema.HealthCheckType(healthCheck.Type)),
+		Path:                    healthCheck.Path,
+		Port:                    healthCheck.Port,
+		Command:                 healthCheck.Command,
+		StartupPeriodSeconds:    healthCheck.StartupPeriodSeconds,
+		StartupTimeoutSeconds:   healthCheck.StartupTimeoutSeconds,
+		StartupFailureThreshold: healthCheck.StartupFailureThreshold,
+		HealthPeriodSeconds:     healthCheck.HealthPeriodSeconds,
+		HealthTimeoutSeconds:    healthCheck.HealthTimeoutSeconds,
+		HealthFailureThreshold:  healthCheck.HealthFailureThreshold,
+	}
+}
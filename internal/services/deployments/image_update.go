@@ -0,0 +1,108 @@
+package deployments_service
+
+import (
+	"context"
+
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/registry"
+)
+
+// RedeployUpdatedImages checks the image tags of services with image auto-update enabled and deploys the ones that were re-pushed
+func (self *DeploymentService) RedeployUpdatedImages(ctx context.Context) error {
+	services, err := self.repo.Service().GetImageAutoUpdateServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if _, err := self.redeployIfImageUpdated(ctx, service); err != nil {
+			log.Error("Failed to check image for updates", "err", err, "service_id", service.ID)
+		}
+	}
+
+	return nil
+}
+
+// HandleRegistryPush checks services whose image was pushed according to a registry webhook, returning how many were redeployed
+// The digest in the payload isn't trusted, we ask the registry, so the webhook can't deploy anything that wasn't pushed
+func (self *DeploymentService) HandleRegistryPush(ctx context.Context, events []registry.PushEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	services, err := self.repo.Service().GetImageAutoUpdateServices(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	redeployed := 0
+	for _, service := range services {
+		matched := false
+		for _, event := range events {
+			if event.Matches(service.Edges.ServiceConfig.Image) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		deployed, err := self.redeployIfImageUpdated(ctx, service)
+		if err != nil {
+			log.Error("Failed to check image for updates", "err", err, "service_id", service.ID)
+			continue
+		}
+		if deployed {
+			redeployed++
+		}
+	}
+
+	return redeployed, nil
+}
+
+// redeployIfImageUpdated compares the tag's digest to the last one seen and deploys the service if it changed
+// The first check only records the digest, what's running is assumed to be current
+func (self *DeploymentService) redeployIfImageUpdated(ctx context.Context, service *ent.Service) (bool, error) {
+	config := service.Edges.ServiceConfig
+	if config == nil || config.Image == "" {
+		return false, nil
+	}
+
+	digest, err := self.registryTester.GetImageDigest(ctx, config.Image)
+	if err != nil {
+		return false, err
+	}
+
+	if config.ImageDigest != nil && *config.ImageDigest == digest {
+		return false, nil
+	}
+
+	if config.ImageDigest != nil {
+		env, err := self.deploymentController.PopulateBuildEnvironment(ctx, service.ID, nil, nil)
+		if err != nil {
+			return false, err
+		}
+		// Pin the digest, nodes that already have the tag wouldn't pull it again
+		env["SERVICE_IMAGE"] = config.Image + "@" + digest
+
+		if _, err := self.deploymentController.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
+			ServiceID:   service.ID,
+			Environment: env,
+			Source:      schema.DeploymentSourceImageUpdate,
+		}); err != nil {
+			return false, err
+		}
+		log.Info("Image tag was re-pushed, redeploying", "service_id", service.ID, "image", config.Image, "digest", digest)
+	}
+
+	// Only recorded once the deployment is queued, so a failure is retried on the next check
+	if err := self.repo.Service().SetImageDigest(ctx, nil, service.ID, &digest); err != nil {
+		return false, err
+	}
+
+	return config.ImageDigest != nil, nil
+}
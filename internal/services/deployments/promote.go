@@ -0,0 +1,156 @@
+package deployments_service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// PromoteDeployment deploys the exact image of a deployment to the same-named service in another environment, without rebuilding
+// The target keeps its own variables and configuration
+func (self *DeploymentService) PromoteDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.PromoteDeploymentInput) (*models.DeploymentResponse, error) {
+	// Viewer can read the source deployment
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionViewer,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   input.ServiceID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if service.Type == schema.ServiceTypeDatabase {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Database deployments can't be promoted")
+	}
+
+	// Get source deployment
+	deployment, err := self.repo.Deployment().GetByID(ctx, input.DeploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+		}
+		return nil, err
+	}
+
+	if deployment.ServiceID != service.ID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+	}
+
+	if deployment.Status != schema.DeploymentStatusBuildSucceeded || deployment.Image == nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Only successful deployments with a built image can be promoted")
+	}
+
+	// Validate target environment
+	if input.TargetEnvironmentID == input.EnvironmentID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Target environment must differ from the source environment")
+	}
+
+	targetEnvironment, err := self.repo.Environment().GetByID(ctx, input.TargetEnvironmentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Target environment not found")
+		}
+		return nil, err
+	}
+
+	if targetEnvironment.ProjectID != input.ProjectID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Target environment does not belong to project")
+	}
+
+	// Find the service with the same name in the target environment
+	targetServices, err := self.repo.Service().GetByEnvironmentID(ctx, targetEnvironment.ID, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetServiceID *uuid.UUID
+	for _, targetService := range targetServices {
+		if targetService.Name == service.Name {
+			targetServiceID = &targetService.ID
+			break
+		}
+	}
+
+	if targetServiceID == nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, fmt.Sprintf("No service named %s in target environment", service.Name))
+	}
+
+	// Editor can deploy to the target service
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   *targetServiceID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	targetService, err := self.repo.Service().GetByID(ctx, *targetServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetService.Type != service.Type {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Target service type does not match the source service")
+	}
+
+	// Pin the tag to what it points to now, it could be re-pushed before or after the target pulls it
+	image := *deployment.Image
+	if !strings.Contains(image, "@") {
+		digest, err := self.registryTester.GetImageDigest(ctx, image)
+		if err != nil {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Unable to resolve the digest of %s: %v", image, err))
+		}
+		image = image + "@" + digest
+	}
+
+	// The builder skips the build for an image, but still runs the target's pre-deploy command before rolling out
+	env, err := self.deploymentController.PopulateBuildEnvironment(ctx, targetService.ID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	env["SERVICE_IMAGE"] = image
+
+	var commitSHA, commitMessage, gitBranch string
+	if deployment.CommitSha != nil {
+		commitSHA = *deployment.CommitSha
+	}
+	if deployment.CommitMessage != nil {
+		commitMessage = *deployment.CommitMessage
+	}
+	if deployment.GitBranch != nil {
+		gitBranch = *deployment.GitBranch
+	}
+
+	job, err := self.deploymentController.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
+		ServiceID:     targetService.ID,
+		Environment:   env,
+		Source:        schema.DeploymentSourceManual,
+		CommitSHA:     commitSHA,
+		CommitMessage: commitMessage,
+		GitBranch:     gitBranch,
+		Committer:     deployment.CommitAuthor,
+		// Environment admins don't need to wait for approval to promote into a protected environment
+		Approved: self.canSkipApproval(ctx, requesterUserId, targetEnvironment),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(job), nil
+}
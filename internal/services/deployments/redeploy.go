@@ -10,9 +10,11 @@ import (
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/log"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
 	"github.com/unbindapp/unbind-api/internal/deployctl"
 	"github.com/unbindapp/unbind-api/internal/models"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
 	ubv1 "github.com/unbindapp/unbind-operator/api/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -37,7 +39,43 @@ func (self *DeploymentService) resolveReferences(ctx context.Context, service *e
 	return envVars, nil
 }
 
-func (self *DeploymentService) redeployExistingImage(ctx context.Context, service *ent.Service, deployment *ent.Deployment) (*models.DeploymentResponse, error) {
+// recordEnvKeys stores the names of the variables a deployment is rolled out with, best effort
+func (self *DeploymentService) recordEnvKeys(ctx context.Context, service *ent.Service, deploymentID uuid.UUID, envVars []corev1.EnvVar) {
+	keys := make([]string, 0, len(envVars))
+	for _, envVar := range envVars {
+		keys = append(keys, envVar.Name)
+	}
+
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+	secrets, err := self.k8s.GetSecretMap(ctx, service.KubernetesSecret, namespace, self.k8s.GetInternalClient())
+	if err != nil {
+		log.Warn("Failed to get service secret for deployment variable names", "err", err, "service_id", service.ID)
+	}
+	for k := range secrets {
+		keys = append(keys, k)
+	}
+
+	if _, err := self.repo.Deployment().SetEnvKeys(ctx, nil, deploymentID, keys); err != nil {
+		log.Warn("Failed to record deployment variable names", "err", err, "deployment_id", deploymentID)
+	}
+}
+
+// checkFreeze refuses deployments that go straight to kubernetes while the environment is frozen
+func checkFreeze(environment *ent.Environment) error {
+	if frozenUntil := schema.FrozenUntil(environment.FreezeWindows, time.Now()); frozenUntil != nil {
+		return errdefs.NewCustomError(errdefs.ErrTypeConflict, fmt.Sprintf("Deployments to %s are frozen until %s", environment.Name, frozenUntil.Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// redeployExistingImage rolls out a copy of the deployment without rebuilding, overrideFreeze deploys during a freeze window
+func (self *DeploymentService) redeployExistingImage(ctx context.Context, service *ent.Service, deployment *ent.Deployment, overrideFreeze bool) (*models.DeploymentResponse, error) {
+	if !overrideFreeze {
+		if err := checkFreeze(service.Edges.Environment); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update env
 	envVars, err := self.resolveReferences(ctx, service)
 	if err != nil {
@@ -50,6 +88,21 @@ func (self *DeploymentService) redeployExistingImage(ctx context.Context, servic
 		return nil, err
 	}
 
+	// For docker image services, update the image reference
+	var image *string
+	if service.Type == schema.ServiceTypeDockerimage {
+		if deployment.Image == nil {
+			image = utils.ToPtr(service.Edges.ServiceConfig.Image)
+		} else {
+			image = utils.ToPtr(*deployment.Image)
+		}
+	}
+
+	return self.rolloutDeployment(ctx, service, newDeployment, envVars, image)
+}
+
+// rolloutDeployment deploys a copied deployment with the service's current configuration, optionally overriding the image
+func (self *DeploymentService) rolloutDeployment(ctx context.Context, service *ent.Service, newDeployment *ent.Deployment, envVars []corev1.EnvVar, image *string) (*models.DeploymentResponse, error) {
 	// Create a CRD from the service configuration
 	newDeployment.ResourceDefinition = self.CreateCRDFromService(service)
 
@@ -58,15 +111,9 @@ func (self *DeploymentService) redeployExistingImage(ctx context.Context, servic
 	newDeployment.ResourceDefinition.Spec.EnvVars = envVars
 	newDeployment.ResourceDefinition.Spec.Config.Volumes = schema.AsV1Volumes(service.Edges.ServiceConfig.Volumes)
 
-	// For docker image services, update the image reference
-	if service.Type == schema.ServiceTypeDockerimage {
-		if deployment.Image == nil {
-			newDeployment.ResourceDefinition.Spec.Config.Image = service.Edges.ServiceConfig.Image
-			newDeployment.Image = utils.ToPtr(service.Edges.ServiceConfig.Image)
-		} else {
-			newDeployment.ResourceDefinition.Spec.Config.Image = *deployment.Image
-			newDeployment.Image = utils.ToPtr(*deployment.Image)
-		}
+	if image != nil {
+		newDeployment.ResourceDefinition.Spec.Config.Image = *image
+		newDeployment.Image = image
 	}
 
 	// For database services, always use latest config
@@ -74,8 +121,18 @@ func (self *DeploymentService) redeployExistingImage(ctx context.Context, servic
 		newDeployment.ResourceDefinition.Spec.Config.Database.Config = service.Edges.ServiceConfig.DatabaseConfig.AsV1DatabaseConfig()
 	}
 
+	// A staged blue-green or canary deployment is superseded by this one
+	if err := self.deploymentController.AbortStagedRollouts(ctx, service.ID, newDeployment.ID); err != nil {
+		log.Warn("Failed to abort staged rollouts", "err", err, "service_id", service.ID)
+	}
+
+	// Blue-green and canary deployments run next to the current one until they're promoted, same as builds
+	if strategy := service.Edges.ServiceConfig.RolloutStrategy; strategy == schema.RolloutStrategyBlueGreen || strategy == schema.RolloutStrategyCanary {
+		return self.stageDeployment(ctx, service, newDeployment, envVars)
+	}
+
 	// Deploy to kubernetes
-	_, _, err = self.k8s.DeployUnbindService(ctx, newDeployment.ResourceDefinition)
+	_, _, err := self.k8s.DeployUnbindService(ctx, newDeployment.ResourceDefinition)
 	if err != nil {
 		// Mark failed
 		if _, err := self.repo.Deployment().MarkFailed(ctx, nil, newDeployment.ID, err.Error(), time.Now()); err != nil {
@@ -100,6 +157,8 @@ func (self *DeploymentService) redeployExistingImage(ctx context.Context, servic
 		return nil, err
 	}
 
+	self.recordEnvKeys(ctx, service, newDeployment.ID, envVars)
+
 	// Mark as succeeded
 	newDeployment, err = self.repo.Deployment().MarkSucceeded(ctx, nil, newDeployment.ID, time.Now())
 	if err != nil {
@@ -114,6 +173,46 @@ func (self *DeploymentService) redeployExistingImage(ctx context.Context, servic
 	return models.TransformDeploymentEntity(newDeployment), nil
 }
 
+// stageDeployment runs the deployment as a blue-green or canary candidate, ReconcileRollouts or the user promotes it
+func (self *DeploymentService) stageDeployment(ctx context.Context, service *ent.Service, newDeployment *ent.Deployment, envVars []corev1.EnvVar) (*models.DeploymentResponse, error) {
+	weight := 0
+	if service.Edges.ServiceConfig.RolloutStrategy == schema.RolloutStrategyCanary {
+		weight = service.Edges.ServiceConfig.CanaryWeight
+	}
+
+	if err := self.k8s.DeployRolloutCandidate(ctx, newDeployment.ResourceDefinition, weight); err != nil {
+		if _, err := self.repo.Deployment().MarkFailed(ctx, nil, newDeployment.ID, err.Error(), time.Now()); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	var staged *ent.Deployment
+	if err := self.repo.WithTx(ctx, func(tx repository.TxInterface) error {
+		if _, err := self.repo.Deployment().AttachDeploymentMetadata(
+			ctx,
+			tx,
+			newDeployment.ID,
+			newDeployment.ResourceDefinition.Spec.Config.Image,
+			newDeployment.ResourceDefinition,
+		); err != nil {
+			return err
+		}
+		var err error
+		staged, err = self.repo.Deployment().MarkStaged(ctx, tx, newDeployment.ID, time.Now())
+		return err
+	}); err != nil {
+		if _, err := self.repo.Deployment().MarkFailed(ctx, nil, newDeployment.ID, err.Error(), time.Now()); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	self.recordEnvKeys(ctx, service, newDeployment.ID, envVars)
+
+	return models.TransformDeploymentEntity(staged), nil
+}
+
 func (self *DeploymentService) CreateRedeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.RedeployExistingDeploymentInput) (*models.DeploymentResponse, error) {
 	// Editor can create deployments
 	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
@@ -126,6 +225,10 @@ func (self *DeploymentService) CreateRedeployment(ctx context.Context, requester
 		return nil, err
 	}
 
+	if err := validateScheduledAt(input.ScheduledAt); err != nil {
+		return nil, err
+	}
+
 	service, err := self.validateInputs(ctx, input)
 	if err != nil {
 		return nil, err
@@ -140,8 +243,9 @@ func (self *DeploymentService) CreateRedeployment(ctx context.Context, requester
 		return nil, err
 	}
 
-	// Check if we can redeploy without rebuilding
-	if input.SmartRedeploy && deployment.ResourceDefinition != nil {
+	// Check if we can redeploy without rebuilding, scheduled redeploys go through the build queue
+	// So do redeploys to protected environments, unless an admin asks, so they're held for approval
+	if input.SmartRedeploy && input.ScheduledAt == nil && deployment.ResourceDefinition != nil && self.canSkipApproval(ctx, requesterUserId, service.Edges.Environment) {
 		canRedeploy := false
 
 		// For non-database services, check if we can pull the existing image
@@ -156,7 +260,7 @@ func (self *DeploymentService) CreateRedeployment(ctx context.Context, requester
 		}
 
 		if canRedeploy {
-			return self.redeployExistingImage(ctx, service, deployment)
+			return self.redeployExistingImage(ctx, service, deployment, false)
 		}
 	}
 
@@ -194,6 +298,7 @@ func (self *DeploymentService) CreateRedeployment(ctx context.Context, requester
 		GitBranch:         gitBranch,
 		Committer:         deployment.CommitAuthor,
 		DisableBuildCache: input.DisableBuildCache,
+		ScheduledAt:       input.ScheduledAt,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,115 @@
+package deployments_service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+)
+
+const (
+	// How long a new deployment may stay unhealthy before it is rolled back
+	AUTO_ROLLBACK_GRACE_PERIOD = 5 * time.Minute
+	// Deployments completed longer ago than this are considered settled and never rolled back
+	AUTO_ROLLBACK_WINDOW = 30 * time.Minute
+)
+
+// AutoRollbackUnhealthyDeployments redeploys the previous image for services with auto rollback enabled
+// whose new deployment is still crashing or failing to launch after the grace period
+func (self *DeploymentService) AutoRollbackUnhealthyDeployments(ctx context.Context) error {
+	now := time.Now()
+	candidates, err := self.repo.Service().GetAutoRollbackCandidates(ctx, now.Add(-AUTO_ROLLBACK_WINDOW))
+	if err != nil {
+		return err
+	}
+
+	// Instance data is fetched per environment
+	byEnvironment := make(map[uuid.UUID][]*ent.Service)
+	for _, service := range candidates {
+		if service.Edges.CurrentDeployment.CompletedAt == nil || now.Sub(*service.Edges.CurrentDeployment.CompletedAt) < AUTO_ROLLBACK_GRACE_PERIOD {
+			continue
+		}
+		byEnvironment[service.EnvironmentID] = append(byEnvironment[service.EnvironmentID], service)
+	}
+
+	for _, services := range byEnvironment {
+		namespace := services[0].Edges.Environment.Edges.Project.Edges.Team.Namespace
+		instanceData, err := self.AttachInstanceDataToServices(ctx, services, namespace)
+		if err != nil {
+			log.Error("Failed to get instance data for auto rollback", "err", err, "environment_id", services[0].EnvironmentID)
+			continue
+		}
+
+		for _, service := range services {
+			data := instanceData[service.ID]
+			if data == nil || (data.Status != schema.DeploymentStatusCrashing && data.Status != schema.DeploymentStatusLaunchError) {
+				continue
+			}
+
+			// Rolling back skips approval, so protected environments are left to their admins
+			if service.Edges.Environment.Protected {
+				log.Warn("Not rolling back unhealthy deployment in protected environment", "service_id", service.ID, "deployment_id", service.Edges.CurrentDeployment.ID)
+				continue
+			}
+
+			if err := self.rollbackService(ctx, service, data); err != nil {
+				log.Error("Failed to roll back deployment", "err", err, "service_id", service.ID, "deployment_id", service.Edges.CurrentDeployment.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollbackService redeploys the last successful deployment before the service's current one
+func (self *DeploymentService) rollbackService(ctx context.Context, service *ent.Service, data *ServiceInstanceData) error {
+	unhealthy := service.Edges.CurrentDeployment
+
+	// A rollback staged for a blue-green or canary rollout is promoted by ReconcileRollouts once it's healthy
+	staged, err := self.repo.Deployment().GetStagedByServiceID(ctx, service.ID)
+	if err != nil {
+		return err
+	}
+	for _, deployment := range staged {
+		if deployment.RollbackReason != nil {
+			return nil
+		}
+	}
+
+	previous, err := self.repo.Deployment().GetPreviousSuccessfulDeployment(ctx, service.ID, unhealthy)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("No previous deployment to roll back to", "service_id", service.ID, "deployment_id", unhealthy.ID)
+			return nil
+		}
+		return err
+	}
+
+	// The CRD is built from the current deployment, base it on the one we're restoring instead
+	service.Edges.CurrentDeployment = previous
+
+	// Restoring a working deployment is what a freeze is meant to protect, so it isn't held by one
+	rollback, err := self.redeployExistingImage(ctx, service, previous, true)
+	if err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("Deployment %s entered %s", unhealthy.ID, data.Status)
+	if len(data.CrashingReasons) > 0 {
+		reason = fmt.Sprintf("%s: %s", reason, strings.Join(data.CrashingReasons, ", "))
+	}
+	if _, err := self.repo.Deployment().SetRollbackReason(ctx, nil, rollback.ID, reason); err != nil {
+		return err
+	}
+
+	log.Info("Rolled back unhealthy deployment", "service_id", service.ID, "from", unhealthy.ID, "to", previous.ID)
+
+	go self.deploymentController.TriggerRolledBackWebhook(context.Background(), service, rollback.ID, reason)
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package deployments_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
+	"github.com/unbindapp/unbind-api/internal/models"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// PromoteRollout moves a staged blue-green or canary deployment over to the service
+func (self *DeploymentService) PromoteRollout(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentRolloutInput) (*models.DeploymentResponse, error) {
+	service, deployment, err := self.getStagedDeployment(ctx, requesterUserId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, err := self.promoteStaged(ctx, service, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(promoted), nil
+}
+
+// AbortRollout tears down a staged blue-green or canary deployment, the current deployment keeps serving all traffic
+func (self *DeploymentService) AbortRollout(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentRolloutInput) (*models.DeploymentResponse, error) {
+	service, deployment, err := self.getStagedDeployment(ctx, requesterUserId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+	if err := self.k8s.DeleteRolloutCandidate(ctx, namespace, deployment.ResourceDefinition.Name); err != nil {
+		return nil, err
+	}
+
+	aborted, err := self.repo.Deployment().MarkAborted(ctx, nil, deployment.ID, "Rollout aborted")
+	if err != nil {
+		return nil, err
+	}
+
+	return models.TransformDeploymentEntity(aborted), nil
+}
+
+func (self *DeploymentService) getStagedDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.DeploymentRolloutInput) (*ent.Service, *ent.Deployment, error) {
+	// Editor can promote or abort
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   input.ServiceID,
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment, err := self.repo.Deployment().GetByID(ctx, input.DeploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+		}
+		return nil, nil, err
+	}
+
+	if deployment.ServiceID != service.ID {
+		return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Deployment not found")
+	}
+
+	if deployment.Status != schema.DeploymentStatusStaged || deployment.ResourceDefinition == nil {
+		return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Deployment is not staged for a blue-green or canary rollout")
+	}
+
+	return service, deployment, nil
+}
+
+// promoteStaged sends all traffic to the candidate, then updates the service to the candidate's image
+// The candidate is removed by ReconcileRollouts once the service's own instances are healthy
+func (self *DeploymentService) promoteStaged(ctx context.Context, service *ent.Service, deployment *ent.Deployment) (*ent.Deployment, error) {
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+
+	envVars, err := self.resolveReferences(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	crd := deployment.ResourceDefinition.DeepCopy()
+	crd.Spec.EnvVars = envVars
+
+	if err := self.k8s.SetCanaryTraffic(ctx, namespace, crd.Name, 100); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := self.k8s.DeployUnbindService(ctx, crd); err != nil {
+		return nil, err
+	}
+
+	var promoted *ent.Deployment
+	if err := self.repo.WithTx(ctx, func(tx repository.TxInterface) error {
+		promoted, err = self.repo.Deployment().MarkPromoting(ctx, tx, deployment.ID)
+		if err != nil {
+			return err
+		}
+		return self.repo.Service().SetCurrentDeployment(ctx, tx, service.ID, deployment.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info("Promoted staged deployment", "service_id", service.ID, "deployment_id", deployment.ID)
+	return promoted, nil
+}
+
+// ReconcileRollouts promotes blue-green deployments once they're healthy, and removes the candidate of
+// promoted deployments once the service is running the new version
+func (self *DeploymentService) ReconcileRollouts(ctx context.Context) error {
+	rollouts, err := self.repo.Deployment().GetInProgressRollouts(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A newer staged deployment owns the candidate resources
+	staged := make(map[uuid.UUID]bool)
+	for _, deployment := range rollouts {
+		if deployment.Status == schema.DeploymentStatusStaged {
+			staged[deployment.ServiceID] = true
+		}
+	}
+
+	for _, deployment := range rollouts {
+		service := deployment.Edges.Service
+		if service == nil || deployment.ResourceDefinition == nil {
+			continue
+		}
+
+		var err error
+		switch deployment.Status {
+		case schema.DeploymentStatusStaged:
+			err = self.promoteIfHealthy(ctx, service, deployment)
+		case schema.DeploymentStatusPromoting:
+			err = self.finishPromotion(ctx, service, deployment, staged[service.ID])
+		}
+		if err != nil {
+			log.Error("Failed to reconcile rollout", "err", err, "service_id", service.ID, "deployment_id", deployment.ID)
+		}
+	}
+
+	return nil
+}
+
+// promoteIfHealthy promotes a blue-green candidate once all of its instances are healthy, canaries wait to be promoted manually
+// Rollbacks don't wait, the deployment they replace is failing
+func (self *DeploymentService) promoteIfHealthy(ctx context.Context, service *ent.Service, deployment *ent.Deployment) error {
+	if service.Edges.ServiceConfig.RolloutStrategy != schema.RolloutStrategyBlueGreen && deployment.RollbackReason == nil {
+		return nil
+	}
+
+	healthy, err := self.isRolloutHealthy(ctx, service, map[string]string{
+		"unbind-deployment":          deployment.ID.String(),
+		"app.kubernetes.io/instance": k8s.RolloutCandidateName(deployment.ResourceDefinition.Name),
+	})
+	if err != nil || !healthy {
+		return err
+	}
+
+	_, err = self.promoteStaged(ctx, service, deployment)
+	return err
+}
+
+// finishPromotion removes the candidate once the service's own instances run the promoted deployment
+func (self *DeploymentService) finishPromotion(ctx context.Context, service *ent.Service, deployment *ent.Deployment, candidateReplaced bool) error {
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+	superseded := service.CurrentDeploymentID == nil || *service.CurrentDeploymentID != deployment.ID
+
+	if !superseded {
+		healthy, err := self.isRolloutHealthy(ctx, service, map[string]string{
+			"unbind-deployment": deployment.ID.String(),
+			"unbind-service":    service.ID.String(),
+		})
+		if err != nil || !healthy {
+			return err
+		}
+	}
+
+	if !candidateReplaced {
+		if err := self.k8s.DeleteRolloutCandidate(ctx, namespace, deployment.ResourceDefinition.Name); err != nil {
+			return fmt.Errorf("failed to delete rollout candidate: %w", err)
+		}
+	}
+
+	_, err := self.repo.Deployment().MarkSucceeded(ctx, nil, deployment.ID, time.Now())
+	return err
+}
+
+func (self *DeploymentService) isRolloutHealthy(ctx context.Context, service *ent.Service, labels map[string]string) (bool, error) {
+	namespace := service.Edges.Environment.Edges.Project.Edges.Team.Namespace
+
+	health, err := self.k8s.GetSimpleHealthStatus(ctx, namespace, labels, utils.ToPtr(int(service.Edges.ServiceConfig.Replicas)), self.k8s.GetInternalClient())
+	if err != nil {
+		return false, err
+	}
+
+	return health.Health == k8s.InstanceHealthActive, nil
+}
@@ -0,0 +1,72 @@
+package deployments_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// GetDeploymentSBOM lists the packages in one deployment's image, or searches the current deployments of every service the user can view for a package
+func (self *DeploymentService) GetDeploymentSBOM(ctx context.Context, requesterUserId uuid.UUID, input *models.GetDeploymentSBOMInput) ([]*models.DeploymentSBOMResponse, error) {
+	if input.DeploymentID == uuid.Nil {
+		return self.searchSBOMPackages(ctx, requesterUserId, input)
+	}
+
+	deployment, err := self.repo.Deployment().GetByID(ctx, input.DeploymentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, input.DeploymentID.String())
+		}
+		return nil, err
+	}
+
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionViewer,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   deployment.ServiceID,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := self.repo.Service().GetByID(ctx, deployment.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := self.repo.Deployment().GetSBOMPackages(ctx, deployment.ID, input.Package, input.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*models.DeploymentSBOMResponse{models.TransformDeploymentSBOM(service, deployment, packages)}, nil
+}
+
+// searchSBOMPackages finds the running services containing a package, across all teams
+func (self *DeploymentService) searchSBOMPackages(ctx context.Context, requesterUserId uuid.UUID, input *models.GetDeploymentSBOMInput) ([]*models.DeploymentSBOMResponse, error) {
+	if input.Package == "" {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "package is required unless a deployment is given")
+	}
+
+	servicePreds, err := self.repo.Permissions().GetAccessibleServicePredicates(ctx, requesterUserId, schema.ActionViewer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := self.repo.Service().GetRunningWithPackage(ctx, servicePreds, input.Package, input.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*models.DeploymentSBOMResponse, len(services))
+	for i, service := range services {
+		resp[i] = models.TransformDeploymentSBOM(service, service.Edges.CurrentDeployment, service.Edges.CurrentDeployment.Edges.SbomPackages)
+	}
+	return resp, nil
+}
@@ -0,0 +1,164 @@
+package deployments_service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/s3"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+	service_repo "github.com/unbindapp/unbind-api/internal/repositories/service"
+	"github.com/unbindapp/unbind-api/internal/sourceanalyzer"
+	"github.com/unbindapp/unbind-api/internal/sourceanalyzer/enum"
+)
+
+// CreateUploadDeployment stores an uploaded tar.gz of the service's source and deploys it
+// Also returns the builder suggested for the source, when the service uses railpack and it's another one
+func (self *DeploymentService) CreateUploadDeployment(ctx context.Context, requesterUserId uuid.UUID, input *models.UploadDeploymentInput, archive []byte) (*models.DeploymentResponse, *schema.ServiceBuilder, error) {
+	// Editor can create deployments
+	if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   input.ServiceID,
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	// Only environment admins can deploy through a freeze
+	if input.OverrideFreeze {
+		if err := self.repo.Permissions().Check(ctx, requesterUserId, []permissions_repo.PermissionCheck{
+			{
+				Action:       schema.ActionAdmin,
+				ResourceType: schema.ResourceTypeEnvironment,
+				ResourceID:   input.EnvironmentID,
+			},
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	service, err := self.validateInputs(ctx, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if service.Type != schema.ServiceTypeUpload {
+		return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Only upload services can deploy uploaded source archives")
+	}
+	config := service.Edges.ServiceConfig
+	if config.UploadS3SourceID == nil || config.UploadS3Bucket == nil {
+		return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Service has no storage for uploads configured")
+	}
+
+	// Analyze the source like we do when a git service is created, uploads are the only time we see it
+	// Only the files the analyzer reads are extracted, the builder extracts the whole archive
+	tmpDir, err := os.MkdirTemp("", "unbind-upload-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := utils.ExtractTarGzFiles(bytes.NewReader(archive), tmpDir, sourceanalyzer.MaxAnalyzedSourceSize, sourceanalyzer.IsAnalyzedFile); err != nil {
+		if !errors.Is(err, utils.ErrArchiveTooLarge) {
+			return nil, nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Invalid source archive: %v", err))
+		}
+		// Plenty to tell what the source is
+		log.Warn("Analyzing part of a large source archive", "service_id", service.ID)
+	}
+
+	analysisResult, err := sourceanalyzer.AnalyzeSourceCode(tmpDir)
+	if err != nil {
+		log.Error("Error analyzing source code", "err", err)
+		return nil, nil, err
+	}
+
+	if err := self.applyUploadAnalysis(ctx, service.ID, config, analysisResult); err != nil {
+		return nil, nil, err
+	}
+
+	// The builder was chosen when the service was created, suggest buildpacks rather than switching to it
+	var suggestedBuilder *schema.ServiceBuilder
+	if config.Builder == schema.ServiceBuilderRailpack && analysisResult.SuggestedBuilder != config.Builder {
+		suggestedBuilder = utils.ToPtr(analysisResult.SuggestedBuilder)
+	}
+
+	// Store the archive, builds download it from the bucket
+	s3Source, err := self.repo.S3().GetByID(ctx, *config.UploadS3SourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	namespace, err := self.repo.Service().GetDeploymentNamespace(ctx, service.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	credentials, err := self.k8s.GetSecretMap(ctx, s3Source.KubernetesSecret, namespace, self.k8s.GetInternalClient())
+	if err != nil {
+		log.Error("Error getting S3 credentials", "err", err)
+		return nil, nil, err
+	}
+	s3Client, err := s3.NewS3Client(ctx, s3Source.Endpoint, s3Source.Region, string(credentials["access_key_id"]), string(credentials["secret_key"]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := fmt.Sprintf("uploads/%s/%s.tar.gz", service.ID, uuid.NewString())
+	if err := s3Client.UploadObject(ctx, *config.UploadS3Bucket, key, archive, "application/gzip"); err != nil {
+		log.Error("Error storing source archive", "err", err)
+		return nil, nil, err
+	}
+
+	// Enqueue build job, populated after the analysis so it builds with the detected provider
+	env, err := self.deploymentController.PopulateBuildEnvironment(ctx, service.ID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	env["SOURCE_ARCHIVE_KEY"] = key
+
+	job, err := self.deploymentController.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
+		ServiceID:      service.ID,
+		Environment:    env,
+		Source:         schema.DeploymentSourceUpload,
+		OverrideFreeze: input.OverrideFreeze,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return models.TransformDeploymentEntity(job), suggestedBuilder, nil
+}
+
+// applyUploadAnalysis records the detected provider and framework, ports only if the service has none yet
+func (self *DeploymentService) applyUploadAnalysis(ctx context.Context, serviceID uuid.UUID, config *ent.ServiceConfig, analysisResult *sourceanalyzer.AnalysisResult) error {
+	input := &service_repo.MutateConfigInput{
+		ServiceID: serviceID,
+	}
+	if analysisResult.Provider != enum.UnknownProvider {
+		input.Provider = utils.ToPtr(analysisResult.Provider)
+		input.Icon = utils.ToPtr(string(analysisResult.Provider))
+	}
+	if analysisResult.Framework != enum.UnknownFramework {
+		input.Framework = utils.ToPtr(analysisResult.Framework)
+		input.Icon = utils.ToPtr(string(analysisResult.Framework))
+	}
+	if len(config.Ports) == 0 && analysisResult.Port != nil {
+		input.AddPorts = []schema.PortSpec{
+			{
+				Port: int32(*analysisResult.Port),
+			},
+		}
+	}
+
+	return self.repo.Service().UpdateConfig(ctx, nil, input)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
 	"github.com/unbindapp/unbind-api/internal/common/log"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+	"k8s.io/client-go/kubernetes"
 )
 
 func (self *EnvironmentService) DeleteEnvironmentByID(ctx context.Context, requesterUserID uuid.UUID, bearerToken string, teamID, projectID, environmentID uuid.UUID) error {
@@ -54,41 +56,7 @@ func (self *EnvironmentService) DeleteEnvironmentByID(ctx context.Context, reque
 			return errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Cannot delete the last environment in a project")
 		}
 
-		// Delete services
-		for _, service := range services {
-			// Cancel deployments
-			if err := self.deployCtl.CancelExistingJobs(ctx, service.ID); err != nil {
-				log.Warnf("Error cancelling jobs for service %s: %v", service.KubernetesName, err)
-			}
-
-			if err := self.k8s.DeleteUnbindService(ctx, team.Namespace, service.KubernetesName); err != nil {
-				log.Error("Error deleting service from k8s", "svc", service.KubernetesName, "err", err)
-
-				return err
-			}
-
-			// Delete secret
-			if err := self.k8s.DeleteSecret(ctx, service.KubernetesSecret, team.Namespace, client); err != nil {
-				log.Error("Error deleting secret from k8s", "secret", service.KubernetesSecret, "err", err)
-				return err
-			}
-
-			if err := self.repo.Service().Delete(ctx, tx, service.ID); err != nil {
-				return err
-			}
-		}
-
-		// Delete any service groups in this environment
-		if err := self.repo.ServiceGroup().DeleteByEnvironmentID(ctx, tx, environmentID); err != nil {
-			return err
-		}
-
-		// Delete environment
-		if err := self.k8s.DeleteSecret(ctx, environment.KubernetesSecret, team.Namespace, client); err != nil {
-			log.Error("Error deleting secret", "secret", environment.KubernetesSecret, "err", err)
-		}
-
-		if err := self.repo.Environment().Delete(ctx, tx, environmentID); err != nil {
+		if err := self.deleteEnvironmentResources(ctx, tx, team.Namespace, environment, services, client); err != nil {
 			return err
 		}
 
@@ -118,3 +86,46 @@ func (self *EnvironmentService) DeleteEnvironmentByID(ctx context.Context, reque
 
 	return nil
 }
+
+// deleteEnvironmentResources deletes the environment with its services, their kubernetes resources and secrets
+func (self *EnvironmentService) deleteEnvironmentResources(ctx context.Context, tx repository.TxInterface, namespace string, environment *ent.Environment, services []*ent.Service, client kubernetes.Interface) error {
+	// Delete services
+	for _, service := range services {
+		// Cancel deployments
+		if err := self.deployCtl.CancelExistingJobs(ctx, service.ID); err != nil {
+			log.Warnf("Error cancelling jobs for service %s: %v", service.KubernetesName, err)
+		}
+
+		if err := self.k8s.DeleteUnbindService(ctx, namespace, service.KubernetesName); err != nil {
+			log.Error("Error deleting service from k8s", "svc", service.KubernetesName, "err", err)
+
+			return err
+		}
+
+		// Delete secret
+		if err := self.k8s.DeleteSecret(ctx, service.KubernetesSecret, namespace, client); err != nil {
+			log.Error("Error deleting secret from k8s", "secret", service.KubernetesSecret, "err", err)
+			return err
+		}
+
+		if err := self.repo.Service().Delete(ctx, tx, service.ID); err != nil {
+			return err
+		}
+	}
+
+	// Delete any service groups in this environment
+	if err := self.repo.ServiceGroup().DeleteByEnvironmentID(ctx, tx, environment.ID); err != nil {
+		return err
+	}
+
+	// Delete environment
+	if err := self.k8s.DeleteSecret(ctx, environment.KubernetesSecret, namespace, client); err != nil {
+		log.Error("Error deleting secret", "secret", environment.KubernetesSecret, "err", err)
+	}
+
+	if err := self.repo.Environment().Delete(ctx, tx, environment.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,25 +4,31 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/config"
 	"github.com/unbindapp/unbind-api/ent"
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
 	"github.com/unbindapp/unbind-api/internal/deployctl"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
+	"github.com/unbindapp/unbind-api/internal/integrations/github"
 	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
 )
 
 // Integrate environment management with internal permissions and kubernetes RBAC
 type EnvironmentService struct {
-	repo      repositories.RepositoriesInterface
-	k8s       k8s.KubeClientInterface
-	deployCtl deployctl.DeploymentControllerInterface
+	cfg          *config.Config
+	repo         repositories.RepositoriesInterface
+	k8s          k8s.KubeClientInterface
+	deployCtl    deployctl.DeploymentControllerInterface
+	githubClient github.GithubClientInterface
 }
 
-func NewEnvironmentService(repo repositories.RepositoriesInterface, k8sClient k8s.KubeClientInterface, deployCtl deployctl.DeploymentControllerInterface) *EnvironmentService {
+func NewEnvironmentService(cfg *config.Config, repo repositories.RepositoriesInterface, k8sClient k8s.KubeClientInterface, deployCtl deployctl.DeploymentControllerInterface, githubClient github.GithubClientInterface) *EnvironmentService {
 	return &EnvironmentService{
-		repo:      repo,
-		k8s:       k8sClient,
-		deployCtl: deployCtl,
+		cfg:          cfg,
+		repo:         repo,
+		k8s:          k8sClient,
+		deployCtl:    deployCtl,
+		githubClient: githubClient,
 	}
 }
 
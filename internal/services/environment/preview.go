@@ -0,0 +1,402 @@
+package environment_service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+	service_repo "github.com/unbindapp/unbind-api/internal/repositories/service"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PullRequestPreview is a pull request opened or updated against a repository with previews enabled
+type PullRequestPreview struct {
+	Installation *ent.GithubInstallation
+	Repository   string
+	Number       int
+	Title        string
+	BaseBranch   string
+	HeadSHA      string
+	Committer    *schema.GitCommitter
+}
+
+// Repositories of different accounts may share a name, previews are keyed by owner/name
+func (self *PullRequestPreview) fullName() string {
+	return previewRepositoryName(self.Installation, self.Repository)
+}
+
+func previewRepositoryName(installation *ent.GithubInstallation, repoName string) string {
+	return fmt.Sprintf("%s/%s", installation.AccountLogin, repoName)
+}
+
+// previewGitRef is the ref github keeps for the head of a pull request, also for pull requests from forks
+func previewGitRef(number int) string {
+	return fmt.Sprintf("refs/pull/%d/head", number)
+}
+
+// DeployPreview clones the environments of services with previews enabled into a pr-<number> environment,
+// builds the head of the pull request there and comments the preview URLs on the pull request
+func (self *EnvironmentService) DeployPreview(ctx context.Context, pr *PullRequestPreview) error {
+	services, err := self.repo.Service().GetPrPreviewServices(ctx, pr.Installation.ID, pr.Repository)
+	if err != nil {
+		return err
+	}
+
+	// Only services deploying the branch the pull request is against, grouped by environment
+	var baseEnvironments []*ent.Environment
+	servicesByEnvironment := make(map[uuid.UUID][]*ent.Service)
+	for _, service := range services {
+		branch := service.Edges.ServiceConfig.GitBranch
+		if branch == nil || strings.TrimPrefix(*branch, "refs/heads/") != pr.BaseBranch {
+			continue
+		}
+		if _, ok := servicesByEnvironment[service.EnvironmentID]; !ok {
+			baseEnvironments = append(baseEnvironments, service.Edges.Environment)
+		}
+		servicesByEnvironment[service.EnvironmentID] = append(servicesByEnvironment[service.EnvironmentID], service)
+	}
+
+	if len(baseEnvironments) == 0 {
+		return nil
+	}
+
+	previews, err := self.repo.Environment().GetPreviews(ctx, pr.fullName(), pr.Number)
+	if err != nil {
+		return err
+	}
+
+	for _, base := range baseEnvironments {
+		var preview *ent.Environment
+		for _, existing := range previews {
+			if existing.PreviewBaseEnvironmentID != nil && *existing.PreviewBaseEnvironmentID == base.ID {
+				preview = existing
+				break
+			}
+		}
+
+		if err := self.deployPreviewEnvironment(ctx, pr, base, preview, servicesByEnvironment[base.ID]); err != nil {
+			return fmt.Errorf("failed to deploy preview of environment %s: %w", base.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (self *EnvironmentService) deployPreviewEnvironment(ctx context.Context, pr *PullRequestPreview, base *ent.Environment, preview *ent.Environment, baseServices []*ent.Service) error {
+	namespace := base.Edges.Project.Edges.Team.Namespace
+	client := self.k8s.GetInternalClient()
+
+	var err error
+	if preview == nil {
+		preview, err = self.createPreviewEnvironment(ctx, pr, base, baseServices, namespace, client)
+		if err != nil {
+			return err
+		}
+		log.Info("Created preview environment", "environment_id", preview.ID, "repository", pr.fullName(), "pull_request", pr.Number)
+	}
+
+	// Services are cloned once, later pushes to the pull request only rebuild them
+	existing, err := self.repo.Service().GetByEnvironmentID(ctx, preview.ID, nil, false)
+	if err != nil {
+		return err
+	}
+
+	previewServices := make([]*ent.Service, 0, len(baseServices))
+	for _, service := range baseServices {
+		idx := slices.IndexFunc(existing, func(s *ent.Service) bool {
+			return s.Name == service.Name
+		})
+		if idx >= 0 {
+			previewServices = append(previewServices, existing[idx])
+			continue
+		}
+
+		cloned, err := self.clonePreviewService(ctx, pr, service, preview, namespace, client)
+		if err != nil {
+			return err
+		}
+		previewServices = append(previewServices, cloned)
+	}
+
+	for _, service := range previewServices {
+		env, err := self.deployCtl.PopulateBuildEnvironment(ctx, service.ID, nil, nil)
+		if err != nil {
+			return err
+		}
+		env["CHECKOUT_COMMIT_SHA"] = pr.HeadSHA
+
+		if _, err := self.deployCtl.EnqueueDeploymentJob(ctx, deployctl.DeploymentJobRequest{
+			ServiceID:     service.ID,
+			Environment:   env,
+			Source:        schema.DeploymentSourceGit,
+			CommitSHA:     pr.HeadSHA,
+			CommitMessage: pr.Title,
+			GitBranch:     previewGitRef(pr.Number),
+			Committer:     pr.Committer,
+		}); err != nil {
+			return err
+		}
+	}
+
+	self.commentPreview(ctx, pr.Installation, pr.Repository, pr.Number, preview, previewCommentBody(self.previewURL(base, preview), preview.Name, pr.HeadSHA, previewServices))
+	return nil
+}
+
+func (self *EnvironmentService) createPreviewEnvironment(ctx context.Context, pr *PullRequestPreview, base *ent.Environment, baseServices []*ent.Service, namespace string, client kubernetes.Interface) (*ent.Environment, error) {
+	name := fmt.Sprintf("pr-%d", pr.Number)
+	kubernetesName, err := utils.GenerateSlug(name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, _, err := self.k8s.GetOrCreateSecret(ctx, kubernetesName, namespace, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start with the base environment's variables the previewed services allow
+	var allowed []string
+	for _, service := range baseServices {
+		allowed = append(allowed, service.Edges.ServiceConfig.PrPreviewVariables...)
+	}
+	values, err := self.previewSecretValues(ctx, base.KubernetesSecret, namespace, allowed, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		if _, err := self.k8s.OverwriteSecretValues(ctx, secret.Name, namespace, values, client); err != nil {
+			return nil, err
+		}
+	}
+
+	description := fmt.Sprintf("Preview of %s#%d: %s", pr.fullName(), pr.Number, pr.Title)
+	return self.repo.Environment().CreatePreview(ctx, nil, kubernetesName, name, secret.Name, &description, base.ProjectID, base.ID, pr.fullName(), pr.Number)
+}
+
+// clonePreviewService copies a service into the preview environment, building the pull request instead of the branch
+// Only variables the service allows for previews are copied, volumes and the pre-deploy command aren't cloned
+func (self *EnvironmentService) clonePreviewService(ctx context.Context, pr *PullRequestPreview, service *ent.Service, preview *ent.Environment, namespace string, client kubernetes.Interface) (*ent.Service, error) {
+	config := service.Edges.ServiceConfig
+
+	kubernetesName, err := utils.GenerateSlug(service.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, _, err := self.k8s.GetOrCreateSecret(ctx, kubernetesName, namespace, client)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := self.previewSecretValues(ctx, service.KubernetesSecret, namespace, config.PrPreviewVariables, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		if _, err := self.k8s.OverwriteSecretValues(ctx, secret.Name, namespace, values, client); err != nil {
+			return nil, err
+		}
+	}
+
+	var cloned *ent.Service
+	if err := self.repo.WithTx(ctx, func(tx repository.TxInterface) error {
+		var hosts []schema.HostSpec
+		if config.IsPublic && len(config.Ports) > 0 {
+			host, err := self.generateWildcardHost(ctx, tx, kubernetesName, config.Ports)
+			if err != nil {
+				return fmt.Errorf("failed to generate wildcard host: %w", err)
+			}
+			if host != nil {
+				hosts = append(hosts, *host)
+			}
+		}
+
+		cloned, err = self.repo.Service().Create(ctx, tx, &service_repo.CreateServiceInput{
+			KubernetesName:       kubernetesName,
+			ServiceType:          service.Type,
+			Name:                 service.Name,
+			Description:          service.Description,
+			EnvironmentID:        preview.ID,
+			GitHubInstallationID: service.GithubInstallationID,
+			GitRepository:        service.GitRepository,
+			GitRepositoryOwner:   service.GitRepositoryOwner,
+			KubernetesSecret:     secret.Name,
+			DetectedPorts:        service.DetectedPorts,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create service: %w", err)
+		}
+
+		cloned.Edges.ServiceConfig, err = self.repo.Service().CreateConfig(ctx, tx, &service_repo.MutateConfigInput{
+			ServiceID:                     cloned.ID,
+			Builder:                       utils.ToPtr(config.Builder),
+			Icon:                          utils.ToPtr(config.Icon),
+			Provider:                      config.RailpackProvider,
+			Framework:                     config.RailpackFramework,
+			GitBranch:                     utils.ToPtr(previewGitRef(pr.Number)),
+			OverwritePorts:                config.Ports,
+			OverwriteHosts:                hosts,
+			Public:                        utils.ToPtr(len(hosts) > 0),
+			RailpackBuilderInstallCommand: config.RailpackBuilderInstallCommand,
+			RailpackBuilderBuildCommand:   config.RailpackBuilderBuildCommand,
+			RunCommand:                    config.RunCommand,
+			DockerBuilderDockerfilePath:   config.DockerBuilderDockerfilePath,
+			DockerBuilderBuildContext:     config.DockerBuilderBuildContext,
+			DockerBuilderTarget:           config.DockerBuilderTarget,
+			DockerBuilderBuildArgs:        &config.DockerBuilderBuildArgs,
+			DockerBuilderBuildContexts:    &config.DockerBuilderBuildContexts,
+			BuildpacksBuilderImage:        config.BuildpacksBuilderImage,
+			Platforms:                     &config.Platforms,
+			CustomDefinitionVersion:       config.DefinitionVersion,
+			SecurityContext:               config.SecurityContext,
+			HealthCheck:                   config.HealthCheck,
+			OverwriteVariableMounts:       config.VariableMounts,
+			PrPreviewVariables:            &config.PrPreviewVariables,
+			ProtectedVariables:            &config.ProtectedVariables,
+			InitContainers:                config.InitContainers,
+			Resources:                     config.Resources,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create service config: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return cloned, nil
+}
+
+// previewSecretValues reads the allowed keys of a secret, previews don't get any other secrets of the base
+func (self *EnvironmentService) previewSecretValues(ctx context.Context, secretName, namespace string, allowed []string, client kubernetes.Interface) (map[string][]byte, error) {
+	if len(allowed) == 0 {
+		return nil, nil
+	}
+
+	values, err := self.k8s.GetSecretMap(ctx, secretName, namespace, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range values {
+		if !slices.Contains(allowed, key) {
+			delete(values, key)
+		}
+	}
+	return values, nil
+}
+
+func (self *EnvironmentService) generateWildcardHost(ctx context.Context, tx repository.TxInterface, kubernetesName string, ports []schema.PortSpec) (*schema.HostSpec, error) {
+	settings, err := self.repo.System().GetSystemSettings(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system settings: %w", err)
+	}
+
+	if settings.WildcardBaseURL == nil || *settings.WildcardBaseURL == "" {
+		return nil, nil // No wildcard base URL configured
+	}
+
+	domain, err := utils.GenerateSubdomain(kubernetesName, *settings.WildcardBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate subdomain: %w", err)
+	}
+
+	domainCount, err := self.repo.Service().CountDomainCollisons(ctx, tx, domain, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count domain collisions: %w", err)
+	}
+
+	if domainCount > 0 {
+		domain, err = utils.GenerateSubdomain(fmt.Sprintf("%s-%d", kubernetesName, domainCount), *settings.WildcardBaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate subdomain with suffix: %w", err)
+		}
+	}
+
+	return &schema.HostSpec{
+		Host:       domain,
+		Path:       "/",
+		TargetPort: utils.ToPtr(ports[0].Port),
+	}, nil
+}
+
+// DeletePreview tears down the preview environments of a closed or merged pull request
+func (self *EnvironmentService) DeletePreview(ctx context.Context, installation *ent.GithubInstallation, repoName string, number int) error {
+	previews, err := self.repo.Environment().GetPreviews(ctx, previewRepositoryName(installation, repoName), number)
+	if err != nil {
+		return err
+	}
+
+	for _, preview := range previews {
+		services, err := self.repo.Service().GetByEnvironmentID(ctx, preview.ID, nil, false)
+		if err != nil {
+			return err
+		}
+
+		namespace := preview.Edges.Project.Edges.Team.Namespace
+		if err := self.repo.WithTx(ctx, func(tx repository.TxInterface) error {
+			return self.deleteEnvironmentResources(ctx, tx, namespace, preview, services, self.k8s.GetInternalClient())
+		}); err != nil {
+			return fmt.Errorf("failed to delete preview environment %s: %w", preview.ID, err)
+		}
+		log.Info("Deleted preview environment", "environment_id", preview.ID, "pull_request", number)
+
+		if preview.PreviewCommentID != nil {
+			self.commentPreview(ctx, installation, repoName, number, preview, fmt.Sprintf("The preview environment **%s** was removed.", preview.Name))
+		}
+	}
+
+	return nil
+}
+
+// commentPreview creates or edits the pull request's preview comment, previews work without it
+func (self *EnvironmentService) commentPreview(ctx context.Context, installation *ent.GithubInstallation, repoName string, number int, preview *ent.Environment, body string) {
+	commentID, err := self.githubClient.UpsertPullRequestComment(ctx, installation, installation.AccountLogin, repoName, number, preview.PreviewCommentID, body)
+	if err != nil {
+		log.Warn("Failed to comment preview on pull request", "err", err, "environment_id", preview.ID, "pull_request", number)
+		return
+	}
+
+	if preview.PreviewCommentID == nil {
+		if _, err := self.repo.Environment().SetPreviewCommentID(ctx, preview.ID, commentID); err != nil {
+			log.Error("Failed to record preview comment", "err", err, "environment_id", preview.ID)
+		}
+	}
+}
+
+// previewURL links to the preview environment in the UI
+func (self *EnvironmentService) previewURL(base *ent.Environment, preview *ent.Environment) string {
+	basePath, _ := utils.JoinURLPaths(
+		self.cfg.ExternalUIUrl,
+		base.Edges.Project.Edges.Team.ID.String(),
+		"project",
+		base.ProjectID.String(),
+	)
+	return basePath + "?environment=" + preview.ID.String()
+}
+
+func previewCommentBody(url, environmentName, headSHA string, services []*ent.Service) string {
+	if len(headSHA) > 7 {
+		headSHA = headSHA[:7]
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Deploying `%s` to the preview environment [**%s**](%s).\n\n", headSHA, environmentName, url)
+	body.WriteString("| Service | URL |\n| --- | --- |\n")
+	for _, service := range services {
+		host := "-"
+		if service.Edges.ServiceConfig != nil && len(service.Edges.ServiceConfig.Hosts) > 0 {
+			host = fmt.Sprintf("https://%s", service.Edges.ServiceConfig.Hosts[0].Host)
+		}
+		fmt.Fprintf(&body, "| %s | %s |\n", service.Name, host)
+	}
+	return body.String()
+}
@@ -0,0 +1,229 @@
+package environment_service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/unbindapp/unbind-api/config"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/deployctl"
+	repository "github.com/unbindapp/unbind-api/internal/repositories"
+	service_repo "github.com/unbindapp/unbind-api/internal/repositories/service"
+	"github.com/unbindapp/unbind-api/internal/services"
+	github_mocks "github.com/unbindapp/unbind-api/mocks/integrations/github"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type PreviewEnvironmentSuite struct {
+	services.ServiceTestSuite
+	service    *EnvironmentService
+	githubMock *github_mocks.GithubClientMock
+
+	// Test data
+	installation    *ent.GithubInstallation
+	baseEnvironment *ent.Environment
+	baseService     *ent.Service
+	pr              *PullRequestPreview
+}
+
+func (suite *PreviewEnvironmentSuite) SetupTest() {
+	suite.ServiceTestSuite.SetupTest()
+	suite.githubMock = github_mocks.NewGithubClientMock(suite.T())
+
+	suite.service = &EnvironmentService{
+		cfg:          &config.Config{ExternalUIUrl: "https://ui.example.com"},
+		repo:         suite.MockRepo,
+		k8s:          suite.MockK8s,
+		deployCtl:    suite.MockDeployCtl,
+		githubClient: suite.githubMock,
+	}
+
+	suite.installation = &ent.GithubInstallation{ID: 1, AccountLogin: "acme"}
+
+	team := &ent.Team{ID: uuid.New(), Namespace: "team-ns"}
+	project := &ent.Project{ID: uuid.New(), TeamID: team.ID, Edges: ent.ProjectEdges{Team: team}}
+	suite.baseEnvironment = &ent.Environment{
+		ID:               uuid.New(),
+		Name:             "production",
+		KubernetesSecret: "production-secret",
+		ProjectID:        project.ID,
+		Edges:            ent.EnvironmentEdges{Project: project},
+	}
+	suite.baseService = &ent.Service{
+		ID:                   uuid.New(),
+		Type:                 schema.ServiceTypeGithub,
+		Name:                 "web",
+		KubernetesName:       "web-abc",
+		KubernetesSecret:     "web-abc",
+		EnvironmentID:        suite.baseEnvironment.ID,
+		GithubInstallationID: utils.ToPtr(int64(1)),
+		GitRepository:        utils.ToPtr("app"),
+		GitRepositoryOwner:   utils.ToPtr("acme"),
+		Edges: ent.ServiceEdges{
+			Environment: suite.baseEnvironment,
+			ServiceConfig: &ent.ServiceConfig{
+				Builder:            schema.ServiceBuilderRailpack,
+				GitBranch:          utils.ToPtr("main"),
+				PrPreviews:         true,
+				PrPreviewVariables: []string{"API_URL"},
+				PreDeployCommand:   utils.ToPtr("npm run migrate"),
+				IsPublic:           true,
+				Ports:              []schema.PortSpec{{Port: 3000}},
+				Hosts:              []schema.HostSpec{{Host: "app.example.com", TargetPort: utils.ToPtr(int32(3000))}},
+			},
+		},
+	}
+	suite.pr = &PullRequestPreview{
+		Installation: suite.installation,
+		Repository:   "app",
+		Number:       5,
+		Title:        "Add login",
+		BaseBranch:   "main",
+		HeadSHA:      "abc1234def",
+	}
+}
+
+func (suite *PreviewEnvironmentSuite) TearDownTest() {
+	suite.ServiceTestSuite.TearDownTest()
+}
+
+func (suite *PreviewEnvironmentSuite) TestDeployPreview_CreatesEnvironment() {
+	client := &kubernetes.Clientset{}
+	preview := &ent.Environment{
+		ID:                       uuid.New(),
+		Name:                     "pr-5",
+		ProjectID:                suite.baseEnvironment.ProjectID,
+		PreviewBaseEnvironmentID: &suite.baseEnvironment.ID,
+	}
+
+	suite.MockServiceRepo.EXPECT().GetPrPreviewServices(suite.Ctx, int64(1), "app").Return([]*ent.Service{suite.baseService}, nil).Once()
+	suite.MockEnvironmentRepo.EXPECT().GetPreviews(suite.Ctx, "acme/app", 5).Return([]*ent.Environment{}, nil).Once()
+	suite.MockK8s.EXPECT().GetInternalClient().Return(client)
+
+	// Environment and service secrets only get the variables allowed for previews
+	suite.MockK8s.EXPECT().GetOrCreateSecret(suite.Ctx, mock.Anything, "team-ns", client).RunAndReturn(
+		func(ctx context.Context, name, namespace string, client kubernetes.Interface) (*corev1.Secret, bool, error) {
+			return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}}, true, nil
+		}).Twice()
+	suite.MockK8s.EXPECT().GetSecretMap(suite.Ctx, "production-secret", "team-ns", client).Return(map[string][]byte{
+		"API_URL":      []byte("https://api.example.com"),
+		"DATABASE_URL": []byte("postgres://production"),
+	}, nil).Once()
+	suite.MockK8s.EXPECT().OverwriteSecretValues(suite.Ctx, mock.MatchedBy(func(name string) bool {
+		return strings.HasPrefix(name, "pr-5-")
+	}), "team-ns", map[string][]byte{"API_URL": []byte("https://api.example.com")}, client).Return(&corev1.Secret{}, nil).Once()
+	suite.MockK8s.EXPECT().GetSecretMap(suite.Ctx, "web-abc", "team-ns", client).Return(map[string][]byte{"API_KEY": []byte("secret")}, nil).Once()
+
+	suite.MockEnvironmentRepo.EXPECT().CreatePreview(suite.Ctx, nil, mock.MatchedBy(func(kubernetesName string) bool {
+		return strings.HasPrefix(kubernetesName, "pr-5-")
+	}), "pr-5", mock.Anything, mock.Anything, suite.baseEnvironment.ProjectID, suite.baseEnvironment.ID, "acme/app", 5).Return(preview, nil).Once()
+	suite.MockServiceRepo.EXPECT().GetByEnvironmentID(suite.Ctx, preview.ID, mock.Anything, false).Return([]*ent.Service{}, nil).Once()
+
+	cloned := &ent.Service{ID: uuid.New(), Name: "web"}
+	suite.MockRepo.EXPECT().
+		WithTx(suite.Ctx, mock.AnythingOfType("func(repository.TxInterface) error")).
+		RunAndReturn(func(ctx context.Context, fn func(repository.TxInterface) error) error {
+			mockTx := suite.NewTxMockTyped()
+
+			suite.MockSystemRepo.EXPECT().GetSystemSettings(suite.Ctx, mockTx).Return(&ent.SystemSetting{WildcardBaseURL: utils.ToPtr("https://preview.example.com")}, nil).Once()
+			suite.MockServiceRepo.EXPECT().CountDomainCollisons(suite.Ctx, mockTx, mock.Anything, (*uuid.UUID)(nil)).Return(0, nil).Once()
+			suite.MockServiceRepo.EXPECT().Create(suite.Ctx, mockTx, mock.MatchedBy(func(input *service_repo.CreateServiceInput) bool {
+				return input.EnvironmentID == preview.ID && input.Name == "web" && *input.GitRepository == "app"
+			})).Return(cloned, nil).Once()
+			suite.MockServiceRepo.EXPECT().CreateConfig(suite.Ctx, mockTx, mock.MatchedBy(func(input *service_repo.MutateConfigInput) bool {
+				return *input.GitBranch == "refs/pull/5/head" &&
+					len(input.OverwriteHosts) == 1 && strings.HasSuffix(input.OverwriteHosts[0].Host, ".preview.example.com") &&
+					*input.Public && input.PrPreviews == nil && input.PreDeployCommand == nil
+			})).RunAndReturn(func(ctx context.Context, tx repository.TxInterface, input *service_repo.MutateConfigInput) (*ent.ServiceConfig, error) {
+				return &ent.ServiceConfig{Hosts: input.OverwriteHosts}, nil
+			}).Once()
+
+			return fn(mockTx)
+		}).Once()
+
+	// Builds the head of the pull request
+	suite.MockDeployCtl.EXPECT().PopulateBuildEnvironment(suite.Ctx, cloned.ID, (*string)(nil), (*ent.Deployment)(nil)).Return(map[string]string{}, nil).Once()
+	suite.MockDeployCtl.EXPECT().EnqueueDeploymentJob(suite.Ctx, mock.MatchedBy(func(req deployctl.DeploymentJobRequest) bool {
+		return req.ServiceID == cloned.ID &&
+			req.CommitSHA == "abc1234def" &&
+			req.GitBranch == "refs/pull/5/head" &&
+			req.Environment["CHECKOUT_COMMIT_SHA"] == "abc1234def"
+	})).Return(&ent.Deployment{}, nil).Once()
+
+	suite.githubMock.EXPECT().UpsertPullRequestComment(suite.Ctx, suite.installation, "acme", "app", 5, (*int64)(nil), mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "`abc1234`") && strings.Contains(body, ".preview.example.com") && strings.Contains(body, "environment="+preview.ID.String())
+	})).Return(int64(42), nil).Once()
+	suite.MockEnvironmentRepo.EXPECT().SetPreviewCommentID(suite.Ctx, preview.ID, int64(42)).Return(preview, nil).Once()
+
+	suite.NoError(suite.service.DeployPreview(suite.Ctx, suite.pr))
+}
+
+func (suite *PreviewEnvironmentSuite) TestDeployPreview_RebuildsExisting() {
+	preview := &ent.Environment{
+		ID:                       uuid.New(),
+		Name:                     "pr-5",
+		PreviewBaseEnvironmentID: &suite.baseEnvironment.ID,
+		PreviewCommentID:         utils.ToPtr(int64(42)),
+	}
+	existing := &ent.Service{ID: uuid.New(), Name: "web", Edges: ent.ServiceEdges{ServiceConfig: &ent.ServiceConfig{}}}
+
+	suite.MockServiceRepo.EXPECT().GetPrPreviewServices(suite.Ctx, int64(1), "app").Return([]*ent.Service{suite.baseService}, nil).Once()
+	suite.MockEnvironmentRepo.EXPECT().GetPreviews(suite.Ctx, "acme/app", 5).Return([]*ent.Environment{preview}, nil).Once()
+	suite.MockK8s.EXPECT().GetInternalClient().Return(&kubernetes.Clientset{})
+	suite.MockServiceRepo.EXPECT().GetByEnvironmentID(suite.Ctx, preview.ID, mock.Anything, false).Return([]*ent.Service{existing}, nil).Once()
+
+	suite.MockDeployCtl.EXPECT().PopulateBuildEnvironment(suite.Ctx, existing.ID, (*string)(nil), (*ent.Deployment)(nil)).Return(map[string]string{}, nil).Once()
+	suite.MockDeployCtl.EXPECT().EnqueueDeploymentJob(suite.Ctx, mock.MatchedBy(func(req deployctl.DeploymentJobRequest) bool {
+		return req.ServiceID == existing.ID
+	})).Return(&ent.Deployment{}, nil).Once()
+
+	// Edits the existing comment
+	suite.githubMock.EXPECT().UpsertPullRequestComment(suite.Ctx, suite.installation, "acme", "app", 5, preview.PreviewCommentID, mock.Anything).Return(int64(42), nil).Once()
+
+	suite.NoError(suite.service.DeployPreview(suite.Ctx, suite.pr))
+}
+
+func (suite *PreviewEnvironmentSuite) TestDeployPreview_OtherBaseBranch() {
+	suite.pr.BaseBranch = "develop"
+	suite.MockServiceRepo.EXPECT().GetPrPreviewServices(suite.Ctx, int64(1), "app").Return([]*ent.Service{suite.baseService}, nil).Once()
+
+	suite.NoError(suite.service.DeployPreview(suite.Ctx, suite.pr))
+}
+
+func (suite *PreviewEnvironmentSuite) TestDeletePreview() {
+	preview := &ent.Environment{
+		ID:               uuid.New(),
+		Name:             "pr-5",
+		KubernetesSecret: "pr-5-secret",
+		PreviewCommentID: utils.ToPtr(int64(42)),
+		Edges:            ent.EnvironmentEdges{Project: suite.baseEnvironment.Edges.Project},
+	}
+	previewService := &ent.Service{ID: uuid.New(), KubernetesName: "web-def", KubernetesSecret: "web-def"}
+
+	suite.MockEnvironmentRepo.EXPECT().GetPreviews(suite.Ctx, "acme/app", 5).Return([]*ent.Environment{preview}, nil).Once()
+	suite.MockServiceRepo.EXPECT().GetByEnvironmentID(suite.Ctx, preview.ID, mock.Anything, false).Return([]*ent.Service{previewService}, nil).Once()
+
+	// Resources are deleted the same way as deleting the environment by hand
+	suite.MockRepo.EXPECT().
+		WithTx(suite.Ctx, mock.AnythingOfType("func(repository.TxInterface) error")).
+		Return(nil).
+		Once()
+
+	suite.githubMock.EXPECT().UpsertPullRequestComment(suite.Ctx, suite.installation, "acme", "app", 5, preview.PreviewCommentID, mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "was removed")
+	})).Return(int64(42), nil).Once()
+
+	suite.NoError(suite.service.DeletePreview(suite.Ctx, suite.installation, "app", 5))
+}
+
+func TestPreviewEnvironmentSuite(t *testing.T) {
+	suite.Run(t, new(PreviewEnvironmentSuite))
+}
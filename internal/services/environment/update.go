@@ -10,11 +10,13 @@ import (
 )
 
 type UpdateEnvironmentInput struct {
-	TeamID        uuid.UUID `json:"team_id" format:"uuid" required:"true"`
-	ProjectID     uuid.UUID `json:"project_id" format:"uuid" required:"true"`
-	EnvironmentID uuid.UUID `json:"environment_id" format:"uuid" required:"true"`
-	Name          *string   `json:"name"`
-	Description   *string   `json:"description"`
+	TeamID        uuid.UUID              `json:"team_id" format:"uuid" required:"true"`
+	ProjectID     uuid.UUID              `json:"project_id" format:"uuid" required:"true"`
+	EnvironmentID uuid.UUID              `json:"environment_id" format:"uuid" required:"true"`
+	Name          *string                `json:"name"`
+	Description   *string                `json:"description"`
+	Protected     *bool                  `json:"protected" doc:"Require admin approval for deployments to this environment"`
+	FreezeWindows *[]schema.FreezeWindow `json:"freeze_windows" doc:"Windows during which deployments are held, replaces the existing windows"`
 }
 
 func (self *EnvironmentService) UpdateEnvironment(ctx context.Context, requesterUserID uuid.UUID, input *UpdateEnvironmentInput) (*models.EnvironmentResponse, error) {
@@ -27,11 +29,30 @@ func (self *EnvironmentService) UpdateEnvironment(ctx context.Context, requester
 		},
 	}
 
+	// Only environment admins can change protection or freezes
+	if input.Protected != nil || input.FreezeWindows != nil {
+		permissionChecks = []permissions_repo.PermissionCheck{
+			{
+				Action:       schema.ActionAdmin,
+				ResourceType: schema.ResourceTypeEnvironment,
+				ResourceID:   input.EnvironmentID,
+			},
+		}
+	}
+
 	// Check permissions
 	if err := self.repo.Permissions().Check(ctx, requesterUserID, permissionChecks); err != nil {
 		return nil, err
 	}
 
+	if input.FreezeWindows != nil {
+		for i := range *input.FreezeWindows {
+			if err := (*input.FreezeWindows)[i].Validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Verify inputs
 	_, environment, err := self.VerifyInputs(ctx, input.TeamID, input.ProjectID, input.EnvironmentID)
 	if err != nil {
@@ -39,7 +60,7 @@ func (self *EnvironmentService) UpdateEnvironment(ctx context.Context, requester
 	}
 
 	// Update the environment
-	updated, err := self.repo.Environment().Update(ctx, environment.ID, input.Name, input.Description)
+	updated, err := self.repo.Environment().Update(ctx, environment.ID, input.Name, input.Description, input.Protected, input.FreezeWindows)
 	if err != nil {
 		return nil, err
 	}
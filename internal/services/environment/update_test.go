@@ -2,6 +2,7 @@ package environment_service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -121,7 +122,7 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_Success() {
 
 	// Update environment
 	suite.MockEnvironmentRepo.EXPECT().
-		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description).
+		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description, (*bool)(nil), (*[]schema.FreezeWindow)(nil)).
 		Return(updatedEnvironment, nil).
 		Once()
 
@@ -181,7 +182,7 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_NameOnly() {
 
 	// Update environment
 	suite.MockEnvironmentRepo.EXPECT().
-		Update(suite.Ctx, suite.testEnvironmentID, input.Name, (*string)(nil)).
+		Update(suite.Ctx, suite.testEnvironmentID, input.Name, (*string)(nil), (*bool)(nil), (*[]schema.FreezeWindow)(nil)).
 		Return(updatedEnvironment, nil).
 		Once()
 
@@ -239,7 +240,7 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_DescriptionOnly() {
 
 	// Update environment
 	suite.MockEnvironmentRepo.EXPECT().
-		Update(suite.Ctx, suite.testEnvironmentID, (*string)(nil), input.Description).
+		Update(suite.Ctx, suite.testEnvironmentID, (*string)(nil), input.Description, (*bool)(nil), (*[]schema.FreezeWindow)(nil)).
 		Return(updatedEnvironment, nil).
 		Once()
 
@@ -266,6 +267,122 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_DescriptionOnly() {
 	suite.Empty(result.ServiceIcons)
 }
 
+func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_Protected() {
+	input := &UpdateEnvironmentInput{
+		TeamID:        suite.testTeamID,
+		ProjectID:     suite.testProjectID,
+		EnvironmentID: suite.testEnvironmentID,
+		Protected:     utils.ToPtr(true),
+	}
+
+	updatedEnvironment := &ent.Environment{
+		ID:               suite.testEnvironmentID,
+		Name:             "Test Environment",
+		KubernetesName:   "test-environment",
+		KubernetesSecret: "test-env-secret",
+		ProjectID:        suite.testProjectID,
+		Protected:        true,
+	}
+
+	// Changing protection requires admin
+	suite.MockPermissionsRepo.EXPECT().
+		Check(suite.Ctx, suite.testUserID, mock.MatchedBy(func(checks []permissions_repo.PermissionCheck) bool {
+			return len(checks) == 1 &&
+				checks[0].Action == schema.ActionAdmin &&
+				checks[0].ResourceType == schema.ResourceTypeEnvironment &&
+				checks[0].ResourceID == suite.testEnvironmentID
+		})).
+		Return(nil).
+		Once()
+
+	// VerifyInputs calls
+	suite.MockTeamRepo.EXPECT().
+		GetByID(suite.Ctx, suite.testTeamID).
+		Return(suite.testTeam, nil).
+		Once()
+
+	// Update environment
+	suite.MockEnvironmentRepo.EXPECT().
+		Update(suite.Ctx, suite.testEnvironmentID, (*string)(nil), (*string)(nil), input.Protected, (*[]schema.FreezeWindow)(nil)).
+		Return(updatedEnvironment, nil).
+		Once()
+
+	// SummarizeServices call
+	suite.MockServiceRepo.EXPECT().
+		SummarizeServices(suite.Ctx, []uuid.UUID{suite.testEnvironmentID}).
+		Return(map[uuid.UUID]int{}, map[uuid.UUID][]string{}, nil).
+		Once()
+
+	// Execute
+	result, err := suite.service.UpdateEnvironment(suite.Ctx, suite.testUserID, input)
+
+	// Assert
+	suite.NoError(err)
+	suite.NotNil(result)
+	suite.True(result.Protected)
+}
+
+func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_InvalidFreezeWindow() {
+	input := &UpdateEnvironmentInput{
+		TeamID:        suite.testTeamID,
+		ProjectID:     suite.testProjectID,
+		EnvironmentID: suite.testEnvironmentID,
+		FreezeWindows: &[]schema.FreezeWindow{
+			{
+				Name:     "Missing duration",
+				Schedule: utils.ToPtr("0 0 * * *"),
+			},
+		},
+	}
+
+	// Changing freezes requires admin
+	suite.MockPermissionsRepo.EXPECT().
+		Check(suite.Ctx, suite.testUserID, mock.MatchedBy(func(checks []permissions_repo.PermissionCheck) bool {
+			return len(checks) == 1 && checks[0].Action == schema.ActionAdmin
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	result, err := suite.service.UpdateEnvironment(suite.Ctx, suite.testUserID, input)
+
+	// Assert
+	suite.Error(err)
+	suite.Nil(result)
+	suite.ErrorIs(err, errdefs.ErrInvalidInput)
+}
+
+func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_FreezeScheduleNeverRuns() {
+	input := &UpdateEnvironmentInput{
+		TeamID:        suite.testTeamID,
+		ProjectID:     suite.testProjectID,
+		EnvironmentID: suite.testEnvironmentID,
+		FreezeWindows: &[]schema.FreezeWindow{
+			{
+				Name:            "February 30th",
+				Schedule:        utils.ToPtr("0 0 30 2 *"),
+				DurationMinutes: 60,
+			},
+		},
+	}
+
+	suite.MockPermissionsRepo.EXPECT().
+		Check(suite.Ctx, suite.testUserID, mock.Anything).
+		Return(nil).
+		Once()
+
+	// Execute
+	result, err := suite.service.UpdateEnvironment(suite.Ctx, suite.testUserID, input)
+
+	// Assert
+	suite.Error(err)
+	suite.Nil(result)
+	suite.ErrorIs(err, errdefs.ErrInvalidInput)
+
+	// Windows saved before validation existed must not freeze the environment forever
+	suite.Nil(schema.FrozenUntil(*input.FreezeWindows, time.Now()))
+}
+
 func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_PermissionDenied() {
 	input := &UpdateEnvironmentInput{
 		TeamID:        suite.testTeamID,
@@ -395,7 +512,7 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_UpdateFails() {
 
 	// Update environment fails
 	suite.MockEnvironmentRepo.EXPECT().
-		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description).
+		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description, (*bool)(nil), (*[]schema.FreezeWindow)(nil)).
 		Return(nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Update failed")).
 		Once()
 
@@ -440,7 +557,7 @@ func (suite *UpdateEnvironmentSuite) TestUpdateEnvironment_ServiceSummaryFails()
 
 	// Update environment
 	suite.MockEnvironmentRepo.EXPECT().
-		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description).
+		Update(suite.Ctx, suite.testEnvironmentID, input.Name, input.Description, (*bool)(nil), (*[]schema.FreezeWindow)(nil)).
 		Return(updatedEnvironment, nil).
 		Once()
 
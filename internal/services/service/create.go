@@ -14,6 +14,7 @@ import (
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
 	"github.com/unbindapp/unbind-api/internal/common/log"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
 	"github.com/unbindapp/unbind-api/internal/models"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
@@ -40,15 +41,65 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 	var dbVersion *string
 	var protectedVariables *[]string
 
+	if input.Type != schema.ServiceTypeDockerimage && input.ImageAutoUpdate != nil && *input.ImageAutoUpdate {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Image auto-update is only supported for docker image services")
+	}
+
+	if err := utils.ValidateDockerBuildArgs(input.DockerBuilderBuildArgs); err != nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+	}
+	if err := utils.ValidateDockerBuildContexts(input.DockerBuilderBuildContexts); err != nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+	}
+	input.Platforms, err = utils.NormalizePlatforms(input.Platforms)
+	if err != nil {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+	}
+
+	// Without a builder the source analysis picks one, railpack if there's nothing to analyze
+	builderChosen := input.Builder != ""
+	if !builderChosen {
+		input.Builder = schema.ServiceBuilderRailpack
+	}
+
+	// Blue-green and canary run a second copy of the service next to the current one, it can't share its volumes
+	if input.RolloutStrategy != nil && *input.RolloutStrategy != schema.RolloutStrategyRolling && len(input.Volumes) > 0 {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Blue-green and canary rollouts are not supported for services with volumes")
+	}
+
 	switch input.Type {
-	case schema.ServiceTypeGithub:
+	case schema.ServiceTypeGithub, schema.ServiceTypeGitlab, schema.ServiceTypeGit:
 		// Validate that if GitHub info is provided, all fields are set
-		if input.GitHubInstallationID != nil {
+		if input.Type == schema.ServiceTypeGithub && input.GitHubInstallationID != nil {
 			if input.RepositoryOwner == nil || input.RepositoryName == nil {
 				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput,
 					"GitHub repository owner, name must be provided together")
 			}
 		}
+		if input.Type == schema.ServiceTypeGitlab {
+			if input.GitlabConnectionID == nil || input.RepositoryOwner == nil || input.RepositoryName == nil {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput,
+					"GitLab connection, repository owner and name must be provided")
+			}
+		}
+		if input.Type == schema.ServiceTypeGit {
+			if input.GitURL == nil {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Git URL must be provided")
+			}
+			if _, err := gitssh.ParseURL(*input.GitURL); err != nil {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+			}
+		}
+		for _, watchPath := range input.WatchPaths {
+			if !utils.IsValidGlobPattern(watchPath) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Invalid watch path %s", watchPath))
+			}
+		}
+		for _, author := range input.IgnoredCommitAuthors {
+			if !utils.IsValidCommitAuthorPattern(author) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Invalid ignored commit author %s", author))
+			}
+		}
 	case schema.ServiceTypeDockerimage:
 		// Validate that if Docker image is provided, all fields are set
 		if input.Image == nil {
@@ -56,6 +107,16 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 				"Docker image must be provided")
 		}
 		input.Builder = schema.ServiceBuilderDocker
+	case schema.ServiceTypeUpload:
+		// Archives are kept in the team's S3 storage, builds download them from there
+		if input.UploadS3SourceID == nil || input.UploadS3Bucket == nil || *input.UploadS3Bucket == "" {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput,
+				"S3 source and bucket for uploads must be provided")
+		}
+		if input.Builder == schema.ServiceBuilderDatabase {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput,
+				"Upload services must be built with railpack, docker or buildpacks")
+		}
 	case schema.ServiceTypeDatabase:
 		// Fixed protected variables for databases
 		protectedVariables = &[]string{
@@ -183,6 +244,8 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 
 	// Git integrations
 	var gitOwnerName *string
+	var gitRepositoryName = input.RepositoryName
+	var gitSSHHostKey, gitDeployPublicKey, gitDeployPrivateKey, gitWebhookSecret *string
 
 	// If GitHub integration is provided, verify repository access
 	var analysisResult *sourceanalyzer.AnalysisResult
@@ -228,6 +291,87 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 			log.Error("Error analyzing source code", "err", err)
 			return nil, err
 		}
+	} else if input.Type == schema.ServiceTypeGitlab {
+		connection, err := self.repo.Gitlab().GetConnectionByID(ctx, *input.GitlabConnectionID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "GitLab connection not found")
+			}
+			return nil, err
+		}
+		// GitLab namespaces are nested groups, store the full path
+		gitOwnerName = input.RepositoryOwner
+
+		// Verify project access
+		canAccess, cloneURL, defaultBranch, err := self.gitlabClient.VerifyProjectAccess(ctx, connection, *input.RepositoryOwner, *input.RepositoryName)
+		if err != nil {
+			log.Error("Error verifying project access", "err", err)
+			return nil, err
+		}
+
+		if !canAccess {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput,
+				"Project not accessible with the specified GitLab connection")
+		}
+		gitBranch = utils.ToPtr(defaultBranch)
+
+		accessToken, err := self.gitlabClient.GetAccessToken(ctx, connection)
+		if err != nil {
+			log.Error("Error getting gitlab access token", "err", err)
+			return nil, err
+		}
+
+		// Clone repository to infer information
+		tmpDir, err := self.gitlabClient.CloneRepository(ctx, accessToken, cloneURL, fmt.Sprintf("refs/heads/%s", defaultBranch), "")
+		if err != nil {
+			log.Error("Error cloning repository", "err", err)
+			return nil, err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		// Perform analysis
+		analysisResult, err = sourceanalyzer.AnalyzeSourceCode(tmpDir)
+		if err != nil {
+			log.Error("Error analyzing source code", "err", err)
+			return nil, err
+		}
+
+		// Register the push webhook auto-deploys come from
+		if err := self.gitlabClient.EnsureProjectHook(ctx, connection, *input.RepositoryOwner, *input.RepositoryName); err != nil {
+			log.Error("Error registering gitlab project hook", "err", err)
+			return nil, err
+		}
+	} else if input.Type == schema.ServiceTypeGit {
+		endpoint, _ := gitssh.ParseURL(*input.GitURL)
+		gitRepositoryName = utils.ToPtr(gitssh.RepositoryName(endpoint))
+		gitBranch = input.GitBranch
+		if gitBranch == nil {
+			gitBranch = utils.ToPtr("main")
+		}
+
+		// Pin the server's host key now, builds refuse any other
+		hostKey, err := gitssh.ScanHostKey(ctx, endpoint)
+		if err != nil {
+			log.Error("Error scanning git host key", "err", err, "url", *input.GitURL)
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Could not reach git server: %v", err))
+		}
+		gitSSHHostKey = utils.ToPtr(hostKey)
+
+		// The repository can't be cloned until the user adds the deploy key, so there's nothing to analyze yet
+		privateKey, publicKey, err := gitssh.GenerateDeployKey("unbind-" + input.Name)
+		if err != nil {
+			log.Error("Error generating deploy key", "err", err)
+			return nil, err
+		}
+		gitDeployPrivateKey = utils.ToPtr(privateKey)
+		gitDeployPublicKey = utils.ToPtr(publicKey)
+
+		webhookSecret, err := utils.GenerateSecurePassword(32, true)
+		if err != nil {
+			log.Error("Error generating webhook secret", "err", err)
+			return nil, err
+		}
+		gitWebhookSecret = utils.ToPtr(webhookSecret)
 	} else if input.Type == schema.ServiceTypeDockerimage && len(input.Ports) == 0 {
 		// Detect ports from image
 		ports, _ := utils.GetExposedPortsFromRegistry(*input.Image)
@@ -284,6 +428,21 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 		}
 	}
 
+	// Verify upload storage, there's no source to analyze until the first upload
+	if input.Type == schema.ServiceTypeUpload {
+		s3Source, err := self.repo.S3().GetByID(ctx, *input.UploadS3SourceID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "S3 source not found")
+			}
+			return nil, err
+		}
+
+		if err := self.verifyS3Access(ctx, s3Source, *input.UploadS3Bucket, project.Edges.Team.Namespace, client); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create service and config in a transaction
 	var service *ent.Service
 	var serviceConfig *ent.ServiceConfig
@@ -302,6 +461,10 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 			if analysisResult.Framework != enum.UnknownFramework {
 				framework = utils.ToPtr(analysisResult.Framework)
 			}
+			// Railpack can't build what it doesn't recognize, buildpacks might
+			if !builderChosen && analysisResult.SuggestedBuilder != "" {
+				input.Builder = analysisResult.SuggestedBuilder
+			}
 
 			// Default configuration information
 			if len(ports) == 0 && analysisResult.Port != nil {
@@ -375,6 +538,18 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 		if err != nil {
 			return fmt.Errorf("failed to create secret: %v", err)
 		}
+		// The deploy key gets its own secret, the service secret is exposed to the service and its builds
+		if gitDeployPrivateKey != nil {
+			deployKeySecret, _, err := self.k8s.GetOrCreateSecret(ctx, gitssh.DeployKeySecretName(secret.Name), project.Edges.Team.Namespace, client)
+			if err != nil {
+				return fmt.Errorf("failed to create deploy key secret: %v", err)
+			}
+			if _, err := self.k8s.UpsertSecretValues(ctx, deployKeySecret.Name, project.Edges.Team.Namespace, map[string][]byte{
+				gitssh.DeployKeySecretKey: []byte(*gitDeployPrivateKey),
+			}, client); err != nil {
+				return fmt.Errorf("failed to create deploy key secret: %v", err)
+			}
+		}
 
 		// Set detected ports
 		var detectedPorts []schema.PortSpec
@@ -391,8 +566,13 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 				Description:          input.Description,
 				EnvironmentID:        input.EnvironmentID,
 				GitHubInstallationID: input.GitHubInstallationID,
-				GitRepository:        input.RepositoryName,
+				GitlabConnectionID:   input.GitlabConnectionID,
+				GitRepository:        gitRepositoryName,
 				GitRepositoryOwner:   gitOwnerName,
+				GitURL:               input.GitURL,
+				GitDeployPublicKey:   gitDeployPublicKey,
+				GitSSHHostKey:        gitSSHHostKey,
+				GitWebhookSecret:     gitWebhookSecret,
 				KubernetesSecret:     secret.Name,
 				Database:             input.DatabaseType,
 				DatabaseVersion:      dbVersion,
@@ -415,23 +595,40 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 			Provider:                      provider,
 			Framework:                     framework,
 			GitBranch:                     gitBranch,
+			WatchPaths:                    &input.WatchPaths,
+			SkipDeployMarker:              input.SkipDeployMarker,
+			IgnoredCommitAuthors:          &input.IgnoredCommitAuthors,
 			OverwritePorts:                ports,
 			OverwriteHosts:                hosts,
 			Replicas:                      input.Replicas,
 			AutoDeploy:                    input.AutoDeploy,
+			AutoRollback:                  input.AutoRollback,
+			PrPreviews:                    input.PrPreviews,
+			PrPreviewVariables:            &input.PrPreviewVariables,
 			RailpackBuilderInstallCommand: input.RailpackBuilderInstallCommand,
 			RailpackBuilderBuildCommand:   input.RailpackBuilderBuildCommand,
 			RunCommand:                    input.RunCommand,
+			PreDeployCommand:              input.PreDeployCommand,
+			RolloutStrategy:               input.RolloutStrategy,
+			CanaryWeight:                  input.CanaryWeight,
 			Public:                        isPublic,
 			Image:                         input.Image,
+			ImageAutoUpdate:               input.ImageAutoUpdate,
 			DockerBuilderDockerfilePath:   input.DockerBuilderDockerfilePath,
 			DockerBuilderBuildContext:     input.DockerBuilderBuildContext,
+			DockerBuilderTarget:           input.DockerBuilderTarget,
+			DockerBuilderBuildArgs:        &input.DockerBuilderBuildArgs,
+			DockerBuilderBuildContexts:    &input.DockerBuilderBuildContexts,
+			BuildpacksBuilderImage:        input.BuildpacksBuilderImage,
+			Platforms:                     &input.Platforms,
 			CustomDefinitionVersion:       utils.ToPtr(self.cfg.UnbindServiceDefVersion),
 			DatabaseConfig:                input.DatabaseConfig,
 			S3BackupSourceID:              input.S3BackupSourceID,
 			S3BackupBucket:                input.S3BackupBucket,
 			BackupSchedule:                input.BackupSchedule,
 			BackupRetentionCount:          input.BackupRetentionCount,
+			UploadS3SourceID:              input.UploadS3SourceID,
+			UploadS3Bucket:                input.UploadS3Bucket,
 			OverwriteVolumes:              input.Volumes,
 			HealthCheck:                   input.HealthCheck,
 			OverwriteVariableMounts:       input.VariableMounts,
@@ -519,6 +716,10 @@ func (self *ServiceService) CreateService(ctx context.Context, requesterUserID u
 
 	// Convert to response
 	resp := models.TransformServiceEntity(service)
+	self.attachGitWebhookURL(ctx, requesterUserID, resp, service)
+	if analysisResult != nil && input.Builder == schema.ServiceBuilderRailpack && analysisResult.SuggestedBuilder != input.Builder {
+		resp.SuggestedBuilder = utils.ToPtr(analysisResult.SuggestedBuilder)
+	}
 
 	// Attach volumes
 	if volume, ok := volumeMap[service.ID]; ok {
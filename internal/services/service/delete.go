@@ -10,9 +10,11 @@ import (
 	"github.com/unbindapp/unbind-api/internal/common/errdefs"
 	"github.com/unbindapp/unbind-api/internal/common/log"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
 	webhooks_service "github.com/unbindapp/unbind-api/internal/services/webooks"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func (self *ServiceService) DeleteServiceByID(ctx context.Context, requesterUserID uuid.UUID, bearerToken string, teamID, projectID, environmentID, serviceID uuid.UUID) error {
@@ -69,6 +71,13 @@ func (self *ServiceService) DeleteServiceByID(ctx context.Context, requesterUser
 			log.Error("Error deleting secret from k8s", "secret", service.KubernetesSecret, "err", err)
 			return err
 		}
+		if service.Type == schema.ServiceTypeGit {
+			deployKeySecret := gitssh.DeployKeySecretName(service.KubernetesSecret)
+			if err := self.k8s.DeleteSecret(ctx, deployKeySecret, team.Namespace, client); err != nil && !apierrors.IsNotFound(err) {
+				log.Error("Error deleting deploy key secret from k8s", "secret", deployKeySecret, "err", err)
+				return err
+			}
+		}
 
 		if err := self.repo.Service().Delete(ctx, tx, serviceID); err != nil {
 			return err
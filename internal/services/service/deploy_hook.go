@@ -0,0 +1,90 @@
+package service_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/errdefs"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// Length of generated deploy hook tokens
+const deployHookTokenLength = 40
+
+// RotateDeployHook generates a new deploy hook token for the service, the previous URL stops working
+// Only a hash of the token is stored, the URL can't be retrieved again
+func (self *ServiceService) RotateDeployHook(ctx context.Context, requesterUserID uuid.UUID, teamID, projectID, environmentID, serviceID uuid.UUID) (*models.DeployHookResponse, error) {
+	if _, err := self.getDeployHookService(ctx, requesterUserID, teamID, projectID, environmentID, serviceID); err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GenerateSecurePassword(deployHookTokenLength, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := self.repo.Service().SetDeployHookToken(ctx, nil, serviceID, &token); err != nil {
+		return nil, err
+	}
+
+	deployHookURL, err := self.deployHookURL(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DeployHookResponse{
+		ServiceID: serviceID,
+		URL:       deployHookURL,
+	}, nil
+}
+
+// RevokeDeployHook removes the service's deploy hook token
+func (self *ServiceService) RevokeDeployHook(ctx context.Context, requesterUserID uuid.UUID, teamID, projectID, environmentID, serviceID uuid.UUID) error {
+	if _, err := self.getDeployHookService(ctx, requesterUserID, teamID, projectID, environmentID, serviceID); err != nil {
+		return err
+	}
+
+	return self.repo.Service().SetDeployHookToken(ctx, nil, serviceID, nil)
+}
+
+// getDeployHookService checks the requester can deploy the service, editors can deploy so they can manage its deploy hook
+func (self *ServiceService) getDeployHookService(ctx context.Context, requesterUserID uuid.UUID, teamID, projectID, environmentID, serviceID uuid.UUID) (*ent.Service, error) {
+	permissionChecks := []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   serviceID,
+		},
+	}
+
+	if err := self.repo.Permissions().Check(ctx, requesterUserID, permissionChecks); err != nil {
+		return nil, err
+	}
+
+	env, _, err := self.VerifyInputs(ctx, teamID, projectID, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := self.repo.Service().GetByID(ctx, serviceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Service not found")
+		}
+		return nil, err
+	}
+
+	if env.ID != service.EnvironmentID {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "Service not found")
+	}
+
+	return service, nil
+}
+
+func (self *ServiceService) deployHookURL(token string) (string, error) {
+	return utils.JoinURLPaths(self.cfg.ExternalAPIURL, "webhook/deploy", token)
+}
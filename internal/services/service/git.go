@@ -0,0 +1,44 @@
+package service_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/unbindapp/unbind-api/ent"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
+	"github.com/unbindapp/unbind-api/internal/models"
+	permissions_repo "github.com/unbindapp/unbind-api/internal/repositories/permissions"
+)
+
+// attachGitWebhookURL sets the signed webhook URL of git services on their responses
+// Anyone with the URL can trigger deployments, so only editors of the service get it
+func (self *ServiceService) attachGitWebhookURL(ctx context.Context, requesterUserID uuid.UUID, resp *models.ServiceResponse, service *ent.Service) {
+	if service.GitWebhookSecret == nil {
+		return
+	}
+
+	if err := self.repo.Permissions().Check(ctx, requesterUserID, []permissions_repo.PermissionCheck{
+		{
+			Action:       schema.ActionEditor,
+			ResourceType: schema.ResourceTypeService,
+			ResourceID:   service.ID,
+		},
+	}); err != nil {
+		return
+	}
+
+	webhookURL, err := utils.JoinURLPaths(
+		self.cfg.ExternalAPIURL,
+		"webhook/git",
+		service.ID.String(),
+		gitssh.SignWebhook(*service.GitWebhookSecret, service.ID.String()),
+	)
+	if err != nil {
+		log.Error("Error building git webhook URL", "err", err, "service_id", service.ID)
+		return
+	}
+	resp.GitWebhookURL = &webhookURL
+}
@@ -48,6 +48,9 @@ func (self *ServiceService) GetServicesInEnvironment(ctx context.Context, reques
 
 	// Convert to response
 	resp := models.TransformServiceEntities(services)
+	for i := range resp {
+		self.attachGitWebhookURL(ctx, requesterUserID, resp[i], services[i])
+	}
 
 	// Attach volumes
 	if len(volumeMap) > 0 {
@@ -113,6 +116,7 @@ func (self *ServiceService) GetServiceByID(ctx context.Context, requesterUserID
 
 	// Convert to response
 	resp := models.TransformServiceEntity(service)
+	self.attachGitWebhookURL(ctx, requesterUserID, resp, service)
 
 	// Attach volumes
 	volumes := volumeMap[service.ID]
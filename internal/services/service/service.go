@@ -16,6 +16,7 @@ import (
 	"github.com/unbindapp/unbind-api/internal/infrastructure/prometheus"
 	"github.com/unbindapp/unbind-api/internal/infrastructure/s3"
 	"github.com/unbindapp/unbind-api/internal/integrations/github"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
 	"github.com/unbindapp/unbind-api/internal/models"
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 	"github.com/unbindapp/unbind-api/internal/repositories/repositories"
@@ -31,6 +32,7 @@ type ServiceService struct {
 	cfg                  *config.Config
 	repo                 repositories.RepositoriesInterface
 	githubClient         *github.GithubClient
+	gitlabClient         *gitlab.GitlabClient
 	k8s                  k8s.KubeClientInterface
 	deploymentController *deployctl.DeploymentController
 	dbProvider           *databases.DatabaseProvider
@@ -43,6 +45,7 @@ type ServiceService struct {
 func NewServiceService(cfg *config.Config,
 	repo repositories.RepositoriesInterface,
 	githubClient *github.GithubClient,
+	gitlabClient *gitlab.GitlabClient,
 	k8s k8s.KubeClientInterface,
 	deploymentController *deployctl.DeploymentController,
 	dbProvider *databases.DatabaseProvider,
@@ -54,6 +57,7 @@ func NewServiceService(cfg *config.Config,
 		cfg:                  cfg,
 		repo:                 repo,
 		githubClient:         githubClient,
+		gitlabClient:         gitlabClient,
 		k8s:                  k8s,
 		deploymentController: deploymentController,
 		dbProvider:           dbProvider,
@@ -3,6 +3,9 @@ package service_service
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/unbindapp/unbind-api/ent"
@@ -26,6 +29,37 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Invalid git tag")
 		}
 	}
+	if input.WatchPaths != nil {
+		for _, watchPath := range *input.WatchPaths {
+			if !utils.IsValidGlobPattern(watchPath) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Invalid watch path %s", watchPath))
+			}
+		}
+	}
+	if input.IgnoredCommitAuthors != nil {
+		for _, author := range *input.IgnoredCommitAuthors {
+			if !utils.IsValidCommitAuthorPattern(author) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, fmt.Sprintf("Invalid ignored commit author %s", author))
+			}
+		}
+	}
+	if input.DockerBuilderBuildArgs != nil {
+		if err := utils.ValidateDockerBuildArgs(*input.DockerBuilderBuildArgs); err != nil {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+		}
+	}
+	if input.DockerBuilderBuildContexts != nil {
+		if err := utils.ValidateDockerBuildContexts(*input.DockerBuilderBuildContexts); err != nil {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+		}
+	}
+	if input.Platforms != nil {
+		platforms, err := utils.NormalizePlatforms(*input.Platforms)
+		if err != nil {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, err.Error())
+		}
+		input.Platforms = &platforms
+	}
 
 	// Check permissions
 	permissionChecks := []permissions_repo.PermissionCheck{
@@ -62,6 +96,24 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 		}
 	}
 
+	if service.Type != schema.ServiceTypeDockerimage && input.ImageAutoUpdate != nil && *input.ImageAutoUpdate {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Image auto-update is only supported for docker image services")
+	}
+
+	if input.UploadS3SourceID != nil || input.UploadS3Bucket != nil {
+		if service.Type != schema.ServiceTypeUpload {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Upload storage is only supported for upload services")
+		}
+		// Uploads always need somewhere to go, the store can be moved but not removed
+		if input.UploadS3SourceID == nil || *input.UploadS3SourceID == uuid.Nil || input.UploadS3Bucket == nil || *input.UploadS3Bucket == "" {
+			return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "S3 source and bucket for uploads must be provided together")
+		}
+	}
+
+	if service.Type == schema.ServiceTypeUpload && input.Builder != nil && *input.Builder == schema.ServiceBuilderDatabase {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Upload services must be built with railpack, docker or buildpacks")
+	}
+
 	// For database we don't want to set ports
 	if service.Type == schema.ServiceTypeDatabase {
 		input.OverwritePorts = nil
@@ -92,6 +144,15 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 		}
 	}
 
+	// Blue-green and canary run a second copy of the service next to the current one, it can't share its volumes
+	rolloutStrategy := service.Edges.ServiceConfig.RolloutStrategy
+	if input.RolloutStrategy != nil {
+		rolloutStrategy = *input.RolloutStrategy
+	}
+	if rolloutStrategy != schema.RolloutStrategyRolling && hasVolumesAfterUpdate(service.Edges.ServiceConfig.Volumes, input) {
+		return nil, errdefs.NewCustomError(errdefs.ErrTypeInvalidInput, "Blue-green and canary rollouts are not supported for services with volumes")
+	}
+
 	// For database we can't set version if deployed
 	if service.Type == schema.ServiceTypeDatabase && input.DatabaseConfig != nil && service.DatabaseVersion != nil {
 		hasDeployment := len(service.Edges.Deployments) > 0
@@ -178,6 +239,21 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 		}
 	}
 
+	// Verify upload storage
+	if input.UploadS3SourceID != nil {
+		s3Source, err := self.repo.S3().GetByID(ctx, *input.UploadS3SourceID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errdefs.NewCustomError(errdefs.ErrTypeNotFound, "S3 source not found")
+			}
+			return nil, err
+		}
+
+		if err := self.verifyS3Access(ctx, s3Source, *input.UploadS3Bucket, service.Edges.Environment.Edges.Project.Edges.Team.Namespace, client); err != nil {
+			return nil, err
+		}
+	}
+
 	// Force build if certain things change
 	forceBuild := false
 	if input.RailpackBuilderInstallCommand != nil {
@@ -190,6 +266,25 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			forceBuild = true
 		}
 	}
+	if input.DockerBuilderTarget != nil {
+		if service.Edges.ServiceConfig.DockerBuilderTarget == nil || (*input.DockerBuilderTarget != *service.Edges.ServiceConfig.DockerBuilderTarget) {
+			forceBuild = true
+		}
+	}
+	if input.DockerBuilderBuildArgs != nil && !maps.Equal(*input.DockerBuilderBuildArgs, service.Edges.ServiceConfig.DockerBuilderBuildArgs) {
+		forceBuild = true
+	}
+	if input.DockerBuilderBuildContexts != nil && !maps.Equal(*input.DockerBuilderBuildContexts, service.Edges.ServiceConfig.DockerBuilderBuildContexts) {
+		forceBuild = true
+	}
+	if input.BuildpacksBuilderImage != nil {
+		if service.Edges.ServiceConfig.BuildpacksBuilderImage == nil || (*input.BuildpacksBuilderImage != *service.Edges.ServiceConfig.BuildpacksBuilderImage) {
+			forceBuild = true
+		}
+	}
+	if input.Platforms != nil && !slices.Equal(*input.Platforms, service.Edges.ServiceConfig.Platforms) {
+		forceBuild = true
+	}
 
 	if err := self.repo.WithTx(ctx, func(tx repository.TxInterface) error {
 		// Update the service
@@ -314,6 +409,9 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			Builder:                       input.Builder,
 			GitBranch:                     input.GitBranch,
 			GitTag:                        input.GitTag,
+			WatchPaths:                    input.WatchPaths,
+			SkipDeployMarker:              input.SkipDeployMarker,
+			IgnoredCommitAuthors:          input.IgnoredCommitAuthors,
 			AddPorts:                      input.AddPorts,
 			RemovePorts:                   input.RemovePorts,
 			OverwritePorts:                input.OverwritePorts,
@@ -322,18 +420,32 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			RemoveHosts:                   input.RemoveHosts,
 			Replicas:                      input.Replicas,
 			AutoDeploy:                    input.AutoDeploy,
+			AutoRollback:                  input.AutoRollback,
+			PrPreviews:                    input.PrPreviews,
+			PrPreviewVariables:            input.PrPreviewVariables,
 			RailpackBuilderInstallCommand: input.RailpackBuilderInstallCommand,
 			RailpackBuilderBuildCommand:   input.RailpackBuilderBuildCommand,
 			RunCommand:                    input.RunCommand,
+			PreDeployCommand:              input.PreDeployCommand,
+			RolloutStrategy:               input.RolloutStrategy,
+			CanaryWeight:                  input.CanaryWeight,
 			Public:                        input.IsPublic,
 			Image:                         input.Image,
+			ImageAutoUpdate:               input.ImageAutoUpdate,
 			DockerBuilderDockerfilePath:   input.DockerBuilderDockerfilePath,
 			DockerBuilderBuildContext:     input.DockerBuilderBuildContext,
+			DockerBuilderTarget:           input.DockerBuilderTarget,
+			DockerBuilderBuildArgs:        input.DockerBuilderBuildArgs,
+			DockerBuilderBuildContexts:    input.DockerBuilderBuildContexts,
+			BuildpacksBuilderImage:        input.BuildpacksBuilderImage,
+			Platforms:                     input.Platforms,
 			DatabaseConfig:                input.DatabaseConfig,
 			S3BackupSourceID:              input.S3BackupSourceID,
 			S3BackupBucket:                input.S3BackupBucket,
 			BackupSchedule:                input.BackupSchedule,
 			BackupRetentionCount:          input.BackupRetentionCount,
+			UploadS3SourceID:              input.UploadS3SourceID,
+			UploadS3Bucket:                input.UploadS3Bucket,
 			OverwriteVolumes:              input.OverwriteVolumes,
 			AddVolumes:                    input.AddVolumes,
 			RemoveVolumes:                 input.RemoveVolumes,
@@ -444,6 +556,13 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			})
 		}
 
+		if input.ImageAutoUpdate != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Image Auto Update",
+				Value: fmt.Sprintf("%t", *input.ImageAutoUpdate),
+			})
+		}
+
 		if input.Replicas != nil {
 			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
 				Name:  "Replicas",
@@ -458,6 +577,20 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			})
 		}
 
+		if input.AutoRollback != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Auto Rollback",
+				Value: fmt.Sprintf("%t", *input.AutoRollback),
+			})
+		}
+
+		if input.PrPreviews != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Pull Request Previews",
+				Value: fmt.Sprintf("%t", *input.PrPreviews),
+			})
+		}
+
 		if input.RunCommand != nil {
 			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
 				Name:  "Run Command",
@@ -465,6 +598,20 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			})
 		}
 
+		if input.PreDeployCommand != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Pre-Deploy Command",
+				Value: *input.PreDeployCommand,
+			})
+		}
+
+		if input.RolloutStrategy != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Rollout Strategy",
+				Value: string(*input.RolloutStrategy),
+			})
+		}
+
 		if input.IsPublic != nil {
 			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
 				Name:  "Public",
@@ -486,6 +633,27 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 			})
 		}
 
+		if input.DockerBuilderTarget != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Dockerfile Target",
+				Value: *input.DockerBuilderTarget,
+			})
+		}
+
+		if input.BuildpacksBuilderImage != nil {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Buildpacks Builder Image",
+				Value: *input.BuildpacksBuilderImage,
+			})
+		}
+
+		if input.Platforms != nil && len(*input.Platforms) > 0 {
+			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
+				Name:  "Platforms",
+				Value: strings.Join(*input.Platforms, ", "),
+			})
+		}
+
 		if len(service.Edges.ServiceConfig.Hosts) > 0 {
 			data.Fields = append(data.Fields, webhooks_service.WebhookDataField{
 				Name:  "Service URL",
@@ -526,3 +694,19 @@ func (self *ServiceService) UpdateService(ctx context.Context, requesterUserID u
 
 	return resp, nil
 }
+
+// hasVolumesAfterUpdate reports whether the service still has volumes once the update is applied, the same way the repository applies it
+func hasVolumesAfterUpdate(existing []schema.ServiceVolume, input *models.UpdateServiceInput) bool {
+	if len(input.OverwriteVolumes) > 0 || len(input.AddVolumes) > 0 {
+		return true
+	}
+
+	for _, volume := range existing {
+		if !slices.ContainsFunc(input.RemoveVolumes, func(removed schema.ServiceVolume) bool {
+			return removed.ID == volume.ID
+		}) {
+			return true
+		}
+	}
+	return false
+}
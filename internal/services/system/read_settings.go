@@ -14,6 +14,7 @@ import (
 type SystemSettingsResponse struct {
 	WildcardDomain    *string                  `json:"wildcard_domain,omitempty" required:"false"`
 	BuildkitSettings  *schema.BuildkitSettings `json:"buildkit_settings,omitempty" required:"false"`
+	BuildSettings     *schema.BuildSettings    `json:"build_settings,omitempty" required:"false"`
 	CanUpdateBuildkit bool                     `json:"can_update_buildkit" doc:"If not externally managed, this indicates if the user can update buildkit settings"`
 }
 
@@ -46,6 +47,7 @@ func (self *SystemService) GetSettings(ctx context.Context, requesterUserID uuid
 	return &SystemSettingsResponse{
 		WildcardDomain:    settings.WildcardBaseURL,
 		BuildkitSettings:  settings.BuildkitSettings,
+		BuildSettings:     settings.BuildSettings,
 		CanUpdateBuildkit: canUpdateBuildkit,
 	}, nil
 }
@@ -67,6 +69,21 @@ func (self *SystemService) UpdateSettings(ctx context.Context, requesterUserID u
 		return nil, err
 	}
 
+	if input.BuildSettings != nil {
+		if input.BuildSettings.MaxConcurrentBuilds < 1 {
+			return nil, errdefs.NewCustomError(
+				errdefs.ErrTypeInvalidInput,
+				"Max concurrent builds must be at least 1",
+			)
+		}
+		if input.BuildSettings.MaxConcurrentBuildsPerTeam < 0 {
+			return nil, errdefs.NewCustomError(
+				errdefs.ErrTypeInvalidInput,
+				"Max concurrent builds per team cannot be negative",
+			)
+		}
+	}
+
 	if input.BuildkitSettings != nil {
 		canUpdateBuildkit := false
 		_, err := self.buildkitManager.GetBuildkitConfig(ctx)
@@ -98,6 +115,7 @@ func (self *SystemService) UpdateSettings(ctx context.Context, requesterUserID u
 	updatedSettings, err := self.repo.System().UpdateSystemSettings(ctx, &system_repo.SystemSettingUpdateInput{
 		WildcardDomain:   input.WildcardDomain,
 		BuildkitSettings: input.BuildkitSettings,
+		BuildSettings:    input.BuildSettings,
 	})
 	if err != nil {
 		log.Errorf("Failed to update buildkit settings in DB: %v", err)
@@ -106,5 +124,6 @@ func (self *SystemService) UpdateSettings(ctx context.Context, requesterUserID u
 	return &SystemSettingsResponse{
 		WildcardDomain:   updatedSettings.WildcardBaseURL,
 		BuildkitSettings: updatedSettings.BuildkitSettings,
+		BuildSettings:    updatedSettings.BuildSettings,
 	}, nil
 }
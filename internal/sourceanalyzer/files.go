@@ -0,0 +1,31 @@
+package sourceanalyzer
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// MaxAnalyzedSourceSize caps how much of an uploaded source archive is extracted to analyze it
+const MaxAnalyzedSourceSize = 64 << 20
+
+// Larger files aren't manifests or source the analyzer learns anything from
+const maxAnalyzedFileSize = 1 << 20
+
+// Dependency and build output directories, the analyzer never reads them
+var skippedDirs = []string{"node_modules", "vendor", "dist", "build", "target", "venv", "__pycache__"}
+
+// IsAnalyzedFile checks if the analyzer may read a file, uploads only extract those to be analyzed
+func IsAnalyzedFile(name string, size int64) bool {
+	if size > maxAnalyzedFileSize {
+		return false
+	}
+
+	parts := strings.Split(path.Clean(name), "/")
+	for _, dir := range parts[:len(parts)-1] {
+		if strings.HasPrefix(dir, ".") || slices.Contains(skippedDirs, dir) {
+			return false
+		}
+	}
+	return true
+}
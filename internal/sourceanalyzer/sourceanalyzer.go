@@ -10,6 +10,7 @@ import (
 	"github.com/railwayapp/railpack/core/generate"
 	"github.com/railwayapp/railpack/core/logger"
 	"github.com/railwayapp/railpack/core/providers"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/log"
 	"github.com/unbindapp/unbind-api/internal/sourceanalyzer/enum"
 	"github.com/unbindapp/unbind-api/internal/sourceanalyzer/portdetector"
@@ -20,6 +21,8 @@ type AnalysisResult struct {
 	Provider  enum.Provider  `json:"provider"` // Railpack provider (node, go, deno, python, java, etc.)
 	Framework enum.Framework `json:"framework"`
 	Port      *int           `json:"port,omitempty"`
+	// Buildpacks when railpack can't tell what the app is
+	SuggestedBuilder schema.ServiceBuilder `json:"suggested_builder"`
 }
 
 func AnalyzeSourceCode(sourceDir string) (*AnalysisResult, error) {
@@ -76,10 +79,16 @@ func AnalyzeSourceCode(sourceDir string) (*AnalysisResult, error) {
 
 	detectedPort, _ := detector.DetectPort()
 
+	suggestedBuilder := schema.ServiceBuilderRailpack
+	if detectedProvider == enum.UnknownProvider {
+		suggestedBuilder = schema.ServiceBuilderBuildpacks
+	}
+
 	return &AnalysisResult{
-		Provider:  detectedProvider,
-		Framework: detectedFramework,
-		Port:      detectedPort,
+		Provider:         detectedProvider,
+		Framework:        detectedFramework,
+		Port:             detectedPort,
+		SuggestedBuilder: suggestedBuilder,
 	}, nil
 }
 
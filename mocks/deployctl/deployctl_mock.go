@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	queue "github.com/unbindapp/unbind-api/internal/infrastructure/queue"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -26,6 +28,113 @@ func (_m *DeploymentControllerMock) EXPECT() *DeploymentControllerMock_Expecter
 	return &DeploymentControllerMock_Expecter{mock: &_m.Mock}
 }
 
+// AbortStagedRollouts provides a mock function with given fields: ctx, serviceID, supersededBy
+func (_m *DeploymentControllerMock) AbortStagedRollouts(ctx context.Context, serviceID uuid.UUID, supersededBy uuid.UUID) error {
+	ret := _m.Called(ctx, serviceID, supersededBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortStagedRollouts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, serviceID, supersededBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentControllerMock_AbortStagedRollouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortStagedRollouts'
+type DeploymentControllerMock_AbortStagedRollouts_Call struct {
+	*mock.Call
+}
+
+// AbortStagedRollouts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceID uuid.UUID
+//   - supersededBy uuid.UUID
+func (_e *DeploymentControllerMock_Expecter) AbortStagedRollouts(ctx interface{}, serviceID interface{}, supersededBy interface{}) *DeploymentControllerMock_AbortStagedRollouts_Call {
+	return &DeploymentControllerMock_AbortStagedRollouts_Call{Call: _e.mock.On("AbortStagedRollouts", ctx, serviceID, supersededBy)}
+}
+
+func (_c *DeploymentControllerMock_AbortStagedRollouts_Call) Run(run func(ctx context.Context, serviceID uuid.UUID, supersededBy uuid.UUID)) *DeploymentControllerMock_AbortStagedRollouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_AbortStagedRollouts_Call) Return(_a0 error) *DeploymentControllerMock_AbortStagedRollouts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_AbortStagedRollouts_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *DeploymentControllerMock_AbortStagedRollouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveDeployment provides a mock function with given fields: ctx, deployment
+func (_m *DeploymentControllerMock) ApproveDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, deployment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveDeployment")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Deployment) (*ent.Deployment, error)); ok {
+		return rf(ctx, deployment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Deployment) *ent.Deployment); ok {
+		r0 = rf(ctx, deployment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Deployment) error); ok {
+		r1 = rf(ctx, deployment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentControllerMock_ApproveDeployment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveDeployment'
+type DeploymentControllerMock_ApproveDeployment_Call struct {
+	*mock.Call
+}
+
+// ApproveDeployment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deployment *ent.Deployment
+func (_e *DeploymentControllerMock_Expecter) ApproveDeployment(ctx interface{}, deployment interface{}) *DeploymentControllerMock_ApproveDeployment_Call {
+	return &DeploymentControllerMock_ApproveDeployment_Call{Call: _e.mock.On("ApproveDeployment", ctx, deployment)}
+}
+
+func (_c *DeploymentControllerMock_ApproveDeployment_Call) Run(run func(ctx context.Context, deployment *ent.Deployment)) *DeploymentControllerMock_ApproveDeployment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.Deployment))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ApproveDeployment_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentControllerMock_ApproveDeployment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ApproveDeployment_Call) RunAndReturn(run func(context.Context, *ent.Deployment) (*ent.Deployment, error)) *DeploymentControllerMock_ApproveDeployment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AreDependenciesReady provides a mock function with given fields: ctx, req
 func (_m *DeploymentControllerMock) AreDependenciesReady(ctx context.Context, req deployctl.DeploymentJobRequest) bool {
 	ret := _m.Called(ctx, req)
@@ -73,6 +182,65 @@ func (_c *DeploymentControllerMock_AreDependenciesReady_Call) RunAndReturn(run f
 	return _c
 }
 
+// CancelDeployment provides a mock function with given fields: ctx, deployment
+func (_m *DeploymentControllerMock) CancelDeployment(ctx context.Context, deployment *ent.Deployment) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, deployment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeployment")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Deployment) (*ent.Deployment, error)); ok {
+		return rf(ctx, deployment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.Deployment) *ent.Deployment); ok {
+		r0 = rf(ctx, deployment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.Deployment) error); ok {
+		r1 = rf(ctx, deployment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentControllerMock_CancelDeployment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeployment'
+type DeploymentControllerMock_CancelDeployment_Call struct {
+	*mock.Call
+}
+
+// CancelDeployment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deployment *ent.Deployment
+func (_e *DeploymentControllerMock_Expecter) CancelDeployment(ctx interface{}, deployment interface{}) *DeploymentControllerMock_CancelDeployment_Call {
+	return &DeploymentControllerMock_CancelDeployment_Call{Call: _e.mock.On("CancelDeployment", ctx, deployment)}
+}
+
+func (_c *DeploymentControllerMock_CancelDeployment_Call) Run(run func(ctx context.Context, deployment *ent.Deployment)) *DeploymentControllerMock_CancelDeployment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.Deployment))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_CancelDeployment_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentControllerMock_CancelDeployment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_CancelDeployment_Call) RunAndReturn(run func(context.Context, *ent.Deployment) (*ent.Deployment, error)) *DeploymentControllerMock_CancelDeployment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelExistingJobs provides a mock function with given fields: ctx, serviceID
 func (_m *DeploymentControllerMock) CancelExistingJobs(ctx context.Context, serviceID uuid.UUID) error {
 	ret := _m.Called(ctx, serviceID)
@@ -238,6 +406,109 @@ func (_c *DeploymentControllerMock_EnqueueDeploymentJob_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetBuildConcurrencyLimits provides a mock function with given fields: ctx
+func (_m *DeploymentControllerMock) GetBuildConcurrencyLimits(ctx context.Context) (queue.ConcurrencyLimits, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBuildConcurrencyLimits")
+	}
+
+	var r0 queue.ConcurrencyLimits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (queue.ConcurrencyLimits, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) queue.ConcurrencyLimits); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(queue.ConcurrencyLimits)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentControllerMock_GetBuildConcurrencyLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBuildConcurrencyLimits'
+type DeploymentControllerMock_GetBuildConcurrencyLimits_Call struct {
+	*mock.Call
+}
+
+// GetBuildConcurrencyLimits is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeploymentControllerMock_Expecter) GetBuildConcurrencyLimits(ctx interface{}) *DeploymentControllerMock_GetBuildConcurrencyLimits_Call {
+	return &DeploymentControllerMock_GetBuildConcurrencyLimits_Call{Call: _e.mock.On("GetBuildConcurrencyLimits", ctx)}
+}
+
+func (_c *DeploymentControllerMock_GetBuildConcurrencyLimits_Call) Run(run func(ctx context.Context)) *DeploymentControllerMock_GetBuildConcurrencyLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_GetBuildConcurrencyLimits_Call) Return(_a0 queue.ConcurrencyLimits, _a1 error) *DeploymentControllerMock_GetBuildConcurrencyLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_GetBuildConcurrencyLimits_Call) RunAndReturn(run func(context.Context) (queue.ConcurrencyLimits, error)) *DeploymentControllerMock_GetBuildConcurrencyLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueuePriority provides a mock function with given fields: ctx, req
+func (_m *DeploymentControllerMock) GetQueuePriority(ctx context.Context, req deployctl.DeploymentJobRequest) int {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueuePriority")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, deployctl.DeploymentJobRequest) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// DeploymentControllerMock_GetQueuePriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueuePriority'
+type DeploymentControllerMock_GetQueuePriority_Call struct {
+	*mock.Call
+}
+
+// GetQueuePriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req deployctl.DeploymentJobRequest
+func (_e *DeploymentControllerMock_Expecter) GetQueuePriority(ctx interface{}, req interface{}) *DeploymentControllerMock_GetQueuePriority_Call {
+	return &DeploymentControllerMock_GetQueuePriority_Call{Call: _e.mock.On("GetQueuePriority", ctx, req)}
+}
+
+func (_c *DeploymentControllerMock_GetQueuePriority_Call) Run(run func(ctx context.Context, req deployctl.DeploymentJobRequest)) *DeploymentControllerMock_GetQueuePriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(deployctl.DeploymentJobRequest))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_GetQueuePriority_Call) Return(_a0 int) *DeploymentControllerMock_GetQueuePriority_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_GetQueuePriority_Call) RunAndReturn(run func(context.Context, deployctl.DeploymentJobRequest) int) *DeploymentControllerMock_GetQueuePriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PopulateBuildEnvironment provides a mock function with given fields: ctx, serviceID, gitTag, deployment
 func (_m *DeploymentControllerMock) PopulateBuildEnvironment(ctx context.Context, serviceID uuid.UUID, gitTag *string, deployment *ent.Deployment) (map[string]string, error) {
 	ret := _m.Called(ctx, serviceID, gitTag, deployment)
@@ -294,7 +565,99 @@ func (_c *DeploymentControllerMock_PopulateBuildEnvironment_Call) Return(_a0 map
 	return _c
 }
 
-func (_c *DeploymentControllerMock_PopulateBuildEnvironment_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string) (map[string]string, error)) *DeploymentControllerMock_PopulateBuildEnvironment_Call {
+func (_c *DeploymentControllerMock_PopulateBuildEnvironment_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string, *ent.Deployment) (map[string]string, error)) *DeploymentControllerMock_PopulateBuildEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseFrozenDeployments provides a mock function with given fields: ctx
+func (_m *DeploymentControllerMock) ReleaseFrozenDeployments(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseFrozenDeployments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentControllerMock_ReleaseFrozenDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseFrozenDeployments'
+type DeploymentControllerMock_ReleaseFrozenDeployments_Call struct {
+	*mock.Call
+}
+
+// ReleaseFrozenDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeploymentControllerMock_Expecter) ReleaseFrozenDeployments(ctx interface{}) *DeploymentControllerMock_ReleaseFrozenDeployments_Call {
+	return &DeploymentControllerMock_ReleaseFrozenDeployments_Call{Call: _e.mock.On("ReleaseFrozenDeployments", ctx)}
+}
+
+func (_c *DeploymentControllerMock_ReleaseFrozenDeployments_Call) Run(run func(ctx context.Context)) *DeploymentControllerMock_ReleaseFrozenDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ReleaseFrozenDeployments_Call) Return(_a0 error) *DeploymentControllerMock_ReleaseFrozenDeployments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ReleaseFrozenDeployments_Call) RunAndReturn(run func(context.Context) error) *DeploymentControllerMock_ReleaseFrozenDeployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseScheduledDeployments provides a mock function with given fields: ctx
+func (_m *DeploymentControllerMock) ReleaseScheduledDeployments(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseScheduledDeployments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentControllerMock_ReleaseScheduledDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseScheduledDeployments'
+type DeploymentControllerMock_ReleaseScheduledDeployments_Call struct {
+	*mock.Call
+}
+
+// ReleaseScheduledDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeploymentControllerMock_Expecter) ReleaseScheduledDeployments(ctx interface{}) *DeploymentControllerMock_ReleaseScheduledDeployments_Call {
+	return &DeploymentControllerMock_ReleaseScheduledDeployments_Call{Call: _e.mock.On("ReleaseScheduledDeployments", ctx)}
+}
+
+func (_c *DeploymentControllerMock_ReleaseScheduledDeployments_Call) Run(run func(ctx context.Context)) *DeploymentControllerMock_ReleaseScheduledDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ReleaseScheduledDeployments_Call) Return(_a0 error) *DeploymentControllerMock_ReleaseScheduledDeployments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentControllerMock_ReleaseScheduledDeployments_Call) RunAndReturn(run func(context.Context) error) *DeploymentControllerMock_ReleaseScheduledDeployments_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -409,6 +772,42 @@ func (_c *DeploymentControllerMock_SyncJobStatuses_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// TriggerRolledBackWebhook provides a mock function with given fields: ctx, service, rollbackDeploymentID, reason
+func (_m *DeploymentControllerMock) TriggerRolledBackWebhook(ctx context.Context, service *ent.Service, rollbackDeploymentID uuid.UUID, reason string) {
+	_m.Called(ctx, service, rollbackDeploymentID, reason)
+}
+
+// DeploymentControllerMock_TriggerRolledBackWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerRolledBackWebhook'
+type DeploymentControllerMock_TriggerRolledBackWebhook_Call struct {
+	*mock.Call
+}
+
+// TriggerRolledBackWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - service *ent.Service
+//   - rollbackDeploymentID uuid.UUID
+//   - reason string
+func (_e *DeploymentControllerMock_Expecter) TriggerRolledBackWebhook(ctx interface{}, service interface{}, rollbackDeploymentID interface{}, reason interface{}) *DeploymentControllerMock_TriggerRolledBackWebhook_Call {
+	return &DeploymentControllerMock_TriggerRolledBackWebhook_Call{Call: _e.mock.On("TriggerRolledBackWebhook", ctx, service, rollbackDeploymentID, reason)}
+}
+
+func (_c *DeploymentControllerMock_TriggerRolledBackWebhook_Call) Run(run func(ctx context.Context, service *ent.Service, rollbackDeploymentID uuid.UUID, reason string)) *DeploymentControllerMock_TriggerRolledBackWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.Service), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DeploymentControllerMock_TriggerRolledBackWebhook_Call) Return() *DeploymentControllerMock_TriggerRolledBackWebhook_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DeploymentControllerMock_TriggerRolledBackWebhook_Call) RunAndReturn(run func(context.Context, *ent.Service, uuid.UUID, string)) *DeploymentControllerMock_TriggerRolledBackWebhook_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewDeploymentControllerMock creates a new instance of DeploymentControllerMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDeploymentControllerMock(t interface {
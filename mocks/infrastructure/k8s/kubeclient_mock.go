@@ -146,6 +146,53 @@ func (_c *KubeClientMock_AvailableStorageBytes_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CancelDeploymentJob provides a mock function with given fields: ctx, deploymentID
+func (_m *KubeClientMock) CancelDeploymentJob(ctx context.Context, deploymentID string) error {
+	ret := _m.Called(ctx, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeploymentJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, deploymentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubeClientMock_CancelDeploymentJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeploymentJob'
+type KubeClientMock_CancelDeploymentJob_Call struct {
+	*mock.Call
+}
+
+// CancelDeploymentJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentID string
+func (_e *KubeClientMock_Expecter) CancelDeploymentJob(ctx interface{}, deploymentID interface{}) *KubeClientMock_CancelDeploymentJob_Call {
+	return &KubeClientMock_CancelDeploymentJob_Call{Call: _e.mock.On("CancelDeploymentJob", ctx, deploymentID)}
+}
+
+func (_c *KubeClientMock_CancelDeploymentJob_Call) Run(run func(ctx context.Context, deploymentID string)) *KubeClientMock_CancelDeploymentJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_CancelDeploymentJob_Call) Return(_a0 error) *KubeClientMock_CancelDeploymentJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubeClientMock_CancelDeploymentJob_Call) RunAndReturn(run func(context.Context, string) error) *KubeClientMock_CancelDeploymentJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelJobsByServiceID provides a mock function with given fields: ctx, serviceID
 func (_m *KubeClientMock) CancelJobsByServiceID(ctx context.Context, serviceID string) error {
 	ret := _m.Called(ctx, serviceID)
@@ -368,6 +415,64 @@ func (_c *KubeClientMock_CountActiveDeploymentJobs_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CountActiveDeploymentJobsByTeam provides a mock function with given fields: ctx
+func (_m *KubeClientMock) CountActiveDeploymentJobsByTeam(ctx context.Context) (map[string]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountActiveDeploymentJobsByTeam")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubeClientMock_CountActiveDeploymentJobsByTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountActiveDeploymentJobsByTeam'
+type KubeClientMock_CountActiveDeploymentJobsByTeam_Call struct {
+	*mock.Call
+}
+
+// CountActiveDeploymentJobsByTeam is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubeClientMock_Expecter) CountActiveDeploymentJobsByTeam(ctx interface{}) *KubeClientMock_CountActiveDeploymentJobsByTeam_Call {
+	return &KubeClientMock_CountActiveDeploymentJobsByTeam_Call{Call: _e.mock.On("CountActiveDeploymentJobsByTeam", ctx)}
+}
+
+func (_c *KubeClientMock_CountActiveDeploymentJobsByTeam_Call) Run(run func(ctx context.Context)) *KubeClientMock_CountActiveDeploymentJobsByTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_CountActiveDeploymentJobsByTeam_Call) Return(_a0 map[string]int, _a1 error) *KubeClientMock_CountActiveDeploymentJobsByTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubeClientMock_CountActiveDeploymentJobsByTeam_Call) RunAndReturn(run func(context.Context) (map[string]int, error)) *KubeClientMock_CountActiveDeploymentJobsByTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClientWithToken provides a mock function with given fields: token
 func (_m *KubeClientMock) CreateClientWithToken(token string) (kubernetes.Interface, error) {
 	ret := _m.Called(token)
@@ -835,6 +940,54 @@ func (_c *KubeClientMock_DeletePersistentVolumeClaim_Call) RunAndReturn(run func
 	return _c
 }
 
+// DeleteRolloutCandidate provides a mock function with given fields: ctx, namespace, name
+func (_m *KubeClientMock) DeleteRolloutCandidate(ctx context.Context, namespace string, name string) error {
+	ret := _m.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRolloutCandidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubeClientMock_DeleteRolloutCandidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRolloutCandidate'
+type KubeClientMock_DeleteRolloutCandidate_Call struct {
+	*mock.Call
+}
+
+// DeleteRolloutCandidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+func (_e *KubeClientMock_Expecter) DeleteRolloutCandidate(ctx interface{}, namespace interface{}, name interface{}) *KubeClientMock_DeleteRolloutCandidate_Call {
+	return &KubeClientMock_DeleteRolloutCandidate_Call{Call: _e.mock.On("DeleteRolloutCandidate", ctx, namespace, name)}
+}
+
+func (_c *KubeClientMock_DeleteRolloutCandidate_Call) Run(run func(ctx context.Context, namespace string, name string)) *KubeClientMock_DeleteRolloutCandidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_DeleteRolloutCandidate_Call) Return(_a0 error) *KubeClientMock_DeleteRolloutCandidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubeClientMock_DeleteRolloutCandidate_Call) RunAndReturn(run func(context.Context, string, string) error) *KubeClientMock_DeleteRolloutCandidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteSecret provides a mock function with given fields: ctx, name, namespace, client
 func (_m *KubeClientMock) DeleteSecret(ctx context.Context, name string, namespace string, client kubernetes.Interface) error {
 	ret := _m.Called(ctx, name, namespace, client)
@@ -1029,6 +1182,54 @@ func (_c *KubeClientMock_DeleteVerificationIngress_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// DeployRolloutCandidate provides a mock function with given fields: ctx, service, weight
+func (_m *KubeClientMock) DeployRolloutCandidate(ctx context.Context, service *apiv1.Service, weight int) error {
+	ret := _m.Called(ctx, service, weight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeployRolloutCandidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *apiv1.Service, int) error); ok {
+		r0 = rf(ctx, service, weight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubeClientMock_DeployRolloutCandidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployRolloutCandidate'
+type KubeClientMock_DeployRolloutCandidate_Call struct {
+	*mock.Call
+}
+
+// DeployRolloutCandidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - service *apiv1.Service
+//   - weight int
+func (_e *KubeClientMock_Expecter) DeployRolloutCandidate(ctx interface{}, service interface{}, weight interface{}) *KubeClientMock_DeployRolloutCandidate_Call {
+	return &KubeClientMock_DeployRolloutCandidate_Call{Call: _e.mock.On("DeployRolloutCandidate", ctx, service, weight)}
+}
+
+func (_c *KubeClientMock_DeployRolloutCandidate_Call) Run(run func(ctx context.Context, service *apiv1.Service, weight int)) *KubeClientMock_DeployRolloutCandidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*apiv1.Service), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_DeployRolloutCandidate_Call) Return(_a0 error) *KubeClientMock_DeployRolloutCandidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubeClientMock_DeployRolloutCandidate_Call) RunAndReturn(run func(context.Context, *apiv1.Service, int) error) *KubeClientMock_DeployRolloutCandidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeployUnbindService provides a mock function with given fields: ctx, service
 func (_m *KubeClientMock) DeployUnbindService(ctx context.Context, service *apiv1.Service) (*unstructured.Unstructured, *apiv1.Service, error) {
 	ret := _m.Called(ctx, service)
@@ -2478,6 +2679,112 @@ func (_c *KubeClientMock_RollingRestartPodsByLabel_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RunPreDeployJob provides a mock function with given fields: ctx, params
+func (_m *KubeClientMock) RunPreDeployJob(ctx context.Context, params k8s.PreDeployJob) (string, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunPreDeployJob")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, k8s.PreDeployJob) (string, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, k8s.PreDeployJob) string); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, k8s.PreDeployJob) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubeClientMock_RunPreDeployJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunPreDeployJob'
+type KubeClientMock_RunPreDeployJob_Call struct {
+	*mock.Call
+}
+
+// RunPreDeployJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params k8s.PreDeployJob
+func (_e *KubeClientMock_Expecter) RunPreDeployJob(ctx interface{}, params interface{}) *KubeClientMock_RunPreDeployJob_Call {
+	return &KubeClientMock_RunPreDeployJob_Call{Call: _e.mock.On("RunPreDeployJob", ctx, params)}
+}
+
+func (_c *KubeClientMock_RunPreDeployJob_Call) Run(run func(ctx context.Context, params k8s.PreDeployJob)) *KubeClientMock_RunPreDeployJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(k8s.PreDeployJob))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_RunPreDeployJob_Call) Return(_a0 string, _a1 error) *KubeClientMock_RunPreDeployJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubeClientMock_RunPreDeployJob_Call) RunAndReturn(run func(context.Context, k8s.PreDeployJob) (string, error)) *KubeClientMock_RunPreDeployJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCanaryTraffic provides a mock function with given fields: ctx, namespace, name, weight
+func (_m *KubeClientMock) SetCanaryTraffic(ctx context.Context, namespace string, name string, weight int) error {
+	ret := _m.Called(ctx, namespace, name, weight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCanaryTraffic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) error); ok {
+		r0 = rf(ctx, namespace, name, weight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubeClientMock_SetCanaryTraffic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCanaryTraffic'
+type KubeClientMock_SetCanaryTraffic_Call struct {
+	*mock.Call
+}
+
+// SetCanaryTraffic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+//   - weight int
+func (_e *KubeClientMock_Expecter) SetCanaryTraffic(ctx interface{}, namespace interface{}, name interface{}, weight interface{}) *KubeClientMock_SetCanaryTraffic_Call {
+	return &KubeClientMock_SetCanaryTraffic_Call{Call: _e.mock.On("SetCanaryTraffic", ctx, namespace, name, weight)}
+}
+
+func (_c *KubeClientMock_SetCanaryTraffic_Call) Run(run func(ctx context.Context, namespace string, name string, weight int)) *KubeClientMock_SetCanaryTraffic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *KubeClientMock_SetCanaryTraffic_Call) Return(_a0 error) *KubeClientMock_SetCanaryTraffic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubeClientMock_SetCanaryTraffic_Call) RunAndReturn(run func(context.Context, string, string, int) error) *KubeClientMock_SetCanaryTraffic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StreamPodLogs provides a mock function with given fields: ctx, namespace, opts, meta, client, eventChan
 func (_m *KubeClientMock) StreamPodLogs(ctx context.Context, namespace string, opts loki.LokiLogStreamOptions, meta loki.LogMetadata, client kubernetes.Interface, eventChan chan<- loki.LogEvents) error {
 	ret := _m.Called(ctx, namespace, opts, meta, client, eventChan)
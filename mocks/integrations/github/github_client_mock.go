@@ -149,6 +149,69 @@ func (_c *GithubClientMock_CloneRepository_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CompareCommits provides a mock function with given fields: ctx, installation, owner, repo, base, head
+func (_m *GithubClientMock) CompareCommits(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, base string, head string) (*github.GithubCommitComparison, error) {
+	ret := _m.Called(ctx, installation, owner, repo, base, head)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareCommits")
+	}
+
+	var r0 *github.GithubCommitComparison
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, string) (*github.GithubCommitComparison, error)); ok {
+		return rf(ctx, installation, owner, repo, base, head)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, string) *github.GithubCommitComparison); ok {
+		r0 = rf(ctx, installation, owner, repo, base, head)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*github.GithubCommitComparison)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.GithubInstallation, string, string, string, string) error); ok {
+		r1 = rf(ctx, installation, owner, repo, base, head)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GithubClientMock_CompareCommits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareCommits'
+type GithubClientMock_CompareCommits_Call struct {
+	*mock.Call
+}
+
+// CompareCommits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installation *ent.GithubInstallation
+//   - owner string
+//   - repo string
+//   - base string
+//   - head string
+func (_e *GithubClientMock_Expecter) CompareCommits(ctx interface{}, installation interface{}, owner interface{}, repo interface{}, base interface{}, head interface{}) *GithubClientMock_CompareCommits_Call {
+	return &GithubClientMock_CompareCommits_Call{Call: _e.mock.On("CompareCommits", ctx, installation, owner, repo, base, head)}
+}
+
+func (_c *GithubClientMock_CompareCommits_Call) Run(run func(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, base string, head string)) *GithubClientMock_CompareCommits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.GithubInstallation), args[2].(string), args[3].(string), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *GithubClientMock_CompareCommits_Call) Return(_a0 *github.GithubCommitComparison, _a1 error) *GithubClientMock_CompareCommits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GithubClientMock_CompareCommits_Call) RunAndReturn(run func(context.Context, *ent.GithubInstallation, string, string, string, string) (*github.GithubCommitComparison, error)) *GithubClientMock_CompareCommits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAppManifest provides a mock function with given fields: redirectUrl, setupUrl, forOrganization
 func (_m *GithubClientMock) CreateAppManifest(redirectUrl string, setupUrl string, forOrganization bool) (*github.GitHubAppManifest, string, error) {
 	ret := _m.Called(redirectUrl, setupUrl, forOrganization)
@@ -277,6 +340,76 @@ func (_c *GithubClientMock_GetAuthenticatedClient_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetChangedFiles provides a mock function with given fields: ctx, installation, owner, repo, base, head
+func (_m *GithubClientMock) GetChangedFiles(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, base string, head string) ([]string, bool, error) {
+	ret := _m.Called(ctx, installation, owner, repo, base, head)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangedFiles")
+	}
+
+	var r0 []string
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, string) ([]string, bool, error)); ok {
+		return rf(ctx, installation, owner, repo, base, head)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, string) []string); ok {
+		r0 = rf(ctx, installation, owner, repo, base, head)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.GithubInstallation, string, string, string, string) bool); ok {
+		r1 = rf(ctx, installation, owner, repo, base, head)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *ent.GithubInstallation, string, string, string, string) error); ok {
+		r2 = rf(ctx, installation, owner, repo, base, head)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GithubClientMock_GetChangedFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangedFiles'
+type GithubClientMock_GetChangedFiles_Call struct {
+	*mock.Call
+}
+
+// GetChangedFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installation *ent.GithubInstallation
+//   - owner string
+//   - repo string
+//   - base string
+//   - head string
+func (_e *GithubClientMock_Expecter) GetChangedFiles(ctx interface{}, installation interface{}, owner interface{}, repo interface{}, base interface{}, head interface{}) *GithubClientMock_GetChangedFiles_Call {
+	return &GithubClientMock_GetChangedFiles_Call{Call: _e.mock.On("GetChangedFiles", ctx, installation, owner, repo, base, head)}
+}
+
+func (_c *GithubClientMock_GetChangedFiles_Call) Run(run func(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, base string, head string)) *GithubClientMock_GetChangedFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.GithubInstallation), args[2].(string), args[3].(string), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *GithubClientMock_GetChangedFiles_Call) Return(_a0 []string, _a1 bool, _a2 error) *GithubClientMock_GetChangedFiles_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *GithubClientMock_GetChangedFiles_Call) RunAndReturn(run func(context.Context, *ent.GithubInstallation, string, string, string, string) ([]string, bool, error)) *GithubClientMock_GetChangedFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCommitSummary provides a mock function with given fields: ctx, installation, owner, repo, branchOrSHA, isCommitSHA
 func (_m *GithubClientMock) GetCommitSummary(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, branchOrSHA string, isCommitSHA bool) (string, string, *schema.GitCommitter, error) {
 	ret := _m.Called(ctx, installation, owner, repo, branchOrSHA, isCommitSHA)
@@ -651,6 +784,130 @@ func (_c *GithubClientMock_ReadUserAdminRepositories_Call) RunAndReturn(run func
 	return _c
 }
 
+// ReportCheckRun provides a mock function with given fields: ctx, installation, owner, repo, headSHA, checkRunID, state
+func (_m *GithubClientMock) ReportCheckRun(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, headSHA string, checkRunID *int64, state github.CheckRunState) (int64, error) {
+	ret := _m.Called(ctx, installation, owner, repo, headSHA, checkRunID, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportCheckRun")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, *int64, github.CheckRunState) (int64, error)); ok {
+		return rf(ctx, installation, owner, repo, headSHA, checkRunID, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, string, *int64, github.CheckRunState) int64); ok {
+		r0 = rf(ctx, installation, owner, repo, headSHA, checkRunID, state)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.GithubInstallation, string, string, string, *int64, github.CheckRunState) error); ok {
+		r1 = rf(ctx, installation, owner, repo, headSHA, checkRunID, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GithubClientMock_ReportCheckRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportCheckRun'
+type GithubClientMock_ReportCheckRun_Call struct {
+	*mock.Call
+}
+
+// ReportCheckRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installation *ent.GithubInstallation
+//   - owner string
+//   - repo string
+//   - headSHA string
+//   - checkRunID *int64
+//   - state github.CheckRunState
+func (_e *GithubClientMock_Expecter) ReportCheckRun(ctx interface{}, installation interface{}, owner interface{}, repo interface{}, headSHA interface{}, checkRunID interface{}, state interface{}) *GithubClientMock_ReportCheckRun_Call {
+	return &GithubClientMock_ReportCheckRun_Call{Call: _e.mock.On("ReportCheckRun", ctx, installation, owner, repo, headSHA, checkRunID, state)}
+}
+
+func (_c *GithubClientMock_ReportCheckRun_Call) Run(run func(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, headSHA string, checkRunID *int64, state github.CheckRunState)) *GithubClientMock_ReportCheckRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.GithubInstallation), args[2].(string), args[3].(string), args[4].(string), args[5].(*int64), args[6].(github.CheckRunState))
+	})
+	return _c
+}
+
+func (_c *GithubClientMock_ReportCheckRun_Call) Return(_a0 int64, _a1 error) *GithubClientMock_ReportCheckRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GithubClientMock_ReportCheckRun_Call) RunAndReturn(run func(context.Context, *ent.GithubInstallation, string, string, string, *int64, github.CheckRunState) (int64, error)) *GithubClientMock_ReportCheckRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertPullRequestComment provides a mock function with given fields: ctx, installation, owner, repo, number, commentID, body
+func (_m *GithubClientMock) UpsertPullRequestComment(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, number int, commentID *int64, body string) (int64, error) {
+	ret := _m.Called(ctx, installation, owner, repo, number, commentID, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertPullRequestComment")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, int, *int64, string) (int64, error)); ok {
+		return rf(ctx, installation, owner, repo, number, commentID, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *ent.GithubInstallation, string, string, int, *int64, string) int64); ok {
+		r0 = rf(ctx, installation, owner, repo, number, commentID, body)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *ent.GithubInstallation, string, string, int, *int64, string) error); ok {
+		r1 = rf(ctx, installation, owner, repo, number, commentID, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GithubClientMock_UpsertPullRequestComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPullRequestComment'
+type GithubClientMock_UpsertPullRequestComment_Call struct {
+	*mock.Call
+}
+
+// UpsertPullRequestComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installation *ent.GithubInstallation
+//   - owner string
+//   - repo string
+//   - number int
+//   - commentID *int64
+//   - body string
+func (_e *GithubClientMock_Expecter) UpsertPullRequestComment(ctx interface{}, installation interface{}, owner interface{}, repo interface{}, number interface{}, commentID interface{}, body interface{}) *GithubClientMock_UpsertPullRequestComment_Call {
+	return &GithubClientMock_UpsertPullRequestComment_Call{Call: _e.mock.On("UpsertPullRequestComment", ctx, installation, owner, repo, number, commentID, body)}
+}
+
+func (_c *GithubClientMock_UpsertPullRequestComment_Call) Run(run func(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string, number int, commentID *int64, body string)) *GithubClientMock_UpsertPullRequestComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*ent.GithubInstallation), args[2].(string), args[3].(string), args[4].(int), args[5].(*int64), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *GithubClientMock_UpsertPullRequestComment_Call) Return(_a0 int64, _a1 error) *GithubClientMock_UpsertPullRequestComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GithubClientMock_UpsertPullRequestComment_Call) RunAndReturn(run func(context.Context, *ent.GithubInstallation, string, string, int, *int64, string) (int64, error)) *GithubClientMock_UpsertPullRequestComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyRepositoryAccess provides a mock function with given fields: ctx, installation, owner, repo
 func (_m *GithubClientMock) VerifyRepositoryAccess(ctx context.Context, installation *ent.GithubInstallation, owner string, repo string) (bool, string, string, error) {
 	ret := _m.Called(ctx, installation, owner, repo)
@@ -15,6 +15,8 @@ import (
 
 	github_repo "github.com/unbindapp/unbind-api/internal/repositories/github"
 
+	gitlab_repo "github.com/unbindapp/unbind-api/internal/repositories/gitlab"
+
 	group_repo "github.com/unbindapp/unbind-api/internal/repositories/group"
 
 	mock "github.com/stretchr/testify/mock"
@@ -294,6 +296,53 @@ func (_c *RepositoriesMock_Github_Call) RunAndReturn(run func() github_repo.Gith
 	return _c
 }
 
+// Gitlab provides a mock function with no fields
+func (_m *RepositoriesMock) Gitlab() gitlab_repo.GitlabRepositoryInterface {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Gitlab")
+	}
+
+	var r0 gitlab_repo.GitlabRepositoryInterface
+	if rf, ok := ret.Get(0).(func() gitlab_repo.GitlabRepositoryInterface); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gitlab_repo.GitlabRepositoryInterface)
+		}
+	}
+
+	return r0
+}
+
+// RepositoriesMock_Gitlab_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Gitlab'
+type RepositoriesMock_Gitlab_Call struct {
+	*mock.Call
+}
+
+// Gitlab is a helper method to define mock.On call
+func (_e *RepositoriesMock_Expecter) Gitlab() *RepositoriesMock_Gitlab_Call {
+	return &RepositoriesMock_Gitlab_Call{Call: _e.mock.On("Gitlab")}
+}
+
+func (_c *RepositoriesMock_Gitlab_Call) Run(run func()) *RepositoriesMock_Gitlab_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepositoriesMock_Gitlab_Call) Return(_a0 gitlab_repo.GitlabRepositoryInterface) *RepositoriesMock_Gitlab_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoriesMock_Gitlab_Call) RunAndReturn(run func() gitlab_repo.GitlabRepositoryInterface) *RepositoriesMock_Gitlab_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Group provides a mock function with no fields
 func (_m *RepositoriesMock) Group() group_repo.GroupRepositoryInterface {
 	ret := _m.Called()
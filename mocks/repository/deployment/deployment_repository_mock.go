@@ -281,6 +281,67 @@ func (_c *DeploymentRepositoryMock_CreateCopy_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CreateCopyForService provides a mock function with given fields: ctx, tx, serviceID, deployment
+func (_m *DeploymentRepositoryMock) CreateCopyForService(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, deployment *ent.Deployment) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, serviceID, deployment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCopyForService")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, *ent.Deployment) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, serviceID, deployment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, *ent.Deployment) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, serviceID, deployment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, *ent.Deployment) error); ok {
+		r1 = rf(ctx, tx, serviceID, deployment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_CreateCopyForService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCopyForService'
+type DeploymentRepositoryMock_CreateCopyForService_Call struct {
+	*mock.Call
+}
+
+// CreateCopyForService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - serviceID uuid.UUID
+//   - deployment *ent.Deployment
+func (_e *DeploymentRepositoryMock_Expecter) CreateCopyForService(ctx interface{}, tx interface{}, serviceID interface{}, deployment interface{}) *DeploymentRepositoryMock_CreateCopyForService_Call {
+	return &DeploymentRepositoryMock_CreateCopyForService_Call{Call: _e.mock.On("CreateCopyForService", ctx, tx, serviceID, deployment)}
+}
+
+func (_c *DeploymentRepositoryMock_CreateCopyForService_Call) Run(run func(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, deployment *ent.Deployment)) *DeploymentRepositoryMock_CreateCopyForService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(*ent.Deployment))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_CreateCopyForService_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_CreateCopyForService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_CreateCopyForService_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, *ent.Deployment) (*ent.Deployment, error)) *DeploymentRepositoryMock_CreateCopyForService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsInEnvironment provides a mock function with given fields: ctx, deploymentID, environmentID
 func (_m *DeploymentRepositoryMock) ExistsInEnvironment(ctx context.Context, deploymentID uuid.UUID, environmentID uuid.UUID) (bool, error) {
 	ret := _m.Called(ctx, deploymentID, environmentID)
@@ -585,6 +646,64 @@ func (_c *DeploymentRepositoryMock_GetByServiceIDPaginated_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetInProgressRollouts provides a mock function with given fields: ctx
+func (_m *DeploymentRepositoryMock) GetInProgressRollouts(ctx context.Context) ([]*ent.Deployment, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInProgressRollouts")
+	}
+
+	var r0 []*ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ent.Deployment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ent.Deployment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_GetInProgressRollouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInProgressRollouts'
+type DeploymentRepositoryMock_GetInProgressRollouts_Call struct {
+	*mock.Call
+}
+
+// GetInProgressRollouts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DeploymentRepositoryMock_Expecter) GetInProgressRollouts(ctx interface{}) *DeploymentRepositoryMock_GetInProgressRollouts_Call {
+	return &DeploymentRepositoryMock_GetInProgressRollouts_Call{Call: _e.mock.On("GetInProgressRollouts", ctx)}
+}
+
+func (_c *DeploymentRepositoryMock_GetInProgressRollouts_Call) Run(run func(ctx context.Context)) *DeploymentRepositoryMock_GetInProgressRollouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_GetInProgressRollouts_Call) Return(_a0 []*ent.Deployment, _a1 error) *DeploymentRepositoryMock_GetInProgressRollouts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_GetInProgressRollouts_Call) RunAndReturn(run func(context.Context) ([]*ent.Deployment, error)) *DeploymentRepositoryMock_GetInProgressRollouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobsByStatus provides a mock function with given fields: ctx, status
 func (_m *DeploymentRepositoryMock) GetJobsByStatus(ctx context.Context, status schema.DeploymentStatus) ([]*ent.Deployment, error) {
 	ret := _m.Called(ctx, status)
@@ -703,124 +822,88 @@ func (_c *DeploymentRepositoryMock_GetLastSuccessfulDeployment_Call) RunAndRetur
 	return _c
 }
 
-// MarkAsCancelled provides a mock function with given fields: ctx, jobIDs
-func (_m *DeploymentRepositoryMock) MarkAsCancelled(ctx context.Context, jobIDs []uuid.UUID) error {
-	ret := _m.Called(ctx, jobIDs)
+// GetPendingGithubChecks provides a mock function with given fields: ctx, since
+func (_m *DeploymentRepositoryMock) GetPendingGithubChecks(ctx context.Context, since time.Time) ([]*ent.Deployment, error) {
+	ret := _m.Called(ctx, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkAsCancelled")
+		panic("no return value specified for GetPendingGithubChecks")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
-		r0 = rf(ctx, jobIDs)
-	} else {
-		r0 = ret.Error(0)
+	var r0 []*ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*ent.Deployment, error)); ok {
+		return rf(ctx, since)
 	}
-
-	return r0
-}
-
-// DeploymentRepositoryMock_MarkAsCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsCancelled'
-type DeploymentRepositoryMock_MarkAsCancelled_Call struct {
-	*mock.Call
-}
-
-// MarkAsCancelled is a helper method to define mock.On call
-//   - ctx context.Context
-//   - jobIDs []uuid.UUID
-func (_e *DeploymentRepositoryMock_Expecter) MarkAsCancelled(ctx interface{}, jobIDs interface{}) *DeploymentRepositoryMock_MarkAsCancelled_Call {
-	return &DeploymentRepositoryMock_MarkAsCancelled_Call{Call: _e.mock.On("MarkAsCancelled", ctx, jobIDs)}
-}
-
-func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) Run(run func(ctx context.Context, jobIDs []uuid.UUID)) *DeploymentRepositoryMock_MarkAsCancelled_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
-	})
-	return _c
-}
-
-func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) Return(_a0 error) *DeploymentRepositoryMock_MarkAsCancelled_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) RunAndReturn(run func(context.Context, []uuid.UUID) error) *DeploymentRepositoryMock_MarkAsCancelled_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// MarkCancelledExcept provides a mock function with given fields: ctx, serviceID, deploymentID
-func (_m *DeploymentRepositoryMock) MarkCancelledExcept(ctx context.Context, serviceID uuid.UUID, deploymentID uuid.UUID) error {
-	ret := _m.Called(ctx, serviceID, deploymentID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for MarkCancelledExcept")
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*ent.Deployment); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Deployment)
+		}
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, serviceID, deploymentID)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeploymentRepositoryMock_MarkCancelledExcept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCancelledExcept'
-type DeploymentRepositoryMock_MarkCancelledExcept_Call struct {
+// DeploymentRepositoryMock_GetPendingGithubChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingGithubChecks'
+type DeploymentRepositoryMock_GetPendingGithubChecks_Call struct {
 	*mock.Call
 }
 
-// MarkCancelledExcept is a helper method to define mock.On call
+// GetPendingGithubChecks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - serviceID uuid.UUID
-//   - deploymentID uuid.UUID
-func (_e *DeploymentRepositoryMock_Expecter) MarkCancelledExcept(ctx interface{}, serviceID interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
-	return &DeploymentRepositoryMock_MarkCancelledExcept_Call{Call: _e.mock.On("MarkCancelledExcept", ctx, serviceID, deploymentID)}
+//   - since time.Time
+func (_e *DeploymentRepositoryMock_Expecter) GetPendingGithubChecks(ctx interface{}, since interface{}) *DeploymentRepositoryMock_GetPendingGithubChecks_Call {
+	return &DeploymentRepositoryMock_GetPendingGithubChecks_Call{Call: _e.mock.On("GetPendingGithubChecks", ctx, since)}
 }
 
-func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) Run(run func(ctx context.Context, serviceID uuid.UUID, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+func (_c *DeploymentRepositoryMock_GetPendingGithubChecks_Call) Run(run func(ctx context.Context, since time.Time)) *DeploymentRepositoryMock_GetPendingGithubChecks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(time.Time))
 	})
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) Return(_a0 error) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
-	_c.Call.Return(_a0)
+func (_c *DeploymentRepositoryMock_GetPendingGithubChecks_Call) Return(_a0 []*ent.Deployment, _a1 error) *DeploymentRepositoryMock_GetPendingGithubChecks_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+func (_c *DeploymentRepositoryMock_GetPendingGithubChecks_Call) RunAndReturn(run func(context.Context, time.Time) ([]*ent.Deployment, error)) *DeploymentRepositoryMock_GetPendingGithubChecks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkFailed provides a mock function with given fields: ctx, tx, deploymentID, message, failedAt
-func (_m *DeploymentRepositoryMock) MarkFailed(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string, failedAt time.Time) (*ent.Deployment, error) {
-	ret := _m.Called(ctx, tx, deploymentID, message, failedAt)
+// GetPreviousSuccessfulDeployment provides a mock function with given fields: ctx, serviceID, before
+func (_m *DeploymentRepositoryMock) GetPreviousSuccessfulDeployment(ctx context.Context, serviceID uuid.UUID, before *ent.Deployment) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, serviceID, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkFailed")
+		panic("no return value specified for GetPreviousSuccessfulDeployment")
 	}
 
 	var r0 *ent.Deployment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) (*ent.Deployment, error)); ok {
-		return rf(ctx, tx, deploymentID, message, failedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *ent.Deployment) (*ent.Deployment, error)); ok {
+		return rf(ctx, serviceID, before)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) *ent.Deployment); ok {
-		r0 = rf(ctx, tx, deploymentID, message, failedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *ent.Deployment) *ent.Deployment); ok {
+		r0 = rf(ctx, serviceID, before)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Deployment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) error); ok {
-		r1 = rf(ctx, tx, deploymentID, message, failedAt)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *ent.Deployment) error); ok {
+		r1 = rf(ctx, serviceID, before)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -828,61 +911,59 @@ func (_m *DeploymentRepositoryMock) MarkFailed(ctx context.Context, tx repositor
 	return r0, r1
 }
 
-// DeploymentRepositoryMock_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
-type DeploymentRepositoryMock_MarkFailed_Call struct {
+// DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousSuccessfulDeployment'
+type DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call struct {
 	*mock.Call
 }
 
-// MarkFailed is a helper method to define mock.On call
+// GetPreviousSuccessfulDeployment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx repository.TxInterface
-//   - deploymentID uuid.UUID
-//   - message string
-//   - failedAt time.Time
-func (_e *DeploymentRepositoryMock_Expecter) MarkFailed(ctx interface{}, tx interface{}, deploymentID interface{}, message interface{}, failedAt interface{}) *DeploymentRepositoryMock_MarkFailed_Call {
-	return &DeploymentRepositoryMock_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, tx, deploymentID, message, failedAt)}
+//   - serviceID uuid.UUID
+//   - before *ent.Deployment
+func (_e *DeploymentRepositoryMock_Expecter) GetPreviousSuccessfulDeployment(ctx interface{}, serviceID interface{}, before interface{}) *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call {
+	return &DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call{Call: _e.mock.On("GetPreviousSuccessfulDeployment", ctx, serviceID, before)}
 }
 
-func (_c *DeploymentRepositoryMock_MarkFailed_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string, failedAt time.Time)) *DeploymentRepositoryMock_MarkFailed_Call {
+func (_c *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call) Run(run func(ctx context.Context, serviceID uuid.UUID, before *ent.Deployment)) *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string), args[4].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*ent.Deployment))
 	})
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkFailed_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkFailed_Call {
+func (_c *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkFailed_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkFailed_Call {
+func (_c *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call) RunAndReturn(run func(context.Context, uuid.UUID, *ent.Deployment) (*ent.Deployment, error)) *DeploymentRepositoryMock_GetPreviousSuccessfulDeployment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkQueued provides a mock function with given fields: ctx, tx, deploymentID, queuedAt
-func (_m *DeploymentRepositoryMock) MarkQueued(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, queuedAt time.Time) (*ent.Deployment, error) {
-	ret := _m.Called(ctx, tx, deploymentID, queuedAt)
+// GetSBOMPackages provides a mock function with given fields: ctx, deploymentID, name, version
+func (_m *DeploymentRepositoryMock) GetSBOMPackages(ctx context.Context, deploymentID uuid.UUID, name string, version string) ([]*ent.SBOMPackage, error) {
+	ret := _m.Called(ctx, deploymentID, name, version)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkQueued")
+		panic("no return value specified for GetSBOMPackages")
 	}
 
-	var r0 *ent.Deployment
+	var r0 []*ent.SBOMPackage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
-		return rf(ctx, tx, deploymentID, queuedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) ([]*ent.SBOMPackage, error)); ok {
+		return rf(ctx, deploymentID, name, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
-		r0 = rf(ctx, tx, deploymentID, queuedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) []*ent.SBOMPackage); ok {
+		r0 = rf(ctx, deploymentID, name, version)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ent.Deployment)
+			r0 = ret.Get(0).([]*ent.SBOMPackage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, tx, deploymentID, queuedAt)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, deploymentID, name, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -890,60 +971,60 @@ func (_m *DeploymentRepositoryMock) MarkQueued(ctx context.Context, tx repositor
 	return r0, r1
 }
 
-// DeploymentRepositoryMock_MarkQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkQueued'
-type DeploymentRepositoryMock_MarkQueued_Call struct {
+// DeploymentRepositoryMock_GetSBOMPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSBOMPackages'
+type DeploymentRepositoryMock_GetSBOMPackages_Call struct {
 	*mock.Call
 }
 
-// MarkQueued is a helper method to define mock.On call
+// GetSBOMPackages is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx repository.TxInterface
 //   - deploymentID uuid.UUID
-//   - queuedAt time.Time
-func (_e *DeploymentRepositoryMock_Expecter) MarkQueued(ctx interface{}, tx interface{}, deploymentID interface{}, queuedAt interface{}) *DeploymentRepositoryMock_MarkQueued_Call {
-	return &DeploymentRepositoryMock_MarkQueued_Call{Call: _e.mock.On("MarkQueued", ctx, tx, deploymentID, queuedAt)}
+//   - name string
+//   - version string
+func (_e *DeploymentRepositoryMock_Expecter) GetSBOMPackages(ctx interface{}, deploymentID interface{}, name interface{}, version interface{}) *DeploymentRepositoryMock_GetSBOMPackages_Call {
+	return &DeploymentRepositoryMock_GetSBOMPackages_Call{Call: _e.mock.On("GetSBOMPackages", ctx, deploymentID, name, version)}
 }
 
-func (_c *DeploymentRepositoryMock_MarkQueued_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, queuedAt time.Time)) *DeploymentRepositoryMock_MarkQueued_Call {
+func (_c *DeploymentRepositoryMock_GetSBOMPackages_Call) Run(run func(ctx context.Context, deploymentID uuid.UUID, name string, version string)) *DeploymentRepositoryMock_GetSBOMPackages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkQueued_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkQueued_Call {
+func (_c *DeploymentRepositoryMock_GetSBOMPackages_Call) Return(_a0 []*ent.SBOMPackage, _a1 error) *DeploymentRepositoryMock_GetSBOMPackages_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkQueued_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkQueued_Call {
+func (_c *DeploymentRepositoryMock_GetSBOMPackages_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) ([]*ent.SBOMPackage, error)) *DeploymentRepositoryMock_GetSBOMPackages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkStarted provides a mock function with given fields: ctx, tx, deploymentID, startedAt
-func (_m *DeploymentRepositoryMock) MarkStarted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time) (*ent.Deployment, error) {
-	ret := _m.Called(ctx, tx, deploymentID, startedAt)
+// GetStagedByServiceID provides a mock function with given fields: ctx, serviceID
+func (_m *DeploymentRepositoryMock) GetStagedByServiceID(ctx context.Context, serviceID uuid.UUID) ([]*ent.Deployment, error) {
+	ret := _m.Called(ctx, serviceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkStarted")
+		panic("no return value specified for GetStagedByServiceID")
 	}
 
-	var r0 *ent.Deployment
+	var r0 []*ent.Deployment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
-		return rf(ctx, tx, deploymentID, startedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*ent.Deployment, error)); ok {
+		return rf(ctx, serviceID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
-		r0 = rf(ctx, tx, deploymentID, startedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*ent.Deployment); ok {
+		r0 = rf(ctx, serviceID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ent.Deployment)
+			r0 = ret.Get(0).([]*ent.Deployment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, tx, deploymentID, startedAt)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, serviceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -951,60 +1032,58 @@ func (_m *DeploymentRepositoryMock) MarkStarted(ctx context.Context, tx reposito
 	return r0, r1
 }
 
-// DeploymentRepositoryMock_MarkStarted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkStarted'
-type DeploymentRepositoryMock_MarkStarted_Call struct {
+// DeploymentRepositoryMock_GetStagedByServiceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStagedByServiceID'
+type DeploymentRepositoryMock_GetStagedByServiceID_Call struct {
 	*mock.Call
 }
 
-// MarkStarted is a helper method to define mock.On call
+// GetStagedByServiceID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx repository.TxInterface
-//   - deploymentID uuid.UUID
-//   - startedAt time.Time
-func (_e *DeploymentRepositoryMock_Expecter) MarkStarted(ctx interface{}, tx interface{}, deploymentID interface{}, startedAt interface{}) *DeploymentRepositoryMock_MarkStarted_Call {
-	return &DeploymentRepositoryMock_MarkStarted_Call{Call: _e.mock.On("MarkStarted", ctx, tx, deploymentID, startedAt)}
+//   - serviceID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) GetStagedByServiceID(ctx interface{}, serviceID interface{}) *DeploymentRepositoryMock_GetStagedByServiceID_Call {
+	return &DeploymentRepositoryMock_GetStagedByServiceID_Call{Call: _e.mock.On("GetStagedByServiceID", ctx, serviceID)}
 }
 
-func (_c *DeploymentRepositoryMock_MarkStarted_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time)) *DeploymentRepositoryMock_MarkStarted_Call {
+func (_c *DeploymentRepositoryMock_GetStagedByServiceID_Call) Run(run func(ctx context.Context, serviceID uuid.UUID)) *DeploymentRepositoryMock_GetStagedByServiceID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkStarted_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkStarted_Call {
+func (_c *DeploymentRepositoryMock_GetStagedByServiceID_Call) Return(_a0 []*ent.Deployment, _a1 error) *DeploymentRepositoryMock_GetStagedByServiceID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkStarted_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkStarted_Call {
+func (_c *DeploymentRepositoryMock_GetStagedByServiceID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*ent.Deployment, error)) *DeploymentRepositoryMock_GetStagedByServiceID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkSucceeded provides a mock function with given fields: ctx, tx, deploymentID, completedAt
-func (_m *DeploymentRepositoryMock) MarkSucceeded(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error) {
-	ret := _m.Called(ctx, tx, deploymentID, completedAt)
+// MarkAborted provides a mock function with given fields: ctx, tx, deploymentID, message
+func (_m *DeploymentRepositoryMock) MarkAborted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, message)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkSucceeded")
+		panic("no return value specified for MarkAborted")
 	}
 
 	var r0 *ent.Deployment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
-		return rf(ctx, tx, deploymentID, completedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, message)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
-		r0 = rf(ctx, tx, deploymentID, completedAt)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, message)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Deployment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
-		r1 = rf(ctx, tx, deploymentID, completedAt)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, tx, deploymentID, message)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1012,33 +1091,919 @@ func (_m *DeploymentRepositoryMock) MarkSucceeded(ctx context.Context, tx reposi
 	return r0, r1
 }
 
-// DeploymentRepositoryMock_MarkSucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSucceeded'
-type DeploymentRepositoryMock_MarkSucceeded_Call struct {
+// DeploymentRepositoryMock_MarkAborted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAborted'
+type DeploymentRepositoryMock_MarkAborted_Call struct {
 	*mock.Call
 }
 
-// MarkSucceeded is a helper method to define mock.On call
+// MarkAborted is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tx repository.TxInterface
 //   - deploymentID uuid.UUID
-//   - completedAt time.Time
-func (_e *DeploymentRepositoryMock_Expecter) MarkSucceeded(ctx interface{}, tx interface{}, deploymentID interface{}, completedAt interface{}) *DeploymentRepositoryMock_MarkSucceeded_Call {
-	return &DeploymentRepositoryMock_MarkSucceeded_Call{Call: _e.mock.On("MarkSucceeded", ctx, tx, deploymentID, completedAt)}
+//   - message string
+func (_e *DeploymentRepositoryMock_Expecter) MarkAborted(ctx interface{}, tx interface{}, deploymentID interface{}, message interface{}) *DeploymentRepositoryMock_MarkAborted_Call {
+	return &DeploymentRepositoryMock_MarkAborted_Call{Call: _e.mock.On("MarkAborted", ctx, tx, deploymentID, message)}
 }
 
-func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time)) *DeploymentRepositoryMock_MarkSucceeded_Call {
+func (_c *DeploymentRepositoryMock_MarkAborted_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string)) *DeploymentRepositoryMock_MarkAborted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkSucceeded_Call {
+func (_c *DeploymentRepositoryMock_MarkAborted_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkAborted_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkSucceeded_Call {
+func (_c *DeploymentRepositoryMock_MarkAborted_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkAborted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsCancelled provides a mock function with given fields: ctx, jobIDs
+func (_m *DeploymentRepositoryMock) MarkAsCancelled(ctx context.Context, jobIDs []uuid.UUID) error {
+	ret := _m.Called(ctx, jobIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsCancelled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, jobIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentRepositoryMock_MarkAsCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsCancelled'
+type DeploymentRepositoryMock_MarkAsCancelled_Call struct {
+	*mock.Call
+}
+
+// MarkAsCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobIDs []uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkAsCancelled(ctx interface{}, jobIDs interface{}) *DeploymentRepositoryMock_MarkAsCancelled_Call {
+	return &DeploymentRepositoryMock_MarkAsCancelled_Call{Call: _e.mock.On("MarkAsCancelled", ctx, jobIDs)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) Run(run func(ctx context.Context, jobIDs []uuid.UUID)) *DeploymentRepositoryMock_MarkAsCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) Return(_a0 error) *DeploymentRepositoryMock_MarkAsCancelled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkAsCancelled_Call) RunAndReturn(run func(context.Context, []uuid.UUID) error) *DeploymentRepositoryMock_MarkAsCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAwaitingApproval provides a mock function with given fields: ctx, tx, deploymentID
+func (_m *DeploymentRepositoryMock) MarkAwaitingApproval(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAwaitingApproval")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID) error); ok {
+		r1 = rf(ctx, tx, deploymentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkAwaitingApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAwaitingApproval'
+type DeploymentRepositoryMock_MarkAwaitingApproval_Call struct {
+	*mock.Call
+}
+
+// MarkAwaitingApproval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkAwaitingApproval(ctx interface{}, tx interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkAwaitingApproval_Call {
+	return &DeploymentRepositoryMock_MarkAwaitingApproval_Call{Call: _e.mock.On("MarkAwaitingApproval", ctx, tx, deploymentID)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkAwaitingApproval_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkAwaitingApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkAwaitingApproval_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkAwaitingApproval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkAwaitingApproval_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkAwaitingApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCancelled provides a mock function with given fields: ctx, tx, deploymentID
+func (_m *DeploymentRepositoryMock) MarkCancelled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCancelled")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID) error); ok {
+		r1 = rf(ctx, tx, deploymentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCancelled'
+type DeploymentRepositoryMock_MarkCancelled_Call struct {
+	*mock.Call
+}
+
+// MarkCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkCancelled(ctx interface{}, tx interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkCancelled_Call {
+	return &DeploymentRepositoryMock_MarkCancelled_Call{Call: _e.mock.On("MarkCancelled", ctx, tx, deploymentID)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelled_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelled_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkCancelled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelled_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCancelledExcept provides a mock function with given fields: ctx, serviceID, deploymentID
+func (_m *DeploymentRepositoryMock) MarkCancelledExcept(ctx context.Context, serviceID uuid.UUID, deploymentID uuid.UUID) error {
+	ret := _m.Called(ctx, serviceID, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCancelledExcept")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, serviceID, deploymentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentRepositoryMock_MarkCancelledExcept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCancelledExcept'
+type DeploymentRepositoryMock_MarkCancelledExcept_Call struct {
+	*mock.Call
+}
+
+// MarkCancelledExcept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceID uuid.UUID
+//   - deploymentID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkCancelledExcept(ctx interface{}, serviceID interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+	return &DeploymentRepositoryMock_MarkCancelledExcept_Call{Call: _e.mock.On("MarkCancelledExcept", ctx, serviceID, deploymentID)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) Run(run func(ctx context.Context, serviceID uuid.UUID, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) Return(_a0 error) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkCancelledExcept_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *DeploymentRepositoryMock_MarkCancelledExcept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, tx, deploymentID, message, failedAt
+func (_m *DeploymentRepositoryMock) MarkFailed(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string, failedAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, message, failedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, message, failedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, message, failedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, message, failedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type DeploymentRepositoryMock_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - message string
+//   - failedAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkFailed(ctx interface{}, tx interface{}, deploymentID interface{}, message interface{}, failedAt interface{}) *DeploymentRepositoryMock_MarkFailed_Call {
+	return &DeploymentRepositoryMock_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, tx, deploymentID, message, failedAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkFailed_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, message string, failedAt time.Time)) *DeploymentRepositoryMock_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkFailed_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkFailed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkFailed_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPending provides a mock function with given fields: ctx, tx, deploymentID
+func (_m *DeploymentRepositoryMock) MarkPending(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPending")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID) error); ok {
+		r1 = rf(ctx, tx, deploymentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPending'
+type DeploymentRepositoryMock_MarkPending_Call struct {
+	*mock.Call
+}
+
+// MarkPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkPending(ctx interface{}, tx interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkPending_Call {
+	return &DeploymentRepositoryMock_MarkPending_Call{Call: _e.mock.On("MarkPending", ctx, tx, deploymentID)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkPending_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkPending_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkPending_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPromoting provides a mock function with given fields: ctx, tx, deploymentID
+func (_m *DeploymentRepositoryMock) MarkPromoting(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPromoting")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID) error); ok {
+		r1 = rf(ctx, tx, deploymentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkPromoting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPromoting'
+type DeploymentRepositoryMock_MarkPromoting_Call struct {
+	*mock.Call
+}
+
+// MarkPromoting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+func (_e *DeploymentRepositoryMock_Expecter) MarkPromoting(ctx interface{}, tx interface{}, deploymentID interface{}) *DeploymentRepositoryMock_MarkPromoting_Call {
+	return &DeploymentRepositoryMock_MarkPromoting_Call{Call: _e.mock.On("MarkPromoting", ctx, tx, deploymentID)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkPromoting_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID)) *DeploymentRepositoryMock_MarkPromoting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkPromoting_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkPromoting_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkPromoting_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkPromoting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkQueued provides a mock function with given fields: ctx, tx, deploymentID, queuedAt
+func (_m *DeploymentRepositoryMock) MarkQueued(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, queuedAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, queuedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkQueued")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, queuedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, queuedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, queuedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkQueued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkQueued'
+type DeploymentRepositoryMock_MarkQueued_Call struct {
+	*mock.Call
+}
+
+// MarkQueued is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - queuedAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkQueued(ctx interface{}, tx interface{}, deploymentID interface{}, queuedAt interface{}) *DeploymentRepositoryMock_MarkQueued_Call {
+	return &DeploymentRepositoryMock_MarkQueued_Call{Call: _e.mock.On("MarkQueued", ctx, tx, deploymentID, queuedAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkQueued_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, queuedAt time.Time)) *DeploymentRepositoryMock_MarkQueued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkQueued_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkQueued_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkQueued_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkQueued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkScheduled provides a mock function with given fields: ctx, tx, deploymentID, scheduledAt
+func (_m *DeploymentRepositoryMock) MarkScheduled(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, scheduledAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, scheduledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkScheduled")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, scheduledAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, scheduledAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, scheduledAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkScheduled'
+type DeploymentRepositoryMock_MarkScheduled_Call struct {
+	*mock.Call
+}
+
+// MarkScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - scheduledAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkScheduled(ctx interface{}, tx interface{}, deploymentID interface{}, scheduledAt interface{}) *DeploymentRepositoryMock_MarkScheduled_Call {
+	return &DeploymentRepositoryMock_MarkScheduled_Call{Call: _e.mock.On("MarkScheduled", ctx, tx, deploymentID, scheduledAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkScheduled_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, scheduledAt time.Time)) *DeploymentRepositoryMock_MarkScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkScheduled_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkScheduled_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSkipped provides a mock function with given fields: ctx, tx, deploymentID, reason
+func (_m *DeploymentRepositoryMock) MarkSkipped(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSkipped")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, tx, deploymentID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkSkipped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSkipped'
+type DeploymentRepositoryMock_MarkSkipped_Call struct {
+	*mock.Call
+}
+
+// MarkSkipped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - reason string
+func (_e *DeploymentRepositoryMock_Expecter) MarkSkipped(ctx interface{}, tx interface{}, deploymentID interface{}, reason interface{}) *DeploymentRepositoryMock_MarkSkipped_Call {
+	return &DeploymentRepositoryMock_MarkSkipped_Call{Call: _e.mock.On("MarkSkipped", ctx, tx, deploymentID, reason)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkSkipped_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string)) *DeploymentRepositoryMock_MarkSkipped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkSkipped_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkSkipped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkSkipped_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkSkipped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkStaged provides a mock function with given fields: ctx, tx, deploymentID, completedAt
+func (_m *DeploymentRepositoryMock) MarkStaged(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, completedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkStaged")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, completedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, completedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, completedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkStaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkStaged'
+type DeploymentRepositoryMock_MarkStaged_Call struct {
+	*mock.Call
+}
+
+// MarkStaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - completedAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkStaged(ctx interface{}, tx interface{}, deploymentID interface{}, completedAt interface{}) *DeploymentRepositoryMock_MarkStaged_Call {
+	return &DeploymentRepositoryMock_MarkStaged_Call{Call: _e.mock.On("MarkStaged", ctx, tx, deploymentID, completedAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkStaged_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time)) *DeploymentRepositoryMock_MarkStaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkStaged_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkStaged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkStaged_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkStaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkStarted provides a mock function with given fields: ctx, tx, deploymentID, startedAt
+func (_m *DeploymentRepositoryMock) MarkStarted(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, startedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkStarted")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, startedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, startedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, startedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkStarted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkStarted'
+type DeploymentRepositoryMock_MarkStarted_Call struct {
+	*mock.Call
+}
+
+// MarkStarted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - startedAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkStarted(ctx interface{}, tx interface{}, deploymentID interface{}, startedAt interface{}) *DeploymentRepositoryMock_MarkStarted_Call {
+	return &DeploymentRepositoryMock_MarkStarted_Call{Call: _e.mock.On("MarkStarted", ctx, tx, deploymentID, startedAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkStarted_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, startedAt time.Time)) *DeploymentRepositoryMock_MarkStarted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkStarted_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkStarted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkStarted_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkStarted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSucceeded provides a mock function with given fields: ctx, tx, deploymentID, completedAt
+func (_m *DeploymentRepositoryMock) MarkSucceeded(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, completedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSucceeded")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, completedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, completedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, tx, deploymentID, completedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_MarkSucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSucceeded'
+type DeploymentRepositoryMock_MarkSucceeded_Call struct {
+	*mock.Call
+}
+
+// MarkSucceeded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - completedAt time.Time
+func (_e *DeploymentRepositoryMock_Expecter) MarkSucceeded(ctx interface{}, tx interface{}, deploymentID interface{}, completedAt interface{}) *DeploymentRepositoryMock_MarkSucceeded_Call {
+	return &DeploymentRepositoryMock_MarkSucceeded_Call{Call: _e.mock.On("MarkSucceeded", ctx, tx, deploymentID, completedAt)}
+}
+
+func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, completedAt time.Time)) *DeploymentRepositoryMock_MarkSucceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_MarkSucceeded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_MarkSucceeded_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, time.Time) (*ent.Deployment, error)) *DeploymentRepositoryMock_MarkSucceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnvKeys provides a mock function with given fields: ctx, tx, deploymentID, keys
+func (_m *DeploymentRepositoryMock) SetEnvKeys(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, keys []string) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnvKeys")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, []string) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, []string) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, tx, deploymentID, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_SetEnvKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnvKeys'
+type DeploymentRepositoryMock_SetEnvKeys_Call struct {
+	*mock.Call
+}
+
+// SetEnvKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - keys []string
+func (_e *DeploymentRepositoryMock_Expecter) SetEnvKeys(ctx interface{}, tx interface{}, deploymentID interface{}, keys interface{}) *DeploymentRepositoryMock_SetEnvKeys_Call {
+	return &DeploymentRepositoryMock_SetEnvKeys_Call{Call: _e.mock.On("SetEnvKeys", ctx, tx, deploymentID, keys)}
+}
+
+func (_c *DeploymentRepositoryMock_SetEnvKeys_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, keys []string)) *DeploymentRepositoryMock_SetEnvKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetEnvKeys_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_SetEnvKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetEnvKeys_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, []string) (*ent.Deployment, error)) *DeploymentRepositoryMock_SetEnvKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGithubCheckRun provides a mock function with given fields: ctx, deploymentID, checkRunID, status, concluded
+func (_m *DeploymentRepositoryMock) SetGithubCheckRun(ctx context.Context, deploymentID uuid.UUID, checkRunID *int64, status schema.DeploymentStatus, concluded bool) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, deploymentID, checkRunID, status, concluded)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetGithubCheckRun")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int64, schema.DeploymentStatus, bool) (*ent.Deployment, error)); ok {
+		return rf(ctx, deploymentID, checkRunID, status, concluded)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int64, schema.DeploymentStatus, bool) *ent.Deployment); ok {
+		r0 = rf(ctx, deploymentID, checkRunID, status, concluded)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *int64, schema.DeploymentStatus, bool) error); ok {
+		r1 = rf(ctx, deploymentID, checkRunID, status, concluded)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_SetGithubCheckRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGithubCheckRun'
+type DeploymentRepositoryMock_SetGithubCheckRun_Call struct {
+	*mock.Call
+}
+
+// SetGithubCheckRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentID uuid.UUID
+//   - checkRunID *int64
+//   - status schema.DeploymentStatus
+//   - concluded bool
+func (_e *DeploymentRepositoryMock_Expecter) SetGithubCheckRun(ctx interface{}, deploymentID interface{}, checkRunID interface{}, status interface{}, concluded interface{}) *DeploymentRepositoryMock_SetGithubCheckRun_Call {
+	return &DeploymentRepositoryMock_SetGithubCheckRun_Call{Call: _e.mock.On("SetGithubCheckRun", ctx, deploymentID, checkRunID, status, concluded)}
+}
+
+func (_c *DeploymentRepositoryMock_SetGithubCheckRun_Call) Run(run func(ctx context.Context, deploymentID uuid.UUID, checkRunID *int64, status schema.DeploymentStatus, concluded bool)) *DeploymentRepositoryMock_SetGithubCheckRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*int64), args[3].(schema.DeploymentStatus), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetGithubCheckRun_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_SetGithubCheckRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetGithubCheckRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, *int64, schema.DeploymentStatus, bool) (*ent.Deployment, error)) *DeploymentRepositoryMock_SetGithubCheckRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1103,6 +2068,238 @@ func (_c *DeploymentRepositoryMock_SetKubernetesJobStatus_Call) RunAndReturn(run
 	return _c
 }
 
+// SetPlatformBuilds provides a mock function with given fields: ctx, tx, deploymentID, builds
+func (_m *DeploymentRepositoryMock) SetPlatformBuilds(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, builds []schema.PlatformBuild) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, builds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPlatformBuilds")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, []schema.PlatformBuild) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, builds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, []schema.PlatformBuild) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, builds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, []schema.PlatformBuild) error); ok {
+		r1 = rf(ctx, tx, deploymentID, builds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_SetPlatformBuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPlatformBuilds'
+type DeploymentRepositoryMock_SetPlatformBuilds_Call struct {
+	*mock.Call
+}
+
+// SetPlatformBuilds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - builds []schema.PlatformBuild
+func (_e *DeploymentRepositoryMock_Expecter) SetPlatformBuilds(ctx interface{}, tx interface{}, deploymentID interface{}, builds interface{}) *DeploymentRepositoryMock_SetPlatformBuilds_Call {
+	return &DeploymentRepositoryMock_SetPlatformBuilds_Call{Call: _e.mock.On("SetPlatformBuilds", ctx, tx, deploymentID, builds)}
+}
+
+func (_c *DeploymentRepositoryMock_SetPlatformBuilds_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, builds []schema.PlatformBuild)) *DeploymentRepositoryMock_SetPlatformBuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].([]schema.PlatformBuild))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetPlatformBuilds_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_SetPlatformBuilds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetPlatformBuilds_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, []schema.PlatformBuild) (*ent.Deployment, error)) *DeploymentRepositoryMock_SetPlatformBuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRollbackReason provides a mock function with given fields: ctx, tx, deploymentID, reason
+func (_m *DeploymentRepositoryMock) SetRollbackReason(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRollbackReason")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, tx, deploymentID, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_SetRollbackReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollbackReason'
+type DeploymentRepositoryMock_SetRollbackReason_Call struct {
+	*mock.Call
+}
+
+// SetRollbackReason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - reason string
+func (_e *DeploymentRepositoryMock_Expecter) SetRollbackReason(ctx interface{}, tx interface{}, deploymentID interface{}, reason interface{}) *DeploymentRepositoryMock_SetRollbackReason_Call {
+	return &DeploymentRepositoryMock_SetRollbackReason_Call{Call: _e.mock.On("SetRollbackReason", ctx, tx, deploymentID, reason)}
+}
+
+func (_c *DeploymentRepositoryMock_SetRollbackReason_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, reason string)) *DeploymentRepositoryMock_SetRollbackReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetRollbackReason_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_SetRollbackReason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetRollbackReason_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)) *DeploymentRepositoryMock_SetRollbackReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSBOMPackages provides a mock function with given fields: ctx, tx, deploymentID, packages
+func (_m *DeploymentRepositoryMock) SetSBOMPackages(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, packages []schema.ImagePackage) error {
+	ret := _m.Called(ctx, tx, deploymentID, packages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSBOMPackages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, []schema.ImagePackage) error); ok {
+		r0 = rf(ctx, tx, deploymentID, packages)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeploymentRepositoryMock_SetSBOMPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSBOMPackages'
+type DeploymentRepositoryMock_SetSBOMPackages_Call struct {
+	*mock.Call
+}
+
+// SetSBOMPackages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - packages []schema.ImagePackage
+func (_e *DeploymentRepositoryMock_Expecter) SetSBOMPackages(ctx interface{}, tx interface{}, deploymentID interface{}, packages interface{}) *DeploymentRepositoryMock_SetSBOMPackages_Call {
+	return &DeploymentRepositoryMock_SetSBOMPackages_Call{Call: _e.mock.On("SetSBOMPackages", ctx, tx, deploymentID, packages)}
+}
+
+func (_c *DeploymentRepositoryMock_SetSBOMPackages_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, packages []schema.ImagePackage)) *DeploymentRepositoryMock_SetSBOMPackages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].([]schema.ImagePackage))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetSBOMPackages_Call) Return(_a0 error) *DeploymentRepositoryMock_SetSBOMPackages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetSBOMPackages_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, []schema.ImagePackage) error) *DeploymentRepositoryMock_SetSBOMPackages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSourceArchive provides a mock function with given fields: ctx, tx, deploymentID, key
+func (_m *DeploymentRepositoryMock) SetSourceArchive(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, key string) (*ent.Deployment, error) {
+	ret := _m.Called(ctx, tx, deploymentID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSourceArchive")
+	}
+
+	var r0 *ent.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)); ok {
+		return rf(ctx, tx, deploymentID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, string) *ent.Deployment); ok {
+		r0 = rf(ctx, tx, deploymentID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, tx, deploymentID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepositoryMock_SetSourceArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSourceArchive'
+type DeploymentRepositoryMock_SetSourceArchive_Call struct {
+	*mock.Call
+}
+
+// SetSourceArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - deploymentID uuid.UUID
+//   - key string
+func (_e *DeploymentRepositoryMock_Expecter) SetSourceArchive(ctx interface{}, tx interface{}, deploymentID interface{}, key interface{}) *DeploymentRepositoryMock_SetSourceArchive_Call {
+	return &DeploymentRepositoryMock_SetSourceArchive_Call{Call: _e.mock.On("SetSourceArchive", ctx, tx, deploymentID, key)}
+}
+
+func (_c *DeploymentRepositoryMock_SetSourceArchive_Call) Run(run func(ctx context.Context, tx repository.TxInterface, deploymentID uuid.UUID, key string)) *DeploymentRepositoryMock_SetSourceArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetSourceArchive_Call) Return(_a0 *ent.Deployment, _a1 error) *DeploymentRepositoryMock_SetSourceArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepositoryMock_SetSourceArchive_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, string) (*ent.Deployment, error)) *DeploymentRepositoryMock_SetSourceArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDeploymentRepositoryMock creates a new instance of DeploymentRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDeploymentRepositoryMock(t interface {
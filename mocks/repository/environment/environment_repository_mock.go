@@ -13,6 +13,8 @@ import (
 
 	repository "github.com/unbindapp/unbind-api/internal/repositories"
 
+	schema "github.com/unbindapp/unbind-api/ent/schema"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -93,6 +95,73 @@ func (_c *EnvironmentRepositoryMock_Create_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CreatePreview provides a mock function with given fields: ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest
+func (_m *EnvironmentRepositoryMock) CreatePreview(ctx context.Context, tx repository.TxInterface, kubernetesName string, name string, kuberneteSecret string, description *string, projectID uuid.UUID, baseEnvironmentID uuid.UUID, gitRepository string, pullRequest int) (*ent.Environment, error) {
+	ret := _m.Called(ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePreview")
+	}
+
+	var r0 *ent.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, string, string, string, *string, uuid.UUID, uuid.UUID, string, int) (*ent.Environment, error)); ok {
+		return rf(ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, string, string, string, *string, uuid.UUID, uuid.UUID, string, int) *ent.Environment); ok {
+		r0 = rf(ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.TxInterface, string, string, string, *string, uuid.UUID, uuid.UUID, string, int) error); ok {
+		r1 = rf(ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentRepositoryMock_CreatePreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePreview'
+type EnvironmentRepositoryMock_CreatePreview_Call struct {
+	*mock.Call
+}
+
+// CreatePreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - kubernetesName string
+//   - name string
+//   - kuberneteSecret string
+//   - description *string
+//   - projectID uuid.UUID
+//   - baseEnvironmentID uuid.UUID
+//   - gitRepository string
+//   - pullRequest int
+func (_e *EnvironmentRepositoryMock_Expecter) CreatePreview(ctx interface{}, tx interface{}, kubernetesName interface{}, name interface{}, kuberneteSecret interface{}, description interface{}, projectID interface{}, baseEnvironmentID interface{}, gitRepository interface{}, pullRequest interface{}) *EnvironmentRepositoryMock_CreatePreview_Call {
+	return &EnvironmentRepositoryMock_CreatePreview_Call{Call: _e.mock.On("CreatePreview", ctx, tx, kubernetesName, name, kuberneteSecret, description, projectID, baseEnvironmentID, gitRepository, pullRequest)}
+}
+
+func (_c *EnvironmentRepositoryMock_CreatePreview_Call) Run(run func(ctx context.Context, tx repository.TxInterface, kubernetesName string, name string, kuberneteSecret string, description *string, projectID uuid.UUID, baseEnvironmentID uuid.UUID, gitRepository string, pullRequest int)) *EnvironmentRepositoryMock_CreatePreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(string), args[3].(string), args[4].(string), args[5].(*string), args[6].(uuid.UUID), args[7].(uuid.UUID), args[8].(string), args[9].(int))
+	})
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_CreatePreview_Call) Return(_a0 *ent.Environment, _a1 error) *EnvironmentRepositoryMock_CreatePreview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_CreatePreview_Call) RunAndReturn(run func(context.Context, repository.TxInterface, string, string, string, *string, uuid.UUID, uuid.UUID, string, int) (*ent.Environment, error)) *EnvironmentRepositoryMock_CreatePreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, tx, environmentID
 func (_m *EnvironmentRepositoryMock) Delete(ctx context.Context, tx repository.TxInterface, environmentID uuid.UUID) error {
 	ret := _m.Called(ctx, tx, environmentID)
@@ -261,9 +330,129 @@ func (_c *EnvironmentRepositoryMock_GetForProject_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, environmentID, name, description
-func (_m *EnvironmentRepositoryMock) Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string) (*ent.Environment, error) {
-	ret := _m.Called(ctx, environmentID, name, description)
+// GetPreviews provides a mock function with given fields: ctx, gitRepository, pullRequest
+func (_m *EnvironmentRepositoryMock) GetPreviews(ctx context.Context, gitRepository string, pullRequest int) ([]*ent.Environment, error) {
+	ret := _m.Called(ctx, gitRepository, pullRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviews")
+	}
+
+	var r0 []*ent.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*ent.Environment, error)); ok {
+		return rf(ctx, gitRepository, pullRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*ent.Environment); ok {
+		r0 = rf(ctx, gitRepository, pullRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, gitRepository, pullRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentRepositoryMock_GetPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviews'
+type EnvironmentRepositoryMock_GetPreviews_Call struct {
+	*mock.Call
+}
+
+// GetPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gitRepository string
+//   - pullRequest int
+func (_e *EnvironmentRepositoryMock_Expecter) GetPreviews(ctx interface{}, gitRepository interface{}, pullRequest interface{}) *EnvironmentRepositoryMock_GetPreviews_Call {
+	return &EnvironmentRepositoryMock_GetPreviews_Call{Call: _e.mock.On("GetPreviews", ctx, gitRepository, pullRequest)}
+}
+
+func (_c *EnvironmentRepositoryMock_GetPreviews_Call) Run(run func(ctx context.Context, gitRepository string, pullRequest int)) *EnvironmentRepositoryMock_GetPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_GetPreviews_Call) Return(_a0 []*ent.Environment, _a1 error) *EnvironmentRepositoryMock_GetPreviews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_GetPreviews_Call) RunAndReturn(run func(context.Context, string, int) ([]*ent.Environment, error)) *EnvironmentRepositoryMock_GetPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPreviewCommentID provides a mock function with given fields: ctx, environmentID, commentID
+func (_m *EnvironmentRepositoryMock) SetPreviewCommentID(ctx context.Context, environmentID uuid.UUID, commentID int64) (*ent.Environment, error) {
+	ret := _m.Called(ctx, environmentID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPreviewCommentID")
+	}
+
+	var r0 *ent.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*ent.Environment, error)); ok {
+		return rf(ctx, environmentID, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *ent.Environment); ok {
+		r0 = rf(ctx, environmentID, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, environmentID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentRepositoryMock_SetPreviewCommentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreviewCommentID'
+type EnvironmentRepositoryMock_SetPreviewCommentID_Call struct {
+	*mock.Call
+}
+
+// SetPreviewCommentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - environmentID uuid.UUID
+//   - commentID int64
+func (_e *EnvironmentRepositoryMock_Expecter) SetPreviewCommentID(ctx interface{}, environmentID interface{}, commentID interface{}) *EnvironmentRepositoryMock_SetPreviewCommentID_Call {
+	return &EnvironmentRepositoryMock_SetPreviewCommentID_Call{Call: _e.mock.On("SetPreviewCommentID", ctx, environmentID, commentID)}
+}
+
+func (_c *EnvironmentRepositoryMock_SetPreviewCommentID_Call) Run(run func(ctx context.Context, environmentID uuid.UUID, commentID int64)) *EnvironmentRepositoryMock_SetPreviewCommentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_SetPreviewCommentID_Call) Return(_a0 *ent.Environment, _a1 error) *EnvironmentRepositoryMock_SetPreviewCommentID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentRepositoryMock_SetPreviewCommentID_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*ent.Environment, error)) *EnvironmentRepositoryMock_SetPreviewCommentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, environmentID, name, description, protected, freezeWindows
+func (_m *EnvironmentRepositoryMock) Update(ctx context.Context, environmentID uuid.UUID, name *string, description *string, protected *bool, freezeWindows *[]schema.FreezeWindow) (*ent.Environment, error) {
+	ret := _m.Called(ctx, environmentID, name, description, protected, freezeWindows)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -271,19 +460,19 @@ func (_m *EnvironmentRepositoryMock) Update(ctx context.Context, environmentID u
 
 	var r0 *ent.Environment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string) (*ent.Environment, error)); ok {
-		return rf(ctx, environmentID, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *bool, *[]schema.FreezeWindow) (*ent.Environment, error)); ok {
+		return rf(ctx, environmentID, name, description, protected, freezeWindows)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string) *ent.Environment); ok {
-		r0 = rf(ctx, environmentID, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string, *bool, *[]schema.FreezeWindow) *ent.Environment); ok {
+		r0 = rf(ctx, environmentID, name, description, protected, freezeWindows)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Environment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string) error); ok {
-		r1 = rf(ctx, environmentID, name, description)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string, *bool, *[]schema.FreezeWindow) error); ok {
+		r1 = rf(ctx, environmentID, name, description, protected, freezeWindows)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,13 +490,15 @@ type EnvironmentRepositoryMock_Update_Call struct {
 //   - environmentID uuid.UUID
 //   - name *string
 //   - description *string
-func (_e *EnvironmentRepositoryMock_Expecter) Update(ctx interface{}, environmentID interface{}, name interface{}, description interface{}) *EnvironmentRepositoryMock_Update_Call {
-	return &EnvironmentRepositoryMock_Update_Call{Call: _e.mock.On("Update", ctx, environmentID, name, description)}
+//   - protected *bool
+//   - freezeWindows *[]schema.FreezeWindow
+func (_e *EnvironmentRepositoryMock_Expecter) Update(ctx interface{}, environmentID interface{}, name interface{}, description interface{}, protected interface{}, freezeWindows interface{}) *EnvironmentRepositoryMock_Update_Call {
+	return &EnvironmentRepositoryMock_Update_Call{Call: _e.mock.On("Update", ctx, environmentID, name, description, protected, freezeWindows)}
 }
 
-func (_c *EnvironmentRepositoryMock_Update_Call) Run(run func(ctx context.Context, environmentID uuid.UUID, name *string, description *string)) *EnvironmentRepositoryMock_Update_Call {
+func (_c *EnvironmentRepositoryMock_Update_Call) Run(run func(ctx context.Context, environmentID uuid.UUID, name *string, description *string, protected *bool, freezeWindows *[]schema.FreezeWindow)) *EnvironmentRepositoryMock_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string), args[3].(*string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string), args[3].(*string), args[4].(*bool), args[5].(*[]schema.FreezeWindow))
 	})
 	return _c
 }
@@ -317,7 +508,7 @@ func (_c *EnvironmentRepositoryMock_Update_Call) Return(_a0 *ent.Environment, _a
 	return _c
 }
 
-func (_c *EnvironmentRepositoryMock_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string, *string) (*ent.Environment, error)) *EnvironmentRepositoryMock_Update_Call {
+func (_c *EnvironmentRepositoryMock_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, *string, *string, *bool, *[]schema.FreezeWindow) (*ent.Environment, error)) *EnvironmentRepositoryMock_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
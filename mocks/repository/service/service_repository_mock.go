@@ -18,6 +18,8 @@ import (
 
 	service_repo "github.com/unbindapp/unbind-api/internal/repositories/service"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -261,6 +263,124 @@ func (_c *ServiceRepositoryMock_Delete_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetAutoRollbackCandidates provides a mock function with given fields: ctx, completedAfter
+func (_m *ServiceRepositoryMock) GetAutoRollbackCandidates(ctx context.Context, completedAfter time.Time) ([]*ent.Service, error) {
+	ret := _m.Called(ctx, completedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAutoRollbackCandidates")
+	}
+
+	var r0 []*ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*ent.Service, error)); ok {
+		return rf(ctx, completedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*ent.Service); ok {
+		r0 = rf(ctx, completedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, completedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetAutoRollbackCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAutoRollbackCandidates'
+type ServiceRepositoryMock_GetAutoRollbackCandidates_Call struct {
+	*mock.Call
+}
+
+// GetAutoRollbackCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - completedAfter time.Time
+func (_e *ServiceRepositoryMock_Expecter) GetAutoRollbackCandidates(ctx interface{}, completedAfter interface{}) *ServiceRepositoryMock_GetAutoRollbackCandidates_Call {
+	return &ServiceRepositoryMock_GetAutoRollbackCandidates_Call{Call: _e.mock.On("GetAutoRollbackCandidates", ctx, completedAfter)}
+}
+
+func (_c *ServiceRepositoryMock_GetAutoRollbackCandidates_Call) Run(run func(ctx context.Context, completedAfter time.Time)) *ServiceRepositoryMock_GetAutoRollbackCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetAutoRollbackCandidates_Call) Return(_a0 []*ent.Service, _a1 error) *ServiceRepositoryMock_GetAutoRollbackCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetAutoRollbackCandidates_Call) RunAndReturn(run func(context.Context, time.Time) ([]*ent.Service, error)) *ServiceRepositoryMock_GetAutoRollbackCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByDeployHookToken provides a mock function with given fields: ctx, token
+func (_m *ServiceRepositoryMock) GetByDeployHookToken(ctx context.Context, token string) (*ent.Service, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByDeployHookToken")
+	}
+
+	var r0 *ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*ent.Service, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ent.Service); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetByDeployHookToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByDeployHookToken'
+type ServiceRepositoryMock_GetByDeployHookToken_Call struct {
+	*mock.Call
+}
+
+// GetByDeployHookToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *ServiceRepositoryMock_Expecter) GetByDeployHookToken(ctx interface{}, token interface{}) *ServiceRepositoryMock_GetByDeployHookToken_Call {
+	return &ServiceRepositoryMock_GetByDeployHookToken_Call{Call: _e.mock.On("GetByDeployHookToken", ctx, token)}
+}
+
+func (_c *ServiceRepositoryMock_GetByDeployHookToken_Call) Run(run func(ctx context.Context, token string)) *ServiceRepositoryMock_GetByDeployHookToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetByDeployHookToken_Call) Return(_a0 *ent.Service, _a1 error) *ServiceRepositoryMock_GetByDeployHookToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetByDeployHookToken_Call) RunAndReturn(run func(context.Context, string) (*ent.Service, error)) *ServiceRepositoryMock_GetByDeployHookToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEnvironmentID provides a mock function with given fields: ctx, environmentID, authPredicate, withLatestDeployment
 func (_m *ServiceRepositoryMock) GetByEnvironmentID(ctx context.Context, environmentID uuid.UUID, authPredicate predicate.Service, withLatestDeployment bool) ([]*ent.Service, error) {
 	ret := _m.Called(ctx, environmentID, authPredicate, withLatestDeployment)
@@ -322,6 +442,67 @@ func (_c *ServiceRepositoryMock_GetByEnvironmentID_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetByGitlabConnectionAndRepo provides a mock function with given fields: ctx, connectionID, owner, repoName
+func (_m *ServiceRepositoryMock) GetByGitlabConnectionAndRepo(ctx context.Context, connectionID uuid.UUID, owner string, repoName string) ([]*ent.Service, error) {
+	ret := _m.Called(ctx, connectionID, owner, repoName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGitlabConnectionAndRepo")
+	}
+
+	var r0 []*ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) ([]*ent.Service, error)); ok {
+		return rf(ctx, connectionID, owner, repoName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) []*ent.Service); ok {
+		r0 = rf(ctx, connectionID, owner, repoName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, connectionID, owner, repoName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGitlabConnectionAndRepo'
+type ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call struct {
+	*mock.Call
+}
+
+// GetByGitlabConnectionAndRepo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connectionID uuid.UUID
+//   - owner string
+//   - repoName string
+func (_e *ServiceRepositoryMock_Expecter) GetByGitlabConnectionAndRepo(ctx interface{}, connectionID interface{}, owner interface{}, repoName interface{}) *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call {
+	return &ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call{Call: _e.mock.On("GetByGitlabConnectionAndRepo", ctx, connectionID, owner, repoName)}
+}
+
+func (_c *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call) Run(run func(ctx context.Context, connectionID uuid.UUID, owner string, repoName string)) *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call) Return(_a0 []*ent.Service, _a1 error) *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) ([]*ent.Service, error)) *ServiceRepositoryMock_GetByGitlabConnectionAndRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, serviceID
 func (_m *ServiceRepositoryMock) GetByID(ctx context.Context, serviceID uuid.UUID) (*ent.Service, error) {
 	ret := _m.Called(ctx, serviceID)
@@ -848,6 +1029,64 @@ func (_c *ServiceRepositoryMock_GetGithubPrivateKey_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetImageAutoUpdateServices provides a mock function with given fields: ctx
+func (_m *ServiceRepositoryMock) GetImageAutoUpdateServices(ctx context.Context) ([]*ent.Service, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImageAutoUpdateServices")
+	}
+
+	var r0 []*ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ent.Service, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ent.Service); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetImageAutoUpdateServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImageAutoUpdateServices'
+type ServiceRepositoryMock_GetImageAutoUpdateServices_Call struct {
+	*mock.Call
+}
+
+// GetImageAutoUpdateServices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ServiceRepositoryMock_Expecter) GetImageAutoUpdateServices(ctx interface{}) *ServiceRepositoryMock_GetImageAutoUpdateServices_Call {
+	return &ServiceRepositoryMock_GetImageAutoUpdateServices_Call{Call: _e.mock.On("GetImageAutoUpdateServices", ctx)}
+}
+
+func (_c *ServiceRepositoryMock_GetImageAutoUpdateServices_Call) Run(run func(ctx context.Context)) *ServiceRepositoryMock_GetImageAutoUpdateServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetImageAutoUpdateServices_Call) Return(_a0 []*ent.Service, _a1 error) *ServiceRepositoryMock_GetImageAutoUpdateServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetImageAutoUpdateServices_Call) RunAndReturn(run func(context.Context) ([]*ent.Service, error)) *ServiceRepositoryMock_GetImageAutoUpdateServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPVCMountPaths provides a mock function with given fields: ctx, pvcs
 func (_m *ServiceRepositoryMock) GetPVCMountPaths(ctx context.Context, pvcs []*models.PVCInfo) (map[string]string, error) {
 	ret := _m.Called(ctx, pvcs)
@@ -907,6 +1146,127 @@ func (_c *ServiceRepositoryMock_GetPVCMountPaths_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetPrPreviewServices provides a mock function with given fields: ctx, installationID, repoName
+func (_m *ServiceRepositoryMock) GetPrPreviewServices(ctx context.Context, installationID int64, repoName string) ([]*ent.Service, error) {
+	ret := _m.Called(ctx, installationID, repoName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrPreviewServices")
+	}
+
+	var r0 []*ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]*ent.Service, error)); ok {
+		return rf(ctx, installationID, repoName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []*ent.Service); ok {
+		r0 = rf(ctx, installationID, repoName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, installationID, repoName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetPrPreviewServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrPreviewServices'
+type ServiceRepositoryMock_GetPrPreviewServices_Call struct {
+	*mock.Call
+}
+
+// GetPrPreviewServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installationID int64
+//   - repoName string
+func (_e *ServiceRepositoryMock_Expecter) GetPrPreviewServices(ctx interface{}, installationID interface{}, repoName interface{}) *ServiceRepositoryMock_GetPrPreviewServices_Call {
+	return &ServiceRepositoryMock_GetPrPreviewServices_Call{Call: _e.mock.On("GetPrPreviewServices", ctx, installationID, repoName)}
+}
+
+func (_c *ServiceRepositoryMock_GetPrPreviewServices_Call) Run(run func(ctx context.Context, installationID int64, repoName string)) *ServiceRepositoryMock_GetPrPreviewServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetPrPreviewServices_Call) Return(_a0 []*ent.Service, _a1 error) *ServiceRepositoryMock_GetPrPreviewServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetPrPreviewServices_Call) RunAndReturn(run func(context.Context, int64, string) ([]*ent.Service, error)) *ServiceRepositoryMock_GetPrPreviewServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunningWithPackage provides a mock function with given fields: ctx, authPredicate, name, version
+func (_m *ServiceRepositoryMock) GetRunningWithPackage(ctx context.Context, authPredicate predicate.Service, name string, version string) ([]*ent.Service, error) {
+	ret := _m.Called(ctx, authPredicate, name, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunningWithPackage")
+	}
+
+	var r0 []*ent.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, predicate.Service, string, string) ([]*ent.Service, error)); ok {
+		return rf(ctx, authPredicate, name, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, predicate.Service, string, string) []*ent.Service); ok {
+		r0 = rf(ctx, authPredicate, name, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, predicate.Service, string, string) error); ok {
+		r1 = rf(ctx, authPredicate, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceRepositoryMock_GetRunningWithPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunningWithPackage'
+type ServiceRepositoryMock_GetRunningWithPackage_Call struct {
+	*mock.Call
+}
+
+// GetRunningWithPackage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authPredicate predicate.Service
+//   - name string
+//   - version string
+func (_e *ServiceRepositoryMock_Expecter) GetRunningWithPackage(ctx interface{}, authPredicate interface{}, name interface{}, version interface{}) *ServiceRepositoryMock_GetRunningWithPackage_Call {
+	return &ServiceRepositoryMock_GetRunningWithPackage_Call{Call: _e.mock.On("GetRunningWithPackage", ctx, authPredicate, name, version)}
+}
+
+func (_c *ServiceRepositoryMock_GetRunningWithPackage_Call) Run(run func(ctx context.Context, authPredicate predicate.Service, name string, version string)) *ServiceRepositoryMock_GetRunningWithPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(predicate.Service), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetRunningWithPackage_Call) Return(_a0 []*ent.Service, _a1 error) *ServiceRepositoryMock_GetRunningWithPackage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_GetRunningWithPackage_Call) RunAndReturn(run func(context.Context, predicate.Service, string, string) ([]*ent.Service, error)) *ServiceRepositoryMock_GetRunningWithPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServicesUsingPVC provides a mock function with given fields: ctx, pvcID
 func (_m *ServiceRepositoryMock) GetServicesUsingPVC(ctx context.Context, pvcID string) ([]*ent.Service, error) {
 	ret := _m.Called(ctx, pvcID)
@@ -1129,6 +1489,104 @@ func (_c *ServiceRepositoryMock_SetCurrentDeployment_Call) RunAndReturn(run func
 	return _c
 }
 
+// SetDeployHookToken provides a mock function with given fields: ctx, tx, serviceID, token
+func (_m *ServiceRepositoryMock) SetDeployHookToken(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, token *string) error {
+	ret := _m.Called(ctx, tx, serviceID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDeployHookToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, *string) error); ok {
+		r0 = rf(ctx, tx, serviceID, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceRepositoryMock_SetDeployHookToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDeployHookToken'
+type ServiceRepositoryMock_SetDeployHookToken_Call struct {
+	*mock.Call
+}
+
+// SetDeployHookToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - serviceID uuid.UUID
+//   - token *string
+func (_e *ServiceRepositoryMock_Expecter) SetDeployHookToken(ctx interface{}, tx interface{}, serviceID interface{}, token interface{}) *ServiceRepositoryMock_SetDeployHookToken_Call {
+	return &ServiceRepositoryMock_SetDeployHookToken_Call{Call: _e.mock.On("SetDeployHookToken", ctx, tx, serviceID, token)}
+}
+
+func (_c *ServiceRepositoryMock_SetDeployHookToken_Call) Run(run func(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, token *string)) *ServiceRepositoryMock_SetDeployHookToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_SetDeployHookToken_Call) Return(_a0 error) *ServiceRepositoryMock_SetDeployHookToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_SetDeployHookToken_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, *string) error) *ServiceRepositoryMock_SetDeployHookToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetImageDigest provides a mock function with given fields: ctx, tx, serviceID, digest
+func (_m *ServiceRepositoryMock) SetImageDigest(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, digest *string) error {
+	ret := _m.Called(ctx, tx, serviceID, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetImageDigest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.TxInterface, uuid.UUID, *string) error); ok {
+		r0 = rf(ctx, tx, serviceID, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceRepositoryMock_SetImageDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetImageDigest'
+type ServiceRepositoryMock_SetImageDigest_Call struct {
+	*mock.Call
+}
+
+// SetImageDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx repository.TxInterface
+//   - serviceID uuid.UUID
+//   - digest *string
+func (_e *ServiceRepositoryMock_Expecter) SetImageDigest(ctx interface{}, tx interface{}, serviceID interface{}, digest interface{}) *ServiceRepositoryMock_SetImageDigest_Call {
+	return &ServiceRepositoryMock_SetImageDigest_Call{Call: _e.mock.On("SetImageDigest", ctx, tx, serviceID, digest)}
+}
+
+func (_c *ServiceRepositoryMock_SetImageDigest_Call) Run(run func(ctx context.Context, tx repository.TxInterface, serviceID uuid.UUID, digest *string)) *ServiceRepositoryMock_SetImageDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.TxInterface), args[2].(uuid.UUID), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_SetImageDigest_Call) Return(_a0 error) *ServiceRepositoryMock_SetImageDigest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceRepositoryMock_SetImageDigest_Call) RunAndReturn(run func(context.Context, repository.TxInterface, uuid.UUID, *string) error) *ServiceRepositoryMock_SetImageDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SummarizeServices provides a mock function with given fields: ctx, environmentIDs
 func (_m *ServiceRepositoryMock) SummarizeServices(ctx context.Context, environmentIDs []uuid.UUID) (map[uuid.UUID]int, map[uuid.UUID][]string, error) {
 	ret := _m.Called(ctx, environmentIDs)
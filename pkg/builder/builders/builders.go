@@ -4,13 +4,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/containerd/platforms"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/log"
 	"github.com/unbindapp/unbind-api/internal/common/utils"
 	"github.com/unbindapp/unbind-api/pkg/builder/config"
+	"github.com/unbindapp/unbind-api/pkg/builder/internal/buildkit"
 )
 
 type Builder struct {
 	config *config.Config
+	// What the last build reported, nil until something was built
+	lastBuild *buildkit.BuildResult
 }
 
 func NewBuilder(config *config.Config) *Builder {
@@ -19,14 +25,49 @@ func NewBuilder(config *config.Config) *Builder {
 	}
 }
 
+// PlatformBuilds returns how each platform of the last build went, empty unless the service builds for specific platforms
+func (self *Builder) PlatformBuilds() []schema.PlatformBuild {
+	if self.lastBuild == nil {
+		return nil
+	}
+	return self.lastBuild.PlatformBuilds
+}
+
+// SBOMPackages returns the packages in the SBOM of the last pushed image
+func (self *Builder) SBOMPackages() []schema.ImagePackage {
+	if self.lastBuild == nil {
+		return nil
+	}
+	return self.lastBuild.Packages
+}
+
+// Parses the platforms the service is built for, empty for the builder's own platform
+func (self *Builder) targetPlatforms() ([]specs.Platform, error) {
+	targets := make([]specs.Platform, 0, len(self.config.ServicePlatforms))
+	for _, value := range self.config.ServicePlatforms {
+		platform, err := platforms.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid platform %s: %w", value, err)
+		}
+		targets = append(targets, platforms.Normalize(platform))
+	}
+	return targets, nil
+}
+
 // Generates build metadata:
 // - repoName: name of the repository to be used for the image name (like git repo name)
 // - outputImage: the name of the image to be built and pushed
 // - cacheKey: the key to be used for caching the build in the registry
 func (self *Builder) GenerateBuildMetadata() (repoName string, outputImage string, cacheKey string) {
 	// -- Generate image name
-	repoName, err := utils.ExtractRepoName(self.config.GitRepoURL)
-	if err != nil {
+	// Uploads have no repository, the service name keeps the cache stable across uploads
+	var err error
+	if self.config.SourceArchiveKey != "" {
+		repoName = self.config.ServiceName
+	} else {
+		repoName, err = utils.ExtractRepoName(self.config.GitRepoURL)
+	}
+	if err != nil || repoName == "" {
 		log.Warnf("Failed to extract repository name: %v", err)
 		repoName = fmt.Sprintf("unbind-build-%d", time.Now().Unix())
 	}
@@ -0,0 +1,83 @@
+package builders
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+
+	a "github.com/railwayapp/railpack/core/app"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/pkg/builder/internal/buildkit"
+)
+
+// DefaultBuildpacksBuilderImage is used when the service doesn't choose a builder image
+const DefaultBuildpacksBuilderImage = "paketobuildpacks/builder-jammy-base:latest"
+
+// Builds with Cloud Native Buildpacks, running the lifecycle from the builder image on buildkit
+func (self *Builder) BuildWithBuildpacks(ctx context.Context, buildSecrets map[string]string) (imageName, repoName string, err error) {
+	// Metadata
+	repoName, outputImage, cacheKey := self.GenerateBuildMetadata()
+
+	// -- Fetch source
+	tmpDir, err := self.fetchSource(ctx)
+	if err != nil {
+		log.Error("Error fetching source", "err", err)
+		return "", "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	builderImage := self.config.ServiceBuildpacksBuilderImage
+	if builderImage == "" {
+		builderImage = DefaultBuildpacksBuilderImage
+	}
+
+	targetPlatforms, err := self.targetPlatforms()
+	if err != nil {
+		return "", repoName, err
+	}
+
+	// Make app from source
+	app, err := a.NewApp(tmpDir)
+	if err != nil {
+		return "", repoName, fmt.Errorf("error creating app: %w", err)
+	}
+
+	self.lastBuild, err = buildkit.BuildWithBuildkitClient(
+		self.config,
+		app.Source,
+		buildkit.BuildWithBuildkitClientOptions{
+			ImageName:   outputImage,
+			CacheKey:    cacheKey,
+			Platforms:   targetPlatforms,
+			Secrets:     buildSecrets,
+			SecretsHash: secretsHash(buildSecrets),
+			Buildpacks: &buildkit.BuildpacksOptions{
+				BuilderImage: builderImage,
+			},
+		},
+	)
+	if err != nil {
+		return "", repoName, fmt.Errorf("build failed: %v", err)
+	}
+
+	log.Infof("Built image %s", outputImage)
+	return outputImage, repoName, nil
+}
+
+// secretsHash changes whenever a secret does, so steps using them aren't served from cache
+func secretsHash(secrets map[string]string) string {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s=%s\n", name, secrets[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
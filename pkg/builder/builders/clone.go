@@ -0,0 +1,42 @@
+package builders
+
+import (
+	"context"
+
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/integrations/github"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitlab"
+	"github.com/unbindapp/unbind-api/internal/integrations/gitssh"
+)
+
+// cloneRepository clones the ref to build with the git provider's credentials, returning the checkout directory
+func (self *Builder) cloneRepository(ctx context.Context) (string, error) {
+	log.Infof("Cloning ref '%s' from '%s'", self.config.GitRef, self.config.GitRepoURL)
+
+	switch self.config.GitProvider {
+	case "gitlab":
+		return gitlab.NewGitlabClient(nil, nil).CloneRepository(ctx,
+			self.config.GitlabAccessToken,
+			self.config.GitRepoURL,
+			self.config.GitRef,
+			self.config.CheckoutCommitSHA,
+		)
+	case "ssh":
+		return gitssh.CloneRepository(ctx,
+			self.config.GitRepoURL,
+			self.config.GitSSHPrivateKey,
+			self.config.GitSSHHostKey,
+			self.config.GitRef,
+			self.config.CheckoutCommitSHA,
+		)
+	default:
+		return github.NewGithubClient(self.config.GithubURL, nil).CloneRepository(ctx,
+			self.config.GithubAppID,
+			self.config.GithubInstallationID,
+			self.config.GithubAppPrivateKey,
+			self.config.GitRepoURL,
+			self.config.GitRef,
+			self.config.CheckoutCommitSHA,
+		)
+	}
+}
@@ -2,13 +2,14 @@ package builders
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
+	"regexp"
 
 	a "github.com/railwayapp/railpack/core/app"
 	"github.com/unbindapp/unbind-api/internal/common/log"
-	"github.com/unbindapp/unbind-api/internal/integrations/github"
 	"github.com/unbindapp/unbind-api/pkg/builder/internal/buildkit"
 )
 
@@ -17,22 +18,10 @@ func (self *Builder) BuildDockerfile(ctx context.Context, buildSecrets map[strin
 	// Metadata
 	repoName, outputImage, cacheKey := self.GenerateBuildMetadata()
 
-	// -- Create github client
-	ghClient := github.NewGithubClient(self.config.GithubURL, nil)
-
-	// -- Clone repository
-	log.Infof("Cloning ref '%s' from '%s'", self.config.GitRef, self.config.GitRepoURL)
-	// Clone repository to infer information
-	tmpDir, err := ghClient.CloneRepository(ctx,
-		self.config.GithubAppID,
-		self.config.GithubInstallationID,
-		self.config.GithubAppPrivateKey,
-		self.config.GitRepoURL,
-		self.config.GitRef,
-		self.config.CheckoutCommitSHA,
-	)
+	// -- Fetch source
+	tmpDir, err := self.fetchSource(ctx)
 	if err != nil {
-		log.Error("Error cloning repository", "err", err)
+		log.Error("Error fetching source", "err", err)
 		return "", "", err
 	}
 	defer os.RemoveAll(tmpDir)
@@ -48,6 +37,16 @@ func (self *Builder) BuildDockerfile(ctx context.Context, buildSecrets map[strin
 		return "", repoName, fmt.Errorf("dockerfile not found at path: %s", self.config.ServiceDockerBuilderDockerfilePath)
 	}
 
+	buildArgs, buildContexts, err := self.dockerBuildOptions(buildSecrets)
+	if err != nil {
+		return "", repoName, err
+	}
+
+	targetPlatforms, err := self.targetPlatforms()
+	if err != nil {
+		return "", repoName, err
+	}
+
 	// Make app from source
 	app, err := a.NewApp(tmpDir)
 	if err != nil {
@@ -55,15 +54,19 @@ func (self *Builder) BuildDockerfile(ctx context.Context, buildSecrets map[strin
 	}
 
 	// Build using BuildKit
-	err = buildkit.BuildWithBuildkitClient(
+	self.lastBuild, err = buildkit.BuildWithBuildkitClient(
 		self.config,
 		app.Source,
 		buildkit.BuildWithBuildkitClientOptions{
 			ImageName:      outputImage,
 			CacheKey:       cacheKey,
+			Platforms:      targetPlatforms,
 			Secrets:        buildSecrets,
 			DockerfilePath: self.config.ServiceDockerBuilderDockerfilePath,
 			ContextPath:    self.config.ServiceDockerBuilderBuildContext,
+			Target:         self.config.ServiceDockerBuilderTarget,
+			BuildArgs:      buildArgs,
+			BuildContexts:  buildContexts,
 		},
 	)
 	if err != nil {
@@ -73,3 +76,39 @@ func (self *Builder) BuildDockerfile(ctx context.Context, buildSecrets map[strin
 	log.Infof("Built image %s from Dockerfile: %s", outputImage, self.config.ServiceDockerBuilderDockerfilePath)
 	return outputImage, repoName, nil
 }
+
+var variableReferenceRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// dockerBuildOptions decodes the build args and named contexts, expanding ${NAME} references to service variables in args
+func (self *Builder) dockerBuildOptions(variables map[string]string) (buildArgs, buildContexts map[string]string, err error) {
+	if self.config.ServiceDockerBuilderBuildArgs != "" {
+		if err := json.Unmarshal([]byte(self.config.ServiceDockerBuilderBuildArgs), &buildArgs); err != nil {
+			return nil, nil, fmt.Errorf("invalid build args: %w", err)
+		}
+		for name, value := range buildArgs {
+			buildArgs[name] = expandVariableReferences(value, variables)
+		}
+	}
+
+	if self.config.ServiceDockerBuilderBuildContexts != "" {
+		if err := json.Unmarshal([]byte(self.config.ServiceDockerBuilderBuildContexts), &buildContexts); err != nil {
+			return nil, nil, fmt.Errorf("invalid build contexts: %w", err)
+		}
+	}
+
+	return buildArgs, buildContexts, nil
+}
+
+// expandVariableReferences replaces ${NAME} with the variable's value, unknown references are left as they are
+// Build args are stored in the image history, variables are also passed as build secrets for anything sensitive
+func expandVariableReferences(value string, variables map[string]string) string {
+	return variableReferenceRegex.ReplaceAllStringFunc(value, func(reference string) string {
+		name := reference[2 : len(reference)-1]
+		if v, ok := variables[name]; ok {
+			log.Warnf("Build arg references variable %s, its value is stored in the image history - read secrets with RUN --mount=type=secret,id=%s instead", name, name)
+			return v
+		}
+		log.Warnf("Build arg references unknown variable %s", name)
+		return reference
+	})
+}
@@ -0,0 +1,38 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbindapp/unbind-api/pkg/builder/config"
+)
+
+func TestExpandVariableReferences(t *testing.T) {
+	variables := map[string]string{
+		"API_URL": "https://api.example.com",
+		"VERSION": "1.2.3",
+	}
+
+	assert.Equal(t, "https://api.example.com/v1", expandVariableReferences("${API_URL}/v1", variables))
+	assert.Equal(t, "1.2.3-1.2.3", expandVariableReferences("${VERSION}-${VERSION}", variables))
+	assert.Equal(t, "${MISSING}", expandVariableReferences("${MISSING}", variables))
+	assert.Equal(t, "$VERSION", expandVariableReferences("$VERSION", variables))
+	assert.Equal(t, "plain", expandVariableReferences("plain", variables))
+}
+
+func TestDockerBuildOptions(t *testing.T) {
+	builder := NewBuilder(&config.Config{
+		ServiceDockerBuilderBuildArgs:     `{"NODE_ENV":"production","API_URL":"${API_URL}"}`,
+		ServiceDockerBuilderBuildContexts: `{"shared":"packages/shared","base":"docker-image://alpine:3.20"}`,
+	})
+
+	buildArgs, buildContexts, err := builder.dockerBuildOptions(map[string]string{"API_URL": "https://api.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"NODE_ENV": "production", "API_URL": "https://api.example.com"}, buildArgs)
+	assert.Equal(t, map[string]string{"shared": "packages/shared", "base": "docker-image://alpine:3.20"}, buildContexts)
+
+	builder = NewBuilder(&config.Config{ServiceDockerBuilderBuildArgs: "not json"})
+	_, _, err = builder.dockerBuildOptions(nil)
+	assert.Error(t, err)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/railwayapp/railpack/core"
 	a "github.com/railwayapp/railpack/core/app"
 	"github.com/unbindapp/unbind-api/internal/common/log"
-	"github.com/unbindapp/unbind-api/internal/integrations/github"
 	"github.com/unbindapp/unbind-api/pkg/builder/internal/buildkit"
 )
 
@@ -16,23 +15,10 @@ func (self *Builder) BuildWithRailpack(ctx context.Context, buildSecrets map[str
 	// Metadata
 	repoName, outputImage, cacheKey := self.GenerateBuildMetadata()
 
-	// -- Create github client
-	ghClient := github.NewGithubClient(self.config.GithubURL, nil)
-
-	// -- Clone repository
-	log.Infof("Cloning ref '%s' from '%s'", self.config.GitRef, self.config.GitRepoURL)
-
-	// Clone repository to infer information
-	tmpDir, err := ghClient.CloneRepository(ctx,
-		self.config.GithubAppID,
-		self.config.GithubInstallationID,
-		self.config.GithubAppPrivateKey,
-		self.config.GitRepoURL,
-		self.config.GitRef,
-		self.config.CheckoutCommitSHA,
-	)
+	// -- Fetch source
+	tmpDir, err := self.fetchSource(ctx)
 	if err != nil {
-		log.Error("Error cloning repository", "err", err)
+		log.Error("Error fetching source", "err", err)
 		return "", "", err
 	}
 	defer os.RemoveAll(tmpDir)
@@ -49,13 +35,19 @@ func (self *Builder) BuildWithRailpack(ctx context.Context, buildSecrets map[str
 		return "", repoName, fmt.Errorf("build failed")
 	}
 
-	err = buildkit.BuildWithBuildkitClient(
+	targetPlatforms, err := self.targetPlatforms()
+	if err != nil {
+		return "", repoName, err
+	}
+
+	self.lastBuild, err = buildkit.BuildWithBuildkitClient(
 		self.config,
 		app.Source,
 		buildkit.BuildWithBuildkitClientOptions{
 			ImageName:         outputImage,
 			RailpackBuildPlan: buildResult.Plan,
 			CacheKey:          cacheKey,
+			Platforms:         targetPlatforms,
 			Secrets:           buildSecrets,
 		},
 	)
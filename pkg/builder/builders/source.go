@@ -0,0 +1,55 @@
+package builders
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
+	"github.com/unbindapp/unbind-api/internal/infrastructure/s3"
+)
+
+// fetchSource gets the source to build, an uploaded archive or a git checkout, returning its directory
+func (self *Builder) fetchSource(ctx context.Context) (string, error) {
+	if self.config.SourceArchiveKey != "" {
+		return self.downloadSourceArchive(ctx)
+	}
+	if self.config.ServiceType == schema.ServiceTypeUpload {
+		return "", fmt.Errorf("no source archive to build, upload one first")
+	}
+	return self.cloneRepository(ctx)
+}
+
+// downloadSourceArchive extracts the uploaded archive from S3 into a temporary directory
+func (self *Builder) downloadSourceArchive(ctx context.Context) (string, error) {
+	log.Infof("Downloading source archive '%s'", self.config.SourceArchiveKey)
+
+	client, err := s3.NewS3Client(ctx,
+		self.config.SourceArchiveS3Endpoint,
+		self.config.SourceArchiveS3Region,
+		self.config.SourceArchiveS3AccessKeyID,
+		self.config.SourceArchiveS3SecretKey,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := client.DownloadObject(ctx, self.config.SourceArchiveS3Bucket, self.config.SourceArchiveKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to download source archive: %w", err)
+	}
+	defer body.Close()
+
+	tmpDir, err := os.MkdirTemp("", "unbind-source-*")
+	if err != nil {
+		return "", err
+	}
+	if err := utils.ExtractTarGz(body, tmpDir, utils.MaxExtractedSourceSize); err != nil {
+		os.RemoveAll(tmpDir)
+		return "", fmt.Errorf("failed to extract source archive: %w", err)
+	}
+
+	return tmpDir, nil
+}
@@ -19,8 +19,22 @@ type Config struct {
 	GithubInstallationID int64 `env:"GITHUB_INSTALLATION_ID"`
 	// Repository to clone (github, https)
 	GitRepoURL string `env:"GITHUB_REPO_URL"`
+	// Provider the repository is hosted on, github unless set
+	GitProvider string `env:"GIT_PROVIDER" envDefault:"github"`
+	// Token to clone gitlab repositories with
+	GitlabAccessToken string `env:"GITLAB_ACCESS_TOKEN"`
+	// Deploy key and pinned host key to clone generic git repositories over SSH with
+	GitSSHPrivateKey string `env:"GIT_SSH_PRIVATE_KEY"`
+	GitSSHHostKey    string `env:"GIT_SSH_HOST_KEY"`
 	// Branch to checkout and build
 	GitRef string `env:"GIT_REF"`
+	// Uploaded source archive to build instead of a git repository, and the S3 storage it's in
+	SourceArchiveKey           string `env:"SOURCE_ARCHIVE_KEY"`
+	SourceArchiveS3Endpoint    string `env:"SOURCE_ARCHIVE_S3_ENDPOINT"`
+	SourceArchiveS3Region      string `env:"SOURCE_ARCHIVE_S3_REGION"`
+	SourceArchiveS3Bucket      string `env:"SOURCE_ARCHIVE_S3_BUCKET"`
+	SourceArchiveS3AccessKeyID string `env:"SOURCE_ARCHIVE_S3_ACCESS_KEY_ID"`
+	SourceArchiveS3SecretKey   string `env:"SOURCE_ARCHIVE_S3_SECRET_KEY"`
 	// Github URL (if using github enterprise)
 	GithubURL string `env:"GITHUB_URL" envDefault:"https://github.com"`
 	// Github app private key
@@ -41,24 +55,32 @@ type Config struct {
 	// Deployment namespace (kubernetes)
 	DeploymentNamespace string `env:"DEPLOYMENT_NAMESPACE,required"`
 	// Service specific
-	ServiceDeploymentID                uuid.UUID             `env:"SERVICE_DEPLOYMENT_ID"`
-	ServiceName                        string                `env:"SERVICE_NAME"`
-	ServiceProvider                    string                `env:"SERVICE_PROVIDER"`
-	ServiceFramework                   string                `env:"SERVICE_FRAMEWORK"`
-	ServicePublic                      *bool                 `env:"SERVICE_PUBLIC"`
-	ServiceReplicas                    *int32                `env:"SERVICE_REPLICAS"`
-	ServiceSecretName                  string                `env:"SERVICE_SECRET_NAME,required"`
-	ServiceBuildSecrets                string                `env:"SERVICE_BUILD_SECRETS"`
-	ServiceType                        schema.ServiceType    `env:"SERVICE_TYPE"`
-	ServiceBuilder                     schema.ServiceBuilder `env:"SERVICE_BUILDER"`
-	ServiceTeamRef                     string                `env:"SERVICE_TEAM_REF"`
-	ServiceProjectRef                  string                `env:"SERVICE_PROJECT_REF"`
-	ServiceEnvironmentRef              string                `env:"SERVICE_ENVIRONMENT_REF"`
-	ServiceRef                         string                `env:"SERVICE_REF"`
-	ServiceDockerBuilderDockerfilePath string                `env:"SERVICE_DOCKER_BUILDER_DOCKERFILE_PATH"` // Path to Dockerfile in the repo (optional)
-	ServiceDockerBuilderBuildContext   string                `env:"SERVICE_DOCKER_BUILDER_BUILD_CONTEXT"`   // Path to Dockerfile context in the repo (optional)
-	ServiceImage                       string                `env:"SERVICE_IMAGE"`                          // Custom image if not building from git
-	ServiceRunCommand                  string                `env:"SERVICE_RUN_COMMAND"`                    // Command to run the service
+	ServiceDeploymentID                uuid.UUID              `env:"SERVICE_DEPLOYMENT_ID"`
+	ServiceName                        string                 `env:"SERVICE_NAME"`
+	ServiceProvider                    string                 `env:"SERVICE_PROVIDER"`
+	ServiceFramework                   string                 `env:"SERVICE_FRAMEWORK"`
+	ServicePublic                      *bool                  `env:"SERVICE_PUBLIC"`
+	ServiceReplicas                    *int32                 `env:"SERVICE_REPLICAS"`
+	ServiceSecretName                  string                 `env:"SERVICE_SECRET_NAME,required"`
+	ServiceBuildSecrets                string                 `env:"SERVICE_BUILD_SECRETS"`
+	ServiceType                        schema.ServiceType     `env:"SERVICE_TYPE"`
+	ServiceBuilder                     schema.ServiceBuilder  `env:"SERVICE_BUILDER"`
+	ServiceTeamRef                     string                 `env:"SERVICE_TEAM_REF"`
+	ServiceProjectRef                  string                 `env:"SERVICE_PROJECT_REF"`
+	ServiceEnvironmentRef              string                 `env:"SERVICE_ENVIRONMENT_REF"`
+	ServiceRef                         string                 `env:"SERVICE_REF"`
+	ServiceDockerBuilderDockerfilePath string                 `env:"SERVICE_DOCKER_BUILDER_DOCKERFILE_PATH"` // Path to Dockerfile in the repo (optional)
+	ServiceDockerBuilderBuildContext   string                 `env:"SERVICE_DOCKER_BUILDER_BUILD_CONTEXT"`   // Path to Dockerfile context in the repo (optional)
+	ServiceDockerBuilderTarget         string                 `env:"SERVICE_DOCKER_BUILDER_TARGET"`          // Dockerfile stage to build (optional)
+	ServiceDockerBuilderBuildArgs      string                 `env:"SERVICE_DOCKER_BUILDER_BUILD_ARGS"`      // JSON map of build args (optional)
+	ServiceDockerBuilderBuildContexts  string                 `env:"SERVICE_DOCKER_BUILDER_BUILD_CONTEXTS"`  // JSON map of named build contexts (optional)
+	ServiceBuildpacksBuilderImage      string                 `env:"SERVICE_BUILDPACKS_BUILDER_IMAGE"`       // CNB builder image (optional)
+	ServicePlatforms                   []string               `env:"SERVICE_PLATFORMS"`                      // Comma separated platforms to build for (optional)
+	ServiceImage                       string                 `env:"SERVICE_IMAGE"`                          // Custom image if not building from git
+	ServiceRunCommand                  string                 `env:"SERVICE_RUN_COMMAND"`                    // Command to run the service
+	ServicePreDeployCommand            string                 `env:"SERVICE_PRE_DEPLOY_COMMAND"`             // Command to run with the new image before rollout
+	ServiceRolloutStrategy             schema.RolloutStrategy `env:"SERVICE_ROLLOUT_STRATEGY"`               // Blue-green or canary, empty to roll out in place
+	ServiceCanaryWeight                int                    `env:"SERVICE_CANARY_WEIGHT"`                  // Percentage of traffic for a canary
 	// Database data
 	ServiceDatabaseType              string `env:"SERVICE_DATABASE_TYPE"`
 	ServiceDatabaseDefinitionVersion string `env:"SERVICE_DATABASE_USD_VERSION"`
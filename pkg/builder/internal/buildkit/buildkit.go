@@ -1,9 +1,11 @@
 package buildkit
 
 import (
-	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,7 +13,7 @@ import (
 	"github.com/docker/cli/cli/config/configfile"
 	"github.com/docker/cli/cli/config/types"
 	"github.com/moby/buildkit/client"
-	"github.com/moby/buildkit/client/llb"
+	gateway "github.com/moby/buildkit/frontend/gateway/client"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/auth/authprovider"
 	"github.com/moby/buildkit/session/secrets/secretsprovider"
@@ -20,7 +22,9 @@ import (
 	rpBuildkit "github.com/railwayapp/railpack/buildkit"
 	"github.com/railwayapp/railpack/core/plan"
 	"github.com/tonistiigi/fsutil"
+	"github.com/unbindapp/unbind-api/ent/schema"
 	"github.com/unbindapp/unbind-api/internal/common/log"
+	"github.com/unbindapp/unbind-api/internal/common/utils"
 	"github.com/unbindapp/unbind-api/pkg/builder/config"
 )
 
@@ -33,9 +37,26 @@ type BuildWithBuildkitClientOptions struct {
 	CacheKey          string
 	DockerfilePath    string
 	ContextPath       string
+	Target            string
+	BuildArgs         map[string]string
+	// Name to a path relative to the app directory, or a source buildkit resolves itself like docker-image://
+	BuildContexts map[string]string
+	Buildpacks    *BuildpacksOptions
+	// Builds a manifest list with an image for each, empty builds for the build platform only
+	Platforms []specs.Platform
 }
 
-func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBuildkitClientOptions) error {
+// What a build reports besides the pushed image
+type BuildResult struct {
+	// Outcome of each platform, only if opts.Platforms was given
+	PlatformBuilds []schema.PlatformBuild
+	// Packages in the image's SBOM, across all platforms
+	Packages []schema.ImagePackage
+}
+
+// BuildWithBuildkitClient builds and pushes the image with SBOM and provenance attestations
+// The result is returned with an error too, it has the outcome of each platform of a failed multi-platform build
+func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBuildkitClientOptions) (*BuildResult, error) {
 	ctx := appcontext.Context()
 
 	imageName := opts.ImageName
@@ -50,7 +71,7 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 			// For Docker Hub, we expect the format: username/repository:tag
 			// Don't prepend docker.io to the image name
 			if !strings.Contains(imageName, "/") {
-				return fmt.Errorf("docker hub requires image name in format: username/repository[:tag]")
+				return nil, fmt.Errorf("docker hub requires image name in format: username/repository[:tag]")
 			}
 		} else {
 			// For other registries, prepend registry URL if needed
@@ -71,14 +92,14 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 
 	c, err := client.New(ctx, cfg.BuildkitHost)
 	if err != nil {
-		return fmt.Errorf("failed to connect to buildkit: %w", err)
+		return nil, fmt.Errorf("failed to connect to buildkit: %w", err)
 	}
 	defer c.Close()
 
 	// Get the buildkit info early so we can ensure we can connect to the buildkit host
 	info, err := c.Info(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get buildkit info: %w", err)
+		return nil, fmt.Errorf("failed to get buildkit info: %w", err)
 	}
 
 	buildPlatform := opts.Platform
@@ -113,7 +134,7 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 	// Create a filesystem for the context directory
 	contextFS, err := fsutil.NewFS(contextPath)
 	if err != nil {
-		return fmt.Errorf("error creating context FS: %w", err)
+		return nil, fmt.Errorf("error creating context FS: %w", err)
 	}
 
 	log.Infof("Building image for %s with BuildKit %s", platforms.Format(buildPlatform), info.BuildkitVersion.Version)
@@ -162,9 +183,14 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 			"context": contextFS,
 		},
 		Session: sessionAttachables,
+		// The SBOM is generated by the build itself, see scanImage
+		// Provenance is kept minimal, the full build definition would include build args that may hold variable values
+		FrontendAttrs: map[string]string{
+			"attest:provenance": "mode=min",
+		},
 	}
 
-	var def *llb.Definition
+	var platformBuild platformBuildFunc
 	exportAttrs := map[string]string{
 		"name":              imageName,
 		"push":              "true",
@@ -172,6 +198,19 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 		"compression-level": "3",
 	}
 
+	// Every platform solves inside a gateway build, which imports the cache itself
+	var cacheImports []gateway.CacheOptionsEntry
+	if opts.CacheKey != "" && !cfg.DisableBuildCache {
+		cacheImports = []gateway.CacheOptionsEntry{
+			{
+				Type: "registry",
+				Attrs: map[string]string{
+					"ref": opts.CacheKey,
+				},
+			},
+		}
+	}
+
 	if opts.DockerfilePath != "" {
 		// Using Dockerfile frontend
 		log.Infof("Building image from Dockerfile: %s with BuildKit %s", opts.DockerfilePath, info.BuildkitVersion.Version)
@@ -195,50 +234,65 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 		// Create filesystem for the Dockerfile directory
 		dockerfileFS, err := fsutil.NewFS(dockerfileDir)
 		if err != nil {
-			return fmt.Errorf("error creating Dockerfile FS: %w", err)
+			return nil, fmt.Errorf("error creating Dockerfile FS: %w", err)
 		}
 
 		// Always add the Dockerfile mount
 		solveOpts.LocalMounts["dockerfile"] = dockerfileFS
 
-		// Set the frontend to use Dockerfile
-		solveOpts.Frontend = "dockerfile.v0"
-		solveOpts.FrontendAttrs = map[string]string{
+		// Attributes for the Dockerfile frontend
+		frontendAttrs := map[string]string{
 			"filename": dockerfileBasename,
 		}
-	} else if opts.RailpackBuildPlan != nil {
-		buildPlatform := opts.Platform
-		if buildPlatform.OS == "" {
-			buildPlatform, _ = rpBuildkit.ParsePlatformWithDefaults("")
+		if opts.Target != "" {
+			frontendAttrs["target"] = opts.Target
 		}
-
-		log.Infof("Building image for %s with BuildKit %s", platforms.Format(buildPlatform), info.BuildkitVersion.Version)
-
-		llbState, image, err := rpBuildkit.ConvertPlanToLLB(opts.RailpackBuildPlan, rpBuildkit.ConvertPlanOptions{
-			BuildPlatform: buildPlatform,
-			SecretsHash:   opts.SecretsHash,
-			CacheKey:      opts.CacheKey,
-		})
-		if err != nil {
-			return fmt.Errorf("error converting plan to LLB: %w", err)
+		for name, value := range opts.BuildArgs {
+			frontendAttrs["build-arg:"+name] = value
 		}
 
-		imageBytes, err := json.Marshal(image)
-		if err != nil {
-			return fmt.Errorf("error marshalling image: %w", err)
-		}
+		// Repository paths are sent as extra local mounts, other sources are left to the frontend
+		contextNames := slices.Sorted(maps.Keys(opts.BuildContexts))
+		for i, name := range contextNames {
+			source := opts.BuildContexts[name]
+			if utils.IsRemoteBuildContext(source) {
+				frontendAttrs["context:"+name] = source
+				continue
+			}
 
-		def, err = llbState.Marshal(ctx, llb.LinuxAmd64)
-		if err != nil {
-			return fmt.Errorf("error marshaling LLB state: %w", err)
+			root := filepath.Clean(appDir)
+			contextDir := filepath.Join(root, source)
+			if contextDir != root && !strings.HasPrefix(contextDir, root+string(os.PathSeparator)) {
+				return nil, fmt.Errorf("build context %s is outside of the repository", name)
+			}
+			namedContextFS, err := fsutil.NewFS(contextDir)
+			if err != nil {
+				return nil, fmt.Errorf("error creating build context %s FS: %w", name, err)
+			}
+			mountName := fmt.Sprintf("context-%d", i)
+			solveOpts.LocalMounts[mountName] = namedContextFS
+			frontendAttrs["context:"+name] = "local:" + mountName
 		}
 
-		// Export attributes for BuildPlan
-		exportAttrs["containerimage.config"] = string(imageBytes)
+		// Each platform is its own frontend solve so they're timed, scanned and reported separately
+		platformBuild = dockerfilePlatformBuild(frontendAttrs)
+	} else if opts.RailpackBuildPlan != nil {
+		platformBuild = railpackPlatformBuild(opts)
+	} else if opts.Buildpacks != nil {
+		platformBuild = buildpacksPlatformBuild(opts)
 	} else {
-		return fmt.Errorf("no Dockerfile or Railpack build plan provided")
+		return nil, fmt.Errorf("no Dockerfile, Railpack build plan or buildpacks builder provided")
 	}
 
+	targets := opts.Platforms
+	if len(targets) == 0 {
+		targets = []specs.Platform{buildPlatform}
+	} else {
+		log.Infof("Building image for %s", strings.Join(formatPlatforms(targets), ", "))
+	}
+	result := &BuildResult{}
+	buildFunc := multiPlatformBuildFunc(targets, platformBuild, cacheImports, result)
+
 	// Set the export configuration
 	solveOpts.Exports = []client.ExportEntry{
 		{
@@ -268,20 +322,27 @@ func BuildWithBuildkitClient(cfg *config.Config, appDir string, opts BuildWithBu
 	}
 
 	startTime := time.Now()
-	_, err = c.Solve(ctx, def, solveOpts, ch)
+	_, err = c.Build(ctx, solveOpts, "unbind-builder", buildFunc, ch)
 
 	// Wait for progress monitoring to complete
 	<-progressDone
 
+	// Only reported for builds that asked for platforms
+	if len(opts.Platforms) == 0 {
+		result.PlatformBuilds = nil
+	}
+
 	if err != nil {
-		return fmt.Errorf("failed to solve: %w", err)
+		// The image wasn't pushed, so neither was its SBOM
+		result.Packages = nil
+		return result, fmt.Errorf("failed to solve: %w", err)
 	}
 
 	buildDuration := time.Since(startTime)
 	log.Infof("Successfully built image in %.2fs", buildDuration.Seconds())
 
 	log.Infof("image name: %s", imageName)
-	return nil
+	return result, nil
 }
 
 func getImageName(appDir string) string {
@@ -0,0 +1,261 @@
+package buildkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/client/llb/sourceresolver"
+	gateway "github.com/moby/buildkit/frontend/gateway/client"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+)
+
+const (
+	// Platform API the lifecycle is driven with, supported by every current lifecycle release
+	cnbPlatformAPI = "0.12"
+	cnbAppDir      = "/workspace"
+	cnbLayersDir   = "/layers"
+	cnbPlatformDir = "/platform"
+	cnbLifecycle   = "/cnb/lifecycle"
+	// Used when the builder image doesn't declare its user
+	cnbDefaultUID = 1000
+	cnbDefaultGID = 1000
+)
+
+type BuildpacksOptions struct {
+	BuilderImage string
+}
+
+// builderImageMetadata is the part of the io.buildpacks.builder.metadata label we need
+type builderImageMetadata struct {
+	Stack struct {
+		RunImage struct {
+			Image string `json:"image"`
+		} `json:"runImage"`
+	} `json:"stack"`
+	RunImages []struct {
+		Image string `json:"image"`
+	} `json:"runImages"`
+}
+
+// launchMetadata is the part of the builder's <layers>/config/metadata.toml we need
+type launchMetadata struct {
+	Processes []struct {
+		Type    string `toml:"type"`
+		Default bool   `toml:"default"`
+	} `toml:"processes"`
+}
+
+// buildpacksPlatformBuild runs the CNB lifecycle (detect and build) from the builder image, then assembles the app on the builder's run image
+// Secrets are passed to buildpacks through the platform env directory, they're mounted so they never end up in a layer
+func buildpacksPlatformBuild(opts BuildWithBuildkitClientOptions) platformBuildFunc {
+	return func(ctx context.Context, c gateway.Client, platform specs.Platform, cacheImports []gateway.CacheOptionsEntry) (gateway.Reference, []byte, error) {
+		builderImage := opts.Buildpacks.BuilderImage
+		builderConfig, err := resolveImageConfig(ctx, c, builderImage, platform)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		uid, gid := builderUser(builderConfig.Config.Env)
+		runImage := builderRunImage(builderConfig.Config.Labels)
+		if runImage == "" {
+			log.Warnf("Builder image %s doesn't declare a run image, running on the builder image", builderImage)
+			runImage = builderImage
+		}
+		log.Infof("Building with buildpacks builder %s, run image %s", builderImage, runImage)
+
+		owner := llb.WithUIDGID(uid, gid)
+		builder := llb.Image(builderImage, llb.Platform(platform)).
+			File(llb.Mkdir(cnbLayersDir, 0755, llb.WithParents(true), owner)).
+			File(llb.Mkdir(path.Join(cnbPlatformDir, "env"), 0755, llb.WithParents(true), owner)).
+			File(llb.Copy(llb.Local("context"), "/", cnbAppDir, &llb.CopyInfo{
+				CopyDirContentsOnly: true,
+				CreateDestPath:      true,
+				ChownOpt:            &llb.ChownOpt{User: &llb.UserOpt{UID: uid}, Group: &llb.UserOpt{UID: gid}},
+			}))
+
+		lifecycleArgs := []string{"-app", cnbAppDir, "-layers", cnbLayersDir, "-platform", cnbPlatformDir}
+		runOpts := []llb.RunOption{
+			llb.User(fmt.Sprintf("%d:%d", uid, gid)),
+			llb.AddEnv("CNB_PLATFORM_API", cnbPlatformAPI),
+			// Secret values aren't part of the cache key, the hash makes changed secrets rebuild
+			llb.AddEnv("UNBIND_SECRETS_HASH", opts.SecretsHash),
+		}
+		for _, name := range sortedSecretNames(opts.Secrets) {
+			runOpts = append(runOpts, llb.AddSecret(path.Join(cnbPlatformDir, "env", name), llb.SecretID(name), llb.SecretFileOpt(uid, gid, 0400)))
+		}
+
+		detect := builder.Run(append([]llb.RunOption{
+			llb.Args(append([]string{path.Join(cnbLifecycle, "detector")}, lifecycleArgs...)),
+			llb.WithCustomName("[buildpacks] detect"),
+		}, runOpts...)...).Root()
+		built := detect.Run(append([]llb.RunOption{
+			llb.Args(append([]string{path.Join(cnbLifecycle, "builder")}, lifecycleArgs...)),
+			llb.WithCustomName("[buildpacks] build"),
+		}, runOpts...)...).Root()
+
+		builtRef, err := solveState(ctx, c, built, platform, cacheImports)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		metadata, err := builtRef.ReadFile(ctx, gateway.ReadRequest{Filename: path.Join(cnbLayersDir, "config", "metadata.toml")})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read launch metadata: %w", err)
+		}
+		processType, err := defaultProcessType(metadata)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Infof("Buildpacks detected process type %s", processType)
+
+		processPath := path.Join("/cnb/process", processType)
+		final := llb.Image(runImage, llb.Platform(platform)).
+			File(llb.Copy(built, cnbLayersDir, cnbLayersDir, &llb.CopyInfo{CopyDirContentsOnly: true, CreateDestPath: true})).
+			File(llb.Copy(built, cnbAppDir, cnbAppDir, &llb.CopyInfo{CopyDirContentsOnly: true, CreateDestPath: true})).
+			File(llb.Copy(built, path.Join(cnbLifecycle, "launcher"), path.Join(cnbLifecycle, "launcher"), &llb.CopyInfo{CreateDestPath: true})).
+			File(llb.Mkdir("/cnb/process", 0755, llb.WithParents(true)).
+				Symlink(path.Join(cnbLifecycle, "launcher"), processPath))
+
+		finalRef, err := solveState(ctx, c, final, platform, cacheImports)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Keep the run image's config, the launcher sets up the buildpack provided environment before starting the process
+		image, err := resolveImageConfig(ctx, c, runImage, platform)
+		if err != nil {
+			return nil, nil, err
+		}
+		image.Platform = platform
+		image.RootFS = specs.RootFS{Type: "layers"}
+		image.History = nil
+		image.Config.User = fmt.Sprintf("%d:%d", uid, gid)
+		image.Config.Entrypoint = []string{processPath}
+		image.Config.Cmd = nil
+		image.Config.WorkingDir = cnbAppDir
+		image.Config.Env = append(image.Config.Env,
+			"CNB_PLATFORM_API="+cnbPlatformAPI,
+			"CNB_APP_DIR="+cnbAppDir,
+			"CNB_LAYERS_DIR="+cnbLayersDir,
+		)
+		imageBytes, err := json.Marshal(image)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshalling image: %w", err)
+		}
+
+		return finalRef, imageBytes, nil
+	}
+}
+
+func resolveImageConfig(ctx context.Context, c gateway.Client, ref string, platform specs.Platform) (specs.Image, error) {
+	var image specs.Image
+	_, _, configBytes, err := c.ResolveImageConfig(ctx, ref, sourceresolver.Opt{
+		ImageOpt: &sourceresolver.ResolveImageOpt{
+			Platform: &platform,
+		},
+	})
+	if err != nil {
+		return image, fmt.Errorf("failed to resolve image %s: %w", ref, err)
+	}
+	if err := json.Unmarshal(configBytes, &image); err != nil {
+		return image, fmt.Errorf("invalid image config for %s: %w", ref, err)
+	}
+	return image, nil
+}
+
+func solveState(ctx context.Context, c gateway.Client, state llb.State, platform specs.Platform, cacheImports []gateway.CacheOptionsEntry) (gateway.Reference, error) {
+	def, err := state.Marshal(ctx, llb.Platform(platform))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling LLB state: %w", err)
+	}
+	res, err := c.Solve(ctx, gateway.SolveRequest{
+		Definition:   def.ToPB(),
+		CacheImports: cacheImports,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SingleRef()
+}
+
+// builderUser returns the user the lifecycle runs as, from CNB_USER_ID and CNB_GROUP_ID in the builder's env
+func builderUser(env []string) (uid, gid int) {
+	uid, gid = cnbDefaultUID, cnbDefaultGID
+	for _, kv := range env {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		switch name {
+		case "CNB_USER_ID":
+			uid = n
+		case "CNB_GROUP_ID":
+			gid = n
+		}
+	}
+	return uid, gid
+}
+
+// builderRunImage returns the run image declared by the builder image's labels, empty if there is none
+func builderRunImage(labels map[string]string) string {
+	raw, ok := labels["io.buildpacks.builder.metadata"]
+	if !ok {
+		return ""
+	}
+	var metadata builderImageMetadata
+	if err := json.Unmarshal([]byte(raw), &metadata); err != nil {
+		return ""
+	}
+	if len(metadata.RunImages) > 0 && metadata.RunImages[0].Image != "" {
+		return metadata.RunImages[0].Image
+	}
+	return metadata.Stack.RunImage.Image
+}
+
+// defaultProcessType picks the process the image starts, the one marked default, else web, else the first
+func defaultProcessType(metadata []byte) (string, error) {
+	var launch launchMetadata
+	if _, err := toml.Decode(string(metadata), &launch); err != nil {
+		return "", fmt.Errorf("invalid launch metadata: %w", err)
+	}
+	if len(launch.Processes) == 0 {
+		return "", fmt.Errorf("buildpacks didn't detect a process to run, set a run command for the service")
+	}
+
+	for _, process := range launch.Processes {
+		if process.Default {
+			return process.Type, nil
+		}
+	}
+	for _, process := range launch.Processes {
+		if process.Type == "web" {
+			return process.Type, nil
+		}
+	}
+	return launch.Processes[0].Type, nil
+}
+
+func sortedSecretNames(secrets map[string]string) []string {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		// Env var names only, anything else would write outside the env directory
+		if name == "" || name != path.Base(name) || name == "." || name == ".." {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,102 @@
+package buildkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderUser(t *testing.T) {
+	uid, gid := builderUser([]string{"PATH=/usr/bin", "CNB_USER_ID=1001", "CNB_GROUP_ID=1002"})
+	assert.Equal(t, 1001, uid)
+	assert.Equal(t, 1002, gid)
+
+	uid, gid = builderUser([]string{"CNB_USER_ID=nope"})
+	assert.Equal(t, cnbDefaultUID, uid)
+	assert.Equal(t, cnbDefaultGID, gid)
+}
+
+func TestBuilderRunImage(t *testing.T) {
+	t.Run("Run images", func(t *testing.T) {
+		labels := map[string]string{
+			"io.buildpacks.builder.metadata": `{"runImages":[{"image":"paketobuildpacks/run-jammy-base:latest"}],"stack":{"runImage":{"image":"old/run"}}}`,
+		}
+		assert.Equal(t, "paketobuildpacks/run-jammy-base:latest", builderRunImage(labels))
+	})
+
+	t.Run("Stack run image", func(t *testing.T) {
+		labels := map[string]string{
+			"io.buildpacks.builder.metadata": `{"stack":{"runImage":{"image":"paketobuildpacks/run:base-cnb"}}}`,
+		}
+		assert.Equal(t, "paketobuildpacks/run:base-cnb", builderRunImage(labels))
+	})
+
+	t.Run("No metadata", func(t *testing.T) {
+		assert.Empty(t, builderRunImage(nil))
+		assert.Empty(t, builderRunImage(map[string]string{"io.buildpacks.builder.metadata": "not json"}))
+	})
+}
+
+func TestDefaultProcessType(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		expected string
+	}{
+		{
+			name: "Marked default",
+			metadata: `
+[[processes]]
+type = "web"
+command = ["java", "-jar", "app.jar"]
+
+[[processes]]
+type = "worker"
+command = ["java", "-cp", "app.jar", "Worker"]
+default = true
+`,
+			expected: "worker",
+		},
+		{
+			name: "Web",
+			metadata: `
+[[processes]]
+type = "task"
+
+[[processes]]
+type = "web"
+`,
+			expected: "web",
+		},
+		{
+			name: "First",
+			metadata: `
+[[processes]]
+type = "executable-jar"
+
+[[processes]]
+type = "task"
+`,
+			expected: "executable-jar",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processType, err := defaultProcessType([]byte(tt.metadata))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, processType)
+		})
+	}
+
+	t.Run("No processes", func(t *testing.T) {
+		_, err := defaultProcessType([]byte(`[[buildpacks]]` + "\n" + `id = "paketo-buildpacks/java"`))
+		assert.Error(t, err)
+	})
+}
+
+func TestSortedSecretNames(t *testing.T) {
+	names := sortedSecretNames(map[string]string{"B": "1", "A": "2", "../escape": "3", "": "4"})
+	assert.Equal(t, []string{"A", "B"}, names)
+}
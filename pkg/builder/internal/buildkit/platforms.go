@@ -0,0 +1,197 @@
+package buildkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/containerd/platforms"
+	"github.com/moby/buildkit/exporter/containerimage/exptypes"
+	gateway "github.com/moby/buildkit/frontend/gateway/client"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	rpBuildkit "github.com/railwayapp/railpack/buildkit"
+	"github.com/unbindapp/unbind-api/ent/schema"
+	"github.com/unbindapp/unbind-api/internal/common/log"
+)
+
+// platformBuildFunc builds the image for one platform, returning its root filesystem and image config
+type platformBuildFunc func(ctx context.Context, c gateway.Client, platform specs.Platform, cacheImports []gateway.CacheOptionsEntry) (gateway.Reference, []byte, error)
+
+type platformResult struct {
+	ref         gateway.Reference
+	config      []byte
+	attestation *gateway.Attestation
+	packages    []schema.ImagePackage
+}
+
+// multiPlatformBuildFunc builds and scans every platform side by side and combines them into one image index, with the SBOM of each platform attached
+// Each platform's outcome is recorded in out, a failing platform doesn't stop the others so all failures are reported
+// The SBOM is best-effort, if it can't be generated the image is pushed without it and no packages are reported
+func multiPlatformBuildFunc(targets []specs.Platform, build platformBuildFunc, cacheImports []gateway.CacheOptionsEntry, out *BuildResult) gateway.BuildFunc {
+	return func(ctx context.Context, c gateway.Client) (*gateway.Result, error) {
+		scanner, err := newSBOMScanner(ctx, c)
+		if err != nil {
+			log.Warnf("Pushing the image without an SBOM: %v", err)
+		}
+
+		results := make([]platformResult, len(targets))
+		out.PlatformBuilds = make([]schema.PlatformBuild, len(targets))
+		var sbomFailed atomic.Bool
+		sbomFailed.Store(scanner == nil)
+
+		var wg sync.WaitGroup
+		for i, platform := range targets {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				name := platforms.Format(platform)
+				startTime := time.Now()
+				ref, config, err := build(ctx, c, platform, cacheImports)
+				if err == nil && scanner != nil {
+					var scanErr error
+					results[i].attestation, results[i].packages, scanErr = scanImage(ctx, c, scanner, name, ref)
+					if scanErr != nil {
+						log.Warnf("Pushing %s without an SBOM: %v", name, scanErr)
+						sbomFailed.Store(true)
+					}
+				}
+				duration := time.Since(startTime)
+
+				out.PlatformBuilds[i] = schema.PlatformBuild{
+					Platform:        name,
+					Succeeded:       err == nil,
+					DurationSeconds: duration.Seconds(),
+				}
+				if err != nil {
+					out.PlatformBuilds[i].Error = err.Error()
+					log.Errorf("Build for %s failed after %.2fs: %v", name, duration.Seconds(), err)
+					return
+				}
+				results[i].ref = ref
+				results[i].config = config
+				log.Infof("Built %s in %.2fs, %d packages in its SBOM", name, duration.Seconds(), len(results[i].packages))
+			}()
+		}
+		wg.Wait()
+
+		if err := platformBuildsError(out.PlatformBuilds); err != nil {
+			return nil, err
+		}
+
+		// Keyed by platform even for a single one, attestations are pushed in an image index either way
+		res := gateway.NewResult()
+		exporterPlatforms := exptypes.Platforms{}
+		var packages []schema.ImagePackage
+		for i, platform := range targets {
+			id := platforms.Format(platform)
+			res.AddRef(id, results[i].ref)
+			res.AddMeta(fmt.Sprintf("%s/%s", exptypes.ExporterImageConfigKey, id), results[i].config)
+			if results[i].attestation != nil {
+				res.AddAttestation(id, *results[i].attestation)
+			}
+			exporterPlatforms.Platforms = append(exporterPlatforms.Platforms, exptypes.Platform{
+				ID:       id,
+				Platform: platform,
+			})
+			packages = append(packages, results[i].packages...)
+		}
+		exporterPlatformsBytes, err := json.Marshal(exporterPlatforms)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling platforms: %w", err)
+		}
+		res.AddMeta(exptypes.ExporterPlatformsKey, exporterPlatformsBytes)
+
+		// A partial index would hide the platforms that weren't scanned from package searches
+		if !sbomFailed.Load() {
+			out.Packages = uniquePackages(packages)
+		}
+		return res, nil
+	}
+}
+
+// dockerfilePlatformBuild runs the Dockerfile frontend for one platform
+func dockerfilePlatformBuild(frontendAttrs map[string]string) platformBuildFunc {
+	return func(ctx context.Context, c gateway.Client, platform specs.Platform, cacheImports []gateway.CacheOptionsEntry) (gateway.Reference, []byte, error) {
+		attrs := maps.Clone(frontendAttrs)
+		attrs["platform"] = platforms.Format(platform)
+		res, err := c.Solve(ctx, gateway.SolveRequest{
+			Frontend:     "dockerfile.v0",
+			FrontendOpt:  attrs,
+			CacheImports: cacheImports,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return frontendPlatformResult(res)
+	}
+}
+
+// railpackPlatformBuild converts the plan for one platform, railpack picks its base images by platform
+func railpackPlatformBuild(opts BuildWithBuildkitClientOptions) platformBuildFunc {
+	return func(ctx context.Context, c gateway.Client, platform specs.Platform, cacheImports []gateway.CacheOptionsEntry) (gateway.Reference, []byte, error) {
+		llbState, image, err := rpBuildkit.ConvertPlanToLLB(opts.RailpackBuildPlan, rpBuildkit.ConvertPlanOptions{
+			BuildPlatform: platform,
+			SecretsHash:   opts.SecretsHash,
+			CacheKey:      opts.CacheKey,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error converting plan to LLB: %w", err)
+		}
+
+		imageBytes, err := json.Marshal(image)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshalling image: %w", err)
+		}
+
+		ref, err := solveState(ctx, c, *llbState, platform, cacheImports)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ref, imageBytes, nil
+	}
+}
+
+func formatPlatforms(targets []specs.Platform) []string {
+	formatted := make([]string, len(targets))
+	for i, platform := range targets {
+		formatted[i] = platforms.Format(platform)
+	}
+	return formatted
+}
+
+// platformBuildsError summarizes the failed platforms, nil if all succeeded
+func platformBuildsError(builds []schema.PlatformBuild) error {
+	var failures []string
+	for _, build := range builds {
+		if !build.Succeeded {
+			failures = append(failures, fmt.Sprintf("%s: %s", build.Platform, build.Error))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("build failed for %s", strings.Join(failures, "; "))
+}
+
+// frontendPlatformResult picks the single platform result out of a frontend's result, which may be keyed by platform
+func frontendPlatformResult(res *gateway.Result) (gateway.Reference, []byte, error) {
+	if res.Ref != nil {
+		return res.Ref, res.Metadata[exptypes.ExporterImageConfigKey], nil
+	}
+	if len(res.Refs) != 1 {
+		return nil, nil, fmt.Errorf("expected a result for one platform, got %d", len(res.Refs))
+	}
+	for id, ref := range res.Refs {
+		config, ok := res.Metadata[fmt.Sprintf("%s/%s", exptypes.ExporterImageConfigKey, id)]
+		if !ok {
+			config = res.Metadata[exptypes.ExporterImageConfigKey]
+		}
+		return ref, config, nil
+	}
+	return nil, nil, nil
+}
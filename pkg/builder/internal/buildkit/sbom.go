@@ -0,0 +1,146 @@
+package buildkit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/client/llb/sourceresolver"
+	"github.com/moby/buildkit/frontend/attestations/sbom"
+	gateway "github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/moby/buildkit/solver/result"
+	"github.com/unbindapp/unbind-api/ent/schema"
+)
+
+const (
+	// Same scanner buildkit uses for attest:sbom, it writes SPDX in-toto statements
+	sbomScannerImage  = "docker/buildkit-syft-scanner:stable-1"
+	spdxPredicateType = "https://spdx.dev/Document"
+)
+
+// sbomStatement is the part of an in-toto statement with an SPDX predicate we need
+type sbomStatement struct {
+	PredicateType string `json:"predicateType"`
+	Predicate     struct {
+		Packages []struct {
+			Name         string `json:"name"`
+			VersionInfo  string `json:"versionInfo"`
+			ExternalRefs []struct {
+				ReferenceType    string `json:"referenceType"`
+				ReferenceLocator string `json:"referenceLocator"`
+			} `json:"externalRefs"`
+		} `json:"packages"`
+	} `json:"predicate"`
+}
+
+// scanImage generates the SBOM attestation for a built image and lists the packages in it
+// The attestation is attached to the result, so buildkit skips its own scan and pushes ours with the image
+func scanImage(ctx context.Context, c gateway.Client, scanner sbom.Scanner, platformID string, ref gateway.Reference) (*gateway.Attestation, []schema.ImagePackage, error) {
+	state, err := ref.ToState()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	att, err := scanner(ctx, platformID, state, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sbom scan: %w", err)
+	}
+	attestation, err := result.ConvertAttestation(&att, func(st *llb.State) (gateway.Reference, error) {
+		def, err := st.Marshal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.Solve(ctx, gateway.SolveRequest{Definition: def.ToPB()})
+		if err != nil {
+			return nil, err
+		}
+		return res.SingleRef()
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan image: %w", err)
+	}
+
+	files, err := attestation.Ref.ReadDir(ctx, gateway.ReadDirRequest{Path: "/", IncludePattern: "*.json"})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read sbom: %w", err)
+	}
+	var packages []schema.ImagePackage
+	for _, file := range files {
+		statement, err := attestation.Ref.ReadFile(ctx, gateway.ReadRequest{Filename: path.Join("/", file.Path)})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read sbom %s: %w", file.Path, err)
+		}
+		filePackages, err := sbomPackages(statement)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid sbom %s: %w", file.Path, err)
+		}
+		packages = append(packages, filePackages...)
+	}
+
+	return attestation, packages, nil
+}
+
+func newSBOMScanner(ctx context.Context, c gateway.Client) (sbom.Scanner, error) {
+	scanner, err := sbom.CreateSBOMScanner(ctx, c, sbomScannerImage, sourceresolver.Opt{
+		ImageOpt: &sourceresolver.ResolveImageOpt{},
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve sbom scanner %s: %w", sbomScannerImage, err)
+	}
+	return scanner, nil
+}
+
+// sbomPackages lists the packages of an SPDX in-toto statement, packages without a package URL (e.g. the scanned directory itself) are left out
+func sbomPackages(statement []byte) ([]schema.ImagePackage, error) {
+	var s sbomStatement
+	if err := json.Unmarshal(statement, &s); err != nil {
+		return nil, err
+	}
+	if s.PredicateType != spdxPredicateType {
+		return nil, nil
+	}
+
+	var packages []schema.ImagePackage
+	for _, p := range s.Predicate.Packages {
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType != "purl" || ref.ReferenceLocator == "" {
+				continue
+			}
+			packages = append(packages, schema.ImagePackage{
+				Name:    p.Name,
+				Version: p.VersionInfo,
+				Type:    purlType(ref.ReferenceLocator),
+				PURL:    ref.ReferenceLocator,
+			})
+			break
+		}
+	}
+	return packages, nil
+}
+
+// purlType returns the type of a package URL, e.g. npm for pkg:npm/express@4.19.2
+func purlType(purl string) string {
+	typ, _, _ := strings.Cut(strings.TrimPrefix(purl, "pkg:"), "/")
+	return typ
+}
+
+// uniquePackages dedupes packages by package URL, platforms mostly contain the same ones
+func uniquePackages(packages []schema.ImagePackage) []schema.ImagePackage {
+	seen := make(map[string]bool, len(packages))
+	unique := make([]schema.ImagePackage, 0, len(packages))
+	for _, p := range packages {
+		if seen[p.PURL] {
+			continue
+		}
+		seen[p.PURL] = true
+		unique = append(unique, p)
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].PURL < unique[j].PURL
+	})
+	return unique
+}
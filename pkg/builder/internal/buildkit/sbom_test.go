@@ -0,0 +1,71 @@
+package buildkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbindapp/unbind-api/ent/schema"
+)
+
+func TestSBOMPackages(t *testing.T) {
+	statement := `{
+  "_type": "https://in-toto.io/Statement/v0.1",
+  "predicateType": "https://spdx.dev/Document",
+  "subject": [{"name": "/run/src/core/sbom"}],
+  "predicate": {
+    "spdxVersion": "SPDX-2.3",
+    "packages": [
+      {
+        "name": "/run/src/core/sbom",
+        "SPDXID": "SPDXRef-DocumentRoot-Directory-run-src-core-sbom"
+      },
+      {
+        "name": "express",
+        "versionInfo": "4.19.2",
+        "externalRefs": [
+          {"referenceCategory": "SECURITY", "referenceType": "cpe23Type", "referenceLocator": "cpe:2.3:a:express:express:4.19.2:*:*:*:*:*:*:*"},
+          {"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/express@4.19.2"}
+        ]
+      },
+      {
+        "name": "libssl3",
+        "versionInfo": "3.0.13-1~deb12u1",
+        "externalRefs": [
+          {"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:deb/debian/libssl3@3.0.13-1~deb12u1?arch=arm64"}
+        ]
+      }
+    ]
+  }
+}`
+
+	packages, err := sbomPackages([]byte(statement))
+	require.NoError(t, err)
+	assert.Equal(t, []schema.ImagePackage{
+		{Name: "express", Version: "4.19.2", Type: "npm", PURL: "pkg:npm/express@4.19.2"},
+		{Name: "libssl3", Version: "3.0.13-1~deb12u1", Type: "deb", PURL: "pkg:deb/debian/libssl3@3.0.13-1~deb12u1?arch=arm64"},
+	}, packages)
+
+	t.Run("Other predicates", func(t *testing.T) {
+		packages, err := sbomPackages([]byte(`{"predicateType": "https://slsa.dev/provenance/v1", "predicate": {}}`))
+		require.NoError(t, err)
+		assert.Empty(t, packages)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := sbomPackages([]byte("not json"))
+		assert.Error(t, err)
+	})
+}
+
+func TestUniquePackages(t *testing.T) {
+	packages := uniquePackages([]schema.ImagePackage{
+		{Name: "zlib", PURL: "pkg:deb/debian/zlib1g@1.2.13"},
+		{Name: "express", PURL: "pkg:npm/express@4.19.2"},
+		{Name: "zlib", PURL: "pkg:deb/debian/zlib1g@1.2.13"},
+	})
+	assert.Equal(t, []schema.ImagePackage{
+		{Name: "zlib", PURL: "pkg:deb/debian/zlib1g@1.2.13"},
+		{Name: "express", PURL: "pkg:npm/express@4.19.2"},
+	}, packages)
+}
@@ -175,6 +175,16 @@ func CreateServiceObject(params ServiceParams) (*v1.Service, error) {
 // DeployImage creates (or replaces) the service resource in the target namespace
 // for deployment after a successful build job.
 func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, additionalEnv map[string]string, securityContext *corev1.SecurityContext, healthCheck *v1.HealthCheckSpec, variableMounts []v1.VariableMountSpec) (*unstructured.Unstructured, *v1.Service, error) {
+	service, err := self.buildServiceObject(crdName, image, additionalEnv, securityContext, healthCheck, variableMounts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return self.k8s.DeployUnbindService(ctx, service)
+}
+
+// buildServiceObject creates the service resource for the built image from the builder configuration
+func (self *K8SClient) buildServiceObject(crdName, image string, additionalEnv map[string]string, securityContext *corev1.SecurityContext, healthCheck *v1.HealthCheckSpec, variableMounts []v1.VariableMountSpec) (*v1.Service, error) {
 	// Generate a sanitized service name from the repo name
 	serviceName := strings.ToLower(strings.ReplaceAll(crdName, "_", "-"))
 
@@ -183,11 +193,11 @@ func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, a
 		// b64 decode first
 		decodedConifg, err := base64.StdEncoding.DecodeString(self.builderConfig.ServiceDatabaseConfig)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to decode database template config: %v", err)
+			return nil, fmt.Errorf("failed to decode database template config: %v", err)
 		}
 		// Parse it to validate the format
 		if err := json.Unmarshal([]byte(decodedConifg), &dbConfig); err != nil {
-			return nil, nil, fmt.Errorf("failed to parse template config: %v", err)
+			return nil, fmt.Errorf("failed to parse template config: %v", err)
 		}
 	}
 
@@ -207,10 +217,10 @@ func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, a
 	if self.builderConfig.ServiceVolumes != "" {
 		decodedVolumes, err := base64.StdEncoding.DecodeString(self.builderConfig.ServiceVolumes)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to decode volumes: %v", err)
+			return nil, fmt.Errorf("failed to decode volumes: %v", err)
 		}
 		if err := json.Unmarshal([]byte(decodedVolumes), &volumes); err != nil {
-			return nil, nil, fmt.Errorf("failed to parse volumes: %v", err)
+			return nil, fmt.Errorf("failed to parse volumes: %v", err)
 		}
 	}
 
@@ -219,10 +229,10 @@ func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, a
 	if self.builderConfig.ServiceInitContainers != "" {
 		decodedInitContainers, err := base64.StdEncoding.DecodeString(self.builderConfig.ServiceInitContainers)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to decode init containers: %v", err)
+			return nil, fmt.Errorf("failed to decode init containers: %v", err)
 		}
 		if err := json.Unmarshal([]byte(decodedInitContainers), &initContainers); err != nil {
-			return nil, nil, fmt.Errorf("failed to parse init containers: %v", err)
+			return nil, fmt.Errorf("failed to parse init containers: %v", err)
 		}
 	}
 
@@ -231,10 +241,10 @@ func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, a
 	if self.builderConfig.ServiceResources != "" {
 		decodedResources, err := base64.StdEncoding.DecodeString(self.builderConfig.ServiceResources)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to decode resources: %v", err)
+			return nil, fmt.Errorf("failed to decode resources: %v", err)
 		}
 		if err := json.Unmarshal([]byte(decodedResources), &resources); err != nil {
-			return nil, nil, fmt.Errorf("failed to parse resources: %v", err)
+			return nil, fmt.Errorf("failed to parse resources: %v", err)
 		}
 	}
 
@@ -305,10 +315,10 @@ func (self *K8SClient) DeployImage(ctx context.Context, crdName, image string, a
 	// Create the Service object
 	service, err := CreateServiceObject(params)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create service object: %v", err)
+		return nil, fmt.Errorf("failed to create service object: %v", err)
 	}
 
-	return self.k8s.DeployUnbindService(ctx, service)
+	return service, nil
 }
 
 // extractGitRepository parses a Git URL and extracts the repository information in the format "owner/repo"
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/unbindapp/unbind-api/internal/infrastructure/k8s"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Must fit within the builder job's own deadline
+const preDeployTimeout = 10 * time.Minute
+
+// How much of the command's output to keep, the full output is in the deployment logs
+const maxPreDeployOutput = 4000
+
+// RunPreDeployCommand runs the service's pre-deploy command with the new image, the rollout only continues if it succeeds
+// Returns the tail of the command's output
+func (self *K8SClient) RunPreDeployCommand(ctx context.Context, image string, additionalEnv map[string]string, securityContext *corev1.SecurityContext) (string, error) {
+	envVars := make([]corev1.EnvVar, 0, len(additionalEnv))
+	for k, v := range additionalEnv {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  k,
+			Value: v,
+		})
+	}
+
+	output, err := self.k8s.RunPreDeployJob(ctx, k8s.PreDeployJob{
+		Namespace:        self.namespace,
+		DeploymentID:     self.builderConfig.ServiceDeploymentID.String(),
+		TeamID:           self.builderConfig.ServiceTeamRef,
+		ProjectID:        self.builderConfig.ServiceProjectRef,
+		EnvironmentID:    self.builderConfig.ServiceEnvironmentRef,
+		Image:            image,
+		Command:          self.builderConfig.ServicePreDeployCommand,
+		SecretName:       self.builderConfig.ServiceSecretName,
+		EnvVars:          envVars,
+		ImagePullSecrets: strings.Split(self.builderConfig.ImagePullSecrets, ","),
+		SecurityContext:  securityContext,
+		Affinity:         k8s.PlatformNodeAffinity(self.builderConfig.ServicePlatforms),
+		Timeout:          preDeployTimeout,
+	})
+
+	if len(output) > maxPreDeployOutput {
+		output = output[len(output)-maxPreDeployOutput:]
+	}
+	return output, err
+}
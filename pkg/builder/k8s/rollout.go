@@ -0,0 +1,29 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/unbindapp/unbind-api/ent/schema"
+	v1 "github.com/unbindapp/unbind-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DeployCandidate runs the built image next to the current deployment for a blue-green or canary rollout
+// Returns the service resource as it will look once promoted
+func (self *K8SClient) DeployCandidate(ctx context.Context, crdName, image string, additionalEnv map[string]string, securityContext *corev1.SecurityContext, healthCheck *v1.HealthCheckSpec, variableMounts []v1.VariableMountSpec) (*v1.Service, error) {
+	service, err := self.buildServiceObject(crdName, image, additionalEnv, securityContext, healthCheck, variableMounts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Canaries get a share of the traffic right away, blue-green candidates get none until they're healthy and promoted
+	weight := 0
+	if self.builderConfig.ServiceRolloutStrategy == schema.RolloutStrategyCanary {
+		weight = self.builderConfig.ServiceCanaryWeight
+	}
+	if err := self.k8s.DeployRolloutCandidate(ctx, service, weight); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}